@@ -67,8 +67,15 @@ func main() {
 	application := app.New(logger, pool, redisClient, &appCfg, &telegramCfg, prometheus.DefaultRegisterer)
 
 	srv := app.RunBackgroundServer(logger, "server", appCfg.Host+":"+appCfg.Port, application.Router)
+	srv.RegisterOnShutdown(application.OnShutdown)
 	adminSrv := app.RunBackgroundServer(logger, "admin server", appCfg.Host+":"+appCfg.AdminPort, application.AdminRouter)
 
+	jobsCtx, stopJobs := context.WithCancel(context.Background())
+	jobsDone := make([]<-chan struct{}, 0, len(application.Jobs))
+	for _, job := range application.Jobs {
+		jobsDone = append(jobsDone, app.RunBackgroundJob(jobsCtx, logger, job))
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
@@ -86,5 +93,16 @@ func main() {
 		logger.Error("Admin server forced to shutdown", slog.String("err", err.Error()))
 	}
 
+	stopJobs()
+waitJobs:
+	for _, done := range jobsDone {
+		select {
+		case <-done:
+		case <-shutdownCtx.Done():
+			logger.Error("Background jobs forced to shutdown")
+			break waitJobs
+		}
+	}
+
 	logger.Info("Server exited")
 }
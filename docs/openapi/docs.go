@@ -15,14 +15,14 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/v1/boards": {
+        "/v1/board-imports/{importId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "List all boards for the current user. Results are returned in increasing creation time order.",
+                "description": "Get the status of a board import of the current user. A succeeded import links the new board; a failed one lists what is wrong with the file.",
                 "consumes": [
                     "application/json"
                 ],
@@ -32,15 +32,21 @@ const docTemplate = `{
                 "tags": [
                     "boards"
                 ],
-                "summary": "List all boards",
+                "summary": "Get a board import by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board import ID",
+                        "name": "importId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.boardResponse"
-                            }
+                            "$ref": "#/definitions/handler.boardImportResponse"
                         }
                     },
                     "400": {
@@ -55,6 +61,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
+                    "404": {
+                        "description": "BOARD_IMPORT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -62,14 +74,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/board-templates": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new board for the current user",
+                "description": "List templates available to the current user. Built-in templates come first, then the user's own templates in increasing creation time order.",
                 "consumes": [
                     "application/json"
                 ],
@@ -77,31 +91,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
-                ],
-                "summary": "Create a new board",
-                "parameters": [
-                    {
-                        "description": "Board details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.createBoardBody"
-                        }
-                    }
+                    "board-templates"
                 ],
+                "summary": "List board templates",
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.boardResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "VALIDATION_ERROR",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/httpschema.DetailedError"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.boardTemplateResponse"
+                            }
                         }
                     },
                     "401": {
@@ -110,12 +110,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
-                    "413": {
-                        "description": "PAYLOAD_TOO_LARGE",
-                        "schema": {
-                            "$ref": "#/definitions/httpschema.DetailedError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -125,14 +119,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/boards/{boardId}": {
-            "get": {
+        "/v1/board-templates/{templateId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get board metadata by id for the current user (owner only)",
+                "description": "Delete a template owned by the current user. Built-in templates cannot be deleted. Boards created from the template are not affected.",
                 "consumes": [
                     "application/json"
                 ],
@@ -140,24 +134,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "boards"
+                    "board-templates"
                 ],
-                "summary": "Get a board by id",
+                "summary": "Delete a board template by id",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Board ID",
-                        "name": "boardId",
+                        "description": "Board template ID",
+                        "name": "templateId",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/handler.boardResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "VALIDATION_ERROR",
@@ -172,7 +163,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "BOARD_NOT_FOUND",
+                        "description": "BOARD_TEMPLATE_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -184,14 +175,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/v1/boards": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Permanently delete a board and its columns and tasks for the current user (owner only)",
+                "description": "List all boards for the current user. Results are returned in increasing creation time order.",
                 "consumes": [
                     "application/json"
                 ],
@@ -201,19 +194,16 @@ const docTemplate = `{
                 "tags": [
                     "boards"
                 ],
-                "summary": "Delete a board by id",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Board ID",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
+                "summary": "List all boards",
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.boardResponse"
+                            }
+                        }
                     },
                     "400": {
                         "description": "VALIDATION_ERROR",
@@ -227,12 +217,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
-                    "404": {
-                        "description": "BOARD_NOT_FOUND",
-                        "schema": {
-                            "$ref": "#/definitions/httpschema.DetailedError"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -241,13 +225,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "patch": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Partially update board metadata for the current user (owner only). Provided fields are updated; omitted or null fields are ignored.",
+                "description": "Create a new board for the current user. When templateId is set, the board starts with the template's columns, WIP limits and starter tasks. The template must be built-in or owned by the current user.",
                 "consumes": [
                     "application/json"
                 ],
@@ -257,30 +241,35 @@ const docTemplate = `{
                 "tags": [
                     "boards"
                 ],
-                "summary": "Update a board by id",
+                "summary": "Create a new board",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Board ID",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     },
                     {
-                        "description": "Board fields to update",
+                        "description": "Board details",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.updateBoardBody"
+                            "$ref": "#/definitions/handler.createBoardBody"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "$ref": "#/definitions/handler.boardResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the board"
+                            }
                         }
                     },
                     "400": {
@@ -296,7 +285,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "BOARD_NOT_FOUND",
+                        "description": "BOARD_TEMPLATE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -307,6 +302,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -316,14 +317,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/boards/{boardId}/aggregate": {
-            "get": {
+        "/v1/boards/import": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a board with nested columns and tasks for the current user (owner only). Columns are returned in increasing position order, and tasks inside each column are returned in increasing position order.",
+                "description": "Start importing a board for the current user from a Trello board export, a CSV file or a board exported as JSON. The import runs in the background; poll it until it has succeeded or failed. Either the whole board is created or nothing is, and a failed import lists every problem of the file found, up to 100.\nTrello lists become columns and cards tasks, with checklists; archived cards become archived tasks and archived lists are skipped. Labels become a multi select \"Labels\" field and due dates a date \"Due\" field. A CSV file has a header row and a task per row; columns and lanes are created in the order their names first appear. A JSON export is recreated with its lanes, custom fields, sprints, parents and links.\nIds and timestamps are new; the original history of the tasks is not imported. The request is limited to 5 MB.",
                 "consumes": [
                     "application/json"
                 ],
@@ -333,21 +334,29 @@ const docTemplate = `{
                 "tags": [
                     "boards"
                 ],
-                "summary": "Get a board aggregate by id",
+                "summary": "Import a board",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Board ID",
-                        "name": "boardId",
-                        "in": "path",
-                        "required": true
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Import source and file",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createBoardImportBody"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "202": {
+                        "description": "Accepted",
                         "schema": {
-                            "$ref": "#/definitions/handler.aggregateBoardResponse"
+                            "$ref": "#/definitions/handler.boardImportResponse"
                         }
                     },
                     "400": {
@@ -362,8 +371,20 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
-                    "404": {
-                        "description": "BOARD_NOT_FOUND",
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -377,21 +398,24 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/boards/{boardId}/columns": {
+        "/v1/boards/{boardId}": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all columns belonging to the specified board. Results are returned in increasing position order.",
+                "description": "Get board metadata by id for the current user (owner only)",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "boards"
                 ],
-                "summary": "List all columns in a board",
+                "summary": "Get a board by id",
                 "parameters": [
                     {
                         "type": "string",
@@ -405,9 +429,12 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.columnResponse"
+                            "$ref": "#/definitions/handler.boardResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the board"
                             }
                         }
                     },
@@ -437,13 +464,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new column in board for the current user. Column is appended to the end.",
+                "description": "Permanently delete a board and its columns and tasks for the current user (owner only)\nWith If-Match set to the ETag of a previous response, the board is only deleted if it hasn't changed since.",
                 "consumes": [
                     "application/json"
                 ],
@@ -451,9 +478,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "boards"
                 ],
-                "summary": "Create a new column",
+                "summary": "Delete a board by id",
                 "parameters": [
                     {
                         "type": "string",
@@ -463,21 +490,15 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Column details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.createColumnBody"
-                        }
+                        "type": "string",
+                        "description": "ETag the deletion is based on",
+                        "name": "If-Match",
+                        "in": "header"
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/handler.columnResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
                     "400": {
                         "description": "VALIDATION_ERROR",
@@ -497,8 +518,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
-                    "413": {
-                        "description": "PAYLOAD_TOO_LARGE",
+                    "412": {
+                        "description": "VERSION_CONFLICT",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -510,16 +531,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/boards/{boardId}/columns/{columnId}": {
-            "delete": {
+            },
+            "patch": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Permanently delete a column from board for the current user and shift positions to close the gap.",
+                "description": "Partially update board metadata for the current user (owner only). Provided fields are updated; omitted or null fields are ignored.\nWith If-Match set to the ETag of a previous response, the board is only updated if it hasn't changed since.",
                 "consumes": [
                     "application/json"
                 ],
@@ -527,9 +546,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "boards"
                 ],
-                "summary": "Delete a column by id",
+                "summary": "Update a board by id",
                 "parameters": [
                     {
                         "type": "string",
@@ -540,15 +559,32 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
-                        "required": true
+                        "description": "ETag the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Board fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateBoardBody"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.boardResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the board"
+                            }
+                        }
                     },
                     "400": {
                         "description": "VALIDATION_ERROR",
@@ -563,7 +599,19 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "COLUMN_NOT_FOUND",
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "412": {
+                        "description": "VERSION_CONFLICT",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -575,14 +623,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/v1/boards/{boardId}/aggregate": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Partially update column metadata for the current user. Provided fields are updated; omitted or null fields are ignored.",
+                "description": "Get a board with nested columns and tasks for the current user (owner only). Columns are returned in increasing position order, and tasks inside each column are returned ordered by swimlane and then by position.\nThe lanes field is the swimlane × column grid: the default lane (lane is null) comes first, then lanes in increasing position order, each with one cell of task ids per column.\nWith tree=true the response is aggregateBoardTreeResponse: columns list only top-level tasks and every task lists its children recursively, whatever column they sit in.\nEvery task has a columnAge with the time it entered its current column, its age there in seconds and whether that age breaches the column SLA; columnAge is null when the entry is unknown.\nArchived tasks are left out unless includeArchived=true, in which case they follow the active tasks of their lane in the order they were archived.",
                 "consumes": [
                     "application/json"
                 ],
@@ -590,9 +640,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "boards"
                 ],
-                "summary": "Rename a column by id",
+                "summary": "Get a board aggregate by id",
                 "parameters": [
                     {
                         "type": "string",
@@ -602,27 +652,23 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Nest children under their parents",
+                        "name": "tree",
+                        "in": "query"
                     },
                     {
-                        "description": "Column fields to update",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.updateColumnBody"
-                        }
+                        "type": "boolean",
+                        "description": "Include archived tasks",
+                        "name": "includeArchived",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.columnResponse"
+                            "$ref": "#/definitions/handler.aggregateBoardResponse"
                         }
                     },
                     "400": {
@@ -638,13 +684,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "COLUMN_NOT_FOUND",
-                        "schema": {
-                            "$ref": "#/definitions/httpschema.DetailedError"
-                        }
-                    },
-                    "413": {
-                        "description": "PAYLOAD_TOO_LARGE",
+                        "description": "BOARD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -658,24 +698,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/boards/{boardId}/columns/{columnId}/position": {
-            "put": {
+        "/v1/boards/{boardId}/analytics/burndown": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Move a column within a board for the current user and shift neighboring columns accordingly.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the daily story points of a board, or of one of its sprints when sprintId is set. Points are snapshotted once per UTC day, by the database clock, for every running sprint of a board with sprints and for the whole board otherwise.\nTasks without an estimate count as zero points; days without a snapshot are left out. For a sprint, from and to default to the sprint dates; otherwise an omitted bound leaves the range open.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "columns"
+                    "analytics"
                 ],
-                "summary": "Move a column to a new position",
+                "summary": "Get burndown and burnup charts",
                 "parameters": [
                     {
                         "type": "string",
@@ -686,26 +723,28 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
-                        "required": true
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "query"
                     },
                     {
-                        "description": "Target position",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.moveColumnBody"
-                        }
+                        "type": "string",
+                        "description": "First day, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.columnPositionResponse"
+                            "$ref": "#/definitions/handler.burndownResponse"
                         }
                     },
                     "400": {
@@ -721,13 +760,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "COLUMN_NOT_FOUND",
-                        "schema": {
-                            "$ref": "#/definitions/httpschema.DetailedError"
-                        }
-                    },
-                    "413": {
-                        "description": "PAYLOAD_TOO_LARGE",
+                        "description": "BOARD_NOT_FOUND or SPRINT_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -741,21 +774,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/boards/{boardId}/columns/{columnId}/tasks": {
+        "/v1/boards/{boardId}/analytics/cfd": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all tasks belonging to the specified column. Results are returned in increasing position order.",
+                "description": "Get the number of tasks in every column of a board at the end of each UTC day from from through to, stepping by interval.\nEvery column change of a task is recorded, and tasks are counted by column identity, so the history stays accurate after columns are renamed or reordered. Columns are the current columns of the board in board order; deleted columns are left out.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "analytics"
                 ],
-                "summary": "List all tasks in a column",
+                "summary": "Get cumulative flow diagram",
                 "parameters": [
                     {
                         "type": "string",
@@ -766,20 +799,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
+                        "description": "First day, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Last day, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "default": "day",
+                        "description": "Step between points, day or week",
+                        "name": "interval",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/handler.taskResponse"
-                            }
+                            "$ref": "#/definitions/handler.cumulativeFlowResponse"
                         }
                     },
                     "400": {
@@ -795,7 +839,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "COLUMN_NOT_FOUND",
+                        "description": "BOARD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -807,24 +851,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/v1/boards/{boardId}/analytics/cycle-time": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new task in a column for the current user. Task is appended to the end of the column.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the p50, p85 and p95 lead time (creation to done) and cycle time (start to done) in days of the tasks of a board finished from from through to, and every such task as a scatterplot point.\nA task starts when it first enters a column with isStarted or isDone set and is finished when it last enters a done column from a column that is not done. Only tasks that are in a done column now count. The range spans at most 366 days.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "analytics"
                 ],
-                "summary": "Create a new task",
+                "summary": "Get lead and cycle times",
                 "parameters": [
                     {
                         "type": "string",
@@ -835,26 +878,24 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
+                        "description": "First day, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query",
                         "required": true
                     },
                     {
-                        "description": "Task details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.createTaskBody"
-                        }
+                        "type": "string",
+                        "description": "Last day, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.taskResponse"
+                            "$ref": "#/definitions/handler.cycleTimeResponse"
                         }
                     },
                     "400": {
@@ -870,13 +911,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "COLUMN_NOT_FOUND",
-                        "schema": {
-                            "$ref": "#/definitions/httpschema.DetailedError"
-                        }
-                    },
-                    "413": {
-                        "description": "PAYLOAD_TOO_LARGE",
+                        "description": "BOARD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -890,24 +925,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}": {
-            "delete": {
+        "/v1/boards/{boardId}/analytics/throughput": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Permanently delete a task from a column for the current user and shift positions to close the gap.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get the number of tasks of a board finished in every week, starting on Monday, from the week of from through the week of to. Only tasks finished from from through to count, with the same definition of finished as the cycle time. The range spans at most 366 weeks.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "analytics"
                 ],
-                "summary": "Delete a task by id",
+                "summary": "Get weekly throughput",
                 "parameters": [
                     {
                         "type": "string",
@@ -918,22 +950,25 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
+                        "description": "First day, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
+                        "description": "Last day, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query",
                         "required": true
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.throughputResponse"
+                        }
                     },
                     "400": {
                         "description": "VALIDATION_ERROR",
@@ -948,7 +983,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "TASK_NOT_FOUND",
+                        "description": "BOARD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -960,24 +995,23 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "patch": {
+            }
+        },
+        "/v1/boards/{boardId}/automations": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Partially update task metadata for the current user. Provided fields are updated; omitted or null fields are ignored.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Get all automations of a board owned by the current user, in increasing creation time order.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "automations"
                 ],
-                "summary": "Update a task by id",
+                "summary": "List automations of a board",
                 "parameters": [
                     {
                         "type": "string",
@@ -985,36 +1019,16 @@ const docTemplate = `{
                         "name": "boardId",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Task fields to update",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.updateTaskBody"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.taskResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.automationResponse"
+                            }
                         }
                     },
                     "400": {
@@ -1030,13 +1044,7 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "TASK_NOT_FOUND",
-                        "schema": {
-                            "$ref": "#/definitions/httpschema.DetailedError"
-                        }
-                    },
-                    "413": {
-                        "description": "PAYLOAD_TOO_LARGE",
+                        "description": "BOARD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -1048,16 +1056,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/position": {
-            "put": {
+            },
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Move a task within its column or across columns in the same board and shift neighboring tasks accordingly.",
+                "description": "Create a \"when this happens, do that\" rule on a board. The trigger is task_created, task_moved, due_date_passed (fieldId of a date field, fires once the date is over in UTC) or label_added (fieldId of a single or multi select field and an optional label, any label when empty).\nA trigger is optionally limited to the column the task was created in, moved into or is in.\nThe action is one of move_to_column (columnId, the task goes to the top of the column), set_custom_field (fieldId and value, null clears the field), add_label (fieldId of a select field and label, which replaces the label of a single select field), notify_telegram (message, sent to the Telegram chat of the board owner) or create_task (columnId and taskName, a follow-up task at the end of the column).\nAutomations run in the background shortly after the event, only on events that happen after they are created. Moves follow the board workflow. Follow-up tasks don't fire task_created automations, and an automation runs at most 5 times an hour on the same task.\nenabled defaults to true.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1065,9 +1071,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tasks"
+                    "automations"
                 ],
-                "summary": "Move a task to a new position, possibly to another column",
+                "summary": "Create an automation",
                 "parameters": [
                     {
                         "type": "string",
@@ -1078,33 +1084,25 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Column ID",
-                        "name": "columnId",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Task ID",
-                        "name": "taskId",
-                        "in": "path",
-                        "required": true
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
                     },
                     {
-                        "description": "Target column and position",
+                        "description": "Automation details",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.moveTaskBody"
+                            "$ref": "#/definitions/handler.createAutomationBody"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/handler.taskPositionResponse"
+                            "$ref": "#/definitions/handler.automationResponse"
                         }
                     },
                     "400": {
@@ -1120,7 +1118,13 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "TASK_NOT_FOUND or COLUMN_NOT_FOUND",
+                        "description": "BOARD_NOT_FOUND, COLUMN_NOT_FOUND or CUSTOM_FIELD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -1131,6 +1135,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1140,29 +1150,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/health": {
-            "get": {
-                "description": "Check if the server is alive",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "health"
-                ],
-                "summary": "Health check",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/httpschema.Status"
-                        }
-                    }
-                }
-            }
-        },
-        "/v1/login": {
+        "/v1/boards/{boardId}/automations/dry-run": {
             "post": {
-                "description": "Login with email and password to get a JWT token",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replay the latest events of a board, task creations and moves, passed due dates and added labels, against a rule without running its action, and get the events the action would have run on, oldest first.\nThe rule is checked like a new automation. events is how many of the latest events are replayed and defaults to 50.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1170,17 +1165,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "automations"
                 ],
-                "summary": "Login a user",
+                "summary": "Dry-run an automation",
                 "parameters": [
                     {
-                        "description": "Login credentials",
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rule to replay",
                         "name": "body",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/handler.loginBody"
+                            "$ref": "#/definitions/handler.dryRunAutomationBody"
                         }
                     }
                 ],
@@ -1188,7 +1190,7 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.loginResponse"
+                            "$ref": "#/definitions/handler.automationDryRunResponse"
                         }
                     },
                     "400": {
@@ -1198,7 +1200,13 @@ const docTemplate = `{
                         }
                     },
                     "401": {
-                        "description": "INVALID_CREDENTIALS or USER_NOT_FOUND",
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND, COLUMN_NOT_FOUND or CUSTOM_FIELD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -1218,35 +1226,38 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/register": {
-            "post": {
-                "description": "Register a new user with email and password",
-                "consumes": [
-                    "application/json"
+        "/v1/boards/{boardId}/automations/runs": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
                 ],
+                "description": "Get the execution log of the automations of a board, the latest 100 runs first. A run is skipped when the action had nothing to do or the loop protection stopped it; message explains skipped and failed runs.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "automations"
                 ],
-                "summary": "Register a new user",
+                "summary": "List automation runs of a board",
                 "parameters": [
                     {
-                        "description": "Registration details",
-                        "name": "body",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/handler.registerBody"
-                        }
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/httpschema.Status"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.automationRunResponse"
+                            }
                         }
                     },
                     "400": {
@@ -1255,14 +1266,14 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
-                    "409": {
-                        "description": "USER_ALREADY_EXISTS",
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
-                    "413": {
-                        "description": "PAYLOAD_TOO_LARGE",
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -1276,26 +1287,120 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/users/me/telegram/link": {
-            "post": {
+        "/v1/boards/{boardId}/automations/{automationId}": {
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Creates a one-time token that a user can send to the Telegram bot via /start to link their Telegram account to the app.",
+                "description": "Delete an automation along with its entries of the execution log. Changes it already made are not affected.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "automations"
+                ],
+                "summary": "Delete an automation by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Automation ID",
+                        "name": "automationId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "AUTOMATION_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update an automation. Provided fields are updated; omitted or null fields are ignored. trigger and action are replaced as a whole.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "automations"
+                ],
+                "summary": "Update an automation by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Automation ID",
+                        "name": "automationId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Automation fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateAutomationBody"
+                        }
+                    }
                 ],
-                "summary": "Generate a Telegram link token",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.telegramLinkTokenResponse"
+                            "$ref": "#/definitions/handler.automationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
                     "401": {
@@ -1304,6 +1409,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
+                    "404": {
+                        "description": "AUTOMATION_NOT_FOUND, COLUMN_NOT_FOUND or CUSTOM_FIELD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1313,30 +1430,54 @@ const docTemplate = `{
                 }
             }
         },
-        "/v1/whoami": {
+        "/v1/boards/{boardId}/columns": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get current user ID from token. This is a protected endpoint — in addition to the responses listed below, the auth middleware may return 401 with codes INVALID_AUTH_HEADER or INVALID_TOKEN.",
+                "description": "Get all columns belonging to the specified board. Results are returned in increasing position order.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "columns"
+                ],
+                "summary": "List all columns in a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get current user info",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/handler.whoAmIResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.columnResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized: INVALID_TOKEN (handler) or INVALID_AUTH_HEADER / INVALID_TOKEN (auth middleware)",
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
                         "schema": {
                             "$ref": "#/definitions/httpschema.DetailedError"
                         }
@@ -1348,11 +1489,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/webhook/telegram": {
+            },
             "post": {
-                "description": "Receives update objects from Telegram Bot API. Processes /start command with a link token to link a Telegram account to the user.",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new column in board for the current user. Column is appended to the end.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1360,156 +1504,7019 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "webhook"
+                    "columns"
                 ],
-                "summary": "Receive Telegram webhook updates",
-                "responses": {
-                    "200": {
-                        "description": "Always returns 200 OK per Telegram webhook protocol"
-                    }
-                }
-            }
-        }
-    },
-    "definitions": {
-        "handler.aggregateBoardResponse": {
-            "type": "object",
-            "properties": {
-                "columns": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.aggregateColumnResponse"
+                "summary": "Create a new column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Column details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createColumnBody"
+                        }
                     }
-                },
-                "createdAt": {
-                    "type": "string",
-                    "example": "2026-03-07T20:56:50.000+03:00"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "My Todo Description"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "My Todo Name"
-                },
-                "ownerId": {
-                    "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
-                },
-                "updatedAt": {
-                    "type": "string",
-                    "example": "2026-03-07T20:56:50.000+03:00"
-                }
-            }
-        },
-        "handler.aggregateColumnResponse": {
-            "type": "object",
-            "properties": {
-                "boardId": {
-                    "type": "string",
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.columnResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the column"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete a column from board for the current user and shift positions to close the gap.\nWith If-Match set to the ETag of a previous response, the column is only deleted if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "columns"
+                ],
+                "summary": "Delete a column by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the deletion is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "COLUMN_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "412": {
+                        "description": "VERSION_CONFLICT",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update column metadata for the current user. Provided fields are updated; omitted or null fields are ignored. The wipLimit is advisory: tasks can be created in or moved into a column at its limit, and clients are expected to flag the column; a wipLimit of 0 means no limit. A task breaches the column SLA once it has been in the column for slaHours hours, and the board owner is notified over Telegram once per stay in the column; a slaHours of 0 means no SLA. Tasks are archived archiveAfterDays days after they enter the column; an archiveAfterDays of 0 never archives. Work on a task starts when it first enters a column with isStarted or isDone set, and tasks in a column with isDone set count as finished.\nallowedTransitions lists the columns tasks may be moved to from this column; an empty list allows any column. entryConditions are checked when a task is moved into this column: checklist_done requires every checklist item to be done and estimate_set requires an estimate. entryRoles lists who may move tasks into this column: owner for the board owner and automation for the automations of the board; an empty list lets everyone in. The lists are replaced as a whole.\nWith If-Match set to the ETag of a previous response, the column is only updated if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "columns"
+                ],
+                "summary": "Rename a column by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Column fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateColumnBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.columnResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the column"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "COLUMN_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "412": {
+                        "description": "VERSION_CONFLICT",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/duplicate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make a deep copy of a column with its tasks for the current user. The copy is placed right after the original and following columns are shifted. Task positions, names and descriptions are preserved, all ids are new.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "columns"
+                ],
+                "summary": "Duplicate a column by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.columnResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the column copy"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "COLUMN_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/position": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a column within a board for the current user and shift neighboring columns accordingly.\nWith If-Match set to the ETag of a previous response, the column is only moved if it hasn't changed since. Moving a column bumps its version and the versions of the columns shifted around it. Their ETags are invalidated too, so a later If-Match request on any of them fails with 412 even though the client never moved it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "columns"
+                ],
+                "summary": "Move a column to a new position",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the move is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Target position",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.moveColumnBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.columnPositionResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the column"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "COLUMN_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "412": {
+                        "description": "VERSION_CONFLICT",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all tasks belonging to the specified column. Results are returned in increasing position order.\nTasks can be filtered by custom field values with customField=\u003cfieldId\u003e:\u003cvalue\u003e, once per field. A multi select filter matches tasks holding the option, a date filter takes YYYY-MM-DD and a checkbox filter takes true or false.\nArchived tasks are left out unless includeArchived=true, in which case they follow the active tasks of their lane in the order they were archived.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List all tasks in a column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Custom field filter as \u003cfieldId\u003e:\u003cvalue\u003e",
+                        "name": "customField",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "List archived tasks too",
+                        "name": "includeArchived",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.taskResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "COLUMN_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new task in a column for the current user. Task is appended to the end of the column.\nWhen templateId is set, name, description and checklist left empty are taken from the board's task template, with placeholders such as {{date}} expanded at creation time.\nWhen parentId is set, the task becomes a child of that task, which must be on the same board.\nWhen laneId is set, the task is appended to that swimlane of the column instead of the default lane.\nAn estimate is optional story points, from 0 to 1000 with at most two decimal places.\ncustomFields holds values of the board's custom fields keyed by field id and is validated against the field types.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Create a new task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Task details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createTaskBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "COLUMN_NOT_FOUND, LANE_NOT_FOUND, TASK_TEMPLATE_NOT_FOUND or TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete a task from a column for the current user and shift positions to close the gap.\nChildren of the task become top-level tasks by default; with children=cascade all descendants are deleted too.\nWith If-Match set to the ETag of a previous response, the task is only deleted if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Delete a task by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "orphan",
+                            "cascade"
+                        ],
+                        "type": "string",
+                        "default": "orphan",
+                        "description": "What happens to the children",
+                        "name": "children",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the deletion is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "412": {
+                        "description": "VERSION_CONFLICT",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update task metadata for the current user. Provided fields are updated; omitted or null fields are ignored.\nA provided checklist replaces the whole checklist. A null estimate clears the estimate.\ncustomFields sets the given custom field values and keeps the others; a null value removes the value of the field.\nWith If-Match set to the ETag of a previous response, the task is only updated if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Update a task by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the update is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Task fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateTaskBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "412": {
+                        "description": "VERSION_CONFLICT",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/children": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the direct children of a task. Children may sit in any column of the board and are returned in board order.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "List children of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.taskResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/duplicate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Copy a task for the current user. The copy is placed right after the original in the same column and following tasks are shifted. It keeps the sprint of the original. Active subtasks are copied recursively to the end of their cells, along with the links between the copied tasks; links to other tasks are not copied. Archived tasks can't be copied.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Duplicate a task by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task copy"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "TASK_ARCHIVED or IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/links": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Link the task with another task on any board of the current user.\nThe type is read from the task in the path: \"blocked_by\" means the target task blocks this one.\nBlocking links that would form a dependency cycle are rejected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task-links"
+                ],
+                "summary": "Link a task with another task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Link type and the target task",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createTaskLinkBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskLinkResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "TASK_LINK_ALREADY_EXISTS, TASK_LINK_CYCLE or IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/links/{linkId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove a link of the task. The link disappears from both linked tasks.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task-links"
+                ],
+                "summary": "Delete a task link by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Link ID",
+                        "name": "linkId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND or TASK_LINK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/parent": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make the task a child of another task on the same board, or a top-level task when parentId is null.\nA task can't become a child of itself or of any of its descendants.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Set the parent of a task",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New parent",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.setTaskParentBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "TASK_PARENT_CYCLE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/position": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a task within its column or across columns in the same board and shift neighboring tasks accordingly.\nWhen blockers are enforced, a task can't enter a done column while tasks blocking it are unfinished.\nMoves across columns follow the board workflow: the source column's allowedTransitions and the target column's entryRoles and entryConditions. Every broken rule is listed in the TRANSITION_NOT_ALLOWED details.\nPositions are scoped per column and swimlane. An omitted targetLaneId keeps the task's lane, null moves it to the default lane. Archived tasks can't be moved until they are restored.\nWith If-Match set to the ETag of a previous response, the task is only moved if it hasn't changed since. Moving a task bumps its version and the versions of the tasks shifted around it. Their ETags are invalidated too, so a later If-Match request on any of them fails with 412 even though the client never moved it.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Move a task to a new position, possibly to another column",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag the move is based on",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Target column and position",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.moveTaskBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskPositionResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND, COLUMN_NOT_FOUND or LANE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "TASK_BLOCKED, TRANSITION_NOT_ALLOWED, TASK_ARCHIVED or IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "412": {
+                        "description": "VERSION_CONFLICT",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Bring an archived task back for the current user. The task is placed at the end of its lane in the column and the archive delay of the column starts over. Restoring an active task changes nothing.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Restore an archived task by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column ID",
+                        "name": "columnId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the task"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/custom-fields": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all custom fields defined on the specified board, oldest first.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "List custom fields of a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.customFieldResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Define a custom field on a board for the current user. Select fields (single_select, multi_select) require options, other types take none. The type can't be changed later.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "Create a custom field",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Custom field details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createCustomFieldBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.customFieldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/custom-fields/{fieldId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete a custom field from a board together with its values on every task of the board.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "Delete a custom field by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Custom field ID",
+                        "name": "fieldId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "CUSTOM_FIELD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Rename a custom field or replace the options of a select field. Omitted or null fields are ignored. Task values lose the options that are no longer offered; single select values and emptied multi select values are removed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "custom-fields"
+                ],
+                "summary": "Update a custom field by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Custom field ID",
+                        "name": "fieldId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Custom field fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateCustomFieldBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.customFieldResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "CUSTOM_FIELD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/duplicate": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Make a deep copy of a board with its columns and tasks for the current user (owner only). Positions, names and descriptions are preserved, all ids are new. Links between tasks of the board are copied; links to tasks on other boards are not. Sprints and archived tasks are not copied, so every task copy starts in the backlog. The copy is listed after the existing boards.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Duplicate a board by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.boardResponse"
+                        },
+                        "headers": {
+                            "ETag": {
+                                "type": "string",
+                                "description": "Version of the board copy"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/events": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Server-Sent Events for every board, column and task created, updated, moved or deleted on the board, by any client.\nEach event is named after the entity and the action, like task.moved, and carries the ids and the new version of what changed; read the entity to get its state.\nTasks and columns shifted to make room for a move, or to close the gap left by a deleted or archived one, come as moved too.\nA reconnecting client sends the id of the last event it got as Last-Event-ID and gets every event after it. Without one the stream starts with the next change.\nThe stream ends after board.deleted. Idle streams get a comment every 15 seconds.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Stream board events",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Id of the last event received, to resume after it",
+                        "name": "Last-Event-ID",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Stream of events with this data",
+                        "schema": {
+                            "$ref": "#/definitions/handler.boardEventResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the board with its columns, lanes, custom fields, sprints and every task, archived ones included, as an attachment.\njson is a versioned document that can be imported back, holding the links between tasks too. csv holds a row per task with a column per custom field. md is a readable outline with a section per column.\nTasks are streamed as they are read, so errors after the first byte cut the body short instead of changing the status.",
+                "produces": [
+                    "application/json",
+                    "text/csv",
+                    "text/markdown"
+                ],
+                "tags": [
+                    "boards"
+                ],
+                "summary": "Export a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "json",
+                            "csv",
+                            "md"
+                        ],
+                        "type": "string",
+                        "default": "json",
+                        "description": "Export format",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.boardExportDocument"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/forecast": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Run Monte Carlo simulations of the future throughput of a board and answer either \"when will these tasks be done?\" (tasks) or \"how many tasks will be done by this day?\" (targetDate); exactly one of them must be set.\nEvery simulated day, starting tomorrow, finishes as many tasks as a day drawn at random from the historyDays full days before today, with the same definition of finished as the cycle time. Each confidence level gets the outcome reached in at least that share of the simulations.\nSimulations run for at most 730 days, which also bounds targetDate. The same seed, settings and history give the same outcomes; the seed used is returned so a forecast can be replayed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "analytics"
+                ],
+                "summary": "Forecast delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Target and simulation settings. Defaults: simulations 5000 (100 to 10000), historyDays 90 (7 to 365), confidenceLevels [50, 85, 95] (1 to 99, at most 10), random seed (0 to 2^53 - 1).",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.forecastBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.forecastResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/lanes": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all swimlanes belonging to the specified board. Results are returned in increasing position order. The default lane is implicit and not listed.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lanes"
+                ],
+                "summary": "List all swimlanes in a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.laneResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a new swimlane in board for the current user. Lane is appended to the end, below the default lane and all existing lanes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lanes"
+                ],
+                "summary": "Create a new swimlane",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Lane details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createLaneBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.laneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/lanes/{laneId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Permanently delete a swimlane from board for the current user and shift positions to close the gap. Tasks of the lane are appended to the default lane of their columns, keeping their order.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lanes"
+                ],
+                "summary": "Delete a swimlane by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Lane ID",
+                        "name": "laneId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "LANE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update swimlane metadata for the current user. Provided fields are updated; omitted or null fields are ignored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lanes"
+                ],
+                "summary": "Rename a swimlane by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Lane ID",
+                        "name": "laneId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Lane fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateLaneBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.laneResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "LANE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/lanes/{laneId}/position": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move a swimlane within a board for the current user and shift neighboring lanes accordingly. The default lane always stays on top.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lanes"
+                ],
+                "summary": "Move a swimlane to a new position",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Lane ID",
+                        "name": "laneId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Target position",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.moveLaneBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.lanePositionResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "LANE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/recurrences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all recurrences of a board owned by the current user, in increasing creation time order.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "List recurrences of a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.recurrenceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a recurrence rule that materializes a new task in a column of the board at the scheduled time.\nThe rule is a subset of RFC 5545 RRULE: FREQ=DAILY, FREQ=WEEKLY;BYDAY=MO,WE,... or FREQ=MONTHLY;BYMONTHDAY=N (months without day N are skipped).\nOccurrences happen at the time of day of startsAt in UTC and never before startsAt.\ncatchUp defaults to skip and tells what to do with occurrences missed during downtime: skip them, or create a single task for all of them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Create a recurrence",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Recurrence details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createRecurrenceBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.recurrenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND or COLUMN_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/recurrences/{recurrenceId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a recurrence. Tasks already created by it are not affected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Delete a recurrence by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Recurrence ID",
+                        "name": "recurrenceId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "RECURRENCE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update a recurrence. Provided fields are updated; omitted or null fields are ignored.\nChanging rule or startsAt reschedules the next run from now; occurrences of the new schedule in the past are not caught up.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurrences"
+                ],
+                "summary": "Update a recurrence by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Recurrence ID",
+                        "name": "recurrenceId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Recurrence fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateRecurrenceBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.recurrenceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "RECURRENCE_NOT_FOUND or COLUMN_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/save-as-template": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Snapshot the columns of a board, with their descriptions and WIP limits, into a new template owned by the current user (board owner only). Tasks are copied as starter tasks only when includeTasks is true.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "board-templates"
+                ],
+                "summary": "Save a board as a template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Template details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.saveBoardAsTemplateBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.boardTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/sprints": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all sprints of a board owned by the current user, ordered by start date.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "List sprints of a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.sprintResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a sprint of the board. Dates are calendar dates in YYYY-MM-DD form; a one-day sprint starts and ends on the same date.\nSprints may overlap. Tasks are added to a sprint one by one; tasks in no sprint form the backlog.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "Create a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Sprint details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createSprintBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.sprintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/sprints/{sprintId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a sprint. Its tasks are returned to the backlog.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "Delete a sprint by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "SPRINT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update an open sprint. Provided fields are updated; omitted or null fields are ignored. A completed sprint cannot be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "Update a sprint by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Sprint fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateSprintBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.sprintResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "SPRINT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "SPRINT_COMPLETED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/sprints/{sprintId}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Complete an open sprint and record its report: committed counts every task in the sprint, completed counts those in done columns.\nUnfinished tasks are carried over to the open sprint that starts next (carryOver next_sprint, the default) or to the backlog (carryOver backlog).\nWithout a next open sprint they go to the backlog. Finished tasks stay in the completed sprint.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "Complete a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Where unfinished tasks go",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.completeSprintBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.sprintReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "SPRINT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "SPRINT_COMPLETED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/sprints/{sprintId}/report": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the report recorded when the sprint was completed. For an open sprint the report is a preview of completing it now, with completedAt and carriedOverTo set to null.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "Get a sprint report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.sprintReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "SPRINT_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/sprints/{sprintId}/tasks/{taskId}": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Add a task of the board to an open sprint. A task is in at most one sprint, so a task planned in another sprint is moved.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "Add a task to a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "SPRINT_NOT_FOUND or TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "SPRINT_COMPLETED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Return a task of an open sprint to the backlog.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sprints"
+                ],
+                "summary": "Remove a task from a sprint",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Sprint ID",
+                        "name": "sprintId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task ID",
+                        "name": "taskId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "SPRINT_NOT_FOUND or TASK_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "SPRINT_COMPLETED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the number of tasks in every column of a board, the tasks created, moved to another column and deleted over the last 7 and 30 days including today (UTC), the average age of open tasks and the 5 oldest open tasks. A task is open while it is not in a done column. Deleting a task with its subtasks counts every deleted task.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get board statistics",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.boardStatsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/task-templates": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get all task templates of a board owned by the current user, in increasing creation time order.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task-templates"
+                ],
+                "summary": "List task templates of a board",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/handler.taskTemplateResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a task template on a board owned by the current user.\nPatterns may use the placeholders {{date}}, {{time}}, {{weekday}}, {{week}} and {{month}}, expanded in UTC when a task is created from the template.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task-templates"
+                ],
+                "summary": "Create a task template",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Template details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.createTaskTemplateBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/task-templates/{templateId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a task template. Tasks already created from the template are not affected.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task-templates"
+                ],
+                "summary": "Delete a task template by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task template ID",
+                        "name": "templateId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_TEMPLATE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Partially update a task template. Provided fields are updated; omitted or null fields are ignored. A provided checklist replaces the whole checklist. Tasks already created from the template are not affected.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "task-templates"
+                ],
+                "summary": "Update a task template by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Task template ID",
+                        "name": "templateId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Template fields to update",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.updateTaskTemplateBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskTemplateResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "TASK_TEMPLATE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/boards/{boardId}/tasks:batch": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Move, update, delete and label tasks of a board in one request. Operations run in order, each seeing the board as the ones before it left it, and are applied all at once: if any of them fails, none is, and the error names the failing operation as operations[i] in its details.\nEach operation is checked like its single-task counterpart, including workflow rules, blockers and custom field values. Blockers are read as stored; only blockers the batch itself moves into a done column or deletes count as finished.\nA label is the value of a single or multi select custom field: set_label sets value on fieldId and null clears it. Deleted tasks leave their children as top-level tasks.\nAt most 100 operations are allowed and the request is limited to 256 KB.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tasks"
+                ],
+                "summary": "Apply several task operations at once",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Board ID",
+                        "name": "boardId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Operations to apply in order",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskBatchBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.taskBatchResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "404": {
+                        "description": "BOARD_NOT_FOUND, TASK_NOT_FOUND, COLUMN_NOT_FOUND or LANE_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "TASK_BLOCKED, TRANSITION_NOT_ALLOWED, TASK_ARCHIVED or IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/health": {
+            "get": {
+                "description": "Check if the server is alive",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Health check",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Status"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/login": {
+            "post": {
+                "description": "Login with email and password to get a JWT token",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login a user",
+                "parameters": [
+                    {
+                        "description": "Login credentials",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.loginBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.loginResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "INVALID_CREDENTIALS or USER_NOT_FOUND",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/register": {
+            "post": {
+                "description": "Register a new user with email and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "Registration details",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.registerBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Status"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "USER_ALREADY_EXISTS",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/sync": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Every board, column and task of the current user that was created, changed or deleted after the since cursor, for a client keeping a replica to work offline.\nChanged entities come as they are now, once however often they changed; deleted ones come in deleted. A deleted board or column takes its columns and tasks with it: only the board or column is listed. Tasks include archived ones.\nMoving a task or column changes the ones shifted to make room as well, so they come at their new positions along with it. The same goes for the ones shifted when another is deleted, archived or duplicated.\nWithout since, the response is full: everything the user has, to replace the replica with. Pass the returned cursor as since next time, right away while hasMore is set.\nA change may come again in a later pull; the version tells whether the replica has it already.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sync"
+                ],
+                "summary": "Pull changes for a local replica",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cursor returned by the last pull",
+                        "name": "since",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.syncChangesResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Apply the queue of operations a client made while offline, in order. Each operation is applied on its own, checked like its single-entity endpoint, and gets a result of its own with the entity as it left it; one that is not applied doesn't stop the others.\nEntities created by the push have no id the client knows: name them in later operations by the ref of their create, in boardRef, columnRef, taskRef or targetColumnRef instead of the id.\nConflicts are resolved on the server. An operation with a baseVersion the entity no longer has is a conflict: the change of the server wins, nothing is written and the result holds the entity as the server has it. Without baseVersion the operation is applied over any change.\nA deletion wins over every other change: an operation on an entity, or into a board or column, that was deleted is gone, and so is deleting what was deleted already. Operations that break a rule of the board, like its workflow or a position past the end of a column, are rejected.\nOperations on an entity whose create was not applied are skipped. If the server fails on an operation, it is failed and the rest are skipped, to be pushed again.\nTasks are created in the default lane and move within their lane. At most 100 operations are allowed and the request is limited to 256 KB.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "sync"
+                ],
+                "summary": "Push changes made offline",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Key the retries of this request are sent with",
+                        "name": "Idempotency-Key",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Operations to apply in order",
+                        "name": "body",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/handler.syncPushBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.syncPushResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "VALIDATION_ERROR",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "409": {
+                        "description": "IDEMPOTENCY_KEY_IN_USE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "413": {
+                        "description": "PAYLOAD_TOO_LARGE",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "422": {
+                        "description": "IDEMPOTENCY_KEY_REUSED",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/stats": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the statistics of all boards owned by the current user: the number of boards and tasks, the tasks created, moved to another column and deleted over the last 7 and 30 days including today (UTC), the average age of open tasks and the 5 oldest open tasks across the boards.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stats"
+                ],
+                "summary": "Get statistics of the current user",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.userStatsResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/users/me/telegram/link": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Creates a one-time token that a user can send to the Telegram bot via /start to link their Telegram account to the app.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Generate a Telegram link token",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.telegramLinkTokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/v1/whoami": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get current user ID from token. This is a protected endpoint — in addition to the responses listed below, the auth middleware may return 401 with codes INVALID_AUTH_HEADER or INVALID_TOKEN.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get current user info",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/handler.whoAmIResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized: INVALID_TOKEN (handler) or INVALID_AUTH_HEADER / INVALID_TOKEN (auth middleware)",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.DetailedError"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/httpschema.Error"
+                        }
+                    }
+                }
+            }
+        },
+        "/webhook/telegram": {
+            "post": {
+                "description": "Receives update objects from Telegram Bot API. Processes /start command with a link token to link a Telegram account to the user.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "Receive Telegram webhook updates",
+                "responses": {
+                    "200": {
+                        "description": "Always returns 200 OK per Telegram webhook protocol"
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "handler.aggregateBoardResponse": {
+            "type": "object",
+            "properties": {
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.aggregateColumnResponse"
+                    }
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "My Todo Description"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "lanes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.aggregateLaneResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "My Todo Name"
+                },
+                "ownerId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.aggregateCellResponse": {
+            "type": "object",
+            "properties": {
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "taskIds": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "handler.aggregateColumnResponse": {
+            "type": "object",
+            "properties": {
+                "allowedTransitions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                    ]
+                },
+                "archiveAfterDays": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "My Column Description"
+                },
+                "entryConditions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "checklist_done"
+                    ]
+                },
+                "entryRoles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "owner"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "isDone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "isStarted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "In Progress"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "slaHours": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.aggregateTaskResponse"
+                    }
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "wipLimit": {
+                    "description": "Advisory: tasks are never refused over it. 0 means no limit.",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.aggregateLaneResponse": {
+            "type": "object",
+            "properties": {
+                "cells": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.aggregateCellResponse"
+                    }
+                },
+                "lane": {
+                    "$ref": "#/definitions/handler.laneResponse"
+                }
+            }
+        },
+        "handler.aggregateTaskResponse": {
+            "type": "object",
+            "properties": {
+                "archivedAt": {
+                    "description": "ArchivedAt is null for active tasks.",
+                    "type": "string",
+                    "example": "2026-03-21T20:56:50.000+03:00"
+                },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemResponse"
+                    }
+                },
+                "columnAge": {
+                    "$ref": "#/definitions/handler.taskColumnAgeResponse"
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "customFields": {
+                    "description": "CustomFields holds the values of the board's custom fields keyed by field id. Fields without a value are omitted.",
+                    "type": "object"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Cover the new endpoint with tests"
+                },
+                "estimate": {
+                    "type": "number",
+                    "example": 3
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "laneId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskLinkResponse"
+                    }
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Write tests"
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a5"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "rollup": {
+                    "$ref": "#/definitions/handler.taskRollupResponse"
+                },
+                "sprintId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.automationActionBody": {
+            "type": "object",
+            "properties": {
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "fieldId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Urgent"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Ready for review"
+                },
+                "taskName": {
+                    "type": "string",
+                    "example": "Write release notes"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "move_to_column",
+                        "set_custom_field",
+                        "add_label",
+                        "notify_telegram",
+                        "create_task"
+                    ],
+                    "example": "move_to_column"
+                },
+                "value": {
+                    "type": "object"
+                }
+            }
+        },
+        "handler.automationActionResponse": {
+            "type": "object",
+            "properties": {
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "fieldId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Urgent"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Ready for review"
+                },
+                "taskName": {
+                    "type": "string",
+                    "example": "Write release notes"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "move_to_column"
+                },
+                "value": {
+                    "type": "object"
+                }
+            }
+        },
+        "handler.automationDryRunResponse": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "matches": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.automationEventResponse"
+                    }
+                }
+            }
+        },
+        "handler.automationEventResponse": {
+            "type": "object",
+            "properties": {
+                "fieldId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "fromColumnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86b0"
+                },
+                "occurredAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
+                },
+                "toColumnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "task_moved"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "Urgent"
+                }
+            }
+        },
+        "handler.automationResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/handler.automationActionResponse"
+                },
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a8"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Move approved tasks to Done"
+                },
+                "trigger": {
+                    "$ref": "#/definitions/handler.automationTriggerResponse"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                }
+            }
+        },
+        "handler.automationRunResponse": {
+            "type": "object",
+            "properties": {
+                "automationId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a8"
+                },
+                "eventId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86b0"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a9"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Board workflow doesn't allow moving the task to the column"
+                },
+                "ranAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "succeeded",
+                        "failed",
+                        "skipped"
+                    ],
+                    "example": "failed"
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
+                }
+            }
+        },
+        "handler.automationTriggerBody": {
+            "type": "object",
+            "properties": {
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "fieldId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Urgent"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "task_created",
+                        "task_moved",
+                        "due_date_passed",
+                        "label_added"
+                    ],
+                    "example": "task_moved"
+                }
+            }
+        },
+        "handler.automationTriggerResponse": {
+            "type": "object",
+            "properties": {
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "fieldId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "label": {
+                    "type": "string",
+                    "example": "Urgent"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "task_moved"
+                }
+            }
+        },
+        "handler.boardEventResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "created",
+                        "updated",
+                        "moved",
+                        "deleted"
+                    ],
+                    "example": "moved"
+                },
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "columnId": {
+                    "description": "ColumnID is the column of a column event, or the column a task is in after a task event. It is null for board events.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "entity": {
+                    "type": "string",
+                    "enum": [
+                        "board",
+                        "column",
+                        "task"
+                    ],
+                    "example": "task"
+                },
+                "occurredAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "taskId": {
+                    "description": "TaskID is null for board and column events.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "version": {
+                    "description": "Version is the version of the entity after the change, or the last one it had if it was deleted.",
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "handler.boardExportDocument": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "$ref": "#/definitions/handler.boardResponse"
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.columnResponse"
+                    }
+                },
+                "customFields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.customFieldResponse"
+                    }
+                },
+                "exportedAt": {
+                    "type": "string",
+                    "example": "2026-03-21T20:56:50.000+03:00"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "goroutine.board"
+                },
+                "lanes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.laneResponse"
+                    }
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.boardExportLink"
+                    }
+                },
+                "sprints": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.sprintResponse"
+                    }
+                },
+                "tasks": {
+                    "description": "Tasks are ordered by column, lane and position; archived tasks follow the active ones of their cell.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.boardExportTask"
+                    }
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handler.boardExportLink": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a8"
+                },
+                "sourceId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "targetId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blocks",
+                        "relates_to",
+                        "duplicates"
+                    ],
+                    "example": "blocks"
+                }
+            }
+        },
+        "handler.boardExportTask": {
+            "type": "object",
+            "properties": {
+                "archivedAt": {
+                    "description": "ArchivedAt is null for active tasks.",
+                    "type": "string",
+                    "example": "2026-03-21T20:56:50.000+03:00"
+                },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemResponse"
+                    }
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "customFields": {
+                    "description": "CustomFields holds the values of the board's custom fields keyed by field id. Fields without a value are omitted.",
+                    "type": "object"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Cover the new endpoint with tests"
+                },
+                "estimate": {
+                    "type": "number",
+                    "example": 3
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "laneId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Write tests"
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a5"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sprintId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                }
+            }
+        },
+        "handler.boardImportErrorResponse": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "name"
+                },
+                "issues": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "Name is too long"
+                    ]
+                },
+                "item": {
+                    "description": "Item names the part of the file at fault the way the file does, like \"card 5f3a9c...\" or \"row 12\".",
+                    "type": "string",
+                    "example": "row 12"
+                }
+            }
+        },
+        "handler.boardImportMappingBody": {
+            "type": "object",
+            "properties": {
+                "checklist": {
+                    "description": "Checklist names a header holding one checklist item per line, done items prefixed with \"[x] \".",
+                    "type": "string",
+                    "example": "Checklist"
+                },
+                "column": {
+                    "type": "string",
+                    "example": "Status"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Details"
+                },
+                "estimate": {
+                    "type": "string",
+                    "example": "Points"
+                },
+                "lane": {
+                    "type": "string",
+                    "example": "Team"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Title"
+                }
+            }
+        },
+        "handler.boardImportResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "description": "BoardID is set once the import has succeeded.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "errors": {
+                    "description": "Errors lists what is wrong with the file of a failed import, at most 100 problems.",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.boardImportErrorResponse"
+                    }
+                },
+                "finishedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:52.000+03:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a9"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "trello",
+                        "csv",
+                        "json"
+                    ],
+                    "example": "trello"
+                },
+                "startedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:51.000+03:00"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "pending",
+                        "running",
+                        "succeeded",
+                        "failed"
+                    ],
+                    "example": "succeeded"
+                }
+            }
+        },
+        "handler.boardResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "My Todo Description"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "My Todo Name"
+                },
+                "ownerId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.boardStatsResponse": {
+            "type": "object",
+            "properties": {
+                "asOf": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "averageOpenTaskAgeSeconds": {
+                    "type": "integer",
+                    "example": 345600
+                },
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.columnTaskCountResponse"
+                    }
+                },
+                "last30Days": {
+                    "$ref": "#/definitions/handler.taskActivityResponse"
+                },
+                "last7Days": {
+                    "$ref": "#/definitions/handler.taskActivityResponse"
+                },
+                "oldestOpenTasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.openTaskAgeResponse"
+                    }
+                },
+                "openTasks": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "tasks": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "handler.boardTemplateColumnResponse": {
+            "type": "object",
+            "properties": {
+                "archiveAfterDays": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Tasks being worked on"
+                },
+                "isDone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "isStarted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "In Progress"
+                },
+                "slaHours": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.boardTemplateTaskResponse"
+                    }
+                },
+                "wipLimit": {
+                    "description": "Advisory: tasks are never refused over it. 0 means no limit.",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.boardTemplateResponse": {
+            "type": "object",
+            "properties": {
+                "builtIn": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.boardTemplateColumnResponse"
+                    }
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Our usual sprint board"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019a0000-0000-7000-8000-000000000001"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Team Kanban"
+                },
+                "ownerId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                }
+            }
+        },
+        "handler.boardTemplateTaskResponse": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Link the team wiki"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Write onboarding notes"
+                }
+            }
+        },
+        "handler.burndownPointResponse": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string",
+                    "example": "2026-03-10"
+                },
+                "remaining": {
+                    "type": "number",
+                    "example": 21
+                }
+            }
+        },
+        "handler.burndownResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "burndown": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.burndownPointResponse"
+                    }
+                },
+                "burnup": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.burnupPointResponse"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2026-03-09"
+                },
+                "sprintId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-03-22"
+                }
+            }
+        },
+        "handler.burnupPointResponse": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "number",
+                    "example": 5
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2026-03-10"
+                },
+                "scope": {
+                    "type": "number",
+                    "example": 26
+                }
+            }
+        },
+        "handler.columnPositionResponse": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "handler.columnResponse": {
+            "type": "object",
+            "properties": {
+                "allowedTransitions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                    ]
+                },
+                "archiveAfterDays": {
+                    "type": "integer",
+                    "example": 14
+                },
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "My Column Description"
+                },
+                "entryConditions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "checklist_done"
+                    ]
+                },
+                "entryRoles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "owner"
+                    ]
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "isDone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "isStarted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "In Progress"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "slaHours": {
+                    "type": "integer",
+                    "example": 48
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "wipLimit": {
+                    "description": "Advisory: tasks are never refused over it. 0 means no limit.",
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "handler.columnTaskCountResponse": {
+            "type": "object",
+            "properties": {
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "In Progress"
+                },
+                "tasks": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "handler.completeSprintBody": {
+            "type": "object",
+            "properties": {
+                "carryOver": {
+                    "type": "string",
+                    "enum": [
+                        "next_sprint",
+                        "backlog"
+                    ],
+                    "example": "next_sprint"
+                }
+            }
+        },
+        "handler.completedTaskResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "cycleTimeDays": {
+                    "type": "number",
+                    "example": 2.35
+                },
+                "doneAt": {
+                    "type": "string",
+                    "example": "2026-03-10T18:30:00.000+03:00"
+                },
+                "leadTimeDays": {
+                    "type": "number",
+                    "example": 2.9
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ship onboarding"
+                },
+                "startedAt": {
+                    "type": "string",
+                    "example": "2026-03-08T10:00:00.000+03:00"
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                }
+            }
+        },
+        "handler.createAutomationBody": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/handler.automationActionBody"
+                },
+                "enabled": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Move approved tasks to Done"
+                },
+                "trigger": {
+                    "$ref": "#/definitions/handler.automationTriggerBody"
+                }
+            }
+        },
+        "handler.createBoardBody": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "My Board Description"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "My Board Name"
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "019a0000-0000-7000-8000-000000000001"
+                }
+            }
+        },
+        "handler.createBoardImportBody": {
+            "type": "object",
+            "properties": {
+                "content": {
+                    "description": "Content is the file: the JSON object of a Trello board export or of a board exported as JSON, or the text of a CSV file.",
+                    "type": "object"
+                },
+                "mapping": {
+                    "description": "Mapping names the CSV headers of the task attributes. It is required for CSV files and not allowed otherwise.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.boardImportMappingBody"
+                        }
+                    ]
+                },
+                "name": {
+                    "description": "Name overrides the board name of the file. It is required for CSV files.",
+                    "type": "string",
+                    "example": "Roadmap"
+                },
+                "source": {
+                    "type": "string",
+                    "enum": [
+                        "trello",
+                        "csv",
+                        "json"
+                    ],
+                    "example": "trello"
+                }
+            }
+        },
+        "handler.createColumnBody": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "My Column Description"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "To Do"
+                }
+            }
+        },
+        "handler.createCustomFieldBody": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Environment"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "staging",
+                        "production"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "text",
+                        "number",
+                        "date",
+                        "single_select",
+                        "multi_select",
+                        "checkbox",
+                        "user"
+                    ],
+                    "example": "single_select"
+                }
+            }
+        },
+        "handler.createLaneBody": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Platform team"
+                }
+            }
+        },
+        "handler.createRecurrenceBody": {
+            "type": "object",
+            "properties": {
+                "catchUp": {
+                    "type": "string",
+                    "enum": [
+                        "skip",
+                        "create_once"
+                    ],
+                    "example": "skip"
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Both floors"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Water the plants"
+                },
+                "rule": {
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO,TH"
+                },
+                "startsAt": {
+                    "type": "string",
+                    "example": "2026-03-09T09:00:00Z"
+                }
+            }
+        },
+        "handler.createSprintBody": {
+            "type": "object",
+            "properties": {
+                "endDate": {
+                    "type": "string",
+                    "example": "2026-03-22"
+                },
+                "goal": {
+                    "type": "string",
+                    "example": "Ship the billing page"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Sprint 14"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2026-03-09"
+                }
+            }
+        },
+        "handler.createTaskBody": {
+            "type": "object",
+            "properties": {
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemBody"
+                    }
+                },
+                "customFields": {
+                    "description": "CustomFields holds values of the board's custom fields keyed by field id.",
+                    "type": "object"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Cover the new endpoint with tests"
+                },
+                "estimate": {
+                    "type": "number",
+                    "example": 3
+                },
+                "laneId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Write tests"
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a5"
+                },
+                "templateId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
+                }
+            }
+        },
+        "handler.createTaskLinkBody": {
+            "type": "object",
+            "properties": {
+                "targetTaskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blocks",
+                        "blocked_by",
+                        "relates_to",
+                        "duplicates",
+                        "duplicated_by"
+                    ],
+                    "example": "blocks"
+                }
+            }
+        },
+        "handler.createTaskTemplateBody": {
+            "type": "object",
+            "properties": {
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemBody"
+                    }
+                },
+                "descriptionPattern": {
+                    "type": "string",
+                    "example": "Summary for the week starting {{date}}"
+                },
+                "namePattern": {
+                    "type": "string",
+                    "example": "Weekly report {{week}}"
+                }
+            }
+        },
+        "handler.cumulativeFlowColumnResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "isDone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "isStarted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "In Progress"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "handler.cumulativeFlowPointResponse": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        4,
+                        2,
+                        7
+                    ]
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2026-03-10"
+                }
+            }
+        },
+        "handler.cumulativeFlowResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.cumulativeFlowColumnResponse"
+                    }
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2026-03-09"
+                },
+                "interval": {
+                    "type": "string",
+                    "example": "day"
+                },
+                "points": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.cumulativeFlowPointResponse"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-03-22"
+                }
+            }
+        },
+        "handler.customFieldResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a8"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Environment"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "staging",
+                        "production"
+                    ]
+                },
+                "type": {
+                    "type": "string",
+                    "example": "single_select"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                }
+            }
+        },
+        "handler.cycleTimeResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "completed": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "cycleTime": {
+                    "$ref": "#/definitions/handler.percentilesResponse"
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2026-03-09"
+                },
+                "leadTime": {
+                    "$ref": "#/definitions/handler.percentilesResponse"
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.completedTaskResponse"
+                    }
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-03-22"
+                }
+            }
+        },
+        "handler.dryRunAutomationBody": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "$ref": "#/definitions/handler.automationActionBody"
+                },
+                "events": {
+                    "type": "integer",
+                    "example": 50
+                },
+                "trigger": {
+                    "$ref": "#/definitions/handler.automationTriggerBody"
+                }
+            }
+        },
+        "handler.forecastBody": {
+            "type": "object",
+            "properties": {
+                "confidenceLevels": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    },
+                    "example": [
+                        50,
+                        85,
+                        95
+                    ]
+                },
+                "historyDays": {
+                    "type": "integer",
+                    "example": 90
+                },
+                "seed": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "simulations": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "targetDate": {
+                    "type": "string",
+                    "example": "2026-04-30"
+                },
+                "tasks": {
+                    "type": "integer",
+                    "example": 20
+                }
+            }
+        },
+        "handler.forecastHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "completed": {
+                    "type": "integer",
+                    "example": 63
+                },
+                "dailyAverage": {
+                    "type": "number",
+                    "example": 0.7
+                },
+                "from": {
+                    "type": "string",
+                    "example": "2025-12-09"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-03-08"
+                }
+            }
+        },
+        "handler.forecastOutcomeResponse": {
+            "type": "object",
+            "properties": {
+                "confidence": {
+                    "type": "integer",
+                    "example": 85
+                },
+                "date": {
+                    "type": "string",
+                    "example": "2026-04-02"
+                },
+                "tasks": {
+                    "type": "integer",
+                    "example": 14
+                }
+            }
+        },
+        "handler.forecastResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "history": {
+                    "$ref": "#/definitions/handler.forecastHistoryResponse"
+                },
+                "outcomes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.forecastOutcomeResponse"
+                    }
+                },
+                "seed": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "simulations": {
+                    "type": "integer",
+                    "example": 5000
+                },
+                "start": {
+                    "type": "string",
+                    "example": "2026-03-10"
+                },
+                "targetDate": {
+                    "type": "string",
+                    "example": "2026-04-30"
+                },
+                "tasks": {
+                    "type": "integer",
+                    "example": 20
+                }
+            }
+        },
+        "handler.lanePositionResponse": {
+            "type": "object",
+            "properties": {
+                "position": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "handler.laneResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Platform team"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                }
+            }
+        },
+        "handler.loginBody": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "secret-password"
+                }
+            }
+        },
+        "handler.loginResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string",
+                    "example": "jwt-token"
+                }
+            }
+        },
+        "handler.moveColumnBody": {
+            "type": "object",
+            "properties": {
+                "targetPosition": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handler.moveLaneBody": {
+            "type": "object",
+            "properties": {
+                "targetPosition": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handler.moveTaskBody": {
+            "type": "object",
+            "properties": {
+                "targetColumnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "targetLaneId": {
+                    "description": "TargetLaneID keeps the current lane when omitted and moves the task to the default lane when null.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "targetPosition": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "handler.openTaskAgeResponse": {
+            "type": "object",
+            "properties": {
+                "ageSeconds": {
+                    "type": "integer",
+                    "example": 1209600
+                },
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Fix login"
+                }
+            }
+        },
+        "handler.percentilesResponse": {
+            "type": "object",
+            "properties": {
+                "p50": {
+                    "type": "number",
+                    "example": 2.5
+                },
+                "p85": {
+                    "type": "number",
+                    "example": 4.75
+                },
+                "p95": {
+                    "type": "number",
+                    "example": 6
+                }
+            }
+        },
+        "handler.recurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "catchUp": {
+                    "type": "string",
+                    "example": "skip"
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Both floors"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a5"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Water the plants"
+                },
+                "nextRunAt": {
+                    "type": "string",
+                    "example": "2026-03-12T09:00:00.000Z"
+                },
+                "rule": {
+                    "type": "string",
+                    "example": "FREQ=WEEKLY;BYDAY=MO,TH"
+                },
+                "startsAt": {
+                    "type": "string",
+                    "example": "2026-03-09T09:00:00.000Z"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                }
+            }
+        },
+        "handler.registerBody": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "secret-password"
+                }
+            }
+        },
+        "handler.saveBoardAsTemplateBody": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Our usual sprint board"
+                },
+                "includeTasks": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Team Kanban"
+                }
+            }
+        },
+        "handler.setTaskParentBody": {
+            "type": "object",
+            "properties": {
+                "parentId": {
+                    "description": "ParentID is null to make the task a top-level task.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a5"
+                }
+            }
+        },
+        "handler.sprintCarryOverTaskResponse": {
+            "type": "object",
+            "properties": {
+                "name": {
+                    "type": "string",
+                    "example": "Write tests"
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                }
+            }
+        },
+        "handler.sprintReportResponse": {
+            "type": "object",
+            "properties": {
+                "carriedOverTo": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a8"
+                },
+                "carryOver": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.sprintCarryOverTaskResponse"
+                    }
+                },
+                "committed": {
+                    "type": "integer",
+                    "example": 8
+                },
+                "completed": {
+                    "type": "integer",
+                    "example": 6
+                },
+                "completedAt": {
+                    "type": "string",
+                    "example": "2026-03-22T17:30:00.000Z"
+                },
+                "sprintId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                }
+            }
+        },
+        "handler.sprintResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "completedAt": {
+                    "type": "string",
+                    "example": "2026-03-22T17:30:00.000Z"
+                },
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "endDate": {
+                    "type": "string",
+                    "example": "2026-03-22"
+                },
+                "goal": {
+                    "type": "string",
+                    "example": "Ship the billing page"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Sprint 14"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2026-03-09"
+                },
+                "updatedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                }
+            }
+        },
+        "handler.syncChangesResponse": {
+            "type": "object",
+            "properties": {
+                "boards": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.boardResponse"
+                    }
+                },
+                "columns": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.columnResponse"
+                    }
+                },
+                "cursor": {
+                    "description": "Cursor is the since of the next pull.",
+                    "type": "string",
+                    "example": "742-1093"
+                },
+                "deleted": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.syncTombstoneResponse"
+                    }
+                },
+                "full": {
+                    "description": "Full is set when the response holds everything the user has, to replace the replica with.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "hasMore": {
+                    "description": "HasMore tells that more changes can be pulled right away.",
+                    "type": "boolean",
+                    "example": false
+                },
+                "tasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskResponse"
+                    }
+                }
+            }
+        },
+        "handler.syncErrorResponse": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "VERSION_CONFLICT"
+                },
+                "details": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/httpschema.Detail"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Resource has changed since the given version"
+                }
+            }
+        },
+        "handler.syncOperationBody": {
+            "type": "object",
+            "properties": {
+                "baseVersion": {
+                    "description": "BaseVersion is the version of the entity the change was made on. Without it the change is applied over any other.",
+                    "type": "integer",
+                    "example": 3
+                },
+                "boardId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
+                },
+                "boardRef": {
+                    "type": "string",
+                    "example": "new-board-1"
+                },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemBody"
+                    }
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "columnRef": {
+                    "type": "string",
+                    "example": "new-column-1"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Cover edge cases"
+                },
+                "estimate": {
+                    "type": "number",
+                    "example": 5
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Rewrite tests"
+                },
+                "ref": {
+                    "description": "Ref labels the operation in the results; the entity a create adds is named by it in later operations.",
+                    "type": "string",
+                    "example": "new-task-1"
+                },
+                "targetColumnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "targetColumnRef": {
+                    "type": "string",
+                    "example": "new-column-1"
+                },
+                "targetPosition": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "taskRef": {
+                    "type": "string",
+                    "example": "new-task-1"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "board.create",
+                        "board.update",
+                        "board.delete",
+                        "column.create",
+                        "column.update",
+                        "column.move",
+                        "column.delete",
+                        "task.create",
+                        "task.update",
+                        "task.move",
+                        "task.delete"
+                    ],
+                    "example": "task.move"
+                }
+            }
+        },
+        "handler.syncPushBody": {
+            "type": "object",
+            "properties": {
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.syncOperationBody"
+                    }
+                }
+            }
+        },
+        "handler.syncPushResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.syncResultResponse"
+                    }
+                }
+            }
+        },
+        "handler.syncResultResponse": {
+            "type": "object",
+            "properties": {
+                "board": {
+                    "description": "Board, Column or Task is the entity as the operation left it, or as the server has it on a conflict.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.boardResponse"
+                        }
+                    ]
+                },
+                "column": {
+                    "$ref": "#/definitions/handler.columnResponse"
+                },
+                "error": {
+                    "description": "Error tells why a conflicting, gone, rejected or failed operation was not applied.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.syncErrorResponse"
+                        }
+                    ]
+                },
+                "ref": {
+                    "type": "string",
+                    "example": "new-task-1"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "applied",
+                        "conflict",
+                        "gone",
+                        "rejected",
+                        "skipped",
+                        "failed"
+                    ],
+                    "example": "applied"
+                },
+                "task": {
+                    "$ref": "#/definitions/handler.taskResponse"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "task.move"
+                }
+            }
+        },
+        "handler.syncTombstoneResponse": {
+            "type": "object",
+            "properties": {
+                "boardId": {
+                    "type": "string",
                     "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
                 },
+                "columnId": {
+                    "description": "ColumnID is the column a deleted task was in, null for boards and columns.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "deletedAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "entity": {
+                    "type": "string",
+                    "enum": [
+                        "board",
+                        "column",
+                        "task"
+                    ],
+                    "example": "task"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "version": {
+                    "description": "Version is the last version the entity had.",
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "handler.taskActivityResponse": {
+            "type": "object",
+            "properties": {
+                "created": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "deleted": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "moved": {
+                    "type": "integer",
+                    "example": 11
+                }
+            }
+        },
+        "handler.taskBatchBody": {
+            "type": "object",
+            "properties": {
+                "operations": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskBatchOperationBody"
+                    }
+                }
+            }
+        },
+        "handler.taskBatchOperationBody": {
+            "type": "object",
+            "properties": {
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemBody"
+                    }
+                },
+                "customFields": {
+                    "type": "object"
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Cover edge cases"
+                },
+                "estimate": {
+                    "type": "number",
+                    "example": 5
+                },
+                "fieldId": {
+                    "description": "FieldID is the select field a label is set on and Value the option, null clearing the label.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a8"
+                },
+                "name": {
+                    "description": "Name, Description, Checklist, Estimate and CustomFields change an updated task like a single update does.",
+                    "type": "string",
+                    "example": "Rewrite tests"
+                },
+                "targetColumnId": {
+                    "description": "TargetColumnID, TargetLaneID and TargetPosition place a moved task like a single move does.",
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "targetLaneId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "targetPosition": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "move",
+                        "update",
+                        "delete",
+                        "set_label"
+                    ],
+                    "example": "move"
+                },
+                "value": {
+                    "type": "string",
+                    "example": "staging"
+                }
+            }
+        },
+        "handler.taskBatchResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskBatchResultResponse"
+                    }
+                }
+            }
+        },
+        "handler.taskBatchResultResponse": {
+            "type": "object",
+            "properties": {
+                "placement": {
+                    "description": "Placement is set for moves.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.taskPositionResponse"
+                        }
+                    ]
+                },
+                "task": {
+                    "description": "Task is the task as the update or label left it.",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/handler.taskResponse"
+                        }
+                    ]
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "move",
+                        "update",
+                        "delete",
+                        "set_label"
+                    ],
+                    "example": "move"
+                }
+            }
+        },
+        "handler.taskChecklistItemBody": {
+            "type": "object",
+            "properties": {
+                "done": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Update the changelog"
+                }
+            }
+        },
+        "handler.taskChecklistItemResponse": {
+            "type": "object",
+            "properties": {
+                "done": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "text": {
+                    "type": "string",
+                    "example": "Update the changelog"
+                }
+            }
+        },
+        "handler.taskColumnAgeResponse": {
+            "type": "object",
+            "properties": {
+                "enteredAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "seconds": {
+                    "type": "integer",
+                    "example": 93600
+                },
+                "slaBreached": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "handler.taskLinkResponse": {
+            "type": "object",
+            "properties": {
+                "createdAt": {
+                    "type": "string",
+                    "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "id": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "taskId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
+                },
+                "type": {
+                    "type": "string",
+                    "enum": [
+                        "blocks",
+                        "blocked_by",
+                        "relates_to",
+                        "duplicates",
+                        "duplicated_by"
+                    ],
+                    "example": "blocked_by"
+                }
+            }
+        },
+        "handler.taskPositionResponse": {
+            "type": "object",
+            "properties": {
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
+                "laneId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 4
+                }
+            }
+        },
+        "handler.taskResponse": {
+            "type": "object",
+            "properties": {
+                "archivedAt": {
+                    "description": "ArchivedAt is null for active tasks.",
+                    "type": "string",
+                    "example": "2026-03-21T20:56:50.000+03:00"
+                },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemResponse"
+                    }
+                },
+                "columnId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                },
                 "createdAt": {
                     "type": "string",
                     "example": "2026-03-07T20:56:50.000+03:00"
                 },
+                "customFields": {
+                    "description": "CustomFields holds the values of the board's custom fields keyed by field id. Fields without a value are omitted.",
+                    "type": "object"
+                },
                 "description": {
                     "type": "string",
-                    "example": "My Column Description"
+                    "example": "Cover the new endpoint with tests"
+                },
+                "estimate": {
+                    "type": "number",
+                    "example": 3
                 },
                 "id": {
                     "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                },
+                "laneId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a6"
+                },
+                "links": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskLinkResponse"
+                    }
                 },
                 "name": {
                     "type": "string",
-                    "example": "In Progress"
+                    "example": "Write tests"
+                },
+                "parentId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a5"
                 },
                 "position": {
                     "type": "integer",
                     "example": 1
                 },
-                "tasks": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/handler.taskResponse"
-                    }
+                "rollup": {
+                    "$ref": "#/definitions/handler.taskRollupResponse"
+                },
+                "sprintId": {
+                    "type": "string",
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a7"
                 },
                 "updatedAt": {
                     "type": "string",
                     "example": "2026-03-07T20:56:50.000+03:00"
+                },
+                "version": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "handler.boardResponse": {
+        "handler.taskRollupColumnResponse": {
             "type": "object",
             "properties": {
-                "createdAt": {
-                    "type": "string",
-                    "example": "2026-03-07T20:56:50.000+03:00"
-                },
-                "description": {
-                    "type": "string",
-                    "example": "My Todo Description"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
-                },
-                "name": {
-                    "type": "string",
-                    "example": "My Todo Name"
-                },
-                "ownerId": {
+                "columnId": {
                     "type": "string",
                     "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
                 },
-                "updatedAt": {
-                    "type": "string",
-                    "example": "2026-03-07T20:56:50.000+03:00"
+                "count": {
+                    "type": "integer",
+                    "example": 2
                 }
             }
         },
-        "handler.columnPositionResponse": {
+        "handler.taskRollupResponse": {
             "type": "object",
             "properties": {
-                "position": {
+                "byColumn": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskRollupColumnResponse"
+                    }
+                },
+                "done": {
                     "type": "integer",
-                    "example": 2
+                    "example": 1
+                },
+                "total": {
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "handler.columnResponse": {
+        "handler.taskTemplateResponse": {
             "type": "object",
             "properties": {
                 "boardId": {
                     "type": "string",
                     "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
                 },
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemResponse"
+                    }
+                },
                 "createdAt": {
                     "type": "string",
                     "example": "2026-03-07T20:56:50.000+03:00"
                 },
-                "description": {
+                "descriptionPattern": {
                     "type": "string",
-                    "example": "My Column Description"
+                    "example": "Summary for the week starting {{date}}"
                 },
                 "id": {
                     "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a4"
                 },
-                "name": {
+                "namePattern": {
                     "type": "string",
-                    "example": "In Progress"
-                },
-                "position": {
-                    "type": "integer",
-                    "example": 1
+                    "example": "Weekly report {{week}}"
                 },
                 "updatedAt": {
                     "type": "string",
@@ -1517,193 +8524,307 @@ const docTemplate = `{
                 }
             }
         },
-        "handler.createBoardBody": {
+        "handler.telegramLinkTokenResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "My Board Description"
-                },
-                "name": {
+                "token": {
                     "type": "string",
-                    "example": "My Board Name"
+                    "example": "018e1000-0000-7000-8000-000000000000"
                 }
             }
         },
-        "handler.createColumnBody": {
+        "handler.throughputResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "boardId": {
                     "type": "string",
-                    "example": "My Column Description"
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a1"
                 },
-                "name": {
+                "from": {
                     "type": "string",
-                    "example": "To Do"
+                    "example": "2026-03-09"
+                },
+                "to": {
+                    "type": "string",
+                    "example": "2026-03-22"
+                },
+                "weeks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.throughputWeekResponse"
+                    }
                 }
             }
         },
-        "handler.createTaskBody": {
+        "handler.throughputWeekResponse": {
             "type": "object",
             "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "Cover the new endpoint with tests"
+                "completed": {
+                    "type": "integer",
+                    "example": 5
                 },
-                "name": {
+                "weekStart": {
                     "type": "string",
-                    "example": "Write tests"
+                    "example": "2026-03-09"
                 }
             }
         },
-        "handler.loginBody": {
+        "handler.updateAutomationBody": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
+                "action": {
+                    "$ref": "#/definitions/handler.automationActionBody"
                 },
-                "password": {
+                "enabled": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "name": {
                     "type": "string",
-                    "example": "secret-password"
+                    "example": "Move approved tasks to Done"
+                },
+                "trigger": {
+                    "$ref": "#/definitions/handler.automationTriggerBody"
                 }
             }
         },
-        "handler.loginResponse": {
+        "handler.updateBoardBody": {
             "type": "object",
             "properties": {
-                "token": {
+                "description": {
                     "type": "string",
-                    "example": "jwt-token"
+                    "example": "My Board Description"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "My Board Name"
                 }
             }
         },
-        "handler.moveColumnBody": {
+        "handler.updateColumnBody": {
             "type": "object",
             "properties": {
-                "targetPosition": {
+                "allowedTransitions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                    ]
+                },
+                "archiveAfterDays": {
                     "type": "integer",
-                    "example": 1
-                }
-            }
-        },
-        "handler.moveTaskBody": {
-            "type": "object",
-            "properties": {
-                "targetColumnId": {
+                    "example": 14
+                },
+                "description": {
                     "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                    "example": "My Column Description"
                 },
-                "targetPosition": {
+                "entryConditions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "checklist_done"
+                    ]
+                },
+                "entryRoles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "owner"
+                    ]
+                },
+                "isDone": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "isStarted": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "name": {
+                    "type": "string",
+                    "example": "In Progress"
+                },
+                "slaHours": {
                     "type": "integer",
-                    "example": 1
+                    "example": 48
+                },
+                "wipLimit": {
+                    "description": "Advisory: tasks are never refused over it. 0 means no limit.",
+                    "type": "integer",
+                    "example": 3
                 }
             }
         },
-        "handler.registerBody": {
+        "handler.updateCustomFieldBody": {
             "type": "object",
             "properties": {
-                "email": {
+                "name": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "Environment"
                 },
-                "password": {
-                    "type": "string",
-                    "example": "secret-password"
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "staging",
+                        "production"
+                    ]
                 }
             }
         },
-        "handler.taskPositionResponse": {
+        "handler.updateLaneBody": {
             "type": "object",
             "properties": {
-                "columnId": {
+                "name": {
                     "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
-                },
-                "position": {
-                    "type": "integer",
-                    "example": 2
+                    "example": "Platform team"
                 }
             }
         },
-        "handler.taskResponse": {
+        "handler.updateRecurrenceBody": {
             "type": "object",
             "properties": {
-                "columnId": {
+                "catchUp": {
                     "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
+                    "enum": [
+                        "skip",
+                        "create_once"
+                    ],
+                    "example": "create_once"
                 },
-                "createdAt": {
+                "columnId": {
                     "type": "string",
-                    "example": "2026-03-07T20:56:50.000+03:00"
+                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a2"
                 },
                 "description": {
                     "type": "string",
-                    "example": "Cover the new endpoint with tests"
-                },
-                "id": {
-                    "type": "string",
-                    "example": "019cc971-e5be-7df9-ae8a-c6e3f29c86a3"
+                    "example": "Both floors"
                 },
                 "name": {
                     "type": "string",
-                    "example": "Write tests"
+                    "example": "Water the plants"
                 },
-                "position": {
-                    "type": "integer",
-                    "example": 1
+                "rule": {
+                    "type": "string",
+                    "example": "FREQ=MONTHLY;BYMONTHDAY=1"
                 },
-                "updatedAt": {
+                "startsAt": {
                     "type": "string",
-                    "example": "2026-03-07T20:56:50.000+03:00"
+                    "example": "2026-03-09T09:00:00Z"
                 }
             }
         },
-        "handler.telegramLinkTokenResponse": {
+        "handler.updateSprintBody": {
             "type": "object",
             "properties": {
-                "token": {
+                "endDate": {
                     "type": "string",
-                    "example": "018e1000-0000-7000-8000-000000000000"
+                    "example": "2026-03-22"
+                },
+                "goal": {
+                    "type": "string",
+                    "example": "Ship the billing page"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Sprint 14"
+                },
+                "startDate": {
+                    "type": "string",
+                    "example": "2026-03-09"
                 }
             }
         },
-        "handler.updateBoardBody": {
+        "handler.updateTaskBody": {
             "type": "object",
             "properties": {
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemBody"
+                    }
+                },
+                "customFields": {
+                    "description": "CustomFields sets the given values keyed by field id; a null value removes the value of the field.",
+                    "type": "object"
+                },
                 "description": {
                     "type": "string",
-                    "example": "My Board Description"
+                    "example": "Cover edge cases"
+                },
+                "estimate": {
+                    "description": "Estimate keeps the current estimate when omitted and clears it when null.",
+                    "type": "number",
+                    "example": 5
                 },
                 "name": {
                     "type": "string",
-                    "example": "My Board Name"
+                    "example": "Rewrite tests"
                 }
             }
         },
-        "handler.updateColumnBody": {
+        "handler.updateTaskTemplateBody": {
             "type": "object",
             "properties": {
-                "description": {
+                "checklist": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.taskChecklistItemBody"
+                    }
+                },
+                "descriptionPattern": {
                     "type": "string",
-                    "example": "My Column Description"
+                    "example": "Summary for the week starting {{date}}"
                 },
-                "name": {
+                "namePattern": {
                     "type": "string",
-                    "example": "In Progress"
+                    "example": "Weekly report {{week}}"
                 }
             }
         },
-        "handler.updateTaskBody": {
+        "handler.userStatsResponse": {
             "type": "object",
             "properties": {
-                "description": {
+                "asOf": {
                     "type": "string",
-                    "example": "Cover edge cases"
+                    "example": "2026-03-07T20:56:50.000+03:00"
                 },
-                "name": {
-                    "type": "string",
-                    "example": "Rewrite tests"
+                "averageOpenTaskAgeSeconds": {
+                    "type": "integer",
+                    "example": 345600
+                },
+                "boards": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "last30Days": {
+                    "$ref": "#/definitions/handler.taskActivityResponse"
+                },
+                "last7Days": {
+                    "$ref": "#/definitions/handler.taskActivityResponse"
+                },
+                "oldestOpenTasks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/handler.openTaskAgeResponse"
+                    }
+                },
+                "openTasks": {
+                    "type": "integer",
+                    "example": 9
+                },
+                "tasks": {
+                    "type": "integer",
+                    "example": 12
                 }
             }
         },
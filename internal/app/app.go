@@ -2,6 +2,7 @@
 package app
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -26,9 +27,50 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+const (
+	recurrenceInterval = 30 * time.Second
+	// recurrenceGrace is how late an occurrence may be processed before it counts as missed.
+	recurrenceGrace = 5 * time.Minute
+	// burndownInterval re-snapshots today's points several times a day, so the day ends with
+	// the latest points and a missed run does not leave a gap in the charts.
+	burndownInterval = time.Hour
+	// slaInterval is how late a column SLA breach may be notified.
+	slaInterval = 5 * time.Minute
+	// automationInterval is how long an event may wait for the automations of its board.
+	automationInterval = 10 * time.Second
+	// archiveInterval is how late a task may be archived after its column's archive delay.
+	archiveInterval = 15 * time.Minute
+	// boardImportInterval is how long an import may wait before it starts.
+	boardImportInterval = 5 * time.Second
+	// boardImportTimeout is how long one import may take to be written. It stays below the time
+	// after which another replica takes a running import over.
+	boardImportTimeout = 5 * time.Minute
+	// idempotencyLockTTL frees the key of a request that never finished. It outlives the request timeout.
+	idempotencyLockTTL = time.Minute
+	// idempotencyPollInterval is how often a retry checks whether the request it waits for is over.
+	idempotencyPollInterval = 50 * time.Millisecond
+	// boardEventsListenInterval renews the connection listening for board events, and is how long
+	// a lost one takes to come back. Streams look for events on their own meanwhile.
+	boardEventsListenInterval = time.Minute
+	// boardEventsKeepAlive is how often an idle event stream is written to and looks for events.
+	boardEventsKeepAlive = 15 * time.Second
+)
+
 type App struct {
 	Router      http.Handler
 	AdminRouter http.Handler
+	Jobs        []BackgroundJob
+	// OnShutdown ends the responses of Router that would otherwise last as long as their clients.
+	OnShutdown func()
+}
+
+// BackgroundJob is run every Interval until the application shuts down. Every run gets a
+// deadline of Timeout, or of one Interval if Timeout is zero.
+type BackgroundJob struct {
+	Name     string
+	Interval time.Duration
+	Timeout  time.Duration
+	Run      func(ctx context.Context) error
 }
 
 func New(
@@ -41,9 +83,25 @@ func New(
 ) *App {
 	userRepo := repository.NewPGUser(pgPool)
 	telegramTokenRepo := repository.NewRedisTelegramToken(redisClient, telegramCfg.LinkTokenTTL)
+	idempotencyRepo := repository.NewRedisIdempotency(redisClient, cfg.IdempotencyKeyTTL, idempotencyLockTTL)
 	boardsRepo := repository.NewPGBoard(pgPool)
 	columnsRepo := repository.NewPGColumn(pgPool)
+	lanesRepo := repository.NewPGLane(pgPool)
 	tasksRepo := repository.NewPGTask(pgPool)
+	boardTemplatesRepo := repository.NewPGBoardTemplate(pgPool)
+	taskTemplatesRepo := repository.NewPGTaskTemplate(pgPool)
+	recurrencesRepo := repository.NewPGRecurrence(pgPool)
+	taskLinksRepo := repository.NewPGTaskLink(pgPool)
+	sprintsRepo := repository.NewPGSprint(pgPool)
+	burndownRepo := repository.NewPGBurndown(pgPool)
+	taskTransitionsRepo := repository.NewPGTaskTransition(pgPool)
+	statsRepo := repository.NewPGStats(pgPool)
+	customFieldsRepo := repository.NewPGCustomField(pgPool)
+	automationsRepo := repository.NewPGAutomation(pgPool)
+	boardImportsRepo := repository.NewPGBoardImport(pgPool)
+	boardEventsRepo := repository.NewPGBoardEvent(pgPool)
+	syncRepo := repository.NewPGSync(pgPool)
+	exportRepo := repository.NewPGExport(pgPool)
 
 	authService := service.NewAuth(userRepo, service.JWTOptions{
 		JWTSecret:     cfg.JWTSecret,
@@ -59,46 +117,150 @@ func New(
 		}
 		return tok
 	})
-	boardsService := service.NewBoard(boardsRepo, columnsRepo, tasksRepo)
+	boardsService := service.NewBoard(boardsRepo, columnsRepo, lanesRepo, tasksRepo, boardTemplatesRepo)
+	boardTemplatesService := service.NewBoardTemplate(boardTemplatesRepo, boardsRepo)
 	columnsService := service.NewColumn(columnsRepo, boardsRepo)
-	tasksService := service.NewTask(tasksRepo, boardsRepo, columnsRepo)
+	lanesService := service.NewLane(lanesRepo, boardsRepo)
+	tasksService := service.NewTask(tasksRepo, boardsRepo, columnsRepo, lanesRepo, taskTemplatesRepo, taskLinksRepo, customFieldsRepo, cfg.EnforceTaskBlockers)
+	taskTemplatesService := service.NewTaskTemplate(taskTemplatesRepo, boardsRepo)
+	recurrencesService := service.NewRecurrence(recurrencesRepo, boardsRepo, columnsRepo, recurrenceGrace)
+	taskLinksService := service.NewTaskLink(taskLinksRepo, boardsRepo, columnsRepo, tasksRepo)
+	sprintsService := service.NewSprint(sprintsRepo, boardsRepo)
+	analyticsService := service.NewAnalytics(burndownRepo, taskTransitionsRepo, boardsRepo, columnsRepo, sprintsRepo)
+	statsService := service.NewStats(statsRepo, boardsRepo)
+	customFieldsService := service.NewCustomField(customFieldsRepo, boardsRepo)
+	slaService := service.NewSLA(taskTransitionsRepo, telegramClient)
+	archiveService := service.NewArchive(tasksRepo)
+	exportService := service.NewExport(exportRepo)
+	boardImportService := service.NewBoardImport(boardImportsRepo)
+	idempotencyService := service.NewIdempotency(idempotencyRepo, idempotencyPollInterval)
+	boardEventsService := service.NewBoardEvents(boardEventsRepo, boardsRepo)
+	syncService := service.NewSync(syncRepo, columnsRepo, tasksRepo, boardsService, columnsService, tasksService)
+	automationsService := service.NewAutomation(automationsRepo, boardsRepo, columnsRepo, tasksRepo, customFieldsRepo, taskLinksRepo, userRepo, telegramClient, cfg.EnforceTaskBlockers)
 
 	errorResponder := httpschema.MustNewErrorResponder(logger, service.TimeNowRFC3339Millis)
 	authHandler := handler.NewAuth(logger, authService, errorResponder)
 	healthHandler := handler.NewHealth(logger)
 	boardsHandler := handler.NewBoards(logger, boardsService, errorResponder)
+	boardTemplatesHandler := handler.NewBoardTemplates(logger, boardTemplatesService, errorResponder)
 	columnsHandler := handler.NewColumns(logger, columnsService, errorResponder)
+	lanesHandler := handler.NewLanes(logger, lanesService, errorResponder)
 	tasksHandler := handler.NewTasks(logger, tasksService, errorResponder)
+	taskTemplatesHandler := handler.NewTaskTemplates(logger, taskTemplatesService, errorResponder)
+	recurrencesHandler := handler.NewRecurrences(logger, recurrencesService, errorResponder)
+	taskLinksHandler := handler.NewTaskLinks(logger, taskLinksService, errorResponder)
+	sprintsHandler := handler.NewSprints(logger, sprintsService, errorResponder)
+	analyticsHandler := handler.NewAnalytics(logger, analyticsService, errorResponder)
+	statsHandler := handler.NewStats(logger, statsService, errorResponder)
+	customFieldsHandler := handler.NewCustomFields(logger, customFieldsService, errorResponder)
+	automationsHandler := handler.NewAutomations(logger, automationsService, errorResponder)
+	exportsHandler := handler.NewExports(logger, exportService, errorResponder)
+	boardImportsHandler := handler.NewBoardImports(logger, boardImportService, errorResponder)
+	boardEventsHandler := handler.NewBoardEvents(logger, boardEventsService, errorResponder, boardEventsKeepAlive)
+	syncHandler := handler.NewSync(logger, syncService, errorResponder)
 	userHandler := handler.NewUser(logger, userService, errorResponder)
 	telegramHandler := handler.NewTelegram(logger, userService, telegramClient)
 
 	metricsMiddleware := middleware.NewMetrics(reg)
 	corsMiddleware := middleware.NewCORS(logger, cfg.AllowedOrigins)
 	authMiddleware := middleware.NewAuth(logger, authService, errorResponder)
+	idempotencyMiddleware := middleware.NewIdempotency(logger, idempotencyService, errorResponder)
 	reqIDMiddleware := middleware.MustNewRequestID(logger, func() string {
 		return fmt.Sprintf("req-%s", uuid.Must(uuid.NewV7()))
 	})
 	timeoutMiddleware := middleware.NewTimeout(30 * time.Second)
 
 	handlers := &handler.Handlers{
-		Auth:     authHandler,
-		Health:   healthHandler,
-		Boards:   boardsHandler,
-		Columns:  columnsHandler,
-		Tasks:    tasksHandler,
-		User:     userHandler,
-		Telegram: telegramHandler,
+		Auth:           authHandler,
+		Health:         healthHandler,
+		Boards:         boardsHandler,
+		Columns:        columnsHandler,
+		Lanes:          lanesHandler,
+		Tasks:          tasksHandler,
+		User:           userHandler,
+		Telegram:       telegramHandler,
+		BoardTemplates: boardTemplatesHandler,
+		TaskTemplates:  taskTemplatesHandler,
+		Recurrences:    recurrencesHandler,
+		TaskLinks:      taskLinksHandler,
+		Sprints:        sprintsHandler,
+		Analytics:      analyticsHandler,
+		Stats:          statsHandler,
+		CustomFields:   customFieldsHandler,
+		Automations:    automationsHandler,
+		Exports:        exportsHandler,
+		BoardImports:   boardImportsHandler,
+		BoardEvents:    boardEventsHandler,
+		Sync:           syncHandler,
 	}
 	middlewares := &middleware.Middlewares{
-		Metrics:   metricsMiddleware,
-		CORS:      corsMiddleware,
-		Auth:      authMiddleware,
-		RequestID: reqIDMiddleware,
-		Timeout:   timeoutMiddleware,
+		Metrics:     metricsMiddleware,
+		CORS:        corsMiddleware,
+		Auth:        authMiddleware,
+		Idempotency: idempotencyMiddleware,
+		RequestID:   reqIDMiddleware,
+		Timeout:     timeoutMiddleware,
 	}
 
 	return &App{
 		Router:      httpapp.NewRouter(handlers, middlewares),
 		AdminRouter: httpapp.NewAdminRouter(),
+		Jobs: []BackgroundJob{
+			{
+				Name:     "recurrences",
+				Interval: recurrenceInterval,
+				Run: func(ctx context.Context) error {
+					_, err := recurrencesService.RunDue(ctx)
+					return err
+				},
+			},
+			{
+				Name:     "burndown snapshots",
+				Interval: burndownInterval,
+				Run: func(ctx context.Context) error {
+					_, err := analyticsService.SnapshotBurndown(ctx)
+					return err
+				},
+			},
+			{
+				Name:     "column SLA alerts",
+				Interval: slaInterval,
+				Run: func(ctx context.Context) error {
+					_, err := slaService.NotifyBreaches(ctx)
+					return err
+				},
+			},
+			{
+				Name:     "automations",
+				Interval: automationInterval,
+				Run: func(ctx context.Context) error {
+					_, err := automationsService.RunPending(ctx)
+					return err
+				},
+			},
+			{
+				Name:     "task archiving",
+				Interval: archiveInterval,
+				Run: func(ctx context.Context) error {
+					_, err := archiveService.ArchiveDue(ctx)
+					return err
+				},
+			},
+			{
+				Name:     "board imports",
+				Interval: boardImportInterval,
+				Timeout:  boardImportTimeout,
+				Run: func(ctx context.Context) error {
+					_, err := boardImportService.RunPending(ctx)
+					return err
+				},
+			},
+			{
+				Name:     "board events",
+				Interval: boardEventsListenInterval,
+				Run:      boardEventsService.Listen,
+			},
+		},
+		OnShutdown: boardEventsService.Shutdown,
 	}
 }
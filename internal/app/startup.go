@@ -117,3 +117,43 @@ func RunBackgroundServer(logger *slog.Logger, name, addr string, handler http.Ha
 
 	return srv
 }
+
+// RunBackgroundJob runs job right away and then every job.Interval until ctx is done.
+// Every run gets a deadline of job.Timeout, or of one interval if it is zero. A run that
+// outlasts the interval delays the next one. The returned channel is closed once the job
+// has stopped.
+func RunBackgroundJob(ctx context.Context, logger *slog.Logger, job BackgroundJob) <-chan struct{} {
+	logger = logging.WithModule(logger, "app.jobs").With(slog.String("job", job.Name))
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		timeout := job.Timeout
+		if timeout == 0 {
+			timeout = job.Interval
+		}
+
+		logger.Info("Starting background job", slog.Duration("interval", job.Interval), slog.Duration("timeout", timeout))
+		ticker := time.NewTicker(job.Interval)
+		defer ticker.Stop()
+
+		for {
+			runCtx, cancel := context.WithTimeout(ctx, timeout)
+			err := job.Run(runCtx)
+			cancel()
+			if err != nil && ctx.Err() == nil {
+				logger.Error("Background job failed", slog.String("err", err.Error()))
+			}
+
+			select {
+			case <-ctx.Done():
+				logger.Info("Background job stopped")
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return done
+}
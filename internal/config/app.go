@@ -4,6 +4,7 @@ package config
 import (
 	"log/slog"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -21,6 +22,10 @@ type App struct {
 	JWTSecret      secrecy.SecretString
 	JWTExp         time.Duration
 	AllowedOrigins map[string]struct{}
+	// EnforceTaskBlockers forbids moving a task into a done column while its blockers are unfinished.
+	EnforceTaskBlockers bool
+	// IdempotencyKeyTTL is how long the response to a request with an Idempotency-Key is replayed.
+	IdempotencyKeyTTL time.Duration
 }
 
 func NewAppFromEnv(logger *slog.Logger) App {
@@ -32,6 +37,17 @@ func NewAppFromEnv(logger *slog.Logger) App {
 		jwtExp = 24 * time.Hour
 	}
 
+	idempotencyKeyTTLStr := getEnvStringOrDefault("IDEMPOTENCY_KEY_TTL", "24h", logger)
+	idempotencyKeyTTL, err := time.ParseDuration(idempotencyKeyTTLStr)
+	if err != nil || idempotencyKeyTTL <= 0 {
+		idempotencyKeyTTL = 24 * time.Hour
+	}
+
+	enforceTaskBlockers, err := strconv.ParseBool(getEnvStringOrDefault("ENFORCE_TASK_BLOCKERS", "false", logger))
+	if err != nil {
+		enforceTaskBlockers = false
+	}
+
 	allowedOrigins := getEnvStringOrDefault("ALLOWED_ORIGINS", "http://localhost:8080,http://127.0.0.1:8080", logger)
 	return App{
 		Port:           getEnvStringOrDefault("PORT", "8080", logger),
@@ -43,6 +59,9 @@ func NewAppFromEnv(logger *slog.Logger) App {
 		JWTSecret:      secrecy.SecretString(getEnvStringOrDefault("JWT_SECRET", "very_secret", logger)),
 		JWTExp:         jwtExp,
 		AllowedOrigins: ParseAllowedOrigins(allowedOrigins),
+
+		EnforceTaskBlockers: enforceTaskBlockers,
+		IdempotencyKeyTTL:   idempotencyKeyTTL,
 	}
 }
 
@@ -82,5 +101,7 @@ func (c App) LogValue() slog.Value {
 		slog.Any("jwt_secret", c.JWTSecret),
 		slog.Duration("jwt_exp", c.JWTExp),
 		slog.Any("allowed_origins", allowedOrigins),
+		slog.Bool("enforce_task_blockers", c.EnforceTaskBlockers),
+		slog.Duration("idempotency_key_ttl", c.IdempotencyKeyTTL),
 	)
 }
@@ -24,9 +24,14 @@ var defaultAppConfig = config.App{
 	JWTSecret:      secrecy.SecretString("very_secret"),
 	JWTExp:         24 * time.Hour,
 	AllowedOrigins: config.ParseAllowedOrigins("http://localhost:8080,http://127.0.0.1:8080"),
+
+	IdempotencyKeyTTL: 24 * time.Hour,
 }
 
-var appEnvVars = []string{"PORT", "ADMIN_PORT", "HOST", "SWAGGER_HOST", "LOG_LEVEL", "ENV", "JWT_SECRET", "JWT_EXP", "ALLOWED_ORIGINS"}
+var appEnvVars = []string{
+	"PORT", "ADMIN_PORT", "HOST", "SWAGGER_HOST", "LOG_LEVEL", "ENV", "JWT_SECRET", "JWT_EXP", "ALLOWED_ORIGINS", "ENFORCE_TASK_BLOCKERS",
+	"IDEMPOTENCY_KEY_TTL",
+}
 
 func setCustomAppEnvVars(t *testing.T) {
 	t.Setenv("PORT", "3000")
@@ -38,6 +43,8 @@ func setCustomAppEnvVars(t *testing.T) {
 	t.Setenv("JWT_SECRET", "more_secret")
 	t.Setenv("JWT_EXP", "1h")
 	t.Setenv("ALLOWED_ORIGINS", "http://example.com,http://test.com")
+	t.Setenv("ENFORCE_TASK_BLOCKERS", "true")
+	t.Setenv("IDEMPOTENCY_KEY_TTL", "2h")
 }
 
 func TestNewAppFromEnv(t *testing.T) {
@@ -66,6 +73,9 @@ func TestNewAppFromEnv(t *testing.T) {
 			JWTSecret:      secrecy.SecretString("more_secret"),
 			JWTExp:         time.Hour,
 			AllowedOrigins: config.ParseAllowedOrigins("http://example.com,http://test.com"),
+
+			EnforceTaskBlockers: true,
+			IdempotencyKeyTTL:   2 * time.Hour,
 		}
 		diff := cmp.Diff(wantCfg, cfg)
 		if diff != "" {
@@ -105,15 +115,17 @@ func TestApp_LogValue(t *testing.T) {
 
 	attrs := v.Group()
 	wantAttrs := map[string]string{
-		"port":            "8080",
-		"admin_port":      "9091",
-		"host":            "0.0.0.0",
-		"log_level":       "info",
-		"env":             "dev",
-		"swagger_host":    "localhost:8080",
-		"jwt_secret":      "(11 chars)",
-		"jwt_exp":         "24h0m0s",
-		"allowed_origins": "[http://127.0.0.1:8080 http://localhost:8080]",
+		"port":                  "8080",
+		"admin_port":            "9091",
+		"host":                  "0.0.0.0",
+		"log_level":             "info",
+		"env":                   "dev",
+		"swagger_host":          "localhost:8080",
+		"jwt_secret":            "(11 chars)",
+		"jwt_exp":               "24h0m0s",
+		"allowed_origins":       "[http://127.0.0.1:8080 http://localhost:8080]",
+		"enforce_task_blockers": "false",
+		"idempotency_key_ttl":   "24h0m0s",
 	}
 
 	testutil.FailOnInvalidLogValue(t, attrs, wantAttrs)
@@ -0,0 +1,325 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrAutomationNameTooShort     = "Name is too short"
+	ErrAutomationNameTooLong      = "Name is too long"
+	ErrAutomationTriggerValue     = "Trigger must be task_created, task_moved, due_date_passed or label_added"
+	ErrAutomationActionValue      = "Action must be move_to_column, set_custom_field, add_label, notify_telegram or create_task"
+	ErrAutomationValueRequired    = "Value is required"
+	ErrAutomationLabelRequired    = "Label is required"
+	ErrAutomationTriggerFieldType = "Custom field must be a date field for due_date_passed and a single or multi select field for label_added"
+	ErrAutomationActionFieldType  = "Custom field must be a single or multi select field"
+	ErrAutomationLabelOption      = "Label must be an option of the custom field"
+	ErrAutomationMessageValue     = "Message must be 1-1024 characters"
+	ErrAutomationDryRunEventCount = "Events must be between 1 and 500"
+)
+
+const (
+	maxAutomationMessage          = 1024
+	MaxAutomationDryRunEvents     = 500
+	DefaultAutomationDryRunEvents = 50
+)
+
+// Automation runs Action on the task of every event that matches Trigger. Only events that
+// happen while the automation is enabled and after it was created are considered.
+type Automation struct {
+	ID        AutomationID
+	BoardID   BoardID
+	Name      AutomationName
+	Trigger   AutomationTrigger
+	Action    AutomationAction
+	Enabled   bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type (
+	automationTag struct{}
+	AutomationID  = UUID[automationTag]
+)
+
+func NewAutomationID() AutomationID {
+	return newID[automationTag]()
+}
+
+func ParseAutomationID(s string) (AutomationID, error) {
+	return parseID[automationTag](s)
+}
+
+func NewAutomationIDFromUUID(u uuid.UUID) (AutomationID, error) {
+	return newIDFromUUID[automationTag](u)
+}
+
+type AutomationName struct {
+	value string
+}
+
+func NewAutomationName(name string) (AutomationName, error) {
+	trimmedName := strings.TrimSpace(name)
+	var issues []string
+	if trimmedName == "" {
+		issues = append(issues, ErrAutomationNameTooShort)
+	}
+	if len(trimmedName) > 64 {
+		issues = append(issues, ErrAutomationNameTooLong)
+	}
+	if len(issues) > 0 {
+		return AutomationName{}, &errValidation{Issues: issues}
+	}
+
+	return AutomationName{value: trimmedName}, nil
+}
+
+func (n AutomationName) String() string {
+	return n.value
+}
+
+type AutomationTriggerType string
+
+const (
+	AutomationTaskCreated   AutomationTriggerType = "task_created"
+	AutomationTaskMoved     AutomationTriggerType = "task_moved"
+	AutomationDueDatePassed AutomationTriggerType = "due_date_passed"
+	AutomationLabelAdded    AutomationTriggerType = "label_added"
+)
+
+func NewAutomationTriggerType(triggerType string) (AutomationTriggerType, error) {
+	switch t := AutomationTriggerType(strings.TrimSpace(triggerType)); t {
+	case AutomationTaskCreated, AutomationTaskMoved, AutomationDueDatePassed, AutomationLabelAdded:
+		return t, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrAutomationTriggerValue}}
+	}
+}
+
+func (t AutomationTriggerType) String() string {
+	return string(t)
+}
+
+// IsFieldTrigger tells whether the trigger watches a custom field of tasks rather than their column.
+func (t AutomationTriggerType) IsFieldTrigger() bool {
+	return t == AutomationDueDatePassed || t == AutomationLabelAdded
+}
+
+// AutomationTrigger matches the creation of a task, its move into another column, the date of a
+// date field of the task passing or a label added to a select field of the task. A nil ColumnID
+// matches any column, otherwise only the column the task was created in, moved into or is in.
+// FieldID is the field of due_date_passed and label_added, and an empty Label matches any label.
+type AutomationTrigger struct {
+	Type     AutomationTriggerType
+	ColumnID ColumnID
+	FieldID  CustomFieldID
+	Label    string
+}
+
+func (t AutomationTrigger) Matches(event *AutomationEvent) bool {
+	if t.Type != event.Type {
+		return false
+	}
+	if !t.ColumnID.IsNil() && t.ColumnID != event.ToColumnID {
+		return false
+	}
+	if t.Type.IsFieldTrigger() && t.FieldID != event.FieldID {
+		return false
+	}
+
+	return t.Type != AutomationLabelAdded || t.Label == "" || t.Label == event.Value
+}
+
+type AutomationActionType string
+
+const (
+	AutomationMoveToColumn   AutomationActionType = "move_to_column"
+	AutomationSetCustomField AutomationActionType = "set_custom_field"
+	AutomationAddLabel       AutomationActionType = "add_label"
+	AutomationNotifyTelegram AutomationActionType = "notify_telegram"
+	AutomationCreateTask     AutomationActionType = "create_task"
+)
+
+func NewAutomationActionType(actionType string) (AutomationActionType, error) {
+	switch t := AutomationActionType(strings.TrimSpace(actionType)); t {
+	case AutomationMoveToColumn, AutomationSetCustomField, AutomationAddLabel, AutomationNotifyTelegram, AutomationCreateTask:
+		return t, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrAutomationActionValue}}
+	}
+}
+
+func (t AutomationActionType) String() string {
+	return string(t)
+}
+
+// AutomationAction is what an automation does with the task of a matching event. Only the
+// fields of its Type are set; build it with the constructor of the action.
+type AutomationAction struct {
+	Type     AutomationActionType
+	ColumnID ColumnID          // Target of move_to_column and create_task.
+	FieldID  CustomFieldID     // Field of set_custom_field and add_label.
+	Value    json.RawMessage   // Value of set_custom_field, null clears the field.
+	Label    string            // Option added by add_label.
+	Message  AutomationMessage // Text of notify_telegram.
+	TaskName TaskName          // Name of the task made by create_task.
+}
+
+// NewMoveToColumnAction moves the task to the top of the column.
+func NewMoveToColumnAction(columnID ColumnID) AutomationAction {
+	return AutomationAction{Type: AutomationMoveToColumn, ColumnID: columnID}
+}
+
+// NewSetCustomFieldAction sets the field of the task to value. The value is checked against
+// the field whenever the action runs, since the field may change after the action is saved.
+func NewSetCustomFieldAction(fieldID CustomFieldID, value json.RawMessage) (AutomationAction, error) {
+	if len(value) == 0 || !json.Valid(value) {
+		return AutomationAction{}, &errValidation{Issues: []string{ErrAutomationValueRequired}}
+	}
+
+	return AutomationAction{Type: AutomationSetCustomField, FieldID: fieldID, Value: value}, nil
+}
+
+// NewAddLabelAction adds the label to the select field of the task. Like a set_custom_field
+// value, the label is checked against the field whenever the action runs.
+func NewAddLabelAction(fieldID CustomFieldID, label string) (AutomationAction, error) {
+	trimmed := strings.TrimSpace(label)
+	if trimmed == "" {
+		return AutomationAction{}, &errValidation{Issues: []string{ErrAutomationLabelRequired}}
+	}
+
+	return AutomationAction{Type: AutomationAddLabel, FieldID: fieldID, Label: trimmed}, nil
+}
+
+// NewNotifyTelegramAction sends the message about the task to the Telegram chat of the board owner.
+func NewNotifyTelegramAction(message AutomationMessage) AutomationAction {
+	return AutomationAction{Type: AutomationNotifyTelegram, Message: message}
+}
+
+// NewCreateTaskAction creates a follow-up task with the name at the end of the column.
+func NewCreateTaskAction(columnID ColumnID, name TaskName) AutomationAction {
+	return AutomationAction{Type: AutomationCreateTask, ColumnID: columnID, TaskName: name}
+}
+
+type AutomationMessage struct {
+	value string
+}
+
+func NewAutomationMessage(message string) (AutomationMessage, error) {
+	trimmed := strings.TrimSpace(message)
+	if trimmed == "" || len(trimmed) > maxAutomationMessage {
+		return AutomationMessage{}, &errValidation{Issues: []string{ErrAutomationMessageValue}}
+	}
+
+	return AutomationMessage{value: trimmed}, nil
+}
+
+func (m AutomationMessage) String() string {
+	return m.value
+}
+
+// AutomationNotification is the text sent to the board owner by a notify_telegram action.
+func AutomationNotification(boardName BoardName, taskName TaskName, message AutomationMessage) string {
+	return fmt.Sprintf("Board %q, task %q: %s", boardName.String(), taskName.String(), message.String())
+}
+
+type (
+	automationEventTag struct{}
+	AutomationEventID  = UUID[automationEventTag]
+)
+
+func NewAutomationEventID() AutomationEventID {
+	return newID[automationEventTag]()
+}
+
+func NewAutomationEventIDFromUUID(u uuid.UUID) (AutomationEventID, error) {
+	return newIDFromUUID[automationEventTag](u)
+}
+
+// AutomationEvent is what automations react to. task_created and task_moved events are task
+// transitions into ToColumnID, and FromColumnID is nil for a creation. due_date_passed and
+// label_added events are about the FieldID field of the task while it is in ToColumnID: Value
+// is the date that passed or the label that was added.
+type AutomationEvent struct {
+	ID           AutomationEventID
+	Type         AutomationTriggerType
+	BoardID      BoardID
+	TaskID       TaskID
+	FromColumnID ColumnID
+	ToColumnID   ColumnID
+	FieldID      CustomFieldID
+	Value        string
+	OccurredAt   time.Time
+}
+
+type AutomationRunStatus string
+
+const (
+	AutomationRunSucceeded AutomationRunStatus = "succeeded"
+	AutomationRunFailed    AutomationRunStatus = "failed"
+	// AutomationRunSkipped is an action that had nothing to do or was stopped by the loop protection.
+	AutomationRunSkipped AutomationRunStatus = "skipped"
+)
+
+func NewAutomationRunStatus(status string) (AutomationRunStatus, error) {
+	switch s := AutomationRunStatus(status); s {
+	case AutomationRunSucceeded, AutomationRunFailed, AutomationRunSkipped:
+		return s, nil
+	default:
+		return "", fmt.Errorf("unknown automation run status %q", status)
+	}
+}
+
+func (s AutomationRunStatus) String() string {
+	return string(s)
+}
+
+type (
+	automationRunTag struct{}
+	AutomationRunID  = UUID[automationRunTag]
+)
+
+func NewAutomationRunIDFromUUID(u uuid.UUID) (AutomationRunID, error) {
+	return newIDFromUUID[automationRunTag](u)
+}
+
+// AutomationRun is an entry of the execution log of a board: the outcome of an automation on
+// the task of an event. Message explains failed and skipped runs.
+type AutomationRun struct {
+	ID           AutomationRunID
+	AutomationID AutomationID
+	BoardID      BoardID
+	EventID      AutomationEventID
+	TaskID       TaskID
+	Status       AutomationRunStatus
+	Message      string
+	RanAt        time.Time
+}
+
+// AutomationDryRun is what an automation would have done over the latest events of a board.
+type AutomationDryRun struct {
+	Events  int               // Number of replayed events.
+	Matches []AutomationEvent // Events whose task the action would have run on, oldest first.
+}
+
+// AutomationDryRunEvents is how many of the latest board events a dry run replays.
+type AutomationDryRunEvents struct {
+	value int64
+}
+
+func NewAutomationDryRunEvents(events int64) (AutomationDryRunEvents, error) {
+	if events < 1 || events > MaxAutomationDryRunEvents {
+		return AutomationDryRunEvents{}, &errValidation{Issues: []string{ErrAutomationDryRunEventCount}}
+	}
+
+	return AutomationDryRunEvents{value: events}, nil
+}
+
+func (e AutomationDryRunEvents) Int64() int64 {
+	return e.value
+}
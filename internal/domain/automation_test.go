@@ -0,0 +1,244 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestAutomationName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       string
+	}{
+		{name: "Valid", input: "Close done tasks", want: "Close done tasks"},
+		{name: "Trimmed", input: "  Close done tasks ", want: "Close done tasks"},
+		{name: "Max length", input: strings.Repeat("a", 64), want: strings.Repeat("a", 64)},
+		{name: "Empty", input: "   ", wantIssues: []string{domain.ErrAutomationNameTooShort}},
+		{name: "Too long", input: strings.Repeat("a", 65), wantIssues: []string{domain.ErrAutomationNameTooLong}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			name, err := domain.NewAutomationName(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if name.String() != tt.want {
+				t.Errorf("got name %q, want %q", name.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestAutomationTrigger_Matches(t *testing.T) {
+	t.Parallel()
+
+	todo := domain.NewColumnID()
+	done := domain.NewColumnID()
+	due := domain.NewCustomFieldID()
+	priority := domain.NewCustomFieldID()
+	created := domain.AutomationEvent{Type: domain.AutomationTaskCreated, ToColumnID: todo}
+	moved := domain.AutomationEvent{Type: domain.AutomationTaskMoved, FromColumnID: todo, ToColumnID: done}
+	duePassed := domain.AutomationEvent{Type: domain.AutomationDueDatePassed, ToColumnID: todo, FieldID: due, Value: "2026-03-07"}
+	labelAdded := domain.AutomationEvent{Type: domain.AutomationLabelAdded, ToColumnID: todo, FieldID: priority, Value: "Urgent"}
+
+	tests := []struct {
+		name    string
+		trigger domain.AutomationTrigger
+		event   domain.AutomationEvent
+		want    bool
+	}{
+		{name: "Created in any column", trigger: domain.AutomationTrigger{Type: domain.AutomationTaskCreated}, event: created, want: true},
+		{name: "Created in the column", trigger: domain.AutomationTrigger{Type: domain.AutomationTaskCreated, ColumnID: todo}, event: created, want: true},
+		{name: "Created in another column", trigger: domain.AutomationTrigger{Type: domain.AutomationTaskCreated, ColumnID: done}, event: created},
+		{name: "Created does not match a move", trigger: domain.AutomationTrigger{Type: domain.AutomationTaskCreated}, event: moved},
+		{name: "Moved into the column", trigger: domain.AutomationTrigger{Type: domain.AutomationTaskMoved, ColumnID: done}, event: moved, want: true},
+		{name: "Moved out of the column", trigger: domain.AutomationTrigger{Type: domain.AutomationTaskMoved, ColumnID: todo}, event: moved},
+		{name: "Moved does not match a creation", trigger: domain.AutomationTrigger{Type: domain.AutomationTaskMoved}, event: created},
+		{name: "Due date of the field passed", trigger: domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: due}, event: duePassed, want: true},
+		{name: "Due date passed in the column", trigger: domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, ColumnID: todo, FieldID: due}, event: duePassed, want: true},
+		{name: "Due date passed in another column", trigger: domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, ColumnID: done, FieldID: due}, event: duePassed},
+		{name: "Due date of another field passed", trigger: domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: priority}, event: duePassed},
+		{name: "Any label added", trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: priority}, event: labelAdded, want: true},
+		{name: "The label added", trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: priority, Label: "Urgent"}, event: labelAdded, want: true},
+		{name: "Another label added", trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: priority, Label: "Low"}, event: labelAdded},
+		{name: "Label added to another field", trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: due}, event: labelAdded},
+		{name: "Label added does not match a creation", trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: priority}, event: created},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tt.trigger.Matches(&tt.event); got != tt.want {
+				t.Errorf("got match %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutomationTypes(t *testing.T) {
+	t.Parallel()
+
+	triggerType, err := domain.NewAutomationTriggerType(" task_moved ")
+	if err != nil || triggerType != domain.AutomationTaskMoved {
+		t.Errorf("got trigger %q, %v, want %q", triggerType, err, domain.AutomationTaskMoved)
+	}
+	triggerType, err = domain.NewAutomationTriggerType("label_added")
+	if err != nil || !triggerType.IsFieldTrigger() {
+		t.Errorf("got trigger %q, %v, want a field trigger", triggerType, err)
+	}
+	_, err = domain.NewAutomationTriggerType("task_deleted")
+	if diff := cmp.Diff([]string{domain.ErrAutomationTriggerValue}, domain.ExtractValidationIssues(err)); diff != "" {
+		t.Errorf("got trigger issues mismatch (-want +got):\n%s", diff)
+	}
+
+	actionType, err := domain.NewAutomationActionType("create_task")
+	if err != nil || actionType != domain.AutomationCreateTask {
+		t.Errorf("got action %q, %v, want %q", actionType, err, domain.AutomationCreateTask)
+	}
+	actionType, err = domain.NewAutomationActionType("add_label")
+	if err != nil || actionType != domain.AutomationAddLabel {
+		t.Errorf("got action %q, %v, want %q", actionType, err, domain.AutomationAddLabel)
+	}
+	_, err = domain.NewAutomationActionType("remove_label")
+	if diff := cmp.Diff([]string{domain.ErrAutomationActionValue}, domain.ExtractValidationIssues(err)); diff != "" {
+		t.Errorf("got action issues mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestNewSetCustomFieldAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		value      string
+		wantIssues []string
+	}{
+		{name: "String", value: `"production"`},
+		{name: "Null clears the field", value: `null`},
+		{name: "Empty", value: ``, wantIssues: []string{domain.ErrAutomationValueRequired}},
+		{name: "Invalid JSON", value: `{`, wantIssues: []string{domain.ErrAutomationValueRequired}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			action, err := domain.NewSetCustomFieldAction(domain.NewCustomFieldID(), json.RawMessage(tt.value))
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues == nil && action.Type != domain.AutomationSetCustomField {
+				t.Errorf("got action type %q, want %q", action.Type, domain.AutomationSetCustomField)
+			}
+		})
+	}
+}
+
+func TestNewAddLabelAction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		label      string
+		wantIssues []string
+		want       string
+	}{
+		{name: "Valid", label: "Urgent", want: "Urgent"},
+		{name: "Trimmed", label: " Urgent ", want: "Urgent"},
+		{name: "Empty", label: "  ", wantIssues: []string{domain.ErrAutomationLabelRequired}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			action, err := domain.NewAddLabelAction(domain.NewCustomFieldID(), tt.label)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if action.Label != tt.want {
+				t.Errorf("got label %q, want %q", action.Label, tt.want)
+			}
+		})
+	}
+}
+
+func TestAutomationMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       string
+	}{
+		{name: "Trimmed", input: " Review it ", want: "Review it"},
+		{name: "Max length", input: strings.Repeat("a", 1024), want: strings.Repeat("a", 1024)},
+		{name: "Empty", input: " ", wantIssues: []string{domain.ErrAutomationMessageValue}},
+		{name: "Too long", input: strings.Repeat("a", 1025), wantIssues: []string{domain.ErrAutomationMessageValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			message, err := domain.NewAutomationMessage(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if message.String() != tt.want {
+				t.Errorf("got message %q, want %q", message.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestAutomationDryRunEvents(t *testing.T) {
+	t.Parallel()
+
+	for _, events := range []int64{1, domain.DefaultAutomationDryRunEvents, domain.MaxAutomationDryRunEvents} {
+		got, err := domain.NewAutomationDryRunEvents(events)
+		if err != nil || got.Int64() != events {
+			t.Errorf("got %d, %v, want %d", got.Int64(), err, events)
+		}
+	}
+	for _, events := range []int64{0, domain.MaxAutomationDryRunEvents + 1} {
+		_, err := domain.NewAutomationDryRunEvents(events)
+		if diff := cmp.Diff([]string{domain.ErrAutomationDryRunEventCount}, domain.ExtractValidationIssues(err)); diff != "" {
+			t.Errorf("events %d: got issues mismatch (-want +got):\n%s", events, diff)
+		}
+	}
+}
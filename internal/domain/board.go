@@ -19,10 +19,21 @@ type Board struct {
 	OwnerID     UserID
 	Name        BoardName
 	Description BoardDescription
+	Version     Version
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 }
 
+// BoardSnapshot is a board with its columns, lanes, custom fields and sprints as they were at
+// one moment.
+type BoardSnapshot struct {
+	Board        Board
+	Columns      []Column // Ordered by position.
+	Lanes        []Lane   // Ordered by position.
+	CustomFields []CustomField
+	Sprints      []Sprint
+}
+
 type (
 	boardTag struct{}
 	BoardID  = UUID[boardTag]
@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const ErrBoardEventCursorValue = "Event id is not one sent by this stream"
+
+// BoardEvent tells that a board, column or task changed. It names what changed rather than
+// carrying it, so a client reads the current state of the entity when it cares.
+// The ids up to the entity's are set: a column event has a ColumnID, and a task event a TaskID
+// and the ColumnID of the column the task is in after the change.
+type BoardEvent struct {
+	Cursor     BoardEventCursor
+	Entity     BoardEventEntity
+	Action     BoardEventAction
+	BoardID    BoardID
+	ColumnID   ColumnID
+	TaskID     TaskID
+	Version    Version
+	OccurredAt time.Time
+}
+
+type BoardEventEntity string
+
+const (
+	BoardEventBoard  BoardEventEntity = "board"
+	BoardEventColumn BoardEventEntity = "column"
+	BoardEventTask   BoardEventEntity = "task"
+)
+
+func (e BoardEventEntity) String() string {
+	return string(e)
+}
+
+// BoardEventAction is what happened to the entity. A task moves when its column, lane or
+// position changes, and a column when its position does.
+type BoardEventAction string
+
+const (
+	BoardEventCreated BoardEventAction = "created"
+	BoardEventUpdated BoardEventAction = "updated"
+	BoardEventMoved   BoardEventAction = "moved"
+	BoardEventDeleted BoardEventAction = "deleted"
+)
+
+func (a BoardEventAction) String() string {
+	return string(a)
+}
+
+// BoardEventCursor is the position of an event in the log, ordered by the transaction that wrote
+// the event first. Its string form is what clients get as an event id and send back to resume.
+// The zero value is before every event.
+type BoardEventCursor struct {
+	txID int64
+	id   int64
+}
+
+func NewBoardEventCursor(txID, id int64) BoardEventCursor {
+	return BoardEventCursor{txID: txID, id: id}
+}
+
+func ParseBoardEventCursor(s string) (BoardEventCursor, error) {
+	txPart, idPart, ok := strings.Cut(strings.TrimSpace(s), "-")
+	if !ok {
+		return BoardEventCursor{}, &errValidation{Issues: []string{ErrBoardEventCursorValue}}
+	}
+
+	txID, txErr := strconv.ParseInt(txPart, 10, 64)
+	id, idErr := strconv.ParseInt(idPart, 10, 64)
+	if txErr != nil || idErr != nil || txID < 0 || id < 0 {
+		return BoardEventCursor{}, &errValidation{Issues: []string{ErrBoardEventCursorValue}}
+	}
+
+	return BoardEventCursor{txID: txID, id: id}, nil
+}
+
+func (c BoardEventCursor) TxID() int64 {
+	return c.txID
+}
+
+func (c BoardEventCursor) ID() int64 {
+	return c.id
+}
+
+func (c BoardEventCursor) String() string {
+	return strconv.FormatInt(c.txID, 10) + "-" + strconv.FormatInt(c.id, 10)
+}
@@ -0,0 +1,67 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestParseBoardEventCursor(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		wantTxID   int64
+		wantID     int64
+	}{
+		{name: "Valid", input: "742-1093", wantTxID: 742, wantID: 1093},
+		{name: "Valid with spaces", input: " 742-1093 ", wantTxID: 742, wantID: 1093},
+		{name: "Valid start", input: "0-0"},
+		{name: "Missing separator", input: "7421093", wantIssues: []string{domain.ErrBoardEventCursorValue}},
+		{name: "Missing id", input: "742-", wantIssues: []string{domain.ErrBoardEventCursorValue}},
+		{name: "Not a number", input: "742-abc", wantIssues: []string{domain.ErrBoardEventCursorValue}},
+		{name: "Negative", input: "742--1", wantIssues: []string{domain.ErrBoardEventCursorValue}},
+		{name: "Overflow", input: "742-9223372036854775808", wantIssues: []string{domain.ErrBoardEventCursorValue}},
+		{name: "Empty", input: "", wantIssues: []string{domain.ErrBoardEventCursorValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cursor, err := domain.ParseBoardEventCursor(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if cursor.TxID() != tt.wantTxID || cursor.ID() != tt.wantID {
+				t.Errorf("got cursor (%d, %d), want (%d, %d)", cursor.TxID(), cursor.ID(), tt.wantTxID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestBoardEventCursor_String(t *testing.T) {
+	t.Parallel()
+
+	cursor := domain.NewBoardEventCursor(742, 1093)
+	if got := cursor.String(); got != "742-1093" {
+		t.Fatalf("got %q, want %q", got, "742-1093")
+	}
+
+	parsed, err := domain.ParseBoardEventCursor(cursor.String())
+	if err != nil {
+		t.Fatalf("ParseBoardEventCursor(%q) error = %v", cursor.String(), err)
+	}
+	if parsed != cursor {
+		t.Errorf("got %v after round trip, want %v", parsed, cursor)
+	}
+}
@@ -0,0 +1,134 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrBoardImportSourceValue    = "Source must be trello, csv or json"
+	ErrBoardImportHeaderRequired = "Header is required"
+	ErrBoardImportHeaderMissing  = "Header is not in the file"
+	ErrBoardImportTrelloContent  = "Content is not a Trello board export"
+	ErrBoardImportCSVContent     = "Content is not a CSV file with a header row"
+	ErrBoardImportJSONContent    = "Content is not a board export"
+	ErrBoardImportJSONVersion    = "Export version is not supported"
+	ErrBoardImportReference      = "Refers to an item that is not in the file"
+	ErrBoardImportDuplicateID    = "Id appears more than once"
+	ErrBoardImportParentCycle    = "Parents form a cycle"
+	ErrBoardImportLinkCycle      = "Blocking links form a cycle"
+	ErrBoardImportDuplicateLink  = "Link appears more than once"
+	ErrBoardImportTimestamp      = "Timestamp is invalid"
+	ErrBoardImportSaveFailed     = "Board could not be saved, start the import again"
+)
+
+// MaxBoardImportErrors bounds the error report of an import; problems past it are not listed.
+const MaxBoardImportErrors = 100
+
+// BoardImport is a request to create a board from an uploaded file. It is processed in the
+// background: the board and everything on it is written at once, or nothing is written and
+// Errors tells what has to be fixed in the file.
+type BoardImport struct {
+	ID      BoardImportID
+	OwnerID UserID
+	Source  BoardImportSource
+	// Name overrides the board name of the file. It is required for CSV files, which have none.
+	Name    BoardName
+	Mapping BoardImportMapping
+	// Content is the uploaded file. It is dropped once the import has finished.
+	Content    []byte
+	Status     BoardImportStatus
+	BoardID    BoardID // Set once the import has succeeded.
+	Errors     []BoardImportError
+	CreatedAt  time.Time
+	StartedAt  time.Time // Zero while pending.
+	FinishedAt time.Time // Zero until succeeded or failed.
+}
+
+type (
+	boardImportTag struct{}
+	BoardImportID  = UUID[boardImportTag]
+)
+
+func NewBoardImportID() BoardImportID {
+	return newID[boardImportTag]()
+}
+
+func ParseBoardImportID(s string) (BoardImportID, error) {
+	return parseID[boardImportTag](s)
+}
+
+func NewBoardImportIDFromUUID(u uuid.UUID) (BoardImportID, error) {
+	return newIDFromUUID[boardImportTag](u)
+}
+
+// BoardImportSource is the format of an uploaded board: a Trello board export, a CSV file with
+// a task per row, or a board exported from here as JSON.
+type BoardImportSource string
+
+const (
+	BoardImportTrello BoardImportSource = "trello"
+	BoardImportCSV    BoardImportSource = "csv"
+	BoardImportJSON   BoardImportSource = "json"
+)
+
+func NewBoardImportSource(source string) (BoardImportSource, error) {
+	switch s := BoardImportSource(strings.TrimSpace(source)); s {
+	case BoardImportTrello, BoardImportCSV, BoardImportJSON:
+		return s, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrBoardImportSourceValue}}
+	}
+}
+
+func (s BoardImportSource) String() string {
+	return string(s)
+}
+
+type BoardImportStatus string
+
+const (
+	BoardImportPending   BoardImportStatus = "pending"
+	BoardImportRunning   BoardImportStatus = "running"
+	BoardImportSucceeded BoardImportStatus = "succeeded"
+	BoardImportFailed    BoardImportStatus = "failed"
+)
+
+func (s BoardImportStatus) String() string {
+	return string(s)
+}
+
+// BoardImportMapping names the CSV headers holding each task attribute. Name and Column are
+// required; an empty header leaves the attribute unset. Columns and lanes are created in the
+// order their names first appear.
+type BoardImportMapping struct {
+	Name        string
+	Column      string
+	Lane        string
+	Description string
+	Checklist   string // One item per line, done items prefixed with "[x] ".
+	Estimate    string
+}
+
+// BoardImportError reports why an item of the file can't be imported. Item names the item the
+// way the file does, like "card 5f3a..." or "row 12", and Field the attribute at fault.
+type BoardImportError struct {
+	Item   string
+	Field  string
+	Issues []string
+}
+
+// ImportedBoard is a board with everything on it, ready to be written at once. Ids are assigned
+// up front so the parts can refer to each other: tasks to their column, lane, parent and sprint,
+// and custom field values to their field.
+type ImportedBoard struct {
+	Board        Board
+	Columns      []Column // Ordered by position.
+	Lanes        []Lane   // Ordered by position.
+	CustomFields []CustomField
+	Sprints      []Sprint
+	Tasks        []Task
+	Links        []TaskLink
+}
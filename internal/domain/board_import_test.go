@@ -0,0 +1,43 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestNewBoardImportSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		source     string
+		want       domain.BoardImportSource
+		wantIssues []string
+	}{
+		{name: "Trello", source: "trello", want: domain.BoardImportTrello},
+		{name: "CSV trimmed", source: " csv ", want: domain.BoardImportCSV},
+		{name: "JSON", source: "json", want: domain.BoardImportJSON},
+		{name: "Unknown", source: "jira", wantIssues: []string{domain.ErrBoardImportSourceValue}},
+		{name: "Case sensitive", source: "CSV", wantIssues: []string{domain.ErrBoardImportSourceValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			source, err := domain.NewBoardImportSource(tt.source)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			} else if source != tt.want {
+				t.Errorf("got source %q, want %q", source, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
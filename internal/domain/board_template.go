@@ -0,0 +1,57 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// BoardTemplate is a reusable board layout. Templates without an owner are built-in and shared by everyone.
+type BoardTemplate struct {
+	ID          BoardTemplateID
+	OwnerID     UserID
+	Name        BoardName
+	Description BoardDescription
+	Columns     []BoardTemplateColumn
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (t *BoardTemplate) IsBuiltIn() bool {
+	return t.OwnerID.IsNil()
+}
+
+// BoardTemplateColumn is a column created in order when a board is made from a template.
+type BoardTemplateColumn struct {
+	Name         ColumnName
+	Description  ColumnDescription
+	WIPLimit     ColumnWIPLimit
+	SLA          ColumnSLA
+	ArchiveAfter ColumnArchiveAfter
+	IsStarted    bool
+	IsDone       bool
+	Tasks        []BoardTemplateTask
+}
+
+// BoardTemplateTask is a starter task created in order inside its column.
+type BoardTemplateTask struct {
+	Name        TaskName
+	Description TaskDescription
+}
+
+type (
+	boardTemplateTag struct{}
+	BoardTemplateID  = UUID[boardTemplateTag]
+)
+
+func NewBoardTemplateID() BoardTemplateID {
+	return newID[boardTemplateTag]()
+}
+
+func ParseBoardTemplateID(s string) (BoardTemplateID, error) {
+	return parseID[boardTemplateTag](s)
+}
+
+func NewBoardTemplateIDFromUUID(u uuid.UUID) (BoardTemplateID, error) {
+	return newIDFromUUID[boardTemplateTag](u)
+}
@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+const (
+	ErrBurndownDateInvalid  = "Date is invalid"
+	ErrBurndownToBeforeFrom = "End of range is before its start"
+)
+
+// BurndownSeries is the daily story points history of a board, or of a single sprint when
+// SprintID is set. Days without a snapshot are missing from Points.
+type BurndownSeries struct {
+	BoardID  BoardID
+	SprintID SprintID // Nil for the whole board.
+	From     time.Time
+	To       time.Time
+	Points   []BurndownPoint
+}
+
+// BurndownPoint is the state of a scope on a UTC day. Tasks without an estimate count as zero points.
+type BurndownPoint struct {
+	Day       time.Time
+	Remaining float64 // Points of tasks outside done columns.
+	Completed float64 // Points of tasks in done columns.
+}
+
+// Scope is the total points planned on that day, which grows as tasks are added.
+func (p BurndownPoint) Scope() float64 {
+	return p.Remaining + p.Completed
+}
+
+// ParseBurndownDate parses a date in YYYY-MM-DD format. An empty date leaves the range
+// unbounded on that side and gives the zero time.
+func ParseBurndownDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, nil
+	}
+
+	parsed, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, &errValidation{Issues: []string{ErrBurndownDateInvalid}}
+	}
+
+	return parsed, nil
+}
+
+// ValidateBurndownRange checks that a range bounded on both sides does not end before it starts.
+func ValidateBurndownRange(from, to time.Time) error {
+	if !from.IsZero() && !to.IsZero() && to.Before(from) {
+		return &errValidation{Issues: []string{ErrBurndownToBeforeFrom}}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestParseBurndownDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       time.Time
+	}{
+		{name: "Valid", input: "2026-01-05", want: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Empty is unbounded", input: ""},
+		{name: "Timestamp", input: "2026-01-05T00:00:00Z", wantIssues: []string{domain.ErrBurndownDateInvalid}},
+		{name: "Not a date", input: "2026-02-30", wantIssues: []string{domain.ErrBurndownDateInvalid}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.ParseBurndownDate(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got date %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateBurndownRange(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		from       time.Time
+		to         time.Time
+		wantIssues []string
+	}{
+		{name: "Single day", from: day, to: day},
+		{name: "Unbounded start", to: day},
+		{name: "Unbounded end", from: day},
+		{name: "End before start", from: day, to: day.AddDate(0, 0, -1), wantIssues: []string{domain.ErrBurndownToBeforeFrom}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := domain.ValidateBurndownRange(tt.from, tt.to)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBurndownPoint_Scope(t *testing.T) {
+	t.Parallel()
+
+	point := domain.BurndownPoint{Remaining: 8, Completed: 5.5}
+	if got := point.Scope(); got != 13.5 {
+		t.Errorf("got scope %v, want 13.5", got)
+	}
+}
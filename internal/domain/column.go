@@ -3,6 +3,7 @@ package domain
 import (
 	"database/sql/driver"
 	"math"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -15,16 +16,67 @@ const (
 	ErrColumnNameTooLong        = "Name is too long"
 	ErrColumnDescriptionTooLong = "Description is too long"
 	ErrColumnPositionValue      = "Position is invalid"
+	ErrColumnWIPLimitValue      = "WIP limit is invalid"
+	ErrColumnSLAValue           = "SLA must be between 0 and 8760 hours"
+	ErrColumnArchiveAfterValue  = "Archive delay must be between 0 and 3650 days"
+	ErrColumnTransitionsTooLong = "Too many allowed transitions"
+	ErrColumnEntryCondition     = "Entry condition must be checklist_done or estimate_set"
+	ErrColumnEntryRole          = "Entry role must be owner or automation"
+)
+
+const (
+	maxColumnWIPLimit = 1000
+	maxColumnSLAHours = 24 * 365
+
+	maxColumnArchiveAfterDays = 3650
+
+	maxColumnTransitions = 100
 )
 
 type Column struct {
-	ID          ColumnID
-	BoardID     BoardID
-	Name        ColumnName
-	Description ColumnDescription
-	Position    ColumnPosition
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID              ColumnID
+	BoardID         BoardID
+	Name            ColumnName
+	Description     ColumnDescription
+	Position        ColumnPosition
+	WIPLimit        ColumnWIPLimit
+	SLA             ColumnSLA
+	ArchiveAfter    ColumnArchiveAfter
+	IsStarted       bool // Work on a task starts when it first enters a started or done column.
+	IsDone          bool // Tasks in a done column count as finished.
+	Transitions     ColumnTransitions
+	EntryConditions ColumnEntryConditions
+	EntryRoles      ColumnEntryRoles
+	Version         Version
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// ColumnPatch holds the column fields to update. Nil fields are left unchanged.
+type ColumnPatch struct {
+	Name            *ColumnName
+	Description     *ColumnDescription
+	WIPLimit        *ColumnWIPLimit
+	SLA             *ColumnSLA
+	ArchiveAfter    *ColumnArchiveAfter
+	IsStarted       *bool
+	IsDone          *bool
+	Transitions     *ColumnTransitions
+	EntryConditions *ColumnEntryConditions
+	EntryRoles      *ColumnEntryRoles
+}
+
+// IsEmpty tells whether the patch leaves every field unchanged.
+func (p *ColumnPatch) IsEmpty() bool {
+	return p.Name == nil && p.Description == nil && p.WIPLimit == nil && p.SLA == nil && p.ArchiveAfter == nil &&
+		p.IsStarted == nil && p.IsDone == nil && p.Transitions == nil && p.EntryConditions == nil &&
+		p.EntryRoles == nil
+}
+
+// ColumnPlacement is where a column sits on its board.
+type ColumnPlacement struct {
+	Position ColumnPosition
+	Version  Version // Of the column once placed.
 }
 
 type (
@@ -118,3 +170,255 @@ func (p ColumnPosition) Int64() int64 {
 func (p ColumnPosition) Value() (driver.Value, error) {
 	return p.value, nil
 }
+
+// ColumnWIPLimit is the max number of tasks a column is meant to hold. Zero means no limit.
+// The limit is advisory: it is shown to clients, never checked when tasks enter the column.
+type ColumnWIPLimit struct {
+	value int32
+}
+
+func NewColumnWIPLimit(limit int64) (ColumnWIPLimit, error) {
+	if limit < 0 || limit > maxColumnWIPLimit {
+		return ColumnWIPLimit{}, &errValidation{Issues: []string{ErrColumnWIPLimitValue}}
+	}
+
+	return ColumnWIPLimit{value: int32(limit)}, nil
+}
+
+func (l ColumnWIPLimit) Int64() int64 {
+	return int64(l.value)
+}
+
+func (l ColumnWIPLimit) Value() (driver.Value, error) {
+	return l.value, nil
+}
+
+// ColumnSLA is how long a task may stay in a column before it breaches the SLA, in whole
+// hours. Zero means no SLA.
+type ColumnSLA struct {
+	hours int32
+}
+
+func NewColumnSLA(hours int64) (ColumnSLA, error) {
+	if hours < 0 || hours > maxColumnSLAHours {
+		return ColumnSLA{}, &errValidation{Issues: []string{ErrColumnSLAValue}}
+	}
+
+	return ColumnSLA{hours: int32(hours)}, nil
+}
+
+func (s ColumnSLA) Hours() int64 {
+	return int64(s.hours)
+}
+
+func (s ColumnSLA) Duration() time.Duration {
+	return time.Duration(s.hours) * time.Hour
+}
+
+// IsBreached reports whether a task that has been in the column for age breaches the SLA.
+func (s ColumnSLA) IsBreached(age time.Duration) bool {
+	return s.hours > 0 && age >= s.Duration()
+}
+
+func (s ColumnSLA) Value() (driver.Value, error) {
+	return s.hours, nil
+}
+
+// ColumnArchiveAfter is how long a task stays in a column before it is archived, in whole
+// days. Zero means tasks in the column are never archived.
+type ColumnArchiveAfter struct {
+	days int32
+}
+
+func NewColumnArchiveAfter(days int64) (ColumnArchiveAfter, error) {
+	if days < 0 || days > maxColumnArchiveAfterDays {
+		return ColumnArchiveAfter{}, &errValidation{Issues: []string{ErrColumnArchiveAfterValue}}
+	}
+
+	return ColumnArchiveAfter{days: int32(days)}, nil
+}
+
+func (a ColumnArchiveAfter) Days() int64 {
+	return int64(a.days)
+}
+
+func (a ColumnArchiveAfter) Value() (driver.Value, error) {
+	return a.days, nil
+}
+
+// ColumnTransitions lists the columns tasks may be moved to from a column. An empty list
+// allows moves to any column of the board.
+type ColumnTransitions struct {
+	targets []ColumnID
+}
+
+func NewColumnTransitions(targets []ColumnID) (ColumnTransitions, error) {
+	if len(targets) == 0 {
+		return ColumnTransitions{}, nil
+	}
+	if len(targets) > maxColumnTransitions {
+		return ColumnTransitions{}, &errValidation{Issues: []string{ErrColumnTransitionsTooLong}}
+	}
+
+	unique := make([]ColumnID, 0, len(targets))
+	for _, target := range targets {
+		if !slices.Contains(unique, target) {
+			unique = append(unique, target)
+		}
+	}
+
+	return ColumnTransitions{targets: unique}, nil
+}
+
+// Targets returns a copy of the allowed target columns.
+func (t ColumnTransitions) Targets() []ColumnID {
+	return slices.Clone(t.targets)
+}
+
+// Allows reports whether tasks may be moved from the column into target.
+func (t ColumnTransitions) Allows(target ColumnID) bool {
+	return len(t.targets) == 0 || slices.Contains(t.targets, target)
+}
+
+func (t ColumnTransitions) Value() (driver.Value, error) {
+	targets := make([]string, len(t.targets))
+	for i, target := range t.targets {
+		targets[i] = target.String()
+	}
+	return targets, nil
+}
+
+// ColumnEntryCondition is a precondition a task must meet to be moved into a column.
+type ColumnEntryCondition string
+
+const (
+	ColumnEntryChecklistDone ColumnEntryCondition = "checklist_done"
+	ColumnEntryEstimateSet   ColumnEntryCondition = "estimate_set"
+)
+
+func NewColumnEntryCondition(condition string) (ColumnEntryCondition, error) {
+	switch c := ColumnEntryCondition(condition); c {
+	case ColumnEntryChecklistDone, ColumnEntryEstimateSet:
+		return c, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrColumnEntryCondition}}
+	}
+}
+
+// IsMetBy reports whether task meets the condition.
+func (c ColumnEntryCondition) IsMetBy(task *Task) bool {
+	switch c {
+	case ColumnEntryChecklistDone:
+		return task.Checklist.IsDone()
+	case ColumnEntryEstimateSet:
+		return task.Estimate.IsSet()
+	default:
+		return false
+	}
+}
+
+// ColumnEntryConditions are the preconditions a task must meet to be moved into a column.
+type ColumnEntryConditions struct {
+	conditions []ColumnEntryCondition
+}
+
+func NewColumnEntryConditions(conditions []string) (ColumnEntryConditions, error) {
+	if len(conditions) == 0 {
+		return ColumnEntryConditions{}, nil
+	}
+
+	unique := make([]ColumnEntryCondition, 0, len(conditions))
+	for _, raw := range conditions {
+		condition, err := NewColumnEntryCondition(raw)
+		if err != nil {
+			return ColumnEntryConditions{}, err
+		}
+		if !slices.Contains(unique, condition) {
+			unique = append(unique, condition)
+		}
+	}
+
+	return ColumnEntryConditions{conditions: unique}, nil
+}
+
+func (c ColumnEntryConditions) Strings() []string {
+	conditions := make([]string, len(c.conditions))
+	for i, condition := range c.conditions {
+		conditions[i] = string(condition)
+	}
+	return conditions
+}
+
+// Unmet returns the conditions task doesn't meet, in the order they were defined.
+func (c ColumnEntryConditions) Unmet(task *Task) []ColumnEntryCondition {
+	var unmet []ColumnEntryCondition
+	for _, condition := range c.conditions {
+		if !condition.IsMetBy(task) {
+			unmet = append(unmet, condition)
+		}
+	}
+	return unmet
+}
+
+func (c ColumnEntryConditions) Value() (driver.Value, error) {
+	return c.Strings(), nil
+}
+
+// ColumnEntryRole is who moves a task: the board owner or an automation of the board.
+type ColumnEntryRole string
+
+const (
+	ColumnEntryOwner      ColumnEntryRole = "owner"
+	ColumnEntryAutomation ColumnEntryRole = "automation"
+)
+
+func NewColumnEntryRole(role string) (ColumnEntryRole, error) {
+	switch r := ColumnEntryRole(role); r {
+	case ColumnEntryOwner, ColumnEntryAutomation:
+		return r, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrColumnEntryRole}}
+	}
+}
+
+// ColumnEntryRoles are the roles that may move tasks into a column. An empty list lets every
+// role move tasks into the column.
+type ColumnEntryRoles struct {
+	roles []ColumnEntryRole
+}
+
+func NewColumnEntryRoles(roles []string) (ColumnEntryRoles, error) {
+	if len(roles) == 0 {
+		return ColumnEntryRoles{}, nil
+	}
+
+	unique := make([]ColumnEntryRole, 0, len(roles))
+	for _, raw := range roles {
+		role, err := NewColumnEntryRole(raw)
+		if err != nil {
+			return ColumnEntryRoles{}, err
+		}
+		if !slices.Contains(unique, role) {
+			unique = append(unique, role)
+		}
+	}
+
+	return ColumnEntryRoles{roles: unique}, nil
+}
+
+func (r ColumnEntryRoles) Strings() []string {
+	roles := make([]string, len(r.roles))
+	for i, role := range r.roles {
+		roles[i] = string(role)
+	}
+	return roles
+}
+
+// Allows reports whether role may move tasks into the column.
+func (r ColumnEntryRoles) Allows(role ColumnEntryRole) bool {
+	return len(r.roles) == 0 || slices.Contains(r.roles, role)
+}
+
+func (r ColumnEntryRoles) Value() (driver.Value, error) {
+	return r.Strings(), nil
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -184,3 +185,288 @@ func TestColumnDescription(t *testing.T) {
 		})
 	}
 }
+
+func TestColumnWIPLimit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      int64
+		wantIssues []string
+		wantValue  int64
+	}{
+		{name: "No limit", input: 0, wantValue: 0},
+		{name: "Valid", input: 3, wantValue: 3},
+		{name: "Valid max", input: 1000, wantValue: 1000},
+		{name: "Negative", input: -1, wantIssues: []string{domain.ErrColumnWIPLimitValue}},
+		{name: "Too big", input: 1001, wantIssues: []string{domain.ErrColumnWIPLimitValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			limit, err := domain.NewColumnWIPLimit(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues == nil && limit.Int64() != tt.wantValue {
+				t.Errorf("got value %d, want %d", limit.Int64(), tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestColumnSLA(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        int64
+		age          time.Duration
+		wantIssues   []string
+		wantBreached bool
+	}{
+		{name: "No SLA never breaches", input: 0, age: 1000 * time.Hour},
+		{name: "Within SLA", input: 48, age: 47 * time.Hour},
+		{name: "Breached at SLA", input: 48, age: 48 * time.Hour, wantBreached: true},
+		{name: "Valid max", input: 8760, age: time.Hour},
+		{name: "Negative", input: -1, wantIssues: []string{domain.ErrColumnSLAValue}},
+		{name: "Too big", input: 8761, wantIssues: []string{domain.ErrColumnSLAValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sla, err := domain.NewColumnSLA(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues != nil {
+				return
+			}
+			if sla.Hours() != tt.input {
+				t.Errorf("got %d hours, want %d", sla.Hours(), tt.input)
+			}
+			if got := sla.IsBreached(tt.age); got != tt.wantBreached {
+				t.Errorf("got IsBreached(%v) %t, want %t", tt.age, got, tt.wantBreached)
+			}
+		})
+	}
+}
+
+func TestColumnTransitions(t *testing.T) {
+	t.Parallel()
+
+	review := domain.NewColumnID()
+	done := domain.NewColumnID()
+	tooMany := make([]domain.ColumnID, 101)
+	for i := range tooMany {
+		tooMany[i] = domain.NewColumnID()
+	}
+
+	tests := []struct {
+		name        string
+		input       []domain.ColumnID
+		wantIssues  []string
+		wantTargets []domain.ColumnID
+		wantAllowed map[domain.ColumnID]bool
+	}{
+		{
+			name:        "Empty allows any column",
+			input:       []domain.ColumnID{},
+			wantAllowed: map[domain.ColumnID]bool{review: true, done: true},
+		},
+		{
+			name:        "Only listed columns are allowed",
+			input:       []domain.ColumnID{review},
+			wantTargets: []domain.ColumnID{review},
+			wantAllowed: map[domain.ColumnID]bool{review: true, done: false},
+		},
+		{
+			name:        "Duplicates are dropped",
+			input:       []domain.ColumnID{review, done, review},
+			wantTargets: []domain.ColumnID{review, done},
+			wantAllowed: map[domain.ColumnID]bool{review: true, done: true},
+		},
+		{name: "Too many", input: tooMany, wantIssues: []string{domain.ErrColumnTransitionsTooLong}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transitions, err := domain.NewColumnTransitions(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wantTargets, transitions.Targets(), cmp.Comparer(func(a, b domain.ColumnID) bool { return a == b })); diff != "" {
+				t.Errorf("got targets mismatch (-want +got):\n%s", diff)
+			}
+			for target, want := range tt.wantAllowed {
+				if got := transitions.Allows(target); got != want {
+					t.Errorf("got Allows(%v) %t, want %t", target, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestColumnEntryConditions(t *testing.T) {
+	t.Parallel()
+
+	estimate, err := domain.NewTaskEstimate(3)
+	if err != nil {
+		t.Fatalf("NewTaskEstimate() error = %v", err)
+	}
+	unfinished, err := domain.NewTaskChecklist([]domain.TaskChecklistItem{{Text: "Write tests", Done: true}, {Text: "Review"}})
+	if err != nil {
+		t.Fatalf("NewTaskChecklist() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		input      []string
+		task       domain.Task
+		wantIssues []string
+		wantUnmet  []domain.ColumnEntryCondition
+	}{
+		{name: "No conditions", input: nil, task: domain.Task{Checklist: unfinished}},
+		{
+			name:  "All met",
+			input: []string{"checklist_done", "estimate_set"},
+			task:  domain.Task{Estimate: estimate},
+		},
+		{
+			name:      "Unfinished checklist",
+			input:     []string{"checklist_done", "estimate_set"},
+			task:      domain.Task{Checklist: unfinished, Estimate: estimate},
+			wantUnmet: []domain.ColumnEntryCondition{domain.ColumnEntryChecklistDone},
+		},
+		{
+			name:      "Both unmet in definition order",
+			input:     []string{"estimate_set", "checklist_done", "estimate_set"},
+			task:      domain.Task{Checklist: unfinished},
+			wantUnmet: []domain.ColumnEntryCondition{domain.ColumnEntryEstimateSet, domain.ColumnEntryChecklistDone},
+		},
+		{name: "Unknown", input: []string{"approved"}, wantIssues: []string{domain.ErrColumnEntryCondition}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conditions, err := domain.NewColumnEntryConditions(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wantUnmet, conditions.Unmet(&tt.task)); diff != "" {
+				t.Errorf("got unmet conditions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestColumnEntryRoles(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		input          []string
+		wantIssues     []string
+		wantOwner      bool
+		wantAutomation bool
+	}{
+		{name: "No roles allow everyone", input: nil, wantOwner: true, wantAutomation: true},
+		{name: "Owner only", input: []string{"owner", "owner"}, wantOwner: true},
+		{name: "Both", input: []string{"automation", "owner"}, wantOwner: true, wantAutomation: true},
+		{name: "Unknown", input: []string{"member"}, wantIssues: []string{domain.ErrColumnEntryRole}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			roles, err := domain.NewColumnEntryRoles(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues != nil {
+				return
+			}
+			if got := roles.Allows(domain.ColumnEntryOwner); got != tt.wantOwner {
+				t.Errorf("Allows(owner) = %v, want %v", got, tt.wantOwner)
+			}
+			if got := roles.Allows(domain.ColumnEntryAutomation); got != tt.wantAutomation {
+				t.Errorf("Allows(automation) = %v, want %v", got, tt.wantAutomation)
+			}
+		})
+	}
+}
+
+func TestColumnArchiveAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      int64
+		wantIssues []string
+	}{
+		{name: "Never archives", input: 0},
+		{name: "Valid", input: 14},
+		{name: "Valid max", input: 3650},
+		{name: "Negative", input: -1, wantIssues: []string{domain.ErrColumnArchiveAfterValue}},
+		{name: "Too big", input: 3651, wantIssues: []string{domain.ErrColumnArchiveAfterValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			archiveAfter, err := domain.NewColumnArchiveAfter(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues == nil && archiveAfter.Days() != tt.input {
+				t.Errorf("got %d days, want %d", archiveAfter.Days(), tt.input)
+			}
+		})
+	}
+}
@@ -0,0 +1,391 @@
+package domain
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrCustomFieldNameTooShort     = "Name is too short"
+	ErrCustomFieldNameTooLong      = "Name is too long"
+	ErrCustomFieldTypeValue        = "Type must be text, number, date, single_select, multi_select, checkbox or user"
+	ErrCustomFieldOptionsRequired  = "Options are required for select fields"
+	ErrCustomFieldOptionsForbidden = "Options are only allowed for select fields"
+	ErrCustomFieldOptionsTooLong   = "Field has too many options"
+	ErrCustomFieldOptionText       = "Option text is invalid"
+	ErrCustomFieldOptionDuplicate  = "Options must be unique"
+	ErrCustomFieldNotFound         = "Custom field does not exist"
+	ErrCustomFieldTextValue        = "Value must be a non-empty string of at most 1024 characters"
+	ErrCustomFieldNumberValue      = "Value must be a number"
+	ErrCustomFieldDateValue        = "Value must be a date in YYYY-MM-DD format"
+	ErrCustomFieldOptionValue      = "Value must be one of the field options"
+	ErrCustomFieldOptionsValue     = "Value must be a list of distinct field options"
+	ErrCustomFieldCheckboxValue    = "Value must be true or false"
+	ErrCustomFieldUserValue        = "Value must be a user id"
+	ErrCustomFieldFilterRepeated   = "Field can be filtered by one value only"
+)
+
+const (
+	maxCustomFieldOptions    = 50
+	maxCustomFieldOptionText = 64
+	maxCustomFieldText       = 1024
+)
+
+// CustomField is a board-defined task attribute. Options are set only for select fields.
+type CustomField struct {
+	ID        CustomFieldID
+	BoardID   BoardID
+	Name      CustomFieldName
+	Type      CustomFieldType
+	Options   CustomFieldOptions
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type (
+	customFieldTag struct{}
+	CustomFieldID  = UUID[customFieldTag]
+)
+
+func NewCustomFieldID() CustomFieldID {
+	return newID[customFieldTag]()
+}
+
+func ParseCustomFieldID(s string) (CustomFieldID, error) {
+	return parseID[customFieldTag](s)
+}
+
+func NewCustomFieldIDFromUUID(u uuid.UUID) (CustomFieldID, error) {
+	return newIDFromUUID[customFieldTag](u)
+}
+
+type CustomFieldName struct {
+	value string
+}
+
+func NewCustomFieldName(name string) (CustomFieldName, error) {
+	trimmedName := strings.TrimSpace(name)
+	var issues []string
+	if trimmedName == "" {
+		issues = append(issues, ErrCustomFieldNameTooShort)
+	}
+	if len(trimmedName) > 64 {
+		issues = append(issues, ErrCustomFieldNameTooLong)
+	}
+	if len(issues) > 0 {
+		return CustomFieldName{}, &errValidation{Issues: issues}
+	}
+
+	return CustomFieldName{value: trimmedName}, nil
+}
+
+func (n CustomFieldName) String() string {
+	return n.value
+}
+
+func (n CustomFieldName) Value() (driver.Value, error) {
+	return n.value, nil
+}
+
+// CustomFieldType is the stored type of a custom field. It can't change after the field is created.
+type CustomFieldType string
+
+const (
+	CustomFieldText         CustomFieldType = "text"
+	CustomFieldNumber       CustomFieldType = "number"
+	CustomFieldDate         CustomFieldType = "date"
+	CustomFieldSingleSelect CustomFieldType = "single_select"
+	CustomFieldMultiSelect  CustomFieldType = "multi_select"
+	CustomFieldCheckbox     CustomFieldType = "checkbox"
+	CustomFieldUser         CustomFieldType = "user"
+)
+
+func NewCustomFieldType(fieldType string) (CustomFieldType, error) {
+	switch t := CustomFieldType(fieldType); t {
+	case CustomFieldText, CustomFieldNumber, CustomFieldDate, CustomFieldSingleSelect,
+		CustomFieldMultiSelect, CustomFieldCheckbox, CustomFieldUser:
+		return t, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrCustomFieldTypeValue}}
+	}
+}
+
+func (t CustomFieldType) IsSelect() bool {
+	return t == CustomFieldSingleSelect || t == CustomFieldMultiSelect
+}
+
+// CustomFieldOptions are the ordered choices of a select field.
+type CustomFieldOptions struct {
+	options []string
+}
+
+func NewCustomFieldOptions(options []string) (CustomFieldOptions, error) {
+	if len(options) == 0 {
+		return CustomFieldOptions{}, nil
+	}
+
+	var issues []string
+	if len(options) > maxCustomFieldOptions {
+		issues = append(issues, ErrCustomFieldOptionsTooLong)
+	}
+
+	trimmed := make([]string, len(options))
+	for i, option := range options {
+		text := strings.TrimSpace(option)
+		if text == "" || len(text) > maxCustomFieldOptionText {
+			issues = append(issues, ErrCustomFieldOptionText)
+			break
+		}
+		if slices.Contains(trimmed[:i], text) {
+			issues = append(issues, ErrCustomFieldOptionDuplicate)
+			break
+		}
+		trimmed[i] = text
+	}
+
+	if len(issues) > 0 {
+		return CustomFieldOptions{}, &errValidation{Issues: issues}
+	}
+
+	return CustomFieldOptions{options: trimmed}, nil
+}
+
+// Strings returns a copy of the options, so callers can't mutate them in place.
+func (o CustomFieldOptions) Strings() []string {
+	options := make([]string, len(o.options))
+	copy(options, o.options)
+	return options
+}
+
+func (o CustomFieldOptions) Len() int {
+	return len(o.options)
+}
+
+func (o CustomFieldOptions) Contains(option string) bool {
+	return slices.Contains(o.options, option)
+}
+
+func (o CustomFieldOptions) Value() (driver.Value, error) {
+	raw, err := json.Marshal(o.Strings())
+	if err != nil {
+		return nil, fmt.Errorf("marshal custom field options: %w", err)
+	}
+
+	return string(raw), nil
+}
+
+// CheckCustomFieldOptions reports whether options fit a field of fieldType: select fields
+// need at least one option and other fields take none.
+func CheckCustomFieldOptions(fieldType CustomFieldType, options CustomFieldOptions) error {
+	if fieldType.IsSelect() && options.Len() == 0 {
+		return &errValidation{Issues: []string{ErrCustomFieldOptionsRequired}}
+	}
+	if !fieldType.IsSelect() && options.Len() > 0 {
+		return &errValidation{Issues: []string{ErrCustomFieldOptionsForbidden}}
+	}
+
+	return nil
+}
+
+// CustomFieldValue is the value of a custom field on a task, kept as canonical JSON:
+// a string for text, date, single select and user fields, a number, a list of options
+// for multi select fields and a boolean for checkboxes.
+type CustomFieldValue struct {
+	raw json.RawMessage
+}
+
+// NewCustomFieldValue validates raw JSON against the field. Text is trimmed and dates must
+// be in YYYY-MM-DD format.
+func NewCustomFieldValue(field *CustomField, raw json.RawMessage) (CustomFieldValue, error) {
+	var (
+		value any
+		issue string
+	)
+	switch field.Type {
+	case CustomFieldText:
+		var text string
+		if json.Unmarshal(raw, &text) == nil {
+			text = strings.TrimSpace(text)
+			if text != "" && len(text) <= maxCustomFieldText {
+				value = text
+			}
+		}
+		issue = ErrCustomFieldTextValue
+	case CustomFieldNumber:
+		var number float64
+		if json.Unmarshal(raw, &number) == nil && !bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			value = number
+		}
+		issue = ErrCustomFieldNumberValue
+	case CustomFieldDate:
+		var date string
+		if json.Unmarshal(raw, &date) == nil {
+			parsed, err := time.Parse(time.DateOnly, date)
+			if err == nil {
+				value = parsed.Format(time.DateOnly)
+			}
+		}
+		issue = ErrCustomFieldDateValue
+	case CustomFieldSingleSelect:
+		var option string
+		if json.Unmarshal(raw, &option) == nil && field.Options.Contains(option) {
+			value = option
+		}
+		issue = ErrCustomFieldOptionValue
+	case CustomFieldMultiSelect:
+		var options []string
+		if json.Unmarshal(raw, &options) == nil && len(options) > 0 && isOptionSubset(field.Options, options) {
+			value = options
+		}
+		issue = ErrCustomFieldOptionsValue
+	case CustomFieldCheckbox:
+		var checked *bool
+		if json.Unmarshal(raw, &checked) == nil && checked != nil {
+			value = *checked
+		}
+		issue = ErrCustomFieldCheckboxValue
+	case CustomFieldUser:
+		var rawUserID string
+		if json.Unmarshal(raw, &rawUserID) == nil {
+			userID, err := ParseUserID(rawUserID)
+			if err == nil {
+				value = userID.String()
+			}
+		}
+		issue = ErrCustomFieldUserValue
+	}
+	if value == nil {
+		return CustomFieldValue{}, &errValidation{Issues: []string{issue}}
+	}
+
+	canonical, err := json.Marshal(value)
+	if err != nil {
+		return CustomFieldValue{}, &errValidation{Issues: []string{issue}}
+	}
+
+	return CustomFieldValue{raw: canonical}, nil
+}
+
+// ParseCustomFieldFilterValue parses a value given as text, like a query parameter, into the
+// value a task must hold to match it. A multi select task matches when it holds the option.
+func ParseCustomFieldFilterValue(field *CustomField, text string) (CustomFieldValue, error) {
+	var value any = text
+	switch field.Type {
+	case CustomFieldNumber:
+		number, err := strconv.ParseFloat(text, 64)
+		if err != nil || math.IsInf(number, 0) || math.IsNaN(number) {
+			return CustomFieldValue{}, &errValidation{Issues: []string{ErrCustomFieldNumberValue}}
+		}
+		value = number
+	case CustomFieldMultiSelect:
+		value = []string{text}
+	case CustomFieldCheckbox:
+		checked, err := strconv.ParseBool(text)
+		if err != nil {
+			return CustomFieldValue{}, &errValidation{Issues: []string{ErrCustomFieldCheckboxValue}}
+		}
+		value = checked
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return CustomFieldValue{}, fmt.Errorf("marshal custom field filter value: %w", err)
+	}
+
+	return NewCustomFieldValue(field, raw)
+}
+
+func isOptionSubset(options CustomFieldOptions, values []string) bool {
+	for i, value := range values {
+		if !options.Contains(value) || slices.Contains(values[:i], value) {
+			return false
+		}
+	}
+	return true
+}
+
+func (v CustomFieldValue) JSON() json.RawMessage {
+	return slices.Clone(v.raw)
+}
+
+// TaskCustomFields are the custom field values of a task by field. Fields without a value are absent.
+// The same shape filters tasks: a task matches when it holds every value, and for multi select
+// fields every option, of the filter.
+type TaskCustomFields struct {
+	values map[CustomFieldID]CustomFieldValue
+}
+
+// ParseTaskCustomFields decodes stored values, a JSON object keyed by field id. The values were
+// validated against their fields when they were written.
+func ParseTaskCustomFields(raw []byte) (TaskCustomFields, error) {
+	var rawValues map[string]json.RawMessage
+	err := json.Unmarshal(raw, &rawValues)
+	if err != nil {
+		return TaskCustomFields{}, fmt.Errorf("unmarshal task custom fields: %w", err)
+	}
+
+	var fields TaskCustomFields
+	for rawID, rawValue := range rawValues {
+		id, parseErr := ParseCustomFieldID(rawID)
+		if parseErr != nil {
+			return TaskCustomFields{}, fmt.Errorf("task custom fields: %w", parseErr)
+		}
+		fields = fields.With(id, CustomFieldValue{raw: rawValue})
+	}
+
+	return fields, nil
+}
+
+// With returns a copy of the values with the value of the field set.
+func (f TaskCustomFields) With(id CustomFieldID, value CustomFieldValue) TaskCustomFields {
+	values := maps.Clone(f.values)
+	if values == nil {
+		values = make(map[CustomFieldID]CustomFieldValue)
+	}
+	values[id] = value
+	return TaskCustomFields{values: values}
+}
+
+// Without returns a copy of the values with the value of the field removed.
+func (f TaskCustomFields) Without(id CustomFieldID) TaskCustomFields {
+	values := maps.Clone(f.values)
+	delete(values, id)
+	return TaskCustomFields{values: values}
+}
+
+func (f TaskCustomFields) Get(id CustomFieldID) (CustomFieldValue, bool) {
+	value, ok := f.values[id]
+	return value, ok
+}
+
+func (f TaskCustomFields) Len() int {
+	return len(f.values)
+}
+
+// JSON returns the values as a JSON object keyed by field id.
+func (f TaskCustomFields) JSON() map[string]json.RawMessage {
+	values := make(map[string]json.RawMessage, len(f.values))
+	for id, value := range f.values {
+		values[id.String()] = value.JSON()
+	}
+	return values
+}
+
+func (f TaskCustomFields) Value() (driver.Value, error) {
+	raw, err := json.Marshal(f.JSON())
+	if err != nil {
+		return nil, fmt.Errorf("marshal task custom fields: %w", err)
+	}
+
+	return string(raw), nil
+}
@@ -0,0 +1,219 @@
+package domain_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func newCustomField(t *testing.T, fieldType domain.CustomFieldType, options ...string) domain.CustomField {
+	t.Helper()
+
+	fieldOptions, err := domain.NewCustomFieldOptions(options)
+	if err != nil {
+		t.Fatalf("NewCustomFieldOptions() error = %v", err)
+	}
+
+	return domain.CustomField{ID: domain.NewCustomFieldID(), Type: fieldType, Options: fieldOptions}
+}
+
+func TestNewCustomFieldOptions(t *testing.T) {
+	t.Parallel()
+
+	tooMany := make([]string, 51)
+	for i := range tooMany {
+		tooMany[i] = string(rune('A' + i))
+	}
+
+	tests := []struct {
+		name       string
+		options    []string
+		want       []string
+		wantIssues []string
+	}{
+		{name: "Trimmed", options: []string{" prod ", "staging"}, want: []string{"prod", "staging"}},
+		{name: "Empty", options: nil, want: []string{}},
+		{name: "Blank option", options: []string{"prod", " "}, wantIssues: []string{domain.ErrCustomFieldOptionText}},
+		{name: "Duplicate after trimming", options: []string{"prod", "prod "}, wantIssues: []string{domain.ErrCustomFieldOptionDuplicate}},
+		{name: "Too many", options: tooMany, wantIssues: []string{domain.ErrCustomFieldOptionsTooLong}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			options, err := domain.NewCustomFieldOptions(tt.options)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			} else if diff := cmp.Diff(tt.want, options.Strings()); diff != "" {
+				t.Errorf("got options mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckCustomFieldOptions(t *testing.T) {
+	t.Parallel()
+
+	options, err := domain.NewCustomFieldOptions([]string{"prod"})
+	if err != nil {
+		t.Fatalf("NewCustomFieldOptions() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		fieldType  domain.CustomFieldType
+		options    domain.CustomFieldOptions
+		wantIssues []string
+	}{
+		{name: "Select with options", fieldType: domain.CustomFieldMultiSelect, options: options},
+		{name: "Text without options", fieldType: domain.CustomFieldText},
+		{name: "Select without options", fieldType: domain.CustomFieldSingleSelect, wantIssues: []string{domain.ErrCustomFieldOptionsRequired}},
+		{name: "Number with options", fieldType: domain.CustomFieldNumber, options: options, wantIssues: []string{domain.ErrCustomFieldOptionsForbidden}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotIssues []string
+			err := domain.CheckCustomFieldOptions(tt.fieldType, tt.options)
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewCustomFieldValue(t *testing.T) {
+	t.Parallel()
+
+	userID := domain.NewUserID()
+
+	tests := []struct {
+		name       string
+		field      domain.CustomField
+		raw        string
+		want       string
+		wantIssues []string
+	}{
+		{name: "Text is trimmed", field: newCustomField(t, domain.CustomFieldText), raw: `"  ACME  "`, want: `"ACME"`},
+		{name: "Blank text", field: newCustomField(t, domain.CustomFieldText), raw: `" "`, wantIssues: []string{domain.ErrCustomFieldTextValue}},
+		{name: "Number", field: newCustomField(t, domain.CustomFieldNumber), raw: `2.50`, want: `2.5`},
+		{name: "Number as string", field: newCustomField(t, domain.CustomFieldNumber), raw: `"2"`, wantIssues: []string{domain.ErrCustomFieldNumberValue}},
+		{name: "Null number", field: newCustomField(t, domain.CustomFieldNumber), raw: `null`, wantIssues: []string{domain.ErrCustomFieldNumberValue}},
+		{name: "Date", field: newCustomField(t, domain.CustomFieldDate), raw: `"2026-10-19"`, want: `"2026-10-19"`},
+		{name: "Date with time", field: newCustomField(t, domain.CustomFieldDate), raw: `"2026-10-19T10:00:00Z"`, wantIssues: []string{domain.ErrCustomFieldDateValue}},
+		{name: "Single select", field: newCustomField(t, domain.CustomFieldSingleSelect, "prod", "staging"), raw: `"staging"`, want: `"staging"`},
+		{name: "Unknown option", field: newCustomField(t, domain.CustomFieldSingleSelect, "prod"), raw: `"dev"`, wantIssues: []string{domain.ErrCustomFieldOptionValue}},
+		{name: "Multi select", field: newCustomField(t, domain.CustomFieldMultiSelect, "ios", "android", "web"), raw: `["web","ios"]`, want: `["web","ios"]`},
+		{name: "Repeated options", field: newCustomField(t, domain.CustomFieldMultiSelect, "ios"), raw: `["ios","ios"]`, wantIssues: []string{domain.ErrCustomFieldOptionsValue}},
+		{name: "No options", field: newCustomField(t, domain.CustomFieldMultiSelect, "ios"), raw: `[]`, wantIssues: []string{domain.ErrCustomFieldOptionsValue}},
+		{name: "Checkbox", field: newCustomField(t, domain.CustomFieldCheckbox), raw: `false`, want: `false`},
+		{name: "Checkbox as string", field: newCustomField(t, domain.CustomFieldCheckbox), raw: `"true"`, wantIssues: []string{domain.ErrCustomFieldCheckboxValue}},
+		{name: "User", field: newCustomField(t, domain.CustomFieldUser), raw: `"` + userID.String() + `"`, want: `"` + userID.String() + `"`},
+		{name: "Invalid user", field: newCustomField(t, domain.CustomFieldUser), raw: `"alice"`, wantIssues: []string{domain.ErrCustomFieldUserValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := domain.NewCustomFieldValue(&tt.field, json.RawMessage(tt.raw))
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			} else if got := string(value.JSON()); got != tt.want {
+				t.Errorf("got value %s, want %s", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestParseCustomFieldFilterValue(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		field      domain.CustomField
+		text       string
+		want       string
+		wantIssues []string
+	}{
+		{name: "Text", field: newCustomField(t, domain.CustomFieldText), text: "ACME", want: `"ACME"`},
+		{name: "Number", field: newCustomField(t, domain.CustomFieldNumber), text: "3", want: `3`},
+		{name: "Invalid number", field: newCustomField(t, domain.CustomFieldNumber), text: "three", wantIssues: []string{domain.ErrCustomFieldNumberValue}},
+		{name: "Multi select holds the option", field: newCustomField(t, domain.CustomFieldMultiSelect, "ios"), text: "ios", want: `["ios"]`},
+		{name: "Checkbox", field: newCustomField(t, domain.CustomFieldCheckbox), text: "true", want: `true`},
+		{name: "Invalid checkbox", field: newCustomField(t, domain.CustomFieldCheckbox), text: "yes", wantIssues: []string{domain.ErrCustomFieldCheckboxValue}},
+		{name: "Invalid date", field: newCustomField(t, domain.CustomFieldDate), text: "19.10.2026", wantIssues: []string{domain.ErrCustomFieldDateValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			value, err := domain.ParseCustomFieldFilterValue(&tt.field, tt.text)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			} else if got := string(value.JSON()); got != tt.want {
+				t.Errorf("got value %s, want %s", got, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestTaskCustomFields(t *testing.T) {
+	t.Parallel()
+
+	field := newCustomField(t, domain.CustomFieldText)
+	value, err := domain.NewCustomFieldValue(&field, json.RawMessage(`"ACME"`))
+	if err != nil {
+		t.Fatalf("NewCustomFieldValue() error = %v", err)
+	}
+
+	var empty domain.TaskCustomFields
+	fields := empty.With(field.ID, value)
+	if empty.Len() != 0 || fields.Len() != 1 {
+		t.Fatalf("got %d and %d values, want With() to copy the values", empty.Len(), fields.Len())
+	}
+
+	stored, err := fields.Value()
+	if err != nil {
+		t.Fatalf("Value() error = %v", err)
+	}
+	parsed, err := domain.ParseTaskCustomFields([]byte(stored.(string)))
+	if err != nil {
+		t.Fatalf("ParseTaskCustomFields() error = %v", err)
+	}
+	got, ok := parsed.Get(field.ID)
+	if !ok || string(got.JSON()) != `"ACME"` {
+		t.Errorf("got value %s, want %q", got.JSON(), "ACME")
+	}
+
+	if without := parsed.Without(field.ID); without.Len() != 0 || parsed.Len() != 1 {
+		t.Errorf("got %d and %d values, want Without() to copy the values", without.Len(), parsed.Len())
+	}
+
+	_, err = domain.ParseTaskCustomFields([]byte(`{"customer": "ACME"}`))
+	if err == nil {
+		t.Error("ParseTaskCustomFields() error = nil, want an error for a key that is not a field id")
+	}
+}
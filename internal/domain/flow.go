@@ -0,0 +1,139 @@
+package domain
+
+import "time"
+
+const (
+	ErrFlowIntervalValue    = "Interval must be day or week"
+	ErrFlowDateRequired     = "Date is required"
+	ErrFlowRangeTooLong     = "Range has too many points"
+	maxCumulativeFlowPoints = 366
+)
+
+// FlowInterval is the step between two points of a flow chart.
+type FlowInterval string
+
+const (
+	FlowIntervalDay  FlowInterval = "day"
+	FlowIntervalWeek FlowInterval = "week"
+)
+
+func NewFlowInterval(interval string) (FlowInterval, error) {
+	switch i := FlowInterval(interval); i {
+	case FlowIntervalDay, FlowIntervalWeek:
+		return i, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrFlowIntervalValue}}
+	}
+}
+
+func (i FlowInterval) String() string {
+	return string(i)
+}
+
+// Days is the length of the interval in days.
+func (i FlowInterval) Days() int {
+	if i == FlowIntervalWeek {
+		return 7
+	}
+	return 1
+}
+
+// ParseFlowDate parses a required date in YYYY-MM-DD format.
+func ParseFlowDate(date string) (time.Time, error) {
+	if date == "" {
+		return time.Time{}, &errValidation{Issues: []string{ErrFlowDateRequired}}
+	}
+
+	return ParseBurndownDate(date)
+}
+
+// ValidateFlowRange checks that the range does not end before it starts and that stepping
+// through it by interval gives at most 366 points.
+func ValidateFlowRange(from, to time.Time, interval FlowInterval) error {
+	if to.Before(from) {
+		return &errValidation{Issues: []string{ErrBurndownToBeforeFrom}}
+	}
+
+	days := int(to.Sub(from).Hours() / 24)
+	if days/interval.Days()+1 > maxCumulativeFlowPoints {
+		return &errValidation{Issues: []string{ErrFlowRangeTooLong}}
+	}
+
+	return nil
+}
+
+// CumulativeFlow is the number of tasks in each column of a board at the end of every
+// interval step from From through To. Tasks are tracked by column identity, so renamed and
+// reordered columns keep their history.
+type CumulativeFlow struct {
+	BoardID  BoardID
+	Interval FlowInterval
+	From     time.Time
+	To       time.Time
+	Columns  []Column // Current columns of the board in board order.
+	Points   []CumulativeFlowPoint
+}
+
+// CumulativeFlowPoint is the state of a board at the end of a UTC day.
+type CumulativeFlowPoint struct {
+	Day    time.Time
+	Counts []int64 // Task counts aligned with CumulativeFlow.Columns.
+}
+
+// CumulativeFlowCount is the number of tasks in a column at the end of a UTC day.
+type CumulativeFlowCount struct {
+	Day      time.Time
+	ColumnID ColumnID
+	Count    int64
+}
+
+// CycleTimeReport is how long the tasks of a board that were finished from From through To
+// took. A task is started when it first enters a started or done column and finished when it
+// last enters a done column from a column that is not done.
+type CycleTimeReport struct {
+	BoardID   BoardID
+	From      time.Time
+	To        time.Time
+	LeadTime  DurationPercentiles // From creation to done.
+	CycleTime DurationPercentiles // From start to done.
+	Tasks     []CompletedTask     // In order of completion.
+}
+
+// DurationPercentiles are the 50th, 85th and 95th percentiles of a set of durations.
+type DurationPercentiles struct {
+	P50 time.Duration
+	P85 time.Duration
+	P95 time.Duration
+}
+
+// CompletedTask is a task that currently sits in a done column.
+type CompletedTask struct {
+	ID        TaskID
+	Name      TaskName
+	CreatedAt time.Time
+	StartedAt time.Time
+	DoneAt    time.Time
+}
+
+func (t CompletedTask) LeadTime() time.Duration {
+	return t.DoneAt.Sub(t.CreatedAt)
+}
+
+func (t CompletedTask) CycleTime() time.Duration {
+	return t.DoneAt.Sub(t.StartedAt)
+}
+
+// ThroughputReport is the number of tasks of a board finished in every week from the week of
+// From through the week of To. Only tasks finished from From through To are counted.
+type ThroughputReport struct {
+	BoardID BoardID
+	From    time.Time
+	To      time.Time
+	Weeks   []ThroughputWeek
+}
+
+// ThroughputWeek is the number of tasks finished in the week starting on Monday Start.
+type ThroughputWeek struct {
+	Start     time.Time
+	Completed int64
+}
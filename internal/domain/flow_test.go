@@ -0,0 +1,145 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestNewFlowInterval(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		wantDays   int
+	}{
+		{name: "Day", input: "day", wantDays: 1},
+		{name: "Week", input: "week", wantDays: 7},
+		{name: "Empty", input: "", wantIssues: []string{domain.ErrFlowIntervalValue}},
+		{name: "Unknown", input: "month", wantIssues: []string{domain.ErrFlowIntervalValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.NewFlowInterval(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues == nil && got.Days() != tt.wantDays {
+				t.Errorf("got %d days, want %d", got.Days(), tt.wantDays)
+			}
+		})
+	}
+}
+
+func TestParseFlowDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       time.Time
+	}{
+		{name: "Valid", input: "2026-01-05", want: time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)},
+		{name: "Empty", input: "", wantIssues: []string{domain.ErrFlowDateRequired}},
+		{name: "Not a date", input: "05.01.2026", wantIssues: []string{domain.ErrBurndownDateInvalid}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.ParseFlowDate(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("got date %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFlowRange(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		from       time.Time
+		to         time.Time
+		interval   domain.FlowInterval
+		wantIssues []string
+	}{
+		{name: "Single day", from: day, to: day, interval: domain.FlowIntervalDay},
+		{name: "Longest daily range", from: day, to: day.AddDate(0, 0, 365), interval: domain.FlowIntervalDay},
+		{
+			name:       "Daily range too long",
+			from:       day,
+			to:         day.AddDate(0, 0, 366),
+			interval:   domain.FlowIntervalDay,
+			wantIssues: []string{domain.ErrFlowRangeTooLong},
+		},
+		{name: "Weekly range over a year", from: day, to: day.AddDate(3, 0, 0), interval: domain.FlowIntervalWeek},
+		{
+			name:       "End before start",
+			from:       day,
+			to:         day.AddDate(0, 0, -1),
+			interval:   domain.FlowIntervalDay,
+			wantIssues: []string{domain.ErrBurndownToBeforeFrom},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := domain.ValidateFlowRange(tt.from, tt.to, tt.interval)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompletedTask_Durations(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2026, time.January, 5, 9, 0, 0, 0, time.UTC)
+	task := domain.CompletedTask{
+		CreatedAt: created,
+		StartedAt: created.Add(24 * time.Hour),
+		DoneAt:    created.Add(60 * time.Hour),
+	}
+
+	if got := task.LeadTime(); got != 60*time.Hour {
+		t.Errorf("got lead time %v, want 60h", got)
+	}
+	if got := task.CycleTime(); got != 36*time.Hour {
+		t.Errorf("got cycle time %v, want 36h", got)
+	}
+}
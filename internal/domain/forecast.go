@@ -0,0 +1,278 @@
+package domain
+
+import (
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+)
+
+const (
+	ErrForecastTargetRequired    = "Either tasks or targetDate is required"
+	ErrForecastTargetConflict    = "Only one of tasks or targetDate can be set"
+	ErrForecastTasksValue        = "Tasks must be between 1 and 10000"
+	ErrForecastTargetDateRange   = "Target date must be after today and at most 730 days ahead"
+	ErrForecastSimulationsValue  = "Simulations must be between 100 and 10000"
+	ErrForecastHistoryDaysValue  = "History days must be between 7 and 365"
+	ErrForecastConfidenceValue   = "Confidence levels must be between 1 and 99"
+	ErrForecastConfidenceTooMany = "At most 10 confidence levels are allowed"
+	ErrForecastSeedValue         = "Seed must be between 0 and 9007199254740991"
+)
+
+const (
+	maxForecastTasks       = 10000
+	minForecastSimulations = 100
+	maxForecastSimulations = 10000
+	minForecastHistoryDays = 7
+	maxForecastHistoryDays = 365
+	maxForecastConfidences = 10
+	// MaxForecastHorizonDays bounds how far a simulation runs, so a request draws at most
+	// maxForecastSimulations * MaxForecastHorizonDays days of throughput.
+	MaxForecastHorizonDays = 730
+	// MaxForecastSeed is the largest integer a JSON client can echo back without losing precision.
+	MaxForecastSeed = 1<<53 - 1
+)
+
+const (
+	DefaultForecastSimulations = 5000
+	DefaultForecastHistoryDays = 90
+)
+
+// DefaultForecastConfidences are the confidence levels reported when none are requested.
+var DefaultForecastConfidences = []int64{50, 85, 95}
+
+// ForecastTaskCount is the number of tasks whose completion date is forecast.
+type ForecastTaskCount struct {
+	value int64
+}
+
+func NewForecastTaskCount(tasks int64) (ForecastTaskCount, error) {
+	if tasks < 1 || tasks > maxForecastTasks {
+		return ForecastTaskCount{}, &errValidation{Issues: []string{ErrForecastTasksValue}}
+	}
+
+	return ForecastTaskCount{value: tasks}, nil
+}
+
+func (c ForecastTaskCount) Int64() int64 {
+	return c.value
+}
+
+// ForecastTarget is the question a forecast answers: when Tasks more tasks will be done, or
+// how many tasks will be done by the end of Date. Exactly one of them is set.
+type ForecastTarget struct {
+	Tasks ForecastTaskCount
+	Date  time.Time
+}
+
+func NewForecastTarget(tasks ForecastTaskCount, date time.Time) (ForecastTarget, error) {
+	switch {
+	case tasks.value == 0 && date.IsZero():
+		return ForecastTarget{}, &errValidation{Issues: []string{ErrForecastTargetRequired}}
+	case tasks.value != 0 && !date.IsZero():
+		return ForecastTarget{}, &errValidation{Issues: []string{ErrForecastTargetConflict}}
+	}
+
+	return ForecastTarget{Tasks: tasks, Date: date}, nil
+}
+
+// IsDate reports whether the target asks how many tasks will be done by a date.
+func (t ForecastTarget) IsDate() bool {
+	return !t.Date.IsZero()
+}
+
+// ValidateForecastTargetDate checks that a date target lies after today and within the
+// simulation horizon. Task count targets are always valid.
+func ValidateForecastTargetDate(target ForecastTarget, today time.Time) error {
+	if !target.IsDate() {
+		return nil
+	}
+	if !target.Date.After(today) || target.Date.After(today.AddDate(0, 0, MaxForecastHorizonDays)) {
+		return &errValidation{Issues: []string{ErrForecastTargetDateRange}}
+	}
+
+	return nil
+}
+
+// ForecastSimulations is the number of Monte Carlo runs of a forecast.
+type ForecastSimulations struct {
+	value int64
+}
+
+func NewForecastSimulations(simulations int64) (ForecastSimulations, error) {
+	if simulations < minForecastSimulations || simulations > maxForecastSimulations {
+		return ForecastSimulations{}, &errValidation{Issues: []string{ErrForecastSimulationsValue}}
+	}
+
+	return ForecastSimulations{value: simulations}, nil
+}
+
+func (s ForecastSimulations) Int64() int64 {
+	return s.value
+}
+
+// ForecastHistoryDays is the number of full days before today whose throughput is sampled.
+type ForecastHistoryDays struct {
+	value int64
+}
+
+func NewForecastHistoryDays(days int64) (ForecastHistoryDays, error) {
+	if days < minForecastHistoryDays || days > maxForecastHistoryDays {
+		return ForecastHistoryDays{}, &errValidation{Issues: []string{ErrForecastHistoryDaysValue}}
+	}
+
+	return ForecastHistoryDays{value: days}, nil
+}
+
+func (d ForecastHistoryDays) Int64() int64 {
+	return d.value
+}
+
+// ForecastConfidence is the share of simulations, in percent, that an outcome must cover.
+type ForecastConfidence struct {
+	value int64
+}
+
+// NewForecastConfidences validates confidence levels and returns them sorted without duplicates.
+func NewForecastConfidences(levels []int64) ([]ForecastConfidence, error) {
+	if len(levels) > maxForecastConfidences {
+		return nil, &errValidation{Issues: []string{ErrForecastConfidenceTooMany}}
+	}
+
+	sorted := slices.Clone(levels)
+	slices.Sort(sorted)
+	sorted = slices.Compact(sorted)
+
+	confidences := make([]ForecastConfidence, len(sorted))
+	for i, level := range sorted {
+		if level < 1 || level > 99 {
+			return nil, &errValidation{Issues: []string{ErrForecastConfidenceValue}}
+		}
+		confidences[i] = ForecastConfidence{value: level}
+	}
+
+	return confidences, nil
+}
+
+func (c ForecastConfidence) Int64() int64 {
+	return c.value
+}
+
+// ForecastSeed seeds the random source of a forecast; the same seed and history give the same outcomes.
+type ForecastSeed struct {
+	value int64
+}
+
+func NewForecastSeed(seed int64) (ForecastSeed, error) {
+	if seed < 0 || seed > MaxForecastSeed {
+		return ForecastSeed{}, &errValidation{Issues: []string{ErrForecastSeedValue}}
+	}
+
+	return ForecastSeed{value: seed}, nil
+}
+
+func (s ForecastSeed) Int64() int64 {
+	return s.value
+}
+
+// ForecastSettings tune how a forecast is simulated.
+type ForecastSettings struct {
+	Simulations ForecastSimulations
+	HistoryDays ForecastHistoryDays
+	Confidences []ForecastConfidence
+	Seed        ForecastSeed
+}
+
+// Forecast is the outcome of simulating the future throughput of a board by drawing days at
+// random from its daily throughput from HistoryFrom through HistoryTo. Simulated days start
+// on Start, the day after the forecast was made.
+type Forecast struct {
+	BoardID     BoardID
+	Target      ForecastTarget
+	Settings    ForecastSettings
+	Start       time.Time
+	HistoryFrom time.Time
+	HistoryTo   time.Time
+	History     []int64 // Tasks finished on each day of the history.
+	Outcomes    []ForecastOutcome
+}
+
+// Completed is the number of tasks finished over the whole history.
+func (f Forecast) Completed() int64 {
+	var completed int64
+	for _, count := range f.History {
+		completed += count
+	}
+	return completed
+}
+
+// ForecastOutcome is the answer to the target with the given confidence. For a task count
+// target Date is the day by which the tasks are done, or zero when that takes longer than
+// the horizon; for a date target Tasks is the number of tasks done by then.
+type ForecastOutcome struct {
+	Confidence ForecastConfidence
+	Date       time.Time
+	Tasks      int64
+}
+
+// SimulateForecast runs the Monte Carlo simulations of settings, each drawing the throughput
+// of every day from start on at random from history, and answers target at every confidence
+// level. Simulations stop after MaxForecastHorizonDays days.
+func SimulateForecast(history []int64, start time.Time, target ForecastTarget, settings ForecastSettings) []ForecastOutcome {
+	runs := int(settings.Simulations.Int64())
+	seed := uint64(settings.Seed.Int64())
+	random := rand.New(rand.NewPCG(seed, seed))
+	productive := slices.ContainsFunc(history, func(count int64) bool { return count > 0 })
+
+	outcomes := make([]ForecastOutcome, len(settings.Confidences))
+	if target.IsDate() {
+		days := int(target.Date.Sub(start).Hours()/24) + 1
+		completed := make([]int64, runs)
+		for run := range completed {
+			if !productive {
+				break
+			}
+			for range days {
+				completed[run] += history[random.IntN(len(history))]
+			}
+		}
+		slices.Sort(completed)
+
+		// A count reached in confidence% of the runs is the one that (100 - confidence)% fall short of.
+		for i, confidence := range settings.Confidences {
+			index := int(math.Floor(float64(runs) * float64(100-confidence.value) / 100))
+			outcomes[i] = ForecastOutcome{Confidence: confidence, Tasks: completed[min(index, runs-1)]}
+		}
+		return outcomes
+	}
+
+	// daysNeeded holds the number of simulated days each run took, or MaxForecastHorizonDays + 1
+	// when it did not finish within the horizon.
+	daysNeeded := make([]int, runs)
+	for run := range daysNeeded {
+		if !productive {
+			daysNeeded[run] = MaxForecastHorizonDays + 1
+			continue
+		}
+		var done int64
+		day := 0
+		for done < target.Tasks.value && day < MaxForecastHorizonDays {
+			done += history[random.IntN(len(history))]
+			day++
+		}
+		if done < target.Tasks.value {
+			day = MaxForecastHorizonDays + 1
+		}
+		daysNeeded[run] = day
+	}
+	slices.Sort(daysNeeded)
+
+	for i, confidence := range settings.Confidences {
+		index := int(math.Ceil(float64(runs)*float64(confidence.value)/100)) - 1
+		outcomes[i] = ForecastOutcome{Confidence: confidence}
+		if days := daysNeeded[max(index, 0)]; days <= MaxForecastHorizonDays {
+			outcomes[i].Date = start.AddDate(0, 0, days-1)
+		}
+	}
+	return outcomes
+}
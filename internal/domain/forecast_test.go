@@ -0,0 +1,281 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/testutil"
+)
+
+func TestNewForecastTarget(t *testing.T) {
+	t.Parallel()
+
+	tasks, err := domain.NewForecastTaskCount(20)
+	if err != nil {
+		t.Fatalf("NewForecastTaskCount() error = %v", err)
+	}
+	date := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		tasks      domain.ForecastTaskCount
+		date       time.Time
+		wantIssues []string
+		wantIsDate bool
+	}{
+		{name: "Tasks", tasks: tasks},
+		{name: "Date", date: date, wantIsDate: true},
+		{name: "Neither", wantIssues: []string{domain.ErrForecastTargetRequired}},
+		{name: "Both", tasks: tasks, date: date, wantIssues: []string{domain.ErrForecastTargetConflict}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := domain.NewForecastTarget(tt.tasks, tt.date)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if got.IsDate() != tt.wantIsDate {
+				t.Errorf("got IsDate() %t, want %t", got.IsDate(), tt.wantIsDate)
+			}
+		})
+	}
+}
+
+func TestValidateForecastTargetDate(t *testing.T) {
+	t.Parallel()
+
+	today := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		date       time.Time
+		wantIssues []string
+	}{
+		{name: "Tomorrow", date: today.AddDate(0, 0, 1)},
+		{name: "End of horizon", date: today.AddDate(0, 0, domain.MaxForecastHorizonDays)},
+		{name: "Today", date: today, wantIssues: []string{domain.ErrForecastTargetDateRange}},
+		{name: "Beyond horizon", date: today.AddDate(0, 0, domain.MaxForecastHorizonDays+1), wantIssues: []string{domain.ErrForecastTargetDateRange}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			target, err := domain.NewForecastTarget(domain.ForecastTaskCount{}, tt.date)
+			if err != nil {
+				t.Fatalf("NewForecastTarget() error = %v", err)
+			}
+
+			err = domain.ValidateForecastTargetDate(target, today)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestForecastSettings_Validation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		validate   func() error
+		wantIssues []string
+	}{
+		{name: "Tasks too few", validate: func() error { _, err := domain.NewForecastTaskCount(0); return err }, wantIssues: []string{domain.ErrForecastTasksValue}},
+		{name: "Tasks too many", validate: func() error { _, err := domain.NewForecastTaskCount(10001); return err }, wantIssues: []string{domain.ErrForecastTasksValue}},
+		{name: "Simulations minimum", validate: func() error { _, err := domain.NewForecastSimulations(100); return err }},
+		{name: "Simulations too many", validate: func() error { _, err := domain.NewForecastSimulations(10001); return err }, wantIssues: []string{domain.ErrForecastSimulationsValue}},
+		{name: "History too short", validate: func() error { _, err := domain.NewForecastHistoryDays(6); return err }, wantIssues: []string{domain.ErrForecastHistoryDaysValue}},
+		{name: "History maximum", validate: func() error { _, err := domain.NewForecastHistoryDays(365); return err }},
+		{name: "Confidence out of range", validate: func() error { _, err := domain.NewForecastConfidences([]int64{50, 100}); return err }, wantIssues: []string{domain.ErrForecastConfidenceValue}},
+		{name: "Too many confidences", validate: func() error { _, err := domain.NewForecastConfidences(make([]int64, 11)); return err }, wantIssues: []string{domain.ErrForecastConfidenceTooMany}},
+		{name: "Negative seed", validate: func() error { _, err := domain.NewForecastSeed(-1); return err }, wantIssues: []string{domain.ErrForecastSeedValue}},
+		{name: "Largest seed", validate: func() error { _, err := domain.NewForecastSeed(domain.MaxForecastSeed); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotIssues []string
+			if err := tt.validate(); err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNewForecastConfidences_SortsAndDeduplicates(t *testing.T) {
+	t.Parallel()
+
+	confidences, err := domain.NewForecastConfidences([]int64{95, 50, 85, 50})
+	if err != nil {
+		t.Fatalf("NewForecastConfidences() error = %v", err)
+	}
+
+	got := make([]int64, len(confidences))
+	for i, confidence := range confidences {
+		got[i] = confidence.Int64()
+	}
+	if diff := cmp.Diff([]int64{50, 85, 95}, got); diff != "" {
+		t.Errorf("confidences mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSimulateForecast(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		history []int64
+		tasks   int64
+		date    time.Time
+		want    []domain.ForecastOutcome
+	}{
+		{
+			name:    "Steady throughput finishes tasks on a fixed day",
+			history: []int64{2, 2, 2},
+			tasks:   10,
+			want:    outcomesAt(start.AddDate(0, 0, 4), 0),
+		},
+		{
+			name:    "Steady throughput by date",
+			history: []int64{2, 2, 2},
+			date:    start.AddDate(0, 0, 4),
+			want:    outcomesAt(time.Time{}, 10),
+		},
+		{
+			name:    "No throughput never finishes",
+			history: []int64{0, 0, 0},
+			tasks:   1,
+			want:    outcomesAt(time.Time{}, 0),
+		},
+		{
+			name:    "No throughput by date",
+			history: []int64{0, 0, 0},
+			date:    start.AddDate(0, 0, 30),
+			want:    outcomesAt(time.Time{}, 0),
+		},
+		{
+			name:    "Beyond horizon",
+			history: []int64{1},
+			tasks:   int64(domain.MaxForecastHorizonDays + 1),
+			want:    outcomesAt(time.Time{}, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := domain.SimulateForecast(tt.history, start, newForecastTarget(t, tt.tasks, tt.date), newForecastSettings(t, 42))
+
+			if diff := cmp.Diff(tt.want, got, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("outcomes mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSimulateForecast_Seeded(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC)
+	history := []int64{0, 0, 1, 3, 0, 2, 5, 0, 1}
+	target := newForecastTarget(t, 0, start.AddDate(0, 0, 13))
+
+	first := domain.SimulateForecast(history, start, target, newForecastSettings(t, 7))
+	second := domain.SimulateForecast(history, start, target, newForecastSettings(t, 7))
+
+	if diff := cmp.Diff(first, second, testutil.CmpAllowUnexported()); diff != "" {
+		t.Errorf("same seed gave different outcomes (-first +second):\n%s", diff)
+	}
+	for i := 1; i < len(first); i++ {
+		if first[i].Tasks > first[i-1].Tasks {
+			t.Errorf("got %d tasks at %d%%, more than %d at %d%%",
+				first[i].Tasks, first[i].Confidence.Int64(), first[i-1].Tasks, first[i-1].Confidence.Int64())
+		}
+	}
+
+	byTasks := domain.SimulateForecast(history, start, newForecastTarget(t, 20, time.Time{}), newForecastSettings(t, 7))
+	for i := 1; i < len(byTasks); i++ {
+		if byTasks[i].Date.Before(byTasks[i-1].Date) {
+			t.Errorf("got date %v at %d%%, before %v at %d%%",
+				byTasks[i].Date, byTasks[i].Confidence.Int64(), byTasks[i-1].Date, byTasks[i-1].Confidence.Int64())
+		}
+	}
+}
+
+// outcomesAt builds the outcomes of the default confidence levels that all share date and tasks.
+func outcomesAt(date time.Time, tasks int64) []domain.ForecastOutcome {
+	confidences, _ := domain.NewForecastConfidences(domain.DefaultForecastConfidences)
+	outcomes := make([]domain.ForecastOutcome, len(confidences))
+	for i, confidence := range confidences {
+		outcomes[i] = domain.ForecastOutcome{Confidence: confidence, Date: date, Tasks: tasks}
+	}
+	return outcomes
+}
+
+func newForecastTarget(t *testing.T, tasks int64, date time.Time) domain.ForecastTarget {
+	t.Helper()
+
+	var count domain.ForecastTaskCount
+	if tasks != 0 {
+		var err error
+		count, err = domain.NewForecastTaskCount(tasks)
+		if err != nil {
+			t.Fatalf("NewForecastTaskCount() error = %v", err)
+		}
+	}
+	target, err := domain.NewForecastTarget(count, date)
+	if err != nil {
+		t.Fatalf("NewForecastTarget() error = %v", err)
+	}
+	return target
+}
+
+func newForecastSettings(t *testing.T, seed int64) domain.ForecastSettings {
+	t.Helper()
+
+	simulations, err := domain.NewForecastSimulations(domain.DefaultForecastSimulations)
+	if err != nil {
+		t.Fatalf("NewForecastSimulations() error = %v", err)
+	}
+	historyDays, err := domain.NewForecastHistoryDays(domain.DefaultForecastHistoryDays)
+	if err != nil {
+		t.Fatalf("NewForecastHistoryDays() error = %v", err)
+	}
+	confidences, err := domain.NewForecastConfidences(domain.DefaultForecastConfidences)
+	if err != nil {
+		t.Fatalf("NewForecastConfidences() error = %v", err)
+	}
+	forecastSeed, err := domain.NewForecastSeed(seed)
+	if err != nil {
+		t.Fatalf("NewForecastSeed() error = %v", err)
+	}
+
+	return domain.ForecastSettings{Simulations: simulations, HistoryDays: historyDays, Confidences: confidences, Seed: forecastSeed}
+}
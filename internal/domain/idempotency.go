@@ -0,0 +1,47 @@
+package domain
+
+const ErrIdempotencyKeyValue = "Idempotency key must be 1-255 printable ASCII characters"
+
+// IdempotencyKey is chosen by a client for one attempt at a write and sent again with every
+// retry of it, so the retries can be answered with the response of the attempt that went through.
+type IdempotencyKey struct {
+	value string
+}
+
+func NewIdempotencyKey(key string) (IdempotencyKey, error) {
+	if key == "" || len(key) > 255 {
+		return IdempotencyKey{}, &errValidation{Issues: []string{ErrIdempotencyKeyValue}}
+	}
+	for i := range len(key) {
+		if key[i] < ' ' || key[i] > '~' {
+			return IdempotencyKey{}, &errValidation{Issues: []string{ErrIdempotencyKeyValue}}
+		}
+	}
+
+	return IdempotencyKey{value: key}, nil
+}
+
+func (k IdempotencyKey) String() string {
+	return k.value
+}
+
+// IdempotentRequest is a write sent with an idempotency key. The key only names attempts of the
+// same user on the same Route, and Fingerprint tells a retry from another request reusing the key.
+// Attempt is random for every run of the request and owns the lock on the key while it runs, so a
+// run whose lock expired can't release the lock of the retry that took it over.
+type IdempotentRequest struct {
+	UserID      UserID
+	Route       string
+	Key         IdempotencyKey
+	Fingerprint string
+	Attempt     string
+}
+
+// IdempotentResponse is what a write with an idempotency key answered, kept to be replayed to
+// its retries. Header only holds the headers that describe the result, such as Location.
+type IdempotentResponse struct {
+	Fingerprint string
+	StatusCode  int
+	Header      map[string]string
+	Body        []byte
+}
@@ -0,0 +1,49 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestIdempotencyKey(t *testing.T) {
+	t.Parallel()
+
+	borderlineLongKey := strings.Repeat("a", 255)
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		wantValue  string
+	}{
+		{name: "Valid UUID", input: "018e1000-0000-7000-8000-000000000001", wantValue: "018e1000-0000-7000-8000-000000000001"},
+		{name: "Valid with spaces and symbols", input: "create task #1: retry", wantValue: "create task #1: retry"},
+		{name: "Long valid", input: borderlineLongKey, wantValue: borderlineLongKey},
+		{name: "Too long", input: borderlineLongKey + "a", wantIssues: []string{domain.ErrIdempotencyKeyValue}},
+		{name: "Empty", input: "", wantIssues: []string{domain.ErrIdempotencyKeyValue}},
+		{name: "Control character", input: "key\n", wantIssues: []string{domain.ErrIdempotencyKeyValue}},
+		{name: "Non-ASCII", input: "ключ", wantIssues: []string{domain.ErrIdempotencyKeyValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			key, err := domain.NewIdempotencyKey(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if key.String() != tt.wantValue {
+				t.Errorf("got value %q, want %q", key.String(), tt.wantValue)
+			}
+		})
+	}
+}
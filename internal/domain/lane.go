@@ -0,0 +1,87 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrLaneNameTooShort  = "Name is too short"
+	ErrLaneNameTooLong   = "Name is too long"
+	ErrLanePositionValue = "Position is invalid"
+)
+
+// Lane is a horizontal swimlane of a board. Together with a column it forms the cell a task sits in.
+type Lane struct {
+	ID        LaneID
+	BoardID   BoardID
+	Name      LaneName
+	Position  LanePosition
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type (
+	laneTag struct{}
+	LaneID  = UUID[laneTag]
+)
+
+func NewLaneID() LaneID {
+	return newID[laneTag]()
+}
+
+func ParseLaneID(s string) (LaneID, error) {
+	return parseID[laneTag](s)
+}
+
+func NewLaneIDFromUUID(u uuid.UUID) (LaneID, error) {
+	return newIDFromUUID[laneTag](u)
+}
+
+type LaneName struct {
+	value string
+}
+
+func NewLaneName(name string) (LaneName, error) {
+	trimmedName := strings.TrimSpace(name)
+	var issues []string
+	if trimmedName == "" {
+		issues = append(issues, ErrLaneNameTooShort)
+	}
+	if len(trimmedName) > 128 {
+		issues = append(issues, ErrLaneNameTooLong)
+	}
+	if len(issues) > 0 {
+		return LaneName{}, &errValidation{Issues: issues}
+	}
+
+	return LaneName{value: trimmedName}, nil
+}
+
+func (n LaneName) String() string {
+	return n.value
+}
+
+type LanePosition struct {
+	value int32
+}
+
+func NewLanePosition(position int64) (LanePosition, error) {
+	if position <= 0 || position > math.MaxInt32 {
+		return LanePosition{}, &errValidation{Issues: []string{ErrLanePositionValue}}
+	}
+
+	return LanePosition{value: int32(position)}, nil
+}
+
+func (p LanePosition) Int64() int64 {
+	return int64(p.value)
+}
+
+func (p LanePosition) Value() (driver.Value, error) {
+	return p.value, nil
+}
@@ -0,0 +1,236 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrRecurrenceRuleInvalid   = "Rule is invalid"
+	ErrRecurrenceCatchUpValue  = "Catch-up policy is invalid"
+	ErrRecurrenceStartsAtValue = "Start time is invalid"
+)
+
+// Recurrence materializes a new task in ColumnID every time Rule fires.
+// Occurrences happen at the StartsAt time of day (UTC) and never before StartsAt.
+type Recurrence struct {
+	ID          RecurrenceID
+	BoardID     BoardID
+	ColumnID    ColumnID
+	Name        TaskName
+	Description TaskDescription
+	Rule        RecurrenceRule
+	StartsAt    time.Time
+	CatchUp     RecurrenceCatchUp
+	NextRunAt   time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Plan decides what the scheduler does with the recurrence at now. An occurrence
+// that is more than grace late is considered missed and is handled by CatchUp.
+// Missed occurrences never produce more than one task.
+func (r *Recurrence) Plan(now time.Time, grace time.Duration) (materialize bool, nextRunAt time.Time) {
+	if r.NextRunAt.After(now) {
+		return false, r.NextRunAt
+	}
+
+	nextRunAt = r.Rule.Next(r.StartsAt, now)
+	if now.Sub(r.NextRunAt) <= grace {
+		return true, nextRunAt
+	}
+
+	return r.CatchUp == RecurrenceCatchUpCreateOnce, nextRunAt
+}
+
+type (
+	recurrenceTag struct{}
+	RecurrenceID  = UUID[recurrenceTag]
+)
+
+func NewRecurrenceID() RecurrenceID {
+	return newID[recurrenceTag]()
+}
+
+func ParseRecurrenceID(s string) (RecurrenceID, error) {
+	return parseID[recurrenceTag](s)
+}
+
+func NewRecurrenceIDFromUUID(u uuid.UUID) (RecurrenceID, error) {
+	return newIDFromUUID[recurrenceTag](u)
+}
+
+// NewRecurrenceStartsAt truncates startsAt to seconds in UTC.
+func NewRecurrenceStartsAt(startsAt time.Time) (time.Time, error) {
+	if startsAt.IsZero() {
+		return time.Time{}, &errValidation{Issues: []string{ErrRecurrenceStartsAtValue}}
+	}
+
+	return startsAt.UTC().Truncate(time.Second), nil
+}
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+// maxRecurrenceScanDays bounds the search for the next occurrence. The longest gap
+// between two occurrences is two months (monthly on day 31).
+const maxRecurrenceScanDays = 400
+
+var rruleWeekdays = []string{"SU", "MO", "TU", "WE", "TH", "FR", "SA"}
+
+// RecurrenceRule is a subset of RFC 5545 RRULE:
+//
+//	FREQ=DAILY
+//	FREQ=WEEKLY;BYDAY=MO,WE,FR
+//	FREQ=MONTHLY;BYMONTHDAY=15
+//
+// As in RFC 5545, months without the given day are skipped.
+type RecurrenceRule struct {
+	freq     RecurrenceFrequency
+	weekdays uint8 // Bit i is set when time.Weekday(i) is included.
+	monthDay int
+}
+
+func NewRecurrenceRule(rule string) (RecurrenceRule, error) {
+	invalid := &errValidation{Issues: []string{ErrRecurrenceRuleInvalid}}
+
+	rule = strings.ToUpper(strings.TrimSpace(rule))
+	rule = strings.TrimPrefix(rule, "RRULE:")
+
+	parts := make(map[string]string)
+	for part := range strings.SplitSeq(rule, ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok || value == "" {
+			return RecurrenceRule{}, invalid
+		}
+		if _, dup := parts[key]; dup {
+			return RecurrenceRule{}, invalid
+		}
+		parts[key] = value
+	}
+
+	r := RecurrenceRule{freq: RecurrenceFrequency(parts["FREQ"])}
+	switch r.freq {
+	case RecurrenceDaily:
+		if len(parts) != 1 {
+			return RecurrenceRule{}, invalid
+		}
+	case RecurrenceWeekly:
+		byDay, ok := parts["BYDAY"]
+		if !ok || len(parts) != 2 {
+			return RecurrenceRule{}, invalid
+		}
+		for day := range strings.SplitSeq(byDay, ",") {
+			idx := slices.Index(rruleWeekdays, day)
+			if idx < 0 || r.weekdays&(1<<idx) != 0 {
+				return RecurrenceRule{}, invalid
+			}
+			r.weekdays |= 1 << idx
+		}
+	case RecurrenceMonthly:
+		byMonthDay, ok := parts["BYMONTHDAY"]
+		if !ok || len(parts) != 2 {
+			return RecurrenceRule{}, invalid
+		}
+		day, err := strconv.Atoi(byMonthDay)
+		if err != nil || day < 1 || day > 31 {
+			return RecurrenceRule{}, invalid
+		}
+		r.monthDay = day
+	default:
+		return RecurrenceRule{}, invalid
+	}
+
+	return r, nil
+}
+
+func (r RecurrenceRule) Frequency() RecurrenceFrequency {
+	return r.freq
+}
+
+func (r RecurrenceRule) String() string {
+	switch r.freq {
+	case RecurrenceWeekly:
+		// Canonical order is Monday first, as in ISO weeks.
+		var days []string
+		for i := range rruleWeekdays {
+			idx := (i + 1) % len(rruleWeekdays)
+			if r.weekdays&(1<<idx) != 0 {
+				days = append(days, rruleWeekdays[idx])
+			}
+		}
+		return "FREQ=WEEKLY;BYDAY=" + strings.Join(days, ",")
+	case RecurrenceMonthly:
+		return "FREQ=MONTHLY;BYMONTHDAY=" + strconv.Itoa(r.monthDay)
+	default:
+		return "FREQ=" + string(r.freq)
+	}
+}
+
+func (r RecurrenceRule) Value() (driver.Value, error) {
+	return r.String(), nil
+}
+
+// Next returns the first occurrence strictly after after, not earlier than startsAt.
+// The zero time is returned only for the zero rule.
+func (r RecurrenceRule) Next(startsAt, after time.Time) time.Time {
+	startsAt = startsAt.UTC()
+	after = after.UTC()
+	if after.Before(startsAt) {
+		after = startsAt.Add(-time.Nanosecond)
+	}
+
+	day := time.Date(after.Year(), after.Month(), after.Day(), startsAt.Hour(), startsAt.Minute(), startsAt.Second(), 0, time.UTC)
+	for range maxRecurrenceScanDays {
+		if day.After(after) && r.matches(day) {
+			return day
+		}
+		day = day.AddDate(0, 0, 1)
+	}
+
+	return time.Time{}
+}
+
+func (r RecurrenceRule) matches(day time.Time) bool {
+	switch r.freq {
+	case RecurrenceDaily:
+		return true
+	case RecurrenceWeekly:
+		return r.weekdays&(1<<day.Weekday()) != 0
+	case RecurrenceMonthly:
+		return day.Day() == r.monthDay
+	default:
+		return false
+	}
+}
+
+// RecurrenceCatchUp tells the scheduler what to do with occurrences missed during downtime.
+type RecurrenceCatchUp string
+
+const (
+	RecurrenceCatchUpSkip       RecurrenceCatchUp = "skip"
+	RecurrenceCatchUpCreateOnce RecurrenceCatchUp = "create_once"
+)
+
+func NewRecurrenceCatchUp(policy string) (RecurrenceCatchUp, error) {
+	switch p := RecurrenceCatchUp(policy); p {
+	case RecurrenceCatchUpSkip, RecurrenceCatchUpCreateOnce:
+		return p, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrRecurrenceCatchUpValue}}
+	}
+}
+
+func (p RecurrenceCatchUp) String() string {
+	return string(p)
+}
@@ -0,0 +1,192 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestRecurrenceRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       string
+	}{
+		{name: "Daily", input: "FREQ=DAILY", want: "FREQ=DAILY"},
+		{name: "Weekly canonical order", input: "rrule:freq=weekly;byday=fr,mo,su", want: "FREQ=WEEKLY;BYDAY=MO,FR,SU"},
+		{name: "Monthly", input: " FREQ=MONTHLY;BYMONTHDAY=31 ", want: "FREQ=MONTHLY;BYMONTHDAY=31"},
+		{name: "Empty", input: "", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+		{name: "Unknown frequency", input: "FREQ=YEARLY", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+		{name: "Daily with extra part", input: "FREQ=DAILY;INTERVAL=2", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+		{name: "Weekly without days", input: "FREQ=WEEKLY", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+		{name: "Weekly duplicate day", input: "FREQ=WEEKLY;BYDAY=MO,MO", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+		{name: "Weekly unknown day", input: "FREQ=WEEKLY;BYDAY=XX", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+		{name: "Monthly day out of range", input: "FREQ=MONTHLY;BYMONTHDAY=32", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+		{name: "Duplicate key", input: "FREQ=DAILY;FREQ=DAILY", wantIssues: []string{domain.ErrRecurrenceRuleInvalid}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := domain.NewRecurrenceRule(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues != nil {
+				return
+			}
+			if rule.String() != tt.want {
+				t.Errorf("got rule %q, want %q", rule.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRuleNext(t *testing.T) {
+	t.Parallel()
+
+	startsAt := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		rule  string
+		after time.Time
+		want  time.Time
+	}{
+		{
+			name:  "Daily before start",
+			rule:  "FREQ=DAILY",
+			after: time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC),
+			want:  startsAt,
+		},
+		{
+			name:  "Daily later today",
+			rule:  "FREQ=DAILY",
+			after: time.Date(2026, 10, 19, 8, 59, 59, 0, time.UTC),
+			want:  time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Daily exactly at occurrence",
+			rule:  "FREQ=DAILY",
+			after: time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 10, 20, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Weekly next weekday",
+			rule:  "FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			after: time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC), // Monday.
+			want:  time.Date(2026, 10, 21, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Weekly wraps week",
+			rule:  "FREQ=WEEKLY;BYDAY=MO",
+			after: time.Date(2026, 10, 23, 10, 0, 0, 0, time.UTC), // Friday.
+			want:  time.Date(2026, 10, 26, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Monthly skips short month",
+			rule:  "FREQ=MONTHLY;BYMONTHDAY=31",
+			after: time.Date(2026, 1, 31, 10, 0, 0, 0, time.UTC),
+			want:  time.Date(2026, 3, 31, 9, 0, 0, 0, time.UTC),
+		},
+		{
+			name:  "Non UTC after",
+			rule:  "FREQ=DAILY",
+			after: time.Date(2026, 10, 19, 11, 0, 0, 0, time.FixedZone("MSK", 3*60*60)),
+			want:  time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rule, err := domain.NewRecurrenceRule(tt.rule)
+			if err != nil {
+				t.Fatalf("NewRecurrenceRule() error = %v", err)
+			}
+
+			got := rule.Next(startsAt, tt.after)
+			if !got.Equal(tt.want) {
+				t.Errorf("got next %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrencePlan(t *testing.T) {
+	t.Parallel()
+
+	rule, err := domain.NewRecurrenceRule("FREQ=DAILY")
+	if err != nil {
+		t.Fatalf("NewRecurrenceRule() error = %v", err)
+	}
+	startsAt := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	nextRunAt := time.Date(2026, 10, 19, 9, 0, 0, 0, time.UTC)
+	grace := 10 * time.Minute
+
+	tests := []struct {
+		name            string
+		catchUp         domain.RecurrenceCatchUp
+		now             time.Time
+		wantMaterialize bool
+		wantNextRunAt   time.Time
+	}{
+		{
+			name:            "Not due",
+			catchUp:         domain.RecurrenceCatchUpSkip,
+			now:             nextRunAt.Add(-time.Second),
+			wantMaterialize: false,
+			wantNextRunAt:   nextRunAt,
+		},
+		{
+			name:            "On time",
+			catchUp:         domain.RecurrenceCatchUpSkip,
+			now:             nextRunAt.Add(grace),
+			wantMaterialize: true,
+			wantNextRunAt:   nextRunAt.AddDate(0, 0, 1),
+		},
+		{
+			name:            "Missed with skip",
+			catchUp:         domain.RecurrenceCatchUpSkip,
+			now:             nextRunAt.AddDate(0, 0, 3).Add(time.Hour),
+			wantMaterialize: false,
+			wantNextRunAt:   nextRunAt.AddDate(0, 0, 4),
+		},
+		{
+			name:            "Missed with create once",
+			catchUp:         domain.RecurrenceCatchUpCreateOnce,
+			now:             nextRunAt.AddDate(0, 0, 3).Add(time.Hour),
+			wantMaterialize: true,
+			wantNextRunAt:   nextRunAt.AddDate(0, 0, 4),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recurrence := domain.Recurrence{Rule: rule, StartsAt: startsAt, CatchUp: tt.catchUp, NextRunAt: nextRunAt}
+
+			materialize, gotNextRunAt := recurrence.Plan(tt.now, grace)
+			if materialize != tt.wantMaterialize {
+				t.Errorf("got materialize %t, want %t", materialize, tt.wantMaterialize)
+			}
+			if !gotNextRunAt.Equal(tt.wantNextRunAt) {
+				t.Errorf("got next run %v, want %v", gotNextRunAt, tt.wantNextRunAt)
+			}
+		})
+	}
+}
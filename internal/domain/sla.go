@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	taskTransitionTag struct{}
+	TaskTransitionID  = UUID[taskTransitionTag]
+)
+
+func NewTaskTransitionID() TaskTransitionID {
+	return newID[taskTransitionTag]()
+}
+
+func NewTaskTransitionIDFromUUID(u uuid.UUID) (TaskTransitionID, error) {
+	return newIDFromUUID[taskTransitionTag](u)
+}
+
+// SLABreach is a task that has stayed in its column longer than the column SLA and whose board
+// owner has not been notified yet. TransitionID is the transition that put the task into the
+// column, so a breach is reported once per stay.
+type SLABreach struct {
+	TransitionID TaskTransitionID
+	BoardID      BoardID
+	BoardName    BoardName
+	ColumnID     ColumnID
+	ColumnName   ColumnName
+	SLA          ColumnSLA
+	TaskID       TaskID
+	TaskName     TaskName
+	EnteredAt    time.Time
+	ChatID       TelegramChatID // Chat of the board owner.
+}
+
+// Message is the text of the notification sent to the board owner.
+func (b SLABreach) Message(now time.Time) string {
+	age := now.Sub(b.EnteredAt).Truncate(time.Hour)
+
+	return fmt.Sprintf(
+		"SLA breached on board %q: task %q has been in column %q for %s, over its %d hour SLA.",
+		b.BoardName.String(), b.TaskName.String(), b.ColumnName.String(), formatSLAAge(age), b.SLA.Hours(),
+	)
+}
+
+// formatSLAAge formats a whole number of hours as days and hours, such as "2d 5h".
+func formatSLAAge(age time.Duration) string {
+	hours := int64(age / time.Hour)
+	if hours < 24 {
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dd %dh", hours/24, hours%24)
+}
@@ -0,0 +1,65 @@
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+)
+
+func TestSLABreach_Message(t *testing.T) {
+	t.Parallel()
+
+	boardName, err := domain.NewBoardName("Platform")
+	if err != nil {
+		t.Fatalf("NewBoardName() error = %v", err)
+	}
+	columnName, err := domain.NewColumnName("Review")
+	if err != nil {
+		t.Fatalf("NewColumnName() error = %v", err)
+	}
+	taskName, err := domain.NewTaskName("Fix login")
+	if err != nil {
+		t.Fatalf("NewTaskName() error = %v", err)
+	}
+	sla, err := domain.NewColumnSLA(24)
+	if err != nil {
+		t.Fatalf("NewColumnSLA() error = %v", err)
+	}
+	enteredAt := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		age  time.Duration
+		want string
+	}{
+		{
+			name: "Days and hours",
+			age:  53*time.Hour + 40*time.Minute,
+			want: `SLA breached on board "Platform": task "Fix login" has been in column "Review" for 2d 5h, over its 24 hour SLA.`,
+		},
+		{
+			name: "Hours only",
+			age:  23*time.Hour + 59*time.Minute,
+			want: `SLA breached on board "Platform": task "Fix login" has been in column "Review" for 23h, over its 24 hour SLA.`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			breach := domain.SLABreach{
+				BoardName:  boardName,
+				ColumnName: columnName,
+				SLA:        sla,
+				TaskName:   taskName,
+				EnteredAt:  enteredAt,
+			}
+
+			if got := breach.Message(enteredAt.Add(tt.age)); got != tt.want {
+				t.Errorf("got message %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
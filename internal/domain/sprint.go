@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrSprintNameTooShort   = "Name is too short"
+	ErrSprintNameTooLong    = "Name is too long"
+	ErrSprintGoalTooLong    = "Goal is too long"
+	ErrSprintDateInvalid    = "Date is invalid"
+	ErrSprintCarryOverValue = "Carry-over target is invalid"
+	ErrSprintEndBeforeStart = "End date is before start date"
+)
+
+// Sprint is a time-boxed iteration of a board. Tasks join a sprint one by one; a task that
+// is in no sprint is in the backlog. A completed sprint is frozen together with its report.
+type Sprint struct {
+	ID          SprintID
+	BoardID     BoardID
+	Name        SprintName
+	Goal        SprintGoal
+	StartDate   time.Time
+	EndDate     time.Time
+	CompletedAt time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (s *Sprint) IsCompleted() bool {
+	return !s.CompletedAt.IsZero()
+}
+
+// SprintReport compares what the sprint committed to with what it completed. Committed
+// counts every task in the sprint, CarryOver lists the unfinished ones in board order.
+// For an open sprint the report is a preview of what completing it now would record.
+type SprintReport struct {
+	SprintID      SprintID
+	Committed     int
+	Completed     int
+	CompletedAt   time.Time
+	CarriedOverTo SprintID
+	CarryOver     []SprintCarryOverTask
+}
+
+// SprintCarryOverTask is a snapshot of an unfinished task; it outlives the task itself.
+type SprintCarryOverTask struct {
+	TaskID TaskID
+	Name   TaskName
+}
+
+// SprintCarryOver tells where the unfinished tasks of a completed sprint go.
+type SprintCarryOver string
+
+const (
+	SprintCarryOverNextSprint SprintCarryOver = "next_sprint"
+	SprintCarryOverBacklog    SprintCarryOver = "backlog"
+)
+
+func NewSprintCarryOver(target string) (SprintCarryOver, error) {
+	switch c := SprintCarryOver(target); c {
+	case SprintCarryOverNextSprint, SprintCarryOverBacklog:
+		return c, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrSprintCarryOverValue}}
+	}
+}
+
+func (c SprintCarryOver) String() string {
+	return string(c)
+}
+
+type (
+	sprintTag struct{}
+	SprintID  = UUID[sprintTag]
+)
+
+func NewSprintID() SprintID {
+	return newID[sprintTag]()
+}
+
+func ParseSprintID(s string) (SprintID, error) {
+	return parseID[sprintTag](s)
+}
+
+func NewSprintIDFromUUID(u uuid.UUID) (SprintID, error) {
+	return newIDFromUUID[sprintTag](u)
+}
+
+type SprintName struct {
+	value string
+}
+
+func NewSprintName(name string) (SprintName, error) {
+	trimmedName := strings.TrimSpace(name)
+	var issues []string
+	if trimmedName == "" {
+		issues = append(issues, ErrSprintNameTooShort)
+	}
+	if len(trimmedName) > 128 {
+		issues = append(issues, ErrSprintNameTooLong)
+	}
+	if len(issues) > 0 {
+		return SprintName{}, &errValidation{Issues: issues}
+	}
+
+	return SprintName{value: trimmedName}, nil
+}
+
+func (n SprintName) String() string {
+	return n.value
+}
+
+type SprintGoal struct {
+	value string
+}
+
+func NewSprintGoal(goal string) (SprintGoal, error) {
+	trimmedGoal := strings.TrimSpace(goal)
+	if len(trimmedGoal) > 1024 {
+		return SprintGoal{}, &errValidation{Issues: []string{ErrSprintGoalTooLong}}
+	}
+
+	return SprintGoal{value: trimmedGoal}, nil
+}
+
+func (g SprintGoal) String() string {
+	return g.value
+}
+
+// ParseSprintDate parses a calendar date in YYYY-MM-DD form as midnight UTC.
+func ParseSprintDate(date string) (time.Time, error) {
+	parsed, err := time.Parse(time.DateOnly, date)
+	if err != nil {
+		return time.Time{}, &errValidation{Issues: []string{ErrSprintDateInvalid}}
+	}
+
+	return parsed, nil
+}
+
+// ValidateSprintDates checks that the sprint does not end before it starts. A one-day
+// sprint starts and ends on the same date.
+func ValidateSprintDates(startDate, endDate time.Time) error {
+	if endDate.Before(startDate) {
+		return &errValidation{Issues: []string{ErrSprintEndBeforeStart}}
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestSprintName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       string
+	}{
+		{name: "Valid", input: "Sprint 14", want: "Sprint 14"},
+		{name: "Trimmed", input: "  Sprint 14 ", want: "Sprint 14"},
+		{name: "Max length", input: strings.Repeat("a", 128), want: strings.Repeat("a", 128)},
+		{name: "Empty", input: "   ", wantIssues: []string{domain.ErrSprintNameTooShort}},
+		{name: "Too long", input: strings.Repeat("a", 129), wantIssues: []string{domain.ErrSprintNameTooLong}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			name, err := domain.NewSprintName(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if name.String() != tt.want {
+				t.Errorf("got name %q, want %q", name.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSprintGoal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       string
+	}{
+		{name: "Empty", input: "", want: ""},
+		{name: "Trimmed", input: " Ship it ", want: "Ship it"},
+		{name: "Too long", input: strings.Repeat("a", 1025), wantIssues: []string{domain.ErrSprintGoalTooLong}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			goal, err := domain.NewSprintGoal(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if goal.String() != tt.want {
+				t.Errorf("got goal %q, want %q", goal.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSprintDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       time.Time
+	}{
+		{name: "Valid", input: "2026-03-09", want: time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)},
+		{name: "Timestamp", input: "2026-03-09T00:00:00Z", wantIssues: []string{domain.ErrSprintDateInvalid}},
+		{name: "No such day", input: "2026-02-30", wantIssues: []string{domain.ErrSprintDateInvalid}},
+		{name: "Empty", input: "", wantIssues: []string{domain.ErrSprintDateInvalid}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			date, err := domain.ParseSprintDate(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if !date.Equal(tt.want) {
+				t.Errorf("got date %v, want %v", date, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSprintDates(t *testing.T) {
+	t.Parallel()
+
+	day := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		start      time.Time
+		end        time.Time
+		wantIssues []string
+	}{
+		{name: "Two weeks", start: day, end: day.AddDate(0, 0, 13)},
+		{name: "One day", start: day, end: day},
+		{name: "End before start", start: day, end: day.AddDate(0, 0, -1), wantIssues: []string{domain.ErrSprintEndBeforeStart}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := domain.ValidateSprintDates(tt.start, tt.end)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSprintCarryOver(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		want       domain.SprintCarryOver
+	}{
+		{name: "Next sprint", input: "next_sprint", want: domain.SprintCarryOverNextSprint},
+		{name: "Backlog", input: "backlog", want: domain.SprintCarryOverBacklog},
+		{name: "Unknown", input: "trash", wantIssues: []string{domain.ErrSprintCarryOverValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			carryOver, err := domain.NewSprintCarryOver(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if carryOver != tt.want {
+				t.Errorf("got carry-over %q, want %q", carryOver, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package domain
+
+import "time"
+
+// OldestOpenTasksLimit is the number of oldest open tasks listed in statistics.
+const OldestOpenTasksLimit = 5
+
+// TaskStats summarizes the tasks of one or more boards. A task is open while it is not in a
+// done column.
+type TaskStats struct {
+	Tasks              int64
+	OpenTasks          int64
+	Last7Days          TaskActivity // Today and the 6 days before, in UTC.
+	Last30Days         TaskActivity // Today and the 29 days before, in UTC.
+	AverageOpenTaskAge time.Duration
+	OldestOpenTasks    []OpenTask // Oldest first, at most OldestOpenTasksLimit.
+}
+
+// TaskActivity counts the tasks created, moved to another column and deleted over a period.
+// Deleting a task with its subtasks counts every deleted task.
+type TaskActivity struct {
+	Created int64
+	Moved   int64
+	Deleted int64
+}
+
+type OpenTask struct {
+	ID        TaskID
+	BoardID   BoardID
+	ColumnID  ColumnID
+	Name      TaskName
+	CreatedAt time.Time
+}
+
+// ColumnTaskCount is the number of tasks in a column.
+type ColumnTaskCount struct {
+	ColumnID ColumnID
+	Name     ColumnName
+	Tasks    int64
+}
+
+// BoardStats are the statistics of a board as of AsOf. Columns are in position order.
+type BoardStats struct {
+	BoardID BoardID
+	AsOf    time.Time
+	Columns []ColumnTaskCount
+	TaskStats
+}
+
+// UserStats are the statistics of all boards owned by a user as of AsOf.
+type UserStats struct {
+	UserID UserID
+	AsOf   time.Time
+	Boards int64
+	TaskStats
+}
@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"strings"
+)
+
+const (
+	ErrSyncOperationType  = "Type must be one of board.create, board.update, board.delete, column.create, column.update, column.move, column.delete, task.create, task.update, task.move or task.delete"
+	ErrSyncOperationCount = "Operations must be between 1 and 100"
+	ErrSyncRefValue       = "Ref must be at most 64 characters and not used by another operation"
+)
+
+// MaxSyncOperations bounds how many operations one push applies.
+const MaxSyncOperations = 100
+
+// maxSyncRefLength bounds the label a client gives an operation.
+const maxSyncRefLength = 64
+
+// SyncChanges is what changed for a user since a cursor: the current state of every board,
+// column and task that changed, and the deletion event of every one that is gone. A deleted
+// board or column takes its columns and tasks with it without a deletion of their own.
+// Full changes hold everything the user has rather than what changed, and replace a replica.
+type SyncChanges struct {
+	// Cursor is where the next changes start. It moves on even when nothing changed.
+	Cursor BoardEventCursor
+	// HasMore tells that changes past Cursor are ready to be read right away.
+	HasMore bool
+	Full    bool
+	Boards  []Board
+	Columns []Column
+	Tasks   []Task // Archived tasks included.
+	Deleted []BoardEvent
+}
+
+// SyncOperationType is the entity and the change an operation of a push makes.
+type SyncOperationType string
+
+const (
+	SyncBoardCreate  SyncOperationType = "board.create"
+	SyncBoardUpdate  SyncOperationType = "board.update"
+	SyncBoardDelete  SyncOperationType = "board.delete"
+	SyncColumnCreate SyncOperationType = "column.create"
+	SyncColumnUpdate SyncOperationType = "column.update"
+	SyncColumnMove   SyncOperationType = "column.move"
+	SyncColumnDelete SyncOperationType = "column.delete"
+	SyncTaskCreate   SyncOperationType = "task.create"
+	SyncTaskUpdate   SyncOperationType = "task.update"
+	SyncTaskMove     SyncOperationType = "task.move"
+	SyncTaskDelete   SyncOperationType = "task.delete"
+)
+
+func NewSyncOperationType(operationType string) (SyncOperationType, error) {
+	switch t := SyncOperationType(strings.TrimSpace(operationType)); t {
+	case SyncBoardCreate, SyncBoardUpdate, SyncBoardDelete,
+		SyncColumnCreate, SyncColumnUpdate, SyncColumnMove, SyncColumnDelete,
+		SyncTaskCreate, SyncTaskUpdate, SyncTaskMove, SyncTaskDelete:
+		return t, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrSyncOperationType}}
+	}
+}
+
+func (t SyncOperationType) String() string {
+	return string(t)
+}
+
+// Entity is what the operation changes, or creates.
+func (t SyncOperationType) Entity() BoardEventEntity {
+	entity, _, _ := strings.Cut(string(t), ".")
+	return BoardEventEntity(entity)
+}
+
+// IsCreate tells whether the operation adds an entity rather than changing one.
+func (t SyncOperationType) IsCreate() bool {
+	return strings.HasSuffix(string(t), ".create")
+}
+
+// NewSyncRef validates the label of an operation. An empty ref is allowed: the operation is
+// then not referred to.
+func NewSyncRef(ref string) (string, error) {
+	if len(ref) > maxSyncRefLength {
+		return "", &errValidation{Issues: []string{ErrSyncRefValue}}
+	}
+	return ref, nil
+}
+
+// SyncOperation is a change a client made offline, pushed to be applied. An entity created
+// earlier in the same push has no id the client knows, so it is named by the Ref of its create
+// operation instead, in the Ref field next to the id. Only the fields of the type are used:
+//   - board.create takes BoardName and BoardDescription, board.update the non-nil ones;
+//   - column.create adds a column named ColumnName to the end of BoardID, column.update
+//     changes the non-nil ColumnName and ColumnDescription, column.move places ColumnID at
+//     ColumnPosition;
+//   - task.create adds a task to the end of ColumnID in the default lane, task.update changes
+//     the non-nil attributes, task.move places TaskID at TaskPosition of TargetColumnID within
+//     its lane;
+//   - deletes remove the entity, a task's children becoming top-level tasks.
+//
+// BaseVersion is the version of the entity the client changed. If the entity changed since,
+// the operation is not applied; a zero BaseVersion applies it over any change.
+type SyncOperation struct {
+	Ref         string
+	Type        SyncOperationType
+	BaseVersion Version
+
+	BoardID         BoardID
+	BoardRef        string
+	ColumnID        ColumnID
+	ColumnRef       string
+	TaskID          TaskID
+	TaskRef         string
+	TargetColumnID  ColumnID
+	TargetColumnRef string
+
+	ColumnPosition ColumnPosition
+	TaskPosition   TaskPosition
+
+	BoardName         *BoardName
+	BoardDescription  *BoardDescription
+	ColumnName        *ColumnName
+	ColumnDescription *ColumnDescription
+	TaskName          *TaskName
+	TaskDescription   *TaskDescription
+	Checklist         *TaskChecklist
+	Estimate          *TaskEstimate
+}
+
+// SyncStatus is the outcome of an operation of a push.
+type SyncStatus string
+
+const (
+	// SyncApplied operations were written.
+	SyncApplied SyncStatus = "applied"
+	// SyncConflict operations were not written as the entity changed after BaseVersion. The
+	// change of the server wins and is returned for the client to rebase on.
+	SyncConflict SyncStatus = "conflict"
+	// SyncGone operations were not written as the entity, or the board or column it goes into,
+	// was deleted. The deletion wins; deleting an entity that is gone already ends up here too.
+	SyncGone SyncStatus = "gone"
+	// SyncRejected operations break a rule of the board that holds whatever the client saw,
+	// like the workflow of the board or a position past the end of a column.
+	SyncRejected SyncStatus = "rejected"
+	// SyncSkipped operations refer to an entity whose create was not applied, or come after an
+	// operation that failed.
+	SyncSkipped SyncStatus = "skipped"
+	// SyncFailed operations hit an error of the server. The operations after it are skipped
+	// and the push can be sent again from there.
+	SyncFailed SyncStatus = "failed"
+)
+
+func (s SyncStatus) String() string {
+	return string(s)
+}
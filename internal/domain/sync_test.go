@@ -0,0 +1,90 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestNewSyncOperationType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		input        string
+		wantIssues   []string
+		wantType     domain.SyncOperationType
+		wantEntity   domain.BoardEventEntity
+		wantIsCreate bool
+	}{
+		{name: "Board create", input: "board.create", wantType: domain.SyncBoardCreate, wantEntity: domain.BoardEventBoard, wantIsCreate: true},
+		{name: "Column move", input: "column.move", wantType: domain.SyncColumnMove, wantEntity: domain.BoardEventColumn},
+		{name: "Task delete with spaces", input: " task.delete ", wantType: domain.SyncTaskDelete, wantEntity: domain.BoardEventTask},
+		{name: "Unknown action", input: "task.archive", wantIssues: []string{domain.ErrSyncOperationType}},
+		{name: "Unknown entity", input: "lane.create", wantIssues: []string{domain.ErrSyncOperationType}},
+		{name: "Empty", input: "", wantIssues: []string{domain.ErrSyncOperationType}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			operationType, err := domain.NewSyncOperationType(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Fatalf("issues mismatch (-want +got):\n%s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if operationType != tt.wantType {
+				t.Errorf("got type %q, want %q", operationType, tt.wantType)
+			}
+			if operationType.Entity() != tt.wantEntity {
+				t.Errorf("got entity %q, want %q", operationType.Entity(), tt.wantEntity)
+			}
+			if operationType.IsCreate() != tt.wantIsCreate {
+				t.Errorf("got is create %v, want %v", operationType.IsCreate(), tt.wantIsCreate)
+			}
+		})
+	}
+}
+
+func TestNewSyncRef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+	}{
+		{name: "Valid", input: "new-task-1"},
+		{name: "Empty", input: ""},
+		{name: "Longest", input: strings.Repeat("a", 64)},
+		{name: "Too long", input: strings.Repeat("a", 65), wantIssues: []string{domain.ErrSyncRefValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ref, err := domain.NewSyncRef(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Fatalf("issues mismatch (-want +got):\n%s", diff)
+			}
+			if err == nil && ref != tt.input {
+				t.Errorf("got ref %q, want %q", ref, tt.input)
+			}
+		})
+	}
+}
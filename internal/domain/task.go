@@ -2,6 +2,8 @@ package domain
 
 import (
 	"database/sql/driver"
+	"encoding/json"
+	"fmt"
 	"math"
 	"strings"
 	"time"
@@ -14,16 +16,72 @@ const (
 	ErrTaskNameTooLong        = "Name is too long"
 	ErrTaskDescriptionTooLong = "Description is too long"
 	ErrTaskPositionValue      = "Position is invalid"
+	ErrTaskChecklistTooLong   = "Checklist has too many items"
+	ErrTaskChecklistItemText  = "Checklist item text is invalid"
+	ErrTaskParentSelf         = "Task cannot be its own parent"
+	ErrTaskEstimateValue      = "Estimate is invalid"
+)
+
+const (
+	maxTaskChecklistItems    = 50
+	maxTaskChecklistItemText = 256
+	maxTaskEstimate          = 1000
 )
 
 type Task struct {
-	ID          TaskID
-	ColumnID    ColumnID
-	Name        TaskName
-	Description TaskDescription
-	Position    TaskPosition
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	ID           TaskID
+	ColumnID     ColumnID
+	LaneID       LaneID   // Nil for tasks in the default lane.
+	ParentID     TaskID   // Nil for top-level tasks.
+	SprintID     SprintID // Nil for tasks in the backlog.
+	Name         TaskName
+	Description  TaskDescription
+	Position     TaskPosition
+	Checklist    TaskChecklist
+	Estimate     TaskEstimate
+	CustomFields TaskCustomFields
+	Links        []TaskLink
+	Rollup       TaskRollup
+	ArchivedAt   time.Time // Zero for active tasks.
+	Version      Version
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (t *Task) HasParent() bool {
+	return !t.ParentID.IsNil()
+}
+
+func (t *Task) IsArchived() bool {
+	return !t.ArchivedAt.IsZero()
+}
+
+// TaskPlacement is where a task sits on a board: its (column, lane) cell and its position in the cell.
+type TaskPlacement struct {
+	ColumnID ColumnID
+	LaneID   LaneID // Nil for the default lane.
+	Position TaskPosition
+	Version  Version // Of the task once placed.
+}
+
+// TaskArchival is an active task due for archiving: it has stayed in its column for the
+// archive delay of the column.
+type TaskArchival struct {
+	BoardID  BoardID
+	ColumnID ColumnID
+	TaskID   TaskID
+}
+
+// TaskRollup summarizes the direct children of a task by the column each child sits in.
+type TaskRollup struct {
+	Total    int
+	Done     int // Children in done columns.
+	ByColumn []TaskRollupColumn
+}
+
+type TaskRollupColumn struct {
+	ColumnID ColumnID
+	Count    int
 }
 
 type (
@@ -116,3 +174,111 @@ func (p TaskPosition) Int64() int64 {
 func (p TaskPosition) Value() (driver.Value, error) {
 	return p.value, nil
 }
+
+// TaskEstimate is the story points estimate of a task, with at most two decimal places.
+// The zero value means the task is not estimated.
+type TaskEstimate struct {
+	points float64
+	set    bool
+}
+
+func NewTaskEstimate(points float64) (TaskEstimate, error) {
+	if math.IsNaN(points) || points < 0 || points > maxTaskEstimate || math.Round(points*100)/100 != points {
+		return TaskEstimate{}, &errValidation{Issues: []string{ErrTaskEstimateValue}}
+	}
+
+	return TaskEstimate{points: points, set: true}, nil
+}
+
+func (e TaskEstimate) IsSet() bool {
+	return e.set
+}
+
+// Float64 returns the estimated points, or 0 for a task that is not estimated.
+func (e TaskEstimate) Float64() float64 {
+	return e.points
+}
+
+func (e TaskEstimate) Value() (driver.Value, error) {
+	if !e.set {
+		return nil, nil
+	}
+	return e.points, nil
+}
+
+type TaskChecklistItem struct {
+	Text string
+	Done bool
+}
+
+// TaskChecklist is an ordered list of sub-steps of a task.
+type TaskChecklist struct {
+	items []TaskChecklistItem
+}
+
+func NewTaskChecklist(items []TaskChecklistItem) (TaskChecklist, error) {
+	if len(items) == 0 {
+		return TaskChecklist{}, nil
+	}
+
+	var issues []string
+	if len(items) > maxTaskChecklistItems {
+		issues = append(issues, ErrTaskChecklistTooLong)
+	}
+
+	trimmed := make([]TaskChecklistItem, len(items))
+	for i, item := range items {
+		text := strings.TrimSpace(item.Text)
+		if text == "" || len(text) > maxTaskChecklistItemText {
+			issues = append(issues, ErrTaskChecklistItemText)
+			break
+		}
+		trimmed[i] = TaskChecklistItem{Text: text, Done: item.Done}
+	}
+
+	if len(issues) > 0 {
+		return TaskChecklist{}, &errValidation{Issues: issues}
+	}
+
+	return TaskChecklist{items: trimmed}, nil
+}
+
+// Items returns a copy of the checklist items, so callers can't mutate the checklist in place.
+func (c TaskChecklist) Items() []TaskChecklistItem {
+	items := make([]TaskChecklistItem, len(c.items))
+	copy(items, c.items)
+	return items
+}
+
+func (c TaskChecklist) Len() int {
+	return len(c.items)
+}
+
+// IsDone reports whether every item is checked. An empty checklist is done.
+func (c TaskChecklist) IsDone() bool {
+	for _, item := range c.items {
+		if !item.Done {
+			return false
+		}
+	}
+	return true
+}
+
+func (c TaskChecklist) Value() (driver.Value, error) {
+	type itemJSON struct {
+		Text string `json:"text"`
+		Done bool   `json:"done"`
+	}
+
+	items := make([]itemJSON, len(c.items))
+	for i, item := range c.items {
+		items[i] = itemJSON{Text: item.Text, Done: item.Done}
+	}
+
+	raw, err := json.Marshal(items)
+	if err != nil {
+		return nil, fmt.Errorf("marshal task checklist: %w", err)
+	}
+
+	return string(raw), nil
+}
@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+const (
+	ErrTaskBatchOperationType  = "Type must be move, update, delete or set_label"
+	ErrTaskBatchOperationCount = "Operations must be between 1 and 100"
+	ErrTaskBatchLabelField     = "Labels are set on select fields only"
+)
+
+// MaxTaskBatchOperations bounds how many operations one batch applies.
+const MaxTaskBatchOperations = 100
+
+// TaskBatchOperationType is what an operation of a batch does to its task.
+type TaskBatchOperationType string
+
+const (
+	TaskBatchMove     TaskBatchOperationType = "move"
+	TaskBatchUpdate   TaskBatchOperationType = "update"
+	TaskBatchDelete   TaskBatchOperationType = "delete"
+	TaskBatchSetLabel TaskBatchOperationType = "set_label"
+)
+
+func NewTaskBatchOperationType(operationType string) (TaskBatchOperationType, error) {
+	switch t := TaskBatchOperationType(strings.TrimSpace(operationType)); t {
+	case TaskBatchMove, TaskBatchUpdate, TaskBatchDelete, TaskBatchSetLabel:
+		return t, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrTaskBatchOperationType}}
+	}
+}
+
+func (t TaskBatchOperationType) String() string {
+	return string(t)
+}
+
+// TaskBatchOperation is one change of a batch applied to the tasks of a board. Operations run
+// in order, each seeing the board as the ones before it left it, and only the fields of the
+// operation type are used:
+//   - move places the task like a single move: TargetLaneID nil keeps the lane, a nil lane ID
+//     is the default lane;
+//   - update changes the non-nil attributes and the given custom field values, a null value
+//     removing the value of its field;
+//   - delete removes the task, its children become top-level tasks;
+//   - set_label sets Value as the value of the select field FieldID, null clearing it.
+type TaskBatchOperation struct {
+	Type   TaskBatchOperationType
+	TaskID TaskID
+
+	TargetColumnID ColumnID
+	TargetLaneID   *LaneID
+	TargetPosition TaskPosition
+
+	Name         *TaskName
+	Description  *TaskDescription
+	Checklist    *TaskChecklist
+	Estimate     *TaskEstimate
+	CustomFields map[CustomFieldID]json.RawMessage
+
+	FieldID CustomFieldID
+	Value   json.RawMessage
+}
+
+// TaskChange is an operation of a batch checked against the board and ready to be written.
+// ColumnID is the column the task is in when the change runs, and a set_label operation is
+// an update of the custom field values.
+type TaskChange struct {
+	Type   TaskBatchOperationType // TaskBatchMove, TaskBatchUpdate or TaskBatchDelete.
+	TaskID TaskID
+	// ColumnID is where the task is expected to be when the change runs.
+	ColumnID ColumnID
+
+	TargetColumnID ColumnID
+	TargetLaneID   LaneID // Nil for the default lane.
+	TargetPosition TaskPosition
+
+	Name         *TaskName
+	Description  *TaskDescription
+	Checklist    *TaskChecklist
+	Estimate     *TaskEstimate
+	CustomFields *TaskCustomFields
+}
+
+// TaskBatchResult is the outcome of an operation of a batch: the new placement of a moved
+// task, or the task as updated. A deleted task leaves only its id.
+type TaskBatchResult struct {
+	Type      TaskBatchOperationType
+	TaskID    TaskID
+	Placement TaskPlacement // Set for moves.
+	Task      Task          // Set for updates and labels.
+}
@@ -0,0 +1,45 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestNewTaskBatchOperationType(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		operationType string
+		want          domain.TaskBatchOperationType
+		wantIssues    []string
+	}{
+		{name: "Move", operationType: "move", want: domain.TaskBatchMove},
+		{name: "Update trimmed", operationType: " update ", want: domain.TaskBatchUpdate},
+		{name: "Delete", operationType: "delete", want: domain.TaskBatchDelete},
+		{name: "Set label", operationType: "set_label", want: domain.TaskBatchSetLabel},
+		{name: "Unknown", operationType: "archive", wantIssues: []string{domain.ErrTaskBatchOperationType}},
+		{name: "Empty", operationType: "", wantIssues: []string{domain.ErrTaskBatchOperationType}},
+		{name: "Case sensitive", operationType: "Move", wantIssues: []string{domain.ErrTaskBatchOperationType}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			operationType, err := domain.NewTaskBatchOperationType(tt.operationType)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			} else if operationType != tt.want {
+				t.Errorf("got type %q, want %q", operationType, tt.want)
+			}
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
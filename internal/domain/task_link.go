@@ -0,0 +1,133 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrTaskLinkTypeValue = "Link type is invalid"
+	ErrTaskLinkSelf      = "Task cannot be linked to itself"
+)
+
+// TaskLink is a directed link from SourceID to TargetID. relates_to links are symmetric
+// and are stored with the smaller task ID as the source, so each pair is stored once.
+type TaskLink struct {
+	ID        TaskLinkID
+	SourceID  TaskID
+	TargetID  TaskID
+	Type      TaskLinkType
+	CreatedAt time.Time
+}
+
+// Relation returns how the link looks from taskID together with the task on the other end.
+func (l *TaskLink) Relation(taskID TaskID) (TaskLinkRelation, TaskID) {
+	outgoing := l.SourceID == taskID
+	switch l.Type {
+	case TaskLinkBlocks:
+		if outgoing {
+			return TaskLinkRelationBlocks, l.TargetID
+		}
+		return TaskLinkRelationBlockedBy, l.SourceID
+	case TaskLinkDuplicates:
+		if outgoing {
+			return TaskLinkRelationDuplicates, l.TargetID
+		}
+		return TaskLinkRelationDuplicatedBy, l.SourceID
+	default:
+		if outgoing {
+			return TaskLinkRelationRelatesTo, l.TargetID
+		}
+		return TaskLinkRelationRelatesTo, l.SourceID
+	}
+}
+
+type (
+	taskLinkTag struct{}
+	TaskLinkID  = UUID[taskLinkTag]
+)
+
+func NewTaskLinkID() TaskLinkID {
+	return newID[taskLinkTag]()
+}
+
+func ParseTaskLinkID(s string) (TaskLinkID, error) {
+	return parseID[taskLinkTag](s)
+}
+
+func NewTaskLinkIDFromUUID(u uuid.UUID) (TaskLinkID, error) {
+	return newIDFromUUID[taskLinkTag](u)
+}
+
+// TaskLinkType is the stored link type.
+type TaskLinkType string
+
+const (
+	TaskLinkBlocks     TaskLinkType = "blocks"
+	TaskLinkRelatesTo  TaskLinkType = "relates_to"
+	TaskLinkDuplicates TaskLinkType = "duplicates"
+)
+
+func NewTaskLinkType(linkType string) (TaskLinkType, error) {
+	switch t := TaskLinkType(linkType); t {
+	case TaskLinkBlocks, TaskLinkRelatesTo, TaskLinkDuplicates:
+		return t, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrTaskLinkTypeValue}}
+	}
+}
+
+func (t TaskLinkType) String() string {
+	return string(t)
+}
+
+// TaskLinkRelation is a link type as seen from one of the linked tasks.
+type TaskLinkRelation string
+
+const (
+	TaskLinkRelationBlocks       TaskLinkRelation = "blocks"
+	TaskLinkRelationBlockedBy    TaskLinkRelation = "blocked_by"
+	TaskLinkRelationRelatesTo    TaskLinkRelation = "relates_to"
+	TaskLinkRelationDuplicates   TaskLinkRelation = "duplicates"
+	TaskLinkRelationDuplicatedBy TaskLinkRelation = "duplicated_by"
+)
+
+func NewTaskLinkRelation(relation string) (TaskLinkRelation, error) {
+	switch r := TaskLinkRelation(relation); r {
+	case TaskLinkRelationBlocks, TaskLinkRelationBlockedBy, TaskLinkRelationRelatesTo,
+		TaskLinkRelationDuplicates, TaskLinkRelationDuplicatedBy:
+		return r, nil
+	default:
+		return "", &errValidation{Issues: []string{ErrTaskLinkTypeValue}}
+	}
+}
+
+func (r TaskLinkRelation) String() string {
+	return string(r)
+}
+
+// Link builds the stored link for "taskID <relation> otherID".
+func (r TaskLinkRelation) Link(taskID, otherID TaskID) (TaskLink, error) {
+	if taskID == otherID {
+		return TaskLink{}, &errValidation{Issues: []string{ErrTaskLinkSelf}}
+	}
+
+	switch r {
+	case TaskLinkRelationBlocks:
+		return TaskLink{SourceID: taskID, TargetID: otherID, Type: TaskLinkBlocks}, nil
+	case TaskLinkRelationBlockedBy:
+		return TaskLink{SourceID: otherID, TargetID: taskID, Type: TaskLinkBlocks}, nil
+	case TaskLinkRelationDuplicates:
+		return TaskLink{SourceID: taskID, TargetID: otherID, Type: TaskLinkDuplicates}, nil
+	case TaskLinkRelationDuplicatedBy:
+		return TaskLink{SourceID: otherID, TargetID: taskID, Type: TaskLinkDuplicates}, nil
+	case TaskLinkRelationRelatesTo:
+		if otherID.String() < taskID.String() {
+			taskID, otherID = otherID, taskID
+		}
+		return TaskLink{SourceID: taskID, TargetID: otherID, Type: TaskLinkRelatesTo}, nil
+	default:
+		return TaskLink{}, &errValidation{Issues: []string{ErrTaskLinkTypeValue}}
+	}
+}
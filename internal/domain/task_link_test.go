@@ -0,0 +1,76 @@
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestTaskLinkRelation_Link(t *testing.T) {
+	t.Parallel()
+
+	taskID := domain.NewTaskID()
+	otherID := domain.NewTaskID() // UUIDv7, so otherID sorts after taskID.
+
+	tests := []struct {
+		name         string
+		relation     string
+		taskID       domain.TaskID
+		otherID      domain.TaskID
+		wantIssues   []string
+		wantSource   domain.TaskID
+		wantTarget   domain.TaskID
+		wantType     domain.TaskLinkType
+		wantRelation domain.TaskLinkRelation
+	}{
+		{
+			name: "Blocks", relation: "blocks", taskID: taskID, otherID: otherID,
+			wantSource: taskID, wantTarget: otherID, wantType: domain.TaskLinkBlocks, wantRelation: domain.TaskLinkRelationBlocks,
+		},
+		{
+			name: "Blocked by", relation: "blocked_by", taskID: taskID, otherID: otherID,
+			wantSource: otherID, wantTarget: taskID, wantType: domain.TaskLinkBlocks, wantRelation: domain.TaskLinkRelationBlockedBy,
+		},
+		{
+			name: "Duplicated by", relation: "duplicated_by", taskID: taskID, otherID: otherID,
+			wantSource: otherID, wantTarget: taskID, wantType: domain.TaskLinkDuplicates, wantRelation: domain.TaskLinkRelationDuplicatedBy,
+		},
+		{
+			name: "Relates to is normalized", relation: "relates_to", taskID: otherID, otherID: taskID,
+			wantSource: taskID, wantTarget: otherID, wantType: domain.TaskLinkRelatesTo, wantRelation: domain.TaskLinkRelationRelatesTo,
+		},
+		{name: "Unknown relation", relation: "parent_of", taskID: taskID, otherID: otherID, wantIssues: []string{domain.ErrTaskLinkTypeValue}},
+		{name: "Self link", relation: "blocks", taskID: taskID, otherID: taskID, wantIssues: []string{domain.ErrTaskLinkSelf}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var gotIssues []string
+			relation, err := domain.NewTaskLinkRelation(tt.relation)
+			if err == nil {
+				var link domain.TaskLink
+				link, err = relation.Link(tt.taskID, tt.otherID)
+				if err == nil {
+					if link.SourceID != tt.wantSource || link.TargetID != tt.wantTarget || link.Type != tt.wantType {
+						t.Errorf("got link %v -%s-> %v, want %v -%s-> %v", link.SourceID, link.Type, link.TargetID, tt.wantSource, tt.wantType, tt.wantTarget)
+					}
+					gotRelation, gotOther := link.Relation(tt.taskID)
+					if gotRelation != tt.wantRelation || gotOther != tt.otherID {
+						t.Errorf("got relation %q to %v, want %q to %v", gotRelation, gotOther, tt.wantRelation, tt.otherID)
+					}
+				}
+			}
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
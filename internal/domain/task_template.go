@@ -0,0 +1,167 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ErrTaskPatternPlaceholder = "Pattern has unknown placeholder"
+)
+
+// TaskTemplate is a per-board preset for new tasks. Name and description are patterns
+// whose placeholders are expanded when a task is created from the template.
+type TaskTemplate struct {
+	ID                 TaskTemplateID
+	BoardID            BoardID
+	NamePattern        TaskNamePattern
+	DescriptionPattern TaskDescriptionPattern
+	Checklist          TaskChecklist
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Render expands the template patterns at now.
+func (t *TaskTemplate) Render(now time.Time) (TaskName, TaskDescription, error) {
+	name, err := NewTaskName(expandTaskPattern(t.NamePattern.value, now))
+	if err != nil {
+		return TaskName{}, TaskDescription{}, fmt.Errorf("render name: %w", err)
+	}
+	description, err := NewTaskDescription(expandTaskPattern(t.DescriptionPattern.value, now))
+	if err != nil {
+		return TaskName{}, TaskDescription{}, fmt.Errorf("render description: %w", err)
+	}
+
+	return name, description, nil
+}
+
+type (
+	taskTemplateTag struct{}
+	TaskTemplateID  = UUID[taskTemplateTag]
+)
+
+func NewTaskTemplateID() TaskTemplateID {
+	return newID[taskTemplateTag]()
+}
+
+func ParseTaskTemplateID(s string) (TaskTemplateID, error) {
+	return parseID[taskTemplateTag](s)
+}
+
+func NewTaskTemplateIDFromUUID(u uuid.UUID) (TaskTemplateID, error) {
+	return newIDFromUUID[taskTemplateTag](u)
+}
+
+// taskPlaceholder renders one {{name}} placeholder. maxLen is the longest value render
+// can produce, used to check that a pattern always expands within the task limits.
+type taskPlaceholder struct {
+	maxLen int
+	render func(now time.Time) string
+}
+
+var taskPlaceholders = map[string]taskPlaceholder{
+	"date":    {maxLen: len("2006-01-02"), render: func(now time.Time) string { return now.Format("2006-01-02") }},
+	"time":    {maxLen: len("15:04"), render: func(now time.Time) string { return now.Format("15:04") }},
+	"weekday": {maxLen: len("Wednesday"), render: func(now time.Time) string { return now.Weekday().String() }},
+	"week": {maxLen: len("2006-W01"), render: func(now time.Time) string {
+		year, week := now.ISOWeek()
+		return fmt.Sprintf("%04d-W%02d", year, week)
+	}},
+	"month": {maxLen: len("2006-01"), render: func(now time.Time) string { return now.Format("2006-01") }},
+}
+
+var taskPlaceholderRe = regexp.MustCompile(`\{\{\s*([a-zA-Z]+)\s*\}\}`)
+
+func expandTaskPattern(pattern string, now time.Time) string {
+	now = now.UTC()
+	return taskPlaceholderRe.ReplaceAllStringFunc(pattern, func(match string) string {
+		key := strings.ToLower(taskPlaceholderRe.FindStringSubmatch(match)[1])
+		placeholder, ok := taskPlaceholders[key]
+		if !ok {
+			return match
+		}
+		return placeholder.render(now)
+	})
+}
+
+// worstCaseTaskPatternLen returns the length of the pattern with every placeholder
+// expanded to its longest value, and whether all placeholders are known.
+func worstCaseTaskPatternLen(pattern string) (int, bool) {
+	known := true
+	expanded := taskPlaceholderRe.ReplaceAllStringFunc(pattern, func(match string) string {
+		key := strings.ToLower(taskPlaceholderRe.FindStringSubmatch(match)[1])
+		placeholder, ok := taskPlaceholders[key]
+		if !ok {
+			known = false
+			return match
+		}
+		return strings.Repeat("x", placeholder.maxLen)
+	})
+	return len(expanded), known
+}
+
+type TaskNamePattern struct {
+	value string
+}
+
+func NewTaskNamePattern(pattern string) (TaskNamePattern, error) {
+	trimmedPattern := strings.TrimSpace(pattern)
+	length, known := worstCaseTaskPatternLen(trimmedPattern)
+	var issues []string
+	if trimmedPattern == "" {
+		issues = append(issues, ErrTaskNameTooShort)
+	}
+	if length > 128 {
+		issues = append(issues, ErrTaskNameTooLong)
+	}
+	if !known {
+		issues = append(issues, ErrTaskPatternPlaceholder)
+	}
+	if len(issues) > 0 {
+		return TaskNamePattern{}, &errValidation{Issues: issues}
+	}
+
+	return TaskNamePattern{value: trimmedPattern}, nil
+}
+
+func (p TaskNamePattern) String() string {
+	return p.value
+}
+
+func (p TaskNamePattern) Value() (driver.Value, error) {
+	return p.value, nil
+}
+
+type TaskDescriptionPattern struct {
+	value string
+}
+
+func NewTaskDescriptionPattern(pattern string) (TaskDescriptionPattern, error) {
+	trimmedPattern := strings.TrimSpace(pattern)
+	length, known := worstCaseTaskPatternLen(trimmedPattern)
+	var issues []string
+	if length > 1024 {
+		issues = append(issues, ErrTaskDescriptionTooLong)
+	}
+	if !known {
+		issues = append(issues, ErrTaskPatternPlaceholder)
+	}
+	if len(issues) > 0 {
+		return TaskDescriptionPattern{}, &errValidation{Issues: issues}
+	}
+
+	return TaskDescriptionPattern{value: trimmedPattern}, nil
+}
+
+func (p TaskDescriptionPattern) String() string {
+	return p.value
+}
+
+func (p TaskDescriptionPattern) Value() (driver.Value, error) {
+	return p.value, nil
+}
@@ -0,0 +1,113 @@
+package domain_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestTaskNamePattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		wantValue  string
+	}{
+		{name: "Valid", input: "  Standup {{date}}  ", wantValue: "Standup {{date}}"},
+		{name: "Without placeholders", input: "Standup", wantValue: "Standup"},
+		{name: "Longest expansion fits", input: strings.Repeat("a", 118) + "{{date}}", wantValue: strings.Repeat("a", 118) + "{{date}}"},
+		{name: "Longest expansion too long", input: strings.Repeat("a", 120) + "{{weekday}}", wantIssues: []string{domain.ErrTaskNameTooLong}},
+		{name: "Empty", input: "  ", wantIssues: []string{domain.ErrTaskNameTooShort}},
+		{name: "Unknown placeholder", input: "Standup {{owner}}", wantIssues: []string{domain.ErrTaskPatternPlaceholder}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern, err := domain.NewTaskNamePattern(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if pattern.String() != tt.wantValue {
+				t.Errorf("got value %q, want %q", pattern.String(), tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestTaskDescriptionPattern(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      string
+		wantIssues []string
+		wantValue  string
+	}{
+		{name: "Valid", input: "Notes for {{weekday}}", wantValue: "Notes for {{weekday}}"},
+		{name: "Empty", input: "", wantValue: ""},
+		{name: "Longest expansion too long", input: strings.Repeat("a", 1020) + "{{time}}", wantIssues: []string{domain.ErrTaskDescriptionTooLong}},
+		{name: "Unknown placeholder", input: "{{nope}}", wantIssues: []string{domain.ErrTaskPatternPlaceholder}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			pattern, err := domain.NewTaskDescriptionPattern(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if pattern.String() != tt.wantValue {
+				t.Errorf("got value %q, want %q", pattern.String(), tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestTaskTemplateRender(t *testing.T) {
+	t.Parallel()
+
+	namePattern, err := domain.NewTaskNamePattern("Report {{week}} ({{date}} {{time}}, {{ Weekday }}, {{month}})")
+	if err != nil {
+		t.Fatalf("NewTaskNamePattern: %v", err)
+	}
+	descriptionPattern, err := domain.NewTaskDescriptionPattern("Due {{date}}")
+	if err != nil {
+		t.Fatalf("NewTaskDescriptionPattern: %v", err)
+	}
+	template := domain.TaskTemplate{NamePattern: namePattern, DescriptionPattern: descriptionPattern}
+
+	// 2026-01-01 01:30 in UTC+3 is still 2025 in UTC, and belongs to ISO week 2026-W01.
+	now := time.Date(2026, time.January, 1, 1, 30, 0, 0, time.FixedZone("MSK", 3*60*60))
+	name, description, err := template.Render(now)
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+
+	wantName := "Report 2026-W01 (2025-12-31 22:30, Wednesday, 2025-12)"
+	if name.String() != wantName {
+		t.Errorf("got name %q, want %q", name.String(), wantName)
+	}
+	wantDescription := "Due 2025-12-31"
+	if description.String() != wantDescription {
+		t.Errorf("got description %q, want %q", description.String(), wantDescription)
+	}
+}
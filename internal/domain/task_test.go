@@ -122,3 +122,101 @@ func TestTaskPosition(t *testing.T) {
 		})
 	}
 }
+
+func TestTaskEstimate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      float64
+		wantIssues []string
+		wantValue  float64
+	}{
+		{name: "Valid", input: 5, wantValue: 5},
+		{name: "Valid zero", input: 0, wantValue: 0},
+		{name: "Valid fraction", input: 0.5, wantValue: 0.5},
+		{name: "Valid max", input: 1000, wantValue: 1000},
+		{name: "Negative", input: -1, wantIssues: []string{domain.ErrTaskEstimateValue}},
+		{name: "Too large", input: 1000.01, wantIssues: []string{domain.ErrTaskEstimateValue}},
+		{name: "Too precise", input: 1.125, wantIssues: []string{domain.ErrTaskEstimateValue}},
+		{name: "NaN", input: math.NaN(), wantIssues: []string{domain.ErrTaskEstimateValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			estimate, err := domain.NewTaskEstimate(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues == nil && (!estimate.IsSet() || estimate.Float64() != tt.wantValue) {
+				t.Errorf("got estimate %v (set %v), want %v", estimate.Float64(), estimate.IsSet(), tt.wantValue)
+			}
+		})
+	}
+}
+
+func TestTaskChecklist(t *testing.T) {
+	t.Parallel()
+
+	tooManyItems := make([]domain.TaskChecklistItem, 51)
+	for i := range tooManyItems {
+		tooManyItems[i] = domain.TaskChecklistItem{Text: "Step"}
+	}
+
+	tests := []struct {
+		name       string
+		input      []domain.TaskChecklistItem
+		wantIssues []string
+		wantItems  []domain.TaskChecklistItem
+		wantDone   bool
+	}{
+		{name: "Empty", input: nil, wantItems: []domain.TaskChecklistItem{}, wantDone: true},
+		{
+			name:      "Trimmed",
+			input:     []domain.TaskChecklistItem{{Text: "  Write tests  ", Done: true}, {Text: "Review"}},
+			wantItems: []domain.TaskChecklistItem{{Text: "Write tests", Done: true}, {Text: "Review"}},
+			wantDone:  false,
+		},
+		{
+			name:      "All done",
+			input:     []domain.TaskChecklistItem{{Text: "Write tests", Done: true}},
+			wantItems: []domain.TaskChecklistItem{{Text: "Write tests", Done: true}},
+			wantDone:  true,
+		},
+		{name: "Blank item", input: []domain.TaskChecklistItem{{Text: "   "}}, wantIssues: []string{domain.ErrTaskChecklistItemText}},
+		{name: "Item too long", input: []domain.TaskChecklistItem{{Text: strings.Repeat("a", 257)}}, wantIssues: []string{domain.ErrTaskChecklistItemText}},
+		{name: "Too many items", input: tooManyItems, wantIssues: []string{domain.ErrTaskChecklistTooLong}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checklist, err := domain.NewTaskChecklist(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantIssues != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wantItems, checklist.Items()); diff != "" {
+				t.Errorf("got items mismatch (-want +got):\n%s", diff)
+			}
+			if checklist.IsDone() != tt.wantDone {
+				t.Errorf("got done %t, want %t", checklist.IsDone(), tt.wantDone)
+			}
+		})
+	}
+}
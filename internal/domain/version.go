@@ -0,0 +1,32 @@
+package domain
+
+import "database/sql/driver"
+
+const ErrVersionValue = "Version must be a positive integer"
+
+// Version counts the changes of a board, column or task. It starts at 1 and grows by one
+// whenever the row itself changes, so a change based on an older version would overwrite
+// someone else's. The zero value is no version: a change that names none applies to any.
+type Version struct {
+	value int64
+}
+
+func NewVersion(version int64) (Version, error) {
+	if version <= 0 {
+		return Version{}, &errValidation{Issues: []string{ErrVersionValue}}
+	}
+
+	return Version{value: version}, nil
+}
+
+func (v Version) IsSet() bool {
+	return v.value > 0
+}
+
+func (v Version) Int64() int64 {
+	return v.value
+}
+
+func (v Version) Value() (driver.Value, error) {
+	return v.value, nil
+}
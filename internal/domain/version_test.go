@@ -0,0 +1,48 @@
+package domain_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+)
+
+func TestVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		input      int64
+		wantIssues []string
+		wantValue  int64
+	}{
+		{name: "Valid", input: 1, wantValue: 1},
+		{name: "Valid max int64", input: math.MaxInt64, wantValue: math.MaxInt64},
+		{name: "Zero", input: 0, wantIssues: []string{domain.ErrVersionValue}},
+		{name: "Negative", input: -3, wantIssues: []string{domain.ErrVersionValue}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			version, err := domain.NewVersion(tt.input)
+			var gotIssues []string
+			if err != nil {
+				gotIssues = domain.ExtractValidationIssues(err)
+			}
+
+			if diff := cmp.Diff(tt.wantIssues, gotIssues); diff != "" {
+				t.Errorf("got issues mismatch (-want +got):\n%s", diff)
+			}
+			if version.IsSet() != (tt.wantIssues == nil) {
+				t.Errorf("got set %t, want %t", version.IsSet(), tt.wantIssues == nil)
+			}
+			if version.Int64() != tt.wantValue {
+				t.Errorf("got value %d, want %d", version.Int64(), tt.wantValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,651 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type analyticsService interface {
+	Burndown(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error)
+	CumulativeFlow(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) (domain.CumulativeFlow, error)
+	CycleTimes(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error)
+	Throughput(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.ThroughputReport, error)
+	Forecast(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, target domain.ForecastTarget, settings domain.ForecastSettings) (domain.Forecast, error)
+}
+
+type analytics struct {
+	logger           *slog.Logger
+	analyticsService analyticsService
+	responder        *httpschema.ErrorResponder
+}
+
+func NewAnalytics(logger *slog.Logger, analyticsService analyticsService, responder *httpschema.ErrorResponder) *analytics {
+	moduleLogger := logging.WithModule(logger, "handler.analytics")
+
+	return &analytics{logger: moduleLogger, analyticsService: analyticsService, responder: responder}
+}
+
+type burndownResponse struct {
+	BoardID  string                  `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	SprintID *string                 `json:"sprintId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	From     *string                 `json:"from" example:"2026-03-09"`
+	To       *string                 `json:"to" example:"2026-03-22"`
+	Burndown []burndownPointResponse `json:"burndown"`
+	Burnup   []burnupPointResponse   `json:"burnup"`
+}
+
+// burndownPointResponse is the points left to do at the end of a day.
+type burndownPointResponse struct {
+	Date      string  `json:"date" example:"2026-03-10"`
+	Remaining float64 `json:"remaining" example:"21"`
+}
+
+// burnupPointResponse is the points done at the end of a day against the total planned scope.
+type burnupPointResponse struct {
+	Date      string  `json:"date" example:"2026-03-10"`
+	Completed float64 `json:"completed" example:"5"`
+	Scope     float64 `json:"scope" example:"26"`
+}
+
+// newDateResponse maps the zero time of an unbounded range side to null.
+func newDateResponse(date time.Time) *string {
+	if date.IsZero() {
+		return nil
+	}
+	value := date.Format(time.DateOnly)
+	return &value
+}
+
+// parseFlowRange reads the required from and to query parameters and checks that stepping
+// through them by interval stays within the point limit. An invalid interval skips the check.
+func parseFlowRange(query url.Values, interval domain.FlowInterval, details *[]httpschema.Detail) (time.Time, time.Time) {
+	from := httpschema.ValidateField("from", query.Get("from"), domain.ParseFlowDate, details)
+	to := httpschema.ValidateField("to", query.Get("to"), domain.ParseFlowDate, details)
+	if len(*details) > 0 {
+		return from, to
+	}
+
+	err := domain.ValidateFlowRange(from, to, interval)
+	if err != nil {
+		*details = append(*details, httpschema.Detail{Field: "to", Issues: domain.ExtractValidationIssues(err)})
+	}
+
+	return from, to
+}
+
+func newBurndownResponse(series *domain.BurndownSeries) burndownResponse {
+	burndown := make([]burndownPointResponse, len(series.Points))
+	burnup := make([]burnupPointResponse, len(series.Points))
+	for i, point := range series.Points {
+		date := point.Day.Format(time.DateOnly)
+		burndown[i] = burndownPointResponse{Date: date, Remaining: point.Remaining}
+		burnup[i] = burnupPointResponse{Date: date, Completed: point.Completed, Scope: point.Scope()}
+	}
+
+	return burndownResponse{
+		BoardID:  series.BoardID.String(),
+		SprintID: newSprintIDResponse(series.SprintID),
+		From:     newDateResponse(series.From),
+		To:       newDateResponse(series.To),
+		Burndown: burndown,
+		Burnup:   burnup,
+	}
+}
+
+// Burndown godoc
+// @Summary Get burndown and burnup charts
+// @Description Get the daily story points of a board, or of one of its sprints when sprintId is set. Points are snapshotted once per UTC day, by the database clock, for every running sprint of a board with sprints and for the whole board otherwise.
+// @Description Tasks without an estimate count as zero points; days without a snapshot are left out. For a sprint, from and to default to the sprint dates; otherwise an omitted bound leaves the range open.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param sprintId query string false "Sprint ID"
+// @Param from query string false "First day, YYYY-MM-DD"
+// @Param to query string false "Last day, YYYY-MM-DD"
+// @Success 200 {object} burndownResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND or SPRINT_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/analytics/burndown [get]
+func (h *analytics) Burndown(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	query := r.URL.Query()
+	details := []httpschema.Detail{}
+	var sprintID domain.SprintID
+	if rawSprintID := query.Get("sprintId"); rawSprintID != "" {
+		sprintID, err = domain.ParseSprintID(rawSprintID)
+		if err != nil {
+			details = append(details, httpschema.Detail{Field: "sprintId", Issues: []string{"Invalid sprint id"}})
+		}
+	}
+	from := httpschema.ValidateField("from", query.Get("from"), domain.ParseBurndownDate, &details)
+	to := httpschema.ValidateField("to", query.Get("to"), domain.ParseBurndownDate, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	series, err := h.analyticsService.Burndown(r.Context(), userID, boardID, sprintID, from, to)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		case errors.Is(err, service.ErrSprintNotFound):
+			h.responder.SprintNotFound(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint not found"}}})
+		case errors.Is(err, service.ErrBurndownRangeInvalid):
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "to", Issues: []string{domain.ErrBurndownToBeforeFrom}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newBurndownResponse(&series))
+}
+
+type cumulativeFlowResponse struct {
+	BoardID  string                         `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	Interval string                         `json:"interval" example:"day"`
+	From     string                         `json:"from" example:"2026-03-09"`
+	To       string                         `json:"to" example:"2026-03-22"`
+	Columns  []cumulativeFlowColumnResponse `json:"columns"`
+	Points   []cumulativeFlowPointResponse  `json:"points"`
+}
+
+// cumulativeFlowColumnResponse is a current column of the board; columns are in board order.
+type cumulativeFlowColumnResponse struct {
+	ID        string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	Name      string `json:"name" example:"In Progress"`
+	Position  int64  `json:"position" example:"2"`
+	IsStarted bool   `json:"isStarted" example:"true"`
+	IsDone    bool   `json:"isDone" example:"false"`
+}
+
+// cumulativeFlowPointResponse is the number of tasks in each column at the end of a day,
+// in the order of the columns.
+type cumulativeFlowPointResponse struct {
+	Date   string  `json:"date" example:"2026-03-10"`
+	Counts []int64 `json:"counts" example:"4,2,7"`
+}
+
+func newCumulativeFlowResponse(flow *domain.CumulativeFlow) cumulativeFlowResponse {
+	columns := make([]cumulativeFlowColumnResponse, len(flow.Columns))
+	for i, column := range flow.Columns {
+		columns[i] = cumulativeFlowColumnResponse{
+			ID:        column.ID.String(),
+			Name:      column.Name.String(),
+			Position:  column.Position.Int64(),
+			IsStarted: column.IsStarted,
+			IsDone:    column.IsDone,
+		}
+	}
+	points := make([]cumulativeFlowPointResponse, len(flow.Points))
+	for i, point := range flow.Points {
+		points[i] = cumulativeFlowPointResponse{Date: point.Day.Format(time.DateOnly), Counts: point.Counts}
+	}
+
+	return cumulativeFlowResponse{
+		BoardID:  flow.BoardID.String(),
+		Interval: flow.Interval.String(),
+		From:     flow.From.Format(time.DateOnly),
+		To:       flow.To.Format(time.DateOnly),
+		Columns:  columns,
+		Points:   points,
+	}
+}
+
+// CumulativeFlow godoc
+// @Summary Get cumulative flow diagram
+// @Description Get the number of tasks in every column of a board at the end of each UTC day from from through to, stepping by interval.
+// @Description Every column change of a task is recorded, and tasks are counted by column identity, so the history stays accurate after columns are renamed or reordered. Columns are the current columns of the board in board order; deleted columns are left out.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param from query string true "First day, YYYY-MM-DD"
+// @Param to query string true "Last day, YYYY-MM-DD"
+// @Param interval query string false "Step between points, day or week" default(day)
+// @Success 200 {object} cumulativeFlowResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/analytics/cfd [get]
+func (h *analytics) CumulativeFlow(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	query := r.URL.Query()
+	rawInterval := query.Get("interval")
+	if rawInterval == "" {
+		rawInterval = domain.FlowIntervalDay.String()
+	}
+	details := []httpschema.Detail{}
+	interval := httpschema.ValidateField("interval", rawInterval, domain.NewFlowInterval, &details)
+	from, to := parseFlowRange(query, interval, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	flow, err := h.analyticsService.CumulativeFlow(r.Context(), userID, boardID, from, to, interval)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newCumulativeFlowResponse(&flow))
+}
+
+type cycleTimeResponse struct {
+	BoardID   string                  `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	From      string                  `json:"from" example:"2026-03-09"`
+	To        string                  `json:"to" example:"2026-03-22"`
+	Completed int                     `json:"completed" example:"12"`
+	LeadTime  *percentilesResponse    `json:"leadTime"`
+	CycleTime *percentilesResponse    `json:"cycleTime"`
+	Tasks     []completedTaskResponse `json:"tasks"`
+}
+
+// percentilesResponse holds durations in days; it is null when no task was finished.
+type percentilesResponse struct {
+	P50 float64 `json:"p50" example:"2.5"`
+	P85 float64 `json:"p85" example:"4.75"`
+	P95 float64 `json:"p95" example:"6"`
+}
+
+// completedTaskResponse is a point of the cycle time scatterplot.
+type completedTaskResponse struct {
+	TaskID        string  `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	Name          string  `json:"name" example:"Ship onboarding"`
+	CreatedAt     string  `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	StartedAt     string  `json:"startedAt" example:"2026-03-08T10:00:00.000+03:00"`
+	DoneAt        string  `json:"doneAt" example:"2026-03-10T18:30:00.000+03:00"`
+	LeadTimeDays  float64 `json:"leadTimeDays" example:"2.9"`
+	CycleTimeDays float64 `json:"cycleTimeDays" example:"2.35"`
+}
+
+// durationDays converts a duration to days rounded to two decimals.
+func durationDays(d time.Duration) float64 {
+	return math.Round(d.Hours()/24*100) / 100
+}
+
+func newPercentilesResponse(percentiles domain.DurationPercentiles) *percentilesResponse {
+	return &percentilesResponse{
+		P50: durationDays(percentiles.P50),
+		P85: durationDays(percentiles.P85),
+		P95: durationDays(percentiles.P95),
+	}
+}
+
+func newCycleTimeResponse(report *domain.CycleTimeReport) cycleTimeResponse {
+	tasks := make([]completedTaskResponse, len(report.Tasks))
+	for i, task := range report.Tasks {
+		tasks[i] = completedTaskResponse{
+			TaskID:        task.ID.String(),
+			Name:          task.Name.String(),
+			CreatedAt:     service.FormatRFC3339Millis(task.CreatedAt),
+			StartedAt:     service.FormatRFC3339Millis(task.StartedAt),
+			DoneAt:        service.FormatRFC3339Millis(task.DoneAt),
+			LeadTimeDays:  durationDays(task.LeadTime()),
+			CycleTimeDays: durationDays(task.CycleTime()),
+		}
+	}
+
+	response := cycleTimeResponse{
+		BoardID:   report.BoardID.String(),
+		From:      report.From.Format(time.DateOnly),
+		To:        report.To.Format(time.DateOnly),
+		Completed: len(report.Tasks),
+		Tasks:     tasks,
+	}
+	if len(report.Tasks) > 0 {
+		response.LeadTime = newPercentilesResponse(report.LeadTime)
+		response.CycleTime = newPercentilesResponse(report.CycleTime)
+	}
+
+	return response
+}
+
+// CycleTimes godoc
+// @Summary Get lead and cycle times
+// @Description Get the p50, p85 and p95 lead time (creation to done) and cycle time (start to done) in days of the tasks of a board finished from from through to, and every such task as a scatterplot point.
+// @Description A task starts when it first enters a column with isStarted or isDone set and is finished when it last enters a done column from a column that is not done. Only tasks that are in a done column now count. The range spans at most 366 days.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param from query string true "First day, YYYY-MM-DD"
+// @Param to query string true "Last day, YYYY-MM-DD"
+// @Success 200 {object} cycleTimeResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/analytics/cycle-time [get]
+func (h *analytics) CycleTimes(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	details := []httpschema.Detail{}
+	from, to := parseFlowRange(r.URL.Query(), domain.FlowIntervalDay, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	report, err := h.analyticsService.CycleTimes(r.Context(), userID, boardID, from, to)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newCycleTimeResponse(&report))
+}
+
+type throughputResponse struct {
+	BoardID string                   `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	From    string                   `json:"from" example:"2026-03-09"`
+	To      string                   `json:"to" example:"2026-03-22"`
+	Weeks   []throughputWeekResponse `json:"weeks"`
+}
+
+// throughputWeekResponse is the number of tasks finished in the week starting on Monday weekStart.
+type throughputWeekResponse struct {
+	WeekStart string `json:"weekStart" example:"2026-03-09"`
+	Completed int64  `json:"completed" example:"5"`
+}
+
+func newThroughputResponse(report *domain.ThroughputReport) throughputResponse {
+	weeks := make([]throughputWeekResponse, len(report.Weeks))
+	for i, week := range report.Weeks {
+		weeks[i] = throughputWeekResponse{WeekStart: week.Start.Format(time.DateOnly), Completed: week.Completed}
+	}
+
+	return throughputResponse{
+		BoardID: report.BoardID.String(),
+		From:    report.From.Format(time.DateOnly),
+		To:      report.To.Format(time.DateOnly),
+		Weeks:   weeks,
+	}
+}
+
+// Throughput godoc
+// @Summary Get weekly throughput
+// @Description Get the number of tasks of a board finished in every week, starting on Monday, from the week of from through the week of to. Only tasks finished from from through to count, with the same definition of finished as the cycle time. The range spans at most 366 weeks.
+// @Tags analytics
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param from query string true "First day, YYYY-MM-DD"
+// @Param to query string true "Last day, YYYY-MM-DD"
+// @Success 200 {object} throughputResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/analytics/throughput [get]
+func (h *analytics) Throughput(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	details := []httpschema.Detail{}
+	from, to := parseFlowRange(r.URL.Query(), domain.FlowIntervalWeek, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	report, err := h.analyticsService.Throughput(r.Context(), userID, boardID, from, to)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newThroughputResponse(&report))
+}
+
+type forecastBody struct {
+	Tasks            *int64  `json:"tasks" example:"20"`
+	TargetDate       *string `json:"targetDate" example:"2026-04-30"`
+	Simulations      *int64  `json:"simulations" example:"5000"`
+	HistoryDays      *int64  `json:"historyDays" example:"90"`
+	ConfidenceLevels []int64 `json:"confidenceLevels" example:"50,85,95"`
+	Seed             *int64  `json:"seed" example:"42"`
+}
+
+type forecastResponse struct {
+	BoardID     string                    `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	Tasks       *int64                    `json:"tasks" example:"20"`
+	TargetDate  *string                   `json:"targetDate" example:"2026-04-30"`
+	Start       string                    `json:"start" example:"2026-03-10"`
+	Simulations int64                     `json:"simulations" example:"5000"`
+	Seed        int64                     `json:"seed" example:"42"`
+	History     forecastHistoryResponse   `json:"history"`
+	Outcomes    []forecastOutcomeResponse `json:"outcomes"`
+}
+
+// forecastHistoryResponse is the range of full days whose throughput was sampled.
+type forecastHistoryResponse struct {
+	From         string  `json:"from" example:"2025-12-09"`
+	To           string  `json:"to" example:"2026-03-08"`
+	Completed    int64   `json:"completed" example:"63"`
+	DailyAverage float64 `json:"dailyAverage" example:"0.7"`
+}
+
+// forecastOutcomeResponse answers the forecast with a confidence in percent: the day by which
+// the tasks are done, null when not within 730 days, or the number of tasks done by targetDate.
+type forecastOutcomeResponse struct {
+	Confidence int64   `json:"confidence" example:"85"`
+	Date       *string `json:"date" example:"2026-04-02"`
+	Tasks      *int64  `json:"tasks" example:"14"`
+}
+
+func newForecastResponse(forecast *domain.Forecast) forecastResponse {
+	outcomes := make([]forecastOutcomeResponse, len(forecast.Outcomes))
+	for i, outcome := range forecast.Outcomes {
+		outcomes[i] = forecastOutcomeResponse{Confidence: outcome.Confidence.Int64()}
+		if forecast.Target.IsDate() {
+			outcomes[i].Tasks = &outcome.Tasks
+		} else {
+			outcomes[i].Date = newDateResponse(outcome.Date)
+		}
+	}
+
+	response := forecastResponse{
+		BoardID:     forecast.BoardID.String(),
+		TargetDate:  newDateResponse(forecast.Target.Date),
+		Start:       forecast.Start.Format(time.DateOnly),
+		Simulations: forecast.Settings.Simulations.Int64(),
+		Seed:        forecast.Settings.Seed.Int64(),
+		History: forecastHistoryResponse{
+			From:      forecast.HistoryFrom.Format(time.DateOnly),
+			To:        forecast.HistoryTo.Format(time.DateOnly),
+			Completed: forecast.Completed(),
+		},
+		Outcomes: outcomes,
+	}
+	if !forecast.Target.IsDate() {
+		tasks := forecast.Target.Tasks.Int64()
+		response.Tasks = &tasks
+	}
+	if len(forecast.History) > 0 {
+		average := float64(forecast.Completed()) / float64(len(forecast.History))
+		response.History.DailyAverage = math.Round(average*100) / 100
+	}
+
+	return response
+}
+
+// parseForecastBody validates the target and the settings of a forecast, filling in the
+// defaults of omitted settings. An omitted seed gets a random one.
+func parseForecastBody(body *forecastBody, details *[]httpschema.Detail) (domain.ForecastTarget, domain.ForecastSettings) {
+	var (
+		tasks      domain.ForecastTaskCount
+		targetDate time.Time
+	)
+	if body.Tasks != nil {
+		tasks = httpschema.ValidateField("tasks", *body.Tasks, domain.NewForecastTaskCount, details)
+	}
+	if body.TargetDate != nil {
+		targetDate = httpschema.ValidateField("targetDate", *body.TargetDate, domain.ParseFlowDate, details)
+	}
+	var target domain.ForecastTarget
+	if len(*details) == 0 {
+		target = httpschema.ValidateField("tasks", tasks, func(tasks domain.ForecastTaskCount) (domain.ForecastTarget, error) {
+			return domain.NewForecastTarget(tasks, targetDate)
+		}, details)
+	}
+
+	simulations := int64(domain.DefaultForecastSimulations)
+	if body.Simulations != nil {
+		simulations = *body.Simulations
+	}
+	historyDays := int64(domain.DefaultForecastHistoryDays)
+	if body.HistoryDays != nil {
+		historyDays = *body.HistoryDays
+	}
+	confidenceLevels := domain.DefaultForecastConfidences
+	if body.ConfidenceLevels != nil {
+		confidenceLevels = body.ConfidenceLevels
+	}
+	seed := rand.Int64N(domain.MaxForecastSeed + 1)
+	if body.Seed != nil {
+		seed = *body.Seed
+	}
+
+	settings := domain.ForecastSettings{
+		Simulations: httpschema.ValidateField("simulations", simulations, domain.NewForecastSimulations, details),
+		HistoryDays: httpschema.ValidateField("historyDays", historyDays, domain.NewForecastHistoryDays, details),
+		Confidences: httpschema.ValidateField("confidenceLevels", confidenceLevels, domain.NewForecastConfidences, details),
+		Seed:        httpschema.ValidateField("seed", seed, domain.NewForecastSeed, details),
+	}
+
+	return target, settings
+}
+
+// Forecast godoc
+// @Summary Forecast delivery
+// @Description Run Monte Carlo simulations of the future throughput of a board and answer either "when will these tasks be done?" (tasks) or "how many tasks will be done by this day?" (targetDate); exactly one of them must be set.
+// @Description Every simulated day, starting tomorrow, finishes as many tasks as a day drawn at random from the historyDays full days before today, with the same definition of finished as the cycle time. Each confidence level gets the outcome reached in at least that share of the simulations.
+// @Description Simulations run for at most 730 days, which also bounds targetDate. The same seed, settings and history give the same outcomes; the seed used is returned so a forecast can be replayed.
+// @Tags analytics
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param request body forecastBody true "Target and simulation settings. Defaults: simulations 5000 (100 to 10000), historyDays 90 (7 to 365), confidenceLevels [50, 85, 95] (1 to 99, at most 10), random seed (0 to 2^53 - 1)."
+// @Success 200 {object} forecastResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 413 {object} httpschema.Error "PAYLOAD_TOO_LARGE"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/forecast [post]
+func (h *analytics) Forecast(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	var body forecastBody
+	err = decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	target, settings := parseForecastBody(&body, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	forecast, err := h.analyticsService.Forecast(r.Context(), userID, boardID, target, settings)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		case errors.Is(err, service.ErrForecastTargetDate):
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "targetDate", Issues: []string{domain.ErrForecastTargetDateRange}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newForecastResponse(&forecast))
+}
@@ -0,0 +1,765 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestAnalytics_Burndown(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	series := domain.BurndownSeries{
+		BoardID:  validBoard.ID,
+		SprintID: validSprint.ID,
+		From:     validSprint.StartDate,
+		To:       validSprint.EndDate,
+		Points: []domain.BurndownPoint{
+			{Day: validSprint.StartDate, Remaining: 13},
+			{Day: validSprint.StartDate.AddDate(0, 0, 1), Remaining: 7.5, Completed: 5.5},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		boardID               string
+		query                 string
+		context               context.Context
+		setupAnalyticsService func(t *testing.T, s *MockAnalyticsService)
+		wantCode              int
+		wantBody              any
+	}{
+		{
+			name:    "Sprint",
+			boardID: validBoard.ID.String(),
+			query:   "?sprintId=" + validSprint.ID.String(),
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.BurndownFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error) {
+					if sprintID != validSprint.ID {
+						t.Errorf("got sprint id %v, want %v", sprintID, validSprint.ID)
+					}
+					if !from.IsZero() || !to.IsZero() {
+						t.Errorf("got range %v..%v, want unbounded", from, to)
+					}
+					return series, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":  validBoard.ID.String(),
+				"sprintId": validSprint.ID.String(),
+				"from":     "2026-01-05",
+				"to":       "2026-01-18",
+				"burndown": []any{
+					map[string]any{"date": "2026-01-05", "remaining": 13},
+					map[string]any{"date": "2026-01-06", "remaining": 7.5},
+				},
+				"burnup": []any{
+					map[string]any{"date": "2026-01-05", "completed": 0, "scope": 13},
+					map[string]any{"date": "2026-01-06", "completed": 5.5, "scope": 13},
+				},
+			},
+		},
+		{
+			name:    "Board with open range",
+			boardID: validBoard.ID.String(),
+			query:   "?from=2026-01-05",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.BurndownFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error) {
+					if !sprintID.IsNil() {
+						t.Errorf("got sprint id %v, want nil", sprintID)
+					}
+					if !from.Equal(validSprint.StartDate) || !to.IsZero() {
+						t.Errorf("got range %v..%v, want %v..", from, to, validSprint.StartDate)
+					}
+					return domain.BurndownSeries{BoardID: boardID, From: from}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":  validBoard.ID.String(),
+				"sprintId": nil,
+				"from":     "2026-01-05",
+				"to":       nil,
+				"burndown": []any{},
+				"burnup":   []any{},
+			},
+		},
+		{
+			name:     "Invalid query",
+			boardID:  validBoard.ID.String(),
+			query:    "?sprintId=nope&to=18.01.2026",
+			wantCode: http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "sprintId", "issues": []string{"Invalid sprint id"}},
+					map[string]any{"field": "to", "issues": []string{domain.ErrBurndownDateInvalid}},
+				},
+			},
+		},
+		{
+			name:    "Range ends before its start",
+			boardID: validBoard.ID.String(),
+			query:   "?from=2026-01-18&to=2026-01-05",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.BurndownFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error) {
+					return domain.BurndownSeries{}, service.ErrBurndownRangeInvalid
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("to", []string{domain.ErrBurndownToBeforeFrom}),
+		},
+		{
+			name:    "Sprint not found",
+			boardID: validBoard.ID.String(),
+			query:   "?sprintId=" + validSprint.ID.String(),
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.BurndownFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error) {
+					return domain.BurndownSeries{}, service.ErrSprintNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: sprintNotFoundError(),
+		},
+		{
+			name:    "Board not found",
+			boardID: validBoard.ID.String(),
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.BurndownFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error) {
+					return domain.BurndownSeries{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:     "Missing context user",
+			boardID:  validBoard.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.BurndownFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error) {
+					return domain.BurndownSeries{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+tt.boardID+"/analytics/burndown"+tt.query, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+			rr := httptest.NewRecorder()
+			mockAnalytics := NewMockAnalyticsService(t)
+			if tt.setupAnalyticsService != nil {
+				tt.setupAnalyticsService(t, mockAnalytics)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAnalytics(logger, mockAnalytics, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Burndown(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestAnalytics_CumulativeFlow(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todo := testutil.NewValidColumn(t, validBoard.ID, "To Do", 1)
+	done := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	done.IsDone = true
+	from := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	flow := domain.CumulativeFlow{
+		BoardID:  validBoard.ID,
+		Interval: domain.FlowIntervalDay,
+		From:     from,
+		To:       from.AddDate(0, 0, 1),
+		Columns:  []domain.Column{todo, done},
+		Points: []domain.CumulativeFlowPoint{
+			{Day: from, Counts: []int64{3, 0}},
+			{Day: from.AddDate(0, 0, 1), Counts: []int64{1, 2}},
+		},
+	}
+
+	tests := []struct {
+		name                  string
+		query                 string
+		context               context.Context
+		setupAnalyticsService func(t *testing.T, s *MockAnalyticsService)
+		wantCode              int
+		wantBody              any
+	}{
+		{
+			name:  "Success defaults to daily points",
+			query: "?from=2026-01-05&to=2026-01-06",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.CumulativeFlowFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, gotFrom time.Time, to time.Time, interval domain.FlowInterval) (domain.CumulativeFlow, error) {
+					if !gotFrom.Equal(from) || !to.Equal(from.AddDate(0, 0, 1)) {
+						t.Errorf("got range %v..%v, want 2026-01-05..2026-01-06", gotFrom, to)
+					}
+					if interval != domain.FlowIntervalDay {
+						t.Errorf("got interval %v, want day", interval)
+					}
+					return flow, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":  validBoard.ID.String(),
+				"interval": "day",
+				"from":     "2026-01-05",
+				"to":       "2026-01-06",
+				"columns": []any{
+					map[string]any{"id": todo.ID.String(), "name": "To Do", "position": 1, "isStarted": false, "isDone": false},
+					map[string]any{"id": done.ID.String(), "name": "Done", "position": 2, "isStarted": false, "isDone": true},
+				},
+				"points": []any{
+					map[string]any{"date": "2026-01-05", "counts": []any{3, 0}},
+					map[string]any{"date": "2026-01-06", "counts": []any{1, 2}},
+				},
+			},
+		},
+		{
+			name:  "Weekly points",
+			query: "?from=2026-01-05&to=2026-03-30&interval=week",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.CumulativeFlowFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) (domain.CumulativeFlow, error) {
+					if interval != domain.FlowIntervalWeek {
+						t.Errorf("got interval %v, want week", interval)
+					}
+					return domain.CumulativeFlow{BoardID: boardID, Interval: interval, From: from, To: to}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":  validBoard.ID.String(),
+				"interval": "week",
+				"from":     "2026-01-05",
+				"to":       "2026-03-30",
+				"columns":  []any{},
+				"points":   []any{},
+			},
+		},
+		{
+			name:     "Invalid query",
+			query:    "?to=06.01.2026&interval=month",
+			wantCode: http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "interval", "issues": []string{domain.ErrFlowIntervalValue}},
+					map[string]any{"field": "from", "issues": []string{domain.ErrFlowDateRequired}},
+					map[string]any{"field": "to", "issues": []string{domain.ErrBurndownDateInvalid}},
+				},
+			},
+		},
+		{
+			name:     "Range ends before its start",
+			query:    "?from=2026-01-06&to=2026-01-05",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("to", []string{domain.ErrBurndownToBeforeFrom}),
+		},
+		{
+			name:     "Range too long",
+			query:    "?from=2025-01-01&to=2026-12-31",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("to", []string{domain.ErrFlowRangeTooLong}),
+		},
+		{
+			name:  "Board not found",
+			query: "?from=2026-01-05&to=2026-01-06",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.CumulativeFlowFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) (domain.CumulativeFlow, error) {
+					return domain.CumulativeFlow{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:     "Missing context user",
+			query:    "?from=2026-01-05&to=2026-01-06",
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:  "Internal error",
+			query: "?from=2026-01-05&to=2026-01-06",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.CumulativeFlowFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) (domain.CumulativeFlow, error) {
+					return domain.CumulativeFlow{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+validBoard.ID.String()+"/analytics/cfd"+tt.query, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", validBoard.ID.String())
+			rr := httptest.NewRecorder()
+			mockAnalytics := NewMockAnalyticsService(t)
+			if tt.setupAnalyticsService != nil {
+				tt.setupAnalyticsService(t, mockAnalytics)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAnalytics(logger, mockAnalytics, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.CumulativeFlow(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestAnalytics_CycleTimes(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTask := testutil.ValidTask(domain.NewColumnID())
+	from := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	created := time.Date(2026, time.January, 2, 9, 0, 0, 0, time.UTC)
+	report := domain.CycleTimeReport{
+		BoardID:   validBoard.ID,
+		From:      from,
+		To:        from.AddDate(0, 0, 6),
+		LeadTime:  domain.DurationPercentiles{P50: 84 * time.Hour, P85: 84 * time.Hour, P95: 84 * time.Hour},
+		CycleTime: domain.DurationPercentiles{P50: 36 * time.Hour, P85: 36 * time.Hour, P95: 36 * time.Hour},
+		Tasks: []domain.CompletedTask{{
+			ID:        validTask.ID,
+			Name:      validTask.Name,
+			CreatedAt: created,
+			StartedAt: created.Add(48 * time.Hour),
+			DoneAt:    created.Add(84 * time.Hour),
+		}},
+	}
+
+	tests := []struct {
+		name                  string
+		query                 string
+		setupAnalyticsService func(t *testing.T, s *MockAnalyticsService)
+		wantCode              int
+		wantBody              any
+	}{
+		{
+			name:  "Success",
+			query: "?from=2026-01-05&to=2026-01-11",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.CycleTimesFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, gotFrom time.Time, to time.Time) (domain.CycleTimeReport, error) {
+					if !gotFrom.Equal(from) || !to.Equal(from.AddDate(0, 0, 6)) {
+						t.Errorf("got range %v..%v, want 2026-01-05..2026-01-11", gotFrom, to)
+					}
+					return report, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":   validBoard.ID.String(),
+				"from":      "2026-01-05",
+				"to":        "2026-01-11",
+				"completed": 1,
+				"leadTime":  map[string]any{"p50": 3.5, "p85": 3.5, "p95": 3.5},
+				"cycleTime": map[string]any{"p50": 1.5, "p85": 1.5, "p95": 1.5},
+				"tasks": []any{
+					map[string]any{
+						"taskId":        validTask.ID.String(),
+						"name":          validTask.Name.String(),
+						"createdAt":     service.FormatRFC3339Millis(created),
+						"startedAt":     service.FormatRFC3339Millis(created.Add(48 * time.Hour)),
+						"doneAt":        service.FormatRFC3339Millis(created.Add(84 * time.Hour)),
+						"leadTimeDays":  3.5,
+						"cycleTimeDays": 1.5,
+					},
+				},
+			},
+		},
+		{
+			name:  "No finished tasks",
+			query: "?from=2026-01-05&to=2026-01-11",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.CycleTimesFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error) {
+					return domain.CycleTimeReport{BoardID: boardID, From: from, To: to}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":   validBoard.ID.String(),
+				"from":      "2026-01-05",
+				"to":        "2026-01-11",
+				"completed": 0,
+				"leadTime":  nil,
+				"cycleTime": nil,
+				"tasks":     []any{},
+			},
+		},
+		{
+			name:     "Range too long",
+			query:    "?from=2025-01-01&to=2026-12-31",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("to", []string{domain.ErrFlowRangeTooLong}),
+		},
+		{
+			name:  "Board not found",
+			query: "?from=2026-01-05&to=2026-01-11",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.CycleTimesFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error) {
+					return domain.CycleTimeReport{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+validBoard.ID.String()+"/analytics/cycle-time"+tt.query, http.NoBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			rr := httptest.NewRecorder()
+			mockAnalytics := NewMockAnalyticsService(t)
+			if tt.setupAnalyticsService != nil {
+				tt.setupAnalyticsService(t, mockAnalytics)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAnalytics(logger, mockAnalytics, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.CycleTimes(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestAnalytics_Throughput(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	monday := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                  string
+		query                 string
+		setupAnalyticsService func(t *testing.T, s *MockAnalyticsService)
+		wantCode              int
+		wantBody              any
+	}{
+		{
+			name:  "Success",
+			query: "?from=2026-01-07&to=2026-01-14",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.ThroughputFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.ThroughputReport, error) {
+					return domain.ThroughputReport{
+						BoardID: boardID,
+						From:    from,
+						To:      to,
+						Weeks:   []domain.ThroughputWeek{{Start: monday, Completed: 3}, {Start: monday.AddDate(0, 0, 7)}},
+					}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId": validBoard.ID.String(),
+				"from":    "2026-01-07",
+				"to":      "2026-01-14",
+				"weeks": []any{
+					map[string]any{"weekStart": "2026-01-05", "completed": 3},
+					map[string]any{"weekStart": "2026-01-12", "completed": 0},
+				},
+			},
+		},
+		{
+			name:     "Missing range",
+			wantCode: http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "from", "issues": []string{domain.ErrFlowDateRequired}},
+					map[string]any{"field": "to", "issues": []string{domain.ErrFlowDateRequired}},
+				},
+			},
+		},
+		{
+			name:  "Internal error",
+			query: "?from=2026-01-07&to=2026-01-14",
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.ThroughputFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.ThroughputReport, error) {
+					return domain.ThroughputReport{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+validBoard.ID.String()+"/analytics/throughput"+tt.query, http.NoBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			rr := httptest.NewRecorder()
+			mockAnalytics := NewMockAnalyticsService(t)
+			if tt.setupAnalyticsService != nil {
+				tt.setupAnalyticsService(t, mockAnalytics)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAnalytics(logger, mockAnalytics, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Throughput(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestAnalytics_Forecast(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	start := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	historyFrom := start.AddDate(0, 0, -91)
+	historyTo := start.AddDate(0, 0, -2)
+	forecastFor := func(target domain.ForecastTarget, settings domain.ForecastSettings) domain.Forecast {
+		outcomes := make([]domain.ForecastOutcome, len(settings.Confidences))
+		for i, confidence := range settings.Confidences {
+			outcomes[i] = domain.ForecastOutcome{Confidence: confidence, Date: start.AddDate(0, 0, 10*i), Tasks: int64(30 - 10*i)}
+		}
+		return domain.Forecast{
+			BoardID:     validBoard.ID,
+			Target:      target,
+			Settings:    settings,
+			Start:       start,
+			HistoryFrom: historyFrom,
+			HistoryTo:   historyTo,
+			History:     []int64{1, 0, 2},
+			Outcomes:    outcomes,
+		}
+	}
+
+	tests := []struct {
+		name                  string
+		inputBody             any
+		setupAnalyticsService func(t *testing.T, s *MockAnalyticsService)
+		wantCode              int
+		wantBody              any
+	}{
+		{
+			name:      "Success tasks target with defaults",
+			inputBody: map[string]any{"tasks": 20},
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.ForecastFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, target domain.ForecastTarget, settings domain.ForecastSettings) (domain.Forecast, error) {
+					if target.IsDate() || target.Tasks.Int64() != 20 {
+						t.Errorf("got target %+v, want 20 tasks", target)
+					}
+					if settings.Simulations.Int64() != 5000 || settings.HistoryDays.Int64() != 90 || len(settings.Confidences) != 3 {
+						t.Errorf("got settings %+v, want the defaults", settings)
+					}
+					// The seed is random when omitted; answer with a fixed one to keep the body stable.
+					forecast := forecastFor(target, settings)
+					forecast.Settings.Seed, _ = domain.NewForecastSeed(42)
+					return forecast, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":     validBoard.ID.String(),
+				"tasks":       20,
+				"targetDate":  nil,
+				"start":       "2026-03-10",
+				"simulations": 5000,
+				"seed":        42,
+				"history":     map[string]any{"from": "2025-12-09", "to": "2026-03-08", "completed": 3, "dailyAverage": 1},
+				"outcomes": []any{
+					map[string]any{"confidence": 50, "date": "2026-03-10", "tasks": nil},
+					map[string]any{"confidence": 85, "date": "2026-03-20", "tasks": nil},
+					map[string]any{"confidence": 95, "date": "2026-03-30", "tasks": nil},
+				},
+			},
+		},
+		{
+			name: "Success date target",
+			inputBody: map[string]any{
+				"targetDate":       "2026-04-30",
+				"simulations":      1000,
+				"historyDays":      30,
+				"confidenceLevels": []int{90, 70},
+				"seed":             7,
+			},
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.ForecastFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, target domain.ForecastTarget, settings domain.ForecastSettings) (domain.Forecast, error) {
+					if !target.Date.Equal(time.Date(2026, time.April, 30, 0, 0, 0, 0, time.UTC)) {
+						t.Errorf("got target date %v, want 2026-04-30", target.Date)
+					}
+					if settings.Simulations.Int64() != 1000 || settings.HistoryDays.Int64() != 30 {
+						t.Errorf("got settings %+v, want 1000 simulations over 30 days", settings)
+					}
+					return forecastFor(target, settings), nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":     validBoard.ID.String(),
+				"tasks":       nil,
+				"targetDate":  "2026-04-30",
+				"start":       "2026-03-10",
+				"simulations": 1000,
+				"seed":        7,
+				"history":     map[string]any{"from": "2025-12-09", "to": "2026-03-08", "completed": 3, "dailyAverage": 1},
+				"outcomes": []any{
+					map[string]any{"confidence": 70, "date": nil, "tasks": 30},
+					map[string]any{"confidence": 90, "date": nil, "tasks": 20},
+				},
+			},
+		},
+		{
+			name:      "Missing target",
+			inputBody: map[string]any{"seed": 7},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("tasks", []string{domain.ErrForecastTargetRequired}),
+		},
+		{
+			name:      "Both targets",
+			inputBody: map[string]any{"tasks": 5, "targetDate": "2026-04-30", "seed": 7},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("tasks", []string{domain.ErrForecastTargetConflict}),
+		},
+		{
+			name: "Invalid settings",
+			inputBody: map[string]any{
+				"tasks":            5,
+				"simulations":      10,
+				"historyDays":      1000,
+				"confidenceLevels": []int{0},
+				"seed":             -1,
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "simulations", "issues": []string{domain.ErrForecastSimulationsValue}},
+					map[string]any{"field": "historyDays", "issues": []string{domain.ErrForecastHistoryDaysValue}},
+					map[string]any{"field": "confidenceLevels", "issues": []string{domain.ErrForecastConfidenceValue}},
+					map[string]any{"field": "seed", "issues": []string{domain.ErrForecastSeedValue}},
+				},
+			},
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: `{"tasks":`,
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Target date outside horizon",
+			inputBody: map[string]any{"targetDate": "2026-01-01", "seed": 7},
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.ForecastFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, target domain.ForecastTarget, settings domain.ForecastSettings) (domain.Forecast, error) {
+					return domain.Forecast{}, service.ErrForecastTargetDate
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("targetDate", []string{domain.ErrForecastTargetDateRange}),
+		},
+		{
+			name:      "Board not found",
+			inputBody: map[string]any{"tasks": 5, "seed": 7},
+			setupAnalyticsService: func(t *testing.T, s *MockAnalyticsService) {
+				s.ForecastFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, target domain.ForecastTarget, settings domain.ForecastSettings) (domain.Forecast, error) {
+					return domain.Forecast{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/forecast"
+			var req *http.Request
+			if body, ok := tt.inputBody.(string); ok {
+				req = httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+			} else {
+				req, _ = testutil.NewJSONRequestAndRecorder(t, http.MethodPost, path, tt.inputBody)
+			}
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			rr := httptest.NewRecorder()
+			mockAnalytics := NewMockAnalyticsService(t)
+			if tt.setupAnalyticsService != nil {
+				tt.setupAnalyticsService(t, mockAnalytics)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAnalytics(logger, mockAnalytics, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Forecast(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
@@ -0,0 +1,685 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type automationsService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error)
+	ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Automation, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID, patch service.AutomationPatch) (domain.Automation, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID) error
+	ListRuns(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.AutomationRun, error)
+	DryRun(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, trigger domain.AutomationTrigger, action domain.AutomationAction, events domain.AutomationDryRunEvents) (domain.AutomationDryRun, error)
+}
+
+type automations struct {
+	logger             *slog.Logger
+	automationsService automationsService
+	responder          *httpschema.ErrorResponder
+}
+
+func NewAutomations(logger *slog.Logger, automationsService automationsService, responder *httpschema.ErrorResponder) *automations {
+	moduleLogger := logging.WithModule(logger, "handler.automations")
+
+	return &automations{logger: moduleLogger, automationsService: automationsService, responder: responder}
+}
+
+type automationTriggerBody struct {
+	Type     string `json:"type" example:"task_moved" enums:"task_created,task_moved,due_date_passed,label_added"`
+	ColumnID string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	FieldID  string `json:"fieldId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Label    string `json:"label" example:"Urgent"`
+}
+
+type automationActionBody struct {
+	Type     string          `json:"type" example:"move_to_column" enums:"move_to_column,set_custom_field,add_label,notify_telegram,create_task"`
+	ColumnID string          `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	FieldID  string          `json:"fieldId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Value    json.RawMessage `json:"value" swaggertype:"object"`
+	Message  string          `json:"message" example:"Ready for review"`
+	TaskName string          `json:"taskName" example:"Write release notes"`
+	Label    string          `json:"label" example:"Urgent"`
+}
+
+type createAutomationBody struct {
+	Name    string                `json:"name" example:"Move approved tasks to Done"`
+	Trigger automationTriggerBody `json:"trigger"`
+	Action  automationActionBody  `json:"action"`
+	Enabled *bool                 `json:"enabled" example:"true"`
+}
+
+type updateAutomationBody struct {
+	Name    *string                `json:"name" example:"Move approved tasks to Done"`
+	Trigger *automationTriggerBody `json:"trigger"`
+	Action  *automationActionBody  `json:"action"`
+	Enabled *bool                  `json:"enabled" example:"false"`
+}
+
+type dryRunAutomationBody struct {
+	Trigger automationTriggerBody `json:"trigger"`
+	Action  automationActionBody  `json:"action"`
+	Events  *int64                `json:"events" example:"50"`
+}
+
+type automationTriggerResponse struct {
+	Type     string  `json:"type" example:"task_moved"`
+	ColumnID *string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	FieldID  *string `json:"fieldId,omitempty" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Label    *string `json:"label,omitempty" example:"Urgent"`
+}
+
+type automationActionResponse struct {
+	Type     string          `json:"type" example:"move_to_column"`
+	ColumnID *string         `json:"columnId,omitempty" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	FieldID  *string         `json:"fieldId,omitempty" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Value    json.RawMessage `json:"value,omitempty" swaggertype:"object"`
+	Message  *string         `json:"message,omitempty" example:"Ready for review"`
+	TaskName *string         `json:"taskName,omitempty" example:"Write release notes"`
+	Label    *string         `json:"label,omitempty" example:"Urgent"`
+}
+
+type automationResponse struct {
+	ID        string                    `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a8"`
+	BoardID   string                    `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	Name      string                    `json:"name" example:"Move approved tasks to Done"`
+	Trigger   automationTriggerResponse `json:"trigger"`
+	Action    automationActionResponse  `json:"action"`
+	Enabled   bool                      `json:"enabled" example:"true"`
+	CreatedAt string                    `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt string                    `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newAutomationResponse(automation *domain.Automation) automationResponse {
+	trigger := automationTriggerResponse{Type: automation.Trigger.Type.String()}
+	if !automation.Trigger.ColumnID.IsNil() {
+		columnID := automation.Trigger.ColumnID.String()
+		trigger.ColumnID = &columnID
+	}
+	if automation.Trigger.Type.IsFieldTrigger() {
+		fieldID := automation.Trigger.FieldID.String()
+		trigger.FieldID = &fieldID
+	}
+	if automation.Trigger.Label != "" {
+		label := automation.Trigger.Label
+		trigger.Label = &label
+	}
+
+	action := automationActionResponse{Type: automation.Action.Type.String()}
+	switch automation.Action.Type {
+	case domain.AutomationMoveToColumn:
+		columnID := automation.Action.ColumnID.String()
+		action.ColumnID = &columnID
+	case domain.AutomationSetCustomField:
+		fieldID := automation.Action.FieldID.String()
+		action.FieldID = &fieldID
+		action.Value = automation.Action.Value
+	case domain.AutomationAddLabel:
+		fieldID := automation.Action.FieldID.String()
+		label := automation.Action.Label
+		action.FieldID = &fieldID
+		action.Label = &label
+	case domain.AutomationNotifyTelegram:
+		message := automation.Action.Message.String()
+		action.Message = &message
+	case domain.AutomationCreateTask:
+		columnID := automation.Action.ColumnID.String()
+		taskName := automation.Action.TaskName.String()
+		action.ColumnID = &columnID
+		action.TaskName = &taskName
+	}
+
+	return automationResponse{
+		ID:        automation.ID.String(),
+		BoardID:   automation.BoardID.String(),
+		Name:      automation.Name.String(),
+		Trigger:   trigger,
+		Action:    action,
+		Enabled:   automation.Enabled,
+		CreatedAt: service.FormatRFC3339Millis(automation.CreatedAt),
+		UpdatedAt: service.FormatRFC3339Millis(automation.UpdatedAt),
+	}
+}
+
+type listAutomationsResponse = []automationResponse
+
+type automationRunResponse struct {
+	ID           string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a9"`
+	AutomationID string `json:"automationId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a8"`
+	EventID      string `json:"eventId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86b0"`
+	TaskID       string `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+	Status       string `json:"status" example:"failed" enums:"succeeded,failed,skipped"`
+	Message      string `json:"message" example:"Board workflow doesn't allow moving the task to the column"`
+	RanAt        string `json:"ranAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+type listAutomationRunsResponse = []automationRunResponse
+
+type automationEventResponse struct {
+	ID           string  `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86b0"`
+	Type         string  `json:"type" example:"task_moved"`
+	TaskID       string  `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+	FromColumnID *string `json:"fromColumnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	ToColumnID   string  `json:"toColumnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	FieldID      *string `json:"fieldId,omitempty" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Value        *string `json:"value,omitempty" example:"Urgent"`
+	OccurredAt   string  `json:"occurredAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+type automationDryRunResponse struct {
+	Events  int                       `json:"events" example:"50"`
+	Matches []automationEventResponse `json:"matches"`
+}
+
+func newAutomationDryRunResponse(dryRun *domain.AutomationDryRun) automationDryRunResponse {
+	matches := make([]automationEventResponse, len(dryRun.Matches))
+	for i, event := range dryRun.Matches {
+		matches[i] = automationEventResponse{
+			ID:         event.ID.String(),
+			Type:       event.Type.String(),
+			TaskID:     event.TaskID.String(),
+			ToColumnID: event.ToColumnID.String(),
+			OccurredAt: service.FormatRFC3339Millis(event.OccurredAt),
+		}
+		if !event.FromColumnID.IsNil() {
+			fromColumnID := event.FromColumnID.String()
+			matches[i].FromColumnID = &fromColumnID
+		}
+		if event.Type.IsFieldTrigger() {
+			fieldID := event.FieldID.String()
+			value := event.Value
+			matches[i].FieldID = &fieldID
+			matches[i].Value = &value
+		}
+	}
+
+	return automationDryRunResponse{Events: dryRun.Events, Matches: matches}
+}
+
+// parseAutomationTrigger reports issues as trigger.<field>. An empty columnId matches any column.
+// fieldId is read for due_date_passed and label_added, label for label_added only, and an empty
+// label matches any label.
+func parseAutomationTrigger(body *automationTriggerBody, details *[]httpschema.Detail) domain.AutomationTrigger {
+	trigger := domain.AutomationTrigger{
+		Type: httpschema.ValidateField("trigger.type", body.Type, domain.NewAutomationTriggerType, details),
+	}
+	if body.ColumnID != "" {
+		columnID, err := domain.ParseColumnID(body.ColumnID)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: "trigger.columnId", Issues: []string{"Invalid column id"}})
+		}
+		trigger.ColumnID = columnID
+	}
+	if trigger.Type.IsFieldTrigger() {
+		fieldID, err := domain.ParseCustomFieldID(body.FieldID)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: "trigger.fieldId", Issues: []string{"Invalid custom field id"}})
+		}
+		trigger.FieldID = fieldID
+	}
+	if trigger.Type == domain.AutomationLabelAdded {
+		trigger.Label = strings.TrimSpace(body.Label)
+	}
+
+	return trigger
+}
+
+// parseAutomationAction reports issues as action.<field>. Only the fields of the action type are read.
+func parseAutomationAction(body *automationActionBody, details *[]httpschema.Detail) domain.AutomationAction {
+	actionType := httpschema.ValidateField("action.type", body.Type, domain.NewAutomationActionType, details)
+
+	parseColumnID := func() domain.ColumnID {
+		columnID, err := domain.ParseColumnID(body.ColumnID)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: "action.columnId", Issues: []string{"Invalid column id"}})
+		}
+		return columnID
+	}
+	parseFieldID := func() domain.CustomFieldID {
+		fieldID, err := domain.ParseCustomFieldID(body.FieldID)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: "action.fieldId", Issues: []string{"Invalid custom field id"}})
+		}
+		return fieldID
+	}
+
+	switch actionType {
+	case domain.AutomationMoveToColumn:
+		return domain.NewMoveToColumnAction(parseColumnID())
+	case domain.AutomationSetCustomField:
+		action, err := domain.NewSetCustomFieldAction(parseFieldID(), body.Value)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: "action.value", Issues: domain.ExtractValidationIssues(err)})
+		}
+		return action
+	case domain.AutomationAddLabel:
+		action, err := domain.NewAddLabelAction(parseFieldID(), body.Label)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: "action.label", Issues: domain.ExtractValidationIssues(err)})
+		}
+		return action
+	case domain.AutomationNotifyTelegram:
+		message := httpschema.ValidateField("action.message", body.Message, domain.NewAutomationMessage, details)
+		return domain.NewNotifyTelegramAction(message)
+	case domain.AutomationCreateTask:
+		columnID := parseColumnID()
+		name := httpschema.ValidateField("action.taskName", body.TaskName, domain.NewTaskName, details)
+		return domain.NewCreateTaskAction(columnID, name)
+	default:
+		return domain.AutomationAction{}
+	}
+}
+
+// respondRuleError responds to the errors of the trigger and action checks shared by Create,
+// Update and DryRun. It returns false for any other error.
+func (h *automations) respondRuleError(w http.ResponseWriter, err error) bool {
+	var valuesErr *service.CustomFieldValuesError
+	switch {
+	case errors.As(err, &valuesErr):
+		var issues []string
+		for _, fieldIssues := range valuesErr.Issues {
+			issues = append(issues, fieldIssues...)
+		}
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "action.value", Issues: issues}})
+	case errors.Is(err, service.ErrColumnNotFound):
+		h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "trigger.columnId", Issues: []string{"Column not found"}}})
+	case errors.Is(err, service.ErrActionColumnNotFound):
+		h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "action.columnId", Issues: []string{"Column not found"}}})
+	case errors.Is(err, service.ErrCustomFieldNotFound):
+		h.responder.CustomFieldNotFound(w, []httpschema.Detail{{Field: "action.fieldId", Issues: []string{"Custom field not found"}}})
+	case errors.Is(err, service.ErrTriggerFieldNotFound):
+		h.responder.CustomFieldNotFound(w, []httpschema.Detail{{Field: "trigger.fieldId", Issues: []string{"Custom field not found"}}})
+	case errors.Is(err, service.ErrTriggerFieldType):
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "trigger.fieldId", Issues: []string{domain.ErrAutomationTriggerFieldType}}})
+	case errors.Is(err, service.ErrTriggerLabelNotFound):
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "trigger.label", Issues: []string{domain.ErrAutomationLabelOption}}})
+	case errors.Is(err, service.ErrActionFieldType):
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "action.fieldId", Issues: []string{domain.ErrAutomationActionFieldType}}})
+	case errors.Is(err, service.ErrActionLabelNotFound):
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "action.label", Issues: []string{domain.ErrAutomationLabelOption}}})
+	default:
+		return false
+	}
+
+	return true
+}
+
+// Create godoc
+// @Summary Create an automation
+// @Description Create a "when this happens, do that" rule on a board. The trigger is task_created, task_moved, due_date_passed (fieldId of a date field, fires once the date is over in UTC) or label_added (fieldId of a single or multi select field and an optional label, any label when empty).
+// @Description A trigger is optionally limited to the column the task was created in, moved into or is in.
+// @Description The action is one of move_to_column (columnId, the task goes to the top of the column), set_custom_field (fieldId and value, null clears the field), add_label (fieldId of a select field and label, which replaces the label of a single select field), notify_telegram (message, sent to the Telegram chat of the board owner) or create_task (columnId and taskName, a follow-up task at the end of the column).
+// @Description Automations run in the background shortly after the event, only on events that happen after they are created. Moves follow the board workflow. Follow-up tasks don't fire task_created automations, and an automation runs at most 5 times an hour on the same task.
+// @Description enabled defaults to true.
+// @Tags automations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createAutomationBody true "Automation details"
+// @Success 201 {object} automationResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND, COLUMN_NOT_FOUND or CUSTOM_FIELD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/automations [post]
+func (h *automations) Create(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	var body createAutomationBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	name := httpschema.ValidateField("name", body.Name, domain.NewAutomationName, &details)
+	trigger := parseAutomationTrigger(&body.Trigger, &details)
+	action := parseAutomationAction(&body.Action, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+	enabled := body.Enabled == nil || *body.Enabled
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	automation, err := h.automationsService.Create(r.Context(), userID, boardID, name, trigger, action, enabled)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		if h.respondRuleError(w, err) {
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newAutomationResponse(&automation))
+}
+
+// ListByBoardID godoc
+// @Summary List automations of a board
+// @Description Get all automations of a board owned by the current user, in increasing creation time order.
+// @Tags automations
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {object} listAutomationsResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/automations [get]
+func (h *automations) ListByBoardID(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	automations, err := h.automationsService.ListByBoardID(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make(listAutomationsResponse, len(automations))
+	for i := range automations {
+		response[i] = newAutomationResponse(&automations[i])
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// Update godoc
+// @Summary Update an automation by id
+// @Description Partially update an automation. Provided fields are updated; omitted or null fields are ignored. trigger and action are replaced as a whole.
+// @Tags automations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param automationId path string true "Automation ID"
+// @Param body body updateAutomationBody true "Automation fields to update"
+// @Success 200 {object} automationResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "AUTOMATION_NOT_FOUND, COLUMN_NOT_FOUND or CUSTOM_FIELD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/automations/{automationId} [patch]
+func (h *automations) Update(w http.ResponseWriter, r *http.Request) {
+	boardID, automationID, ok := h.parseBoardAndAutomationID(w, r)
+	if !ok {
+		return
+	}
+
+	var body updateAutomationBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	patch := service.AutomationPatch{Enabled: body.Enabled}
+	if body.Name != nil {
+		value := httpschema.ValidateField("name", *body.Name, domain.NewAutomationName, &details)
+		patch.Name = &value
+	}
+	if body.Trigger != nil {
+		value := parseAutomationTrigger(body.Trigger, &details)
+		patch.Trigger = &value
+	}
+	if body.Action != nil {
+		value := parseAutomationAction(body.Action, &details)
+		patch.Action = &value
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	automation, err := h.automationsService.Update(r.Context(), userID, boardID, automationID, patch)
+	if err != nil {
+		if errors.Is(err, service.ErrAutomationNotFound) {
+			h.responder.AutomationNotFound(w, []httpschema.Detail{{Field: "automationId", Issues: []string{"Automation not found"}}})
+			return
+		}
+		if h.respondRuleError(w, err) {
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newAutomationResponse(&automation))
+}
+
+// Delete godoc
+// @Summary Delete an automation by id
+// @Description Delete an automation along with its entries of the execution log. Changes it already made are not affected.
+// @Tags automations
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param automationId path string true "Automation ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "AUTOMATION_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/automations/{automationId} [delete]
+func (h *automations) Delete(w http.ResponseWriter, r *http.Request) {
+	boardID, automationID, ok := h.parseBoardAndAutomationID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err := h.automationsService.Delete(r.Context(), userID, boardID, automationID)
+	if err != nil {
+		if errors.Is(err, service.ErrAutomationNotFound) {
+			h.responder.AutomationNotFound(w, []httpschema.Detail{{Field: "automationId", Issues: []string{"Automation not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// ListRuns godoc
+// @Summary List automation runs of a board
+// @Description Get the execution log of the automations of a board, the latest 100 runs first. A run is skipped when the action had nothing to do or the loop protection stopped it; message explains skipped and failed runs.
+// @Tags automations
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {object} listAutomationRunsResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/automations/runs [get]
+func (h *automations) ListRuns(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	runs, err := h.automationsService.ListRuns(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make(listAutomationRunsResponse, len(runs))
+	for i, run := range runs {
+		response[i] = automationRunResponse{
+			ID:           run.ID.String(),
+			AutomationID: run.AutomationID.String(),
+			EventID:      run.EventID.String(),
+			TaskID:       run.TaskID.String(),
+			Status:       run.Status.String(),
+			Message:      run.Message,
+			RanAt:        service.FormatRFC3339Millis(run.RanAt),
+		}
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// DryRun godoc
+// @Summary Dry-run an automation
+// @Description Replay the latest events of a board, task creations and moves, passed due dates and added labels, against a rule without running its action, and get the events the action would have run on, oldest first.
+// @Description The rule is checked like a new automation. events is how many of the latest events are replayed and defaults to 50.
+// @Tags automations
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param body body dryRunAutomationBody true "Rule to replay"
+// @Success 200 {object} automationDryRunResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND, COLUMN_NOT_FOUND or CUSTOM_FIELD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/automations/dry-run [post]
+func (h *automations) DryRun(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	var body dryRunAutomationBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+	eventCount := int64(domain.DefaultAutomationDryRunEvents)
+	if body.Events != nil {
+		eventCount = *body.Events
+	}
+
+	details := []httpschema.Detail{}
+	trigger := parseAutomationTrigger(&body.Trigger, &details)
+	action := parseAutomationAction(&body.Action, &details)
+	events := httpschema.ValidateField("events", eventCount, domain.NewAutomationDryRunEvents, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	dryRun, err := h.automationsService.DryRun(r.Context(), userID, boardID, trigger, action, events)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		if h.respondRuleError(w, err) {
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newAutomationDryRunResponse(&dryRun))
+}
+
+func (h *automations) parseBoardID(w http.ResponseWriter, r *http.Request) (domain.BoardID, bool) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return domain.BoardID{}, false
+	}
+
+	return boardID, true
+}
+
+func (h *automations) parseBoardAndAutomationID(w http.ResponseWriter, r *http.Request) (boardID domain.BoardID, automationID domain.AutomationID, ok bool) {
+	boardID, ok = h.parseBoardID(w, r)
+	if !ok {
+		return domain.BoardID{}, domain.AutomationID{}, false
+	}
+
+	rawAutomationID := r.PathValue("automationId")
+	automationID, err := domain.ParseAutomationID(rawAutomationID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "automationId", Issues: []string{"Invalid automation id"}}})
+		return domain.BoardID{}, domain.AutomationID{}, false
+	}
+
+	return boardID, automationID, true
+}
@@ -0,0 +1,678 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func automationBody(automation *domain.Automation) map[string]any {
+	return map[string]any{
+		"id":      automation.ID.String(),
+		"boardId": automation.BoardID.String(),
+		"name":    automation.Name.String(),
+		"trigger": map[string]any{
+			"type":     automation.Trigger.Type.String(),
+			"columnId": nil,
+		},
+		"action": map[string]any{
+			"type":     automation.Action.Type.String(),
+			"columnId": automation.Action.ColumnID.String(),
+		},
+		"enabled":   automation.Enabled,
+		"createdAt": automation.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt": automation.UpdatedAt.Format(testutil.TimeFormat),
+	}
+}
+
+func TestAutomations_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validAutomation := testutil.ValidAutomation(validBoard.ID, validColumn.ID)
+	priorityID := domain.NewCustomFieldID()
+	statusID := domain.NewCustomFieldID()
+	labelAutomation := validAutomation
+	labelAutomation.Trigger = domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: priorityID, Label: "Urgent"}
+	labelAutomation.Action = domain.AutomationAction{Type: domain.AutomationAddLabel, FieldID: statusID, Label: "Needs triage"}
+
+	validInput := func(overrides map[string]any) map[string]any {
+		input := map[string]any{
+			"name":    validAutomation.Name.String(),
+			"trigger": map[string]any{"type": "task_created"},
+			"action":  map[string]any{"type": "move_to_column", "columnId": validColumn.ID.String()},
+		}
+		for k, v := range overrides {
+			input[k] = v
+		}
+		return input
+	}
+
+	tests := []struct {
+		name                   string
+		inputBody              any
+		setupAutomationService func(t *testing.T, s *MockAutomationService)
+		wantCode               int
+		wantBody               any
+	}{
+		{
+			name:      "Success enabled by default",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if trigger.Type != domain.AutomationTaskCreated || !trigger.ColumnID.IsNil() {
+						t.Errorf("got trigger %+v, want task_created in any column", trigger)
+					}
+					if action.Type != domain.AutomationMoveToColumn || action.ColumnID != validColumn.ID {
+						t.Errorf("got action %+v, want move to %v", action, validColumn.ID)
+					}
+					if !enabled {
+						t.Error("got disabled, want enabled")
+					}
+					return validAutomation, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: automationBody(&validAutomation),
+		},
+		{
+			name: "Success label added adds a label",
+			inputBody: validInput(map[string]any{
+				"trigger": map[string]any{"type": "label_added", "fieldId": priorityID.String(), "label": " Urgent "},
+				"action":  map[string]any{"type": "add_label", "fieldId": statusID.String(), "label": "Needs triage"},
+			}),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					if trigger != labelAutomation.Trigger {
+						t.Errorf("got trigger %+v, want %+v", trigger, labelAutomation.Trigger)
+					}
+					if action.Type != domain.AutomationAddLabel || action.FieldID != statusID || action.Label != "Needs triage" {
+						t.Errorf("got action %+v, want add_label Needs triage to %v", action, statusID)
+					}
+					return labelAutomation, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":        labelAutomation.ID.String(),
+				"boardId":   labelAutomation.BoardID.String(),
+				"name":      labelAutomation.Name.String(),
+				"trigger":   map[string]any{"type": "label_added", "columnId": nil, "fieldId": priorityID.String(), "label": "Urgent"},
+				"action":    map[string]any{"type": "add_label", "fieldId": statusID.String(), "label": "Needs triage"},
+				"enabled":   labelAutomation.Enabled,
+				"createdAt": labelAutomation.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt": labelAutomation.UpdatedAt.Format(testutil.TimeFormat),
+			},
+		},
+		{
+			name: "Success due date passed",
+			inputBody: validInput(map[string]any{
+				"trigger": map[string]any{"type": "due_date_passed", "fieldId": priorityID.String(), "label": "ignored"},
+			}),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					want := domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: priorityID}
+					if trigger != want {
+						t.Errorf("got trigger %+v, want %+v", trigger, want)
+					}
+					return validAutomation, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: automationBody(&validAutomation),
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: "{\"name\":",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name: "Invalid field trigger and label action",
+			inputBody: validInput(map[string]any{
+				"trigger": map[string]any{"type": "due_date_passed"},
+				"action":  map[string]any{"type": "add_label", "fieldId": "nope", "label": " "},
+			}),
+			wantCode: http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "trigger.fieldId", "issues": []string{"Invalid custom field id"}},
+					map[string]any{"field": "action.fieldId", "issues": []string{"Invalid custom field id"}},
+					map[string]any{"field": "action.label", "issues": []string{domain.ErrAutomationLabelRequired}},
+				},
+			},
+		},
+		{
+			name: "Invalid trigger and action",
+			inputBody: validInput(map[string]any{
+				"trigger": map[string]any{"type": "task_deleted", "columnId": "nope"},
+				"action":  map[string]any{"type": "notify_telegram", "message": " "},
+			}),
+			wantCode: http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "trigger.type", "issues": []string{domain.ErrAutomationTriggerValue}},
+					map[string]any{"field": "trigger.columnId", "issues": []string{"Invalid column id"}},
+					map[string]any{"field": "action.message", "issues": []string{domain.ErrAutomationMessageValue}},
+				},
+			},
+		},
+		{
+			name: "Invalid custom field action",
+			inputBody: validInput(map[string]any{
+				"action": map[string]any{"type": "set_custom_field", "fieldId": "nope"},
+			}),
+			wantCode: http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "action.fieldId", "issues": []string{"Invalid custom field id"}},
+					map[string]any{"field": "action.value", "issues": []string{domain.ErrAutomationValueRequired}},
+				},
+			},
+		},
+		{
+			name:      "Action column not found",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrActionColumnNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: columnNotFoundError("action.columnId"),
+		},
+		{
+			name:      "Trigger field not found",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrTriggerFieldNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: map[string]any{
+				"code":      "CUSTOM_FIELD_NOT_FOUND",
+				"message":   "Custom field not found",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "trigger.fieldId", "issues": []string{"Custom field not found"}},
+				},
+			},
+		},
+		{
+			name:      "Trigger field type mismatch",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrTriggerFieldType
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("trigger.fieldId", []string{domain.ErrAutomationTriggerFieldType}),
+		},
+		{
+			name:      "Trigger label not an option",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrTriggerLabelNotFound
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("trigger.label", []string{domain.ErrAutomationLabelOption}),
+		},
+		{
+			name:      "Action field not a select field",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrActionFieldType
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("action.fieldId", []string{domain.ErrAutomationActionFieldType}),
+		},
+		{
+			name:      "Action label not an option",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrActionLabelNotFound
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("action.label", []string{domain.ErrAutomationLabelOption}),
+		},
+		{
+			name:      "Custom field value invalid",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, &service.CustomFieldValuesError{
+						Issues: map[domain.CustomFieldID][]string{domain.NewCustomFieldID(): {"Value must be one of the field options"}},
+					}
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("action.value", []string{"Value must be one of the field options"}),
+		},
+		{
+			name:      "Board not found",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:      "Internal error",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error) {
+					return domain.Automation{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/automations"
+			req := buildTaskRequest(t, http.MethodPost, path, tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+
+			rr := httptest.NewRecorder()
+			s := NewMockAutomationService(t)
+			if tt.setupAutomationService != nil {
+				tt.setupAutomationService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAutomations(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestAutomations_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validAutomation := testutil.ValidAutomation(validBoard.ID, domain.NewColumnID())
+
+	tests := []struct {
+		name                   string
+		automationID           string
+		inputBody              any
+		setupAutomationService func(t *testing.T, s *MockAutomationService)
+		wantCode               int
+		wantBody               any
+	}{
+		{
+			name:         "Success enabled only",
+			automationID: validAutomation.ID.String(),
+			inputBody:    map[string]any{"enabled": false},
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID, patch service.AutomationPatch) (domain.Automation, error) {
+					if automationID != validAutomation.ID {
+						t.Errorf("got automation id %v, want %v", automationID, validAutomation.ID)
+					}
+					if patch.Enabled == nil || *patch.Enabled {
+						t.Errorf("got enabled %v, want false", patch.Enabled)
+					}
+					if patch.Name != nil || patch.Trigger != nil || patch.Action != nil {
+						t.Errorf("got unexpected patch fields %+v", patch)
+					}
+					return validAutomation, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: automationBody(&validAutomation),
+		},
+		{
+			name:         "Invalid automation id",
+			automationID: "not-a-uuid",
+			inputBody:    map[string]any{"enabled": false},
+			wantCode:     http.StatusBadRequest,
+			wantBody:     validationError("automationId", []string{"Invalid automation id"}),
+		},
+		{
+			name:         "Invalid action type",
+			automationID: validAutomation.ID.String(),
+			inputBody:    map[string]any{"action": map[string]any{"type": "remove_label"}},
+			wantCode:     http.StatusBadRequest,
+			wantBody:     validationError("action.type", []string{domain.ErrAutomationActionValue}),
+		},
+		{
+			name:         "Trigger column not found",
+			automationID: validAutomation.ID.String(),
+			inputBody:    map[string]any{"trigger": map[string]any{"type": "task_moved", "columnId": domain.NewColumnID().String()}},
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID, patch service.AutomationPatch) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrColumnNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: columnNotFoundError("trigger.columnId"),
+		},
+		{
+			name:         "Automation not found",
+			automationID: validAutomation.ID.String(),
+			inputBody:    map[string]any{"enabled": true},
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID, patch service.AutomationPatch) (domain.Automation, error) {
+					return domain.Automation{}, service.ErrAutomationNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: automationNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/automations/" + tt.automationID
+			req := buildTaskRequest(t, http.MethodPatch, path, tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			req.SetPathValue("automationId", tt.automationID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockAutomationService(t)
+			if tt.setupAutomationService != nil {
+				tt.setupAutomationService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAutomations(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Update(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestAutomations_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	automationID := domain.NewAutomationID()
+
+	tests := []struct {
+		name      string
+		deleteErr error
+		wantCode  int
+		wantBody  any
+	}{
+		{name: "Success", wantCode: http.StatusNoContent},
+		{name: "Automation not found", deleteErr: service.ErrAutomationNotFound, wantCode: http.StatusNotFound, wantBody: automationNotFoundError()},
+		{name: "Internal error", deleteErr: errors.New("db exploded"), wantCode: http.StatusInternalServerError, wantBody: internalError()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/automations/" + automationID.String()
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			req.SetPathValue("automationId", automationID.String())
+
+			rr := httptest.NewRecorder()
+			s := NewMockAutomationService(t)
+			s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, gotAutomationID domain.AutomationID) error {
+				if gotAutomationID != automationID {
+					t.Errorf("got automation id %v, want %v", gotAutomationID, automationID)
+				}
+				return tt.deleteErr
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAutomations(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Delete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			if tt.wantBody != nil {
+				testutil.AssertContentType(t, rr, "application/json")
+				testutil.AssertResponseBody(t, rr, tt.wantBody)
+			}
+		})
+	}
+}
+
+func TestAutomations_ListRuns(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	run := domain.AutomationRun{
+		ID:           domain.AutomationRunID(domain.NewAutomationID()),
+		AutomationID: domain.NewAutomationID(),
+		BoardID:      validBoard.ID,
+		EventID:      domain.NewAutomationEventID(),
+		TaskID:       domain.NewTaskID(),
+		Status:       domain.AutomationRunSkipped,
+		Message:      "Task is already in the column",
+		RanAt:        testutil.FixedNow(),
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+validBoard.ID.String()+"/automations/runs", nil)
+	req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+	req.SetPathValue("boardId", validBoard.ID.String())
+
+	rr := httptest.NewRecorder()
+	s := NewMockAutomationService(t)
+	s.ListRunsFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.AutomationRun, error) {
+		return []domain.AutomationRun{run}, nil
+	}
+
+	logger := testutil.NewLogger(t)
+	h := handler.NewAutomations(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+	h.ListRuns(rr, req)
+
+	testutil.AssertStatusCode(t, rr, http.StatusOK)
+	testutil.AssertContentType(t, rr, "application/json")
+	testutil.AssertResponseBody(t, rr, []any{map[string]any{
+		"id":           run.ID.String(),
+		"automationId": run.AutomationID.String(),
+		"eventId":      run.EventID.String(),
+		"taskId":       run.TaskID.String(),
+		"status":       "skipped",
+		"message":      run.Message,
+		"ranAt":        testutil.FixedNowStr(),
+	}})
+}
+
+func TestAutomations_DryRun(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todo := domain.NewColumnID()
+	done := domain.NewColumnID()
+	moved := domain.AutomationEvent{
+		ID:           domain.NewAutomationEventID(),
+		Type:         domain.AutomationTaskMoved,
+		BoardID:      validBoard.ID,
+		TaskID:       domain.NewTaskID(),
+		FromColumnID: todo,
+		ToColumnID:   done,
+		OccurredAt:   testutil.FixedNow(),
+	}
+	priorityID := domain.NewCustomFieldID()
+	labelAdded := domain.AutomationEvent{
+		ID:         domain.NewAutomationEventID(),
+		Type:       domain.AutomationLabelAdded,
+		BoardID:    validBoard.ID,
+		TaskID:     domain.NewTaskID(),
+		ToColumnID: todo,
+		FieldID:    priorityID,
+		Value:      "Urgent",
+		OccurredAt: testutil.FixedNow(),
+	}
+
+	validInput := func(overrides map[string]any) map[string]any {
+		input := map[string]any{
+			"trigger": map[string]any{"type": "task_moved", "columnId": done.String()},
+			"action":  map[string]any{"type": "create_task", "columnId": todo.String(), "taskName": "Write release notes"},
+		}
+		for k, v := range overrides {
+			input[k] = v
+		}
+		return input
+	}
+
+	tests := []struct {
+		name                   string
+		inputBody              any
+		setupAutomationService func(t *testing.T, s *MockAutomationService)
+		wantCode               int
+		wantBody               any
+	}{
+		{
+			name:      "Success with default events",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.DryRunFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, trigger domain.AutomationTrigger, action domain.AutomationAction, events domain.AutomationDryRunEvents) (domain.AutomationDryRun, error) {
+					if events.Int64() != domain.DefaultAutomationDryRunEvents {
+						t.Errorf("got events %d, want %d", events.Int64(), domain.DefaultAutomationDryRunEvents)
+					}
+					if action.Type != domain.AutomationCreateTask || action.TaskName.String() != "Write release notes" {
+						t.Errorf("got action %+v, want create_task", action)
+					}
+					return domain.AutomationDryRun{Events: 2, Matches: []domain.AutomationEvent{moved}}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"events": 2,
+				"matches": []any{map[string]any{
+					"id":           moved.ID.String(),
+					"type":         "task_moved",
+					"taskId":       moved.TaskID.String(),
+					"fromColumnId": todo.String(),
+					"toColumnId":   done.String(),
+					"occurredAt":   testutil.FixedNowStr(),
+				}},
+			},
+		},
+		{
+			name: "Success label added",
+			inputBody: validInput(map[string]any{
+				"trigger": map[string]any{"type": "label_added", "fieldId": priorityID.String(), "label": "Urgent"},
+			}),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.DryRunFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, trigger domain.AutomationTrigger, action domain.AutomationAction, events domain.AutomationDryRunEvents) (domain.AutomationDryRun, error) {
+					want := domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: priorityID, Label: "Urgent"}
+					if trigger != want {
+						t.Errorf("got trigger %+v, want %+v", trigger, want)
+					}
+					return domain.AutomationDryRun{Events: 2, Matches: []domain.AutomationEvent{labelAdded}}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"events": 2,
+				"matches": []any{map[string]any{
+					"id":           labelAdded.ID.String(),
+					"type":         "label_added",
+					"taskId":       labelAdded.TaskID.String(),
+					"fromColumnId": nil,
+					"toColumnId":   todo.String(),
+					"fieldId":      priorityID.String(),
+					"value":        "Urgent",
+					"occurredAt":   testutil.FixedNowStr(),
+				}},
+			},
+		},
+		{
+			name:      "Success without matches",
+			inputBody: validInput(map[string]any{"events": 500}),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.DryRunFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, trigger domain.AutomationTrigger, action domain.AutomationAction, events domain.AutomationDryRunEvents) (domain.AutomationDryRun, error) {
+					return domain.AutomationDryRun{}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{"events": 0, "matches": []any{}},
+		},
+		{
+			name:      "Too many events",
+			inputBody: validInput(map[string]any{"events": 501}),
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("events", []string{domain.ErrAutomationDryRunEventCount}),
+		},
+		{
+			name:      "Board not found",
+			inputBody: validInput(nil),
+			setupAutomationService: func(t *testing.T, s *MockAutomationService) {
+				s.DryRunFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, trigger domain.AutomationTrigger, action domain.AutomationAction, events domain.AutomationDryRunEvents) (domain.AutomationDryRun, error) {
+					return domain.AutomationDryRun{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/automations/dry-run"
+			req := buildTaskRequest(t, http.MethodPost, path, tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+
+			rr := httptest.NewRecorder()
+			s := NewMockAutomationService(t)
+			if tt.setupAutomationService != nil {
+				tt.setupAutomationService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewAutomations(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.DryRun(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
@@ -0,0 +1,260 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+const (
+	// boardEventsWriteTimeout bounds every write to a stream, standing in for the write timeout
+	// of the server, which would end the stream after its first seconds.
+	boardEventsWriteTimeout = 10 * time.Second
+	// boardEventsRetry is how long an EventSource waits before it reconnects, in milliseconds.
+	boardEventsRetry = 3000
+)
+
+type boardEventService interface {
+	Subscribe(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, lastEventID *domain.BoardEventCursor) (service.BoardEventSubscription, error)
+	ListAfter(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor) ([]domain.BoardEvent, error)
+}
+
+type boardEvents struct {
+	logger            *slog.Logger
+	boardEventService boardEventService
+	responder         *httpschema.ErrorResponder
+	keepAlive         time.Duration
+}
+
+// NewBoardEvents streams board events. Every keepAlive an idle stream gets a comment, so proxies
+// keep it open, and looks for events it was not woken up for.
+func NewBoardEvents(
+	logger *slog.Logger,
+	boardEventService boardEventService,
+	responder *httpschema.ErrorResponder,
+	keepAlive time.Duration,
+) *boardEvents {
+	moduleLogger := logging.WithModule(logger, "handler.board_events")
+
+	return &boardEvents{logger: moduleLogger, boardEventService: boardEventService, responder: responder, keepAlive: keepAlive}
+}
+
+// boardEventResponse is the data of an event. Its SSE event name is the entity and the action,
+// like task.moved, and its id is the one to resume after.
+type boardEventResponse struct {
+	Entity  string `json:"entity" example:"task" enums:"board,column,task"`
+	Action  string `json:"action" example:"moved" enums:"created,updated,moved,deleted"`
+	BoardID string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	// ColumnID is the column of a column event, or the column a task is in after a task event. It is null for board events.
+	ColumnID *string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	// TaskID is null for board and column events.
+	TaskID *string `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	// Version is the version of the entity after the change, or the last one it had if it was deleted.
+	Version    int64  `json:"version" example:"4"`
+	OccurredAt string `json:"occurredAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newBoardEventResponse(event *domain.BoardEvent) boardEventResponse {
+	var columnID, taskID *string
+	if !event.ColumnID.IsNil() {
+		value := event.ColumnID.String()
+		columnID = &value
+	}
+	if !event.TaskID.IsNil() {
+		value := event.TaskID.String()
+		taskID = &value
+	}
+
+	return boardEventResponse{
+		Entity:     event.Entity.String(),
+		Action:     event.Action.String(),
+		BoardID:    event.BoardID.String(),
+		ColumnID:   columnID,
+		TaskID:     taskID,
+		Version:    event.Version.Int64(),
+		OccurredAt: service.FormatRFC3339Millis(event.OccurredAt),
+	}
+}
+
+// Stream godoc
+// @Summary Stream board events
+// @Description Server-Sent Events for every board, column and task created, updated, moved or deleted on the board, by any client.
+// @Description Each event is named after the entity and the action, like task.moved, and carries the ids and the new version of what changed; read the entity to get its state.
+// @Description Tasks and columns shifted to make room for a move, or to close the gap left by a deleted or archived one, come as moved too.
+// @Description A reconnecting client sends the id of the last event it got as Last-Event-ID and gets every event after it. Without one the stream starts with the next change.
+// @Description The stream ends after board.deleted. Idle streams get a comment every 15 seconds.
+// @Tags boards
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Last-Event-ID header string false "Id of the last event received, to resume after it"
+// @Success 200 {object} boardEventResponse "Stream of events with this data"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/events [get]
+func (h *boardEvents) Stream(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	var lastEventID *domain.BoardEventCursor
+	if header := r.Header.Get("Last-Event-ID"); header != "" {
+		cursor, parseErr := domain.ParseBoardEventCursor(header)
+		if parseErr != nil {
+			h.responder.ValidationError(
+				w, []httpschema.Detail{{Field: "Last-Event-ID", Issues: domain.ExtractValidationIssues(parseErr)}},
+			)
+			return
+		}
+		lastEventID = &cursor
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	sub, err := h.boardEventService.Subscribe(r.Context(), userID, boardID, lastEventID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+	defer sub.Stop()
+
+	stream := newEventStream(w)
+	// The read deadline of the server ends the request once it passes, even with the body read.
+	err = stream.rc.SetReadDeadline(time.Time{})
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	err = stream.send([]byte("retry: " + strconv.Itoa(boardEventsRetry) + "\n\n"))
+	if err != nil {
+		return
+	}
+
+	keepAlive := time.NewTicker(h.keepAlive)
+	defer keepAlive.Stop()
+
+	cursor := sub.Cursor
+	for {
+		var ended bool
+		cursor, ended, err = h.sendEvents(r.Context(), stream, boardID, cursor)
+		if err != nil || ended {
+			return
+		}
+
+		select {
+		case <-r.Context().Done():
+			return
+		case _, open := <-sub.Changes:
+			if !open {
+				return
+			}
+		case <-keepAlive.C:
+			err = stream.send([]byte(": keep-alive\n\n"))
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// sendEvents sends the events of the board after the cursor and returns the cursor after them.
+// The stream has ended if the board was deleted, or if sending failed.
+func (h *boardEvents) sendEvents(
+	ctx context.Context,
+	stream *eventStream,
+	boardID domain.BoardID,
+	cursor domain.BoardEventCursor,
+) (domain.BoardEventCursor, bool, error) {
+	for {
+		events, err := h.boardEventService.ListAfter(ctx, boardID, cursor)
+		if err != nil {
+			if ctx.Err() == nil {
+				h.logger.ErrorContext(ctx, "Board event stream cut short", slog.String("err", err.Error()))
+			}
+			return cursor, false, err
+		}
+		if len(events) == 0 {
+			return cursor, false, nil
+		}
+
+		var b bytes.Buffer
+		next := cursor
+		boardDeleted := false
+		for i := range events {
+			data, marshalErr := json.Marshal(newBoardEventResponse(&events[i]))
+			if marshalErr != nil {
+				h.logger.ErrorContext(ctx, "Failed to encode board event", slog.String("err", marshalErr.Error()))
+				return cursor, false, marshalErr
+			}
+			b.WriteString("id: " + events[i].Cursor.String() + "\n")
+			b.WriteString("event: " + events[i].Entity.String() + "." + events[i].Action.String() + "\n")
+			b.WriteString("data: " + string(data) + "\n\n")
+			next = events[i].Cursor
+
+			if events[i].Entity == domain.BoardEventBoard && events[i].Action == domain.BoardEventDeleted {
+				boardDeleted = true
+				break
+			}
+		}
+
+		err = stream.send(b.Bytes())
+		if err != nil {
+			return cursor, false, err
+		}
+		cursor = next
+		if boardDeleted {
+			return cursor, true, nil
+		}
+	}
+}
+
+// eventStream writes a response that lasts as long as the client listens. Every write has a
+// deadline of its own and is flushed right away.
+type eventStream struct {
+	w  http.ResponseWriter
+	rc *http.ResponseController
+}
+
+func newEventStream(w http.ResponseWriter) *eventStream {
+	return &eventStream{w: w, rc: http.NewResponseController(w)}
+}
+
+func (s *eventStream) send(b []byte) error {
+	err := s.rc.SetWriteDeadline(time.Now().Add(boardEventsWriteTimeout))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return err
+	}
+	_, err = s.w.Write(b)
+	if err != nil {
+		return err
+	}
+
+	return s.rc.Flush()
+}
@@ -0,0 +1,273 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestBoardEvents_Stream(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	column := testutil.ValidColumn(validBoard.ID)
+	task := testutil.ValidTask(column.ID)
+	version, err := domain.NewVersion(4)
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	head := domain.NewBoardEventCursor(742, 0)
+	taskMoved := domain.BoardEvent{
+		Cursor:     domain.NewBoardEventCursor(742, 1093),
+		Entity:     domain.BoardEventTask,
+		Action:     domain.BoardEventMoved,
+		BoardID:    validBoard.ID,
+		ColumnID:   column.ID,
+		TaskID:     task.ID,
+		Version:    version,
+		OccurredAt: testutil.FixedNow(),
+	}
+	boardDeleted := domain.BoardEvent{
+		Cursor:     domain.NewBoardEventCursor(745, 1100),
+		Entity:     domain.BoardEventBoard,
+		Action:     domain.BoardEventDeleted,
+		BoardID:    validBoard.ID,
+		Version:    version,
+		OccurredAt: testutil.FixedNow(),
+	}
+	// Events after the deletion of the board are never sent.
+	columnCreated := domain.BoardEvent{
+		Cursor:     domain.NewBoardEventCursor(746, 1101),
+		Entity:     domain.BoardEventColumn,
+		Action:     domain.BoardEventCreated,
+		BoardID:    validBoard.ID,
+		ColumnID:   column.ID,
+		Version:    version,
+		OccurredAt: testutil.FixedNow(),
+	}
+
+	const prelude = "retry: 3000\n\n"
+	taskMovedText := "id: 742-1093\nevent: task.moved\ndata: {\"entity\":\"task\",\"action\":\"moved\",\"boardId\":\"" +
+		validBoard.ID.String() + "\",\"columnId\":\"" + column.ID.String() + "\",\"taskId\":\"" + task.ID.String() +
+		"\",\"version\":4,\"occurredAt\":\"" + testutil.FixedNowStr() + "\"}\n\n"
+	boardDeletedText := "id: 745-1100\nevent: board.deleted\ndata: {\"entity\":\"board\",\"action\":\"deleted\",\"boardId\":\"" +
+		validBoard.ID.String() + "\",\"columnId\":null,\"taskId\":null,\"version\":4,\"occurredAt\":\"" + testutil.FixedNowStr() + "\"}\n\n"
+
+	tests := []struct {
+		name            string
+		boardID         string
+		lastEventID     string
+		noUser          bool
+		subscribeErr    error
+		wantLastEventID *domain.BoardEventCursor
+		pages           [][]domain.BoardEvent
+		listErr         error
+		wantAfter       []domain.BoardEventCursor
+		wakes           int
+		closeChanges    bool
+		keepAlive       time.Duration
+		wantCode        int
+		wantContentType string
+		wantText        string
+		wantBody        any
+	}{
+		{
+			name:            "Success streams events until board is deleted",
+			boardID:         validBoard.ID.String(),
+			pages:           [][]domain.BoardEvent{{taskMoved}, {boardDeleted, columnCreated}},
+			wantAfter:       []domain.BoardEventCursor{head, taskMoved.Cursor},
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantText:        prelude + taskMovedText + boardDeletedText,
+		},
+		{
+			name:            "Success resumes after last event id",
+			boardID:         validBoard.ID.String(),
+			lastEventID:     "742-1093",
+			wantLastEventID: &taskMoved.Cursor,
+			pages:           [][]domain.BoardEvent{{boardDeleted}},
+			wantAfter:       []domain.BoardEventCursor{taskMoved.Cursor},
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantText:        prelude + boardDeletedText,
+		},
+		{
+			name:            "Success reads events when woken up",
+			boardID:         validBoard.ID.String(),
+			pages:           [][]domain.BoardEvent{nil, {taskMoved}, nil, {boardDeleted}},
+			wantAfter:       []domain.BoardEventCursor{head, head, taskMoved.Cursor, taskMoved.Cursor},
+			wakes:           2,
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantText:        prelude + taskMovedText + boardDeletedText,
+		},
+		{
+			name:            "Success keeps idle stream alive and looks for missed events",
+			boardID:         validBoard.ID.String(),
+			pages:           [][]domain.BoardEvent{nil, {boardDeleted}},
+			wantAfter:       []domain.BoardEventCursor{head, head},
+			keepAlive:       time.Millisecond,
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantText:        prelude + ": keep-alive\n\n" + boardDeletedText,
+		},
+		{
+			name:            "Success ends on shutdown",
+			boardID:         validBoard.ID.String(),
+			pages:           [][]domain.BoardEvent{nil},
+			wantAfter:       []domain.BoardEventCursor{head},
+			closeChanges:    true,
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantText:        prelude,
+		},
+		{
+			name:            "List error ends stream",
+			boardID:         validBoard.ID.String(),
+			listErr:         service.ErrInternal,
+			wantAfter:       []domain.BoardEventCursor{head},
+			wantCode:        http.StatusOK,
+			wantContentType: "text/event-stream",
+			wantText:        prelude,
+		},
+		{
+			name:            "Invalid board id",
+			boardID:         "invalid",
+			wantCode:        http.StatusBadRequest,
+			wantContentType: "application/json",
+			wantBody:        validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:            "Invalid last event id",
+			boardID:         validBoard.ID.String(),
+			lastEventID:     "1093",
+			wantCode:        http.StatusBadRequest,
+			wantContentType: "application/json",
+			wantBody:        validationError("Last-Event-ID", []string{domain.ErrBoardEventCursorValue}),
+		},
+		{
+			name:            "Missing user",
+			boardID:         validBoard.ID.String(),
+			noUser:          true,
+			wantCode:        http.StatusUnauthorized,
+			wantContentType: "application/json",
+			wantBody: map[string]any{
+				"code":      "INVALID_TOKEN",
+				"message":   "Invalid token",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "Authorization", "issues": []string{"Invalid token"}},
+				},
+			},
+		},
+		{
+			name:            "Board not found",
+			boardID:         validBoard.ID.String(),
+			subscribeErr:    service.ErrBoardNotFound,
+			wantCode:        http.StatusNotFound,
+			wantContentType: "application/json",
+			wantBody:        boardNotFoundError(),
+		},
+		{
+			name:            "Subscribe error",
+			boardID:         validBoard.ID.String(),
+			subscribeErr:    errors.New("database exploded"),
+			wantCode:        http.StatusInternalServerError,
+			wantContentType: "application/json",
+			wantBody:        internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+tt.boardID+"/events", http.NoBody)
+			if !tt.noUser {
+				req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			}
+			req.SetPathValue("boardId", tt.boardID)
+			if tt.lastEventID != "" {
+				req.Header.Set("Last-Event-ID", tt.lastEventID)
+			}
+			rr := httptest.NewRecorder()
+
+			changes := make(chan struct{}, tt.wakes+1)
+			for range tt.wakes {
+				changes <- struct{}{}
+			}
+			if tt.closeChanges {
+				close(changes)
+			}
+			subscribed, stopped := false, false
+			var gotAfter []domain.BoardEventCursor
+
+			s := NewMockBoardEventService(t)
+			s.SubscribeFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, lastEventID *domain.BoardEventCursor) (service.BoardEventSubscription, error) {
+				subscribed = true
+				if callerID != validBoard.OwnerID || boardID != validBoard.ID {
+					t.Errorf("got caller %v and board %v, want %v and %v", callerID, boardID, validBoard.OwnerID, validBoard.ID)
+				}
+				if (lastEventID == nil) != (tt.wantLastEventID == nil) || lastEventID != nil && *lastEventID != *tt.wantLastEventID {
+					t.Errorf("got last event id %v, want %v", lastEventID, tt.wantLastEventID)
+				}
+				if tt.subscribeErr != nil {
+					return service.BoardEventSubscription{}, tt.subscribeErr
+				}
+				cursor := head
+				if lastEventID != nil {
+					cursor = *lastEventID
+				}
+				return service.BoardEventSubscription{Cursor: cursor, Changes: changes, Stop: func() { stopped = true }}, nil
+			}
+			s.ListAfterFunc = func(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor) ([]domain.BoardEvent, error) {
+				gotAfter = append(gotAfter, after)
+				if tt.listErr != nil {
+					return nil, tt.listErr
+				}
+				if len(gotAfter) > len(tt.pages) {
+					t.Errorf("got %d calls to ListAfter, want %d", len(gotAfter), len(tt.pages))
+					return nil, service.ErrInternal
+				}
+				return tt.pages[len(gotAfter)-1], nil
+			}
+
+			keepAlive := tt.keepAlive
+			if keepAlive == 0 {
+				keepAlive = time.Hour
+			}
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoardEvents(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr), keepAlive)
+			h.Stream(rr, req)
+
+			if subscribed && tt.subscribeErr == nil && !stopped {
+				t.Error("got subscription not stopped, want stopped")
+			}
+			if !slices.Equal(gotAfter, tt.wantAfter) {
+				t.Errorf("got ListAfter cursors %v, want %v", gotAfter, tt.wantAfter)
+			}
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, tt.wantContentType)
+			if tt.wantText != "" {
+				if got := rr.Body.String(); got != tt.wantText {
+					t.Errorf("got body %q, want %q", got, tt.wantText)
+				}
+				if !rr.Flushed {
+					t.Error("got stream not flushed, want flushed")
+				}
+				return
+			}
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
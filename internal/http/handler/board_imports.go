@@ -0,0 +1,243 @@
+package handler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+// boardImportMaxBodySize bounds an import request, the file included. Larger boards can be split
+// or trimmed of archived cards before the export.
+const boardImportMaxBodySize = 5 << 20
+
+type boardImportService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardImport domain.BoardImport) (domain.BoardImport, error)
+	Get(ctx context.Context, callerID domain.UserID, importID domain.BoardImportID) (domain.BoardImport, error)
+}
+
+type boardImports struct {
+	logger             *slog.Logger
+	boardImportService boardImportService
+	responder          *httpschema.ErrorResponder
+}
+
+func NewBoardImports(logger *slog.Logger, boardImportService boardImportService, responder *httpschema.ErrorResponder) *boardImports {
+	moduleLogger := logging.WithModule(logger, "handler.board_imports")
+
+	return &boardImports{logger: moduleLogger, boardImportService: boardImportService, responder: responder}
+}
+
+type createBoardImportBody struct {
+	Source string `json:"source" example:"trello" enums:"trello,csv,json"`
+	// Name overrides the board name of the file. It is required for CSV files.
+	Name *string `json:"name" example:"Roadmap"`
+	// Content is the file: the JSON object of a Trello board export or of a board exported as JSON, or the text of a CSV file.
+	Content json.RawMessage `json:"content" swaggertype:"object"`
+	// Mapping names the CSV headers of the task attributes. It is required for CSV files and not allowed otherwise.
+	Mapping *boardImportMappingBody `json:"mapping"`
+}
+
+type boardImportMappingBody struct {
+	Name        string `json:"name" example:"Title"`
+	Column      string `json:"column" example:"Status"`
+	Lane        string `json:"lane" example:"Team"`
+	Description string `json:"description" example:"Details"`
+	// Checklist names a header holding one checklist item per line, done items prefixed with "[x] ".
+	Checklist string `json:"checklist" example:"Checklist"`
+	Estimate  string `json:"estimate" example:"Points"`
+}
+
+type boardImportResponse struct {
+	ID     string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a9"`
+	Source string `json:"source" example:"trello" enums:"trello,csv,json"`
+	Status string `json:"status" example:"succeeded" enums:"pending,running,succeeded,failed"`
+	// BoardID is set once the import has succeeded.
+	BoardID *string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	// Errors lists what is wrong with the file of a failed import, at most 100 problems.
+	Errors     []boardImportErrorResponse `json:"errors"`
+	CreatedAt  string                     `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	StartedAt  *string                    `json:"startedAt" example:"2026-03-07T20:56:51.000+03:00"`
+	FinishedAt *string                    `json:"finishedAt" example:"2026-03-07T20:56:52.000+03:00"`
+}
+
+type boardImportErrorResponse struct {
+	// Item names the part of the file at fault the way the file does, like "card 5f3a9c..." or "row 12".
+	Item   string   `json:"item" example:"row 12"`
+	Field  string   `json:"field" example:"name"`
+	Issues []string `json:"issues" example:"Name is too long"`
+}
+
+func newBoardImportResponse(boardImport *domain.BoardImport) boardImportResponse {
+	var boardID *string
+	if !boardImport.BoardID.IsNil() {
+		value := boardImport.BoardID.String()
+		boardID = &value
+	}
+
+	importErrors := make([]boardImportErrorResponse, len(boardImport.Errors))
+	for i, importError := range boardImport.Errors {
+		importErrors[i] = boardImportErrorResponse{Item: importError.Item, Field: importError.Field, Issues: importError.Issues}
+	}
+
+	return boardImportResponse{
+		ID:         boardImport.ID.String(),
+		Source:     boardImport.Source.String(),
+		Status:     boardImport.Status.String(),
+		BoardID:    boardID,
+		Errors:     importErrors,
+		CreatedAt:  service.FormatRFC3339Millis(boardImport.CreatedAt),
+		StartedAt:  newBoardImportTimeResponse(boardImport.StartedAt),
+		FinishedAt: newBoardImportTimeResponse(boardImport.FinishedAt),
+	}
+}
+
+func newBoardImportTimeResponse(t time.Time) *string {
+	if t.IsZero() {
+		return nil
+	}
+	value := service.FormatRFC3339Millis(t)
+	return &value
+}
+
+// Create godoc
+// @Summary Import a board
+// @Description Start importing a board for the current user from a Trello board export, a CSV file or a board exported as JSON. The import runs in the background; poll it until it has succeeded or failed. Either the whole board is created or nothing is, and a failed import lists every problem of the file found, up to 100.
+// @Description Trello lists become columns and cards tasks, with checklists; archived cards become archived tasks and archived lists are skipped. Labels become a multi select "Labels" field and due dates a date "Due" field. A CSV file has a header row and a task per row; columns and lanes are created in the order their names first appear. A JSON export is recreated with its lanes, custom fields, sprints, parents and links.
+// @Description Ids and timestamps are new; the original history of the tasks is not imported. The request is limited to 5 MB.
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createBoardImportBody true "Import source and file"
+// @Success 202 {object} boardImportResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/import [post]
+func (h *boardImports) Create(w http.ResponseWriter, r *http.Request) {
+	var body createBoardImportBody
+
+	err := decodeJSONWithLimit(r, &body, boardImportMaxBodySize)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	boardImport := domain.BoardImport{}
+	boardImport.Source = httpschema.ValidateField("source", body.Source, domain.NewBoardImportSource, &details)
+	isCSV := boardImport.Source == domain.BoardImportCSV
+
+	switch {
+	case body.Name != nil:
+		boardImport.Name = httpschema.ValidateField("name", *body.Name, domain.NewBoardName, &details)
+	case isCSV:
+		httpschema.ValidateField("name", "", domain.NewBoardName, &details)
+	}
+
+	content := bytes.TrimSpace(body.Content)
+	switch {
+	case len(content) == 0 || bytes.Equal(content, []byte("null")):
+		details = append(details, httpschema.Detail{Field: "content", Issues: []string{"Content is required"}})
+	case isCSV:
+		var text string
+		if json.Unmarshal(content, &text) != nil {
+			details = append(details, httpschema.Detail{Field: "content", Issues: []string{"Content of a CSV file must be a string"}})
+		}
+		boardImport.Content = []byte(text)
+	default:
+		if content[0] != '{' {
+			details = append(details, httpschema.Detail{Field: "content", Issues: []string{"Content of a JSON file must be an object"}})
+		}
+		boardImport.Content = content
+	}
+
+	switch {
+	case isCSV && body.Mapping == nil:
+		details = append(details, httpschema.Detail{Field: "mapping", Issues: []string{"Mapping is required for CSV files"}})
+	case isCSV:
+		if body.Mapping.Name == "" {
+			details = append(details, httpschema.Detail{Field: "mapping.name", Issues: []string{domain.ErrBoardImportHeaderRequired}})
+		}
+		if body.Mapping.Column == "" {
+			details = append(details, httpschema.Detail{Field: "mapping.column", Issues: []string{domain.ErrBoardImportHeaderRequired}})
+		}
+		boardImport.Mapping = domain.BoardImportMapping(*body.Mapping)
+	case body.Mapping != nil:
+		details = append(details, httpschema.Detail{Field: "mapping", Issues: []string{"Mapping is only allowed for CSV files"}})
+	}
+
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	created, err := h.boardImportService.Create(r.Context(), userID, boardImport)
+	if err != nil {
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusAccepted, newBoardImportResponse(&created))
+}
+
+// Get godoc
+// @Summary Get a board import by id
+// @Description Get the status of a board import of the current user. A succeeded import links the new board; a failed one lists what is wrong with the file.
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param importId path string true "Board import ID"
+// @Success 200 {object} boardImportResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_IMPORT_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/board-imports/{importId} [get]
+func (h *boardImports) Get(w http.ResponseWriter, r *http.Request) {
+	importID, err := domain.ParseBoardImportID(r.PathValue("importId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "importId", Issues: []string{"Invalid board import id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	boardImport, err := h.boardImportService.Get(r.Context(), userID, importID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardImportNotFound) {
+			h.responder.BoardImportNotFound(w, []httpschema.Detail{{Field: "importId", Issues: []string{"Board import not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newBoardImportResponse(&boardImport))
+}
@@ -0,0 +1,339 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+type boardImportsTestCase struct {
+	name                    string
+	importID                string
+	inputBody               any
+	context                 context.Context
+	setupBoardImportService func(t *testing.T, s *MockBoardImportService)
+	wantCode                int
+	wantBody                any
+}
+
+func newBoardImportsRequest(t *testing.T, tt *boardImportsTestCase, method, path string, ownerID domain.UserID) *http.Request {
+	t.Helper()
+
+	var req *http.Request
+	switch body := tt.inputBody.(type) {
+	case nil:
+		req = httptest.NewRequest(method, path, http.NoBody)
+	case string:
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	default:
+		req, _ = testutil.NewJSONRequestAndRecorder(t, method, path, body)
+	}
+
+	ctx := tt.context
+	if ctx == nil {
+		ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, ownerID)
+	}
+	req = req.WithContext(ctx)
+	req.SetPathValue("importId", tt.importID)
+
+	return req
+}
+
+func boardImportResponseMap(boardImport *domain.BoardImport) map[string]any {
+	importErrors := []any{}
+	for _, importError := range boardImport.Errors {
+		importErrors = append(importErrors, map[string]any{"item": importError.Item, "field": importError.Field, "issues": importError.Issues})
+	}
+	optionalTime := func(t time.Time) any {
+		if t.IsZero() {
+			return nil
+		}
+		return t.Format(testutil.TimeFormat)
+	}
+	var boardID any
+	if !boardImport.BoardID.IsNil() {
+		boardID = boardImport.BoardID.String()
+	}
+
+	return map[string]any{
+		"id":         boardImport.ID.String(),
+		"source":     boardImport.Source.String(),
+		"status":     boardImport.Status.String(),
+		"boardId":    boardID,
+		"errors":     importErrors,
+		"createdAt":  boardImport.CreatedAt.Format(testutil.TimeFormat),
+		"startedAt":  optionalTime(boardImport.StartedAt),
+		"finishedAt": optionalTime(boardImport.FinishedAt),
+	}
+}
+
+func boardImportValidationError(details ...map[string]any) map[string]any {
+	detailList := make([]any, len(details))
+	for i, detail := range details {
+		detailList[i] = detail
+	}
+	return map[string]any{
+		"code":      "VALIDATION_ERROR",
+		"message":   "Some fields are invalid",
+		"timestamp": testutil.FixedNowStr(),
+		"details":   detailList,
+	}
+}
+
+func TestBoardImports_Create(t *testing.T) {
+	t.Parallel()
+
+	ownerID := testutil.ValidUserID()
+	validImport := testutil.ValidBoardImport(ownerID)
+	trelloContent := map[string]any{"name": "Roadmap", "lists": []any{}}
+	csvMapping := map[string]any{"name": "Title", "column": "Status"}
+
+	tests := []boardImportsTestCase{
+		{
+			name:      "Success with Trello export",
+			inputBody: map[string]any{"source": "trello", "content": trelloContent},
+			setupBoardImportService: func(t *testing.T, s *MockBoardImportService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardImport domain.BoardImport) (domain.BoardImport, error) {
+					if callerID != ownerID {
+						t.Errorf("got caller id %v, want %v", callerID, ownerID)
+					}
+					if boardImport.Source != domain.BoardImportTrello {
+						t.Errorf("got source %v, want %v", boardImport.Source, domain.BoardImportTrello)
+					}
+					if got := string(boardImport.Content); got != `{"lists":[],"name":"Roadmap"}` {
+						t.Errorf("got content %s, want the JSON object", got)
+					}
+					return validImport, nil
+				}
+			},
+			wantCode: http.StatusAccepted,
+			wantBody: boardImportResponseMap(&validImport),
+		},
+		{
+			name:      "Success with CSV file",
+			inputBody: map[string]any{"source": "csv", "name": "Roadmap", "content": "Title,Status\nWrite docs,To do\n", "mapping": csvMapping},
+			setupBoardImportService: func(t *testing.T, s *MockBoardImportService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardImport domain.BoardImport) (domain.BoardImport, error) {
+					if got := string(boardImport.Content); got != "Title,Status\nWrite docs,To do\n" {
+						t.Errorf("got content %q, want the CSV text", got)
+					}
+					if boardImport.Name.String() != "Roadmap" {
+						t.Errorf("got name %q, want %q", boardImport.Name, "Roadmap")
+					}
+					wantMapping := domain.BoardImportMapping{Name: "Title", Column: "Status"}
+					if boardImport.Mapping != wantMapping {
+						t.Errorf("got mapping %+v, want %+v", boardImport.Mapping, wantMapping)
+					}
+					return validImport, nil
+				}
+			},
+			wantCode: http.StatusAccepted,
+			wantBody: boardImportResponseMap(&validImport),
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: "{\"source\":\"trello\"",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Invalid source and missing content",
+			inputBody: map[string]any{"source": "jira"},
+			wantCode:  http.StatusBadRequest,
+			wantBody: boardImportValidationError(
+				map[string]any{"field": "source", "issues": []string{domain.ErrBoardImportSourceValue}},
+				map[string]any{"field": "content", "issues": []string{"Content is required"}},
+			),
+		},
+		{
+			name:      "JSON content that is not an object",
+			inputBody: map[string]any{"source": "json", "content": []any{}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("content", []string{"Content of a JSON file must be an object"}),
+		},
+		{
+			name:      "Mapping for a Trello export",
+			inputBody: map[string]any{"source": "trello", "content": trelloContent, "mapping": csvMapping},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("mapping", []string{"Mapping is only allowed for CSV files"}),
+		},
+		{
+			name:      "CSV file without name and mapping",
+			inputBody: map[string]any{"source": "csv", "content": map[string]any{}},
+			wantCode:  http.StatusBadRequest,
+			wantBody: boardImportValidationError(
+				map[string]any{"field": "name", "issues": []string{"Name is too short"}},
+				map[string]any{"field": "content", "issues": []string{"Content of a CSV file must be a string"}},
+				map[string]any{"field": "mapping", "issues": []string{"Mapping is required for CSV files"}},
+			),
+		},
+		{
+			name:      "CSV mapping without required headers",
+			inputBody: map[string]any{"source": "csv", "name": "Roadmap", "content": "Title", "mapping": map[string]any{"lane": "Team"}},
+			wantCode:  http.StatusBadRequest,
+			wantBody: boardImportValidationError(
+				map[string]any{"field": "mapping.name", "issues": []string{domain.ErrBoardImportHeaderRequired}},
+				map[string]any{"field": "mapping.column", "issues": []string{domain.ErrBoardImportHeaderRequired}},
+			),
+		},
+		{
+			name:      "Missing context user",
+			inputBody: map[string]any{"source": "trello", "content": trelloContent},
+			context:   context.Background(),
+			wantCode:  http.StatusUnauthorized,
+			wantBody:  unauthorizedTokenError(),
+		},
+		{
+			name:      "Internal error",
+			inputBody: map[string]any{"source": "trello", "content": trelloContent},
+			setupBoardImportService: func(t *testing.T, s *MockBoardImportService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardImport domain.BoardImport) (domain.BoardImport, error) {
+					return domain.BoardImport{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:      "Body too large",
+			inputBody: `{"source":"csv","content":"` + strings.Repeat("a", 5<<20) + `"}`,
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newBoardImportsRequest(t, &tt, http.MethodPost, "/v1/boards/import", ownerID)
+			rr := httptest.NewRecorder()
+			mockImports := NewMockBoardImportService(t)
+			if tt.setupBoardImportService != nil {
+				tt.setupBoardImportService(t, mockImports)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoardImports(logger, mockImports, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestBoardImports_Get(t *testing.T) {
+	t.Parallel()
+
+	ownerID := testutil.ValidUserID()
+	succeeded := testutil.ValidBoardImport(ownerID)
+	succeeded.Status = domain.BoardImportSucceeded
+	succeeded.BoardID = domain.NewBoardID()
+	succeeded.StartedAt = testutil.FixedNow().Add(time.Second)
+	succeeded.FinishedAt = testutil.FixedNow().Add(2 * time.Second)
+	failed := testutil.ValidBoardImport(ownerID)
+	failed.Status = domain.BoardImportFailed
+	failed.Errors = []domain.BoardImportError{{Item: "card c1", Field: "name", Issues: []string{domain.ErrTaskNameTooShort}}}
+	failed.StartedAt = testutil.FixedNow().Add(time.Second)
+	failed.FinishedAt = testutil.FixedNow().Add(2 * time.Second)
+	pending := testutil.ValidBoardImport(ownerID)
+
+	getReturning := func(boardImport domain.BoardImport, err error) func(t *testing.T, s *MockBoardImportService) {
+		return func(t *testing.T, s *MockBoardImportService) {
+			s.GetFunc = func(ctx context.Context, callerID domain.UserID, importID domain.BoardImportID) (domain.BoardImport, error) {
+				if callerID != ownerID {
+					t.Errorf("got caller id %v, want %v", callerID, ownerID)
+				}
+				if !boardImport.ID.IsNil() && importID != boardImport.ID {
+					t.Errorf("got import id %v, want %v", importID, boardImport.ID)
+				}
+				return boardImport, err
+			}
+		}
+	}
+
+	tests := []boardImportsTestCase{
+		{
+			name:                    "Success pending",
+			importID:                pending.ID.String(),
+			setupBoardImportService: getReturning(pending, nil),
+			wantCode:                http.StatusOK,
+			wantBody:                boardImportResponseMap(&pending),
+		},
+		{
+			name:                    "Success succeeded",
+			importID:                succeeded.ID.String(),
+			setupBoardImportService: getReturning(succeeded, nil),
+			wantCode:                http.StatusOK,
+			wantBody:                boardImportResponseMap(&succeeded),
+		},
+		{
+			name:                    "Success failed",
+			importID:                failed.ID.String(),
+			setupBoardImportService: getReturning(failed, nil),
+			wantCode:                http.StatusOK,
+			wantBody:                boardImportResponseMap(&failed),
+		},
+		{
+			name:     "Invalid import id",
+			importID: "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("importId", []string{"Invalid board import id"}),
+		},
+		{
+			name:     "Missing context user",
+			importID: pending.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:                    "Not found",
+			importID:                pending.ID.String(),
+			setupBoardImportService: getReturning(domain.BoardImport{}, service.ErrBoardImportNotFound),
+			wantCode:                http.StatusNotFound,
+			wantBody:                boardImportNotFoundError(),
+		},
+		{
+			name:                    "Internal error",
+			importID:                pending.ID.String(),
+			setupBoardImportService: getReturning(domain.BoardImport{}, errors.New("db exploded")),
+			wantCode:                http.StatusInternalServerError,
+			wantBody:                internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newBoardImportsRequest(t, &tt, http.MethodGet, "/v1/board-imports/"+tt.importID, ownerID)
+			rr := httptest.NewRecorder()
+			mockImports := NewMockBoardImportService(t)
+			if tt.setupBoardImportService != nil {
+				tt.setupBoardImportService(t, mockImports)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoardImports(logger, mockImports, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Get(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
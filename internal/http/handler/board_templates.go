@@ -0,0 +1,245 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type boardTemplatesService interface {
+	SaveFromBoard(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error)
+	ListAvailable(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error)
+	Delete(ctx context.Context, ownerID domain.UserID, templateID domain.BoardTemplateID) error
+}
+
+type boardTemplates struct {
+	logger                *slog.Logger
+	boardTemplatesService boardTemplatesService
+	responder             *httpschema.ErrorResponder
+}
+
+func NewBoardTemplates(logger *slog.Logger, boardTemplatesService boardTemplatesService, responder *httpschema.ErrorResponder) *boardTemplates {
+	moduleLogger := logging.WithModule(logger, "handler.board_templates")
+
+	return &boardTemplates{logger: moduleLogger, boardTemplatesService: boardTemplatesService, responder: responder}
+}
+
+type saveBoardAsTemplateBody struct {
+	Name         string `json:"name" example:"Team Kanban"`
+	Description  string `json:"description" example:"Our usual sprint board"`
+	IncludeTasks bool   `json:"includeTasks" example:"false"`
+}
+
+type boardTemplateResponse struct {
+	ID          string                        `json:"id" example:"019a0000-0000-7000-8000-000000000001"`
+	OwnerID     *string                       `json:"ownerId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	BuiltIn     bool                          `json:"builtIn" example:"false"`
+	Name        string                        `json:"name" example:"Team Kanban"`
+	Description string                        `json:"description" example:"Our usual sprint board"`
+	Columns     []boardTemplateColumnResponse `json:"columns"`
+	CreatedAt   string                        `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt   string                        `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+type boardTemplateColumnResponse struct {
+	Name             string                      `json:"name" example:"In Progress"`
+	Description      string                      `json:"description" example:"Tasks being worked on"`
+	WIPLimit         int64                       `json:"wipLimit" example:"3"` // Advisory: tasks are never refused over it. 0 means no limit.
+	SLAHours         int64                       `json:"slaHours" example:"48"`
+	ArchiveAfterDays int64                       `json:"archiveAfterDays" example:"14"`
+	IsStarted        bool                        `json:"isStarted" example:"true"`
+	IsDone           bool                        `json:"isDone" example:"false"`
+	Tasks            []boardTemplateTaskResponse `json:"tasks"`
+}
+
+type boardTemplateTaskResponse struct {
+	Name        string `json:"name" example:"Write onboarding notes"`
+	Description string `json:"description" example:"Link the team wiki"`
+}
+
+func newBoardTemplateResponse(template *domain.BoardTemplate) boardTemplateResponse {
+	var ownerID *string
+	if !template.IsBuiltIn() {
+		value := template.OwnerID.String()
+		ownerID = &value
+	}
+
+	columns := make([]boardTemplateColumnResponse, len(template.Columns))
+	for i := range template.Columns {
+		column := &template.Columns[i]
+
+		tasks := make([]boardTemplateTaskResponse, len(column.Tasks))
+		for j := range column.Tasks {
+			tasks[j] = boardTemplateTaskResponse{
+				Name:        column.Tasks[j].Name.String(),
+				Description: column.Tasks[j].Description.String(),
+			}
+		}
+
+		columns[i] = boardTemplateColumnResponse{
+			Name:             column.Name.String(),
+			Description:      column.Description.String(),
+			WIPLimit:         column.WIPLimit.Int64(),
+			SLAHours:         column.SLA.Hours(),
+			ArchiveAfterDays: column.ArchiveAfter.Days(),
+			IsStarted:        column.IsStarted,
+			IsDone:           column.IsDone,
+			Tasks:            tasks,
+		}
+	}
+
+	return boardTemplateResponse{
+		ID:          template.ID.String(),
+		OwnerID:     ownerID,
+		BuiltIn:     template.IsBuiltIn(),
+		Name:        template.Name.String(),
+		Description: template.Description.String(),
+		Columns:     columns,
+
+		CreatedAt: service.FormatRFC3339Millis(template.CreatedAt),
+		UpdatedAt: service.FormatRFC3339Millis(template.UpdatedAt),
+	}
+}
+
+type listBoardTemplatesResponse = []boardTemplateResponse
+
+// SaveFromBoard godoc
+// @Summary Save a board as a template
+// @Description Snapshot the columns of a board, with their descriptions and WIP limits, into a new template owned by the current user (board owner only). Tasks are copied as starter tasks only when includeTasks is true.
+// @Tags board-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body saveBoardAsTemplateBody true "Template details"
+// @Success 201 {object} boardTemplateResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/save-as-template [post]
+func (h *boardTemplates) SaveFromBoard(w http.ResponseWriter, r *http.Request) {
+	rawID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	var body saveBoardAsTemplateBody
+	err = decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	name := httpschema.ValidateField("name", body.Name, domain.NewBoardName, &details)
+	description := httpschema.ValidateField("description", body.Description, domain.NewBoardDescription, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	template, err := h.boardTemplatesService.SaveFromBoard(r.Context(), userID, boardID, name, description, body.IncludeTasks)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newBoardTemplateResponse(&template))
+}
+
+// List godoc
+// @Summary List board templates
+// @Description List templates available to the current user. Built-in templates come first, then the user's own templates in increasing creation time order.
+// @Tags board-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} listBoardTemplatesResponse
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/board-templates [get]
+func (h *boardTemplates) List(w http.ResponseWriter, r *http.Request) {
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	templates, err := h.boardTemplatesService.ListAvailable(r.Context(), userID)
+	if err != nil {
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make(listBoardTemplatesResponse, len(templates))
+	for i := range templates {
+		response[i] = newBoardTemplateResponse(&templates[i])
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// Delete godoc
+// @Summary Delete a board template by id
+// @Description Delete a template owned by the current user. Built-in templates cannot be deleted. Boards created from the template are not affected.
+// @Tags board-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param templateId path string true "Board template ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_TEMPLATE_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/board-templates/{templateId} [delete]
+func (h *boardTemplates) Delete(w http.ResponseWriter, r *http.Request) {
+	rawID := r.PathValue("templateId")
+	templateID, err := domain.ParseBoardTemplateID(rawID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "templateId", Issues: []string{"Invalid template id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err = h.boardTemplatesService.Delete(r.Context(), userID, templateID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardTemplateNotFound) {
+			h.responder.BoardTemplateNotFound(w, []httpschema.Detail{{Field: "templateId", Issues: []string{"Board template not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
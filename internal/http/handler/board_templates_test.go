@@ -0,0 +1,385 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func boardTemplateBody(template *domain.BoardTemplate) map[string]any {
+	var ownerID any
+	if !template.IsBuiltIn() {
+		ownerID = template.OwnerID.String()
+	}
+
+	columns := make([]any, len(template.Columns))
+	for i, column := range template.Columns {
+		tasks := make([]any, len(column.Tasks))
+		for j, task := range column.Tasks {
+			tasks[j] = map[string]any{
+				"name":        task.Name.String(),
+				"description": task.Description.String(),
+			}
+		}
+		columns[i] = map[string]any{
+			"name":             column.Name.String(),
+			"description":      column.Description.String(),
+			"wipLimit":         column.WIPLimit.Int64(),
+			"slaHours":         column.SLA.Hours(),
+			"archiveAfterDays": column.ArchiveAfter.Days(),
+			"isStarted":        column.IsStarted,
+			"isDone":           column.IsDone,
+			"tasks":            tasks,
+		}
+	}
+
+	return map[string]any{
+		"id":          template.ID.String(),
+		"ownerId":     ownerID,
+		"builtIn":     template.IsBuiltIn(),
+		"name":        template.Name.String(),
+		"description": template.Description.String(),
+		"columns":     columns,
+		"createdAt":   template.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt":   template.UpdatedAt.Format(testutil.TimeFormat),
+	}
+}
+
+func TestBoardTemplates_SaveFromBoard(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidBoardTemplate(validBoard.OwnerID)
+
+	tests := []struct {
+		name                 string
+		boardID              string
+		inputBody            any
+		context              context.Context
+		setupTemplateService func(t *testing.T, s *MockBoardTemplateService)
+		wantCode             int
+		wantBody             any
+	}{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			inputBody: map[string]any{
+				"name":         validTemplate.Name.String(),
+				"description":  validTemplate.Description.String(),
+				"includeTasks": true,
+			},
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.SaveFromBoardFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+					if ownerID != validBoard.OwnerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
+					}
+					if name != validTemplate.Name {
+						t.Errorf("got name %v, want %v", name, validTemplate.Name)
+					}
+					if !includeTasks {
+						t.Error("got includeTasks false, want true")
+					}
+					return validTemplate, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: boardTemplateBody(&validTemplate),
+		},
+		{
+			name:      "Invalid board id",
+			boardID:   "not-a-uuid",
+			inputBody: map[string]string{"name": "Team Kanban"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:      "Invalid JSON",
+			boardID:   validBoard.ID.String(),
+			inputBody: "{\"name\":\"broken\"",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Empty name",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "  "},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("name", []string{"Name is too short"}),
+		},
+		{
+			name:      "Description too long",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Team Kanban", "description": strings.Repeat("a", 1025)},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("description", []string{"Description is too long"}),
+		},
+		{
+			name:      "Missing context user",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Team Kanban"},
+			context:   context.Background(),
+			wantCode:  http.StatusUnauthorized,
+			wantBody:  unauthorizedTokenError(),
+		},
+		{
+			name:      "Board not found",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Team Kanban"},
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.SaveFromBoardFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+					return domain.BoardTemplate{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:      "Internal error",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Team Kanban"},
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.SaveFromBoardFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+					return domain.BoardTemplate{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:      "Body too large",
+			boardID:   validBoard.ID.String(),
+			inputBody: testutil.Valid25KBJSON(),
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/save-as-template"
+			var req *http.Request
+			if raw, ok := tt.inputBody.(string); ok {
+				req = httptest.NewRequest(http.MethodPost, path, strings.NewReader(raw))
+				req.Header.Set("Content-Type", "application/json")
+			} else {
+				req, _ = testutil.NewJSONRequestAndRecorder(t, http.MethodPost, path, tt.inputBody)
+			}
+
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockBoardTemplateService(t)
+			if tt.setupTemplateService != nil {
+				tt.setupTemplateService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoardTemplates(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.SaveFromBoard(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestBoardTemplates_List(t *testing.T) {
+	t.Parallel()
+
+	callerID := testutil.ValidUserID()
+	builtInTemplate := testutil.ValidBoardTemplate(domain.UserID{})
+	ownTemplate := testutil.ValidBoardTemplate(callerID)
+
+	tests := []struct {
+		name                 string
+		context              context.Context
+		setupTemplateService func(t *testing.T, s *MockBoardTemplateService)
+		wantCode             int
+		wantBody             any
+	}{
+		{
+			name: "Success",
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.ListAvailableFunc = func(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+					if ownerID != callerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, callerID)
+					}
+					return []domain.BoardTemplate{builtInTemplate, ownTemplate}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{boardTemplateBody(&builtInTemplate), boardTemplateBody(&ownTemplate)},
+		},
+		{
+			name: "Success empty",
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.ListAvailableFunc = func(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{},
+		},
+		{
+			name:     "Missing context user",
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name: "Internal error",
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.ListAvailableFunc = func(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+					return nil, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/board-templates", http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, callerID)
+			}
+			req = req.WithContext(ctx)
+
+			rr := httptest.NewRecorder()
+			s := NewMockBoardTemplateService(t)
+			if tt.setupTemplateService != nil {
+				tt.setupTemplateService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoardTemplates(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.List(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestBoardTemplates_Delete(t *testing.T) {
+	t.Parallel()
+
+	callerID := testutil.ValidUserID()
+	templateID := domain.NewBoardTemplateID()
+
+	tests := []struct {
+		name                 string
+		templateID           string
+		context              context.Context
+		setupTemplateService func(t *testing.T, s *MockBoardTemplateService)
+		wantCode             int
+		wantBody             any
+	}{
+		{
+			name:       "Success",
+			templateID: templateID.String(),
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, gotTemplateID domain.BoardTemplateID) error {
+					if ownerID != callerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, callerID)
+					}
+					if gotTemplateID != templateID {
+						t.Errorf("got templateID %v, want %v", gotTemplateID, templateID)
+					}
+					return nil
+				}
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:       "Invalid template id",
+			templateID: "not-a-uuid",
+			wantCode:   http.StatusBadRequest,
+			wantBody:   validationError("templateId", []string{"Invalid template id"}),
+		},
+		{
+			name:       "Missing context user",
+			templateID: templateID.String(),
+			context:    context.Background(),
+			wantCode:   http.StatusUnauthorized,
+			wantBody:   unauthorizedTokenError(),
+		},
+		{
+			name:       "Template not found",
+			templateID: templateID.String(),
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, templateID domain.BoardTemplateID) error {
+					return service.ErrBoardTemplateNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardTemplateNotFoundError(),
+		},
+		{
+			name:       "Internal error",
+			templateID: templateID.String(),
+			setupTemplateService: func(t *testing.T, s *MockBoardTemplateService) {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, templateID domain.BoardTemplateID) error {
+					return service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodDelete, "/v1/board-templates/"+tt.templateID, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, callerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("templateId", tt.templateID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockBoardTemplateService(t)
+			if tt.setupTemplateService != nil {
+				tt.setupTemplateService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoardTemplates(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Delete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			if tt.wantCode != http.StatusNoContent {
+				testutil.AssertContentType(t, rr, "application/json")
+			}
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
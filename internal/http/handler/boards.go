@@ -5,6 +5,8 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/http/httpschema"
@@ -14,11 +16,13 @@ import (
 
 type boardsService interface {
 	Create(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
+	CreateFromTemplate(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, templateID domain.BoardTemplateID) (domain.Board, error)
 	Get(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error)
-	GetAggregate(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (service.AggregateBoard, error)
+	GetAggregate(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error)
 	ListByOwnerID(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error)
-	Update(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error)
-	Delete(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) error
+	Update(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error)
+	Delete(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error
+	Duplicate(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error)
 }
 
 type boards struct {
@@ -34,8 +38,9 @@ func NewBoards(logger *slog.Logger, boardsService boardsService, responder *http
 }
 
 type createBoardBody struct {
-	Name        string `json:"name" example:"My Board Name"`
-	Description string `json:"description" example:"My Board Description"`
+	Name        string  `json:"name" example:"My Board Name"`
+	Description string  `json:"description" example:"My Board Description"`
+	TemplateID  *string `json:"templateId" example:"019a0000-0000-7000-8000-000000000001"`
 }
 
 type updateBoardBody struct {
@@ -50,6 +55,7 @@ type boardResponse struct {
 	Description string `json:"description" example:"My Todo Description"`
 	CreatedAt   string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
 	UpdatedAt   string `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+	Version     int64  `json:"version" example:"3"`
 }
 
 func newBoardResponse(board *domain.Board) boardResponse {
@@ -61,17 +67,62 @@ func newBoardResponse(board *domain.Board) boardResponse {
 
 		CreatedAt: service.FormatRFC3339Millis(board.CreatedAt),
 		UpdatedAt: service.FormatRFC3339Millis(board.UpdatedAt),
+		Version:   board.Version.Int64(),
 	}
 }
 
 type aggregateBoardResponse struct {
 	boardResponse
 	Columns []aggregateColumnResponse `json:"columns"`
+	Lanes   []aggregateLaneResponse   `json:"lanes"`
+}
+
+// aggregateLaneResponse is a row of the lane × column grid. Lane is null for the default lane,
+// which always comes first. Cells reference the tasks listed under columns.
+type aggregateLaneResponse struct {
+	Lane  *laneResponse           `json:"lane"`
+	Cells []aggregateCellResponse `json:"cells"`
+}
+
+type aggregateCellResponse struct {
+	ColumnID string   `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	TaskIDs  []string `json:"taskIds"`
 }
 
 type aggregateColumnResponse struct {
 	columnResponse
-	Tasks []taskResponse `json:"tasks"`
+	Tasks []aggregateTaskResponse `json:"tasks"`
+}
+
+type aggregateTaskResponse struct {
+	taskResponse
+	ColumnAge *taskColumnAgeResponse `json:"columnAge"`
+}
+
+// taskColumnAgeResponse tells how long a task has been in its current column. SLABreached is set
+// once the age reaches the SLA of the column; columns without an SLA are never breached.
+type taskColumnAgeResponse struct {
+	EnteredAt   string `json:"enteredAt" example:"2026-03-07T20:56:50.000+03:00"`
+	Seconds     int64  `json:"seconds" example:"93600"`
+	SLABreached bool   `json:"slaBreached" example:"false"`
+}
+
+// newAggregateTaskResponse adds the age of the task in column to its response. The age is null
+// when the entry of the task into the column is unknown.
+func newAggregateTaskResponse(aggregateBoard *service.AggregateBoard, column *domain.Column, task *domain.Task) aggregateTaskResponse {
+	resp := aggregateTaskResponse{taskResponse: newTaskResponse(task)}
+
+	enteredAt, ok := aggregateBoard.ColumnEnteredAt[task.ID]
+	if !ok {
+		return resp
+	}
+	age := max(aggregateBoard.AsOf.Sub(enteredAt), 0)
+	resp.ColumnAge = &taskColumnAgeResponse{
+		EnteredAt:   service.FormatRFC3339Millis(enteredAt),
+		Seconds:     int64(age / time.Second),
+		SLABreached: column.SLA.IsBreached(age),
+	}
+	return resp
 }
 
 func newBoardAggregateResponse(aggregateBoard *service.AggregateBoard) aggregateBoardResponse {
@@ -80,9 +131,9 @@ func newBoardAggregateResponse(aggregateBoard *service.AggregateBoard) aggregate
 	for i := range aggregateBoard.Columns {
 		column := &aggregateBoard.Columns[i]
 
-		taskResps := make([]taskResponse, len(column.Tasks))
+		taskResps := make([]aggregateTaskResponse, len(column.Tasks))
 		for j := range column.Tasks {
-			taskResps[j] = newTaskResponse(&column.Tasks[j])
+			taskResps[j] = newAggregateTaskResponse(aggregateBoard, &column.Column, &column.Tasks[j])
 		}
 
 		columnResps[i] = aggregateColumnResponse{
@@ -91,9 +142,107 @@ func newBoardAggregateResponse(aggregateBoard *service.AggregateBoard) aggregate
 		}
 	}
 
+	laneResps := make([]aggregateLaneResponse, len(aggregateBoard.Lanes))
+	for i := range aggregateBoard.Lanes {
+		lane := &aggregateBoard.Lanes[i]
+
+		var laneResp *laneResponse
+		if !lane.Lane.ID.IsNil() {
+			value := newLaneResponse(&lane.Lane)
+			laneResp = &value
+		}
+
+		cellResps := make([]aggregateCellResponse, len(lane.Cells))
+		for j, cell := range lane.Cells {
+			taskIDs := make([]string, len(cell.TaskIDs))
+			for k, taskID := range cell.TaskIDs {
+				taskIDs[k] = taskID.String()
+			}
+			cellResps[j] = aggregateCellResponse{ColumnID: cell.ColumnID.String(), TaskIDs: taskIDs}
+		}
+
+		laneResps[i] = aggregateLaneResponse{Lane: laneResp, Cells: cellResps}
+	}
+
 	return aggregateBoardResponse{
 		boardResponse: newBoardResponse(&aggregateBoard.Board),
 		Columns:       columnResps,
+		Lanes:         laneResps,
+	}
+}
+
+// aggregateBoardTreeResponse is the aggregate with children nested under their parents.
+// Columns only list top-level tasks; a child is listed under its parent whatever column it sits in.
+type aggregateBoardTreeResponse struct {
+	boardResponse
+	Columns []aggregateColumnTreeResponse `json:"columns"`
+}
+
+type aggregateColumnTreeResponse struct {
+	columnResponse
+	Tasks []taskTreeResponse `json:"tasks"`
+}
+
+type taskTreeResponse struct {
+	aggregateTaskResponse
+	Children []taskTreeResponse `json:"children"`
+}
+
+func newBoardAggregateTreeResponse(aggregateBoard *service.AggregateBoard) aggregateBoardTreeResponse {
+	onBoard := make(map[domain.TaskID]struct{})
+	columnOf := make(map[domain.ColumnID]*domain.Column, len(aggregateBoard.Columns))
+	for i := range aggregateBoard.Columns {
+		columnOf[aggregateBoard.Columns[i].Column.ID] = &aggregateBoard.Columns[i].Column
+		for j := range aggregateBoard.Columns[i].Tasks {
+			onBoard[aggregateBoard.Columns[i].Tasks[j].ID] = struct{}{}
+		}
+	}
+
+	// Columns and tasks come in board order, so children keep it too.
+	childrenOf := make(map[domain.TaskID][]*domain.Task)
+	for i := range aggregateBoard.Columns {
+		for j := range aggregateBoard.Columns[i].Tasks {
+			task := &aggregateBoard.Columns[i].Tasks[j]
+			if _, ok := onBoard[task.ParentID]; ok {
+				childrenOf[task.ParentID] = append(childrenOf[task.ParentID], task)
+			}
+		}
+	}
+
+	var newTree func(task *domain.Task) taskTreeResponse
+	newTree = func(task *domain.Task) taskTreeResponse {
+		children := make([]taskTreeResponse, len(childrenOf[task.ID]))
+		for i, child := range childrenOf[task.ID] {
+			children[i] = newTree(child)
+		}
+		return taskTreeResponse{
+			aggregateTaskResponse: newAggregateTaskResponse(aggregateBoard, columnOf[task.ColumnID], task),
+			Children:              children,
+		}
+	}
+
+	columnResps := make([]aggregateColumnTreeResponse, len(aggregateBoard.Columns))
+	for i := range aggregateBoard.Columns {
+		column := &aggregateBoard.Columns[i]
+
+		taskResps := []taskTreeResponse{}
+		for j := range column.Tasks {
+			task := &column.Tasks[j]
+			if _, ok := onBoard[task.ParentID]; ok {
+				continue
+			}
+			taskResps = append(taskResps, newTree(task))
+		}
+
+		columnResps[i] = aggregateColumnTreeResponse{
+			columnResponse: newColumnResponse(&column.Column),
+			Tasks:          taskResps,
+		}
+	}
+
+	return aggregateBoardTreeResponse{
+		boardResponse: newBoardResponse(&aggregateBoard.Board),
+		Columns:       columnResps,
 	}
 }
 
@@ -101,16 +250,21 @@ type listBoardsResponse = []boardResponse
 
 // Create godoc
 // @Summary Create a new board
-// @Description Create a new board for the current user
+// @Description Create a new board for the current user. When templateId is set, the board starts with the template's columns, WIP limits and starter tasks. The template must be built-in or owned by the current user.
 // @Tags boards
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
 // @Param body body createBoardBody true "Board details"
 // @Success 201 {object} boardResponse
+// @Header 201 {string} ETag "Version of the board"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_TEMPLATE_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards [post]
 func (h *boards) Create(w http.ResponseWriter, r *http.Request) {
@@ -129,6 +283,14 @@ func (h *boards) Create(w http.ResponseWriter, r *http.Request) {
 	details := []httpschema.Detail{}
 	name := httpschema.ValidateField("name", body.Name, domain.NewBoardName, &details)
 	description := httpschema.ValidateField("description", body.Description, domain.NewBoardDescription, &details)
+	var templateID *domain.BoardTemplateID
+	if body.TemplateID != nil {
+		value, parseErr := domain.ParseBoardTemplateID(*body.TemplateID)
+		if parseErr != nil {
+			details = append(details, httpschema.Detail{Field: "templateId", Issues: []string{"Invalid template id"}})
+		}
+		templateID = &value
+	}
 	if len(details) > 0 {
 		h.responder.ValidationError(w, details)
 		return
@@ -139,12 +301,22 @@ func (h *boards) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	board, err := h.boardsService.Create(r.Context(), userID, name, description)
+	var board domain.Board
+	if templateID != nil {
+		board, err = h.boardsService.CreateFromTemplate(r.Context(), userID, name, description, *templateID)
+	} else {
+		board, err = h.boardsService.Create(r.Context(), userID, name, description)
+	}
 	if err != nil {
+		if errors.Is(err, service.ErrBoardTemplateNotFound) {
+			h.responder.BoardTemplateNotFound(w, []httpschema.Detail{{Field: "templateId", Issues: []string{"Board template not found"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
 
+	setETag(w, board.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newBoardResponse(&board))
 }
 
@@ -157,6 +329,7 @@ func (h *boards) Create(w http.ResponseWriter, r *http.Request) {
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
 // @Success 200 {object} boardResponse
+// @Header 200 {string} ETag "Version of the board"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
@@ -185,17 +358,24 @@ func (h *boards) Get(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, board.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusOK, newBoardResponse(&board))
 }
 
 // GetAggregate godoc
 // @Summary Get a board aggregate by id
-// @Description Get a board with nested columns and tasks for the current user (owner only). Columns are returned in increasing position order, and tasks inside each column are returned in increasing position order.
+// @Description Get a board with nested columns and tasks for the current user (owner only). Columns are returned in increasing position order, and tasks inside each column are returned ordered by swimlane and then by position.
+// @Description The lanes field is the swimlane × column grid: the default lane (lane is null) comes first, then lanes in increasing position order, each with one cell of task ids per column.
+// @Description With tree=true the response is aggregateBoardTreeResponse: columns list only top-level tasks and every task lists its children recursively, whatever column they sit in.
+// @Description Every task has a columnAge with the time it entered its current column, its age there in seconds and whether that age breaches the column SLA; columnAge is null when the entry is unknown.
+// @Description Archived tasks are left out unless includeArchived=true, in which case they follow the active tasks of their lane in the order they were archived.
 // @Tags boards
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
+// @Param tree query bool false "Nest children under their parents"
+// @Param includeArchived query bool false "Include archived tasks"
 // @Success 200 {object} aggregateBoardResponse
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
@@ -210,12 +390,27 @@ func (h *boards) GetAggregate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	tree := false
+	if rawTree := r.URL.Query().Get("tree"); rawTree != "" {
+		tree, err = strconv.ParseBool(rawTree)
+		if err != nil {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "tree", Issues: []string{"Must be true or false"}}})
+			return
+		}
+	}
+
+	includeArchived, err := parseIncludeArchived(r)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "includeArchived", Issues: []string{"Must be true or false"}}})
+		return
+	}
+
 	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
 	if !ok {
 		return
 	}
 
-	aggregate, err := h.boardsService.GetAggregate(r.Context(), userID, boardID)
+	aggregate, err := h.boardsService.GetAggregate(r.Context(), userID, boardID, includeArchived)
 	if err != nil {
 		if errors.Is(err, service.ErrBoardNotFound) {
 			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
@@ -225,6 +420,10 @@ func (h *boards) GetAggregate(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if tree {
+		httpschema.RespondJSON(w, h.logger, http.StatusOK, newBoardAggregateTreeResponse(&aggregate))
+		return
+	}
 	httpschema.RespondJSON(w, h.logger, http.StatusOK, newBoardAggregateResponse(&aggregate))
 }
 
@@ -263,17 +462,21 @@ func (h *boards) ListByOwnerID(w http.ResponseWriter, r *http.Request) {
 // Update godoc
 // @Summary Update a board by id
 // @Description Partially update board metadata for the current user (owner only). Provided fields are updated; omitted or null fields are ignored.
+// @Description With If-Match set to the ETag of a previous response, the board is only updated if it hasn't changed since.
 // @Tags boards
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
+// @Param If-Match header string false "ETag the update is based on"
 // @Param body body updateBoardBody true "Board fields to update"
 // @Success 200 {object} boardResponse
+// @Header 200 {string} ETag "Version of the board"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId} [patch]
 func (h *boards) Update(w http.ResponseWriter, r *http.Request) {
@@ -284,6 +487,12 @@ func (h *boards) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	var body updateBoardBody
 	err = decodeJSONLimited(r, &body)
 	if err != nil {
@@ -318,31 +527,39 @@ func (h *boards) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	board, err := h.boardsService.Update(r.Context(), userID, boardID, name, description)
+	board, err := h.boardsService.Update(r.Context(), userID, boardID, name, description, ifMatch)
 	if err != nil {
 		if errors.Is(err, service.ErrBoardNotFound) {
 			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Board has changed"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
 
+	setETag(w, board.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusOK, newBoardResponse(&board))
 }
 
 // Delete godoc
 // @Summary Delete a board by id
 // @Description Permanently delete a board and its columns and tasks for the current user (owner only)
+// @Description With If-Match set to the ETag of a previous response, the board is only deleted if it hasn't changed since.
 // @Tags boards
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
+// @Param If-Match header string false "ETag the deletion is based on"
 // @Success 204 "No Content"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId} [delete]
 func (h *boards) Delete(w http.ResponseWriter, r *http.Request) {
@@ -353,17 +570,27 @@ func (h *boards) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
 	if !ok {
 		return
 	}
 
-	err = h.boardsService.Delete(r.Context(), userID, boardID)
+	err = h.boardsService.Delete(r.Context(), userID, boardID, ifMatch)
 	if err != nil {
 		if errors.Is(err, service.ErrBoardNotFound) {
 			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Board has changed"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 
 		return
@@ -371,3 +598,48 @@ func (h *boards) Delete(w http.ResponseWriter, r *http.Request) {
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// Duplicate godoc
+// @Summary Duplicate a board by id
+// @Description Make a deep copy of a board with its columns and tasks for the current user (owner only). Positions, names and descriptions are preserved, all ids are new. Links between tasks of the board are copied; links to tasks on other boards are not. Sprints and archived tasks are not copied, so every task copy starts in the backlog. The copy is listed after the existing boards.
+// @Tags boards
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Success 201 {object} boardResponse
+// @Header 201 {string} ETag "Version of the board copy"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/duplicate [post]
+func (h *boards) Duplicate(w http.ResponseWriter, r *http.Request) {
+	rawID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	board, err := h.boardsService.Duplicate(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	setETag(w, board.Version)
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newBoardResponse(&board))
+}
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/http/handler"
@@ -20,10 +21,13 @@ import (
 type boardsTestCase struct {
 	name              string
 	boardID           string
+	query             string
 	inputBody         any
+	ifMatch           string
 	context           context.Context
 	setupBoardService func(t *testing.T, s *MockBoardService)
 	wantCode          int
+	wantETag          string
 	wantBody          any
 }
 
@@ -31,6 +35,7 @@ func TestBoards_Create(t *testing.T) {
 	t.Parallel()
 
 	validBoard := testutil.ValidBoard()
+	templateID := domain.NewBoardTemplateID()
 
 	tests := []boardsTestCase{
 		{
@@ -45,15 +50,58 @@ func TestBoards_Create(t *testing.T) {
 				}
 			},
 			wantCode: http.StatusCreated,
-			wantBody: map[string]string{
+			wantBody: map[string]any{
 				"id":          validBoard.ID.String(),
 				"ownerId":     validBoard.OwnerID.String(),
 				"name":        validBoard.Name.String(),
 				"description": validBoard.Description.String(),
 				"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     validBoard.Version.Int64(),
 			},
 		},
+		{
+			name:      "Success from template",
+			inputBody: map[string]string{"name": validBoard.Name.String(), "description": validBoard.Description.String(), "templateId": templateID.String()},
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.CreateFromTemplateFunc = func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, gotTemplateID domain.BoardTemplateID) (domain.Board, error) {
+					if ownerID != validBoard.OwnerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
+					}
+					if gotTemplateID != templateID {
+						t.Errorf("got templateID %v, want %v", gotTemplateID, templateID)
+					}
+					return validBoard, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":          validBoard.ID.String(),
+				"ownerId":     validBoard.OwnerID.String(),
+				"name":        validBoard.Name.String(),
+				"description": validBoard.Description.String(),
+				"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     validBoard.Version.Int64(),
+			},
+		},
+		{
+			name:      "Invalid template id",
+			inputBody: map[string]string{"name": validBoard.Name.String(), "templateId": "not-a-uuid"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("templateId", []string{"Invalid template id"}),
+		},
+		{
+			name:      "Template not found",
+			inputBody: map[string]string{"name": validBoard.Name.String(), "templateId": templateID.String()},
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.CreateFromTemplateFunc = func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, templateID domain.BoardTemplateID) (domain.Board, error) {
+					return domain.Board{}, service.ErrBoardTemplateNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardTemplateNotFoundError(),
+		},
 		{
 			name:      "Empty name",
 			inputBody: map[string]string{"name": "", "description": validBoard.Description.String()},
@@ -158,7 +206,7 @@ func TestBoards_ListByOwnerID(t *testing.T) {
 				}
 			},
 			wantCode: http.StatusOK,
-			wantBody: []map[string]string{
+			wantBody: []map[string]any{
 				{
 					"id":          validBoard.ID.String(),
 					"ownerId":     validBoard.OwnerID.String(),
@@ -166,6 +214,7 @@ func TestBoards_ListByOwnerID(t *testing.T) {
 					"description": validBoard.Description.String(),
 					"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
 					"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+					"version":     validBoard.Version.Int64(),
 				},
 			},
 		},
@@ -251,13 +300,14 @@ func TestBoards_Get(t *testing.T) {
 				}
 			},
 			wantCode: http.StatusOK,
-			wantBody: map[string]string{
+			wantBody: map[string]any{
 				"id":          validBoard.ID.String(),
 				"ownerId":     validBoard.OwnerID.String(),
 				"name":        validBoard.Name.String(),
 				"description": validBoard.Description.String(),
 				"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     validBoard.Version.Int64(),
 			},
 		},
 		{
@@ -348,6 +398,13 @@ func TestBoards_GetAggregate(t *testing.T) {
 	firstTask := testutil.ValidTask(firstColumn.ID)
 	secondTask := testutil.NewValidTask(t, firstColumn.ID, "Second task", "Second description", 2)
 	doneTask := testutil.ValidTask(secondColumn.ID)
+	lane := testutil.ValidLane(validBoard.ID)
+	secondTask.LaneID = lane.ID
+	sla := testutil.NewValidColumnSLA(t, 24)
+	firstColumn.SLA = sla
+	asOf := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	firstEnteredAt := asOf.Add(-25 * time.Hour)
+	doneEnteredAt := asOf.Add(-time.Hour)
 
 	aggregate := service.AggregateBoard{
 		Board: validBoard,
@@ -361,6 +418,23 @@ func TestBoards_GetAggregate(t *testing.T) {
 				Tasks:  []domain.Task{doneTask},
 			},
 		},
+		Lanes: []service.AggregateLane{
+			{
+				Cells: []service.AggregateCell{
+					{ColumnID: firstColumn.ID, TaskIDs: []domain.TaskID{firstTask.ID}},
+					{ColumnID: secondColumn.ID, TaskIDs: []domain.TaskID{doneTask.ID}},
+				},
+			},
+			{
+				Lane: lane,
+				Cells: []service.AggregateCell{
+					{ColumnID: firstColumn.ID, TaskIDs: []domain.TaskID{secondTask.ID}},
+					{ColumnID: secondColumn.ID, TaskIDs: []domain.TaskID{}},
+				},
+			},
+		},
+		ColumnEnteredAt: map[domain.TaskID]time.Time{firstTask.ID: firstEnteredAt, doneTask.ID: doneEnteredAt},
+		AsOf:            asOf,
 	}
 
 	tests := []boardsTestCase{
@@ -368,7 +442,7 @@ func TestBoards_GetAggregate(t *testing.T) {
 			name:    "Success",
 			boardID: validBoard.ID.String(),
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (service.AggregateBoard, error) {
+				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error) {
 					if ownerID != validBoard.OwnerID {
 						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
 					}
@@ -386,60 +460,175 @@ func TestBoards_GetAggregate(t *testing.T) {
 				"description": validBoard.Description.String(),
 				"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     validBoard.Version.Int64(),
 				"columns": []map[string]any{
 					{
-						"id":          firstColumn.ID.String(),
-						"boardId":     firstColumn.BoardID.String(),
-						"name":        firstColumn.Name.String(),
-						"description": firstColumn.Description.String(),
-						"position":    firstColumn.Position.Int64(),
-						"createdAt":   firstColumn.CreatedAt.Format(testutil.TimeFormat),
-						"updatedAt":   firstColumn.UpdatedAt.Format(testutil.TimeFormat),
+						"id":                 firstColumn.ID.String(),
+						"boardId":            firstColumn.BoardID.String(),
+						"name":               firstColumn.Name.String(),
+						"description":        firstColumn.Description.String(),
+						"position":           firstColumn.Position.Int64(),
+						"wipLimit":           firstColumn.WIPLimit.Int64(),
+						"slaHours":           firstColumn.SLA.Hours(),
+						"archiveAfterDays":   firstColumn.ArchiveAfter.Days(),
+						"isStarted":          firstColumn.IsStarted,
+						"isDone":             firstColumn.IsDone,
+						"allowedTransitions": []any{},
+						"entryConditions":    []any{},
+						"entryRoles":         []any{},
+						"createdAt":          firstColumn.CreatedAt.Format(testutil.TimeFormat),
+						"updatedAt":          firstColumn.UpdatedAt.Format(testutil.TimeFormat),
+						"version":            firstColumn.Version.Int64(),
 						"tasks": []map[string]any{
 							{
-								"id":          firstTask.ID.String(),
-								"columnId":    firstTask.ColumnID.String(),
-								"name":        firstTask.Name.String(),
-								"description": firstTask.Description.String(),
-								"position":    firstTask.Position.Int64(),
-								"createdAt":   firstTask.CreatedAt.Format(testutil.TimeFormat),
-								"updatedAt":   firstTask.UpdatedAt.Format(testutil.TimeFormat),
+								"id":           firstTask.ID.String(),
+								"columnId":     firstTask.ColumnID.String(),
+								"laneId":       nil,
+								"parentId":     nil,
+								"sprintId":     nil,
+								"estimate":     nil,
+								"customFields": map[string]any{},
+								"name":         firstTask.Name.String(),
+								"description":  firstTask.Description.String(),
+								"position":     firstTask.Position.Int64(),
+								"checklist":    []any{},
+								"links":        []any{},
+								"rollup":       emptyTaskRollup(),
+								"archivedAt":   nil,
+								"createdAt":    firstTask.CreatedAt.Format(testutil.TimeFormat),
+								"updatedAt":    firstTask.UpdatedAt.Format(testutil.TimeFormat),
+								"version":      firstTask.Version.Int64(),
+								"columnAge": map[string]any{
+									"enteredAt":   firstEnteredAt.Format(testutil.TimeFormat),
+									"seconds":     int64(25 * 60 * 60),
+									"slaBreached": true,
+								},
 							},
 							{
-								"id":          secondTask.ID.String(),
-								"columnId":    secondTask.ColumnID.String(),
-								"name":        secondTask.Name.String(),
-								"description": secondTask.Description.String(),
-								"position":    secondTask.Position.Int64(),
-								"createdAt":   secondTask.CreatedAt.Format(testutil.TimeFormat),
-								"updatedAt":   secondTask.UpdatedAt.Format(testutil.TimeFormat),
+								"id":           secondTask.ID.String(),
+								"columnId":     secondTask.ColumnID.String(),
+								"laneId":       lane.ID.String(),
+								"parentId":     nil,
+								"sprintId":     nil,
+								"estimate":     nil,
+								"customFields": map[string]any{},
+								"name":         secondTask.Name.String(),
+								"description":  secondTask.Description.String(),
+								"position":     secondTask.Position.Int64(),
+								"checklist":    []any{},
+								"links":        []any{},
+								"rollup":       emptyTaskRollup(),
+								"archivedAt":   nil,
+								"createdAt":    secondTask.CreatedAt.Format(testutil.TimeFormat),
+								"updatedAt":    secondTask.UpdatedAt.Format(testutil.TimeFormat),
+								"version":      secondTask.Version.Int64(),
+								"columnAge":    nil,
 							},
 						},
 					},
 					{
-						"id":          secondColumn.ID.String(),
-						"boardId":     secondColumn.BoardID.String(),
-						"name":        secondColumn.Name.String(),
-						"description": secondColumn.Description.String(),
-						"position":    secondColumn.Position.Int64(),
-						"createdAt":   secondColumn.CreatedAt.Format(testutil.TimeFormat),
-						"updatedAt":   secondColumn.UpdatedAt.Format(testutil.TimeFormat),
+						"id":                 secondColumn.ID.String(),
+						"boardId":            secondColumn.BoardID.String(),
+						"name":               secondColumn.Name.String(),
+						"description":        secondColumn.Description.String(),
+						"position":           secondColumn.Position.Int64(),
+						"wipLimit":           secondColumn.WIPLimit.Int64(),
+						"slaHours":           secondColumn.SLA.Hours(),
+						"archiveAfterDays":   secondColumn.ArchiveAfter.Days(),
+						"isStarted":          secondColumn.IsStarted,
+						"isDone":             secondColumn.IsDone,
+						"allowedTransitions": []any{},
+						"entryConditions":    []any{},
+						"entryRoles":         []any{},
+						"createdAt":          secondColumn.CreatedAt.Format(testutil.TimeFormat),
+						"updatedAt":          secondColumn.UpdatedAt.Format(testutil.TimeFormat),
+						"version":            secondColumn.Version.Int64(),
 						"tasks": []map[string]any{
 							{
-								"id":          doneTask.ID.String(),
-								"columnId":    doneTask.ColumnID.String(),
-								"name":        doneTask.Name.String(),
-								"description": doneTask.Description.String(),
-								"position":    doneTask.Position.Int64(),
-								"createdAt":   doneTask.CreatedAt.Format(testutil.TimeFormat),
-								"updatedAt":   doneTask.UpdatedAt.Format(testutil.TimeFormat),
+								"id":           doneTask.ID.String(),
+								"columnId":     doneTask.ColumnID.String(),
+								"laneId":       nil,
+								"parentId":     nil,
+								"sprintId":     nil,
+								"estimate":     nil,
+								"customFields": map[string]any{},
+								"name":         doneTask.Name.String(),
+								"description":  doneTask.Description.String(),
+								"position":     doneTask.Position.Int64(),
+								"checklist":    []any{},
+								"links":        []any{},
+								"rollup":       emptyTaskRollup(),
+								"archivedAt":   nil,
+								"createdAt":    doneTask.CreatedAt.Format(testutil.TimeFormat),
+								"updatedAt":    doneTask.UpdatedAt.Format(testutil.TimeFormat),
+								"version":      doneTask.Version.Int64(),
+								"columnAge": map[string]any{
+									"enteredAt":   doneEnteredAt.Format(testutil.TimeFormat),
+									"seconds":     int64(60 * 60),
+									"slaBreached": false,
+								},
 							},
 						},
 					},
 				},
+				"lanes": []map[string]any{
+					{
+						"lane": nil,
+						"cells": []map[string]any{
+							{"columnId": firstColumn.ID.String(), "taskIds": []string{firstTask.ID.String()}},
+							{"columnId": secondColumn.ID.String(), "taskIds": []string{doneTask.ID.String()}},
+						},
+					},
+					{
+						"lane": map[string]any{
+							"id":        lane.ID.String(),
+							"boardId":   lane.BoardID.String(),
+							"name":      lane.Name.String(),
+							"position":  lane.Position.Int64(),
+							"createdAt": lane.CreatedAt.Format(testutil.TimeFormat),
+							"updatedAt": lane.UpdatedAt.Format(testutil.TimeFormat),
+						},
+						"cells": []map[string]any{
+							{"columnId": firstColumn.ID.String(), "taskIds": []string{secondTask.ID.String()}},
+							{"columnId": secondColumn.ID.String(), "taskIds": []string{}},
+						},
+					},
+				},
 			},
 		},
 
+		{
+			name:    "Success including archived",
+			boardID: validBoard.ID.String(),
+			query:   "?includeArchived=true",
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error) {
+					if !includeArchived {
+						t.Errorf("got active tasks only, want include archived")
+					}
+					return service.AggregateBoard{Board: validBoard}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":          validBoard.ID.String(),
+				"ownerId":     validBoard.OwnerID.String(),
+				"name":        validBoard.Name.String(),
+				"description": validBoard.Description.String(),
+				"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     validBoard.Version.Int64(),
+				"columns":     []any{},
+				"lanes":       []any{},
+			},
+		},
+		{
+			name:     "Invalid include archived",
+			boardID:  validBoard.ID.String(),
+			query:    "?includeArchived=maybe",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("includeArchived", []string{"Must be true or false"}),
+		},
 		{
 			name:     "Invalid board id",
 			boardID:  "not-a-uuid",
@@ -450,7 +639,7 @@ func TestBoards_GetAggregate(t *testing.T) {
 			name:    "Board not found",
 			boardID: validBoard.ID.String(),
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (service.AggregateBoard, error) {
+				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error) {
 					return service.AggregateBoard{}, service.ErrBoardNotFound
 				}
 			},
@@ -461,7 +650,7 @@ func TestBoards_GetAggregate(t *testing.T) {
 			name:    "Internal error",
 			boardID: validBoard.ID.String(),
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (service.AggregateBoard, error) {
+				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error) {
 					return service.AggregateBoard{}, service.ErrInternal
 				}
 			},
@@ -472,7 +661,7 @@ func TestBoards_GetAggregate(t *testing.T) {
 			name:    "Unknown error",
 			boardID: validBoard.ID.String(),
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (service.AggregateBoard, error) {
+				s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error) {
 					return service.AggregateBoard{}, errors.New("unknown")
 				}
 			},
@@ -492,7 +681,7 @@ func TestBoards_GetAggregate(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			path := "/v1/boards/" + tt.boardID + "/aggregate"
+			path := "/v1/boards/" + tt.boardID + "/aggregate" + tt.query
 			req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
 			ctx := tt.context
 			if ctx == nil {
@@ -519,6 +708,150 @@ func TestBoards_GetAggregate(t *testing.T) {
 	}
 }
 
+func TestBoards_GetAggregateTree(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	firstColumn := testutil.ValidColumn(validBoard.ID)
+	secondColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	parent := testutil.ValidTask(firstColumn.ID)
+	child := testutil.ValidTask(secondColumn.ID)
+	child.ParentID = parent.ID
+	orphan := testutil.NewValidTask(t, secondColumn.ID, "Orphan", "", 2)
+	orphan.ParentID = domain.NewTaskID()
+	sla := testutil.NewValidColumnSLA(t, 1)
+	secondColumn.SLA = sla
+	asOf := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	childEnteredAt := asOf.Add(-2 * time.Hour)
+	orphanEnteredAt := asOf.Add(-30 * time.Minute)
+
+	aggregate := service.AggregateBoard{
+		Board: validBoard,
+		Columns: []service.AggregateColumn{
+			{Column: firstColumn, Tasks: []domain.Task{parent}},
+			{Column: secondColumn, Tasks: []domain.Task{child, orphan}},
+		},
+		ColumnEnteredAt: map[domain.TaskID]time.Time{child.ID: childEnteredAt, orphan.ID: orphanEnteredAt},
+		AsOf:            asOf,
+	}
+
+	columnAge := func(enteredAt time.Time, breached bool) map[string]any {
+		return map[string]any{
+			"enteredAt":   enteredAt.Format(testutil.TimeFormat),
+			"seconds":     int64(asOf.Sub(enteredAt) / time.Second),
+			"slaBreached": breached,
+		}
+	}
+	taskTree := func(task *domain.Task, parentID, columnAge any, children []map[string]any) map[string]any {
+		return map[string]any{
+			"id":           task.ID.String(),
+			"columnId":     task.ColumnID.String(),
+			"laneId":       nil,
+			"parentId":     parentID,
+			"sprintId":     nil,
+			"estimate":     nil,
+			"customFields": map[string]any{},
+			"name":         task.Name.String(),
+			"description":  task.Description.String(),
+			"position":     task.Position.Int64(),
+			"checklist":    []any{},
+			"links":        []any{},
+			"rollup":       emptyTaskRollup(),
+			"archivedAt":   nil,
+			"columnAge":    columnAge,
+			"children":     children,
+			"createdAt":    task.CreatedAt.Format(testutil.TimeFormat),
+			"updatedAt":    task.UpdatedAt.Format(testutil.TimeFormat),
+			"version":      task.Version.Int64(),
+		}
+	}
+	columnTree := func(column *domain.Column, tasks []map[string]any) map[string]any {
+		return map[string]any{
+			"id":                 column.ID.String(),
+			"boardId":            column.BoardID.String(),
+			"name":               column.Name.String(),
+			"description":        column.Description.String(),
+			"position":           column.Position.Int64(),
+			"wipLimit":           column.WIPLimit.Int64(),
+			"slaHours":           column.SLA.Hours(),
+			"archiveAfterDays":   column.ArchiveAfter.Days(),
+			"isStarted":          column.IsStarted,
+			"isDone":             column.IsDone,
+			"allowedTransitions": []any{},
+			"entryConditions":    []any{},
+			"entryRoles":         []any{},
+			"createdAt":          column.CreatedAt.Format(testutil.TimeFormat),
+			"updatedAt":          column.UpdatedAt.Format(testutil.TimeFormat),
+			"version":            column.Version.Int64(),
+			"tasks":              tasks,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode int
+		wantBody any
+	}{
+		{
+			name:     "Success",
+			query:    "?tree=true",
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":          validBoard.ID.String(),
+				"ownerId":     validBoard.OwnerID.String(),
+				"name":        validBoard.Name.String(),
+				"description": validBoard.Description.String(),
+				"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     validBoard.Version.Int64(),
+				"columns": []map[string]any{
+					columnTree(&firstColumn, []map[string]any{
+						taskTree(&parent, nil, nil, []map[string]any{
+							taskTree(&child, parent.ID.String(), columnAge(childEnteredAt, true), []map[string]any{}),
+						}),
+					}),
+					columnTree(&secondColumn, []map[string]any{
+						taskTree(&orphan, orphan.ParentID.String(), columnAge(orphanEnteredAt, false), []map[string]any{}),
+					}),
+				},
+			},
+		},
+		{
+			name:     "Invalid tree flag",
+			query:    "?tree=maybe",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("tree", []string{"Must be true or false"}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/aggregate" + tt.query
+			req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+
+			rr := httptest.NewRecorder()
+
+			s := NewMockBoardService(t)
+			s.GetAggregateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error) {
+				return aggregate, nil
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoards(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.GetAggregate(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
 func TestBoards_Update(t *testing.T) {
 	t.Parallel()
 
@@ -536,8 +869,13 @@ func TestBoards_Update(t *testing.T) {
 				"name":        updatedValidBoard.Name.String(),
 				"description": updatedValidBoard.Description.String(),
 			},
+			ifMatch:  `"1"`,
+			wantETag: `"2"`,
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
+					if ifMatch != validBoard.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validBoard.Version)
+					}
 					if ownerID != validBoard.OwnerID {
 						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
 					}
@@ -553,13 +891,14 @@ func TestBoards_Update(t *testing.T) {
 					return updatedValidBoard, nil
 				}
 			},
-			wantBody: map[string]string{
+			wantBody: map[string]any{
 				"id":          updatedValidBoard.ID.String(),
 				"ownerId":     updatedValidBoard.OwnerID.String(),
 				"name":        updatedValidBoard.Name.String(),
 				"description": updatedValidBoard.Description.String(),
 				"createdAt":   updatedValidBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   updatedValidBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     updatedValidBoard.Version.Int64(),
 			},
 			wantCode: http.StatusOK,
 		},
@@ -580,7 +919,7 @@ func TestBoards_Update(t *testing.T) {
 				"name": updatedNameOnlyBoard.Name.String(),
 			},
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 					if name == nil || *name != updatedNameOnlyBoard.Name {
 						t.Errorf("got name %+v, want %+v", name, updatedNameOnlyBoard.Name)
 					}
@@ -597,6 +936,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": updatedNameOnlyBoard.Description.String(),
 				"createdAt":   updatedNameOnlyBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   updatedNameOnlyBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     updatedNameOnlyBoard.Version.Int64(),
 			},
 			wantCode: http.StatusOK,
 		},
@@ -607,7 +947,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": updatedDescriptionOnlyBoard.Description.String(),
 			},
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 					if name != nil {
 						t.Errorf("got name %+v, want nil", name)
 					}
@@ -624,6 +964,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": updatedDescriptionOnlyBoard.Description.String(),
 				"createdAt":   updatedDescriptionOnlyBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   updatedDescriptionOnlyBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     updatedDescriptionOnlyBoard.Version.Int64(),
 			},
 			wantCode: http.StatusOK,
 		},
@@ -632,7 +973,7 @@ func TestBoards_Update(t *testing.T) {
 			boardID:   validBoard.ID.String(),
 			inputBody: map[string]any{"name": nil, "description": nil},
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 					if name != nil || description != nil {
 						t.Errorf("got name %+v, description %+v, want nil, nil", name, description)
 					}
@@ -646,6 +987,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": validBoard.Description.String(),
 				"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     validBoard.Version.Int64(),
 			},
 			wantCode: http.StatusOK,
 		},
@@ -657,7 +999,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": "",
 			},
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 					if name == nil || description == nil {
 						t.Errorf("got name %+v, description %+v, want non-nil, non-nil", name, description)
 					}
@@ -671,6 +1013,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": emptyDescriptionBoard.Description.String(),
 				"createdAt":   emptyDescriptionBoard.CreatedAt.Format(testutil.TimeFormat),
 				"updatedAt":   emptyDescriptionBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     emptyDescriptionBoard.Version.Int64(),
 			},
 			wantCode: http.StatusOK,
 		},
@@ -688,7 +1031,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": validBoard.Description.String(),
 			},
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 					return domain.Board{}, service.ErrBoardNotFound
 				}
 			},
@@ -703,7 +1046,7 @@ func TestBoards_Update(t *testing.T) {
 				"description": validBoard.Description.String(),
 			},
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 					return domain.Board{}, service.ErrInternal
 				}
 			},
@@ -718,13 +1061,34 @@ func TestBoards_Update(t *testing.T) {
 				"description": validBoard.Description.String(),
 			},
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 					return domain.Board{}, errors.New("unknown")
 				}
 			},
 			wantCode: http.StatusInternalServerError,
 			wantBody: internalError(),
 		},
+		{
+			name:      "Invalid If-Match",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Renamed"},
+			ifMatch:   `W/"1"`,
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:      "Version conflict",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Renamed"},
+			ifMatch:   `"1"`,
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.UpdateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
+					return domain.Board{}, service.ErrVersionConflict
+				}
+			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Board has changed"),
+		},
 		{
 			name:    "No context user ID",
 			boardID: validBoard.ID.String(),
@@ -751,6 +1115,9 @@ func TestBoards_Update(t *testing.T) {
 
 			path := "/v1/boards/" + tt.boardID
 			req, rr := testutil.NewJSONRequestAndRecorder(t, http.MethodPatch, path, tt.inputBody)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx := tt.context
 			if ctx == nil {
 				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
@@ -769,6 +1136,9 @@ func TestBoards_Update(t *testing.T) {
 
 			testutil.AssertStatusCode(t, rr, tt.wantCode)
 			testutil.AssertContentType(t, rr, "application/json")
+			if got := rr.Header().Get("ETag"); tt.wantETag != "" && got != tt.wantETag {
+				t.Errorf("got ETag %q, want %q", got, tt.wantETag)
+			}
 			testutil.AssertResponseBody(t, rr, tt.wantBody)
 		})
 	}
@@ -783,8 +1153,12 @@ func TestBoards_Delete(t *testing.T) {
 		{
 			name:    "Success",
 			boardID: validBoard.ID.String(),
+			ifMatch: `"1"`,
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) error {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
+					if ifMatch != validBoard.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validBoard.Version)
+					}
 					if ownerID != validBoard.OwnerID {
 						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
 					}
@@ -807,7 +1181,7 @@ func TestBoards_Delete(t *testing.T) {
 			name:    "Not found",
 			boardID: validBoard.ID.String(),
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) error {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
 					return service.ErrBoardNotFound
 				}
 			},
@@ -818,7 +1192,7 @@ func TestBoards_Delete(t *testing.T) {
 			name:    "Internal error",
 			boardID: validBoard.ID.String(),
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) error {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
 					return service.ErrInternal
 				}
 			},
@@ -829,13 +1203,32 @@ func TestBoards_Delete(t *testing.T) {
 			name:    "Unknown error",
 			boardID: validBoard.ID.String(),
 			setupBoardService: func(t *testing.T, s *MockBoardService) {
-				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) error {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
 					return errors.New("unknown")
 				}
 			},
 			wantCode: http.StatusInternalServerError,
 			wantBody: internalError(),
 		},
+		{
+			name:     "Invalid If-Match",
+			boardID:  validBoard.ID.String(),
+			ifMatch:  `W/"1"`,
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:    "Version conflict",
+			boardID: validBoard.ID.String(),
+			ifMatch: `"1"`,
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.DeleteFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
+					return service.ErrVersionConflict
+				}
+			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Board has changed"),
+		},
 		{
 			name:     "No context user ID",
 			boardID:  validBoard.ID.String(),
@@ -851,6 +1244,9 @@ func TestBoards_Delete(t *testing.T) {
 
 			path := "/v1/boards/" + tt.boardID
 			req := httptest.NewRequest(http.MethodDelete, path, http.NoBody)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx := tt.context
 			if ctx == nil {
 				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
@@ -877,3 +1273,104 @@ func TestBoards_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestBoards_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	copiedBoard := validBoard
+	copiedBoard.ID = domain.NewBoardID()
+
+	tests := []boardsTestCase{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.DuplicateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error) {
+					if ownerID != validBoard.OwnerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
+					}
+					return copiedBoard, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":          copiedBoard.ID.String(),
+				"ownerId":     copiedBoard.OwnerID.String(),
+				"name":        copiedBoard.Name.String(),
+				"description": copiedBoard.Description.String(),
+				"createdAt":   copiedBoard.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":   copiedBoard.UpdatedAt.Format(testutil.TimeFormat),
+				"version":     copiedBoard.Version.Int64(),
+			},
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:    "Not found",
+			boardID: validBoard.ID.String(),
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.DuplicateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			setupBoardService: func(t *testing.T, s *MockBoardService) {
+				s.DuplicateFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:     "No context user ID",
+			boardID:  validBoard.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/duplicate"
+			req := httptest.NewRequest(http.MethodPost, path, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+
+			rr := httptest.NewRecorder()
+
+			s := NewMockBoardService(t)
+			if tt.setupBoardService != nil {
+				tt.setupBoardService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewBoards(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Duplicate(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
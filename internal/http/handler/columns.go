@@ -15,9 +15,10 @@ import (
 type columnsService interface {
 	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error)
 	ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Column, error)
-	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error)
-	Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error)
-	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) error
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error)
+	Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error
+	Duplicate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error)
 }
 
 type columns struct {
@@ -38,8 +39,16 @@ type createColumnBody struct {
 }
 
 type updateColumnBody struct {
-	Name        *string `json:"name" example:"In Progress"`
-	Description *string `json:"description" example:"My Column Description"`
+	Name               *string  `json:"name" example:"In Progress"`
+	Description        *string  `json:"description" example:"My Column Description"`
+	WIPLimit           *int64   `json:"wipLimit" example:"3"` // Advisory: tasks are never refused over it. 0 means no limit.
+	SLAHours           *int64   `json:"slaHours" example:"48"`
+	ArchiveAfterDays   *int64   `json:"archiveAfterDays" example:"14"`
+	IsStarted          *bool    `json:"isStarted" example:"true"`
+	IsDone             *bool    `json:"isDone" example:"false"`
+	AllowedTransitions []string `json:"allowedTransitions" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	EntryConditions    []string `json:"entryConditions" example:"checklist_done"`
+	EntryRoles         []string `json:"entryRoles" example:"owner"`
 }
 
 type moveColumnBody struct {
@@ -47,29 +56,56 @@ type moveColumnBody struct {
 }
 
 type columnResponse struct {
-	ID          string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
-	BoardID     string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
-	Name        string `json:"name" example:"In Progress"`
-	Description string `json:"description" example:"My Column Description"`
-	Position    int64  `json:"position" example:"1"`
-	CreatedAt   string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
-	UpdatedAt   string `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+	ID                 string   `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	BoardID            string   `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	Name               string   `json:"name" example:"In Progress"`
+	Description        string   `json:"description" example:"My Column Description"`
+	Position           int64    `json:"position" example:"1"`
+	WIPLimit           int64    `json:"wipLimit" example:"3"` // Advisory: tasks are never refused over it. 0 means no limit.
+	SLAHours           int64    `json:"slaHours" example:"48"`
+	ArchiveAfterDays   int64    `json:"archiveAfterDays" example:"14"`
+	IsStarted          bool     `json:"isStarted" example:"true"`
+	IsDone             bool     `json:"isDone" example:"false"`
+	AllowedTransitions []string `json:"allowedTransitions" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	EntryConditions    []string `json:"entryConditions" example:"checklist_done"`
+	EntryRoles         []string `json:"entryRoles" example:"owner"`
+	CreatedAt          string   `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt          string   `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+	Version            int64    `json:"version" example:"3"`
 }
 
 type columnPositionResponse struct {
 	Position int64 `json:"position" example:"2"`
+	Version  int64 `json:"version" example:"4"`
 }
 
 func newColumnResponse(column *domain.Column) columnResponse {
 	return columnResponse{
-		ID:          column.ID.String(),
-		BoardID:     column.BoardID.String(),
-		Name:        column.Name.String(),
-		Description: column.Description.String(),
-		Position:    column.Position.Int64(),
-		CreatedAt:   service.FormatRFC3339Millis(column.CreatedAt),
-		UpdatedAt:   service.FormatRFC3339Millis(column.UpdatedAt),
+		ID:                 column.ID.String(),
+		BoardID:            column.BoardID.String(),
+		Name:               column.Name.String(),
+		Description:        column.Description.String(),
+		Position:           column.Position.Int64(),
+		WIPLimit:           column.WIPLimit.Int64(),
+		SLAHours:           column.SLA.Hours(),
+		ArchiveAfterDays:   column.ArchiveAfter.Days(),
+		IsStarted:          column.IsStarted,
+		IsDone:             column.IsDone,
+		AllowedTransitions: newColumnIDsResponse(column.Transitions.Targets()),
+		EntryConditions:    column.EntryConditions.Strings(),
+		EntryRoles:         column.EntryRoles.Strings(),
+		CreatedAt:          service.FormatRFC3339Millis(column.CreatedAt),
+		UpdatedAt:          service.FormatRFC3339Millis(column.UpdatedAt),
+		Version:            column.Version.Int64(),
+	}
+}
+
+func newColumnIDsResponse(ids []domain.ColumnID) []string {
+	response := make([]string, len(ids))
+	for i, id := range ids {
+		response[i] = id.String()
 	}
+	return response
 }
 
 // Create godoc
@@ -80,12 +116,16 @@ func newColumnResponse(column *domain.Column) columnResponse {
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
 // @Param body body createColumnBody true "Column details"
 // @Success 201 {object} columnResponse
+// @Header 201 {string} ETag "Version of the column"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns [post]
 func (h *columns) Create(w http.ResponseWriter, r *http.Request) {
@@ -130,6 +170,7 @@ func (h *columns) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	setETag(w, column.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newColumnResponse(&column))
 }
 
@@ -179,19 +220,24 @@ func (h *columns) ListByBoardID(w http.ResponseWriter, r *http.Request) {
 
 // Update godoc
 // @Summary Rename a column by id
-// @Description Partially update column metadata for the current user. Provided fields are updated; omitted or null fields are ignored.
+// @Description Partially update column metadata for the current user. Provided fields are updated; omitted or null fields are ignored. The wipLimit is advisory: tasks can be created in or moved into a column at its limit, and clients are expected to flag the column; a wipLimit of 0 means no limit. A task breaches the column SLA once it has been in the column for slaHours hours, and the board owner is notified over Telegram once per stay in the column; a slaHours of 0 means no SLA. Tasks are archived archiveAfterDays days after they enter the column; an archiveAfterDays of 0 never archives. Work on a task starts when it first enters a column with isStarted or isDone set, and tasks in a column with isDone set count as finished.
+// @Description allowedTransitions lists the columns tasks may be moved to from this column; an empty list allows any column. entryConditions are checked when a task is moved into this column: checklist_done requires every checklist item to be done and estimate_set requires an estimate. entryRoles lists who may move tasks into this column: owner for the board owner and automation for the automations of the board; an empty list lets everyone in. The lists are replaced as a whole.
+// @Description With If-Match set to the ETag of a previous response, the column is only updated if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.
 // @Tags columns
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
+// @Param If-Match header string false "ETag the update is based on"
 // @Param body body updateColumnBody true "Column fields to update"
 // @Success 200 {object} columnResponse
+// @Header 200 {string} ETag "Version of the column"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "COLUMN_NOT_FOUND"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns/{columnId} [patch]
 func (h *columns) Update(w http.ResponseWriter, r *http.Request) {
@@ -209,6 +255,12 @@ func (h *columns) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	var body updateColumnBody
 	err = decodeJSONLimited(r, &body)
 	if err != nil {
@@ -221,16 +273,50 @@ func (h *columns) Update(w http.ResponseWriter, r *http.Request) {
 	}
 
 	details := []httpschema.Detail{}
-	var name *domain.ColumnName
+	patch := domain.ColumnPatch{IsStarted: body.IsStarted, IsDone: body.IsDone}
 	if body.Name != nil {
 		value := httpschema.ValidateField("name", *body.Name, domain.NewColumnName, &details)
-		name = &value
+		patch.Name = &value
 	}
 
-	var description *domain.ColumnDescription
 	if body.Description != nil {
 		value := httpschema.ValidateField("description", *body.Description, domain.NewColumnDescription, &details)
-		description = &value
+		patch.Description = &value
+	}
+
+	if body.WIPLimit != nil {
+		value := httpschema.ValidateField("wipLimit", *body.WIPLimit, domain.NewColumnWIPLimit, &details)
+		patch.WIPLimit = &value
+	}
+
+	if body.SLAHours != nil {
+		value := httpschema.ValidateField("slaHours", *body.SLAHours, domain.NewColumnSLA, &details)
+		patch.SLA = &value
+	}
+
+	if body.ArchiveAfterDays != nil {
+		value := httpschema.ValidateField("archiveAfterDays", *body.ArchiveAfterDays, domain.NewColumnArchiveAfter, &details)
+		patch.ArchiveAfter = &value
+	}
+
+	if body.AllowedTransitions != nil {
+		targets, parseErr := parseColumnIDs(body.AllowedTransitions)
+		if parseErr != nil {
+			details = append(details, httpschema.Detail{Field: "allowedTransitions", Issues: []string{"Invalid column id"}})
+		} else {
+			value := httpschema.ValidateField("allowedTransitions", targets, domain.NewColumnTransitions, &details)
+			patch.Transitions = &value
+		}
+	}
+
+	if body.EntryConditions != nil {
+		value := httpschema.ValidateField("entryConditions", body.EntryConditions, domain.NewColumnEntryConditions, &details)
+		patch.EntryConditions = &value
+	}
+
+	if body.EntryRoles != nil {
+		value := httpschema.ValidateField("entryRoles", body.EntryRoles, domain.NewColumnEntryRoles, &details)
+		patch.EntryRoles = &value
 	}
 	if len(details) > 0 {
 		h.responder.ValidationError(w, details)
@@ -242,34 +328,50 @@ func (h *columns) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	column, err := h.columnsService.Update(r.Context(), userID, boardID, columnID, name, description)
+	column, err := h.columnsService.Update(r.Context(), userID, boardID, columnID, patch, ifMatch)
 	if err != nil {
 		if errors.Is(err, service.ErrColumnNotFound) {
 			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Column has changed"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTransitionColumnNotFound) {
+			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "allowedTransitions", Issues: []string{"Column not found"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
 
+	setETag(w, column.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusOK, newColumnResponse(&column))
 }
 
 // Move godoc
 // @Summary Move a column to a new position
 // @Description Move a column within a board for the current user and shift neighboring columns accordingly.
+// @Description With If-Match set to the ETag of a previous response, the column is only moved if it hasn't changed since. Moving a column bumps its version and the versions of the columns shifted around it. Their ETags are invalidated too, so a later If-Match request on any of them fails with 412 even though the client never moved it.
 // @Tags columns
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
+// @Param If-Match header string false "ETag the move is based on"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
 // @Param body body moveColumnBody true "Target position"
 // @Success 200 {object} columnPositionResponse
+// @Header 200 {string} ETag "Version of the column"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "COLUMN_NOT_FOUND"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns/{columnId}/position [put]
 func (h *columns) Move(w http.ResponseWriter, r *http.Request) {
@@ -287,6 +389,12 @@ func (h *columns) Move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	var body moveColumnBody
 	err = decodeJSONLimited(r, &body)
 	if err != nil {
@@ -310,12 +418,16 @@ func (h *columns) Move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	position, err := h.columnsService.Move(r.Context(), userID, boardID, columnID, targetPosition)
+	placement, err := h.columnsService.Move(r.Context(), userID, boardID, columnID, targetPosition, ifMatch)
 	if err != nil {
 		if errors.Is(err, service.ErrColumnNotFound) {
 			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Column has changed"}}})
+			return
+		}
 		if errors.Is(err, service.ErrIndexOutOfBounds) {
 			h.responder.ValidationError(w, []httpschema.Detail{{Field: "targetPosition", Issues: []string{"Index out of bounds"}}})
 			return
@@ -324,22 +436,29 @@ func (h *columns) Move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	httpschema.RespondJSON(w, h.logger, http.StatusOK, columnPositionResponse{Position: position.Int64()})
+	setETag(w, placement.Version)
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, columnPositionResponse{
+		Position: placement.Position.Int64(),
+		Version:  placement.Version.Int64(),
+	})
 }
 
 // Delete godoc
 // @Summary Delete a column by id
 // @Description Permanently delete a column from board for the current user and shift positions to close the gap.
+// @Description With If-Match set to the ETag of a previous response, the column is only deleted if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.
 // @Tags columns
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
+// @Param If-Match header string false "ETag the deletion is based on"
 // @Success 204 "No Content"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "COLUMN_NOT_FOUND"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns/{columnId} [delete]
 func (h *columns) Delete(w http.ResponseWriter, r *http.Request) {
@@ -357,20 +476,95 @@ func (h *columns) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
 	if !ok {
 		return
 	}
 
-	err = h.columnsService.Delete(r.Context(), userID, boardID, columnID)
+	err = h.columnsService.Delete(r.Context(), userID, boardID, columnID, ifMatch)
 	if err != nil {
 		if errors.Is(err, service.ErrColumnNotFound) {
 			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Column has changed"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
 
 	w.WriteHeader(http.StatusNoContent)
 }
+
+// Duplicate godoc
+// @Summary Duplicate a column by id
+// @Description Make a deep copy of a column with its tasks for the current user. The copy is placed right after the original and following columns are shifted. Task positions, names and descriptions are preserved, all ids are new.
+// @Tags columns
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param columnId path string true "Column ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Success 201 {object} columnResponse
+// @Header 201 {string} ETag "Version of the column copy"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "COLUMN_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/columns/{columnId}/duplicate [post]
+func (h *columns) Duplicate(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	rawColumnID := r.PathValue("columnId")
+	columnID, err := domain.ParseColumnID(rawColumnID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Invalid column id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	column, err := h.columnsService.Duplicate(r.Context(), userID, boardID, columnID)
+	if err != nil {
+		if errors.Is(err, service.ErrColumnNotFound) {
+			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	setETag(w, column.Version)
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newColumnResponse(&column))
+}
+
+func parseColumnIDs(rawIDs []string) ([]domain.ColumnID, error) {
+	ids := make([]domain.ColumnID, len(rawIDs))
+	for i, rawID := range rawIDs {
+		id, err := domain.ParseColumnID(rawID)
+		if err != nil {
+			return nil, err
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
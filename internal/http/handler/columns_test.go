@@ -8,6 +8,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"goroutine/internal/domain"
 	"goroutine/internal/http/handler"
 	"goroutine/internal/http/httpschema"
@@ -53,13 +55,22 @@ func TestColumns_Create(t *testing.T) {
 			},
 			wantCode: http.StatusCreated,
 			wantBody: map[string]any{
-				"id":          validColumn.ID.String(),
-				"boardId":     validColumn.BoardID.String(),
-				"name":        validColumn.Name.String(),
-				"description": validColumn.Description.String(),
-				"position":    validColumn.Position.Int64(),
-				"createdAt":   validColumn.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   validColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"id":                 validColumn.ID.String(),
+				"boardId":            validColumn.BoardID.String(),
+				"name":               validColumn.Name.String(),
+				"description":        validColumn.Description.String(),
+				"position":           validColumn.Position.Int64(),
+				"wipLimit":           validColumn.WIPLimit.Int64(),
+				"slaHours":           validColumn.SLA.Hours(),
+				"archiveAfterDays":   validColumn.ArchiveAfter.Days(),
+				"isStarted":          validColumn.IsStarted,
+				"isDone":             validColumn.IsDone,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          validColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          validColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            validColumn.Version.Int64(),
 			},
 		},
 		{
@@ -213,22 +224,40 @@ func TestColumns_ListByBoardID(t *testing.T) {
 			wantCode: http.StatusOK,
 			wantBody: []map[string]any{
 				{
-					"id":          first.ID.String(),
-					"boardId":     first.BoardID.String(),
-					"name":        first.Name.String(),
-					"description": first.Description.String(),
-					"position":    first.Position.Int64(),
-					"createdAt":   first.CreatedAt.Format(testutil.TimeFormat),
-					"updatedAt":   first.UpdatedAt.Format(testutil.TimeFormat),
+					"id":                 first.ID.String(),
+					"boardId":            first.BoardID.String(),
+					"name":               first.Name.String(),
+					"description":        first.Description.String(),
+					"position":           first.Position.Int64(),
+					"wipLimit":           first.WIPLimit.Int64(),
+					"slaHours":           first.SLA.Hours(),
+					"archiveAfterDays":   first.ArchiveAfter.Days(),
+					"isStarted":          first.IsStarted,
+					"isDone":             first.IsDone,
+					"allowedTransitions": []any{},
+					"entryConditions":    []any{},
+					"entryRoles":         []any{},
+					"createdAt":          first.CreatedAt.Format(testutil.TimeFormat),
+					"updatedAt":          first.UpdatedAt.Format(testutil.TimeFormat),
+					"version":            first.Version.Int64(),
 				},
 				{
-					"id":          second.ID.String(),
-					"boardId":     second.BoardID.String(),
-					"name":        second.Name.String(),
-					"description": second.Description.String(),
-					"position":    second.Position.Int64(),
-					"createdAt":   second.CreatedAt.Format(testutil.TimeFormat),
-					"updatedAt":   second.UpdatedAt.Format(testutil.TimeFormat),
+					"id":                 second.ID.String(),
+					"boardId":            second.BoardID.String(),
+					"name":               second.Name.String(),
+					"description":        second.Description.String(),
+					"position":           second.Position.Int64(),
+					"wipLimit":           second.WIPLimit.Int64(),
+					"slaHours":           second.SLA.Hours(),
+					"archiveAfterDays":   second.ArchiveAfter.Days(),
+					"isStarted":          second.IsStarted,
+					"isDone":             second.IsDone,
+					"allowedTransitions": []any{},
+					"entryConditions":    []any{},
+					"entryRoles":         []any{},
+					"createdAt":          second.CreatedAt.Format(testutil.TimeFormat),
+					"updatedAt":          second.UpdatedAt.Format(testutil.TimeFormat),
+					"version":            second.Version.Int64(),
 				},
 			},
 		},
@@ -320,6 +349,20 @@ func TestColumns_Update(t *testing.T) {
 	updatedDescriptionOnlyColumn.Description = updatedDescOnly
 	updatedDescriptionOnlyColumn.UpdatedAt = testutil.Fixed5mFromNow()
 
+	updatedWIPLimit, err := domain.NewColumnWIPLimit(3)
+	if err != nil {
+		t.Fatalf("NewColumnWIPLimit() error = %v", err)
+	}
+	updatedWIPLimitColumn := validColumn
+	updatedWIPLimitColumn.WIPLimit = updatedWIPLimit
+	updatedWIPLimitColumn.UpdatedAt = testutil.Fixed5mFromNow()
+
+	doneColumn := validColumn
+	doneColumn.IsDone = true
+	startedColumn := validColumn
+	startedColumn.IsStarted = true
+	doneColumn.UpdatedAt = testutil.Fixed5mFromNow()
+
 	emptyDescriptionColumn := validColumn
 	emptyDescriptionColumn.Name = updatedName
 	emptyDesc, errEmpty := domain.NewColumnDescription("")
@@ -329,14 +372,35 @@ func TestColumns_Update(t *testing.T) {
 	emptyDescriptionColumn.Description = emptyDesc
 	emptyDescriptionColumn.UpdatedAt = testutil.Fixed5mFromNow()
 
+	reviewColumnID := domain.NewColumnID()
+	reviewOnly, err := domain.NewColumnTransitions([]domain.ColumnID{reviewColumnID})
+	if err != nil {
+		t.Fatalf("NewColumnTransitions() error = %v", err)
+	}
+	checklistDone, err := domain.NewColumnEntryConditions([]string{"checklist_done"})
+	if err != nil {
+		t.Fatalf("NewColumnEntryConditions() error = %v", err)
+	}
+	ownerOnly, err := domain.NewColumnEntryRoles([]string{"owner"})
+	if err != nil {
+		t.Fatalf("NewColumnEntryRoles() error = %v", err)
+	}
+	workflowColumn := validColumn
+	workflowColumn.Transitions = reviewOnly
+	workflowColumn.EntryConditions = checklistDone
+	workflowColumn.EntryRoles = ownerOnly
+	workflowColumn.UpdatedAt = testutil.Fixed5mFromNow()
+
 	tests := []struct {
 		name               string
 		boardID            string
 		columnID           string
 		inputBody          any
+		ifMatch            string
 		context            context.Context
 		setupColumnService func(t *testing.T, s *MockColumnService)
 		wantCode           int
+		wantETag           string
 		wantBody           any
 	}{
 		{
@@ -344,8 +408,13 @@ func TestColumns_Update(t *testing.T) {
 			boardID:   validBoard.ID.String(),
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"name": updatedName.String()},
+			ifMatch:   `"1"`,
+			wantETag:  `"1"`,
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if ifMatch != validColumn.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validColumn.Version)
+					}
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -355,24 +424,33 @@ func TestColumns_Update(t *testing.T) {
 					if columnID != validColumn.ID {
 						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
 					}
-					if name == nil || *name != updatedName {
-						t.Errorf("got name %v, want %v", name, updatedName)
+					if patch.Name == nil || *patch.Name != updatedName {
+						t.Errorf("got name %v, want %v", patch.Name, updatedName)
 					}
-					if description != nil {
-						t.Errorf("got description %+v, want nil", description)
+					if patch.Description != nil {
+						t.Errorf("got description %+v, want nil", patch.Description)
 					}
 					return updatedColumn, nil
 				}
 			},
 			wantCode: http.StatusOK,
 			wantBody: map[string]any{
-				"id":          updatedColumn.ID.String(),
-				"boardId":     updatedColumn.BoardID.String(),
-				"name":        updatedColumn.Name.String(),
-				"description": updatedColumn.Description.String(),
-				"position":    updatedColumn.Position.Int64(),
-				"createdAt":   updatedColumn.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   updatedColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"id":                 updatedColumn.ID.String(),
+				"boardId":            updatedColumn.BoardID.String(),
+				"name":               updatedColumn.Name.String(),
+				"description":        updatedColumn.Description.String(),
+				"position":           updatedColumn.Position.Int64(),
+				"wipLimit":           updatedColumn.WIPLimit.Int64(),
+				"slaHours":           updatedColumn.SLA.Hours(),
+				"archiveAfterDays":   updatedColumn.ArchiveAfter.Days(),
+				"isStarted":          updatedColumn.IsStarted,
+				"isDone":             updatedColumn.IsDone,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          updatedColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          updatedColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            updatedColumn.Version.Int64(),
 			},
 		},
 		{
@@ -381,25 +459,142 @@ func TestColumns_Update(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"description": updatedDescOnly.String()},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
-					if name != nil {
-						t.Errorf("got name %+v, want nil", name)
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if patch.Name != nil {
+						t.Errorf("got name %+v, want nil", patch.Name)
 					}
-					if description == nil || *description != updatedDescOnly {
-						t.Errorf("got description %v, want %v", description, updatedDescOnly)
+					if patch.Description == nil || *patch.Description != updatedDescOnly {
+						t.Errorf("got description %v, want %v", patch.Description, updatedDescOnly)
 					}
 					return updatedDescriptionOnlyColumn, nil
 				}
 			},
 			wantCode: http.StatusOK,
 			wantBody: map[string]any{
-				"id":          updatedDescriptionOnlyColumn.ID.String(),
-				"boardId":     updatedDescriptionOnlyColumn.BoardID.String(),
-				"name":        updatedDescriptionOnlyColumn.Name.String(),
-				"description": updatedDescriptionOnlyColumn.Description.String(),
-				"position":    updatedDescriptionOnlyColumn.Position.Int64(),
-				"createdAt":   updatedDescriptionOnlyColumn.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   updatedDescriptionOnlyColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"id":                 updatedDescriptionOnlyColumn.ID.String(),
+				"boardId":            updatedDescriptionOnlyColumn.BoardID.String(),
+				"name":               updatedDescriptionOnlyColumn.Name.String(),
+				"description":        updatedDescriptionOnlyColumn.Description.String(),
+				"position":           updatedDescriptionOnlyColumn.Position.Int64(),
+				"wipLimit":           updatedDescriptionOnlyColumn.WIPLimit.Int64(),
+				"slaHours":           updatedDescriptionOnlyColumn.SLA.Hours(),
+				"archiveAfterDays":   updatedDescriptionOnlyColumn.ArchiveAfter.Days(),
+				"isStarted":          updatedDescriptionOnlyColumn.IsStarted,
+				"isDone":             updatedDescriptionOnlyColumn.IsDone,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          updatedDescriptionOnlyColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          updatedDescriptionOnlyColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            updatedDescriptionOnlyColumn.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success (isDone only)",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"isDone": true},
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if patch.Name != nil || patch.Description != nil || patch.WIPLimit != nil {
+						t.Errorf("got name %+v, description %+v, wip limit %+v, want nil, nil, nil", patch.Name, patch.Description, patch.WIPLimit)
+					}
+					if patch.IsDone == nil || !*patch.IsDone {
+						t.Errorf("got done flag %v, want true", patch.IsDone)
+					}
+					return doneColumn, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":                 doneColumn.ID.String(),
+				"boardId":            doneColumn.BoardID.String(),
+				"name":               doneColumn.Name.String(),
+				"description":        doneColumn.Description.String(),
+				"position":           doneColumn.Position.Int64(),
+				"wipLimit":           doneColumn.WIPLimit.Int64(),
+				"slaHours":           doneColumn.SLA.Hours(),
+				"archiveAfterDays":   doneColumn.ArchiveAfter.Days(),
+				"isStarted":          false,
+				"isDone":             true,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          doneColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          doneColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            doneColumn.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success (isStarted only)",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"isStarted": true},
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if patch.IsDone != nil {
+						t.Errorf("got done flag %v, want nil", patch.IsDone)
+					}
+					if patch.IsStarted == nil || !*patch.IsStarted {
+						t.Errorf("got started flag %v, want true", patch.IsStarted)
+					}
+					return startedColumn, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":                 startedColumn.ID.String(),
+				"boardId":            startedColumn.BoardID.String(),
+				"name":               startedColumn.Name.String(),
+				"description":        startedColumn.Description.String(),
+				"position":           startedColumn.Position.Int64(),
+				"wipLimit":           startedColumn.WIPLimit.Int64(),
+				"slaHours":           startedColumn.SLA.Hours(),
+				"archiveAfterDays":   startedColumn.ArchiveAfter.Days(),
+				"isStarted":          true,
+				"isDone":             false,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          startedColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          startedColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            startedColumn.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success (wipLimit only)",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"wipLimit": updatedWIPLimit.Int64()},
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if patch.Name != nil || patch.Description != nil {
+						t.Errorf("got name %+v, description %+v, want nil, nil", patch.Name, patch.Description)
+					}
+					if patch.WIPLimit == nil || *patch.WIPLimit != updatedWIPLimit {
+						t.Errorf("got wip limit %v, want %v", patch.WIPLimit, updatedWIPLimit)
+					}
+					return updatedWIPLimitColumn, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":                 updatedWIPLimitColumn.ID.String(),
+				"boardId":            updatedWIPLimitColumn.BoardID.String(),
+				"name":               updatedWIPLimitColumn.Name.String(),
+				"description":        updatedWIPLimitColumn.Description.String(),
+				"position":           updatedWIPLimitColumn.Position.Int64(),
+				"wipLimit":           updatedWIPLimitColumn.WIPLimit.Int64(),
+				"slaHours":           updatedWIPLimitColumn.SLA.Hours(),
+				"archiveAfterDays":   updatedWIPLimitColumn.ArchiveAfter.Days(),
+				"isStarted":          updatedWIPLimitColumn.IsStarted,
+				"isDone":             updatedWIPLimitColumn.IsDone,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          updatedWIPLimitColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          updatedWIPLimitColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            updatedWIPLimitColumn.Version.Int64(),
 			},
 		},
 		{
@@ -408,25 +603,34 @@ func TestColumns_Update(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]any{},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
-					if name != nil {
-						t.Errorf("got name %+v, want nil", name)
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if patch.Name != nil {
+						t.Errorf("got name %+v, want nil", patch.Name)
 					}
-					if description != nil {
-						t.Errorf("got description %+v, want nil", description)
+					if patch.Description != nil {
+						t.Errorf("got description %+v, want nil", patch.Description)
 					}
 					return validColumn, nil
 				}
 			},
 			wantCode: http.StatusOK,
 			wantBody: map[string]any{
-				"id":          validColumn.ID.String(),
-				"boardId":     validColumn.BoardID.String(),
-				"name":        validColumn.Name.String(),
-				"description": validColumn.Description.String(),
-				"position":    validColumn.Position.Int64(),
-				"createdAt":   validColumn.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   validColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"id":                 validColumn.ID.String(),
+				"boardId":            validColumn.BoardID.String(),
+				"name":               validColumn.Name.String(),
+				"description":        validColumn.Description.String(),
+				"position":           validColumn.Position.Int64(),
+				"wipLimit":           validColumn.WIPLimit.Int64(),
+				"slaHours":           validColumn.SLA.Hours(),
+				"archiveAfterDays":   validColumn.ArchiveAfter.Days(),
+				"isStarted":          validColumn.IsStarted,
+				"isDone":             validColumn.IsDone,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          validColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          validColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            validColumn.Version.Int64(),
 			},
 		},
 		{
@@ -435,22 +639,31 @@ func TestColumns_Update(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"name": updatedName.String(), "description": ""},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
-					if name == nil || description == nil {
-						t.Errorf("got name %+v, description %+v, want non-nil, non-nil", name, description)
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if patch.Name == nil || patch.Description == nil {
+						t.Errorf("got name %+v, description %+v, want non-nil, non-nil", patch.Name, patch.Description)
 					}
 					return emptyDescriptionColumn, nil
 				}
 			},
 			wantCode: http.StatusOK,
 			wantBody: map[string]any{
-				"id":          emptyDescriptionColumn.ID.String(),
-				"boardId":     emptyDescriptionColumn.BoardID.String(),
-				"name":        emptyDescriptionColumn.Name.String(),
-				"description": emptyDescriptionColumn.Description.String(),
-				"position":    emptyDescriptionColumn.Position.Int64(),
-				"createdAt":   emptyDescriptionColumn.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   emptyDescriptionColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"id":                 emptyDescriptionColumn.ID.String(),
+				"boardId":            emptyDescriptionColumn.BoardID.String(),
+				"name":               emptyDescriptionColumn.Name.String(),
+				"description":        emptyDescriptionColumn.Description.String(),
+				"position":           emptyDescriptionColumn.Position.Int64(),
+				"wipLimit":           emptyDescriptionColumn.WIPLimit.Int64(),
+				"slaHours":           emptyDescriptionColumn.SLA.Hours(),
+				"archiveAfterDays":   emptyDescriptionColumn.ArchiveAfter.Days(),
+				"isStarted":          emptyDescriptionColumn.IsStarted,
+				"isDone":             emptyDescriptionColumn.IsDone,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          emptyDescriptionColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          emptyDescriptionColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            emptyDescriptionColumn.Version.Int64(),
 			},
 		},
 		{
@@ -493,6 +706,117 @@ func TestColumns_Update(t *testing.T) {
 			wantCode:  http.StatusBadRequest,
 			wantBody:  validationError("description", []string{"Description is too long"}),
 		},
+		{
+			name:      "Invalid wipLimit",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"wipLimit": -1},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("wipLimit", []string{"WIP limit is invalid"}),
+		},
+		{
+			name:     "Success (workflow rules)",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			inputBody: map[string]any{
+				"allowedTransitions": []string{reviewColumnID.String()},
+				"entryConditions":    []string{"checklist_done"},
+				"entryRoles":         []string{"owner"},
+			},
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					if diff := cmp.Diff(&reviewOnly, patch.Transitions, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("got transitions mismatch (-want +got):\n%s", diff)
+					}
+					if diff := cmp.Diff(&checklistDone, patch.EntryConditions, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("got entry conditions mismatch (-want +got):\n%s", diff)
+					}
+					if diff := cmp.Diff(&ownerOnly, patch.EntryRoles, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("got entry roles mismatch (-want +got):\n%s", diff)
+					}
+					return workflowColumn, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":                 workflowColumn.ID.String(),
+				"boardId":            workflowColumn.BoardID.String(),
+				"name":               workflowColumn.Name.String(),
+				"description":        workflowColumn.Description.String(),
+				"position":           workflowColumn.Position.Int64(),
+				"wipLimit":           workflowColumn.WIPLimit.Int64(),
+				"slaHours":           workflowColumn.SLA.Hours(),
+				"archiveAfterDays":   workflowColumn.ArchiveAfter.Days(),
+				"isStarted":          workflowColumn.IsStarted,
+				"isDone":             workflowColumn.IsDone,
+				"allowedTransitions": []any{reviewColumnID.String()},
+				"entryConditions":    []any{"checklist_done"},
+				"entryRoles":         []any{"owner"},
+				"createdAt":          workflowColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          workflowColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            workflowColumn.Version.Int64(),
+			},
+		},
+		{
+			name:      "Invalid allowedTransitions",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"allowedTransitions": []string{"review"}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("allowedTransitions", []string{"Invalid column id"}),
+		},
+		{
+			name:      "Invalid entryConditions",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"entryConditions": []string{"approved"}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("entryConditions", []string{"Entry condition must be checklist_done or estimate_set"}),
+		},
+		{
+			name:      "Invalid entryRoles",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"entryRoles": []string{"member"}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("entryRoles", []string{"Entry role must be owner or automation"}),
+		},
+		{
+			name:      "Transition target not found",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"allowedTransitions": []string{reviewColumnID.String()}},
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					return domain.Column{}, service.ErrTransitionColumnNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: columnNotFoundError("allowedTransitions"),
+		},
+		{
+			name:      "Invalid If-Match",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]string{"name": "Renamed"},
+			ifMatch:   `W/"1"`,
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:      "Version conflict",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]string{"name": "Renamed"},
+			ifMatch:   `"1"`,
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+					return domain.Column{}, service.ErrVersionConflict
+				}
+			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Column has changed"),
+		},
 		{
 			name:      "Missing context user",
 			boardID:   validBoard.ID.String(),
@@ -508,7 +832,7 @@ func TestColumns_Update(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"name": "Renamed"},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
 					return domain.Column{}, service.ErrColumnNotFound
 				}
 			},
@@ -521,7 +845,7 @@ func TestColumns_Update(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"name": "Renamed"},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
 					return domain.Column{}, service.ErrInternal
 				}
 			},
@@ -551,6 +875,9 @@ func TestColumns_Update(t *testing.T) {
 				req, _ = testutil.NewJSONRequestAndRecorder(t, http.MethodPatch, path, tt.inputBody)
 			}
 
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx := tt.context
 			if ctx == nil {
 				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
@@ -572,6 +899,9 @@ func TestColumns_Update(t *testing.T) {
 
 			testutil.AssertStatusCode(t, rr, tt.wantCode)
 			testutil.AssertContentType(t, rr, "application/json")
+			if got := rr.Header().Get("ETag"); tt.wantETag != "" && got != tt.wantETag {
+				t.Errorf("got ETag %q, want %q", got, tt.wantETag)
+			}
 			testutil.AssertResponseBody(t, rr, tt.wantBody)
 		})
 	}
@@ -586,15 +916,21 @@ func TestColumns_Move(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewColumnPosition() error = %v", err)
 	}
+	movedVersion, err := domain.NewVersion(validColumn.Version.Int64() + 1)
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
 
 	tests := []struct {
 		name               string
 		boardID            string
 		columnID           string
 		inputBody          any
+		ifMatch            string
 		context            context.Context
 		setupColumnService func(t *testing.T, s *MockColumnService)
 		wantCode           int
+		wantETag           string
 		wantBody           any
 	}{
 		{
@@ -603,7 +939,7 @@ func TestColumns_Move(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]int64{"targetPosition": targetPosition.Int64()},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, gotTargetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, gotTargetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -616,13 +952,62 @@ func TestColumns_Move(t *testing.T) {
 					if gotTargetPosition != targetPosition {
 						t.Errorf("got target position %v, want %v", gotTargetPosition, targetPosition)
 					}
-					return targetPosition, nil
+					if ifMatch.IsSet() {
+						t.Errorf("got if-match %v, want none", ifMatch)
+					}
+					return domain.ColumnPlacement{Position: targetPosition, Version: movedVersion}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantETag: `"2"`,
+			wantBody: map[string]any{
+				"position": targetPosition.Int64(),
+				"version":  movedVersion.Int64(),
+			},
+		},
+		{
+			name:      "Success with If-Match",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]int64{"targetPosition": targetPosition.Int64()},
+			ifMatch:   `"1"`,
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, gotTargetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
+					if ifMatch != validColumn.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validColumn.Version)
+					}
+					return domain.ColumnPlacement{Position: targetPosition, Version: movedVersion}, nil
 				}
 			},
 			wantCode: http.StatusOK,
+			wantETag: `"2"`,
 			wantBody: map[string]any{
 				"position": targetPosition.Int64(),
+				"version":  movedVersion.Int64(),
+			},
+		},
+		{
+			name:      "Invalid If-Match",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]int64{"targetPosition": 1},
+			ifMatch:   `W/"1"`,
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:      "Version conflict",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]int64{"targetPosition": 1},
+			ifMatch:   `"1"`,
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, service.ErrVersionConflict
+				}
 			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Column has changed"),
 		},
 		{
 			name:      "Invalid board id",
@@ -671,8 +1056,8 @@ func TestColumns_Move(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]int64{"targetPosition": 10},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
-					return domain.ColumnPosition{}, service.ErrIndexOutOfBounds
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, service.ErrIndexOutOfBounds
 				}
 			},
 			wantCode: http.StatusBadRequest,
@@ -684,8 +1069,8 @@ func TestColumns_Move(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]int64{"targetPosition": 1},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
-					return domain.ColumnPosition{}, service.ErrColumnNotFound
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, service.ErrColumnNotFound
 				}
 			},
 			wantCode: http.StatusNotFound,
@@ -697,8 +1082,8 @@ func TestColumns_Move(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]int64{"targetPosition": 1},
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
-					return domain.ColumnPosition{}, service.ErrInternal
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, service.ErrInternal
 				}
 			},
 			wantCode: http.StatusInternalServerError,
@@ -726,6 +1111,9 @@ func TestColumns_Move(t *testing.T) {
 			} else {
 				req, _ = testutil.NewJSONRequestAndRecorder(t, http.MethodPut, path, tt.inputBody)
 			}
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 
 			ctx := tt.context
 			if ctx == nil {
@@ -748,6 +1136,9 @@ func TestColumns_Move(t *testing.T) {
 
 			testutil.AssertStatusCode(t, rr, tt.wantCode)
 			testutil.AssertContentType(t, rr, "application/json")
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Errorf("got ETag %q, want %q", got, tt.wantETag)
+			}
 			testutil.AssertResponseBody(t, rr, tt.wantBody)
 		})
 	}
@@ -763,6 +1154,7 @@ func TestColumns_Delete(t *testing.T) {
 		name               string
 		boardID            string
 		columnID           string
+		ifMatch            string
 		context            context.Context
 		setupColumnService func(t *testing.T, s *MockColumnService)
 		wantCode           int
@@ -772,8 +1164,12 @@ func TestColumns_Delete(t *testing.T) {
 			name:     "Success",
 			boardID:  validBoard.ID.String(),
 			columnID: validColumn.ID.String(),
+			ifMatch:  `"1"`,
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) error {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error {
+					if ifMatch != validColumn.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validColumn.Version)
+					}
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -803,6 +1199,27 @@ func TestColumns_Delete(t *testing.T) {
 			wantCode: http.StatusBadRequest,
 			wantBody: validationError("columnId", []string{"Invalid column id"}),
 		},
+		{
+			name:     "Invalid If-Match",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			ifMatch:  `W/"1"`,
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:     "Version conflict",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			ifMatch:  `"1"`,
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error {
+					return service.ErrVersionConflict
+				}
+			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Column has changed"),
+		},
 		{
 			name:     "Missing context user",
 			boardID:  validBoard.ID.String(),
@@ -816,7 +1233,7 @@ func TestColumns_Delete(t *testing.T) {
 			boardID:  validBoard.ID.String(),
 			columnID: validColumn.ID.String(),
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) error {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error {
 					return service.ErrColumnNotFound
 				}
 			},
@@ -828,7 +1245,7 @@ func TestColumns_Delete(t *testing.T) {
 			boardID:  validBoard.ID.String(),
 			columnID: validColumn.ID.String(),
 			setupColumnService: func(t *testing.T, s *MockColumnService) {
-				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) error {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error {
 					return service.ErrInternal
 				}
 			},
@@ -843,6 +1260,9 @@ func TestColumns_Delete(t *testing.T) {
 
 			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID
 			req := httptest.NewRequest(http.MethodDelete, path, http.NoBody)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx := tt.context
 			if ctx == nil {
 				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
@@ -870,3 +1290,138 @@ func TestColumns_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestColumns_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	copiedColumn := validColumn
+	copiedColumn.ID = domain.NewColumnID()
+
+	tests := []struct {
+		name               string
+		boardID            string
+		columnID           string
+		context            context.Context
+		setupColumnService func(t *testing.T, s *MockColumnService)
+		wantCode           int
+		wantBody           any
+	}{
+		{
+			name:     "Success",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.DuplicateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if columnID != validColumn.ID {
+						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
+					}
+					return copiedColumn, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":                 copiedColumn.ID.String(),
+				"boardId":            copiedColumn.BoardID.String(),
+				"name":               copiedColumn.Name.String(),
+				"description":        copiedColumn.Description.String(),
+				"position":           copiedColumn.Position.Int64(),
+				"wipLimit":           copiedColumn.WIPLimit.Int64(),
+				"slaHours":           copiedColumn.SLA.Hours(),
+				"archiveAfterDays":   copiedColumn.ArchiveAfter.Days(),
+				"isStarted":          copiedColumn.IsStarted,
+				"isDone":             copiedColumn.IsDone,
+				"allowedTransitions": []any{},
+				"entryConditions":    []any{},
+				"entryRoles":         []any{},
+				"createdAt":          copiedColumn.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":          copiedColumn.UpdatedAt.Format(testutil.TimeFormat),
+				"version":            copiedColumn.Version.Int64(),
+			},
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "not-a-uuid",
+			columnID: validColumn.ID.String(),
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:     "Invalid column id",
+			boardID:  validBoard.ID.String(),
+			columnID: "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("columnId", []string{"Invalid column id"}),
+		},
+		{
+			name:     "Missing context user",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:     "Column not found",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.DuplicateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					return domain.Column{}, service.ErrColumnNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: columnNotFoundError("columnId"),
+		},
+		{
+			name:     "Internal error",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			setupColumnService: func(t *testing.T, s *MockColumnService) {
+				s.DuplicateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					return domain.Column{}, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/duplicate"
+			req := httptest.NewRequest(http.MethodPost, path, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+
+			req.SetPathValue("boardId", tt.boardID)
+			req.SetPathValue("columnId", tt.columnID)
+
+			rr := httptest.NewRecorder()
+			mockColumns := NewMockColumnService(t)
+			if tt.setupColumnService != nil {
+				tt.setupColumnService(t, mockColumns)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewColumns(logger, mockColumns, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Duplicate(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
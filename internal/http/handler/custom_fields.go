@@ -0,0 +1,307 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type customFieldsService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error)
+	ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID) error
+}
+
+type customFields struct {
+	logger              *slog.Logger
+	customFieldsService customFieldsService
+	responder           *httpschema.ErrorResponder
+}
+
+func NewCustomFields(logger *slog.Logger, customFieldsService customFieldsService, responder *httpschema.ErrorResponder) *customFields {
+	moduleLogger := logging.WithModule(logger, "handler.custom_fields")
+
+	return &customFields{logger: moduleLogger, customFieldsService: customFieldsService, responder: responder}
+}
+
+type createCustomFieldBody struct {
+	Name    string   `json:"name" example:"Environment"`
+	Type    string   `json:"type" example:"single_select" enums:"text,number,date,single_select,multi_select,checkbox,user"`
+	Options []string `json:"options" example:"staging,production"`
+}
+
+type updateCustomFieldBody struct {
+	Name    *string  `json:"name" example:"Environment"`
+	Options []string `json:"options" example:"staging,production"`
+}
+
+type customFieldResponse struct {
+	ID        string   `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a8"`
+	BoardID   string   `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	Name      string   `json:"name" example:"Environment"`
+	Type      string   `json:"type" example:"single_select"`
+	Options   []string `json:"options" example:"staging,production"`
+	CreatedAt string   `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt string   `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newCustomFieldResponse(field *domain.CustomField) customFieldResponse {
+	return customFieldResponse{
+		ID:        field.ID.String(),
+		BoardID:   field.BoardID.String(),
+		Name:      field.Name.String(),
+		Type:      string(field.Type),
+		Options:   field.Options.Strings(),
+		CreatedAt: service.FormatRFC3339Millis(field.CreatedAt),
+		UpdatedAt: service.FormatRFC3339Millis(field.UpdatedAt),
+	}
+}
+
+// Create godoc
+// @Summary Create a custom field
+// @Description Define a custom field on a board for the current user. Select fields (single_select, multi_select) require options, other types take none. The type can't be changed later.
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createCustomFieldBody true "Custom field details"
+// @Success 201 {object} customFieldResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/custom-fields [post]
+func (h *customFields) Create(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	var body createCustomFieldBody
+	err = decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	name := httpschema.ValidateField("name", body.Name, domain.NewCustomFieldName, &details)
+	fieldType := httpschema.ValidateField("type", body.Type, domain.NewCustomFieldType, &details)
+	options, err := domain.NewCustomFieldOptions(body.Options)
+	if err == nil && fieldType != "" {
+		err = domain.CheckCustomFieldOptions(fieldType, options)
+	}
+	if err != nil {
+		details = append(details, httpschema.Detail{Field: "options", Issues: domain.ExtractValidationIssues(err)})
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	field, err := h.customFieldsService.Create(r.Context(), userID, boardID, name, fieldType, options)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newCustomFieldResponse(&field))
+}
+
+// List godoc
+// @Summary List custom fields of a board
+// @Description Get all custom fields defined on the specified board, oldest first.
+// @Tags custom-fields
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {array} customFieldResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/custom-fields [get]
+func (h *customFields) ListByBoardID(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	fields, err := h.customFieldsService.ListByBoardID(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make([]customFieldResponse, 0, len(fields))
+	for i := range fields {
+		response = append(response, newCustomFieldResponse(&fields[i]))
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// Update godoc
+// @Summary Update a custom field by id
+// @Description Rename a custom field or replace the options of a select field. Omitted or null fields are ignored. Task values lose the options that are no longer offered; single select values and emptied multi select values are removed.
+// @Tags custom-fields
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param fieldId path string true "Custom field ID"
+// @Param body body updateCustomFieldBody true "Custom field fields to update"
+// @Success 200 {object} customFieldResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "CUSTOM_FIELD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/custom-fields/{fieldId} [patch]
+func (h *customFields) Update(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	rawFieldID := r.PathValue("fieldId")
+	fieldID, err := domain.ParseCustomFieldID(rawFieldID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "fieldId", Issues: []string{"Invalid custom field id"}}})
+		return
+	}
+
+	var body updateCustomFieldBody
+	err = decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	var name *domain.CustomFieldName
+	if body.Name != nil {
+		value := httpschema.ValidateField("name", *body.Name, domain.NewCustomFieldName, &details)
+		name = &value
+	}
+	var options *domain.CustomFieldOptions
+	if body.Options != nil {
+		value := httpschema.ValidateField("options", body.Options, domain.NewCustomFieldOptions, &details)
+		options = &value
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	field, err := h.customFieldsService.Update(r.Context(), userID, boardID, fieldID, name, options)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrCustomFieldNotFound):
+			h.responder.CustomFieldNotFound(w, []httpschema.Detail{{Field: "fieldId", Issues: []string{"Custom field not found"}}})
+		case errors.Is(err, service.ErrCustomFieldOptionsInvalid):
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "options", Issues: domain.ExtractValidationIssues(err)}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newCustomFieldResponse(&field))
+}
+
+// Delete godoc
+// @Summary Delete a custom field by id
+// @Description Permanently delete a custom field from a board together with its values on every task of the board.
+// @Tags custom-fields
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param fieldId path string true "Custom field ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "CUSTOM_FIELD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/custom-fields/{fieldId} [delete]
+func (h *customFields) Delete(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	rawFieldID := r.PathValue("fieldId")
+	fieldID, err := domain.ParseCustomFieldID(rawFieldID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "fieldId", Issues: []string{"Invalid custom field id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err = h.customFieldsService.Delete(r.Context(), userID, boardID, fieldID)
+	if err != nil {
+		if errors.Is(err, service.ErrCustomFieldNotFound) {
+			h.responder.CustomFieldNotFound(w, []httpschema.Detail{{Field: "fieldId", Issues: []string{"Custom field not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,472 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+type customFieldsTestCase struct {
+	name                    string
+	boardID                 string
+	fieldID                 string
+	inputBody               any
+	context                 context.Context
+	setupCustomFieldService func(t *testing.T, s *MockCustomFieldService)
+	wantCode                int
+	wantBody                any
+}
+
+func newCustomFieldsRequest(t *testing.T, tt *customFieldsTestCase, method, path string, ownerID domain.UserID) *http.Request {
+	t.Helper()
+
+	var req *http.Request
+	switch body := tt.inputBody.(type) {
+	case nil:
+		req = httptest.NewRequest(method, path, http.NoBody)
+	case string:
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	default:
+		req, _ = testutil.NewJSONRequestAndRecorder(t, method, path, body)
+	}
+
+	ctx := tt.context
+	if ctx == nil {
+		ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, ownerID)
+	}
+	req = req.WithContext(ctx)
+	req.SetPathValue("boardId", tt.boardID)
+	req.SetPathValue("fieldId", tt.fieldID)
+
+	return req
+}
+
+func customFieldResponseMap(field *domain.CustomField) map[string]any {
+	return map[string]any{
+		"id":        field.ID.String(),
+		"boardId":   field.BoardID.String(),
+		"name":      field.Name.String(),
+		"type":      string(field.Type),
+		"options":   field.Options.Strings(),
+		"createdAt": field.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt": field.UpdatedAt.Format(testutil.TimeFormat),
+	}
+}
+
+func TestCustomFields_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validField := testutil.ValidCustomField(validBoard.ID)
+
+	tests := []customFieldsTestCase{
+		{
+			name:      "Success",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Environment", "type": "single_select", "options": []string{" staging ", "production"}},
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if fieldType != domain.CustomFieldSingleSelect {
+						t.Errorf("got type %v, want %v", fieldType, domain.CustomFieldSingleSelect)
+					}
+					if got := options.Strings(); len(got) != 2 || got[0] != "staging" {
+						t.Errorf("got options %v, want trimmed options", got)
+					}
+					return validField, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: customFieldResponseMap(&validField),
+		},
+		{
+			name:      "Invalid board id",
+			boardID:   "not-a-uuid",
+			inputBody: map[string]any{"name": "Environment", "type": "text"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:      "Invalid JSON",
+			boardID:   validBoard.ID.String(),
+			inputBody: "{\"name\":\"broken\"",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Invalid type",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Environment", "type": "color"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("type", []string{domain.ErrCustomFieldTypeValue}),
+		},
+		{
+			name:      "Select field without options",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Environment", "type": "multi_select"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("options", []string{domain.ErrCustomFieldOptionsRequired}),
+		},
+		{
+			name:      "Options on a text field",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Customer", "type": "text", "options": []string{"Acme"}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("options", []string{domain.ErrCustomFieldOptionsForbidden}),
+		},
+		{
+			name:      "Duplicate options",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Environment", "type": "single_select", "options": []string{"staging", "staging "}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("options", []string{domain.ErrCustomFieldOptionDuplicate}),
+		},
+		{
+			name:      "Missing context user",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Customer", "type": "text"},
+			context:   context.Background(),
+			wantCode:  http.StatusUnauthorized,
+			wantBody:  unauthorizedTokenError(),
+		},
+		{
+			name:      "Board not found",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Customer", "type": "text"},
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error) {
+					return domain.CustomField{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:      "Internal error",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"name": "Customer", "type": "text"},
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error) {
+					return domain.CustomField{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:      "Body too large",
+			boardID:   validBoard.ID.String(),
+			inputBody: testutil.Valid25KBJSON(),
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newCustomFieldsRequest(t, &tt, http.MethodPost, "/v1/boards/"+tt.boardID+"/custom-fields", validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockFields := NewMockCustomFieldService(t)
+			if tt.setupCustomFieldService != nil {
+				tt.setupCustomFieldService(t, mockFields)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewCustomFields(logger, mockFields, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestCustomFields_ListByBoardID(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	selectField := testutil.ValidCustomField(validBoard.ID)
+	textField := testutil.ValidCustomField(validBoard.ID)
+	textField.Type = domain.CustomFieldText
+	textField.Options = domain.CustomFieldOptions{}
+
+	tests := []customFieldsTestCase{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					return []domain.CustomField{selectField, textField}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []map[string]any{customFieldResponseMap(&selectField), customFieldResponseMap(&textField)},
+		},
+		{
+			name:    "Empty",
+			boardID: validBoard.ID.String(),
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error) {
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []map[string]any{},
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:    "Board not found",
+			boardID: validBoard.ID.String(),
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error) {
+					return nil, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error) {
+					return nil, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newCustomFieldsRequest(t, &tt, http.MethodGet, "/v1/boards/"+tt.boardID+"/custom-fields", validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockFields := NewMockCustomFieldService(t)
+			if tt.setupCustomFieldService != nil {
+				tt.setupCustomFieldService(t, mockFields)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewCustomFields(logger, mockFields, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.ListByBoardID(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestCustomFields_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validField := testutil.ValidCustomField(validBoard.ID)
+
+	tests := []customFieldsTestCase{
+		{
+			name:      "Success",
+			boardID:   validBoard.ID.String(),
+			fieldID:   validField.ID.String(),
+			inputBody: map[string]any{"options": []string{"staging", "production"}},
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+					if fieldID != validField.ID {
+						t.Errorf("got field id %v, want %v", fieldID, validField.ID)
+					}
+					if name != nil {
+						t.Errorf("got name %v, want nil", name)
+					}
+					if options == nil || options.Len() != 2 {
+						t.Errorf("got options %v, want 2 options", options)
+					}
+					return validField, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: customFieldResponseMap(&validField),
+		},
+		{
+			name:      "Invalid field id",
+			boardID:   validBoard.ID.String(),
+			fieldID:   "not-a-uuid",
+			inputBody: map[string]any{"name": "Stage"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("fieldId", []string{"Invalid custom field id"}),
+		},
+		{
+			name:      "Invalid name",
+			boardID:   validBoard.ID.String(),
+			fieldID:   validField.ID.String(),
+			inputBody: map[string]any{"name": " "},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("name", []string{domain.ErrCustomFieldNameTooShort}),
+		},
+		{
+			name:      "Options don't fit the field",
+			boardID:   validBoard.ID.String(),
+			fieldID:   validField.ID.String(),
+			inputBody: map[string]any{"options": []string{}},
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+					err := domain.CheckCustomFieldOptions(domain.CustomFieldSingleSelect, *options)
+					return domain.CustomField{}, fmt.Errorf("update: %w: %w", err, service.ErrCustomFieldOptionsInvalid)
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("options", []string{domain.ErrCustomFieldOptionsRequired}),
+		},
+		{
+			name:      "Field not found",
+			boardID:   validBoard.ID.String(),
+			fieldID:   validField.ID.String(),
+			inputBody: map[string]any{"name": "Stage"},
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+					return domain.CustomField{}, service.ErrCustomFieldNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: customFieldNotFoundError(),
+		},
+		{
+			name:      "Internal error",
+			boardID:   validBoard.ID.String(),
+			fieldID:   validField.ID.String(),
+			inputBody: map[string]any{"name": "Stage"},
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+					return domain.CustomField{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newCustomFieldsRequest(t, &tt, http.MethodPatch, "/v1/boards/"+tt.boardID+"/custom-fields/"+tt.fieldID, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockFields := NewMockCustomFieldService(t)
+			if tt.setupCustomFieldService != nil {
+				tt.setupCustomFieldService(t, mockFields)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewCustomFields(logger, mockFields, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Update(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestCustomFields_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validField := testutil.ValidCustomField(validBoard.ID)
+
+	tests := []customFieldsTestCase{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			fieldID: validField.ID.String(),
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+					if fieldID != validField.ID {
+						t.Errorf("got field id %v, want %v", fieldID, validField.ID)
+					}
+					return nil
+				}
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "not-a-uuid",
+			fieldID:  validField.ID.String(),
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:    "Field not found",
+			boardID: validBoard.ID.String(),
+			fieldID: validField.ID.String(),
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+					return service.ErrCustomFieldNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: customFieldNotFoundError(),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			fieldID: validField.ID.String(),
+			setupCustomFieldService: func(t *testing.T, s *MockCustomFieldService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+					return errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newCustomFieldsRequest(t, &tt, http.MethodDelete, "/v1/boards/"+tt.boardID+"/custom-fields/"+tt.fieldID, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockFields := NewMockCustomFieldService(t)
+			if tt.setupCustomFieldService != nil {
+				tt.setupCustomFieldService(t, mockFields)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewCustomFields(logger, mockFields, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Delete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
@@ -0,0 +1,550 @@
+package handler
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type exportService interface {
+	Export(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error
+}
+
+type exports struct {
+	logger        *slog.Logger
+	exportService exportService
+	responder     *httpschema.ErrorResponder
+}
+
+func NewExports(logger *slog.Logger, exportService exportService, responder *httpschema.ErrorResponder) *exports {
+	moduleLogger := logging.WithModule(logger, "handler.exports")
+
+	return &exports{logger: moduleLogger, exportService: exportService, responder: responder}
+}
+
+// boardExportHeader is everything of the JSON export before the tasks.
+type boardExportHeader struct {
+	Format       string                `json:"format" example:"goroutine.board"`
+	Version      int                   `json:"version" example:"1"`
+	ExportedAt   string                `json:"exportedAt" example:"2026-03-21T20:56:50.000+03:00"`
+	Board        boardResponse         `json:"board"`
+	Columns      []columnResponse      `json:"columns"`
+	Lanes        []laneResponse        `json:"lanes"`
+	CustomFields []customFieldResponse `json:"customFields"`
+	Sprints      []sprintResponse      `json:"sprints"`
+}
+
+// boardExportDocument is the JSON export. It is written piece by piece and never built as a whole.
+type boardExportDocument struct {
+	boardExportHeader
+	// Tasks are ordered by column, lane and position; archived tasks follow the active ones of their cell.
+	Tasks []boardExportTask `json:"tasks"`
+	Links []boardExportLink `json:"links"`
+}
+
+type boardExportTask struct {
+	ID          string                      `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	ColumnID    string                      `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	LaneID      *string                     `json:"laneId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a6"`
+	ParentID    *string                     `json:"parentId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a5"`
+	SprintID    *string                     `json:"sprintId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Name        string                      `json:"name" example:"Write tests"`
+	Description string                      `json:"description" example:"Cover the new endpoint with tests"`
+	Position    int64                       `json:"position" example:"1"`
+	Checklist   []taskChecklistItemResponse `json:"checklist"`
+	Estimate    *float64                    `json:"estimate" example:"3"`
+	// CustomFields holds the values of the board's custom fields keyed by field id. Fields without a value are omitted.
+	CustomFields map[string]json.RawMessage `json:"customFields" swaggertype:"object"`
+	// ArchivedAt is null for active tasks.
+	ArchivedAt *string `json:"archivedAt" example:"2026-03-21T20:56:50.000+03:00"`
+	CreatedAt  string  `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt  string  `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+type boardExportLink struct {
+	ID        string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a8"`
+	SourceID  string `json:"sourceId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	TargetID  string `json:"targetId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+	Type      string `json:"type" example:"blocks" enums:"blocks,relates_to,duplicates"`
+	CreatedAt string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newBoardExportHeader(export *service.BoardExport) boardExportHeader {
+	columns := make([]columnResponse, len(export.Columns))
+	for i := range export.Columns {
+		columns[i] = newColumnResponse(&export.Columns[i])
+	}
+	lanes := make([]laneResponse, len(export.Lanes))
+	for i := range export.Lanes {
+		lanes[i] = newLaneResponse(&export.Lanes[i])
+	}
+	fields := make([]customFieldResponse, len(export.CustomFields))
+	for i := range export.CustomFields {
+		fields[i] = newCustomFieldResponse(&export.CustomFields[i])
+	}
+	sprints := make([]sprintResponse, len(export.Sprints))
+	for i := range export.Sprints {
+		sprints[i] = newSprintResponse(&export.Sprints[i])
+	}
+
+	return boardExportHeader{
+		Format:       service.BoardExportFormat,
+		Version:      service.BoardExportVersion,
+		ExportedAt:   service.FormatRFC3339Millis(export.ExportedAt),
+		Board:        newBoardResponse(&export.Board),
+		Columns:      columns,
+		Lanes:        lanes,
+		CustomFields: fields,
+		Sprints:      sprints,
+	}
+}
+
+func newBoardExportTask(task *domain.Task) boardExportTask {
+	var parentID *string
+	if task.HasParent() {
+		value := task.ParentID.String()
+		parentID = &value
+	}
+
+	return boardExportTask{
+		ID:           task.ID.String(),
+		ColumnID:     task.ColumnID.String(),
+		LaneID:       newLaneIDResponse(task.LaneID),
+		ParentID:     parentID,
+		SprintID:     newSprintIDResponse(task.SprintID),
+		Name:         task.Name.String(),
+		Description:  task.Description.String(),
+		Position:     task.Position.Int64(),
+		Checklist:    newTaskChecklistResponse(task.Checklist),
+		Estimate:     newTaskEstimateResponse(task.Estimate),
+		CustomFields: task.CustomFields.JSON(),
+		ArchivedAt:   newArchivedAtResponse(task.ArchivedAt),
+		CreatedAt:    service.FormatRFC3339Millis(task.CreatedAt),
+		UpdatedAt:    service.FormatRFC3339Millis(task.UpdatedAt),
+	}
+}
+
+func newBoardExportLink(link *domain.TaskLink) boardExportLink {
+	return boardExportLink{
+		ID:        link.ID.String(),
+		SourceID:  link.SourceID.String(),
+		TargetID:  link.TargetID.String(),
+		Type:      link.Type.String(),
+		CreatedAt: service.FormatRFC3339Millis(link.CreatedAt),
+	}
+}
+
+// boardExportWriter is a service.BoardExportWriter that writes an HTTP response.
+type boardExportWriter interface {
+	service.BoardExportWriter
+	// hasStarted reports whether the response status was sent, after which errors can no longer be reported.
+	hasStarted() bool
+}
+
+func newBoardExportWriter(w http.ResponseWriter, format string) (boardExportWriter, bool) {
+	switch format {
+	case "json":
+		return &jsonBoardExportWriter{exportResponse: exportResponse{w: w, contentType: "application/json", extension: ".json"}}, true
+	case "csv":
+		return &csvBoardExportWriter{exportResponse: exportResponse{w: w, contentType: "text/csv; charset=utf-8", extension: ".csv"}}, true
+	case "md":
+		return &markdownBoardExportWriter{exportResponse: exportResponse{w: w, contentType: "text/markdown; charset=utf-8", extension: ".md"}}, true
+	default:
+		return nil, false
+	}
+}
+
+// exportResponse sends the status and headers of an export once the board is known.
+type exportResponse struct {
+	w           http.ResponseWriter
+	contentType string
+	extension   string
+	started     bool
+}
+
+func (r *exportResponse) start(export *service.BoardExport) {
+	filename := exportFileSlug(export.Board.Name.String()) + "-" + export.ExportedAt.UTC().Format("2006-01-02") + r.extension
+	r.w.Header().Set("Content-Type", r.contentType)
+	r.w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": filename}))
+	r.w.WriteHeader(http.StatusOK)
+	r.started = true
+}
+
+func (r *exportResponse) hasStarted() bool {
+	return r.started
+}
+
+// exportFileSlug lowercases the board name and replaces every run of characters other than
+// letters and digits with a dash.
+func exportFileSlug(name string) string {
+	var b strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+	if b.Len() == 0 {
+		return "board"
+	}
+	return b.String()
+}
+
+// jsonBoardExportWriter writes boardExportDocument. The header is marshaled as a whole and left
+// open, then the tasks and links are appended to it one at a time.
+type jsonBoardExportWriter struct {
+	exportResponse
+	tasks int
+	links int
+	// linksOpen is set once the tasks array is closed and the links array opened.
+	linksOpen bool
+}
+
+func (e *jsonBoardExportWriter) Begin(export *service.BoardExport) error {
+	header, err := json.Marshal(newBoardExportHeader(export))
+	if err != nil {
+		return fmt.Errorf("marshal export header: %w", err)
+	}
+
+	e.start(export)
+	_, err = e.w.Write(append(header[:len(header)-1], `,"tasks":[`...))
+	return err
+}
+
+func (e *jsonBoardExportWriter) Task(task *domain.Task) error {
+	return e.writeElement(newBoardExportTask(task), &e.tasks)
+}
+
+func (e *jsonBoardExportWriter) Link(link *domain.TaskLink) error {
+	err := e.openLinks()
+	if err != nil {
+		return err
+	}
+	return e.writeElement(newBoardExportLink(link), &e.links)
+}
+
+func (e *jsonBoardExportWriter) End() error {
+	err := e.openLinks()
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(e.w, "]}\n")
+	return err
+}
+
+func (e *jsonBoardExportWriter) openLinks() error {
+	if e.linksOpen {
+		return nil
+	}
+	e.linksOpen = true
+	_, err := io.WriteString(e.w, `],"links":[`)
+	return err
+}
+
+func (e *jsonBoardExportWriter) writeElement(element any, count *int) error {
+	raw, err := json.Marshal(element)
+	if err != nil {
+		return fmt.Errorf("marshal export element: %w", err)
+	}
+	if *count > 0 {
+		raw = append([]byte{','}, raw...)
+	}
+	*count++
+
+	_, err = e.w.Write(raw)
+	return err
+}
+
+// csvBoardExportWriter writes one row per task. Columns, lanes and sprints are written by name,
+// and every custom field gets a column of its own after the fixed ones. Links are left out.
+type csvBoardExportWriter struct {
+	exportResponse
+	csv     *csv.Writer
+	columns map[domain.ColumnID]string
+	lanes   map[domain.LaneID]string
+	sprints map[domain.SprintID]string
+	fields  []domain.CustomFieldID
+}
+
+var csvBoardExportHeader = []string{
+	"id", "column", "lane", "position", "name", "description", "parent_id", "sprint", "estimate",
+	"checklist", "archived_at", "created_at", "updated_at",
+}
+
+func (e *csvBoardExportWriter) Begin(export *service.BoardExport) error {
+	e.columns = make(map[domain.ColumnID]string, len(export.Columns))
+	for _, column := range export.Columns {
+		e.columns[column.ID] = column.Name.String()
+	}
+	e.lanes = make(map[domain.LaneID]string, len(export.Lanes))
+	for _, lane := range export.Lanes {
+		e.lanes[lane.ID] = lane.Name.String()
+	}
+	e.sprints = make(map[domain.SprintID]string, len(export.Sprints))
+	for _, sprint := range export.Sprints {
+		e.sprints[sprint.ID] = sprint.Name.String()
+	}
+	header := append([]string{}, csvBoardExportHeader...)
+	for _, field := range export.CustomFields {
+		e.fields = append(e.fields, field.ID)
+		header = append(header, field.Name.String())
+	}
+
+	e.start(export)
+	e.csv = csv.NewWriter(e.w)
+	return e.csv.Write(header)
+}
+
+func (e *csvBoardExportWriter) Task(task *domain.Task) error {
+	var parentID, estimate, archivedAt string
+	if task.HasParent() {
+		parentID = task.ParentID.String()
+	}
+	if task.Estimate.IsSet() {
+		estimate = strconv.FormatFloat(task.Estimate.Float64(), 'f', -1, 64)
+	}
+	if task.IsArchived() {
+		archivedAt = service.FormatRFC3339Millis(task.ArchivedAt)
+	}
+	checklist := make([]string, 0, task.Checklist.Len())
+	for _, item := range task.Checklist.Items() {
+		checklist = append(checklist, checklistMark(item.Done)+" "+item.Text)
+	}
+
+	record := []string{
+		task.ID.String(),
+		e.columns[task.ColumnID],
+		e.lanes[task.LaneID],
+		strconv.FormatInt(task.Position.Int64(), 10),
+		task.Name.String(),
+		task.Description.String(),
+		parentID,
+		e.sprints[task.SprintID],
+		estimate,
+		strings.Join(checklist, "\n"),
+		archivedAt,
+		service.FormatRFC3339Millis(task.CreatedAt),
+		service.FormatRFC3339Millis(task.UpdatedAt),
+	}
+	for _, id := range e.fields {
+		var text string
+		if value, ok := task.CustomFields.Get(id); ok {
+			text = csvCustomFieldValue(value.JSON())
+		}
+		record = append(record, text)
+	}
+
+	return e.csv.Write(record)
+}
+
+func (e *csvBoardExportWriter) Link(*domain.TaskLink) error {
+	return nil
+}
+
+func (e *csvBoardExportWriter) End() error {
+	e.csv.Flush()
+	return e.csv.Error()
+}
+
+// csvCustomFieldValue writes text values as they are and any other value as JSON.
+func csvCustomFieldValue(raw json.RawMessage) string {
+	var text string
+	if json.Unmarshal(raw, &text) == nil {
+		return text
+	}
+	return string(raw)
+}
+
+func checklistMark(done bool) string {
+	if done {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`, `#`, `\#`,
+)
+
+// markdownBoardExportWriter writes a section per column with its tasks as a task list, checked in
+// done columns, under a subsection per lane. Descriptions are kept as they are, being markdown
+// themselves. Links are left out.
+type markdownBoardExportWriter struct {
+	exportResponse
+	columns []domain.Column
+	lanes   map[domain.LaneID]string
+	// next is the index of the first column whose section is not written yet.
+	next int
+	// tasks counts the tasks written in the current column section.
+	tasks int
+	lane  domain.LaneID
+}
+
+func (e *markdownBoardExportWriter) Begin(export *service.BoardExport) error {
+	e.columns = export.Columns
+	e.lanes = make(map[domain.LaneID]string, len(export.Lanes))
+	for _, lane := range export.Lanes {
+		e.lanes[lane.ID] = lane.Name.String()
+	}
+
+	var b strings.Builder
+	b.WriteString("# " + markdownEscaper.Replace(export.Board.Name.String()) + "\n")
+	if description := export.Board.Description.String(); description != "" {
+		b.WriteString("\n" + description + "\n")
+	}
+
+	e.start(export)
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+func (e *markdownBoardExportWriter) Task(task *domain.Task) error {
+	var b strings.Builder
+	column, ok := e.openColumn(&b, task.ColumnID)
+	if !ok {
+		// The column was created or moved after the export began.
+		return nil
+	}
+	if task.LaneID != e.lane {
+		if e.tasks > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("### " + markdownEscaper.Replace(e.lanes[task.LaneID]) + "\n\n")
+		e.lane = task.LaneID
+	}
+	e.tasks++
+
+	b.WriteString("- " + checklistMark(column.IsDone) + " **" + markdownEscaper.Replace(task.Name.String()) + "**")
+	if task.IsArchived() {
+		b.WriteString(" _(archived)_")
+	}
+	b.WriteString("\n")
+	if description := task.Description.String(); description != "" {
+		for line := range strings.SplitSeq(description, "\n") {
+			if line != "" {
+				line = "  " + line
+			}
+			b.WriteString(line + "\n")
+		}
+	}
+	for _, item := range task.Checklist.Items() {
+		b.WriteString("  - " + checklistMark(item.Done) + " " + markdownEscaper.Replace(item.Text) + "\n")
+	}
+
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+func (e *markdownBoardExportWriter) Link(*domain.TaskLink) error {
+	return nil
+}
+
+func (e *markdownBoardExportWriter) End() error {
+	var b strings.Builder
+	for e.next < len(e.columns) {
+		e.writeColumn(&b)
+	}
+	e.closeColumn(&b)
+	_, err := io.WriteString(e.w, b.String())
+	return err
+}
+
+// openColumn writes the sections up to the one of the column, unless it is the current one. Tasks
+// come ordered by column, so the sections passed over have no tasks. It reports false for columns
+// that are not among those left.
+func (e *markdownBoardExportWriter) openColumn(b *strings.Builder, columnID domain.ColumnID) (*domain.Column, bool) {
+	index := slices.IndexFunc(e.columns, func(column domain.Column) bool { return column.ID == columnID })
+	if index < 0 || index < e.next-1 {
+		return nil, false
+	}
+	for e.next <= index {
+		e.writeColumn(b)
+	}
+	return &e.columns[index], true
+}
+
+func (e *markdownBoardExportWriter) writeColumn(b *strings.Builder) {
+	e.closeColumn(b)
+	b.WriteString("\n## " + markdownEscaper.Replace(e.columns[e.next].Name.String()) + "\n\n")
+	e.next++
+	e.tasks = 0
+	e.lane = domain.LaneID{}
+}
+
+func (e *markdownBoardExportWriter) closeColumn(b *strings.Builder) {
+	if e.next > 0 && e.tasks == 0 {
+		b.WriteString("_No tasks_\n")
+	}
+}
+
+// Export godoc
+// @Summary Export a board
+// @Description Download the board with its columns, lanes, custom fields, sprints and every task, archived ones included, as an attachment.
+// @Description json is a versioned document that can be imported back, holding the links between tasks too. csv holds a row per task with a column per custom field. md is a readable outline with a section per column.
+// @Description Tasks are streamed as they are read, so errors after the first byte cut the body short instead of changing the status.
+// @Tags boards
+// @Produce json,text/csv,text/markdown
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param format query string false "Export format" Enums(json, csv, md) default(json)
+// @Success 200 {object} boardExportDocument
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/export [get]
+func (h *exports) Export(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format == "" {
+		format = "json"
+	}
+	writer, ok := newBoardExportWriter(w, format)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "format", Issues: []string{"Must be one of json, csv, md"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err = h.exportService.Export(r.Context(), userID, boardID, writer)
+	if err != nil {
+		switch {
+		case writer.hasStarted():
+			h.logger.ErrorContext(r.Context(), "Board export cut short", slog.String("err", err.Error()))
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+	}
+}
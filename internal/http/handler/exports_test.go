@@ -0,0 +1,407 @@
+package handler_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+// streamExport feeds a board to the writer the way the export service does.
+func streamExport(w service.BoardExportWriter, export *service.BoardExport, tasks []domain.Task, links []domain.TaskLink) error {
+	err := w.Begin(export)
+	if err != nil {
+		return err
+	}
+	for i := range tasks {
+		err = w.Task(&tasks[i])
+		if err != nil {
+			return err
+		}
+	}
+	for i := range links {
+		err = w.Link(&links[i])
+		if err != nil {
+			return err
+		}
+	}
+	return w.End()
+}
+
+func TestExports_Export(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todo := testutil.NewValidColumn(t, validBoard.ID, "To do", 1)
+	review := testutil.NewValidColumn(t, validBoard.ID, "Review", 2)
+	done := testutil.NewValidColumn(t, validBoard.ID, "Done", 3)
+	done.IsDone = true
+	lane := testutil.ValidLane(validBoard.ID)
+	field := testutil.ValidCustomField(validBoard.ID)
+	sprint := testutil.ValidSprint(validBoard.ID)
+	production, err := domain.NewCustomFieldValue(&field, json.RawMessage(`"production"`))
+	if err != nil {
+		t.Fatalf("NewCustomFieldValue() error = %v", err)
+	}
+
+	first := testutil.NewValidTask(t, todo.ID, "First", "", 1)
+	first.SprintID = sprint.ID
+	first.Estimate = testutil.NewValidTaskEstimate(t, 3)
+	first.Checklist = testutil.NewValidTaskChecklist(
+		t,
+		domain.TaskChecklistItem{Text: "Review", Done: true},
+		domain.TaskChecklistItem{Text: "Ship", Done: false},
+	)
+	first.CustomFields = first.CustomFields.With(field.ID, production)
+	second := testutil.NewValidTask(t, todo.ID, "Fix *login*", "Line one\nLine two", 1)
+	second.LaneID = lane.ID
+	second.ParentID = first.ID
+	third := testutil.NewValidTask(t, done.ID, "Deploy", "", 1)
+	third.ArchivedAt = testutil.Fixed5mFromNow()
+	link := domain.TaskLink{ID: domain.NewTaskLinkID(), SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkBlocks, CreatedAt: testutil.FixedNow()}
+
+	export := service.BoardExport{
+		Board:        validBoard,
+		Columns:      []domain.Column{todo, review, done},
+		Lanes:        []domain.Lane{lane},
+		CustomFields: []domain.CustomField{field},
+		Sprints:      []domain.Sprint{sprint},
+		ExportedAt:   testutil.FixedNow(),
+	}
+	tasks := []domain.Task{first, second, third}
+	links := []domain.TaskLink{link}
+	exportAll := func(t *testing.T, s *MockExportService) {
+		s.ExportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error {
+			if callerID != validBoard.OwnerID {
+				t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+			}
+			if boardID != validBoard.ID {
+				t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+			}
+			return streamExport(w, &export, tasks, links)
+		}
+	}
+
+	columnMap := func(column *domain.Column) map[string]any {
+		return map[string]any{
+			"id":                 column.ID.String(),
+			"boardId":            column.BoardID.String(),
+			"name":               column.Name.String(),
+			"description":        column.Description.String(),
+			"position":           column.Position.Int64(),
+			"wipLimit":           column.WIPLimit.Int64(),
+			"slaHours":           column.SLA.Hours(),
+			"archiveAfterDays":   column.ArchiveAfter.Days(),
+			"isStarted":          column.IsStarted,
+			"isDone":             column.IsDone,
+			"allowedTransitions": []any{},
+			"entryConditions":    []any{},
+			"entryRoles":         []any{},
+			"createdAt":          column.CreatedAt.Format(testutil.TimeFormat),
+			"updatedAt":          column.UpdatedAt.Format(testutil.TimeFormat),
+			"version":            column.Version.Int64(),
+		}
+	}
+	createdAt := testutil.FixedNowStr()
+
+	tests := []struct {
+		name               string
+		boardID            string
+		query              string
+		context            context.Context
+		setupExportService func(t *testing.T, s *MockExportService)
+		wantCode           int
+		wantContentType    string
+		wantDisposition    string
+		wantBody           any
+		wantText           string
+	}{
+		{
+			name:               "Success json",
+			boardID:            validBoard.ID.String(),
+			setupExportService: exportAll,
+			wantCode:           http.StatusOK,
+			wantContentType:    "application/json",
+			wantDisposition:    "attachment; filename=test-board-2026-01-01.json",
+			wantBody: map[string]any{
+				"format":     "goroutine.board",
+				"version":    1,
+				"exportedAt": createdAt,
+				"board": map[string]any{
+					"id":          validBoard.ID.String(),
+					"ownerId":     validBoard.OwnerID.String(),
+					"name":        validBoard.Name.String(),
+					"description": validBoard.Description.String(),
+					"createdAt":   createdAt,
+					"updatedAt":   createdAt,
+					"version":     validBoard.Version.Int64(),
+				},
+				"columns": []any{columnMap(&todo), columnMap(&review), columnMap(&done)},
+				"lanes": []any{map[string]any{
+					"id":        lane.ID.String(),
+					"boardId":   validBoard.ID.String(),
+					"name":      lane.Name.String(),
+					"position":  lane.Position.Int64(),
+					"createdAt": createdAt,
+					"updatedAt": createdAt,
+				}},
+				"customFields": []any{customFieldResponseMap(&field)},
+				"sprints":      []any{sprintResponseMap(&sprint)},
+				"tasks": []any{
+					map[string]any{
+						"id":           first.ID.String(),
+						"columnId":     todo.ID.String(),
+						"laneId":       nil,
+						"parentId":     nil,
+						"sprintId":     sprint.ID.String(),
+						"name":         "First",
+						"description":  "",
+						"position":     1,
+						"checklist":    []any{map[string]any{"text": "Review", "done": true}, map[string]any{"text": "Ship", "done": false}},
+						"estimate":     3,
+						"customFields": map[string]any{field.ID.String(): "production"},
+						"archivedAt":   nil,
+						"createdAt":    createdAt,
+						"updatedAt":    createdAt,
+					},
+					map[string]any{
+						"id":           second.ID.String(),
+						"columnId":     todo.ID.String(),
+						"laneId":       lane.ID.String(),
+						"parentId":     first.ID.String(),
+						"sprintId":     nil,
+						"name":         "Fix *login*",
+						"description":  "Line one\nLine two",
+						"position":     1,
+						"checklist":    []any{},
+						"estimate":     nil,
+						"customFields": map[string]any{},
+						"archivedAt":   nil,
+						"createdAt":    createdAt,
+						"updatedAt":    createdAt,
+					},
+					map[string]any{
+						"id":           third.ID.String(),
+						"columnId":     done.ID.String(),
+						"laneId":       nil,
+						"parentId":     nil,
+						"sprintId":     nil,
+						"name":         "Deploy",
+						"description":  "",
+						"position":     1,
+						"checklist":    []any{},
+						"estimate":     nil,
+						"customFields": map[string]any{},
+						"archivedAt":   third.ArchivedAt.Format(testutil.TimeFormat),
+						"createdAt":    createdAt,
+						"updatedAt":    createdAt,
+					},
+				},
+				"links": []any{map[string]any{
+					"id":        link.ID.String(),
+					"sourceId":  first.ID.String(),
+					"targetId":  second.ID.String(),
+					"type":      "blocks",
+					"createdAt": createdAt,
+				}},
+			},
+		},
+		{
+			name:    "Success json of an empty board",
+			boardID: validBoard.ID.String(),
+			query:   "?format=json",
+			setupExportService: func(t *testing.T, s *MockExportService) {
+				s.ExportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error {
+					return streamExport(w, &service.BoardExport{Board: validBoard, ExportedAt: testutil.FixedNow()}, nil, nil)
+				}
+			},
+			wantCode:        http.StatusOK,
+			wantContentType: "application/json",
+			wantDisposition: "attachment; filename=test-board-2026-01-01.json",
+			wantBody: map[string]any{
+				"format":     "goroutine.board",
+				"version":    1,
+				"exportedAt": createdAt,
+				"board": map[string]any{
+					"id":          validBoard.ID.String(),
+					"ownerId":     validBoard.OwnerID.String(),
+					"name":        validBoard.Name.String(),
+					"description": validBoard.Description.String(),
+					"createdAt":   createdAt,
+					"updatedAt":   createdAt,
+					"version":     validBoard.Version.Int64(),
+				},
+				"columns":      []any{},
+				"lanes":        []any{},
+				"customFields": []any{},
+				"sprints":      []any{},
+				"tasks":        []any{},
+				"links":        []any{},
+			},
+		},
+		{
+			name:               "Success csv",
+			boardID:            validBoard.ID.String(),
+			query:              "?format=csv",
+			setupExportService: exportAll,
+			wantCode:           http.StatusOK,
+			wantContentType:    "text/csv",
+			wantDisposition:    "attachment; filename=test-board-2026-01-01.csv",
+			wantText: "id,column,lane,position,name,description,parent_id,sprint,estimate,checklist,archived_at,created_at,updated_at,Environment\n" +
+				first.ID.String() + ",To do,,1,First,,,Sprint 14,3,\"[x] Review\n[ ] Ship\",," + createdAt + "," + createdAt + ",production\n" +
+				second.ID.String() + ",To do,Platform team,1,Fix *login*,\"Line one\nLine two\"," + first.ID.String() + ",,,,," + createdAt + "," + createdAt + ",\n" +
+				third.ID.String() + ",Done,,1,Deploy,,,,,," + third.ArchivedAt.Format(testutil.TimeFormat) + "," + createdAt + "," + createdAt + ",\n",
+		},
+		{
+			name:               "Success md",
+			boardID:            validBoard.ID.String(),
+			query:              "?format=md",
+			setupExportService: exportAll,
+			wantCode:           http.StatusOK,
+			wantContentType:    "text/markdown",
+			wantDisposition:    "attachment; filename=test-board-2026-01-01.md",
+			wantText: "# Test Board\n\nTest Board Description\n" +
+				"\n## To do\n\n" +
+				"- [ ] **First**\n  - [x] Review\n  - [ ] Ship\n" +
+				"\n### Platform team\n\n" +
+				"- [ ] **Fix \\*login\\***\n  Line one\n  Line two\n" +
+				"\n## Review\n\n_No tasks_\n" +
+				"\n## Done\n\n" +
+				"- [x] **Deploy** _(archived)_\n",
+		},
+		{
+			name:    "Success md of a board with empty columns",
+			boardID: validBoard.ID.String(),
+			query:   "?format=md",
+			setupExportService: func(t *testing.T, s *MockExportService) {
+				s.ExportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error {
+					emptyExport := export
+					return streamExport(w, &emptyExport, nil, nil)
+				}
+			},
+			wantCode:        http.StatusOK,
+			wantContentType: "text/markdown",
+			wantDisposition: "attachment; filename=test-board-2026-01-01.md",
+			wantText: "# Test Board\n\nTest Board Description\n" +
+				"\n## To do\n\n_No tasks_\n" +
+				"\n## Review\n\n_No tasks_\n" +
+				"\n## Done\n\n_No tasks_\n",
+		},
+		{
+			name:    "Error after the first byte cuts the body short",
+			boardID: validBoard.ID.String(),
+			query:   "?format=md",
+			setupExportService: func(t *testing.T, s *MockExportService) {
+				s.ExportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error {
+					err := w.Begin(&export)
+					if err != nil {
+						return err
+					}
+					err = w.Task(&first)
+					if err != nil {
+						return err
+					}
+					return errors.New("db exploded")
+				}
+			},
+			wantCode:        http.StatusOK,
+			wantContentType: "text/markdown",
+			wantDisposition: "attachment; filename=test-board-2026-01-01.md",
+			wantText: "# Test Board\n\nTest Board Description\n" +
+				"\n## To do\n\n" +
+				"- [ ] **First**\n  - [x] Review\n  - [ ] Ship\n",
+		},
+		{
+			name:            "Invalid board id",
+			boardID:         "nope",
+			wantCode:        http.StatusBadRequest,
+			wantContentType: "application/json",
+			wantBody:        validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:            "Invalid format",
+			boardID:         validBoard.ID.String(),
+			query:           "?format=xlsx",
+			wantCode:        http.StatusBadRequest,
+			wantContentType: "application/json",
+			wantBody:        validationError("format", []string{"Must be one of json, csv, md"}),
+		},
+		{
+			name:    "Board not found",
+			boardID: validBoard.ID.String(),
+			setupExportService: func(t *testing.T, s *MockExportService) {
+				s.ExportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error {
+					return service.ErrBoardNotFound
+				}
+			},
+			wantCode:        http.StatusNotFound,
+			wantContentType: "application/json",
+			wantBody:        boardNotFoundError(),
+		},
+		{
+			name:            "Missing context user",
+			boardID:         validBoard.ID.String(),
+			context:         context.Background(),
+			wantCode:        http.StatusUnauthorized,
+			wantContentType: "application/json",
+			wantBody:        unauthorizedTokenError(),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			query:   "?format=csv",
+			setupExportService: func(t *testing.T, s *MockExportService) {
+				s.ExportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error {
+					return errors.New("db exploded")
+				}
+			},
+			wantCode:        http.StatusInternalServerError,
+			wantContentType: "application/json",
+			wantBody:        internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+tt.boardID+"/export"+tt.query, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+			rr := httptest.NewRecorder()
+			mockExport := NewMockExportService(t)
+			if tt.setupExportService != nil {
+				tt.setupExportService(t, mockExport)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewExports(logger, mockExport, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Export(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, tt.wantContentType)
+			if got := rr.Header().Get("Content-Disposition"); got != tt.wantDisposition {
+				t.Errorf("got content disposition %q, want %q", got, tt.wantDisposition)
+			}
+			if tt.wantText != "" {
+				if got := rr.Body.String(); got != tt.wantText {
+					t.Errorf("got body %q, want %q", got, tt.wantText)
+				}
+				return
+			}
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
@@ -5,25 +5,47 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/http/httpschema"
 )
 
 type Handlers struct {
-	Auth     *auth
-	Health   *health
-	Boards   *boards
-	Columns  *columns
-	Tasks    *tasks
-	User     *user
-	Telegram *telegram
+	Auth           *auth
+	Health         *health
+	Boards         *boards
+	Columns        *columns
+	Lanes          *lanes
+	Tasks          *tasks
+	User           *user
+	Telegram       *telegram
+	BoardTemplates *boardTemplates
+	TaskTemplates  *taskTemplates
+	Recurrences    *recurrences
+	Sprints        *sprints
+	TaskLinks      *taskLinks
+	Analytics      *analytics
+	Stats          *stats
+	CustomFields   *customFields
+	Automations    *automations
+	Exports        *exports
+	BoardImports   *boardImports
+	BoardEvents    *boardEvents
+	Sync           *deltaSync
 }
 
 var errBodyTooLarge = errors.New("request body too large")
 
 func decodeJSONLimited(r *http.Request, v any) error {
 	const maxBodySize = 20 * 1024 // 20KB is the absolute max for API
+	return decodeJSONWithLimit(r, v, maxBodySize)
+}
+
+// decodeJSONWithLimit is decodeJSONLimited for the few endpoints that take files, with a limit
+// of their own.
+func decodeJSONWithLimit(r *http.Request, v any, maxBodySize int64) error {
 	err := json.NewDecoder(http.MaxBytesReader(nil, r.Body, maxBodySize)).Decode(v)
 	if err != nil {
 		var maxBytesErr *http.MaxBytesError
@@ -49,3 +71,33 @@ func extractUserIDOrHandleMissing(w http.ResponseWriter,
 
 	return userID, true
 }
+
+// parseIfMatch reads the version a write is conditioned on from the If-Match header. A missing
+// header and * match any version, which is the zero version. Otherwise the header must be a
+// single ETag as setETag writes it, a quoted version; weak ETags never match a write.
+func parseIfMatch(r *http.Request) (domain.Version, bool) {
+	header := strings.TrimSpace(r.Header.Get("If-Match"))
+	if header == "" || header == "*" {
+		return domain.Version{}, true
+	}
+
+	unquoted, err := strconv.Unquote(header)
+	if err != nil || !strings.HasPrefix(header, `"`) {
+		return domain.Version{}, false
+	}
+	raw, err := strconv.ParseInt(unquoted, 10, 64)
+	if err != nil {
+		return domain.Version{}, false
+	}
+	version, err := domain.NewVersion(raw)
+	if err != nil {
+		return domain.Version{}, false
+	}
+
+	return version, true
+}
+
+// setETag tags the response with the version of the board, column or task it returns.
+func setETag(w http.ResponseWriter, version domain.Version) {
+	w.Header().Set("ETag", `"`+strconv.FormatInt(version.Int64(), 10)+`"`)
+}
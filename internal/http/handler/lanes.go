@@ -0,0 +1,371 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type lanesService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error)
+	ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Lane, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error)
+	Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, targetPosition domain.LanePosition) (domain.LanePosition, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID) error
+}
+
+type lanes struct {
+	logger       *slog.Logger
+	lanesService lanesService
+	responder    *httpschema.ErrorResponder
+}
+
+func NewLanes(logger *slog.Logger, lanesService lanesService, responder *httpschema.ErrorResponder) *lanes {
+	moduleLogger := logging.WithModule(logger, "handler.lanes")
+
+	return &lanes{logger: moduleLogger, lanesService: lanesService, responder: responder}
+}
+
+type createLaneBody struct {
+	Name string `json:"name" example:"Platform team"`
+}
+
+type updateLaneBody struct {
+	Name *string `json:"name" example:"Platform team"`
+}
+
+type moveLaneBody struct {
+	TargetPosition int64 `json:"targetPosition" example:"1"`
+}
+
+type laneResponse struct {
+	ID        string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+	BoardID   string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	Name      string `json:"name" example:"Platform team"`
+	Position  int64  `json:"position" example:"1"`
+	CreatedAt string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt string `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+type lanePositionResponse struct {
+	Position int64 `json:"position" example:"2"`
+}
+
+func newLaneResponse(lane *domain.Lane) laneResponse {
+	return laneResponse{
+		ID:        lane.ID.String(),
+		BoardID:   lane.BoardID.String(),
+		Name:      lane.Name.String(),
+		Position:  lane.Position.Int64(),
+		CreatedAt: service.FormatRFC3339Millis(lane.CreatedAt),
+		UpdatedAt: service.FormatRFC3339Millis(lane.UpdatedAt),
+	}
+}
+
+// Create godoc
+// @Summary Create a new swimlane
+// @Description Create a new swimlane in board for the current user. Lane is appended to the end, below the default lane and all existing lanes.
+// @Tags lanes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createLaneBody true "Lane details"
+// @Success 201 {object} laneResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/lanes [post]
+func (h *lanes) Create(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	var body createLaneBody
+	err = decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	name := httpschema.ValidateField("name", body.Name, domain.NewLaneName, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	lane, err := h.lanesService.Create(r.Context(), userID, boardID, name)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newLaneResponse(&lane))
+}
+
+// List godoc
+// @Summary List all swimlanes in a board
+// @Description Get all swimlanes belonging to the specified board. Results are returned in increasing position order. The default lane is implicit and not listed.
+// @Tags lanes
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {array} laneResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/lanes [get]
+func (h *lanes) ListByBoardID(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	lanes, err := h.lanesService.ListByBoardID(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make([]laneResponse, 0, len(lanes))
+	for i := range lanes {
+		response = append(response, newLaneResponse(&lanes[i]))
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// Update godoc
+// @Summary Rename a swimlane by id
+// @Description Partially update swimlane metadata for the current user. Provided fields are updated; omitted or null fields are ignored.
+// @Tags lanes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param laneId path string true "Lane ID"
+// @Param body body updateLaneBody true "Lane fields to update"
+// @Success 200 {object} laneResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "LANE_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/lanes/{laneId} [patch]
+func (h *lanes) Update(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	rawLaneID := r.PathValue("laneId")
+	laneID, err := domain.ParseLaneID(rawLaneID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "laneId", Issues: []string{"Invalid lane id"}}})
+		return
+	}
+
+	var body updateLaneBody
+	err = decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	var name *domain.LaneName
+	if body.Name != nil {
+		value := httpschema.ValidateField("name", *body.Name, domain.NewLaneName, &details)
+		name = &value
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	lane, err := h.lanesService.Update(r.Context(), userID, boardID, laneID, name)
+	if err != nil {
+		if errors.Is(err, service.ErrLaneNotFound) {
+			h.responder.LaneNotFound(w, []httpschema.Detail{{Field: "laneId", Issues: []string{"Lane not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newLaneResponse(&lane))
+}
+
+// Move godoc
+// @Summary Move a swimlane to a new position
+// @Description Move a swimlane within a board for the current user and shift neighboring lanes accordingly. The default lane always stays on top.
+// @Tags lanes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param laneId path string true "Lane ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body moveLaneBody true "Target position"
+// @Success 200 {object} lanePositionResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "LANE_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/lanes/{laneId}/position [put]
+func (h *lanes) Move(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	rawLaneID := r.PathValue("laneId")
+	laneID, err := domain.ParseLaneID(rawLaneID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "laneId", Issues: []string{"Invalid lane id"}}})
+		return
+	}
+
+	var body moveLaneBody
+	err = decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	targetPosition := httpschema.ValidateField("targetPosition", body.TargetPosition, domain.NewLanePosition, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	position, err := h.lanesService.Move(r.Context(), userID, boardID, laneID, targetPosition)
+	if err != nil {
+		if errors.Is(err, service.ErrLaneNotFound) {
+			h.responder.LaneNotFound(w, []httpschema.Detail{{Field: "laneId", Issues: []string{"Lane not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrIndexOutOfBounds) {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "targetPosition", Issues: []string{"Index out of bounds"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, lanePositionResponse{Position: position.Int64()})
+}
+
+// Delete godoc
+// @Summary Delete a swimlane by id
+// @Description Permanently delete a swimlane from board for the current user and shift positions to close the gap. Tasks of the lane are appended to the default lane of their columns, keeping their order.
+// @Tags lanes
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param laneId path string true "Lane ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "LANE_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/lanes/{laneId} [delete]
+func (h *lanes) Delete(w http.ResponseWriter, r *http.Request) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	rawLaneID := r.PathValue("laneId")
+	laneID, err := domain.ParseLaneID(rawLaneID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "laneId", Issues: []string{"Invalid lane id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err = h.lanesService.Delete(r.Context(), userID, boardID, laneID)
+	if err != nil {
+		if errors.Is(err, service.ErrLaneNotFound) {
+			h.responder.LaneNotFound(w, []httpschema.Detail{{Field: "laneId", Issues: []string{"Lane not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,524 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+type lanesTestCase struct {
+	name             string
+	boardID          string
+	laneID           string
+	inputBody        any
+	context          context.Context
+	setupLaneService func(t *testing.T, s *MockLaneService)
+	wantCode         int
+	wantBody         any
+}
+
+func newLanesRequest(t *testing.T, tt *lanesTestCase, method, path string, ownerID domain.UserID) *http.Request {
+	t.Helper()
+
+	var req *http.Request
+	switch body := tt.inputBody.(type) {
+	case nil:
+		req = httptest.NewRequest(method, path, http.NoBody)
+	case string:
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	default:
+		req, _ = testutil.NewJSONRequestAndRecorder(t, method, path, body)
+	}
+
+	ctx := tt.context
+	if ctx == nil {
+		ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, ownerID)
+	}
+	req = req.WithContext(ctx)
+	req.SetPathValue("boardId", tt.boardID)
+	req.SetPathValue("laneId", tt.laneID)
+
+	return req
+}
+
+func laneResponseMap(lane *domain.Lane) map[string]any {
+	return map[string]any{
+		"id":        lane.ID.String(),
+		"boardId":   lane.BoardID.String(),
+		"name":      lane.Name.String(),
+		"position":  lane.Position.Int64(),
+		"createdAt": lane.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt": lane.UpdatedAt.Format(testutil.TimeFormat),
+	}
+}
+
+func TestLanes_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+
+	tests := []lanesTestCase{
+		{
+			name:      "Success",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": validLane.Name.String()},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if name != validLane.Name {
+						t.Errorf("got name %v, want %v", name, validLane.Name)
+					}
+					return validLane, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: laneResponseMap(&validLane),
+		},
+		{
+			name:      "Invalid board id",
+			boardID:   "not-a-uuid",
+			inputBody: map[string]string{"name": "Platform team"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:      "Invalid JSON",
+			boardID:   validBoard.ID.String(),
+			inputBody: "{\"name\":\"broken\"",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Invalid name",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "   "},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("name", []string{"Name is too short"}),
+		},
+		{
+			name:      "Missing context user",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Platform team"},
+			context:   context.Background(),
+			wantCode:  http.StatusUnauthorized,
+			wantBody:  unauthorizedTokenError(),
+		},
+		{
+			name:      "Board not found",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Platform team"},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+					return domain.Lane{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:      "Internal error",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": "Platform team"},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+					return domain.Lane{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:      "Body too large",
+			boardID:   validBoard.ID.String(),
+			inputBody: testutil.Valid25KBJSON(),
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newLanesRequest(t, &tt, http.MethodPost, "/v1/boards/"+tt.boardID+"/lanes", validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockLanes := NewMockLaneService(t)
+			if tt.setupLaneService != nil {
+				tt.setupLaneService(t, mockLanes)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewLanes(logger, mockLanes, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestLanes_ListByBoardID(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	firstLane := testutil.ValidLane(validBoard.ID)
+	secondLane := testutil.NewValidLane(t, validBoard.ID, "Support", 2)
+
+	tests := []lanesTestCase{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Lane, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					return []domain.Lane{firstLane, secondLane}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []map[string]any{laneResponseMap(&firstLane), laneResponseMap(&secondLane)},
+		},
+		{
+			name:    "Empty",
+			boardID: validBoard.ID.String(),
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Lane, error) {
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []map[string]any{},
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:     "Missing context user",
+			boardID:  validBoard.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:    "Board not found",
+			boardID: validBoard.ID.String(),
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Lane, error) {
+					return nil, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newLanesRequest(t, &tt, http.MethodGet, "/v1/boards/"+tt.boardID+"/lanes", validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockLanes := NewMockLaneService(t)
+			if tt.setupLaneService != nil {
+				tt.setupLaneService(t, mockLanes)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewLanes(logger, mockLanes, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.ListByBoardID(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestLanes_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+
+	tests := []lanesTestCase{
+		{
+			name:      "Success",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]string{"name": validLane.Name.String()},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error) {
+					if laneID != validLane.ID {
+						t.Errorf("got lane id %v, want %v", laneID, validLane.ID)
+					}
+					if name == nil || *name != validLane.Name {
+						t.Errorf("got name %v, want %v", name, validLane.Name)
+					}
+					return validLane, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: laneResponseMap(&validLane),
+		},
+		{
+			name:      "Null name is ignored",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]any{"name": nil},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error) {
+					if name != nil {
+						t.Errorf("got name %v, want nil", *name)
+					}
+					return validLane, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: laneResponseMap(&validLane),
+		},
+		{
+			name:      "Invalid lane id",
+			boardID:   validBoard.ID.String(),
+			laneID:    "not-a-uuid",
+			inputBody: map[string]string{"name": "Support"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("laneId", []string{"Invalid lane id"}),
+		},
+		{
+			name:      "Name too long",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]string{"name": strings.Repeat("a", 129)},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("name", []string{"Name is too long"}),
+		},
+		{
+			name:      "Lane not found",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]string{"name": "Support"},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error) {
+					return domain.Lane{}, service.ErrLaneNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: laneNotFoundError("laneId"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/lanes/" + tt.laneID
+			req := newLanesRequest(t, &tt, http.MethodPatch, path, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockLanes := NewMockLaneService(t)
+			if tt.setupLaneService != nil {
+				tt.setupLaneService(t, mockLanes)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewLanes(logger, mockLanes, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Update(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestLanes_Move(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+	targetPosition, err := domain.NewLanePosition(2)
+	if err != nil {
+		t.Fatalf("NewLanePosition() error = %v", err)
+	}
+
+	tests := []lanesTestCase{
+		{
+			name:      "Success",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]int64{"targetPosition": targetPosition.Int64()},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, gotTargetPosition domain.LanePosition) (domain.LanePosition, error) {
+					if laneID != validLane.ID {
+						t.Errorf("got lane id %v, want %v", laneID, validLane.ID)
+					}
+					if gotTargetPosition != targetPosition {
+						t.Errorf("got target position %v, want %v", gotTargetPosition, targetPosition)
+					}
+					return targetPosition, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{"position": targetPosition.Int64()},
+		},
+		{
+			name:      "Invalid target position",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]int64{"targetPosition": 0},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("targetPosition", []string{"Position is invalid"}),
+		},
+		{
+			name:      "Index out of bounds",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]int64{"targetPosition": 10},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, gotTargetPosition domain.LanePosition) (domain.LanePosition, error) {
+					return domain.LanePosition{}, service.ErrIndexOutOfBounds
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("targetPosition", []string{"Index out of bounds"}),
+		},
+		{
+			name:      "Lane not found",
+			boardID:   validBoard.ID.String(),
+			laneID:    validLane.ID.String(),
+			inputBody: map[string]int64{"targetPosition": targetPosition.Int64()},
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, gotTargetPosition domain.LanePosition) (domain.LanePosition, error) {
+					return domain.LanePosition{}, service.ErrLaneNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: laneNotFoundError("laneId"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/lanes/" + tt.laneID + "/position"
+			req := newLanesRequest(t, &tt, http.MethodPut, path, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockLanes := NewMockLaneService(t)
+			if tt.setupLaneService != nil {
+				tt.setupLaneService(t, mockLanes)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewLanes(logger, mockLanes, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Move(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestLanes_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+
+	tests := []lanesTestCase{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			laneID:  validLane.ID.String(),
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID) error {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if laneID != validLane.ID {
+						t.Errorf("got lane id %v, want %v", laneID, validLane.ID)
+					}
+					return nil
+				}
+			},
+			wantCode: http.StatusNoContent,
+			wantBody: nil,
+		},
+		{
+			name:     "Invalid lane id",
+			boardID:  validBoard.ID.String(),
+			laneID:   "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("laneId", []string{"Invalid lane id"}),
+		},
+		{
+			name:    "Lane not found",
+			boardID: validBoard.ID.String(),
+			laneID:  validLane.ID.String(),
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID) error {
+					return service.ErrLaneNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: laneNotFoundError("laneId"),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			laneID:  validLane.ID.String(),
+			setupLaneService: func(t *testing.T, s *MockLaneService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID) error {
+					return service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/lanes/" + tt.laneID
+			req := newLanesRequest(t, &tt, http.MethodDelete, path, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockLanes := NewMockLaneService(t)
+			if tt.setupLaneService != nil {
+				tt.setupLaneService(t, mockLanes)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewLanes(logger, mockLanes, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Delete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			if tt.wantCode != http.StatusNoContent {
+				testutil.AssertContentType(t, rr, "application/json")
+			}
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
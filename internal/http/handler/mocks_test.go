@@ -2,7 +2,9 @@ package handler_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/service"
@@ -23,12 +25,14 @@ func NewMockAuthService(t *testing.T) *MockAuthService {
 type MockBoardService struct {
 	t *testing.T
 
-	CreateFunc        func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
-	GetFunc           func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error)
-	GetAggregateFunc  func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (service.AggregateBoard, error)
-	ListByOwnerIDFunc func(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error)
-	UpdateFunc        func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error)
-	DeleteFunc        func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) error
+	CreateFunc             func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
+	CreateFromTemplateFunc func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, templateID domain.BoardTemplateID) (domain.Board, error)
+	GetFunc                func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error)
+	GetAggregateFunc       func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error)
+	ListByOwnerIDFunc      func(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error)
+	UpdateFunc             func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error)
+	DeleteFunc             func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error
+	DuplicateFunc          func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error)
 }
 
 func NewMockBoardService(t *testing.T) *MockBoardService {
@@ -40,23 +44,44 @@ type MockColumnService struct {
 
 	CreateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error)
 	ListByBoardIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Column, error)
-	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error)
-	MoveFunc          func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error)
-	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) error
+	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error)
+	MoveFunc          func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error)
+	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error
+	DuplicateFunc     func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error)
 }
 
 func NewMockColumnService(t *testing.T) *MockColumnService {
 	return &MockColumnService{t: t}
 }
 
+type MockLaneService struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error)
+	ListByBoardIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Lane, error)
+	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error)
+	MoveFunc          func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, targetPosition domain.LanePosition) (domain.LanePosition, error)
+	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID) error
+}
+
+func NewMockLaneService(t *testing.T) *MockLaneService {
+	return &MockLaneService{t: t}
+}
+
 type MockTaskService struct {
 	t *testing.T
 
-	CreateFunc         func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error)
-	ListByColumnIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) ([]domain.Task, error)
-	UpdateFunc         func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error)
-	MoveFunc           func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error)
-	DeleteFunc         func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error
+	CreateFunc             func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error)
+	CreateFromTemplateFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, templateID domain.TaskTemplateID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error)
+	ListByColumnIDFunc     func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error)
+	ListChildrenFunc       func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) ([]domain.Task, error)
+	SetParentFunc          func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, parentID domain.TaskID) (domain.Task, error)
+	UpdateFunc             func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error)
+	MoveFunc               func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error)
+	DeleteFunc             func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error
+	DuplicateFunc          func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	RestoreFunc            func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	BatchFunc              func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, operations []domain.TaskBatchOperation) ([]domain.TaskBatchResult, error)
 }
 
 func NewMockTaskService(t *testing.T) *MockTaskService {
@@ -99,14 +124,19 @@ func (m *MockBoardService) Create(ctx context.Context, ownerID domain.UserID, na
 	return m.CreateFunc(ctx, ownerID, name, description)
 }
 
+func (m *MockBoardService) CreateFromTemplate(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, templateID domain.BoardTemplateID) (domain.Board, error) {
+	testutil.AssertFuncNotNil(m.t, "boardsService.CreateFromTemplateFunc", m.CreateFromTemplateFunc)
+	return m.CreateFromTemplateFunc(ctx, ownerID, name, description, templateID)
+}
+
 func (m *MockBoardService) Get(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error) {
 	testutil.AssertFuncNotNil(m.t, "boardsService.GetFunc", m.GetFunc)
 	return m.GetFunc(ctx, ownerID, boardID)
 }
 
-func (m *MockBoardService) GetAggregate(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (service.AggregateBoard, error) {
+func (m *MockBoardService) GetAggregate(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, includeArchived bool) (service.AggregateBoard, error) {
 	testutil.AssertFuncNotNil(m.t, "boardsService.GetAggregateFunc", m.GetAggregateFunc)
-	return m.GetAggregateFunc(ctx, ownerID, boardID)
+	return m.GetAggregateFunc(ctx, ownerID, boardID, includeArchived)
 }
 
 func (m *MockBoardService) ListByOwnerID(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error) {
@@ -114,14 +144,19 @@ func (m *MockBoardService) ListByOwnerID(ctx context.Context, ownerID domain.Use
 	return m.ListByOwnerIDFunc(ctx, ownerID)
 }
 
-func (m *MockBoardService) Update(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+func (m *MockBoardService) Update(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
 	testutil.AssertFuncNotNil(m.t, "boardsService.UpdateFunc", m.UpdateFunc)
-	return m.UpdateFunc(ctx, ownerID, boardID, name, description)
+	return m.UpdateFunc(ctx, ownerID, boardID, name, description, ifMatch)
 }
 
-func (m *MockBoardService) Delete(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) error {
+func (m *MockBoardService) Delete(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
 	testutil.AssertFuncNotNil(m.t, "boardsService.DeleteFunc", m.DeleteFunc)
-	return m.DeleteFunc(ctx, ownerID, boardID)
+	return m.DeleteFunc(ctx, ownerID, boardID, ifMatch)
+}
+
+func (m *MockBoardService) Duplicate(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID) (domain.Board, error) {
+	testutil.AssertFuncNotNil(m.t, "boardsService.DuplicateFunc", m.DuplicateFunc)
+	return m.DuplicateFunc(ctx, ownerID, boardID)
 }
 
 func (m *MockColumnService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error) {
@@ -134,44 +169,104 @@ func (m *MockColumnService) ListByBoardID(ctx context.Context, callerID domain.U
 	return m.ListByBoardIDFunc(ctx, callerID, boardID)
 }
 
-func (m *MockColumnService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+func (m *MockColumnService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
 	testutil.AssertFuncNotNil(m.t, "columnsService.UpdateFunc", m.UpdateFunc)
-	return m.UpdateFunc(ctx, callerID, boardID, columnID, name, description)
+	return m.UpdateFunc(ctx, callerID, boardID, columnID, patch, ifMatch)
 }
 
-func (m *MockColumnService) Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
+func (m *MockColumnService) Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
 	testutil.AssertFuncNotNil(m.t, "columnsService.MoveFunc", m.MoveFunc)
-	return m.MoveFunc(ctx, callerID, boardID, columnID, targetPosition)
+	return m.MoveFunc(ctx, callerID, boardID, columnID, targetPosition, ifMatch)
 }
 
-func (m *MockColumnService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) error {
+func (m *MockColumnService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error {
 	testutil.AssertFuncNotNil(m.t, "columnsService.DeleteFunc", m.DeleteFunc)
-	return m.DeleteFunc(ctx, callerID, boardID, columnID)
+	return m.DeleteFunc(ctx, callerID, boardID, columnID, ifMatch)
+}
+
+func (m *MockColumnService) Duplicate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+	testutil.AssertFuncNotNil(m.t, "columnsService.DuplicateFunc", m.DuplicateFunc)
+	return m.DuplicateFunc(ctx, callerID, boardID, columnID)
+}
+
+func (m *MockLaneService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+	testutil.AssertFuncNotNil(m.t, "lanesService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, name)
+}
+
+func (m *MockLaneService) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Lane, error) {
+	testutil.AssertFuncNotNil(m.t, "lanesService.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, callerID, boardID)
 }
 
-func (m *MockTaskService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+func (m *MockLaneService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error) {
+	testutil.AssertFuncNotNil(m.t, "lanesService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, laneID, name)
+}
+
+func (m *MockLaneService) Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID, targetPosition domain.LanePosition) (domain.LanePosition, error) {
+	testutil.AssertFuncNotNil(m.t, "lanesService.MoveFunc", m.MoveFunc)
+	return m.MoveFunc(ctx, callerID, boardID, laneID, targetPosition)
+}
+
+func (m *MockLaneService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, laneID domain.LaneID) error {
+	testutil.AssertFuncNotNil(m.t, "lanesService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, laneID)
+}
+
+func (m *MockTaskService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
 	testutil.AssertFuncNotNil(m.t, "tasksService.CreateFunc", m.CreateFunc)
-	return m.CreateFunc(ctx, callerID, boardID, columnID, name, description)
+	return m.CreateFunc(ctx, callerID, boardID, columnID, laneID, parentID, name, description, checklist, estimate, customFields)
 }
 
-func (m *MockTaskService) ListByColumnID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) ([]domain.Task, error) {
+func (m *MockTaskService) CreateFromTemplate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, templateID domain.TaskTemplateID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "tasksService.CreateFromTemplateFunc", m.CreateFromTemplateFunc)
+	return m.CreateFromTemplateFunc(ctx, callerID, boardID, columnID, laneID, parentID, templateID, name, description, checklist, estimate, customFields)
+}
+
+func (m *MockTaskService) ListByColumnID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error) {
 	testutil.AssertFuncNotNil(m.t, "tasksService.ListByColumnIDFunc", m.ListByColumnIDFunc)
-	return m.ListByColumnIDFunc(ctx, callerID, boardID, columnID)
+	return m.ListByColumnIDFunc(ctx, callerID, boardID, columnID, filters, includeArchived)
 }
 
-func (m *MockTaskService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+func (m *MockTaskService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
 	testutil.AssertFuncNotNil(m.t, "tasksService.UpdateFunc", m.UpdateFunc)
-	return m.UpdateFunc(ctx, callerID, boardID, columnID, taskID, name, description)
+	return m.UpdateFunc(ctx, callerID, boardID, columnID, taskID, name, description, checklist, estimate, customFields, ifMatch)
 }
 
-func (m *MockTaskService) Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
+func (m *MockTaskService) Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
 	testutil.AssertFuncNotNil(m.t, "tasksService.MoveFunc", m.MoveFunc)
-	return m.MoveFunc(ctx, callerID, boardID, columnID, taskID, targetColumnID, targetPosition)
+	return m.MoveFunc(ctx, callerID, boardID, columnID, taskID, targetColumnID, targetLaneID, targetPosition, ifMatch)
+}
+
+func (m *MockTaskService) ListChildren(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) ([]domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "tasksService.ListChildrenFunc", m.ListChildrenFunc)
+	return m.ListChildrenFunc(ctx, callerID, boardID, columnID, taskID)
+}
+
+func (m *MockTaskService) SetParent(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, parentID domain.TaskID) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "tasksService.SetParentFunc", m.SetParentFunc)
+	return m.SetParentFunc(ctx, callerID, boardID, columnID, taskID, parentID)
 }
 
-func (m *MockTaskService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+func (m *MockTaskService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error {
 	testutil.AssertFuncNotNil(m.t, "tasksService.DeleteFunc", m.DeleteFunc)
-	return m.DeleteFunc(ctx, callerID, boardID, columnID, taskID)
+	return m.DeleteFunc(ctx, callerID, boardID, columnID, taskID, cascade, ifMatch)
+}
+
+func (m *MockTaskService) Duplicate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "tasksService.DuplicateFunc", m.DuplicateFunc)
+	return m.DuplicateFunc(ctx, callerID, boardID, columnID, taskID)
+}
+
+func (m *MockTaskService) Restore(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "tasksService.RestoreFunc", m.RestoreFunc)
+	return m.RestoreFunc(ctx, callerID, boardID, columnID, taskID)
+}
+
+func (m *MockTaskService) Batch(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, operations []domain.TaskBatchOperation) ([]domain.TaskBatchResult, error) {
+	testutil.AssertFuncNotNil(m.t, "tasksService.BatchFunc", m.BatchFunc)
+	return m.BatchFunc(ctx, callerID, boardID, operations)
 }
 
 type MockNotifier struct {
@@ -188,3 +283,423 @@ func (m *MockNotifier) Notify(ctx context.Context, chatID domain.TelegramChatID,
 	testutil.AssertFuncNotNil(m.t, "notifier.NotifyFunc", m.NotifyFunc)
 	return m.NotifyFunc(ctx, chatID, text)
 }
+
+type MockBoardTemplateService struct {
+	t *testing.T
+
+	SaveFromBoardFunc func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error)
+	ListAvailableFunc func(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error)
+	DeleteFunc        func(ctx context.Context, ownerID domain.UserID, templateID domain.BoardTemplateID) error
+}
+
+func NewMockBoardTemplateService(t *testing.T) *MockBoardTemplateService {
+	return &MockBoardTemplateService{t: t}
+}
+
+func (m *MockBoardTemplateService) SaveFromBoard(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "boardTemplatesService.SaveFromBoardFunc", m.SaveFromBoardFunc)
+	return m.SaveFromBoardFunc(ctx, ownerID, boardID, name, description, includeTasks)
+}
+
+func (m *MockBoardTemplateService) ListAvailable(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "boardTemplatesService.ListAvailableFunc", m.ListAvailableFunc)
+	return m.ListAvailableFunc(ctx, ownerID)
+}
+
+func (m *MockBoardTemplateService) Delete(ctx context.Context, ownerID domain.UserID, templateID domain.BoardTemplateID) error {
+	testutil.AssertFuncNotNil(m.t, "boardTemplatesService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, ownerID, templateID)
+}
+
+type MockTaskTemplateService struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error)
+	ListByBoardIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error)
+	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error)
+	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID) error
+}
+
+func NewMockTaskTemplateService(t *testing.T) *MockTaskTemplateService {
+	return &MockTaskTemplateService{t: t}
+}
+
+func (m *MockTaskTemplateService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "taskTemplatesService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, namePattern, descriptionPattern, checklist)
+}
+
+func (m *MockTaskTemplateService) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "taskTemplatesService.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, callerID, boardID)
+}
+
+func (m *MockTaskTemplateService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "taskTemplatesService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, templateID, namePattern, descriptionPattern, checklist)
+}
+
+func (m *MockTaskTemplateService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+	testutil.AssertFuncNotNil(m.t, "taskTemplatesService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, templateID)
+}
+
+type MockRecurrenceService struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, rule domain.RecurrenceRule, startsAt time.Time, catchUp domain.RecurrenceCatchUp) (domain.Recurrence, error)
+	ListByBoardIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Recurrence, error)
+	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID, patch service.RecurrencePatch) (domain.Recurrence, error)
+	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error
+}
+
+func NewMockRecurrenceService(t *testing.T) *MockRecurrenceService {
+	return &MockRecurrenceService{t: t}
+}
+
+func (m *MockRecurrenceService) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	name domain.TaskName,
+	description domain.TaskDescription,
+	rule domain.RecurrenceRule,
+	startsAt time.Time,
+	catchUp domain.RecurrenceCatchUp,
+) (domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "recurrencesService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, columnID, name, description, rule, startsAt, catchUp)
+}
+
+func (m *MockRecurrenceService) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "recurrencesService.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, callerID, boardID)
+}
+
+func (m *MockRecurrenceService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID, patch service.RecurrencePatch) (domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "recurrencesService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, recurrenceID, patch)
+}
+
+func (m *MockRecurrenceService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error {
+	testutil.AssertFuncNotNil(m.t, "recurrencesService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, recurrenceID)
+}
+
+type MockAutomationService struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.AutomationName, trigger domain.AutomationTrigger, action domain.AutomationAction, enabled bool) (domain.Automation, error)
+	ListByBoardIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Automation, error)
+	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID, patch service.AutomationPatch) (domain.Automation, error)
+	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID) error
+	ListRunsFunc      func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.AutomationRun, error)
+	DryRunFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, trigger domain.AutomationTrigger, action domain.AutomationAction, events domain.AutomationDryRunEvents) (domain.AutomationDryRun, error)
+}
+
+func NewMockAutomationService(t *testing.T) *MockAutomationService {
+	return &MockAutomationService{t: t}
+}
+
+func (m *MockAutomationService) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	name domain.AutomationName,
+	trigger domain.AutomationTrigger,
+	action domain.AutomationAction,
+	enabled bool,
+) (domain.Automation, error) {
+	testutil.AssertFuncNotNil(m.t, "automationsService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, name, trigger, action, enabled)
+}
+
+func (m *MockAutomationService) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Automation, error) {
+	testutil.AssertFuncNotNil(m.t, "automationsService.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, callerID, boardID)
+}
+
+func (m *MockAutomationService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID, patch service.AutomationPatch) (domain.Automation, error) {
+	testutil.AssertFuncNotNil(m.t, "automationsService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, automationID, patch)
+}
+
+func (m *MockAutomationService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID) error {
+	testutil.AssertFuncNotNil(m.t, "automationsService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, automationID)
+}
+
+func (m *MockAutomationService) ListRuns(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.AutomationRun, error) {
+	testutil.AssertFuncNotNil(m.t, "automationsService.ListRunsFunc", m.ListRunsFunc)
+	return m.ListRunsFunc(ctx, callerID, boardID)
+}
+
+func (m *MockAutomationService) DryRun(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	trigger domain.AutomationTrigger,
+	action domain.AutomationAction,
+	events domain.AutomationDryRunEvents,
+) (domain.AutomationDryRun, error) {
+	testutil.AssertFuncNotNil(m.t, "automationsService.DryRunFunc", m.DryRunFunc)
+	return m.DryRunFunc(ctx, callerID, boardID, trigger, action, events)
+}
+
+type MockTaskLinkService struct {
+	t *testing.T
+
+	CreateFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, link domain.TaskLink) (domain.TaskLink, error)
+	DeleteFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, linkID domain.TaskLinkID) error
+}
+
+func NewMockTaskLinkService(t *testing.T) *MockTaskLinkService {
+	return &MockTaskLinkService{t: t}
+}
+
+func (m *MockTaskLinkService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, link domain.TaskLink) (domain.TaskLink, error) {
+	testutil.AssertFuncNotNil(m.t, "taskLinksService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, columnID, taskID, link)
+}
+
+func (m *MockTaskLinkService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, linkID domain.TaskLinkID) error {
+	testutil.AssertFuncNotNil(m.t, "taskLinksService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, columnID, taskID, linkID)
+}
+
+type MockSprintService struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.SprintName, goal domain.SprintGoal, startDate time.Time, endDate time.Time) (domain.Sprint, error)
+	ListByBoardIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Sprint, error)
+	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, patch service.SprintPatch) (domain.Sprint, error)
+	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) error
+	AddTaskFunc       func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	RemoveTaskFunc    func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	CompleteFunc      func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, carryOver domain.SprintCarryOver) (domain.SprintReport, error)
+	ReportFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) (domain.SprintReport, error)
+}
+
+func NewMockSprintService(t *testing.T) *MockSprintService {
+	return &MockSprintService{t: t}
+}
+
+func (m *MockSprintService) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	name domain.SprintName,
+	goal domain.SprintGoal,
+	startDate time.Time,
+	endDate time.Time,
+) (domain.Sprint, error) {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, name, goal, startDate, endDate)
+}
+
+func (m *MockSprintService) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Sprint, error) {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, callerID, boardID)
+}
+
+func (m *MockSprintService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, patch service.SprintPatch) (domain.Sprint, error) {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, sprintID, patch)
+}
+
+func (m *MockSprintService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) error {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, sprintID)
+}
+
+func (m *MockSprintService) AddTask(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.AddTaskFunc", m.AddTaskFunc)
+	return m.AddTaskFunc(ctx, callerID, boardID, sprintID, taskID)
+}
+
+func (m *MockSprintService) RemoveTask(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.RemoveTaskFunc", m.RemoveTaskFunc)
+	return m.RemoveTaskFunc(ctx, callerID, boardID, sprintID, taskID)
+}
+
+func (m *MockSprintService) Complete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, carryOver domain.SprintCarryOver) (domain.SprintReport, error) {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.CompleteFunc", m.CompleteFunc)
+	return m.CompleteFunc(ctx, callerID, boardID, sprintID, carryOver)
+}
+
+func (m *MockSprintService) Report(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) (domain.SprintReport, error) {
+	testutil.AssertFuncNotNil(m.t, "sprintsService.ReportFunc", m.ReportFunc)
+	return m.ReportFunc(ctx, callerID, boardID, sprintID)
+}
+
+type MockAnalyticsService struct {
+	t *testing.T
+
+	BurndownFunc       func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error)
+	CumulativeFlowFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) (domain.CumulativeFlow, error)
+	CycleTimesFunc     func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error)
+	ThroughputFunc     func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.ThroughputReport, error)
+	ForecastFunc       func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, target domain.ForecastTarget, settings domain.ForecastSettings) (domain.Forecast, error)
+}
+
+func NewMockAnalyticsService(t *testing.T) *MockAnalyticsService {
+	return &MockAnalyticsService{t: t}
+}
+
+func (m *MockAnalyticsService) Burndown(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) (domain.BurndownSeries, error) {
+	testutil.AssertFuncNotNil(m.t, "analyticsService.BurndownFunc", m.BurndownFunc)
+	return m.BurndownFunc(ctx, callerID, boardID, sprintID, from, to)
+}
+
+func (m *MockAnalyticsService) CumulativeFlow(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) (domain.CumulativeFlow, error) {
+	testutil.AssertFuncNotNil(m.t, "analyticsService.CumulativeFlowFunc", m.CumulativeFlowFunc)
+	return m.CumulativeFlowFunc(ctx, callerID, boardID, from, to, interval)
+}
+
+func (m *MockAnalyticsService) CycleTimes(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error) {
+	testutil.AssertFuncNotNil(m.t, "analyticsService.CycleTimesFunc", m.CycleTimesFunc)
+	return m.CycleTimesFunc(ctx, callerID, boardID, from, to)
+}
+
+func (m *MockAnalyticsService) Throughput(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, from time.Time, to time.Time) (domain.ThroughputReport, error) {
+	testutil.AssertFuncNotNil(m.t, "analyticsService.ThroughputFunc", m.ThroughputFunc)
+	return m.ThroughputFunc(ctx, callerID, boardID, from, to)
+}
+
+func (m *MockAnalyticsService) Forecast(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, target domain.ForecastTarget, settings domain.ForecastSettings) (domain.Forecast, error) {
+	testutil.AssertFuncNotNil(m.t, "analyticsService.ForecastFunc", m.ForecastFunc)
+	return m.ForecastFunc(ctx, callerID, boardID, target, settings)
+}
+
+type MockStatsService struct {
+	t *testing.T
+
+	BoardStatsFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error)
+	UserStatsFunc  func(ctx context.Context, callerID domain.UserID) (domain.UserStats, error)
+}
+
+func NewMockStatsService(t *testing.T) *MockStatsService {
+	return &MockStatsService{t: t}
+}
+
+func (m *MockStatsService) BoardStats(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error) {
+	testutil.AssertFuncNotNil(m.t, "statsService.BoardStatsFunc", m.BoardStatsFunc)
+	return m.BoardStatsFunc(ctx, callerID, boardID)
+}
+
+func (m *MockStatsService) UserStats(ctx context.Context, callerID domain.UserID) (domain.UserStats, error) {
+	testutil.AssertFuncNotNil(m.t, "statsService.UserStatsFunc", m.UserStatsFunc)
+	return m.UserStatsFunc(ctx, callerID)
+}
+
+type MockCustomFieldService struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error)
+	ListByBoardIDFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error)
+	UpdateFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error)
+	DeleteFunc        func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID) error
+}
+
+func NewMockCustomFieldService(t *testing.T) *MockCustomFieldService {
+	return &MockCustomFieldService{t: t}
+}
+
+func (m *MockCustomFieldService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error) {
+	testutil.AssertFuncNotNil(m.t, "customFieldsService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, name, fieldType, options)
+}
+
+func (m *MockCustomFieldService) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error) {
+	testutil.AssertFuncNotNil(m.t, "customFieldsService.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, callerID, boardID)
+}
+
+func (m *MockCustomFieldService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+	testutil.AssertFuncNotNil(m.t, "customFieldsService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, fieldID, name, options)
+}
+
+func (m *MockCustomFieldService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+	testutil.AssertFuncNotNil(m.t, "customFieldsService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, fieldID)
+}
+
+type MockExportService struct {
+	t *testing.T
+
+	ExportFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error
+}
+
+func NewMockExportService(t *testing.T) *MockExportService {
+	return &MockExportService{t: t}
+}
+
+func (m *MockExportService) Export(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w service.BoardExportWriter) error {
+	testutil.AssertFuncNotNil(m.t, "exportService.ExportFunc", m.ExportFunc)
+	return m.ExportFunc(ctx, callerID, boardID, w)
+}
+
+type MockBoardImportService struct {
+	t *testing.T
+
+	CreateFunc func(ctx context.Context, callerID domain.UserID, boardImport domain.BoardImport) (domain.BoardImport, error)
+	GetFunc    func(ctx context.Context, callerID domain.UserID, importID domain.BoardImportID) (domain.BoardImport, error)
+}
+
+func NewMockBoardImportService(t *testing.T) *MockBoardImportService {
+	return &MockBoardImportService{t: t}
+}
+
+func (m *MockBoardImportService) Create(ctx context.Context, callerID domain.UserID, boardImport domain.BoardImport) (domain.BoardImport, error) {
+	testutil.AssertFuncNotNil(m.t, "boardImportService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardImport)
+}
+
+func (m *MockBoardImportService) Get(ctx context.Context, callerID domain.UserID, importID domain.BoardImportID) (domain.BoardImport, error) {
+	testutil.AssertFuncNotNil(m.t, "boardImportService.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, callerID, importID)
+}
+
+type MockBoardEventService struct {
+	t *testing.T
+
+	SubscribeFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, lastEventID *domain.BoardEventCursor) (service.BoardEventSubscription, error)
+	ListAfterFunc func(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor) ([]domain.BoardEvent, error)
+}
+
+func NewMockBoardEventService(t *testing.T) *MockBoardEventService {
+	return &MockBoardEventService{t: t}
+}
+
+func (m *MockBoardEventService) Subscribe(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, lastEventID *domain.BoardEventCursor) (service.BoardEventSubscription, error) {
+	testutil.AssertFuncNotNil(m.t, "boardEventService.SubscribeFunc", m.SubscribeFunc)
+	return m.SubscribeFunc(ctx, callerID, boardID, lastEventID)
+}
+
+func (m *MockBoardEventService) ListAfter(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor) ([]domain.BoardEvent, error) {
+	testutil.AssertFuncNotNil(m.t, "boardEventService.ListAfterFunc", m.ListAfterFunc)
+	return m.ListAfterFunc(ctx, boardID, after)
+}
+
+type MockSyncService struct {
+	t *testing.T
+
+	ChangesFunc func(ctx context.Context, callerID domain.UserID, since *domain.BoardEventCursor) (domain.SyncChanges, error)
+	PushFunc    func(ctx context.Context, callerID domain.UserID, operations []domain.SyncOperation) []service.SyncResult
+}
+
+func NewMockSyncService(t *testing.T) *MockSyncService {
+	return &MockSyncService{t: t}
+}
+
+func (m *MockSyncService) Changes(ctx context.Context, callerID domain.UserID, since *domain.BoardEventCursor) (domain.SyncChanges, error) {
+	testutil.AssertFuncNotNil(m.t, "syncService.ChangesFunc", m.ChangesFunc)
+	return m.ChangesFunc(ctx, callerID, since)
+}
+
+func (m *MockSyncService) Push(ctx context.Context, callerID domain.UserID, operations []domain.SyncOperation) []service.SyncResult {
+	testutil.AssertFuncNotNil(m.t, "syncService.PushFunc", m.PushFunc)
+	return m.PushFunc(ctx, callerID, operations)
+}
@@ -0,0 +1,368 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type recurrencesService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, rule domain.RecurrenceRule, startsAt time.Time, catchUp domain.RecurrenceCatchUp) (domain.Recurrence, error)
+	ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Recurrence, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID, patch service.RecurrencePatch) (domain.Recurrence, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error
+}
+
+type recurrences struct {
+	logger             *slog.Logger
+	recurrencesService recurrencesService
+	responder          *httpschema.ErrorResponder
+}
+
+func NewRecurrences(logger *slog.Logger, recurrencesService recurrencesService, responder *httpschema.ErrorResponder) *recurrences {
+	moduleLogger := logging.WithModule(logger, "handler.recurrences")
+
+	return &recurrences{logger: moduleLogger, recurrencesService: recurrencesService, responder: responder}
+}
+
+type createRecurrenceBody struct {
+	ColumnID    string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	Name        string `json:"name" example:"Water the plants"`
+	Description string `json:"description" example:"Both floors"`
+	Rule        string `json:"rule" example:"FREQ=WEEKLY;BYDAY=MO,TH"`
+	StartsAt    string `json:"startsAt" example:"2026-03-09T09:00:00Z"`
+	CatchUp     string `json:"catchUp" example:"skip" enums:"skip,create_once"`
+}
+
+type updateRecurrenceBody struct {
+	ColumnID    *string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	Name        *string `json:"name" example:"Water the plants"`
+	Description *string `json:"description" example:"Both floors"`
+	Rule        *string `json:"rule" example:"FREQ=MONTHLY;BYMONTHDAY=1"`
+	StartsAt    *string `json:"startsAt" example:"2026-03-09T09:00:00Z"`
+	CatchUp     *string `json:"catchUp" example:"create_once" enums:"skip,create_once"`
+}
+
+type recurrenceResponse struct {
+	ID          string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a5"`
+	BoardID     string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	ColumnID    string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	Name        string `json:"name" example:"Water the plants"`
+	Description string `json:"description" example:"Both floors"`
+	Rule        string `json:"rule" example:"FREQ=WEEKLY;BYDAY=MO,TH"`
+	StartsAt    string `json:"startsAt" example:"2026-03-09T09:00:00.000Z"`
+	CatchUp     string `json:"catchUp" example:"skip"`
+	NextRunAt   string `json:"nextRunAt" example:"2026-03-12T09:00:00.000Z"`
+	CreatedAt   string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt   string `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newRecurrenceResponse(recurrence *domain.Recurrence) recurrenceResponse {
+	return recurrenceResponse{
+		ID:          recurrence.ID.String(),
+		BoardID:     recurrence.BoardID.String(),
+		ColumnID:    recurrence.ColumnID.String(),
+		Name:        recurrence.Name.String(),
+		Description: recurrence.Description.String(),
+		Rule:        recurrence.Rule.String(),
+		StartsAt:    service.FormatRFC3339Millis(recurrence.StartsAt),
+		CatchUp:     recurrence.CatchUp.String(),
+		NextRunAt:   service.FormatRFC3339Millis(recurrence.NextRunAt),
+		CreatedAt:   service.FormatRFC3339Millis(recurrence.CreatedAt),
+		UpdatedAt:   service.FormatRFC3339Millis(recurrence.UpdatedAt),
+	}
+}
+
+type listRecurrencesResponse = []recurrenceResponse
+
+func parseRecurrenceStartsAt(raw string) (time.Time, error) {
+	startsAt, err := time.Parse(time.RFC3339, raw)
+	if err != nil {
+		return domain.NewRecurrenceStartsAt(time.Time{})
+	}
+	return domain.NewRecurrenceStartsAt(startsAt)
+}
+
+// Create godoc
+// @Summary Create a recurrence
+// @Description Create a recurrence rule that materializes a new task in a column of the board at the scheduled time.
+// @Description The rule is a subset of RFC 5545 RRULE: FREQ=DAILY, FREQ=WEEKLY;BYDAY=MO,WE,... or FREQ=MONTHLY;BYMONTHDAY=N (months without day N are skipped).
+// @Description Occurrences happen at the time of day of startsAt in UTC and never before startsAt.
+// @Description catchUp defaults to skip and tells what to do with occurrences missed during downtime: skip them, or create a single task for all of them.
+// @Tags recurrences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createRecurrenceBody true "Recurrence details"
+// @Success 201 {object} recurrenceResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND or COLUMN_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/recurrences [post]
+func (h *recurrences) Create(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	var body createRecurrenceBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+	if body.CatchUp == "" {
+		body.CatchUp = domain.RecurrenceCatchUpSkip.String()
+	}
+
+	details := []httpschema.Detail{}
+	columnID, err := domain.ParseColumnID(body.ColumnID)
+	if err != nil {
+		details = append(details, httpschema.Detail{Field: "columnId", Issues: []string{"Invalid column id"}})
+	}
+	name := httpschema.ValidateField("name", body.Name, domain.NewTaskName, &details)
+	description := httpschema.ValidateField("description", body.Description, domain.NewTaskDescription, &details)
+	rule := httpschema.ValidateField("rule", body.Rule, domain.NewRecurrenceRule, &details)
+	startsAt := httpschema.ValidateField("startsAt", body.StartsAt, parseRecurrenceStartsAt, &details)
+	catchUp := httpschema.ValidateField("catchUp", body.CatchUp, domain.NewRecurrenceCatchUp, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	recurrence, err := h.recurrencesService.Create(r.Context(), userID, boardID, columnID, name, description, rule, startsAt, catchUp)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrColumnNotFound) {
+			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newRecurrenceResponse(&recurrence))
+}
+
+// ListByBoardID godoc
+// @Summary List recurrences of a board
+// @Description Get all recurrences of a board owned by the current user, in increasing creation time order.
+// @Tags recurrences
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {object} listRecurrencesResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/recurrences [get]
+func (h *recurrences) ListByBoardID(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	recurrences, err := h.recurrencesService.ListByBoardID(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make(listRecurrencesResponse, len(recurrences))
+	for i := range recurrences {
+		response[i] = newRecurrenceResponse(&recurrences[i])
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// Update godoc
+// @Summary Update a recurrence by id
+// @Description Partially update a recurrence. Provided fields are updated; omitted or null fields are ignored.
+// @Description Changing rule or startsAt reschedules the next run from now; occurrences of the new schedule in the past are not caught up.
+// @Tags recurrences
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param recurrenceId path string true "Recurrence ID"
+// @Param body body updateRecurrenceBody true "Recurrence fields to update"
+// @Success 200 {object} recurrenceResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "RECURRENCE_NOT_FOUND or COLUMN_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/recurrences/{recurrenceId} [patch]
+func (h *recurrences) Update(w http.ResponseWriter, r *http.Request) {
+	boardID, recurrenceID, ok := h.parseBoardAndRecurrenceID(w, r)
+	if !ok {
+		return
+	}
+
+	var body updateRecurrenceBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	var patch service.RecurrencePatch
+	if body.ColumnID != nil {
+		columnID, parseErr := domain.ParseColumnID(*body.ColumnID)
+		if parseErr != nil {
+			details = append(details, httpschema.Detail{Field: "columnId", Issues: []string{"Invalid column id"}})
+		}
+		patch.ColumnID = &columnID
+	}
+	if body.Name != nil {
+		value := httpschema.ValidateField("name", *body.Name, domain.NewTaskName, &details)
+		patch.Name = &value
+	}
+	if body.Description != nil {
+		value := httpschema.ValidateField("description", *body.Description, domain.NewTaskDescription, &details)
+		patch.Description = &value
+	}
+	if body.Rule != nil {
+		value := httpschema.ValidateField("rule", *body.Rule, domain.NewRecurrenceRule, &details)
+		patch.Rule = &value
+	}
+	if body.StartsAt != nil {
+		value := httpschema.ValidateField("startsAt", *body.StartsAt, parseRecurrenceStartsAt, &details)
+		patch.StartsAt = &value
+	}
+	if body.CatchUp != nil {
+		value := httpschema.ValidateField("catchUp", *body.CatchUp, domain.NewRecurrenceCatchUp, &details)
+		patch.CatchUp = &value
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	recurrence, err := h.recurrencesService.Update(r.Context(), userID, boardID, recurrenceID, patch)
+	if err != nil {
+		if errors.Is(err, service.ErrRecurrenceNotFound) {
+			h.responder.RecurrenceNotFound(w, []httpschema.Detail{{Field: "recurrenceId", Issues: []string{"Recurrence not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrColumnNotFound) {
+			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newRecurrenceResponse(&recurrence))
+}
+
+// Delete godoc
+// @Summary Delete a recurrence by id
+// @Description Delete a recurrence. Tasks already created by it are not affected.
+// @Tags recurrences
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param recurrenceId path string true "Recurrence ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "RECURRENCE_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/recurrences/{recurrenceId} [delete]
+func (h *recurrences) Delete(w http.ResponseWriter, r *http.Request) {
+	boardID, recurrenceID, ok := h.parseBoardAndRecurrenceID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err := h.recurrencesService.Delete(r.Context(), userID, boardID, recurrenceID)
+	if err != nil {
+		if errors.Is(err, service.ErrRecurrenceNotFound) {
+			h.responder.RecurrenceNotFound(w, []httpschema.Detail{{Field: "recurrenceId", Issues: []string{"Recurrence not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *recurrences) parseBoardID(w http.ResponseWriter, r *http.Request) (domain.BoardID, bool) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return domain.BoardID{}, false
+	}
+
+	return boardID, true
+}
+
+func (h *recurrences) parseBoardAndRecurrenceID(w http.ResponseWriter, r *http.Request) (boardID domain.BoardID, recurrenceID domain.RecurrenceID, ok bool) {
+	boardID, ok = h.parseBoardID(w, r)
+	if !ok {
+		return domain.BoardID{}, domain.RecurrenceID{}, false
+	}
+
+	rawRecurrenceID := r.PathValue("recurrenceId")
+	recurrenceID, err := domain.ParseRecurrenceID(rawRecurrenceID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "recurrenceId", Issues: []string{"Invalid recurrence id"}}})
+		return domain.BoardID{}, domain.RecurrenceID{}, false
+	}
+
+	return boardID, recurrenceID, true
+}
@@ -0,0 +1,419 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func recurrenceBody(recurrence *domain.Recurrence) map[string]any {
+	return map[string]any{
+		"id":          recurrence.ID.String(),
+		"boardId":     recurrence.BoardID.String(),
+		"columnId":    recurrence.ColumnID.String(),
+		"name":        recurrence.Name.String(),
+		"description": recurrence.Description.String(),
+		"rule":        recurrence.Rule.String(),
+		"startsAt":    recurrence.StartsAt.Format(testutil.TimeFormat),
+		"catchUp":     recurrence.CatchUp.String(),
+		"nextRunAt":   recurrence.NextRunAt.Format(testutil.TimeFormat),
+		"createdAt":   recurrence.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt":   recurrence.UpdatedAt.Format(testutil.TimeFormat),
+	}
+}
+
+func TestRecurrences_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validRecurrence := testutil.ValidRecurrence(validBoard.ID, validColumn.ID)
+
+	validInput := func(overrides map[string]any) map[string]any {
+		input := map[string]any{
+			"columnId":    validColumn.ID.String(),
+			"name":        validRecurrence.Name.String(),
+			"description": validRecurrence.Description.String(),
+			"rule":        "FREQ=WEEKLY;BYDAY=WE,MO",
+			"startsAt":    "2025-12-31T03:00:00+03:00",
+		}
+		for k, v := range overrides {
+			input[k] = v
+		}
+		return input
+	}
+
+	tests := []struct {
+		name                   string
+		boardID                string
+		inputBody              any
+		setupRecurrenceService func(t *testing.T, s *MockRecurrenceService)
+		wantCode               int
+		wantBody               any
+	}{
+		{
+			name:      "Success with default catch-up",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(nil),
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, rule domain.RecurrenceRule, startsAt time.Time, catchUp domain.RecurrenceCatchUp) (domain.Recurrence, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if columnID != validColumn.ID {
+						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
+					}
+					if rule != validRecurrence.Rule {
+						t.Errorf("got rule %v, want %v", rule, validRecurrence.Rule)
+					}
+					if !startsAt.Equal(validRecurrence.StartsAt) || startsAt.Location() != time.UTC {
+						t.Errorf("got starts at %v, want %v in UTC", startsAt, validRecurrence.StartsAt)
+					}
+					if catchUp != domain.RecurrenceCatchUpSkip {
+						t.Errorf("got catch-up %v, want %v", catchUp, domain.RecurrenceCatchUpSkip)
+					}
+					return validRecurrence, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: recurrenceBody(&validRecurrence),
+		},
+		{
+			name:      "Invalid board id",
+			boardID:   "not-a-uuid",
+			inputBody: validInput(nil),
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:      "Invalid JSON",
+			boardID:   validBoard.ID.String(),
+			inputBody: "{\"rule\":\"broken\"",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Invalid column id",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(map[string]any{"columnId": "nope"}),
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("columnId", []string{"Invalid column id"}),
+		},
+		{
+			name:      "Invalid rule",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(map[string]any{"rule": "FREQ=YEARLY"}),
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("rule", []string{"Rule is invalid"}),
+		},
+		{
+			name:      "Invalid starts at",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(map[string]any{"startsAt": "tomorrow"}),
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("startsAt", []string{"Start time is invalid"}),
+		},
+		{
+			name:      "Invalid catch-up",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(map[string]any{"catchUp": "all"}),
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("catchUp", []string{"Catch-up policy is invalid"}),
+		},
+		{
+			name:      "Board not found",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(nil),
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, rule domain.RecurrenceRule, startsAt time.Time, catchUp domain.RecurrenceCatchUp) (domain.Recurrence, error) {
+					return domain.Recurrence{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:      "Column not found",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(nil),
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, rule domain.RecurrenceRule, startsAt time.Time, catchUp domain.RecurrenceCatchUp) (domain.Recurrence, error) {
+					return domain.Recurrence{}, service.ErrColumnNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: columnNotFoundError("columnId"),
+		},
+		{
+			name:      "Internal error",
+			boardID:   validBoard.ID.String(),
+			inputBody: validInput(nil),
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, rule domain.RecurrenceRule, startsAt time.Time, catchUp domain.RecurrenceCatchUp) (domain.Recurrence, error) {
+					return domain.Recurrence{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:      "Body too large",
+			boardID:   validBoard.ID.String(),
+			inputBody: testutil.Valid25KBJSON(),
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/recurrences"
+			req := buildTaskRequest(t, http.MethodPost, path, tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", tt.boardID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockRecurrenceService(t)
+			if tt.setupRecurrenceService != nil {
+				tt.setupRecurrenceService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewRecurrences(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestRecurrences_ListByBoardID(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validRecurrence := testutil.ValidRecurrence(validBoard.ID, domain.NewColumnID())
+
+	tests := []struct {
+		name                   string
+		setupRecurrenceService func(t *testing.T, s *MockRecurrenceService)
+		wantCode               int
+		wantBody               any
+	}{
+		{
+			name: "Success",
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Recurrence, error) {
+					return []domain.Recurrence{validRecurrence}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{recurrenceBody(&validRecurrence)},
+		},
+		{
+			name: "Success empty",
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Recurrence, error) {
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{},
+		},
+		{
+			name: "Board not found",
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Recurrence, error) {
+					return nil, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+validBoard.ID.String()+"/recurrences", nil)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+
+			rr := httptest.NewRecorder()
+			s := NewMockRecurrenceService(t)
+			tt.setupRecurrenceService(t, s)
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewRecurrences(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.ListByBoardID(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestRecurrences_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validRecurrence := testutil.ValidRecurrence(validBoard.ID, domain.NewColumnID())
+
+	tests := []struct {
+		name                   string
+		recurrenceID           string
+		inputBody              any
+		setupRecurrenceService func(t *testing.T, s *MockRecurrenceService)
+		wantCode               int
+		wantBody               any
+	}{
+		{
+			name:         "Success rule only",
+			recurrenceID: validRecurrence.ID.String(),
+			inputBody:    map[string]any{"rule": "FREQ=MONTHLY;BYMONTHDAY=1"},
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID, patch service.RecurrencePatch) (domain.Recurrence, error) {
+					if recurrenceID != validRecurrence.ID {
+						t.Errorf("got recurrence id %v, want %v", recurrenceID, validRecurrence.ID)
+					}
+					if patch.Rule == nil || patch.Rule.String() != "FREQ=MONTHLY;BYMONTHDAY=1" {
+						t.Errorf("got rule %v, want monthly on day 1", patch.Rule)
+					}
+					if patch.ColumnID != nil || patch.Name != nil || patch.Description != nil || patch.StartsAt != nil || patch.CatchUp != nil {
+						t.Errorf("got unexpected patch fields %+v", patch)
+					}
+					return validRecurrence, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: recurrenceBody(&validRecurrence),
+		},
+		{
+			name:         "Invalid recurrence id",
+			recurrenceID: "not-a-uuid",
+			inputBody:    map[string]any{"name": "Renamed"},
+			wantCode:     http.StatusBadRequest,
+			wantBody:     validationError("recurrenceId", []string{"Invalid recurrence id"}),
+		},
+		{
+			name:         "Invalid catch-up",
+			recurrenceID: validRecurrence.ID.String(),
+			inputBody:    map[string]any{"catchUp": "sometimes"},
+			wantCode:     http.StatusBadRequest,
+			wantBody:     validationError("catchUp", []string{"Catch-up policy is invalid"}),
+		},
+		{
+			name:         "Recurrence not found",
+			recurrenceID: validRecurrence.ID.String(),
+			inputBody:    map[string]any{"name": "Renamed"},
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID, patch service.RecurrencePatch) (domain.Recurrence, error) {
+					return domain.Recurrence{}, service.ErrRecurrenceNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: recurrenceNotFoundError(),
+		},
+		{
+			name:         "Column not found",
+			recurrenceID: validRecurrence.ID.String(),
+			inputBody:    map[string]any{"columnId": domain.NewColumnID().String()},
+			setupRecurrenceService: func(t *testing.T, s *MockRecurrenceService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID, patch service.RecurrencePatch) (domain.Recurrence, error) {
+					return domain.Recurrence{}, service.ErrColumnNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: columnNotFoundError("columnId"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/recurrences/" + tt.recurrenceID
+			req := buildTaskRequest(t, http.MethodPatch, path, tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			req.SetPathValue("recurrenceId", tt.recurrenceID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockRecurrenceService(t)
+			if tt.setupRecurrenceService != nil {
+				tt.setupRecurrenceService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewRecurrences(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Update(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestRecurrences_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	recurrenceID := domain.NewRecurrenceID()
+
+	tests := []struct {
+		name      string
+		deleteErr error
+		wantCode  int
+		wantBody  any
+	}{
+		{name: "Success", wantCode: http.StatusNoContent},
+		{name: "Recurrence not found", deleteErr: service.ErrRecurrenceNotFound, wantCode: http.StatusNotFound, wantBody: recurrenceNotFoundError()},
+		{name: "Internal error", deleteErr: errors.New("db exploded"), wantCode: http.StatusInternalServerError, wantBody: internalError()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/recurrences/" + recurrenceID.String()
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			req.SetPathValue("recurrenceId", recurrenceID.String())
+
+			rr := httptest.NewRecorder()
+			s := NewMockRecurrenceService(t)
+			s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, gotRecurrenceID domain.RecurrenceID) error {
+				if gotRecurrenceID != recurrenceID {
+					t.Errorf("got recurrence id %v, want %v", gotRecurrenceID, recurrenceID)
+				}
+				return tt.deleteErr
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewRecurrences(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Delete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			if tt.wantBody != nil {
+				testutil.AssertContentType(t, rr, "application/json")
+				testutil.AssertResponseBody(t, rr, tt.wantBody)
+			}
+		})
+	}
+}
@@ -1,6 +1,11 @@
 package handler_test
 
 import (
+	"maps"
+	"slices"
+	"strings"
+
+	"goroutine/internal/domain"
 	"goroutine/internal/testutil"
 )
 
@@ -15,6 +20,28 @@ func columnNotFoundError(field string) map[string]any {
 	}
 }
 
+func laneNotFoundError(field string) map[string]any {
+	return map[string]any{
+		"code":      "LANE_NOT_FOUND",
+		"message":   "Lane not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": field, "issues": []string{"Lane not found"}},
+		},
+	}
+}
+
+func customFieldNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "CUSTOM_FIELD_NOT_FOUND",
+		"message":   "Custom field not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "fieldId", "issues": []string{"Custom field not found"}},
+		},
+	}
+}
+
 func taskNotFoundError(field string) map[string]any {
 	return map[string]any{
 		"code":      "TASK_NOT_FOUND",
@@ -26,6 +53,28 @@ func taskNotFoundError(field string) map[string]any {
 	}
 }
 
+func taskArchivedError() map[string]any {
+	return map[string]any{
+		"code":      "TASK_ARCHIVED",
+		"message":   "Task is archived",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "taskId", "issues": []string{"Task is archived"}},
+		},
+	}
+}
+
+func versionConflictError(issue string) map[string]any {
+	return map[string]any{
+		"code":      "VERSION_CONFLICT",
+		"message":   "Resource has changed since the given version",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "If-Match", "issues": []string{issue}},
+		},
+	}
+}
+
 func payloadTooLargeError() map[string]any {
 	return map[string]any{
 		"code":      "PAYLOAD_TOO_LARGE",
@@ -67,6 +116,92 @@ func boardNotFoundError() map[string]any {
 	}
 }
 
+func boardTemplateNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "BOARD_TEMPLATE_NOT_FOUND",
+		"message":   "Board template not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "templateId", "issues": []string{"Board template not found"}},
+		},
+	}
+}
+
+func taskTemplateNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "TASK_TEMPLATE_NOT_FOUND",
+		"message":   "Task template not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "templateId", "issues": []string{"Task template not found"}},
+		},
+	}
+}
+
+func recurrenceNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "RECURRENCE_NOT_FOUND",
+		"message":   "Recurrence not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "recurrenceId", "issues": []string{"Recurrence not found"}},
+		},
+	}
+}
+
+func automationNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "AUTOMATION_NOT_FOUND",
+		"message":   "Automation not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "automationId", "issues": []string{"Automation not found"}},
+		},
+	}
+}
+
+func boardImportNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "BOARD_IMPORT_NOT_FOUND",
+		"message":   "Board import not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "importId", "issues": []string{"Board import not found"}},
+		},
+	}
+}
+
+func taskLinkNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "TASK_LINK_NOT_FOUND",
+		"message":   "Task link not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "linkId", "issues": []string{"Task link not found"}},
+		},
+	}
+}
+
+func taskBlockedError(blockerIDs ...string) map[string]any {
+	return map[string]any{
+		"code":      "TASK_BLOCKED",
+		"message":   "Task is blocked by unfinished tasks",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "blockedBy", "issues": blockerIDs},
+		},
+	}
+}
+
+func transitionNotAllowedError(details ...map[string]any) map[string]any {
+	return map[string]any{
+		"code":      "TRANSITION_NOT_ALLOWED",
+		"message":   "Task transition is not allowed by the board workflow",
+		"timestamp": testutil.FixedNowStr(),
+		"details":   details,
+	}
+}
+
 func userAlreadyExistsError() map[string]any {
 	return map[string]any{
 		"code":      "USER_ALREADY_EXISTS",
@@ -89,6 +224,23 @@ func unauthorizedTokenError() map[string]any {
 	}
 }
 
+// customFieldValuesError lists the issues of every field as customFields.<fieldId>, sorted by field.
+func customFieldValuesError(issues map[domain.CustomFieldID][]string) map[string]any {
+	details := make([]any, 0, len(issues))
+	for _, fieldID := range slices.SortedFunc(maps.Keys(issues), func(a, b domain.CustomFieldID) int {
+		return strings.Compare(a.String(), b.String())
+	}) {
+		details = append(details, map[string]any{"field": "customFields." + fieldID.String(), "issues": issues[fieldID]})
+	}
+
+	return map[string]any{
+		"code":      "VALIDATION_ERROR",
+		"message":   "Some fields are invalid",
+		"timestamp": testutil.FixedNowStr(),
+		"details":   details,
+	}
+}
+
 func validationError(field string, issues []string) map[string]any {
 	return map[string]any{
 		"code":      "VALIDATION_ERROR",
@@ -99,3 +251,40 @@ func validationError(field string, issues []string) map[string]any {
 		},
 	}
 }
+
+func emptyTaskRollup() map[string]any {
+	return map[string]any{"total": 0, "done": 0, "byColumn": []any{}}
+}
+
+func parentTaskNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "TASK_NOT_FOUND",
+		"message":   "Task not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "parentId", "issues": []string{"Parent task not found"}},
+		},
+	}
+}
+
+func sprintNotFoundError() map[string]any {
+	return map[string]any{
+		"code":      "SPRINT_NOT_FOUND",
+		"message":   "Sprint not found",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "sprintId", "issues": []string{"Sprint not found"}},
+		},
+	}
+}
+
+func sprintCompletedError() map[string]any {
+	return map[string]any{
+		"code":      "SPRINT_COMPLETED",
+		"message":   "Sprint is already completed",
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "sprintId", "issues": []string{"Sprint is already completed"}},
+		},
+	}
+}
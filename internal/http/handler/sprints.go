@@ -0,0 +1,598 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type sprintsService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.SprintName, goal domain.SprintGoal, startDate time.Time, endDate time.Time) (domain.Sprint, error)
+	ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Sprint, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, patch service.SprintPatch) (domain.Sprint, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) error
+	AddTask(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	RemoveTask(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	Complete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, carryOver domain.SprintCarryOver) (domain.SprintReport, error)
+	Report(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) (domain.SprintReport, error)
+}
+
+type sprints struct {
+	logger         *slog.Logger
+	sprintsService sprintsService
+	responder      *httpschema.ErrorResponder
+}
+
+func NewSprints(logger *slog.Logger, sprintsService sprintsService, responder *httpschema.ErrorResponder) *sprints {
+	moduleLogger := logging.WithModule(logger, "handler.sprints")
+
+	return &sprints{logger: moduleLogger, sprintsService: sprintsService, responder: responder}
+}
+
+type createSprintBody struct {
+	Name      string `json:"name" example:"Sprint 14"`
+	Goal      string `json:"goal" example:"Ship the billing page"`
+	StartDate string `json:"startDate" example:"2026-03-09"`
+	EndDate   string `json:"endDate" example:"2026-03-22"`
+}
+
+type updateSprintBody struct {
+	Name      *string `json:"name" example:"Sprint 14"`
+	Goal      *string `json:"goal" example:"Ship the billing page"`
+	StartDate *string `json:"startDate" example:"2026-03-09"`
+	EndDate   *string `json:"endDate" example:"2026-03-22"`
+}
+
+type completeSprintBody struct {
+	CarryOver string `json:"carryOver" example:"next_sprint" enums:"next_sprint,backlog"`
+}
+
+type sprintResponse struct {
+	ID          string  `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	BoardID     string  `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	Name        string  `json:"name" example:"Sprint 14"`
+	Goal        string  `json:"goal" example:"Ship the billing page"`
+	StartDate   string  `json:"startDate" example:"2026-03-09"`
+	EndDate     string  `json:"endDate" example:"2026-03-22"`
+	CompletedAt *string `json:"completedAt" example:"2026-03-22T17:30:00.000Z"`
+	CreatedAt   string  `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt   string  `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+type sprintReportResponse struct {
+	SprintID      string                        `json:"sprintId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Committed     int                           `json:"committed" example:"8"`
+	Completed     int                           `json:"completed" example:"6"`
+	CompletedAt   *string                       `json:"completedAt" example:"2026-03-22T17:30:00.000Z"`
+	CarriedOverTo *string                       `json:"carriedOverTo" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a8"`
+	CarryOver     []sprintCarryOverTaskResponse `json:"carryOver"`
+}
+
+type sprintCarryOverTaskResponse struct {
+	TaskID string `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	Name   string `json:"name" example:"Write tests"`
+}
+
+type listSprintsResponse = []sprintResponse
+
+// newSprintIDResponse maps the backlog to null.
+func newSprintIDResponse(sprintID domain.SprintID) *string {
+	if sprintID.IsNil() {
+		return nil
+	}
+	value := sprintID.String()
+	return &value
+}
+
+func newCompletedAtResponse(completedAt time.Time) *string {
+	if completedAt.IsZero() {
+		return nil
+	}
+	value := service.FormatRFC3339Millis(completedAt)
+	return &value
+}
+
+func newSprintResponse(sprint *domain.Sprint) sprintResponse {
+	return sprintResponse{
+		ID:          sprint.ID.String(),
+		BoardID:     sprint.BoardID.String(),
+		Name:        sprint.Name.String(),
+		Goal:        sprint.Goal.String(),
+		StartDate:   sprint.StartDate.Format(time.DateOnly),
+		EndDate:     sprint.EndDate.Format(time.DateOnly),
+		CompletedAt: newCompletedAtResponse(sprint.CompletedAt),
+		CreatedAt:   service.FormatRFC3339Millis(sprint.CreatedAt),
+		UpdatedAt:   service.FormatRFC3339Millis(sprint.UpdatedAt),
+	}
+}
+
+func newSprintReportResponse(report *domain.SprintReport) sprintReportResponse {
+	carryOver := make([]sprintCarryOverTaskResponse, len(report.CarryOver))
+	for i, task := range report.CarryOver {
+		carryOver[i] = sprintCarryOverTaskResponse{TaskID: task.TaskID.String(), Name: task.Name.String()}
+	}
+
+	return sprintReportResponse{
+		SprintID:      report.SprintID.String(),
+		Committed:     report.Committed,
+		Completed:     report.Completed,
+		CompletedAt:   newCompletedAtResponse(report.CompletedAt),
+		CarriedOverTo: newSprintIDResponse(report.CarriedOverTo),
+		CarryOver:     carryOver,
+	}
+}
+
+// Create godoc
+// @Summary Create a sprint
+// @Description Create a sprint of the board. Dates are calendar dates in YYYY-MM-DD form; a one-day sprint starts and ends on the same date.
+// @Description Sprints may overlap. Tasks are added to a sprint one by one; tasks in no sprint form the backlog.
+// @Tags sprints
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createSprintBody true "Sprint details"
+// @Success 201 {object} sprintResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints [post]
+func (h *sprints) Create(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	var body createSprintBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	name := httpschema.ValidateField("name", body.Name, domain.NewSprintName, &details)
+	goal := httpschema.ValidateField("goal", body.Goal, domain.NewSprintGoal, &details)
+	startDate := httpschema.ValidateField("startDate", body.StartDate, domain.ParseSprintDate, &details)
+	endDate := httpschema.ValidateField("endDate", body.EndDate, domain.ParseSprintDate, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	sprint, err := h.sprintsService.Create(r.Context(), userID, boardID, name, goal, startDate, endDate)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrSprintDatesInvalid) {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "endDate", Issues: []string{domain.ErrSprintEndBeforeStart}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newSprintResponse(&sprint))
+}
+
+// ListByBoardID godoc
+// @Summary List sprints of a board
+// @Description Get all sprints of a board owned by the current user, ordered by start date.
+// @Tags sprints
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {object} listSprintsResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints [get]
+func (h *sprints) ListByBoardID(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	sprints, err := h.sprintsService.ListByBoardID(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make(listSprintsResponse, len(sprints))
+	for i := range sprints {
+		response[i] = newSprintResponse(&sprints[i])
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// Update godoc
+// @Summary Update a sprint by id
+// @Description Partially update an open sprint. Provided fields are updated; omitted or null fields are ignored. A completed sprint cannot be changed.
+// @Tags sprints
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param sprintId path string true "Sprint ID"
+// @Param body body updateSprintBody true "Sprint fields to update"
+// @Success 200 {object} sprintResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "SPRINT_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "SPRINT_COMPLETED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints/{sprintId} [patch]
+func (h *sprints) Update(w http.ResponseWriter, r *http.Request) {
+	boardID, sprintID, ok := h.parseBoardAndSprintID(w, r)
+	if !ok {
+		return
+	}
+
+	var body updateSprintBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	var patch service.SprintPatch
+	if body.Name != nil {
+		value := httpschema.ValidateField("name", *body.Name, domain.NewSprintName, &details)
+		patch.Name = &value
+	}
+	if body.Goal != nil {
+		value := httpschema.ValidateField("goal", *body.Goal, domain.NewSprintGoal, &details)
+		patch.Goal = &value
+	}
+	if body.StartDate != nil {
+		value := httpschema.ValidateField("startDate", *body.StartDate, domain.ParseSprintDate, &details)
+		patch.StartDate = &value
+	}
+	if body.EndDate != nil {
+		value := httpschema.ValidateField("endDate", *body.EndDate, domain.ParseSprintDate, &details)
+		patch.EndDate = &value
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	sprint, err := h.sprintsService.Update(r.Context(), userID, boardID, sprintID, patch)
+	if err != nil {
+		if errors.Is(err, service.ErrSprintNotFound) {
+			h.responder.SprintNotFound(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrSprintCompleted) {
+			h.responder.SprintCompleted(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint is already completed"}}})
+			return
+		}
+		if errors.Is(err, service.ErrSprintDatesInvalid) {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "endDate", Issues: []string{domain.ErrSprintEndBeforeStart}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newSprintResponse(&sprint))
+}
+
+// Delete godoc
+// @Summary Delete a sprint by id
+// @Description Delete a sprint. Its tasks are returned to the backlog.
+// @Tags sprints
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param sprintId path string true "Sprint ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "SPRINT_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints/{sprintId} [delete]
+func (h *sprints) Delete(w http.ResponseWriter, r *http.Request) {
+	boardID, sprintID, ok := h.parseBoardAndSprintID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err := h.sprintsService.Delete(r.Context(), userID, boardID, sprintID)
+	if err != nil {
+		if errors.Is(err, service.ErrSprintNotFound) {
+			h.responder.SprintNotFound(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// AddTask godoc
+// @Summary Add a task to a sprint
+// @Description Add a task of the board to an open sprint. A task is in at most one sprint, so a task planned in another sprint is moved.
+// @Tags sprints
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param sprintId path string true "Sprint ID"
+// @Param taskId path string true "Task ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "SPRINT_NOT_FOUND or TASK_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "SPRINT_COMPLETED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints/{sprintId}/tasks/{taskId} [put]
+func (h *sprints) AddTask(w http.ResponseWriter, r *http.Request) {
+	boardID, sprintID, taskID, ok := h.parseSprintTaskPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err := h.sprintsService.AddTask(r.Context(), userID, boardID, sprintID, taskID)
+	if err != nil {
+		h.handleSprintTaskError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RemoveTask godoc
+// @Summary Remove a task from a sprint
+// @Description Return a task of an open sprint to the backlog.
+// @Tags sprints
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param sprintId path string true "Sprint ID"
+// @Param taskId path string true "Task ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "SPRINT_NOT_FOUND or TASK_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "SPRINT_COMPLETED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints/{sprintId}/tasks/{taskId} [delete]
+func (h *sprints) RemoveTask(w http.ResponseWriter, r *http.Request) {
+	boardID, sprintID, taskID, ok := h.parseSprintTaskPath(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err := h.sprintsService.RemoveTask(r.Context(), userID, boardID, sprintID, taskID)
+	if err != nil {
+		h.handleSprintTaskError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Complete godoc
+// @Summary Complete a sprint
+// @Description Complete an open sprint and record its report: committed counts every task in the sprint, completed counts those in done columns.
+// @Description Unfinished tasks are carried over to the open sprint that starts next (carryOver next_sprint, the default) or to the backlog (carryOver backlog).
+// @Description Without a next open sprint they go to the backlog. Finished tasks stay in the completed sprint.
+// @Tags sprints
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param sprintId path string true "Sprint ID"
+// @Param body body completeSprintBody true "Where unfinished tasks go"
+// @Success 200 {object} sprintReportResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "SPRINT_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "SPRINT_COMPLETED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints/{sprintId}/complete [post]
+func (h *sprints) Complete(w http.ResponseWriter, r *http.Request) {
+	boardID, sprintID, ok := h.parseBoardAndSprintID(w, r)
+	if !ok {
+		return
+	}
+
+	var body completeSprintBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+	if body.CarryOver == "" {
+		body.CarryOver = domain.SprintCarryOverNextSprint.String()
+	}
+
+	details := []httpschema.Detail{}
+	carryOver := httpschema.ValidateField("carryOver", body.CarryOver, domain.NewSprintCarryOver, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	report, err := h.sprintsService.Complete(r.Context(), userID, boardID, sprintID, carryOver)
+	if err != nil {
+		if errors.Is(err, service.ErrSprintNotFound) {
+			h.responder.SprintNotFound(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrSprintCompleted) {
+			h.responder.SprintCompleted(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint is already completed"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newSprintReportResponse(&report))
+}
+
+// Report godoc
+// @Summary Get a sprint report
+// @Description Get the report recorded when the sprint was completed. For an open sprint the report is a preview of completing it now, with completedAt and carriedOverTo set to null.
+// @Tags sprints
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param sprintId path string true "Sprint ID"
+// @Success 200 {object} sprintReportResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "SPRINT_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/sprints/{sprintId}/report [get]
+func (h *sprints) Report(w http.ResponseWriter, r *http.Request) {
+	boardID, sprintID, ok := h.parseBoardAndSprintID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	report, err := h.sprintsService.Report(r.Context(), userID, boardID, sprintID)
+	if err != nil {
+		if errors.Is(err, service.ErrSprintNotFound) {
+			h.responder.SprintNotFound(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newSprintReportResponse(&report))
+}
+
+func (h *sprints) handleSprintTaskError(w http.ResponseWriter, r *http.Request, err error) {
+	switch {
+	case errors.Is(err, service.ErrSprintNotFound):
+		h.responder.SprintNotFound(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint not found"}}})
+	case errors.Is(err, service.ErrTaskNotFound):
+		h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
+	case errors.Is(err, service.ErrSprintCompleted):
+		h.responder.SprintCompleted(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Sprint is already completed"}}})
+	default:
+		h.responder.InternalError(w, r, err)
+	}
+}
+
+func (h *sprints) parseBoardID(w http.ResponseWriter, r *http.Request) (domain.BoardID, bool) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return domain.BoardID{}, false
+	}
+
+	return boardID, true
+}
+
+func (h *sprints) parseBoardAndSprintID(w http.ResponseWriter, r *http.Request) (boardID domain.BoardID, sprintID domain.SprintID, ok bool) {
+	boardID, ok = h.parseBoardID(w, r)
+	if !ok {
+		return domain.BoardID{}, domain.SprintID{}, false
+	}
+
+	rawSprintID := r.PathValue("sprintId")
+	sprintID, err := domain.ParseSprintID(rawSprintID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "sprintId", Issues: []string{"Invalid sprint id"}}})
+		return domain.BoardID{}, domain.SprintID{}, false
+	}
+
+	return boardID, sprintID, true
+}
+
+func (h *sprints) parseSprintTaskPath(w http.ResponseWriter, r *http.Request) (boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID, ok bool) {
+	boardID, sprintID, ok = h.parseBoardAndSprintID(w, r)
+	if !ok {
+		return domain.BoardID{}, domain.SprintID{}, domain.TaskID{}, false
+	}
+
+	rawTaskID := r.PathValue("taskId")
+	taskID, err := domain.ParseTaskID(rawTaskID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Invalid task id"}}})
+		return domain.BoardID{}, domain.SprintID{}, domain.TaskID{}, false
+	}
+
+	return boardID, sprintID, taskID, true
+}
@@ -0,0 +1,657 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+type sprintsTestCase struct {
+	name               string
+	boardID            string
+	sprintID           string
+	taskID             string
+	inputBody          any
+	context            context.Context
+	setupSprintService func(t *testing.T, s *MockSprintService)
+	wantCode           int
+	wantBody           any
+}
+
+func newSprintsRequest(t *testing.T, tt *sprintsTestCase, method, path string, ownerID domain.UserID) *http.Request {
+	t.Helper()
+
+	var req *http.Request
+	switch body := tt.inputBody.(type) {
+	case nil:
+		req = httptest.NewRequest(method, path, http.NoBody)
+	case string:
+		req = httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+	default:
+		req, _ = testutil.NewJSONRequestAndRecorder(t, method, path, body)
+	}
+
+	ctx := tt.context
+	if ctx == nil {
+		ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, ownerID)
+	}
+	req = req.WithContext(ctx)
+	req.SetPathValue("boardId", tt.boardID)
+	req.SetPathValue("sprintId", tt.sprintID)
+	req.SetPathValue("taskId", tt.taskID)
+
+	return req
+}
+
+func sprintResponseMap(sprint *domain.Sprint) map[string]any {
+	var completedAt any
+	if sprint.IsCompleted() {
+		completedAt = sprint.CompletedAt.Format(testutil.TimeFormat)
+	}
+
+	return map[string]any{
+		"id":          sprint.ID.String(),
+		"boardId":     sprint.BoardID.String(),
+		"name":        sprint.Name.String(),
+		"goal":        sprint.Goal.String(),
+		"startDate":   sprint.StartDate.Format(time.DateOnly),
+		"endDate":     sprint.EndDate.Format(time.DateOnly),
+		"completedAt": completedAt,
+		"createdAt":   sprint.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt":   sprint.UpdatedAt.Format(testutil.TimeFormat),
+	}
+}
+
+func TestSprints_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	validBody := map[string]string{
+		"name":      validSprint.Name.String(),
+		"goal":      validSprint.Goal.String(),
+		"startDate": "2026-01-05",
+		"endDate":   "2026-01-18",
+	}
+
+	tests := []sprintsTestCase{
+		{
+			name:      "Success",
+			boardID:   validBoard.ID.String(),
+			inputBody: validBody,
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.SprintName, goal domain.SprintGoal, startDate time.Time, endDate time.Time) (domain.Sprint, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if name != validSprint.Name || goal != validSprint.Goal {
+						t.Errorf("got name %v and goal %v, want %v and %v", name, goal, validSprint.Name, validSprint.Goal)
+					}
+					if !startDate.Equal(validSprint.StartDate) || !endDate.Equal(validSprint.EndDate) {
+						t.Errorf("got dates %v..%v, want %v..%v", startDate, endDate, validSprint.StartDate, validSprint.EndDate)
+					}
+					return validSprint, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: sprintResponseMap(&validSprint),
+		},
+		{
+			name:      "Invalid fields",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"name": " ", "startDate": "2026-01-05T00:00:00Z", "endDate": "2026-01-18"},
+			wantCode:  http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "name", "issues": []string{domain.ErrSprintNameTooShort}},
+					map[string]any{"field": "startDate", "issues": []string{domain.ErrSprintDateInvalid}},
+				},
+			},
+		},
+		{
+			name:      "End before start",
+			boardID:   validBoard.ID.String(),
+			inputBody: validBody,
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.SprintName, goal domain.SprintGoal, startDate time.Time, endDate time.Time) (domain.Sprint, error) {
+					return domain.Sprint{}, service.ErrSprintDatesInvalid
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("endDate", []string{domain.ErrSprintEndBeforeStart}),
+		},
+		{
+			name:      "Invalid JSON",
+			boardID:   validBoard.ID.String(),
+			inputBody: "{\"name\":\"broken\"",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Board not found",
+			boardID:   validBoard.ID.String(),
+			inputBody: validBody,
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.SprintName, goal domain.SprintGoal, startDate time.Time, endDate time.Time) (domain.Sprint, error) {
+					return domain.Sprint{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:      "Missing context user",
+			boardID:   validBoard.ID.String(),
+			inputBody: validBody,
+			context:   context.Background(),
+			wantCode:  http.StatusUnauthorized,
+			wantBody:  unauthorizedTokenError(),
+		},
+		{
+			name:      "Body too large",
+			boardID:   validBoard.ID.String(),
+			inputBody: testutil.Valid25KBJSON(),
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newSprintsRequest(t, &tt, http.MethodPost, "/v1/boards/"+tt.boardID+"/sprints", validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockSprints := NewMockSprintService(t)
+			if tt.setupSprintService != nil {
+				tt.setupSprintService(t, mockSprints)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSprints(logger, mockSprints, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestSprints_ListByBoardID(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	first := testutil.ValidSprint(validBoard.ID)
+	first.CompletedAt = testutil.FixedNow()
+	second := testutil.ValidSprint(validBoard.ID)
+
+	tests := []sprintsTestCase{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Sprint, error) {
+					return []domain.Sprint{first, second}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{sprintResponseMap(&first), sprintResponseMap(&second)},
+		},
+		{
+			name:    "Empty",
+			boardID: validBoard.ID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Sprint, error) {
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{},
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:    "Board not found",
+			boardID: validBoard.ID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Sprint, error) {
+					return nil, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := newSprintsRequest(t, &tt, http.MethodGet, "/v1/boards/"+tt.boardID+"/sprints", validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockSprints := NewMockSprintService(t)
+			if tt.setupSprintService != nil {
+				tt.setupSprintService(t, mockSprints)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSprints(logger, mockSprints, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.ListByBoardID(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestSprints_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+
+	tests := []sprintsTestCase{
+		{
+			name:      "Success",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{"goal": validSprint.Goal.String(), "endDate": "2026-01-18", "name": nil},
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, patch service.SprintPatch) (domain.Sprint, error) {
+					if sprintID != validSprint.ID {
+						t.Errorf("got sprint id %v, want %v", sprintID, validSprint.ID)
+					}
+					if patch.Name != nil || patch.StartDate != nil {
+						t.Errorf("got name %v and start date %v, want both omitted", patch.Name, patch.StartDate)
+					}
+					if patch.Goal == nil || *patch.Goal != validSprint.Goal {
+						t.Errorf("got goal %v, want %v", patch.Goal, validSprint.Goal)
+					}
+					if patch.EndDate == nil || !patch.EndDate.Equal(validSprint.EndDate) {
+						t.Errorf("got end date %v, want %v", patch.EndDate, validSprint.EndDate)
+					}
+					return validSprint, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: sprintResponseMap(&validSprint),
+		},
+		{
+			name:      "Invalid sprint id",
+			boardID:   validBoard.ID.String(),
+			sprintID:  "not-a-uuid",
+			inputBody: map[string]any{},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("sprintId", []string{"Invalid sprint id"}),
+		},
+		{
+			name:      "Invalid end date",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{"endDate": "18.01.2026"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("endDate", []string{domain.ErrSprintDateInvalid}),
+		},
+		{
+			name:      "Sprint completed",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{"name": "Sprint 15"},
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, patch service.SprintPatch) (domain.Sprint, error) {
+					return domain.Sprint{}, service.ErrSprintCompleted
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: sprintCompletedError(),
+		},
+		{
+			name:      "Sprint not found",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{"name": "Sprint 15"},
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, patch service.SprintPatch) (domain.Sprint, error) {
+					return domain.Sprint{}, service.ErrSprintNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: sprintNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/sprints/" + tt.sprintID
+			req := newSprintsRequest(t, &tt, http.MethodPatch, path, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockSprints := NewMockSprintService(t)
+			if tt.setupSprintService != nil {
+				tt.setupSprintService(t, mockSprints)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSprints(logger, mockSprints, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Update(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestSprints_AddTask(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	taskID := domain.NewTaskID()
+
+	tests := []sprintsTestCase{
+		{
+			name:     "Success",
+			boardID:  validBoard.ID.String(),
+			sprintID: validSprint.ID.String(),
+			taskID:   taskID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.AddTaskFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, gotTaskID domain.TaskID) error {
+					if sprintID != validSprint.ID {
+						t.Errorf("got sprint id %v, want %v", sprintID, validSprint.ID)
+					}
+					if gotTaskID != taskID {
+						t.Errorf("got task id %v, want %v", gotTaskID, taskID)
+					}
+					return nil
+				}
+			},
+			wantCode: http.StatusNoContent,
+			wantBody: nil,
+		},
+		{
+			name:     "Invalid task id",
+			boardID:  validBoard.ID.String(),
+			sprintID: validSprint.ID.String(),
+			taskID:   "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("taskId", []string{"Invalid task id"}),
+		},
+		{
+			name:     "Task not found",
+			boardID:  validBoard.ID.String(),
+			sprintID: validSprint.ID.String(),
+			taskID:   taskID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.AddTaskFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+					return service.ErrTaskNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskNotFoundError("taskId"),
+		},
+		{
+			name:     "Sprint completed",
+			boardID:  validBoard.ID.String(),
+			sprintID: validSprint.ID.String(),
+			taskID:   taskID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.AddTaskFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+					return service.ErrSprintCompleted
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: sprintCompletedError(),
+		},
+		{
+			name:     "Internal error",
+			boardID:  validBoard.ID.String(),
+			sprintID: validSprint.ID.String(),
+			taskID:   taskID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.AddTaskFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+					return errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/sprints/" + tt.sprintID + "/tasks/" + tt.taskID
+			req := newSprintsRequest(t, &tt, http.MethodPut, path, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockSprints := NewMockSprintService(t)
+			if tt.setupSprintService != nil {
+				tt.setupSprintService(t, mockSprints)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSprints(logger, mockSprints, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.AddTask(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			if tt.wantCode != http.StatusNoContent {
+				testutil.AssertContentType(t, rr, "application/json")
+			}
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestSprints_Complete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	nextSprintID := domain.NewSprintID()
+	carriedTask := testutil.ValidTask(domain.NewColumnID())
+	report := domain.SprintReport{
+		SprintID:      validSprint.ID,
+		Committed:     3,
+		Completed:     2,
+		CompletedAt:   testutil.FixedNow(),
+		CarriedOverTo: nextSprintID,
+		CarryOver:     []domain.SprintCarryOverTask{{TaskID: carriedTask.ID, Name: carriedTask.Name}},
+	}
+	reportBody := map[string]any{
+		"sprintId":      validSprint.ID.String(),
+		"committed":     3,
+		"completed":     2,
+		"completedAt":   testutil.FixedNowStr(),
+		"carriedOverTo": nextSprintID.String(),
+		"carryOver": []any{
+			map[string]any{"taskId": carriedTask.ID.String(), "name": carriedTask.Name.String()},
+		},
+	}
+
+	tests := []sprintsTestCase{
+		{
+			name:      "Defaults to next sprint",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{},
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.CompleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, carryOver domain.SprintCarryOver) (domain.SprintReport, error) {
+					if carryOver != domain.SprintCarryOverNextSprint {
+						t.Errorf("got carry-over %q, want %q", carryOver, domain.SprintCarryOverNextSprint)
+					}
+					return report, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: reportBody,
+		},
+		{
+			name:      "Carry over to backlog",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{"carryOver": "backlog"},
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.CompleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, carryOver domain.SprintCarryOver) (domain.SprintReport, error) {
+					if carryOver != domain.SprintCarryOverBacklog {
+						t.Errorf("got carry-over %q, want %q", carryOver, domain.SprintCarryOverBacklog)
+					}
+					backlogReport := report
+					backlogReport.CarriedOverTo = domain.SprintID{}
+					return backlogReport, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"sprintId":      validSprint.ID.String(),
+				"committed":     3,
+				"completed":     2,
+				"completedAt":   testutil.FixedNowStr(),
+				"carriedOverTo": nil,
+				"carryOver": []any{
+					map[string]any{"taskId": carriedTask.ID.String(), "name": carriedTask.Name.String()},
+				},
+			},
+		},
+		{
+			name:      "Invalid carry-over",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{"carryOver": "trash"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("carryOver", []string{domain.ErrSprintCarryOverValue}),
+		},
+		{
+			name:      "Already completed",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{},
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.CompleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, carryOver domain.SprintCarryOver) (domain.SprintReport, error) {
+					return domain.SprintReport{}, service.ErrSprintCompleted
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: sprintCompletedError(),
+		},
+		{
+			name:      "Sprint not found",
+			boardID:   validBoard.ID.String(),
+			sprintID:  validSprint.ID.String(),
+			inputBody: map[string]any{},
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.CompleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID, carryOver domain.SprintCarryOver) (domain.SprintReport, error) {
+					return domain.SprintReport{}, service.ErrSprintNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: sprintNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/sprints/" + tt.sprintID + "/complete"
+			req := newSprintsRequest(t, &tt, http.MethodPost, path, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockSprints := NewMockSprintService(t)
+			if tt.setupSprintService != nil {
+				tt.setupSprintService(t, mockSprints)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSprints(logger, mockSprints, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Complete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestSprints_Report(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+
+	tests := []sprintsTestCase{
+		{
+			name:     "Preview of open sprint",
+			boardID:  validBoard.ID.String(),
+			sprintID: validSprint.ID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.ReportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) (domain.SprintReport, error) {
+					return domain.SprintReport{SprintID: validSprint.ID, Committed: 1, Completed: 1}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"sprintId":      validSprint.ID.String(),
+				"committed":     1,
+				"completed":     1,
+				"completedAt":   nil,
+				"carriedOverTo": nil,
+				"carryOver":     []any{},
+			},
+		},
+		{
+			name:     "Sprint not found",
+			boardID:  validBoard.ID.String(),
+			sprintID: validSprint.ID.String(),
+			setupSprintService: func(t *testing.T, s *MockSprintService) {
+				s.ReportFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) (domain.SprintReport, error) {
+					return domain.SprintReport{}, service.ErrSprintNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: sprintNotFoundError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/sprints/" + tt.sprintID + "/report"
+			req := newSprintsRequest(t, &tt, http.MethodGet, path, validBoard.OwnerID)
+			rr := httptest.NewRecorder()
+			mockSprints := NewMockSprintService(t)
+			if tt.setupSprintService != nil {
+				tt.setupSprintService(t, mockSprints)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSprints(logger, mockSprints, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Report(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
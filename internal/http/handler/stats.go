@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type statsService interface {
+	BoardStats(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error)
+	UserStats(ctx context.Context, callerID domain.UserID) (domain.UserStats, error)
+}
+
+type stats struct {
+	logger       *slog.Logger
+	statsService statsService
+	responder    *httpschema.ErrorResponder
+}
+
+func NewStats(logger *slog.Logger, statsService statsService, responder *httpschema.ErrorResponder) *stats {
+	moduleLogger := logging.WithModule(logger, "handler.stats")
+
+	return &stats{logger: moduleLogger, statsService: statsService, responder: responder}
+}
+
+type boardStatsResponse struct {
+	BoardID string                    `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	AsOf    string                    `json:"asOf" example:"2026-03-07T20:56:50.000+03:00"`
+	Columns []columnTaskCountResponse `json:"columns"`
+	taskStatsResponse
+}
+
+type userStatsResponse struct {
+	AsOf   string `json:"asOf" example:"2026-03-07T20:56:50.000+03:00"`
+	Boards int64  `json:"boards" example:"3"`
+	taskStatsResponse
+}
+
+type columnTaskCountResponse struct {
+	ColumnID string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	Name     string `json:"name" example:"In Progress"`
+	Tasks    int64  `json:"tasks" example:"4"`
+}
+
+type taskStatsResponse struct {
+	Tasks                     int64                 `json:"tasks" example:"12"`
+	OpenTasks                 int64                 `json:"openTasks" example:"9"`
+	Last7Days                 taskActivityResponse  `json:"last7Days"`
+	Last30Days                taskActivityResponse  `json:"last30Days"`
+	AverageOpenTaskAgeSeconds int64                 `json:"averageOpenTaskAgeSeconds" example:"345600"`
+	OldestOpenTasks           []openTaskAgeResponse `json:"oldestOpenTasks"`
+}
+
+type taskActivityResponse struct {
+	Created int64 `json:"created" example:"5"`
+	Moved   int64 `json:"moved" example:"11"`
+	Deleted int64 `json:"deleted" example:"1"`
+}
+
+type openTaskAgeResponse struct {
+	ID         string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	BoardID    string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	ColumnID   string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	Name       string `json:"name" example:"Fix login"`
+	CreatedAt  string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	AgeSeconds int64  `json:"ageSeconds" example:"1209600"`
+}
+
+func newTaskActivityResponse(activity domain.TaskActivity) taskActivityResponse {
+	return taskActivityResponse{Created: activity.Created, Moved: activity.Moved, Deleted: activity.Deleted}
+}
+
+func newTaskStatsResponse(taskStats *domain.TaskStats, asOf time.Time) taskStatsResponse {
+	oldest := make([]openTaskAgeResponse, len(taskStats.OldestOpenTasks))
+	for i, task := range taskStats.OldestOpenTasks {
+		oldest[i] = openTaskAgeResponse{
+			ID:         task.ID.String(),
+			BoardID:    task.BoardID.String(),
+			ColumnID:   task.ColumnID.String(),
+			Name:       task.Name.String(),
+			CreatedAt:  service.FormatRFC3339Millis(task.CreatedAt),
+			AgeSeconds: int64(max(asOf.Sub(task.CreatedAt), 0) / time.Second),
+		}
+	}
+
+	return taskStatsResponse{
+		Tasks:                     taskStats.Tasks,
+		OpenTasks:                 taskStats.OpenTasks,
+		Last7Days:                 newTaskActivityResponse(taskStats.Last7Days),
+		Last30Days:                newTaskActivityResponse(taskStats.Last30Days),
+		AverageOpenTaskAgeSeconds: int64(taskStats.AverageOpenTaskAge / time.Second),
+		OldestOpenTasks:           oldest,
+	}
+}
+
+func newBoardStatsResponse(boardStats *domain.BoardStats) boardStatsResponse {
+	columns := make([]columnTaskCountResponse, len(boardStats.Columns))
+	for i, column := range boardStats.Columns {
+		columns[i] = columnTaskCountResponse{ColumnID: column.ColumnID.String(), Name: column.Name.String(), Tasks: column.Tasks}
+	}
+
+	return boardStatsResponse{
+		BoardID:           boardStats.BoardID.String(),
+		AsOf:              service.FormatRFC3339Millis(boardStats.AsOf),
+		Columns:           columns,
+		taskStatsResponse: newTaskStatsResponse(&boardStats.TaskStats, boardStats.AsOf),
+	}
+}
+
+func newUserStatsResponse(userStats *domain.UserStats) userStatsResponse {
+	return userStatsResponse{
+		AsOf:              service.FormatRFC3339Millis(userStats.AsOf),
+		Boards:            userStats.Boards,
+		taskStatsResponse: newTaskStatsResponse(&userStats.TaskStats, userStats.AsOf),
+	}
+}
+
+// BoardStats godoc
+// @Summary Get board statistics
+// @Description Get the number of tasks in every column of a board, the tasks created, moved to another column and deleted over the last 7 and 30 days including today (UTC), the average age of open tasks and the 5 oldest open tasks. A task is open while it is not in a done column. Deleting a task with its subtasks counts every deleted task.
+// @Tags stats
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {object} boardStatsResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/stats [get]
+func (h *stats) BoardStats(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	boardStats, err := h.statsService.BoardStats(r.Context(), userID, boardID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrBoardNotFound):
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+		default:
+			h.responder.InternalError(w, r, err)
+		}
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newBoardStatsResponse(&boardStats))
+}
+
+// UserStats godoc
+// @Summary Get statistics of the current user
+// @Description Get the statistics of all boards owned by the current user: the number of boards and tasks, the tasks created, moved to another column and deleted over the last 7 and 30 days including today (UTC), the average age of open tasks and the 5 oldest open tasks across the boards.
+// @Tags stats
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} userStatsResponse
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/users/me/stats [get]
+func (h *stats) UserStats(w http.ResponseWriter, r *http.Request) {
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	userStats, err := h.statsService.UserStats(r.Context(), userID)
+	if err != nil {
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newUserStatsResponse(&userStats))
+}
@@ -0,0 +1,279 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func validTaskStats(t *testing.T, boardID domain.BoardID, columnID domain.ColumnID, asOf time.Time) domain.TaskStats {
+	t.Helper()
+
+	oldest := testutil.NewValidTask(t, columnID, "Fix login", "", 1)
+
+	return domain.TaskStats{
+		Tasks:              4,
+		OpenTasks:          3,
+		Last7Days:          domain.TaskActivity{Created: 2, Moved: 5, Deleted: 1},
+		Last30Days:         domain.TaskActivity{Created: 6, Moved: 9, Deleted: 2},
+		AverageOpenTaskAge: 36*time.Hour + 500*time.Millisecond,
+		OldestOpenTasks: []domain.OpenTask{
+			{ID: oldest.ID, BoardID: boardID, ColumnID: columnID, Name: oldest.Name, CreatedAt: asOf.Add(-48 * time.Hour)},
+		},
+	}
+}
+
+func TestStats_BoardStats(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todo := testutil.NewValidColumn(t, validBoard.ID, "To Do", 1)
+	done := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	asOf := testutil.FixedNow()
+	boardStats := domain.BoardStats{
+		BoardID: validBoard.ID,
+		AsOf:    asOf,
+		Columns: []domain.ColumnTaskCount{
+			{ColumnID: todo.ID, Name: todo.Name, Tasks: 3},
+			{ColumnID: done.ID, Name: done.Name, Tasks: 1},
+		},
+		TaskStats: validTaskStats(t, validBoard.ID, todo.ID, asOf),
+	}
+
+	tests := []struct {
+		name              string
+		boardID           string
+		context           context.Context
+		setupStatsService func(t *testing.T, s *MockStatsService)
+		wantCode          int
+		wantBody          any
+	}{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			setupStatsService: func(t *testing.T, s *MockStatsService) {
+				s.BoardStatsFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					return boardStats, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId": validBoard.ID.String(),
+				"asOf":    service.FormatRFC3339Millis(asOf),
+				"columns": []any{
+					map[string]any{"columnId": todo.ID.String(), "name": "To Do", "tasks": 3},
+					map[string]any{"columnId": done.ID.String(), "name": "Done", "tasks": 1},
+				},
+				"tasks":                     4,
+				"openTasks":                 3,
+				"last7Days":                 map[string]any{"created": 2, "moved": 5, "deleted": 1},
+				"last30Days":                map[string]any{"created": 6, "moved": 9, "deleted": 2},
+				"averageOpenTaskAgeSeconds": 129600,
+				"oldestOpenTasks": []any{
+					map[string]any{
+						"id":         boardStats.OldestOpenTasks[0].ID.String(),
+						"boardId":    validBoard.ID.String(),
+						"columnId":   todo.ID.String(),
+						"name":       "Fix login",
+						"createdAt":  service.FormatRFC3339Millis(asOf.Add(-48 * time.Hour)),
+						"ageSeconds": 172800,
+					},
+				},
+			},
+		},
+		{
+			name:    "Empty board",
+			boardID: validBoard.ID.String(),
+			setupStatsService: func(t *testing.T, s *MockStatsService) {
+				s.BoardStatsFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error) {
+					return domain.BoardStats{BoardID: boardID, AsOf: asOf, TaskStats: domain.TaskStats{OldestOpenTasks: []domain.OpenTask{}}}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"boardId":                   validBoard.ID.String(),
+				"asOf":                      service.FormatRFC3339Millis(asOf),
+				"columns":                   []any{},
+				"tasks":                     0,
+				"openTasks":                 0,
+				"last7Days":                 map[string]any{"created": 0, "moved": 0, "deleted": 0},
+				"last30Days":                map[string]any{"created": 0, "moved": 0, "deleted": 0},
+				"averageOpenTaskAgeSeconds": 0,
+				"oldestOpenTasks":           []any{},
+			},
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "nope",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:    "Board not found",
+			boardID: validBoard.ID.String(),
+			setupStatsService: func(t *testing.T, s *MockStatsService) {
+				s.BoardStatsFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error) {
+					return domain.BoardStats{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:     "Missing context user",
+			boardID:  validBoard.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			setupStatsService: func(t *testing.T, s *MockStatsService) {
+				s.BoardStatsFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error) {
+					return domain.BoardStats{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/boards/"+tt.boardID+"/stats", http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+			rr := httptest.NewRecorder()
+			mockStats := NewMockStatsService(t)
+			if tt.setupStatsService != nil {
+				tt.setupStatsService(t, mockStats)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewStats(logger, mockStats, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.BoardStats(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestStats_UserStats(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	column := testutil.NewValidColumn(t, validBoard.ID, "To Do", 1)
+	asOf := testutil.FixedNow()
+	userStats := domain.UserStats{
+		UserID:    validBoard.OwnerID,
+		AsOf:      asOf,
+		Boards:    2,
+		TaskStats: validTaskStats(t, validBoard.ID, column.ID, asOf),
+	}
+
+	tests := []struct {
+		name              string
+		context           context.Context
+		setupStatsService func(t *testing.T, s *MockStatsService)
+		wantCode          int
+		wantBody          any
+	}{
+		{
+			name: "Success",
+			setupStatsService: func(t *testing.T, s *MockStatsService) {
+				s.UserStatsFunc = func(ctx context.Context, callerID domain.UserID) (domain.UserStats, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					return userStats, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"asOf":                      service.FormatRFC3339Millis(asOf),
+				"boards":                    2,
+				"tasks":                     4,
+				"openTasks":                 3,
+				"last7Days":                 map[string]any{"created": 2, "moved": 5, "deleted": 1},
+				"last30Days":                map[string]any{"created": 6, "moved": 9, "deleted": 2},
+				"averageOpenTaskAgeSeconds": 129600,
+				"oldestOpenTasks": []any{
+					map[string]any{
+						"id":         userStats.OldestOpenTasks[0].ID.String(),
+						"boardId":    validBoard.ID.String(),
+						"columnId":   column.ID.String(),
+						"name":       "Fix login",
+						"createdAt":  service.FormatRFC3339Millis(asOf.Add(-48 * time.Hour)),
+						"ageSeconds": 172800,
+					},
+				},
+			},
+		},
+		{
+			name:     "Missing context user",
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name: "Internal error",
+			setupStatsService: func(t *testing.T, s *MockStatsService) {
+				s.UserStatsFunc = func(ctx context.Context, callerID domain.UserID) (domain.UserStats, error) {
+					return domain.UserStats{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/users/me/stats", http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			rr := httptest.NewRecorder()
+			mockStats := NewMockStatsService(t)
+			if tt.setupStatsService != nil {
+				tt.setupStatsService(t, mockStats)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewStats(logger, mockStats, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.UserStats(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
@@ -0,0 +1,499 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+// syncMaxBodySize bounds a push, enough for the largest operations at the maximum count.
+const syncMaxBodySize = 256 << 10
+
+type syncService interface {
+	Changes(ctx context.Context, callerID domain.UserID, since *domain.BoardEventCursor) (domain.SyncChanges, error)
+	Push(ctx context.Context, callerID domain.UserID, operations []domain.SyncOperation) []service.SyncResult
+}
+
+type deltaSync struct {
+	logger      *slog.Logger
+	syncService syncService
+	responder   *httpschema.ErrorResponder
+}
+
+func NewSync(logger *slog.Logger, syncService syncService, responder *httpschema.ErrorResponder) *deltaSync {
+	moduleLogger := logging.WithModule(logger, "handler.sync")
+
+	return &deltaSync{logger: moduleLogger, syncService: syncService, responder: responder}
+}
+
+type syncChangesResponse struct {
+	// Cursor is the since of the next pull.
+	Cursor string `json:"cursor" example:"742-1093"`
+	// HasMore tells that more changes can be pulled right away.
+	HasMore bool `json:"hasMore" example:"false"`
+	// Full is set when the response holds everything the user has, to replace the replica with.
+	Full    bool                    `json:"full" example:"false"`
+	Boards  []boardResponse         `json:"boards"`
+	Columns []columnResponse        `json:"columns"`
+	Tasks   []taskResponse          `json:"tasks"`
+	Deleted []syncTombstoneResponse `json:"deleted"`
+}
+
+// syncTombstoneResponse is a board, column or task that was deleted.
+type syncTombstoneResponse struct {
+	Entity  string `json:"entity" example:"task" enums:"board,column,task"`
+	ID      string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	BoardID string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	// ColumnID is the column a deleted task was in, null for boards and columns.
+	ColumnID *string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	// Version is the last version the entity had.
+	Version   int64  `json:"version" example:"4"`
+	DeletedAt string `json:"deletedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newSyncChangesResponse(changes *domain.SyncChanges) syncChangesResponse {
+	response := syncChangesResponse{
+		Cursor:  changes.Cursor.String(),
+		HasMore: changes.HasMore,
+		Full:    changes.Full,
+		Boards:  make([]boardResponse, len(changes.Boards)),
+		Columns: make([]columnResponse, len(changes.Columns)),
+		Tasks:   make([]taskResponse, len(changes.Tasks)),
+		Deleted: make([]syncTombstoneResponse, len(changes.Deleted)),
+	}
+	for i := range changes.Boards {
+		response.Boards[i] = newBoardResponse(&changes.Boards[i])
+	}
+	for i := range changes.Columns {
+		response.Columns[i] = newColumnResponse(&changes.Columns[i])
+	}
+	for i := range changes.Tasks {
+		response.Tasks[i] = newTaskResponse(&changes.Tasks[i])
+	}
+	for i := range changes.Deleted {
+		response.Deleted[i] = newSyncTombstoneResponse(&changes.Deleted[i])
+	}
+
+	return response
+}
+
+func newSyncTombstoneResponse(event *domain.BoardEvent) syncTombstoneResponse {
+	response := syncTombstoneResponse{
+		Entity:    event.Entity.String(),
+		ID:        event.BoardID.String(),
+		BoardID:   event.BoardID.String(),
+		Version:   event.Version.Int64(),
+		DeletedAt: service.FormatRFC3339Millis(event.OccurredAt),
+	}
+	switch event.Entity {
+	case domain.BoardEventColumn:
+		response.ID = event.ColumnID.String()
+	case domain.BoardEventTask:
+		response.ID = event.TaskID.String()
+		columnID := event.ColumnID.String()
+		response.ColumnID = &columnID
+	}
+
+	return response
+}
+
+// Pull godoc
+// @Summary Pull changes for a local replica
+// @Description Every board, column and task of the current user that was created, changed or deleted after the since cursor, for a client keeping a replica to work offline.
+// @Description Changed entities come as they are now, once however often they changed; deleted ones come in deleted. A deleted board or column takes its columns and tasks with it: only the board or column is listed. Tasks include archived ones.
+// @Description Moving a task or column changes the ones shifted to make room as well, so they come at their new positions along with it. The same goes for the ones shifted when another is deleted, archived or duplicated.
+// @Description Without since, the response is full: everything the user has, to replace the replica with. Pass the returned cursor as since next time, right away while hasMore is set.
+// @Description A change may come again in a later pull; the version tells whether the replica has it already.
+// @Tags sync
+// @Produce json
+// @Security BearerAuth
+// @Param since query string false "Cursor returned by the last pull"
+// @Success 200 {object} syncChangesResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/sync [get]
+func (h *deltaSync) Pull(w http.ResponseWriter, r *http.Request) {
+	var since *domain.BoardEventCursor
+	if raw := r.URL.Query().Get("since"); raw != "" {
+		cursor, err := domain.ParseBoardEventCursor(raw)
+		if err != nil {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "since", Issues: []string{"Invalid cursor"}}})
+			return
+		}
+		since = &cursor
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	changes, err := h.syncService.Changes(r.Context(), userID, since)
+	if err != nil {
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newSyncChangesResponse(&changes))
+}
+
+type syncPushBody struct {
+	Operations []syncOperationBody `json:"operations"`
+}
+
+// syncOperationBody is a change made offline. Only the fields of its type are read, and an
+// entity is named either by its id or by the ref of the operation of this push that creates it.
+type syncOperationBody struct {
+	Type string `json:"type" example:"task.move" enums:"board.create,board.update,board.delete,column.create,column.update,column.move,column.delete,task.create,task.update,task.move,task.delete"`
+	// Ref labels the operation in the results; the entity a create adds is named by it in later operations.
+	Ref string `json:"ref" example:"new-task-1"`
+	// BaseVersion is the version of the entity the change was made on. Without it the change is applied over any other.
+	BaseVersion *int64 `json:"baseVersion" example:"3"`
+
+	BoardID         string `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	BoardRef        string `json:"boardRef" example:"new-board-1"`
+	ColumnID        string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	ColumnRef       string `json:"columnRef" example:"new-column-1"`
+	TaskID          string `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	TaskRef         string `json:"taskRef" example:"new-task-1"`
+	TargetColumnID  string `json:"targetColumnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	TargetColumnRef string `json:"targetColumnRef" example:"new-column-1"`
+	TargetPosition  int64  `json:"targetPosition" example:"1"`
+
+	Name        *string                 `json:"name" example:"Rewrite tests"`
+	Description *string                 `json:"description" example:"Cover edge cases"`
+	Checklist   []taskChecklistItemBody `json:"checklist"`
+	Estimate    json.RawMessage         `json:"estimate" swaggertype:"number" example:"5"`
+}
+
+type syncPushResponse struct {
+	Results []syncResultResponse `json:"results"`
+}
+
+type syncResultResponse struct {
+	Ref    string `json:"ref" example:"new-task-1"`
+	Type   string `json:"type" example:"task.move"`
+	Status string `json:"status" example:"applied" enums:"applied,conflict,gone,rejected,skipped,failed"`
+	// Board, Column or Task is the entity as the operation left it, or as the server has it on a conflict.
+	Board  *boardResponse  `json:"board"`
+	Column *columnResponse `json:"column"`
+	Task   *taskResponse   `json:"task"`
+	// Error tells why a conflicting, gone, rejected or failed operation was not applied.
+	Error *syncErrorResponse `json:"error"`
+}
+
+type syncErrorResponse struct {
+	Code    string              `json:"code" example:"VERSION_CONFLICT"`
+	Message string              `json:"message" example:"Resource has changed since the given version"`
+	Details []httpschema.Detail `json:"details"`
+}
+
+// parseSyncOperation reads an operation of a push, reporting its problems under prefix. Refs
+// holds the ref of every operation before it, with the entity of the creates among them.
+func parseSyncOperation(
+	body *syncOperationBody,
+	prefix string,
+	refs map[string]domain.BoardEventEntity,
+	details *[]httpschema.Detail,
+) domain.SyncOperation {
+	var operation domain.SyncOperation
+
+	operation.Type = httpschema.ValidateField(prefix+"type", body.Type, domain.NewSyncOperationType, details)
+	operation.Ref = httpschema.ValidateField(prefix+"ref", body.Ref, domain.NewSyncRef, details)
+	if _, used := refs[operation.Ref]; used && operation.Ref != "" {
+		*details = append(*details, httpschema.Detail{Field: prefix + "ref", Issues: []string{domain.ErrSyncRefValue}})
+	}
+	if body.BaseVersion != nil {
+		operation.BaseVersion = httpschema.ValidateField(prefix+"baseVersion", *body.BaseVersion, domain.NewVersion, details)
+	}
+
+	board := func() {
+		operation.BoardID, operation.BoardRef = parseSyncID(
+			body.BoardID, body.BoardRef, prefix, "board", domain.BoardEventBoard, refs, domain.ParseBoardID, details,
+		)
+	}
+	column := func() {
+		operation.ColumnID, operation.ColumnRef = parseSyncID(
+			body.ColumnID, body.ColumnRef, prefix, "column", domain.BoardEventColumn, refs, domain.ParseColumnID, details,
+		)
+	}
+	task := func() {
+		operation.TaskID, operation.TaskRef = parseSyncID(
+			body.TaskID, body.TaskRef, prefix, "task", domain.BoardEventTask, refs, domain.ParseTaskID, details,
+		)
+	}
+
+	switch operation.Type {
+	case domain.SyncBoardCreate:
+		operation.BoardName = parseSyncField(prefix+"name", valueOrEmpty(body.Name), domain.NewBoardName, details)
+		if body.Description != nil {
+			operation.BoardDescription = parseSyncField(prefix+"description", *body.Description, domain.NewBoardDescription, details)
+		}
+	case domain.SyncBoardUpdate:
+		board()
+		if body.Name != nil {
+			operation.BoardName = parseSyncField(prefix+"name", *body.Name, domain.NewBoardName, details)
+		}
+		if body.Description != nil {
+			operation.BoardDescription = parseSyncField(prefix+"description", *body.Description, domain.NewBoardDescription, details)
+		}
+	case domain.SyncBoardDelete:
+		board()
+	case domain.SyncColumnCreate:
+		board()
+		operation.ColumnName = parseSyncField(prefix+"name", valueOrEmpty(body.Name), domain.NewColumnName, details)
+		if body.Description != nil {
+			operation.ColumnDescription = parseSyncField(prefix+"description", *body.Description, domain.NewColumnDescription, details)
+		}
+	case domain.SyncColumnUpdate:
+		column()
+		if body.Name != nil {
+			operation.ColumnName = parseSyncField(prefix+"name", *body.Name, domain.NewColumnName, details)
+		}
+		if body.Description != nil {
+			operation.ColumnDescription = parseSyncField(prefix+"description", *body.Description, domain.NewColumnDescription, details)
+		}
+	case domain.SyncColumnMove:
+		column()
+		operation.ColumnPosition = httpschema.ValidateField(prefix+"targetPosition", body.TargetPosition, domain.NewColumnPosition, details)
+	case domain.SyncColumnDelete:
+		column()
+	case domain.SyncTaskCreate:
+		column()
+		operation.TaskName = parseSyncField(prefix+"name", valueOrEmpty(body.Name), domain.NewTaskName, details)
+		parseSyncTaskAttributes(body, prefix, &operation, details)
+	case domain.SyncTaskUpdate:
+		task()
+		if body.Name != nil {
+			operation.TaskName = parseSyncField(prefix+"name", *body.Name, domain.NewTaskName, details)
+		}
+		parseSyncTaskAttributes(body, prefix, &operation, details)
+	case domain.SyncTaskMove:
+		task()
+		operation.TargetColumnID, operation.TargetColumnRef = parseSyncID(
+			body.TargetColumnID, body.TargetColumnRef, prefix, "targetColumn", domain.BoardEventColumn, refs, domain.ParseColumnID, details,
+		)
+		operation.TaskPosition = httpschema.ValidateField(prefix+"targetPosition", body.TargetPosition, domain.NewTaskPosition, details)
+	case domain.SyncTaskDelete:
+		task()
+	}
+
+	return operation
+}
+
+func parseSyncTaskAttributes(body *syncOperationBody, prefix string, operation *domain.SyncOperation, details *[]httpschema.Detail) {
+	if body.Description != nil {
+		operation.TaskDescription = parseSyncField(prefix+"description", *body.Description, domain.NewTaskDescription, details)
+	}
+	if body.Checklist != nil {
+		operation.Checklist = parseSyncField(prefix+"checklist", body.Checklist, newTaskChecklistFromBody, details)
+	}
+	estimate, err := parseTaskEstimatePatch(body.Estimate)
+	if err != nil {
+		*details = append(*details, httpschema.Detail{Field: prefix + "estimate", Issues: []string{domain.ErrTaskEstimateValue}})
+	}
+	operation.Estimate = estimate
+}
+
+// parseSyncID reads an entity named either by its id in the <name>Id field or by the ref of an
+// earlier create in the <name>Ref field.
+func parseSyncID[ID any](
+	rawID, ref, prefix, name string,
+	entity domain.BoardEventEntity,
+	refs map[string]domain.BoardEventEntity,
+	parse func(string) (ID, error),
+	details *[]httpschema.Detail,
+) (ID, string) {
+	var id ID
+
+	if ref != "" {
+		if rawID != "" {
+			*details = append(*details, httpschema.Detail{Field: prefix + name + "Ref", Issues: []string{"Either " + name + "Id or " + name + "Ref must be given, not both"}})
+		} else if refs[ref] != entity {
+			*details = append(*details, httpschema.Detail{Field: prefix + name + "Ref", Issues: []string{"Ref must be one of an earlier " + entity.String() + ".create operation"}})
+		}
+		return id, ref
+	}
+
+	id, err := parse(rawID)
+	if err != nil {
+		*details = append(*details, httpschema.Detail{Field: prefix + name + "Id", Issues: []string{"Invalid " + entity.String() + " id"}})
+	}
+
+	return id, ""
+}
+
+// parseSyncField validates a field set by the operation.
+func parseSyncField[T any, V any](field string, value V, constructor func(V) (T, error), details *[]httpschema.Detail) *T {
+	parsed := httpschema.ValidateField(field, value, constructor, details)
+	return &parsed
+}
+
+func valueOrEmpty(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func newSyncResultResponse(result *service.SyncResult) syncResultResponse {
+	response := syncResultResponse{Ref: result.Ref, Type: result.Type.String(), Status: result.Status.String()}
+
+	// A delete leaves nothing to return, unless it conflicts and the entity stays as the server has it.
+	deleted := result.Type == domain.SyncBoardDelete || result.Type == domain.SyncColumnDelete || result.Type == domain.SyncTaskDelete
+	if result.Status == domain.SyncConflict || result.Status == domain.SyncApplied && !deleted {
+		switch result.Type.Entity() {
+		case domain.BoardEventBoard:
+			board := newBoardResponse(&result.Board)
+			response.Board = &board
+		case domain.BoardEventColumn:
+			column := newColumnResponse(&result.Column)
+			response.Column = &column
+		case domain.BoardEventTask:
+			task := newTaskResponse(&result.Task)
+			response.Task = &task
+		}
+	}
+
+	if result.Err != nil {
+		response.Error = newSyncErrorResponse(result.Err)
+	}
+
+	return response
+}
+
+// newSyncErrorResponse reports why an operation was not applied the way its single-entity
+// endpoint would.
+func newSyncErrorResponse(err error) *syncErrorResponse {
+	var (
+		code          string
+		details       []httpschema.Detail
+		blockedErr    *service.TaskBlockedError
+		transitionErr *service.TransitionNotAllowedError
+	)
+	switch {
+	case errors.Is(err, service.ErrVersionConflict):
+		code = "VERSION_CONFLICT"
+		details = []httpschema.Detail{{Field: "baseVersion", Issues: []string{"Changed since this version"}}}
+	case errors.Is(err, service.ErrBoardNotFound):
+		code = "BOARD_NOT_FOUND"
+		details = []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}}
+	case errors.Is(err, service.ErrColumnNotFound):
+		code = "COLUMN_NOT_FOUND"
+		details = []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}}
+	case errors.Is(err, service.ErrTaskNotFound):
+		code = "TASK_NOT_FOUND"
+		details = []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}}
+	case errors.Is(err, service.ErrLaneNotFound):
+		code = "LANE_NOT_FOUND"
+		details = []httpschema.Detail{{Field: "taskId", Issues: []string{"Lane not found"}}}
+	case errors.Is(err, service.ErrIndexOutOfBounds):
+		code = "VALIDATION_ERROR"
+		details = []httpschema.Detail{{Field: "targetPosition", Issues: []string{"Index out of bounds"}}}
+	case errors.Is(err, service.ErrTaskArchived):
+		code = "TASK_ARCHIVED"
+		details = []httpschema.Detail{{Field: "taskId", Issues: []string{"Task is archived"}}}
+	case errors.As(err, &blockedErr):
+		blockerIDs := make([]string, len(blockedErr.BlockerIDs))
+		for i, blockerID := range blockedErr.BlockerIDs {
+			blockerIDs[i] = blockerID.String()
+		}
+		code = "TASK_BLOCKED"
+		details = []httpschema.Detail{{Field: "blockedBy", Issues: blockerIDs}}
+	case errors.As(err, &transitionErr):
+		code = "TRANSITION_NOT_ALLOWED"
+		details = newTransitionDetails(transitionErr)
+	default:
+		code = "INTERNAL_SERVER_ERROR"
+		details = []httpschema.Detail{}
+	}
+
+	return &syncErrorResponse{Code: code, Message: httpschema.DescribeCode(code), Details: details}
+}
+
+// Push godoc
+// @Summary Push changes made offline
+// @Description Apply the queue of operations a client made while offline, in order. Each operation is applied on its own, checked like its single-entity endpoint, and gets a result of its own with the entity as it left it; one that is not applied doesn't stop the others.
+// @Description Entities created by the push have no id the client knows: name them in later operations by the ref of their create, in boardRef, columnRef, taskRef or targetColumnRef instead of the id.
+// @Description Conflicts are resolved on the server. An operation with a baseVersion the entity no longer has is a conflict: the change of the server wins, nothing is written and the result holds the entity as the server has it. Without baseVersion the operation is applied over any change.
+// @Description A deletion wins over every other change: an operation on an entity, or into a board or column, that was deleted is gone, and so is deleting what was deleted already. Operations that break a rule of the board, like its workflow or a position past the end of a column, are rejected.
+// @Description Operations on an entity whose create was not applied are skipped. If the server fails on an operation, it is failed and the rest are skipped, to be pushed again.
+// @Description Tasks are created in the default lane and move within their lane. At most 100 operations are allowed and the request is limited to 256 KB.
+// @Tags sync
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body syncPushBody true "Operations to apply in order"
+// @Success 200 {object} syncPushResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/sync [post]
+func (h *deltaSync) Push(w http.ResponseWriter, r *http.Request) {
+	var body syncPushBody
+	err := decodeJSONWithLimit(r, &body, syncMaxBodySize)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	if len(body.Operations) == 0 || len(body.Operations) > domain.MaxSyncOperations {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "operations", Issues: []string{domain.ErrSyncOperationCount}}})
+		return
+	}
+
+	details := []httpschema.Detail{}
+	refs := make(map[string]domain.BoardEventEntity)
+	operations := make([]domain.SyncOperation, len(body.Operations))
+	for i := range body.Operations {
+		operations[i] = parseSyncOperation(&body.Operations[i], fmt.Sprintf("operations[%d].", i), refs, &details)
+		if operations[i].Ref == "" {
+			continue
+		}
+		// Only the entity of a create can be referred to.
+		refs[operations[i].Ref] = ""
+		if operations[i].Type.IsCreate() {
+			refs[operations[i].Ref] = operations[i].Type.Entity()
+		}
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	results := h.syncService.Push(r.Context(), userID, operations)
+
+	response := syncPushResponse{Results: make([]syncResultResponse, len(results))}
+	for i := range results {
+		if results[i].Status == domain.SyncFailed {
+			h.logger.ErrorContext(
+				r.Context(), "Sync operation failed", slog.Int("index", i), slog.String("err", results[i].Err.Error()),
+			)
+		}
+		response.Results[i] = newSyncResultResponse(&results[i])
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
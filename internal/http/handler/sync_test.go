@@ -0,0 +1,458 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestSync_Pull(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	deletedTask := domain.BoardEvent{
+		Cursor:     domain.NewBoardEventCursor(742, 1093),
+		Entity:     domain.BoardEventTask,
+		Action:     domain.BoardEventDeleted,
+		BoardID:    validBoard.ID,
+		ColumnID:   validColumn.ID,
+		TaskID:     domain.NewTaskID(),
+		Version:    validBoard.Version,
+		OccurredAt: testutil.FixedNow(),
+	}
+	deletedColumn := domain.BoardEvent{
+		Cursor:     domain.NewBoardEventCursor(742, 1094),
+		Entity:     domain.BoardEventColumn,
+		Action:     domain.BoardEventDeleted,
+		BoardID:    validBoard.ID,
+		ColumnID:   domain.NewColumnID(),
+		Version:    validBoard.Version,
+		OccurredAt: testutil.FixedNow(),
+	}
+	since := domain.NewBoardEventCursor(700, 1000)
+
+	tests := []struct {
+		name             string
+		query            string
+		setupSyncService func(t *testing.T, s *MockSyncService)
+		wantCode         int
+		wantBody         any
+	}{
+		{
+			name:  "Success",
+			query: "?since=700-1000",
+			setupSyncService: func(t *testing.T, s *MockSyncService) {
+				s.ChangesFunc = func(ctx context.Context, callerID domain.UserID, gotSince *domain.BoardEventCursor) (domain.SyncChanges, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if gotSince == nil || *gotSince != since {
+						t.Errorf("got since %v, want %v", gotSince, since)
+					}
+					return domain.SyncChanges{
+						Cursor:  domain.NewBoardEventCursor(743, 0),
+						Columns: []domain.Column{validColumn},
+						Deleted: []domain.BoardEvent{deletedTask, deletedColumn},
+					}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"cursor":  "743-0",
+				"hasMore": false,
+				"full":    false,
+				"boards":  []any{},
+				"columns": []any{map[string]any{
+					"id":                 validColumn.ID.String(),
+					"boardId":            validColumn.BoardID.String(),
+					"name":               validColumn.Name.String(),
+					"description":        validColumn.Description.String(),
+					"position":           validColumn.Position.Int64(),
+					"wipLimit":           validColumn.WIPLimit.Int64(),
+					"slaHours":           validColumn.SLA.Hours(),
+					"archiveAfterDays":   validColumn.ArchiveAfter.Days(),
+					"isStarted":          validColumn.IsStarted,
+					"isDone":             validColumn.IsDone,
+					"allowedTransitions": []any{},
+					"entryConditions":    []any{},
+					"entryRoles":         []any{},
+					"createdAt":          validColumn.CreatedAt.Format(testutil.TimeFormat),
+					"updatedAt":          validColumn.UpdatedAt.Format(testutil.TimeFormat),
+					"version":            validColumn.Version.Int64(),
+				}},
+				"tasks": []any{},
+				"deleted": []any{
+					map[string]any{
+						"entity":    "task",
+						"id":        deletedTask.TaskID.String(),
+						"boardId":   validBoard.ID.String(),
+						"columnId":  validColumn.ID.String(),
+						"version":   validBoard.Version.Int64(),
+						"deletedAt": testutil.FixedNowStr(),
+					},
+					map[string]any{
+						"entity":    "column",
+						"id":        deletedColumn.ColumnID.String(),
+						"boardId":   validBoard.ID.String(),
+						"columnId":  nil,
+						"version":   validBoard.Version.Int64(),
+						"deletedAt": testutil.FixedNowStr(),
+					},
+				},
+			},
+		},
+		{
+			name: "Snapshot without since",
+			setupSyncService: func(t *testing.T, s *MockSyncService) {
+				s.ChangesFunc = func(ctx context.Context, callerID domain.UserID, gotSince *domain.BoardEventCursor) (domain.SyncChanges, error) {
+					if gotSince != nil {
+						t.Errorf("got since %v, want none", gotSince)
+					}
+					return domain.SyncChanges{Cursor: domain.NewBoardEventCursor(743, 0), Full: true, Boards: []domain.Board{validBoard}}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"cursor":  "743-0",
+				"hasMore": false,
+				"full":    true,
+				"boards": []any{map[string]any{
+					"id":          validBoard.ID.String(),
+					"ownerId":     validBoard.OwnerID.String(),
+					"name":        validBoard.Name.String(),
+					"description": validBoard.Description.String(),
+					"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
+					"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+					"version":     validBoard.Version.Int64(),
+				}},
+				"columns": []any{},
+				"tasks":   []any{},
+				"deleted": []any{},
+			},
+		},
+		{
+			name:     "Invalid cursor",
+			query:    "?since=latest",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("since", []string{"Invalid cursor"}),
+		},
+		{
+			name:  "Internal error",
+			query: "?since=700-1000",
+			setupSyncService: func(t *testing.T, s *MockSyncService) {
+				s.ChangesFunc = func(ctx context.Context, callerID domain.UserID, gotSince *domain.BoardEventCursor) (domain.SyncChanges, error) {
+					return domain.SyncChanges{}, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := httptest.NewRequest(http.MethodGet, "/v1/sync"+tt.query, http.NoBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+
+			rr := httptest.NewRecorder()
+			mockSync := NewMockSyncService(t)
+			if tt.setupSyncService != nil {
+				tt.setupSyncService(t, mockSync)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSync(logger, mockSync, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Pull(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestSync_Push(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	targetColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	targetPosition := testutil.NewValidTaskPosition(t, 1)
+	baseVersion, err := domain.NewVersion(3)
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+
+	createBoard := map[string]any{"type": "board.create", "ref": "board", "name": validBoard.Name.String()}
+	deleteTask := map[string]any{"type": "task.delete", "taskId": validTask.ID.String()}
+	tooManyOperations := make([]any, domain.MaxSyncOperations+1)
+	for i := range tooManyOperations {
+		tooManyOperations[i] = deleteTask
+	}
+
+	boardBody := map[string]any{
+		"id":          validBoard.ID.String(),
+		"ownerId":     validBoard.OwnerID.String(),
+		"name":        validBoard.Name.String(),
+		"description": validBoard.Description.String(),
+		"createdAt":   validBoard.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt":   validBoard.UpdatedAt.Format(testutil.TimeFormat),
+		"version":     validBoard.Version.Int64(),
+	}
+	taskBody := map[string]any{
+		"id":           validTask.ID.String(),
+		"columnId":     validTask.ColumnID.String(),
+		"laneId":       nil,
+		"parentId":     nil,
+		"sprintId":     nil,
+		"estimate":     nil,
+		"customFields": map[string]any{},
+		"name":         validTask.Name.String(),
+		"description":  validTask.Description.String(),
+		"position":     validTask.Position.Int64(),
+		"checklist":    []any{},
+		"links":        []any{},
+		"rollup":       emptyTaskRollup(),
+		"archivedAt":   nil,
+		"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+		"version":      validTask.Version.Int64(),
+	}
+
+	tests := []struct {
+		name             string
+		inputBody        any
+		setupSyncService func(t *testing.T, s *MockSyncService)
+		wantCode         int
+		wantBody         any
+	}{
+		{
+			name: "Success",
+			inputBody: map[string]any{"operations": []any{
+				createBoard,
+				map[string]any{"type": "column.create", "ref": "column", "boardRef": "board", "name": "Todo"},
+				map[string]any{"type": "task.create", "columnRef": "column", "name": "Write docs", "estimate": 5},
+				map[string]any{
+					"type":           "task.move",
+					"taskId":         validTask.ID.String(),
+					"targetColumnId": targetColumn.ID.String(),
+					"targetPosition": targetPosition.Int64(),
+					"baseVersion":    baseVersion.Int64(),
+				},
+			}},
+			setupSyncService: func(t *testing.T, s *MockSyncService) {
+				s.PushFunc = func(ctx context.Context, callerID domain.UserID, operations []domain.SyncOperation) []service.SyncResult {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					columnName := testutil.NewValidColumn(t, validBoard.ID, "Todo", 1).Name
+					taskName := testutil.NewValidTask(t, validColumn.ID, "Write docs", "", 1).Name
+					estimate := testutil.NewValidTaskEstimate(t, 5)
+					want := []domain.SyncOperation{
+						{Ref: "board", Type: domain.SyncBoardCreate, BoardName: &validBoard.Name},
+						{Ref: "column", Type: domain.SyncColumnCreate, BoardRef: "board", ColumnName: &columnName},
+						{Type: domain.SyncTaskCreate, ColumnRef: "column", TaskName: &taskName, Estimate: &estimate},
+						{
+							Type:           domain.SyncTaskMove,
+							BaseVersion:    baseVersion,
+							TaskID:         validTask.ID,
+							TargetColumnID: targetColumn.ID,
+							TaskPosition:   targetPosition,
+						},
+					}
+					if diff := cmp.Diff(want, operations, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("operations mismatch (-want +got):\n%s", diff)
+					}
+					return []service.SyncResult{
+						{Ref: "board", Type: domain.SyncBoardCreate, Status: domain.SyncApplied, Board: validBoard},
+						{Ref: "column", Type: domain.SyncColumnCreate, Status: domain.SyncRejected, Err: service.ErrBoardNotFound},
+						{Type: domain.SyncTaskCreate, Status: domain.SyncSkipped},
+						{Type: domain.SyncTaskMove, Status: domain.SyncConflict, Task: validTask, Err: service.ErrVersionConflict},
+					}
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{"results": []any{
+				map[string]any{"ref": "board", "type": "board.create", "status": "applied", "board": boardBody, "column": nil, "task": nil, "error": nil},
+				map[string]any{
+					"ref": "column", "type": "column.create", "status": "rejected", "board": nil, "column": nil, "task": nil,
+					"error": map[string]any{
+						"code":    "BOARD_NOT_FOUND",
+						"message": "Board not found",
+						"details": []any{map[string]any{"field": "boardId", "issues": []string{"Board not found"}}},
+					},
+				},
+				map[string]any{"ref": "", "type": "task.create", "status": "skipped", "board": nil, "column": nil, "task": nil, "error": nil},
+				map[string]any{
+					"ref": "", "type": "task.move", "status": "conflict", "board": nil, "column": nil, "task": taskBody,
+					"error": map[string]any{
+						"code":    "VERSION_CONFLICT",
+						"message": "Resource has changed since the given version",
+						"details": []any{map[string]any{"field": "baseVersion", "issues": []string{"Changed since this version"}}},
+					},
+				},
+			}},
+		},
+		{
+			name:      "Deleted task is gone",
+			inputBody: map[string]any{"operations": []any{deleteTask}},
+			setupSyncService: func(t *testing.T, s *MockSyncService) {
+				s.PushFunc = func(ctx context.Context, callerID domain.UserID, operations []domain.SyncOperation) []service.SyncResult {
+					return []service.SyncResult{{Type: domain.SyncTaskDelete, Status: domain.SyncGone, Err: service.ErrTaskNotFound}}
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{"results": []any{map[string]any{
+				"ref": "", "type": "task.delete", "status": "gone", "board": nil, "column": nil, "task": nil,
+				"error": map[string]any{
+					"code":    "TASK_NOT_FOUND",
+					"message": "Task not found",
+					"details": []any{map[string]any{"field": "taskId", "issues": []string{"Task not found"}}},
+				},
+			}}},
+		},
+		{
+			name:      "Failed operation",
+			inputBody: map[string]any{"operations": []any{deleteTask, deleteTask}},
+			setupSyncService: func(t *testing.T, s *MockSyncService) {
+				s.PushFunc = func(ctx context.Context, callerID domain.UserID, operations []domain.SyncOperation) []service.SyncResult {
+					return []service.SyncResult{
+						{Type: domain.SyncTaskDelete, Status: domain.SyncFailed, Err: service.ErrInternal},
+						{Type: domain.SyncTaskDelete, Status: domain.SyncSkipped},
+					}
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{"results": []any{
+				map[string]any{
+					"ref": "", "type": "task.delete", "status": "failed", "board": nil, "column": nil, "task": nil,
+					"error": map[string]any{"code": "INTERNAL_SERVER_ERROR", "message": "Internal server error", "details": []any{}},
+				},
+				map[string]any{"ref": "", "type": "task.delete", "status": "skipped", "board": nil, "column": nil, "task": nil, "error": nil},
+			}},
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: "{",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Payload too large",
+			inputBody: `{"operations":[{"type":"board.create","name":"` + strings.Repeat("a", 256<<10) + `"}]}`,
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+		{
+			name:      "No operations",
+			inputBody: map[string]any{"operations": []any{}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations", []string{domain.ErrSyncOperationCount}),
+		},
+		{
+			name:      "Too many operations",
+			inputBody: map[string]any{"operations": tooManyOperations},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations", []string{domain.ErrSyncOperationCount}),
+		},
+		{
+			name:      "Unknown operation type",
+			inputBody: map[string]any{"operations": []any{deleteTask, map[string]any{"type": "task.archive"}}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations[1].type", []string{domain.ErrSyncOperationType}),
+		},
+		{
+			name:      "Ref used twice",
+			inputBody: map[string]any{"operations": []any{createBoard, createBoard}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations[1].ref", []string{domain.ErrSyncRefValue}),
+		},
+		{
+			name: "Ref of a create of another entity",
+			inputBody: map[string]any{"operations": []any{
+				createBoard,
+				map[string]any{"type": "task.create", "columnRef": "board", "name": "Write docs"},
+			}},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[1].columnRef", []string{"Ref must be one of an earlier column.create operation"}),
+		},
+		{
+			name: "Ref of a later create",
+			inputBody: map[string]any{"operations": []any{
+				map[string]any{"type": "column.create", "boardRef": "board", "name": "Todo"},
+				createBoard,
+			}},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[0].boardRef", []string{"Ref must be one of an earlier board.create operation"}),
+		},
+		{
+			name: "Both id and ref",
+			inputBody: map[string]any{"operations": []any{
+				createBoard,
+				map[string]any{"type": "board.delete", "boardId": validBoard.ID.String(), "boardRef": "board"},
+			}},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[1].boardRef", []string{"Either boardId or boardRef must be given, not both"}),
+		},
+		{
+			name:      "Invalid task id",
+			inputBody: map[string]any{"operations": []any{map[string]any{"type": "task.update", "taskId": "invalid", "name": "Write docs"}}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations[0].taskId", []string{"Invalid task id"}),
+		},
+		{
+			name:      "Create without name",
+			inputBody: map[string]any{"operations": []any{map[string]any{"type": "task.create", "columnId": validColumn.ID.String()}}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations[0].name", []string{domain.ErrTaskNameTooShort}),
+		},
+		{
+			name: "Invalid target position",
+			inputBody: map[string]any{"operations": []any{map[string]any{
+				"type":           "task.move",
+				"taskId":         validTask.ID.String(),
+				"targetColumnId": targetColumn.ID.String(),
+				"targetPosition": 0,
+			}}},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[0].targetPosition", []string{domain.ErrTaskPositionValue}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := buildTaskRequest(t, http.MethodPost, "/v1/sync", tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+
+			rr := httptest.NewRecorder()
+			mockSync := NewMockSyncService(t)
+			if tt.setupSyncService != nil {
+				tt.setupSyncService(t, mockSync)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewSync(logger, mockSync, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Push(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
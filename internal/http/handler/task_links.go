@@ -0,0 +1,220 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type taskLinksService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, link domain.TaskLink) (domain.TaskLink, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, linkID domain.TaskLinkID) error
+}
+
+type taskLinks struct {
+	logger           *slog.Logger
+	taskLinksService taskLinksService
+	responder        *httpschema.ErrorResponder
+}
+
+func NewTaskLinks(logger *slog.Logger, taskLinksService taskLinksService, responder *httpschema.ErrorResponder) *taskLinks {
+	moduleLogger := logging.WithModule(logger, "handler.task_links")
+
+	return &taskLinks{logger: moduleLogger, taskLinksService: taskLinksService, responder: responder}
+}
+
+type createTaskLinkBody struct {
+	Type         string `json:"type" example:"blocks" enums:"blocks,blocked_by,relates_to,duplicates,duplicated_by"`
+	TargetTaskID string `json:"targetTaskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+}
+
+// taskLinkResponse describes a link as seen from the task it is listed on.
+type taskLinkResponse struct {
+	ID        string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a6"`
+	Type      string `json:"type" example:"blocked_by" enums:"blocks,blocked_by,relates_to,duplicates,duplicated_by"`
+	TaskID    string `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+	CreatedAt string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newTaskLinkResponse(link *domain.TaskLink, taskID domain.TaskID) taskLinkResponse {
+	relation, otherID := link.Relation(taskID)
+	return taskLinkResponse{
+		ID:        link.ID.String(),
+		Type:      relation.String(),
+		TaskID:    otherID.String(),
+		CreatedAt: service.FormatRFC3339Millis(link.CreatedAt),
+	}
+}
+
+func newTaskLinksResponse(task *domain.Task) []taskLinkResponse {
+	response := make([]taskLinkResponse, len(task.Links))
+	for i := range task.Links {
+		response[i] = newTaskLinkResponse(&task.Links[i], task.ID)
+	}
+	return response
+}
+
+// Create godoc
+// @Summary Link a task with another task
+// @Description Link the task with another task on any board of the current user.
+// @Description The type is read from the task in the path: "blocked_by" means the target task blocks this one.
+// @Description Blocking links that would form a dependency cycle are rejected.
+// @Tags task-links
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param columnId path string true "Column ID"
+// @Param taskId path string true "Task ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createTaskLinkBody true "Link type and the target task"
+// @Success 201 {object} taskLinkResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "TASK_LINK_ALREADY_EXISTS, TASK_LINK_CYCLE or IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/links [post]
+func (h *taskLinks) Create(w http.ResponseWriter, r *http.Request) {
+	boardID, columnID, taskID, ok := h.parseBoardColumnAndTaskID(w, r)
+	if !ok {
+		return
+	}
+
+	var body createTaskLinkBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	relation := httpschema.ValidateField("type", body.Type, domain.NewTaskLinkRelation, &details)
+	otherID, err := domain.ParseTaskID(body.TargetTaskID)
+	if err != nil {
+		details = append(details, httpschema.Detail{Field: "targetTaskId", Issues: []string{"Invalid target task id"}})
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	link, err := relation.Link(taskID, otherID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "targetTaskId", Issues: domain.ExtractValidationIssues(err)}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	created, err := h.taskLinksService.Create(r.Context(), userID, boardID, columnID, taskID, link)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrLinkedTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "targetTaskId", Issues: []string{"Linked task not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTaskLinkAlreadyExists) {
+			h.responder.TaskLinkAlreadyExists(w, []httpschema.Detail{{Field: "targetTaskId", Issues: []string{"Tasks are already linked this way"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTaskLinkCycle) {
+			h.responder.TaskLinkCycle(w, []httpschema.Detail{{Field: "targetTaskId", Issues: []string{"Link would create a dependency cycle"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newTaskLinkResponse(&created, taskID))
+}
+
+// Delete godoc
+// @Summary Delete a task link by id
+// @Description Remove a link of the task. The link disappears from both linked tasks.
+// @Tags task-links
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param columnId path string true "Column ID"
+// @Param taskId path string true "Task ID"
+// @Param linkId path string true "Link ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND or TASK_LINK_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/links/{linkId} [delete]
+func (h *taskLinks) Delete(w http.ResponseWriter, r *http.Request) {
+	boardID, columnID, taskID, ok := h.parseBoardColumnAndTaskID(w, r)
+	if !ok {
+		return
+	}
+
+	linkID, err := domain.ParseTaskLinkID(r.PathValue("linkId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "linkId", Issues: []string{"Invalid link id"}}})
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err = h.taskLinksService.Delete(r.Context(), userID, boardID, columnID, taskID, linkID)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTaskLinkNotFound) {
+			h.responder.TaskLinkNotFound(w, []httpschema.Detail{{Field: "linkId", Issues: []string{"Task link not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *taskLinks) parseBoardColumnAndTaskID(w http.ResponseWriter, r *http.Request) (boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, ok bool) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return domain.BoardID{}, domain.ColumnID{}, domain.TaskID{}, false
+	}
+
+	columnID, err = domain.ParseColumnID(r.PathValue("columnId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Invalid column id"}}})
+		return domain.BoardID{}, domain.ColumnID{}, domain.TaskID{}, false
+	}
+
+	taskID, err = domain.ParseTaskID(r.PathValue("taskId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Invalid task id"}}})
+		return domain.BoardID{}, domain.ColumnID{}, domain.TaskID{}, false
+	}
+
+	return boardID, columnID, taskID, true
+}
@@ -0,0 +1,225 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func taskLinkConflictError(code, message, issue string) map[string]any {
+	return map[string]any{
+		"code":      code,
+		"message":   message,
+		"timestamp": testutil.FixedNowStr(),
+		"details": []any{
+			map[string]any{"field": "targetTaskId", "issues": []string{issue}},
+		},
+	}
+}
+
+func TestTaskLinks_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	otherTaskID := domain.NewTaskID()
+	linkID := domain.NewTaskLinkID()
+
+	tests := []struct {
+		name      string
+		inputBody any
+		wantLink  *domain.TaskLink
+		createErr error
+		wantCode  int
+		wantBody  any
+	}{
+		{
+			name:      "Success (blocked_by)",
+			inputBody: map[string]any{"type": "blocked_by", "targetTaskId": otherTaskID.String()},
+			wantLink:  &domain.TaskLink{SourceID: otherTaskID, TargetID: validTask.ID, Type: domain.TaskLinkBlocks},
+			wantCode:  http.StatusCreated,
+			wantBody: map[string]any{
+				"id":        linkID.String(),
+				"type":      "blocked_by",
+				"taskId":    otherTaskID.String(),
+				"createdAt": testutil.FixedNow().Format(testutil.TimeFormat),
+			},
+		},
+		{
+			name:      "Success (duplicates)",
+			inputBody: map[string]any{"type": "duplicates", "targetTaskId": otherTaskID.String()},
+			wantLink:  &domain.TaskLink{SourceID: validTask.ID, TargetID: otherTaskID, Type: domain.TaskLinkDuplicates},
+			wantCode:  http.StatusCreated,
+			wantBody: map[string]any{
+				"id":        linkID.String(),
+				"type":      "duplicates",
+				"taskId":    otherTaskID.String(),
+				"createdAt": testutil.FixedNow().Format(testutil.TimeFormat),
+			},
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: "{",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Invalid type and target",
+			inputBody: map[string]any{"type": "parent_of", "targetTaskId": "nope"},
+			wantCode:  http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "type", "issues": []string{domain.ErrTaskLinkTypeValue}},
+					map[string]any{"field": "targetTaskId", "issues": []string{"Invalid target task id"}},
+				},
+			},
+		},
+		{
+			name:      "Link to itself",
+			inputBody: map[string]any{"type": "blocks", "targetTaskId": validTask.ID.String()},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("targetTaskId", []string{domain.ErrTaskLinkSelf}),
+		},
+		{
+			name:      "Task not found",
+			inputBody: map[string]any{"type": "blocks", "targetTaskId": otherTaskID.String()},
+			createErr: service.ErrTaskNotFound,
+			wantCode:  http.StatusNotFound,
+			wantBody:  taskNotFoundError("taskId"),
+		},
+		{
+			name:      "Linked task not found",
+			inputBody: map[string]any{"type": "blocks", "targetTaskId": otherTaskID.String()},
+			createErr: service.ErrLinkedTaskNotFound,
+			wantCode:  http.StatusNotFound,
+			wantBody:  taskLinkConflictError("TASK_NOT_FOUND", "Task not found", "Linked task not found"),
+		},
+		{
+			name:      "Already linked",
+			inputBody: map[string]any{"type": "blocks", "targetTaskId": otherTaskID.String()},
+			createErr: service.ErrTaskLinkAlreadyExists,
+			wantCode:  http.StatusConflict,
+			wantBody:  taskLinkConflictError("TASK_LINK_ALREADY_EXISTS", "Task link already exists", "Tasks are already linked this way"),
+		},
+		{
+			name:      "Cycle",
+			inputBody: map[string]any{"type": "blocks", "targetTaskId": otherTaskID.String()},
+			createErr: service.ErrTaskLinkCycle,
+			wantCode:  http.StatusConflict,
+			wantBody:  taskLinkConflictError("TASK_LINK_CYCLE", "Task link would create a dependency cycle", "Link would create a dependency cycle"),
+		},
+		{
+			name:      "Internal error",
+			inputBody: map[string]any{"type": "blocks", "targetTaskId": otherTaskID.String()},
+			createErr: errors.New("db exploded"),
+			wantCode:  http.StatusInternalServerError,
+			wantBody:  internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/columns/" + validColumn.ID.String() + "/tasks/" + validTask.ID.String() + "/links"
+			req := buildTaskRequest(t, http.MethodPost, path, tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			req.SetPathValue("columnId", validColumn.ID.String())
+			req.SetPathValue("taskId", validTask.ID.String())
+
+			rr := httptest.NewRecorder()
+			s := NewMockTaskLinkService(t)
+			s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, link domain.TaskLink) (domain.TaskLink, error) {
+				if taskID != validTask.ID {
+					t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+				}
+				if tt.wantLink != nil && link != *tt.wantLink {
+					t.Errorf("got link %+v, want %+v", link, *tt.wantLink)
+				}
+				if tt.createErr != nil {
+					return domain.TaskLink{}, tt.createErr
+				}
+				link.ID = linkID
+				link.CreatedAt = testutil.FixedNow()
+				return link, nil
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTaskLinks(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTaskLinks_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	linkID := domain.NewTaskLinkID()
+
+	tests := []struct {
+		name      string
+		linkID    string
+		deleteErr error
+		wantCode  int
+		wantBody  any
+	}{
+		{name: "Success", linkID: linkID.String(), wantCode: http.StatusNoContent},
+		{name: "Invalid link id", linkID: "nope", wantCode: http.StatusBadRequest, wantBody: validationError("linkId", []string{"Invalid link id"})},
+		{name: "Task not found", linkID: linkID.String(), deleteErr: service.ErrTaskNotFound, wantCode: http.StatusNotFound, wantBody: taskNotFoundError("taskId")},
+		{name: "Link not found", linkID: linkID.String(), deleteErr: service.ErrTaskLinkNotFound, wantCode: http.StatusNotFound, wantBody: taskLinkNotFoundError()},
+		{name: "Internal error", linkID: linkID.String(), deleteErr: errors.New("db exploded"), wantCode: http.StatusInternalServerError, wantBody: internalError()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + validBoard.ID.String() + "/columns/" + validColumn.ID.String() + "/tasks/" + validTask.ID.String() + "/links/" + tt.linkID
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", validBoard.ID.String())
+			req.SetPathValue("columnId", validColumn.ID.String())
+			req.SetPathValue("taskId", validTask.ID.String())
+			req.SetPathValue("linkId", tt.linkID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockTaskLinkService(t)
+			s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotLinkID domain.TaskLinkID) error {
+				if gotLinkID != linkID {
+					t.Errorf("got link id %v, want %v", gotLinkID, linkID)
+				}
+				return tt.deleteErr
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTaskLinks(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Delete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			if tt.wantBody != nil {
+				testutil.AssertContentType(t, rr, "application/json")
+				testutil.AssertResponseBody(t, rr, tt.wantBody)
+			}
+		})
+	}
+}
@@ -0,0 +1,312 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+type taskTemplatesService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error)
+	ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID) error
+}
+
+type taskTemplates struct {
+	logger               *slog.Logger
+	taskTemplatesService taskTemplatesService
+	responder            *httpschema.ErrorResponder
+}
+
+func NewTaskTemplates(logger *slog.Logger, taskTemplatesService taskTemplatesService, responder *httpschema.ErrorResponder) *taskTemplates {
+	moduleLogger := logging.WithModule(logger, "handler.task_templates")
+
+	return &taskTemplates{logger: moduleLogger, taskTemplatesService: taskTemplatesService, responder: responder}
+}
+
+type createTaskTemplateBody struct {
+	NamePattern        string                  `json:"namePattern" example:"Weekly report {{week}}"`
+	DescriptionPattern string                  `json:"descriptionPattern" example:"Summary for the week starting {{date}}"`
+	Checklist          []taskChecklistItemBody `json:"checklist"`
+}
+
+type updateTaskTemplateBody struct {
+	NamePattern        *string                 `json:"namePattern" example:"Weekly report {{week}}"`
+	DescriptionPattern *string                 `json:"descriptionPattern" example:"Summary for the week starting {{date}}"`
+	Checklist          []taskChecklistItemBody `json:"checklist"`
+}
+
+type taskTemplateResponse struct {
+	ID                 string                      `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+	BoardID            string                      `json:"boardId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a1"`
+	NamePattern        string                      `json:"namePattern" example:"Weekly report {{week}}"`
+	DescriptionPattern string                      `json:"descriptionPattern" example:"Summary for the week starting {{date}}"`
+	Checklist          []taskChecklistItemResponse `json:"checklist"`
+	CreatedAt          string                      `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt          string                      `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+}
+
+func newTaskTemplateResponse(template *domain.TaskTemplate) taskTemplateResponse {
+	return taskTemplateResponse{
+		ID:                 template.ID.String(),
+		BoardID:            template.BoardID.String(),
+		NamePattern:        template.NamePattern.String(),
+		DescriptionPattern: template.DescriptionPattern.String(),
+		Checklist:          newTaskChecklistResponse(template.Checklist),
+		CreatedAt:          service.FormatRFC3339Millis(template.CreatedAt),
+		UpdatedAt:          service.FormatRFC3339Millis(template.UpdatedAt),
+	}
+}
+
+type listTaskTemplatesResponse = []taskTemplateResponse
+
+// Create godoc
+// @Summary Create a task template
+// @Description Create a task template on a board owned by the current user.
+// @Description Patterns may use the placeholders {{date}}, {{time}}, {{weekday}}, {{week}} and {{month}}, expanded in UTC when a task is created from the template.
+// @Tags task-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body createTaskTemplateBody true "Template details"
+// @Success 201 {object} taskTemplateResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/task-templates [post]
+func (h *taskTemplates) Create(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	var body createTaskTemplateBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	namePattern := httpschema.ValidateField("namePattern", body.NamePattern, domain.NewTaskNamePattern, &details)
+	descriptionPattern := httpschema.ValidateField("descriptionPattern", body.DescriptionPattern, domain.NewTaskDescriptionPattern, &details)
+	checklist := httpschema.ValidateField("checklist", body.Checklist, newTaskChecklistFromBody, &details)
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	template, err := h.taskTemplatesService.Create(r.Context(), userID, boardID, namePattern, descriptionPattern, checklist)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newTaskTemplateResponse(&template))
+}
+
+// ListByBoardID godoc
+// @Summary List task templates of a board
+// @Description Get all task templates of a board owned by the current user, in increasing creation time order.
+// @Tags task-templates
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Success 200 {object} listTaskTemplatesResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/task-templates [get]
+func (h *taskTemplates) ListByBoardID(w http.ResponseWriter, r *http.Request) {
+	boardID, ok := h.parseBoardID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	templates, err := h.taskTemplatesService.ListByBoardID(r.Context(), userID, boardID)
+	if err != nil {
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make(listTaskTemplatesResponse, len(templates))
+	for i := range templates {
+		response[i] = newTaskTemplateResponse(&templates[i])
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// Update godoc
+// @Summary Update a task template by id
+// @Description Partially update a task template. Provided fields are updated; omitted or null fields are ignored. A provided checklist replaces the whole checklist. Tasks already created from the template are not affected.
+// @Tags task-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param templateId path string true "Task template ID"
+// @Param body body updateTaskTemplateBody true "Template fields to update"
+// @Success 200 {object} taskTemplateResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_TEMPLATE_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/task-templates/{templateId} [patch]
+func (h *taskTemplates) Update(w http.ResponseWriter, r *http.Request) {
+	boardID, templateID, ok := h.parseBoardAndTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	var body updateTaskTemplateBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	details := []httpschema.Detail{}
+	var namePattern *domain.TaskNamePattern
+	if body.NamePattern != nil {
+		value := httpschema.ValidateField("namePattern", *body.NamePattern, domain.NewTaskNamePattern, &details)
+		namePattern = &value
+	}
+	var descriptionPattern *domain.TaskDescriptionPattern
+	if body.DescriptionPattern != nil {
+		value := httpschema.ValidateField("descriptionPattern", *body.DescriptionPattern, domain.NewTaskDescriptionPattern, &details)
+		descriptionPattern = &value
+	}
+	var checklist *domain.TaskChecklist
+	if body.Checklist != nil {
+		value := httpschema.ValidateField("checklist", body.Checklist, newTaskChecklistFromBody, &details)
+		checklist = &value
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	template, err := h.taskTemplatesService.Update(r.Context(), userID, boardID, templateID, namePattern, descriptionPattern, checklist)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskTemplateNotFound) {
+			h.responder.TaskTemplateNotFound(w, []httpschema.Detail{{Field: "templateId", Issues: []string{"Task template not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newTaskTemplateResponse(&template))
+}
+
+// Delete godoc
+// @Summary Delete a task template by id
+// @Description Delete a task template. Tasks already created from the template are not affected.
+// @Tags task-templates
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param templateId path string true "Task template ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_TEMPLATE_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/task-templates/{templateId} [delete]
+func (h *taskTemplates) Delete(w http.ResponseWriter, r *http.Request) {
+	boardID, templateID, ok := h.parseBoardAndTemplateID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	err := h.taskTemplatesService.Delete(r.Context(), userID, boardID, templateID)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskTemplateNotFound) {
+			h.responder.TaskTemplateNotFound(w, []httpschema.Detail{{Field: "templateId", Issues: []string{"Task template not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *taskTemplates) parseBoardID(w http.ResponseWriter, r *http.Request) (domain.BoardID, bool) {
+	rawBoardID := r.PathValue("boardId")
+	boardID, err := domain.ParseBoardID(rawBoardID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return domain.BoardID{}, false
+	}
+
+	return boardID, true
+}
+
+func (h *taskTemplates) parseBoardAndTemplateID(w http.ResponseWriter, r *http.Request) (boardID domain.BoardID, templateID domain.TaskTemplateID, ok bool) {
+	boardID, ok = h.parseBoardID(w, r)
+	if !ok {
+		return domain.BoardID{}, domain.TaskTemplateID{}, false
+	}
+
+	rawTemplateID := r.PathValue("templateId")
+	templateID, err := domain.ParseTaskTemplateID(rawTemplateID)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "templateId", Issues: []string{"Invalid template id"}}})
+		return domain.BoardID{}, domain.TaskTemplateID{}, false
+	}
+
+	return boardID, templateID, true
+}
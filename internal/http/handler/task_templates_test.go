@@ -0,0 +1,464 @@
+package handler_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/handler"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func taskTemplateBody(template *domain.TaskTemplate) map[string]any {
+	checklist := []any{}
+	for _, item := range template.Checklist.Items() {
+		checklist = append(checklist, map[string]any{"text": item.Text, "done": item.Done})
+	}
+
+	return map[string]any{
+		"id":                 template.ID.String(),
+		"boardId":            template.BoardID.String(),
+		"namePattern":        template.NamePattern.String(),
+		"descriptionPattern": template.DescriptionPattern.String(),
+		"checklist":          checklist,
+		"createdAt":          template.CreatedAt.Format(testutil.TimeFormat),
+		"updatedAt":          template.UpdatedAt.Format(testutil.TimeFormat),
+	}
+}
+
+func TestTaskTemplates_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+
+	tests := []struct {
+		name                 string
+		boardID              string
+		inputBody            any
+		context              context.Context
+		setupTemplateService func(t *testing.T, s *MockTaskTemplateService)
+		wantCode             int
+		wantBody             any
+	}{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			inputBody: map[string]any{
+				"namePattern":        validTemplate.NamePattern.String(),
+				"descriptionPattern": validTemplate.DescriptionPattern.String(),
+				"checklist":          []map[string]any{{"text": "Yesterday"}, {"text": "Today"}},
+			},
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if namePattern != validTemplate.NamePattern {
+						t.Errorf("got name pattern %v, want %v", namePattern, validTemplate.NamePattern)
+					}
+					if checklist.Len() != 2 {
+						t.Errorf("got %d checklist items, want 2", checklist.Len())
+					}
+					return validTemplate, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: taskTemplateBody(&validTemplate),
+		},
+		{
+			name:      "Invalid board id",
+			boardID:   "not-a-uuid",
+			inputBody: map[string]string{"namePattern": "Standup"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:      "Invalid JSON",
+			boardID:   validBoard.ID.String(),
+			inputBody: "{\"namePattern\":\"broken\"",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Unknown placeholder",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"namePattern": "Standup {{owner}}"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("namePattern", []string{"Pattern has unknown placeholder"}),
+		},
+		{
+			name:      "Missing context user",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"namePattern": "Standup"},
+			context:   context.Background(),
+			wantCode:  http.StatusUnauthorized,
+			wantBody:  unauthorizedTokenError(),
+		},
+		{
+			name:      "Board not found",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"namePattern": "Standup"},
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:      "Internal error",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]string{"namePattern": "Standup"},
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:      "Body too large",
+			boardID:   validBoard.ID.String(),
+			inputBody: testutil.Valid25KBJSON(),
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/task-templates"
+			req := buildTaskRequest(t, http.MethodPost, path, tt.inputBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockTaskTemplateService(t)
+			if tt.setupTemplateService != nil {
+				tt.setupTemplateService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTaskTemplates(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Create(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTaskTemplates_ListByBoardID(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+
+	tests := []struct {
+		name                 string
+		boardID              string
+		context              context.Context
+		setupTemplateService func(t *testing.T, s *MockTaskTemplateService)
+		wantCode             int
+		wantBody             any
+	}{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					return []domain.TaskTemplate{validTemplate}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{taskTemplateBody(&validTemplate)},
+		},
+		{
+			name:    "Success empty",
+			boardID: validBoard.ID.String(),
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{},
+		},
+		{
+			name:     "Invalid board id",
+			boardID:  "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:    "Board not found",
+			boardID: validBoard.ID.String(),
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+					return nil, service.ErrBoardNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: boardNotFoundError(),
+		},
+		{
+			name:    "Internal error",
+			boardID: validBoard.ID.String(),
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.ListByBoardIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+					return nil, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/task-templates"
+			req := httptest.NewRequest(http.MethodGet, path, nil)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockTaskTemplateService(t)
+			if tt.setupTemplateService != nil {
+				tt.setupTemplateService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTaskTemplates(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.ListByBoardID(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTaskTemplates_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+
+	tests := []struct {
+		name                 string
+		boardID              string
+		templateID           string
+		inputBody            any
+		setupTemplateService func(t *testing.T, s *MockTaskTemplateService)
+		wantCode             int
+		wantBody             any
+	}{
+		{
+			name:       "Success",
+			boardID:    validBoard.ID.String(),
+			templateID: validTemplate.ID.String(),
+			inputBody:  map[string]any{"descriptionPattern": "Notes for {{weekday}}"},
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error) {
+					if templateID != validTemplate.ID {
+						t.Errorf("got template id %v, want %v", templateID, validTemplate.ID)
+					}
+					if namePattern != nil || checklist != nil {
+						t.Errorf("got name pattern %v and checklist %v, want nil", namePattern, checklist)
+					}
+					if descriptionPattern == nil || *descriptionPattern != validTemplate.DescriptionPattern {
+						t.Errorf("got description pattern %v, want %v", descriptionPattern, validTemplate.DescriptionPattern)
+					}
+					return validTemplate, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: taskTemplateBody(&validTemplate),
+		},
+		{
+			name:       "Invalid template id",
+			boardID:    validBoard.ID.String(),
+			templateID: "not-a-uuid",
+			inputBody:  map[string]any{},
+			wantCode:   http.StatusBadRequest,
+			wantBody:   validationError("templateId", []string{"Invalid template id"}),
+		},
+		{
+			name:       "Empty name pattern",
+			boardID:    validBoard.ID.String(),
+			templateID: validTemplate.ID.String(),
+			inputBody:  map[string]any{"namePattern": " "},
+			wantCode:   http.StatusBadRequest,
+			wantBody:   validationError("namePattern", []string{"Name is too short"}),
+		},
+		{
+			name:       "Template not found",
+			boardID:    validBoard.ID.String(),
+			templateID: validTemplate.ID.String(),
+			inputBody:  map[string]any{"namePattern": "Standup"},
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, service.ErrTaskTemplateNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskTemplateNotFoundError(),
+		},
+		{
+			name:       "Internal error",
+			boardID:    validBoard.ID.String(),
+			templateID: validTemplate.ID.String(),
+			inputBody:  map[string]any{"namePattern": "Standup"},
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/task-templates/" + tt.templateID
+			req := buildTaskRequest(t, http.MethodPatch, path, tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", tt.boardID)
+			req.SetPathValue("templateId", tt.templateID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockTaskTemplateService(t)
+			if tt.setupTemplateService != nil {
+				tt.setupTemplateService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTaskTemplates(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Update(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTaskTemplates_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	templateID := domain.NewTaskTemplateID()
+
+	tests := []struct {
+		name                 string
+		boardID              string
+		templateID           string
+		setupTemplateService func(t *testing.T, s *MockTaskTemplateService)
+		wantCode             int
+		wantBody             any
+	}{
+		{
+			name:       "Success",
+			boardID:    validBoard.ID.String(),
+			templateID: templateID.String(),
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, gotTemplateID domain.TaskTemplateID) error {
+					if gotTemplateID != templateID {
+						t.Errorf("got template id %v, want %v", gotTemplateID, templateID)
+					}
+					return nil
+				}
+			},
+			wantCode: http.StatusNoContent,
+		},
+		{
+			name:       "Invalid board id",
+			boardID:    "not-a-uuid",
+			templateID: templateID.String(),
+			wantCode:   http.StatusBadRequest,
+			wantBody:   validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:       "Template not found",
+			boardID:    validBoard.ID.String(),
+			templateID: templateID.String(),
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+					return service.ErrTaskTemplateNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskTemplateNotFoundError(),
+		},
+		{
+			name:       "Internal error",
+			boardID:    validBoard.ID.String(),
+			templateID: templateID.String(),
+			setupTemplateService: func(t *testing.T, s *MockTaskTemplateService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+					return errors.New("db exploded")
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/task-templates/" + tt.templateID
+			req := httptest.NewRequest(http.MethodDelete, path, nil)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", tt.boardID)
+			req.SetPathValue("templateId", tt.templateID)
+
+			rr := httptest.NewRecorder()
+			s := NewMockTaskTemplateService(t)
+			if tt.setupTemplateService != nil {
+				tt.setupTemplateService(t, s)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTaskTemplates(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Delete(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			if tt.wantBody != nil {
+				testutil.AssertContentType(t, rr, "application/json")
+				testutil.AssertResponseBody(t, rr, tt.wantBody)
+			}
+		})
+	}
+}
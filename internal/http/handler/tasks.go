@@ -2,9 +2,15 @@ package handler
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/http/httpschema"
@@ -13,11 +19,17 @@ import (
 )
 
 type tasksService interface {
-	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error)
-	ListByColumnID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) ([]domain.Task, error)
-	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error)
-	Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error)
-	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error)
+	CreateFromTemplate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, templateID domain.TaskTemplateID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error)
+	ListByColumnID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error)
+	ListChildren(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) ([]domain.Task, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error)
+	SetParent(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, parentID domain.TaskID) (domain.Task, error)
+	Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error
+	Duplicate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	Restore(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	Batch(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, operations []domain.TaskBatchOperation) ([]domain.TaskBatchResult, error)
 }
 
 type tasks struct {
@@ -33,62 +45,302 @@ func NewTasks(logger *slog.Logger, tasksService tasksService, responder *httpsch
 }
 
 type createTaskBody struct {
-	Name        string `json:"name" example:"Write tests"`
-	Description string `json:"description" example:"Cover the new endpoint with tests"`
+	Name        string                  `json:"name" example:"Write tests"`
+	Description string                  `json:"description" example:"Cover the new endpoint with tests"`
+	Checklist   []taskChecklistItemBody `json:"checklist"`
+	TemplateID  *string                 `json:"templateId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a4"`
+	ParentID    *string                 `json:"parentId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a5"`
+	LaneID      *string                 `json:"laneId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a6"`
+	Estimate    *float64                `json:"estimate" example:"3"`
+	// CustomFields holds values of the board's custom fields keyed by field id.
+	CustomFields map[string]json.RawMessage `json:"customFields" swaggertype:"object"`
 }
 
 type updateTaskBody struct {
-	Name        *string `json:"name" example:"Rewrite tests"`
-	Description *string `json:"description" example:"Cover edge cases"`
+	Name        *string                 `json:"name" example:"Rewrite tests"`
+	Description *string                 `json:"description" example:"Cover edge cases"`
+	Checklist   []taskChecklistItemBody `json:"checklist"`
+	// Estimate keeps the current estimate when omitted and clears it when null.
+	Estimate json.RawMessage `json:"estimate" swaggertype:"number" example:"5"`
+	// CustomFields sets the given values keyed by field id; a null value removes the value of the field.
+	CustomFields map[string]json.RawMessage `json:"customFields" swaggertype:"object"`
+}
+
+// parseCustomFieldValues keys the values by custom field id. It returns nil when no values are given.
+func parseCustomFieldValues(raw map[string]json.RawMessage) (map[domain.CustomFieldID]json.RawMessage, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	values := make(map[domain.CustomFieldID]json.RawMessage, len(raw))
+	for rawFieldID, value := range raw {
+		fieldID, err := domain.ParseCustomFieldID(rawFieldID)
+		if err != nil {
+			return nil, err
+		}
+		values[fieldID] = value
+	}
+
+	return values, nil
+}
+
+// parseCustomFieldFilters reads repeated customField=<fieldId>:<value> query parameters.
+// It returns nil when no filters are given.
+func parseCustomFieldFilters(rawFilters []string) (map[domain.CustomFieldID][]string, error) {
+	if len(rawFilters) == 0 {
+		return nil, nil
+	}
+
+	filters := make(map[domain.CustomFieldID][]string, len(rawFilters))
+	for _, rawFilter := range rawFilters {
+		rawFieldID, value, found := strings.Cut(rawFilter, ":")
+		if !found {
+			return nil, errors.New("custom field filter without value")
+		}
+		fieldID, err := domain.ParseCustomFieldID(rawFieldID)
+		if err != nil {
+			return nil, err
+		}
+		filters[fieldID] = append(filters[fieldID], value)
+	}
+
+	return filters, nil
+}
+
+// newCustomFieldValuesDetails reports the issues of every field as customFields.<fieldId>, sorted by field.
+func newCustomFieldValuesDetails(valuesErr *service.CustomFieldValuesError) []httpschema.Detail {
+	details := make([]httpschema.Detail, 0, len(valuesErr.Issues))
+	for fieldID, issues := range valuesErr.Issues {
+		details = append(details, httpschema.Detail{Field: "customFields." + fieldID.String(), Issues: issues})
+	}
+	slices.SortFunc(details, func(a, b httpschema.Detail) int {
+		return strings.Compare(a.Field, b.Field)
+	})
+
+	return details
+}
+
+// parseTaskEstimatePatch returns nil when the estimate is omitted and an unset estimate when it is null.
+func parseTaskEstimatePatch(raw json.RawMessage) (*domain.TaskEstimate, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var rawPoints *float64
+	err := json.Unmarshal(raw, &rawPoints)
+	if err != nil {
+		return nil, err
+	}
+
+	var estimate domain.TaskEstimate
+	if rawPoints != nil {
+		estimate, err = domain.NewTaskEstimate(*rawPoints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &estimate, nil
+}
+
+type taskChecklistItemBody struct {
+	Text string `json:"text" example:"Update the changelog"`
+	Done bool   `json:"done" example:"false"`
+}
+
+func newTaskChecklistFromBody(items []taskChecklistItemBody) (domain.TaskChecklist, error) {
+	checklistItems := make([]domain.TaskChecklistItem, len(items))
+	for i, item := range items {
+		checklistItems[i] = domain.TaskChecklistItem{Text: item.Text, Done: item.Done}
+	}
+	return domain.NewTaskChecklist(checklistItems)
+}
+
+type setTaskParentBody struct {
+	// ParentID is null to make the task a top-level task.
+	ParentID *string `json:"parentId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a5"`
 }
 
 type moveTaskBody struct {
 	TargetColumnID string `json:"targetColumnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
-	TargetPosition int64  `json:"targetPosition" example:"1"`
+	// TargetLaneID keeps the current lane when omitted and moves the task to the default lane when null.
+	TargetLaneID   json.RawMessage `json:"targetLaneId" swaggertype:"string" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a6"`
+	TargetPosition int64           `json:"targetPosition" example:"1"`
+}
+
+// parseTargetLaneID returns nil when the lane is omitted and a nil lane ID when it is null.
+func parseTargetLaneID(raw json.RawMessage) (*domain.LaneID, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	var rawLaneID *string
+	err := json.Unmarshal(raw, &rawLaneID)
+	if err != nil {
+		return nil, err
+	}
+
+	var laneID domain.LaneID
+	if rawLaneID != nil {
+		laneID, err = domain.ParseLaneID(*rawLaneID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &laneID, nil
 }
 
 type taskResponse struct {
-	ID          string `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
-	ColumnID    string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
-	Name        string `json:"name" example:"Write tests"`
-	Description string `json:"description" example:"Cover the new endpoint with tests"`
-	Position    int64  `json:"position" example:"1"`
-	CreatedAt   string `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
-	UpdatedAt   string `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+	ID          string                      `json:"id" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	ColumnID    string                      `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	LaneID      *string                     `json:"laneId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a6"`
+	ParentID    *string                     `json:"parentId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a5"`
+	SprintID    *string                     `json:"sprintId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a7"`
+	Name        string                      `json:"name" example:"Write tests"`
+	Description string                      `json:"description" example:"Cover the new endpoint with tests"`
+	Position    int64                       `json:"position" example:"1"`
+	Checklist   []taskChecklistItemResponse `json:"checklist"`
+	Estimate    *float64                    `json:"estimate" example:"3"`
+	// CustomFields holds the values of the board's custom fields keyed by field id. Fields without a value are omitted.
+	CustomFields map[string]json.RawMessage `json:"customFields" swaggertype:"object"`
+	Links        []taskLinkResponse         `json:"links"`
+	Rollup       taskRollupResponse         `json:"rollup"`
+	// ArchivedAt is null for active tasks.
+	ArchivedAt *string `json:"archivedAt" example:"2026-03-21T20:56:50.000+03:00"`
+	CreatedAt  string  `json:"createdAt" example:"2026-03-07T20:56:50.000+03:00"`
+	UpdatedAt  string  `json:"updatedAt" example:"2026-03-07T20:56:50.000+03:00"`
+	Version    int64   `json:"version" example:"3"`
 }
 
-type taskPositionResponse struct {
+// taskRollupResponse counts the direct children of a task. Done counts children in done columns.
+type taskRollupResponse struct {
+	Total    int                        `json:"total" example:"3"`
+	Done     int                        `json:"done" example:"1"`
+	ByColumn []taskRollupColumnResponse `json:"byColumn"`
+}
+
+type taskRollupColumnResponse struct {
 	ColumnID string `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
-	Position int64  `json:"position" example:"2"`
+	Count    int    `json:"count" example:"2"`
+}
+
+type taskChecklistItemResponse struct {
+	Text string `json:"text" example:"Update the changelog"`
+	Done bool   `json:"done" example:"false"`
+}
+
+type taskPositionResponse struct {
+	ColumnID string  `json:"columnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	LaneID   *string `json:"laneId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a6"`
+	Position int64   `json:"position" example:"2"`
+	Version  int64   `json:"version" example:"4"`
+}
+
+// newLaneIDResponse maps the default lane to null.
+func newLaneIDResponse(laneID domain.LaneID) *string {
+	if laneID.IsNil() {
+		return nil
+	}
+	value := laneID.String()
+	return &value
+}
+
+func newArchivedAtResponse(archivedAt time.Time) *string {
+	if archivedAt.IsZero() {
+		return nil
+	}
+	value := service.FormatRFC3339Millis(archivedAt)
+	return &value
+}
+
+// parseIncludeArchived reads the includeArchived query parameter, false when it is omitted.
+func parseIncludeArchived(r *http.Request) (bool, error) {
+	raw := r.URL.Query().Get("includeArchived")
+	if raw == "" {
+		return false, nil
+	}
+	return strconv.ParseBool(raw)
 }
 
 func newTaskResponse(task *domain.Task) taskResponse {
+	var parentID *string
+	if task.HasParent() {
+		value := task.ParentID.String()
+		parentID = &value
+	}
+
 	return taskResponse{
-		ID:          task.ID.String(),
-		ColumnID:    task.ColumnID.String(),
-		Name:        task.Name.String(),
-		Description: task.Description.String(),
-		Position:    task.Position.Int64(),
-		CreatedAt:   service.FormatRFC3339Millis(task.CreatedAt),
-		UpdatedAt:   service.FormatRFC3339Millis(task.UpdatedAt),
+		ID:           task.ID.String(),
+		ColumnID:     task.ColumnID.String(),
+		LaneID:       newLaneIDResponse(task.LaneID),
+		ParentID:     parentID,
+		SprintID:     newSprintIDResponse(task.SprintID),
+		Name:         task.Name.String(),
+		Description:  task.Description.String(),
+		Position:     task.Position.Int64(),
+		Checklist:    newTaskChecklistResponse(task.Checklist),
+		Estimate:     newTaskEstimateResponse(task.Estimate),
+		CustomFields: task.CustomFields.JSON(),
+		Links:        newTaskLinksResponse(task),
+		Rollup:       newTaskRollupResponse(task.Rollup),
+		ArchivedAt:   newArchivedAtResponse(task.ArchivedAt),
+		CreatedAt:    service.FormatRFC3339Millis(task.CreatedAt),
+		UpdatedAt:    service.FormatRFC3339Millis(task.UpdatedAt),
+		Version:      task.Version.Int64(),
+	}
+}
+
+// newTaskEstimateResponse maps a task that is not estimated to null.
+func newTaskEstimateResponse(estimate domain.TaskEstimate) *float64 {
+	if !estimate.IsSet() {
+		return nil
+	}
+	value := estimate.Float64()
+	return &value
+}
+
+func newTaskRollupResponse(rollup domain.TaskRollup) taskRollupResponse {
+	byColumn := make([]taskRollupColumnResponse, len(rollup.ByColumn))
+	for i, column := range rollup.ByColumn {
+		byColumn[i] = taskRollupColumnResponse{ColumnID: column.ColumnID.String(), Count: column.Count}
 	}
+	return taskRollupResponse{Total: rollup.Total, Done: rollup.Done, ByColumn: byColumn}
+}
+
+func newTaskChecklistResponse(checklist domain.TaskChecklist) []taskChecklistItemResponse {
+	items := checklist.Items()
+	response := make([]taskChecklistItemResponse, len(items))
+	for i, item := range items {
+		response[i] = taskChecklistItemResponse{Text: item.Text, Done: item.Done}
+	}
+	return response
 }
 
 // Create godoc
 // @Summary Create a new task
 // @Description Create a new task in a column for the current user. Task is appended to the end of the column.
+// @Description When templateId is set, name, description and checklist left empty are taken from the board's task template, with placeholders such as {{date}} expanded at creation time.
+// @Description When parentId is set, the task becomes a child of that task, which must be on the same board.
+// @Description When laneId is set, the task is appended to that swimlane of the column instead of the default lane.
+// @Description An estimate is optional story points, from 0 to 1000 with at most two decimal places.
+// @Description customFields holds values of the board's custom fields keyed by field id and is validated against the field types.
 // @Tags tasks
 // @Accept json
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
 // @Param body body createTaskBody true "Task details"
 // @Success 201 {object} taskResponse
+// @Header 201 {string} ETag "Version of the task"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
-// @Failure 404 {object} httpschema.DetailedError "COLUMN_NOT_FOUND"
+// @Failure 404 {object} httpschema.DetailedError "COLUMN_NOT_FOUND, LANE_NOT_FOUND, TASK_TEMPLATE_NOT_FOUND or TASK_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns/{columnId}/tasks [post]
 func (h *tasks) Create(w http.ResponseWriter, r *http.Request) {
@@ -109,8 +361,52 @@ func (h *tasks) Create(w http.ResponseWriter, r *http.Request) {
 	}
 
 	details := []httpschema.Detail{}
-	name := httpschema.ValidateField("name", body.Name, domain.NewTaskName, &details)
-	description := httpschema.ValidateField("description", body.Description, domain.NewTaskDescription, &details)
+	var templateID *domain.TaskTemplateID
+	if body.TemplateID != nil {
+		value, parseErr := domain.ParseTaskTemplateID(*body.TemplateID)
+		if parseErr != nil {
+			details = append(details, httpschema.Detail{Field: "templateId", Issues: []string{"Invalid template id"}})
+		}
+		templateID = &value
+	}
+	var parentID domain.TaskID
+	if body.ParentID != nil {
+		parentID, err = domain.ParseTaskID(*body.ParentID)
+		if err != nil {
+			details = append(details, httpschema.Detail{Field: "parentId", Issues: []string{"Invalid parent id"}})
+		}
+	}
+	var laneID domain.LaneID
+	if body.LaneID != nil {
+		laneID, err = domain.ParseLaneID(*body.LaneID)
+		if err != nil {
+			details = append(details, httpschema.Detail{Field: "laneId", Issues: []string{"Invalid lane id"}})
+		}
+	}
+	// With a template, empty fields are left for the template to fill.
+	var name *domain.TaskName
+	if body.TemplateID == nil || strings.TrimSpace(body.Name) != "" {
+		value := httpschema.ValidateField("name", body.Name, domain.NewTaskName, &details)
+		name = &value
+	}
+	var description *domain.TaskDescription
+	if body.TemplateID == nil || strings.TrimSpace(body.Description) != "" {
+		value := httpschema.ValidateField("description", body.Description, domain.NewTaskDescription, &details)
+		description = &value
+	}
+	var checklist *domain.TaskChecklist
+	if body.TemplateID == nil || len(body.Checklist) > 0 {
+		value := httpschema.ValidateField("checklist", body.Checklist, newTaskChecklistFromBody, &details)
+		checklist = &value
+	}
+	var estimate domain.TaskEstimate
+	if body.Estimate != nil {
+		estimate = httpschema.ValidateField("estimate", *body.Estimate, domain.NewTaskEstimate, &details)
+	}
+	customFields, err := parseCustomFieldValues(body.CustomFields)
+	if err != nil {
+		details = append(details, httpschema.Detail{Field: "customFields", Issues: []string{"Invalid custom field id"}})
+	}
 	if len(details) > 0 {
 		h.responder.ValidationError(w, details)
 		return
@@ -121,27 +417,54 @@ func (h *tasks) Create(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	task, err := h.tasksService.Create(r.Context(), userID, boardID, columnID, name, description)
+	var task domain.Task
+	if templateID == nil {
+		task, err = h.tasksService.Create(r.Context(), userID, boardID, columnID, laneID, parentID, *name, *description, *checklist, estimate, customFields)
+	} else {
+		task, err = h.tasksService.CreateFromTemplate(r.Context(), userID, boardID, columnID, laneID, parentID, *templateID, name, description, checklist, estimate, customFields)
+	}
 	if err != nil {
+		var valuesErr *service.CustomFieldValuesError
+		if errors.As(err, &valuesErr) {
+			h.responder.ValidationError(w, newCustomFieldValuesDetails(valuesErr))
+			return
+		}
 		if errors.Is(err, service.ErrColumnNotFound) {
 			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrLaneNotFound) {
+			h.responder.LaneNotFound(w, []httpschema.Detail{{Field: "laneId", Issues: []string{"Lane not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTaskTemplateNotFound) {
+			h.responder.TaskTemplateNotFound(w, []httpschema.Detail{{Field: "templateId", Issues: []string{"Task template not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrParentTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "parentId", Issues: []string{"Parent task not found"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
 
+	setETag(w, task.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newTaskResponse(&task))
 }
 
 // List godoc
 // @Summary List all tasks in a column
 // @Description Get all tasks belonging to the specified column. Results are returned in increasing position order.
+// @Description Tasks can be filtered by custom field values with customField=<fieldId>:<value>, once per field. A multi select filter matches tasks holding the option, a date filter takes YYYY-MM-DD and a checkbox filter takes true or false.
+// @Description Archived tasks are left out unless includeArchived=true, in which case they follow the active tasks of their lane in the order they were archived.
 // @Tags tasks
 // @Produce json
 // @Security BearerAuth
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
+// @Param customField query []string false "Custom field filter as <fieldId>:<value>" collectionFormat(multi)
+// @Param includeArchived query bool false "List archived tasks too"
 // @Success 200 {array} taskResponse
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
@@ -154,13 +477,30 @@ func (h *tasks) ListByColumnID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	filters, err := parseCustomFieldFilters(r.URL.Query()["customField"])
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "customField", Issues: []string{"Must be <fieldId>:<value>"}}})
+		return
+	}
+
+	includeArchived, err := parseIncludeArchived(r)
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "includeArchived", Issues: []string{"Must be true or false"}}})
+		return
+	}
+
 	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
 	if !ok {
 		return
 	}
 
-	tasks, err := h.tasksService.ListByColumnID(r.Context(), userID, boardID, columnID)
+	tasks, err := h.tasksService.ListByColumnID(r.Context(), userID, boardID, columnID, filters, includeArchived)
 	if err != nil {
+		var valuesErr *service.CustomFieldValuesError
+		if errors.As(err, &valuesErr) {
+			h.responder.ValidationError(w, newCustomFieldValuesDetails(valuesErr))
+			return
+		}
 		if errors.Is(err, service.ErrColumnNotFound) {
 			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "columnId", Issues: []string{"Column not found"}}})
 			return
@@ -180,6 +520,9 @@ func (h *tasks) ListByColumnID(w http.ResponseWriter, r *http.Request) {
 // Update godoc
 // @Summary Update a task by id
 // @Description Partially update task metadata for the current user. Provided fields are updated; omitted or null fields are ignored.
+// @Description A provided checklist replaces the whole checklist. A null estimate clears the estimate.
+// @Description customFields sets the given custom field values and keeps the others; a null value removes the value of the field.
+// @Description With If-Match set to the ETag of a previous response, the task is only updated if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -187,12 +530,15 @@ func (h *tasks) ListByColumnID(w http.ResponseWriter, r *http.Request) {
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
 // @Param taskId path string true "Task ID"
+// @Param If-Match header string false "ETag the update is based on"
 // @Param body body updateTaskBody true "Task fields to update"
 // @Success 200 {object} taskResponse
+// @Header 200 {string} ETag "Version of the task"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId} [patch]
 func (h *tasks) Update(w http.ResponseWriter, r *http.Request) {
@@ -201,6 +547,12 @@ func (h *tasks) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	var body updateTaskBody
 	err := decodeJSONLimited(r, &body)
 	if err != nil {
@@ -223,6 +575,19 @@ func (h *tasks) Update(w http.ResponseWriter, r *http.Request) {
 		value := httpschema.ValidateField("description", *body.Description, domain.NewTaskDescription, &details)
 		description = &value
 	}
+	var checklist *domain.TaskChecklist
+	if body.Checklist != nil {
+		value := httpschema.ValidateField("checklist", body.Checklist, newTaskChecklistFromBody, &details)
+		checklist = &value
+	}
+	estimate, err := parseTaskEstimatePatch(body.Estimate)
+	if err != nil {
+		details = append(details, httpschema.Detail{Field: "estimate", Issues: []string{domain.ErrTaskEstimateValue}})
+	}
+	customFields, err := parseCustomFieldValues(body.CustomFields)
+	if err != nil {
+		details = append(details, httpschema.Detail{Field: "customFields", Issues: []string{"Invalid custom field id"}})
+	}
 	if len(details) > 0 {
 		h.responder.ValidationError(w, details)
 		return
@@ -233,22 +598,158 @@ func (h *tasks) Update(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	task, err := h.tasksService.Update(r.Context(), userID, boardID, columnID, taskID, name, description)
+	task, err := h.tasksService.Update(r.Context(), userID, boardID, columnID, taskID, name, description, checklist, estimate, customFields, ifMatch)
 	if err != nil {
+		var valuesErr *service.CustomFieldValuesError
+		if errors.As(err, &valuesErr) {
+			h.responder.ValidationError(w, newCustomFieldValuesDetails(valuesErr))
+			return
+		}
 		if errors.Is(err, service.ErrTaskNotFound) {
 			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Task has changed"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
 
+	setETag(w, task.Version)
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newTaskResponse(&task))
+}
+
+// ListChildren godoc
+// @Summary List children of a task
+// @Description Get the direct children of a task. Children may sit in any column of the board and are returned in board order.
+// @Tags tasks
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param columnId path string true "Column ID"
+// @Param taskId path string true "Task ID"
+// @Success 200 {array} taskResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/children [get]
+func (h *tasks) ListChildren(w http.ResponseWriter, r *http.Request) {
+	boardID, columnID, taskID, ok := h.parseBoardColumnAndTaskID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	children, err := h.tasksService.ListChildren(r.Context(), userID, boardID, columnID, taskID)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := make([]taskResponse, 0, len(children))
+	for i := range children {
+		response = append(response, newTaskResponse(&children[i]))
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// SetParent godoc
+// @Summary Set the parent of a task
+// @Description Make the task a child of another task on the same board, or a top-level task when parentId is null.
+// @Description A task can't become a child of itself or of any of its descendants.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param columnId path string true "Column ID"
+// @Param taskId path string true "Task ID"
+// @Param body body setTaskParentBody true "New parent"
+// @Success 200 {object} taskResponse
+// @Header 200 {string} ETag "Version of the task"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "TASK_PARENT_CYCLE"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/parent [put]
+func (h *tasks) SetParent(w http.ResponseWriter, r *http.Request) {
+	boardID, columnID, taskID, ok := h.parseBoardColumnAndTaskID(w, r)
+	if !ok {
+		return
+	}
+
+	var body setTaskParentBody
+	err := decodeJSONLimited(r, &body)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	var parentID domain.TaskID
+	if body.ParentID != nil {
+		parentID, err = domain.ParseTaskID(*body.ParentID)
+		if err != nil {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "parentId", Issues: []string{"Invalid parent id"}}})
+			return
+		}
+		if parentID == taskID {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "parentId", Issues: []string{domain.ErrTaskParentSelf}}})
+			return
+		}
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	task, err := h.tasksService.SetParent(r.Context(), userID, boardID, columnID, taskID, parentID)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrParentTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "parentId", Issues: []string{"Parent task not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTaskParentCycle) {
+			h.responder.TaskParentCycle(w, []httpschema.Detail{{Field: "parentId", Issues: []string{"Parent is a descendant of the task"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	setETag(w, task.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusOK, newTaskResponse(&task))
 }
 
 // Move godoc
 // @Summary Move a task to a new position, possibly to another column
 // @Description Move a task within its column or across columns in the same board and shift neighboring tasks accordingly.
+// @Description When blockers are enforced, a task can't enter a done column while tasks blocking it are unfinished.
+// @Description Moves across columns follow the board workflow: the source column's allowedTransitions and the target column's entryRoles and entryConditions. Every broken rule is listed in the TRANSITION_NOT_ALLOWED details.
+// @Description Positions are scoped per column and swimlane. An omitted targetLaneId keeps the task's lane, null moves it to the default lane. Archived tasks can't be moved until they are restored.
+// @Description With If-Match set to the ETag of a previous response, the task is only moved if it hasn't changed since. Moving a task bumps its version and the versions of the tasks shifted around it. Their ETags are invalidated too, so a later If-Match request on any of them fails with 412 even though the client never moved it.
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -256,12 +757,18 @@ func (h *tasks) Update(w http.ResponseWriter, r *http.Request) {
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
 // @Param taskId path string true "Task ID"
+// @Param If-Match header string false "ETag the move is based on"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
 // @Param body body moveTaskBody true "Target column and position"
 // @Success 200 {object} taskPositionResponse
+// @Header 200 {string} ETag "Version of the task"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
-// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND or COLUMN_NOT_FOUND"
+// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND, COLUMN_NOT_FOUND or LANE_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "TASK_BLOCKED, TRANSITION_NOT_ALLOWED, TASK_ARCHIVED or IDEMPOTENCY_KEY_IN_USE"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/position [put]
 func (h *tasks) Move(w http.ResponseWriter, r *http.Request) {
@@ -270,6 +777,12 @@ func (h *tasks) Move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	var body moveTaskBody
 	err := decodeJSONLimited(r, &body)
 	if err != nil {
@@ -286,6 +799,10 @@ func (h *tasks) Move(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		details = append(details, httpschema.Detail{Field: "targetColumnId", Issues: []string{"Invalid target column id"}})
 	}
+	targetLaneID, err := parseTargetLaneID(body.TargetLaneID)
+	if err != nil {
+		details = append(details, httpschema.Detail{Field: "targetLaneId", Issues: []string{"Invalid target lane id"}})
+	}
 	targetPosition := httpschema.ValidateField("targetPosition", body.TargetPosition, domain.NewTaskPosition, &details)
 	if len(details) > 0 {
 		h.responder.ValidationError(w, details)
@@ -297,33 +814,84 @@ func (h *tasks) Move(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	newColumnID, newPosition, err := h.tasksService.Move(r.Context(), userID, boardID, columnID, taskID, targetColumnID, targetPosition)
+	placement, err := h.tasksService.Move(r.Context(), userID, boardID, columnID, taskID, targetColumnID, targetLaneID, targetPosition, ifMatch)
 	if err != nil {
 		if errors.Is(err, service.ErrTaskNotFound) {
 			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Task has changed"}}})
+			return
+		}
 		if errors.Is(err, service.ErrColumnNotFound) {
 			h.responder.ColumnNotFound(w, []httpschema.Detail{{Field: "targetColumnId", Issues: []string{"Column not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrLaneNotFound) {
+			h.responder.LaneNotFound(w, []httpschema.Detail{{Field: "targetLaneId", Issues: []string{"Lane not found"}}})
+			return
+		}
 		if errors.Is(err, service.ErrIndexOutOfBounds) {
 			h.responder.ValidationError(w, []httpschema.Detail{{Field: "targetPosition", Issues: []string{"Index out of bounds"}}})
 			return
 		}
+		if errors.Is(err, service.ErrTaskArchived) {
+			h.responder.TaskArchived(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task is archived"}}})
+			return
+		}
+		var blockedErr *service.TaskBlockedError
+		if errors.As(err, &blockedErr) {
+			blockerIDs := make([]string, len(blockedErr.BlockerIDs))
+			for i, blockerID := range blockedErr.BlockerIDs {
+				blockerIDs[i] = blockerID.String()
+			}
+			h.responder.TaskBlocked(w, []httpschema.Detail{{Field: "blockedBy", Issues: blockerIDs}})
+			return
+		}
+		var transitionErr *service.TransitionNotAllowedError
+		if errors.As(err, &transitionErr) {
+			h.responder.TransitionNotAllowed(w, newTransitionDetails(transitionErr))
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
 
+	setETag(w, placement.Version)
 	httpschema.RespondJSON(w, h.logger, http.StatusOK, taskPositionResponse{
-		ColumnID: newColumnID.String(),
-		Position: newPosition.Int64(),
+		ColumnID: placement.ColumnID.String(),
+		LaneID:   newLaneIDResponse(placement.LaneID),
+		Position: placement.Position.Int64(),
+		Version:  placement.Version.Int64(),
 	})
 }
 
+// newTransitionDetails lists the workflow rules a move broke, one detail per rule.
+func newTransitionDetails(err *service.TransitionNotAllowedError) []httpschema.Detail {
+	details := []httpschema.Detail{}
+	if !err.Allowed {
+		details = append(details, httpschema.Detail{Field: "targetColumnId", Issues: []string{"Tasks can't be moved from this column to the target column"}})
+	}
+	if err.Restricted {
+		details = append(details, httpschema.Detail{Field: "targetColumnId", Issues: []string{"The target column doesn't let you move tasks into it"}})
+	}
+	for _, condition := range err.UnmetConditions {
+		switch condition {
+		case domain.ColumnEntryChecklistDone:
+			details = append(details, httpschema.Detail{Field: "checklist", Issues: []string{"All checklist items must be done"}})
+		case domain.ColumnEntryEstimateSet:
+			details = append(details, httpschema.Detail{Field: "estimate", Issues: []string{"Estimate must be set"}})
+		}
+	}
+	return details
+}
+
 // Delete godoc
 // @Summary Delete a task by id
 // @Description Permanently delete a task from a column for the current user and shift positions to close the gap.
+// @Description Children of the task become top-level tasks by default; with children=cascade all descendants are deleted too.
+// @Description With If-Match set to the ETag of a previous response, the task is only deleted if it hasn't changed since. Reordering its neighbours shifts its position and changes its ETag as well.
 // @Tags tasks
 // @Accept json
 // @Produce json
@@ -331,10 +899,13 @@ func (h *tasks) Move(w http.ResponseWriter, r *http.Request) {
 // @Param boardId path string true "Board ID"
 // @Param columnId path string true "Column ID"
 // @Param taskId path string true "Task ID"
+// @Param children query string false "What happens to the children" Enums(orphan, cascade) default(orphan)
+// @Param If-Match header string false "ETag the deletion is based on"
 // @Success 204 "No Content"
 // @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
 // @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
 // @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND"
+// @Failure 412 {object} httpschema.DetailedError "VERSION_CONFLICT"
 // @Failure 500 {object} httpschema.Error "Internal server error"
 // @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId} [delete]
 func (h *tasks) Delete(w http.ResponseWriter, r *http.Request) {
@@ -343,17 +914,37 @@ func (h *tasks) Delete(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var cascade bool
+	switch r.URL.Query().Get("children") {
+	case "", "orphan":
+	case "cascade":
+		cascade = true
+	default:
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "children", Issues: []string{"Must be orphan or cascade"}}})
+		return
+	}
+
+	ifMatch, ok := parseIfMatch(r)
+	if !ok {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Invalid version"}}})
+		return
+	}
+
 	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
 	if !ok {
 		return
 	}
 
-	err := h.tasksService.Delete(r.Context(), userID, boardID, columnID, taskID)
+	err := h.tasksService.Delete(r.Context(), userID, boardID, columnID, taskID, cascade, ifMatch)
 	if err != nil {
 		if errors.Is(err, service.ErrTaskNotFound) {
 			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
 			return
 		}
+		if errors.Is(err, service.ErrVersionConflict) {
+			h.responder.VersionConflict(w, []httpschema.Detail{{Field: "If-Match", Issues: []string{"Task has changed"}}})
+			return
+		}
 		h.responder.InternalError(w, r, err)
 		return
 	}
@@ -361,6 +952,352 @@ func (h *tasks) Delete(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// Duplicate godoc
+// @Summary Duplicate a task by id
+// @Description Copy a task for the current user. The copy is placed right after the original in the same column and following tasks are shifted. It keeps the sprint of the original. Active subtasks are copied recursively to the end of their cells, along with the links between the copied tasks; links to other tasks are not copied. Archived tasks can't be copied.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param columnId path string true "Column ID"
+// @Param taskId path string true "Task ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Success 201 {object} taskResponse
+// @Header 201 {string} ETag "Version of the task copy"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "TASK_ARCHIVED or IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/duplicate [post]
+func (h *tasks) Duplicate(w http.ResponseWriter, r *http.Request) {
+	boardID, columnID, taskID, ok := h.parseBoardColumnAndTaskID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	task, err := h.tasksService.Duplicate(r.Context(), userID, boardID, columnID, taskID)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTaskArchived) {
+			h.responder.TaskArchived(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task is archived"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	setETag(w, task.Version)
+	httpschema.RespondJSON(w, h.logger, http.StatusCreated, newTaskResponse(&task))
+}
+
+// Restore godoc
+// @Summary Restore an archived task by id
+// @Description Bring an archived task back for the current user. The task is placed at the end of its lane in the column and the archive delay of the column starts over. Restoring an active task changes nothing.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param columnId path string true "Column ID"
+// @Param taskId path string true "Task ID"
+// @Success 200 {object} taskResponse
+// @Header 200 {string} ETag "Version of the task"
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "TASK_NOT_FOUND"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/restore [post]
+func (h *tasks) Restore(w http.ResponseWriter, r *http.Request) {
+	boardID, columnID, taskID, ok := h.parseBoardColumnAndTaskID(w, r)
+	if !ok {
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	task, err := h.tasksService.Restore(r.Context(), userID, boardID, columnID, taskID)
+	if err != nil {
+		if errors.Is(err, service.ErrTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	setETag(w, task.Version)
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, newTaskResponse(&task))
+}
+
+// taskBatchMaxBodySize bounds a batch request, enough for the largest operations at the maximum count.
+const taskBatchMaxBodySize = 256 << 10
+
+type taskBatchBody struct {
+	Operations []taskBatchOperationBody `json:"operations"`
+}
+
+// taskBatchOperationBody is an operation of a batch. Only the fields of its type are read.
+type taskBatchOperationBody struct {
+	Type   string `json:"type" example:"move" enums:"move,update,delete,set_label"`
+	TaskID string `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	// TargetColumnID, TargetLaneID and TargetPosition place a moved task like a single move does.
+	TargetColumnID string          `json:"targetColumnId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a2"`
+	TargetLaneID   json.RawMessage `json:"targetLaneId" swaggertype:"string" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a6"`
+	TargetPosition int64           `json:"targetPosition" example:"1"`
+	// Name, Description, Checklist, Estimate and CustomFields change an updated task like a single update does.
+	Name         *string                    `json:"name" example:"Rewrite tests"`
+	Description  *string                    `json:"description" example:"Cover edge cases"`
+	Checklist    []taskChecklistItemBody    `json:"checklist"`
+	Estimate     json.RawMessage            `json:"estimate" swaggertype:"number" example:"5"`
+	CustomFields map[string]json.RawMessage `json:"customFields" swaggertype:"object"`
+	// FieldID is the select field a label is set on and Value the option, null clearing the label.
+	FieldID string          `json:"fieldId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a8"`
+	Value   json.RawMessage `json:"value" swaggertype:"string" example:"staging"`
+}
+
+type taskBatchResponse struct {
+	Results []taskBatchResultResponse `json:"results"`
+}
+
+type taskBatchResultResponse struct {
+	Type   string `json:"type" example:"move" enums:"move,update,delete,set_label"`
+	TaskID string `json:"taskId" example:"019cc971-e5be-7df9-ae8a-c6e3f29c86a3"`
+	// Placement is set for moves.
+	Placement *taskPositionResponse `json:"placement"`
+	// Task is the task as the update or label left it.
+	Task *taskResponse `json:"task"`
+}
+
+// parseTaskBatchOperation reads an operation of a batch, reporting its problems under prefix.
+func parseTaskBatchOperation(body *taskBatchOperationBody, prefix string, details *[]httpschema.Detail) domain.TaskBatchOperation {
+	var (
+		operation domain.TaskBatchOperation
+		err       error
+	)
+
+	operation.Type = httpschema.ValidateField(prefix+"type", body.Type, domain.NewTaskBatchOperationType, details)
+	operation.TaskID, err = domain.ParseTaskID(body.TaskID)
+	if err != nil {
+		*details = append(*details, httpschema.Detail{Field: prefix + "taskId", Issues: []string{"Invalid task id"}})
+	}
+
+	switch operation.Type {
+	case domain.TaskBatchMove:
+		operation.TargetColumnID, err = domain.ParseColumnID(body.TargetColumnID)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: prefix + "targetColumnId", Issues: []string{"Invalid target column id"}})
+		}
+		operation.TargetLaneID, err = parseTargetLaneID(body.TargetLaneID)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: prefix + "targetLaneId", Issues: []string{"Invalid target lane id"}})
+		}
+		operation.TargetPosition = httpschema.ValidateField(prefix+"targetPosition", body.TargetPosition, domain.NewTaskPosition, details)
+	case domain.TaskBatchUpdate:
+		if body.Name != nil {
+			value := httpschema.ValidateField(prefix+"name", *body.Name, domain.NewTaskName, details)
+			operation.Name = &value
+		}
+		if body.Description != nil {
+			value := httpschema.ValidateField(prefix+"description", *body.Description, domain.NewTaskDescription, details)
+			operation.Description = &value
+		}
+		if body.Checklist != nil {
+			value := httpschema.ValidateField(prefix+"checklist", body.Checklist, newTaskChecklistFromBody, details)
+			operation.Checklist = &value
+		}
+		operation.Estimate, err = parseTaskEstimatePatch(body.Estimate)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: prefix + "estimate", Issues: []string{domain.ErrTaskEstimateValue}})
+		}
+		operation.CustomFields, err = parseCustomFieldValues(body.CustomFields)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: prefix + "customFields", Issues: []string{"Invalid custom field id"}})
+		}
+	case domain.TaskBatchSetLabel:
+		operation.FieldID, err = domain.ParseCustomFieldID(body.FieldID)
+		if err != nil {
+			*details = append(*details, httpschema.Detail{Field: prefix + "fieldId", Issues: []string{"Invalid custom field id"}})
+		}
+		if len(body.Value) == 0 {
+			*details = append(*details, httpschema.Detail{Field: prefix + "value", Issues: []string{"Value is required, null clears the label"}})
+		}
+		operation.Value = body.Value
+	}
+
+	return operation
+}
+
+func newTaskBatchResultResponse(result *domain.TaskBatchResult) taskBatchResultResponse {
+	response := taskBatchResultResponse{Type: result.Type.String(), TaskID: result.TaskID.String()}
+
+	switch result.Type {
+	case domain.TaskBatchMove:
+		response.Placement = &taskPositionResponse{
+			ColumnID: result.Placement.ColumnID.String(),
+			LaneID:   newLaneIDResponse(result.Placement.LaneID),
+			Position: result.Placement.Position.Int64(),
+			Version:  result.Placement.Version.Int64(),
+		}
+	case domain.TaskBatchUpdate, domain.TaskBatchSetLabel:
+		task := newTaskResponse(&result.Task)
+		response.Task = &task
+	}
+
+	return response
+}
+
+// Batch godoc
+// @Summary Apply several task operations at once
+// @Description Move, update, delete and label tasks of a board in one request. Operations run in order, each seeing the board as the ones before it left it, and are applied all at once: if any of them fails, none is, and the error names the failing operation as operations[i] in its details.
+// @Description Each operation is checked like its single-task counterpart, including workflow rules, blockers and custom field values. Blockers are read as stored; only blockers the batch itself moves into a done column or deletes count as finished.
+// @Description A label is the value of a single or multi select custom field: set_label sets value on fieldId and null clears it. Deleted tasks leave their children as top-level tasks.
+// @Description At most 100 operations are allowed and the request is limited to 256 KB.
+// @Tags tasks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param boardId path string true "Board ID"
+// @Param Idempotency-Key header string false "Key the retries of this request are sent with"
+// @Param body body taskBatchBody true "Operations to apply in order"
+// @Success 200 {object} taskBatchResponse
+// @Failure 400 {object} httpschema.DetailedError "VALIDATION_ERROR"
+// @Failure 413 {object} httpschema.DetailedError "PAYLOAD_TOO_LARGE"
+// @Failure 401 {object} httpschema.DetailedError "Unauthorized: INVALID_TOKEN or INVALID_AUTH_HEADER"
+// @Failure 404 {object} httpschema.DetailedError "BOARD_NOT_FOUND, TASK_NOT_FOUND, COLUMN_NOT_FOUND or LANE_NOT_FOUND"
+// @Failure 409 {object} httpschema.DetailedError "TASK_BLOCKED, TRANSITION_NOT_ALLOWED, TASK_ARCHIVED or IDEMPOTENCY_KEY_IN_USE"
+// @Failure 422 {object} httpschema.DetailedError "IDEMPOTENCY_KEY_REUSED"
+// @Failure 500 {object} httpschema.Error "Internal server error"
+// @Router /v1/boards/{boardId}/tasks:batch [post]
+func (h *tasks) Batch(w http.ResponseWriter, r *http.Request) {
+	boardID, err := domain.ParseBoardID(r.PathValue("boardId"))
+	if err != nil {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Invalid board id"}}})
+		return
+	}
+
+	var body taskBatchBody
+	err = decodeJSONWithLimit(r, &body, taskBatchMaxBodySize)
+	if err != nil {
+		if errors.Is(err, errBodyTooLarge) {
+			h.responder.PayloadTooLarge(w)
+		} else {
+			h.responder.ValidationError(w, []httpschema.Detail{{Field: "body", Issues: []string{"Invalid JSON body"}}})
+		}
+		return
+	}
+
+	if len(body.Operations) == 0 || len(body.Operations) > domain.MaxTaskBatchOperations {
+		h.responder.ValidationError(w, []httpschema.Detail{{Field: "operations", Issues: []string{domain.ErrTaskBatchOperationCount}}})
+		return
+	}
+
+	details := []httpschema.Detail{}
+	operations := make([]domain.TaskBatchOperation, len(body.Operations))
+	for i := range body.Operations {
+		operations[i] = parseTaskBatchOperation(&body.Operations[i], fmt.Sprintf("operations[%d].", i), &details)
+	}
+	if len(details) > 0 {
+		h.responder.ValidationError(w, details)
+		return
+	}
+
+	userID, ok := extractUserIDOrHandleMissing(w, r, h.logger, h.responder)
+	if !ok {
+		return
+	}
+
+	results, err := h.tasksService.Batch(r.Context(), userID, boardID, operations)
+	if err != nil {
+		var batchErr *service.TaskBatchError
+		if errors.As(err, &batchErr) {
+			h.respondTaskBatchError(w, r, batchErr, &operations[batchErr.Index])
+			return
+		}
+		if errors.Is(err, service.ErrBoardNotFound) {
+			h.responder.BoardNotFound(w, []httpschema.Detail{{Field: "boardId", Issues: []string{"Board not found"}}})
+			return
+		}
+		if errors.Is(err, service.ErrTaskNotFound) {
+			h.responder.TaskNotFound(w, []httpschema.Detail{{Field: "operations", Issues: []string{"Task not found"}}})
+			return
+		}
+		h.responder.InternalError(w, r, err)
+		return
+	}
+
+	response := taskBatchResponse{Results: make([]taskBatchResultResponse, len(results))}
+	for i := range results {
+		response.Results[i] = newTaskBatchResultResponse(&results[i])
+	}
+
+	httpschema.RespondJSON(w, h.logger, http.StatusOK, response)
+}
+
+// respondTaskBatchError reports the failed operation of a batch like the single-task endpoint
+// would, with the fields prefixed by operations[i].
+func (h *tasks) respondTaskBatchError(w http.ResponseWriter, r *http.Request, batchErr *service.TaskBatchError, operation *domain.TaskBatchOperation) {
+	prefix := fmt.Sprintf("operations[%d].", batchErr.Index)
+	withPrefix := func(details []httpschema.Detail) []httpschema.Detail {
+		for i := range details {
+			details[i].Field = prefix + details[i].Field
+		}
+		return details
+	}
+
+	err := batchErr.Err
+	var (
+		blockedErr    *service.TaskBlockedError
+		transitionErr *service.TransitionNotAllowedError
+		valuesErr     *service.CustomFieldValuesError
+	)
+	switch {
+	case errors.Is(err, service.ErrTaskNotFound):
+		h.responder.TaskNotFound(w, withPrefix([]httpschema.Detail{{Field: "taskId", Issues: []string{"Task not found"}}}))
+	case errors.Is(err, service.ErrColumnNotFound):
+		h.responder.ColumnNotFound(w, withPrefix([]httpschema.Detail{{Field: "targetColumnId", Issues: []string{"Column not found"}}}))
+	case errors.Is(err, service.ErrLaneNotFound):
+		h.responder.LaneNotFound(w, withPrefix([]httpschema.Detail{{Field: "targetLaneId", Issues: []string{"Lane not found"}}}))
+	case errors.Is(err, service.ErrIndexOutOfBounds):
+		h.responder.ValidationError(w, withPrefix([]httpschema.Detail{{Field: "targetPosition", Issues: []string{"Index out of bounds"}}}))
+	case errors.Is(err, service.ErrTaskArchived):
+		h.responder.TaskArchived(w, withPrefix([]httpschema.Detail{{Field: "taskId", Issues: []string{"Task is archived"}}}))
+	case errors.As(err, &blockedErr):
+		blockerIDs := make([]string, len(blockedErr.BlockerIDs))
+		for i, blockerID := range blockedErr.BlockerIDs {
+			blockerIDs[i] = blockerID.String()
+		}
+		h.responder.TaskBlocked(w, withPrefix([]httpschema.Detail{{Field: "blockedBy", Issues: blockerIDs}}))
+	case errors.As(err, &transitionErr):
+		h.responder.TransitionNotAllowed(w, withPrefix(newTransitionDetails(transitionErr)))
+	case errors.As(err, &valuesErr) && operation.Type == domain.TaskBatchSetLabel:
+		// A label fails either on its field or on its value.
+		issues := valuesErr.Issues[operation.FieldID]
+		field := "value"
+		if slices.Contains(issues, domain.ErrCustomFieldNotFound) || slices.Contains(issues, domain.ErrTaskBatchLabelField) {
+			field = "fieldId"
+		}
+		h.responder.ValidationError(w, withPrefix([]httpschema.Detail{{Field: field, Issues: issues}}))
+	case errors.As(err, &valuesErr):
+		h.responder.ValidationError(w, withPrefix(newCustomFieldValuesDetails(valuesErr)))
+	default:
+		h.responder.InternalError(w, r, batchErr)
+	}
+}
+
 func (h *tasks) parseBoardAndColumnID(w http.ResponseWriter, r *http.Request) (boardID domain.BoardID, columnID domain.ColumnID, ok bool) {
 	rawBoardID := r.PathValue("boardId")
 	boardID, err := domain.ParseBoardID(rawBoardID)
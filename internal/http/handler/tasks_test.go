@@ -2,12 +2,16 @@ package handler_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+
 	"goroutine/internal/domain"
 	"goroutine/internal/http/handler"
 	"goroutine/internal/http/httpschema"
@@ -21,6 +25,19 @@ func TestTasks_Create(t *testing.T) {
 	validBoard := testutil.ValidBoard()
 	validColumn := testutil.ValidColumn(validBoard.ID)
 	validTask := testutil.ValidTask(validColumn.ID)
+	templateID := domain.NewTaskTemplateID()
+	checkedTask := validTask
+	checkedTask.Checklist = testutil.NewValidTaskChecklist(t, domain.TaskChecklistItem{Text: "Review", Done: true})
+	childTask := validTask
+	childTask.ParentID = domain.NewTaskID()
+	envField := testutil.ValidCustomField(validBoard.ID)
+	staging, err := domain.NewCustomFieldValue(&envField, json.RawMessage(`"staging"`))
+	if err != nil {
+		t.Fatalf("NewCustomFieldValue() error = %v", err)
+	}
+	taggedTask := validTask
+	taggedTask.CustomFields = domain.TaskCustomFields{}.With(envField.ID, staging)
+	unknownFieldID := domain.NewCustomFieldID()
 
 	tests := []struct {
 		name             string
@@ -41,7 +58,7 @@ func TestTasks_Create(t *testing.T) {
 				"description": validTask.Description.String(),
 			},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -62,14 +79,134 @@ func TestTasks_Create(t *testing.T) {
 			},
 			wantCode: http.StatusCreated,
 			wantBody: map[string]any{
-				"id":          validTask.ID.String(),
-				"columnId":    validTask.ColumnID.String(),
-				"name":        validTask.Name.String(),
-				"description": validTask.Description.String(),
-				"position":    validTask.Position.Int64(),
-				"createdAt":   validTask.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
+			},
+		},
+		{
+			name:     "Success with checklist",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			inputBody: map[string]any{
+				"name":      validTask.Name.String(),
+				"checklist": []map[string]any{{"text": " Review ", "done": true}},
+			},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+					items := checklist.Items()
+					if len(items) != 1 || items[0] != (domain.TaskChecklistItem{Text: "Review", Done: true}) {
+						t.Errorf("got checklist %v, want one done Review item", items)
+					}
+					return checkedTask, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":           checkedTask.ID.String(),
+				"columnId":     checkedTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         checkedTask.Name.String(),
+				"description":  checkedTask.Description.String(),
+				"position":     checkedTask.Position.Int64(),
+				"checklist":    []any{map[string]any{"text": "Review", "done": true}},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    checkedTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    checkedTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      checkedTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success from template",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"templateId": templateID.String(), "name": "", "description": "Custom"},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.CreateFromTemplateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, gotTemplateID domain.TaskTemplateID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+					if gotTemplateID != templateID {
+						t.Errorf("got template id %v, want %v", gotTemplateID, templateID)
+					}
+					if name != nil {
+						t.Errorf("got name %v, want nil", name)
+					}
+					if description == nil || description.String() != "Custom" {
+						t.Errorf("got description %v, want Custom", description)
+					}
+					if checklist != nil {
+						t.Errorf("got checklist %v, want nil", checklist)
+					}
+					return validTask, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Template not found",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"templateId": templateID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.CreateFromTemplateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, templateID domain.TaskTemplateID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+					return domain.Task{}, service.ErrTaskTemplateNotFound
+				}
 			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskTemplateNotFoundError(),
+		},
+		{
+			name:      "Invalid template id",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"templateId": "not-a-uuid"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("templateId", []string{"Invalid template id"}),
+		},
+		{
+			name:      "Invalid checklist",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"name": "ok", "checklist": []map[string]any{{"text": "  "}}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("checklist", []string{"Checklist item text is invalid"}),
 		},
 		{
 			name:      "Invalid board id",
@@ -103,6 +240,14 @@ func TestTasks_Create(t *testing.T) {
 			wantCode:  http.StatusBadRequest,
 			wantBody:  validationError("name", []string{"Name is too short"}),
 		},
+		{
+			name:      "Invalid estimate",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"name": "Estimate me", "estimate": -1},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("estimate", []string{domain.ErrTaskEstimateValue}),
+		},
 		{
 			name:      "Missing context user",
 			boardID:   validBoard.ID.String(),
@@ -118,7 +263,7 @@ func TestTasks_Create(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"name": "ok", "description": "ok"},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
 					return domain.Task{}, service.ErrColumnNotFound
 				}
 			},
@@ -131,7 +276,7 @@ func TestTasks_Create(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"name": "ok", "description": "ok"},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
 					return domain.Task{}, service.ErrInternal
 				}
 			},
@@ -144,13 +289,138 @@ func TestTasks_Create(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			inputBody: map[string]string{"name": "ok", "description": "ok"},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
 					return domain.Task{}, errors.New("db exploded")
 				}
 			},
 			wantCode: http.StatusInternalServerError,
 			wantBody: internalError(),
 		},
+		{
+			name:      "Success with parent",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"name": "ok", "description": "ok", "parentId": childTask.ParentID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+					if parentID != childTask.ParentID {
+						t.Errorf("got parent id %v, want %v", parentID, childTask.ParentID)
+					}
+					return childTask, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":           childTask.ID.String(),
+				"columnId":     childTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     childTask.ParentID.String(),
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         childTask.Name.String(),
+				"description":  childTask.Description.String(),
+				"position":     childTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    childTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    childTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      childTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Invalid parent id",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"name": "ok", "description": "ok", "parentId": "not-a-uuid"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("parentId", []string{"Invalid parent id"}),
+		},
+		{
+			name:      "Parent task not found",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			inputBody: map[string]any{"name": "ok", "description": "ok", "parentId": childTask.ParentID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+					return domain.Task{}, service.ErrParentTaskNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: parentTaskNotFoundError(),
+		},
+		{
+			name:     "Success with custom fields",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			inputBody: map[string]any{
+				"name":         validTask.Name.String(),
+				"customFields": map[string]any{envField.ID.String(): "staging"},
+			},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+					if got := string(customFields[envField.ID]); got != `"staging"` {
+						t.Errorf("got custom field value %s, want %q", got, "staging")
+					}
+					return taggedTask, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":           taggedTask.ID.String(),
+				"columnId":     taggedTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{envField.ID.String(): "staging"},
+				"name":         taggedTask.Name.String(),
+				"description":  taggedTask.Description.String(),
+				"position":     taggedTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    taggedTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    taggedTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      taggedTask.Version.Int64(),
+			},
+		},
+		{
+			name:     "Invalid custom field id",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			inputBody: map[string]any{
+				"name":         validTask.Name.String(),
+				"customFields": map[string]any{"environment": "staging"},
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("customFields", []string{"Invalid custom field id"}),
+		},
+		{
+			name:     "Invalid custom field values",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			inputBody: map[string]any{
+				"name":         validTask.Name.String(),
+				"customFields": map[string]any{envField.ID.String(): "qa", unknownFieldID.String(): 1},
+			},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error) {
+					return domain.Task{}, fmt.Errorf("create: %w", &service.CustomFieldValuesError{Issues: map[domain.CustomFieldID][]string{
+						envField.ID:    {domain.ErrCustomFieldOptionValue},
+						unknownFieldID: {domain.ErrCustomFieldNotFound},
+					}})
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: customFieldValuesError(map[domain.CustomFieldID][]string{
+				envField.ID:    {domain.ErrCustomFieldOptionValue},
+				unknownFieldID: {domain.ErrCustomFieldNotFound},
+			}),
+		},
 		{
 			name:      "Body too large",
 			boardID:   validBoard.ID.String(),
@@ -200,11 +470,24 @@ func TestTasks_ListByColumnID(t *testing.T) {
 	first := testutil.ValidTask(validColumn.ID)
 	second := testutil.ValidTask(validColumn.ID)
 	second.Position = testutil.NewValidTaskPosition(t, first.Position.Int64()+1)
+	link := domain.TaskLink{
+		ID:        domain.NewTaskLinkID(),
+		SourceID:  first.ID,
+		TargetID:  second.ID,
+		Type:      domain.TaskLinkBlocks,
+		CreatedAt: testutil.FixedNow(),
+	}
+	first.Links = []domain.TaskLink{link}
+	second.Links = []domain.TaskLink{link}
+	envField := testutil.ValidCustomField(validBoard.ID)
+	archived := testutil.ValidTask(validColumn.ID)
+	archived.ArchivedAt = testutil.FixedNow()
 
 	tests := []struct {
 		name             string
 		boardID          string
 		columnID         string
+		query            string
 		context          context.Context
 		setupTaskService func(t *testing.T, s *MockTaskService)
 		wantCode         int
@@ -215,7 +498,7 @@ func TestTasks_ListByColumnID(t *testing.T) {
 			boardID:  validBoard.ID.String(),
 			columnID: validColumn.ID.String(),
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) ([]domain.Task, error) {
+				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error) {
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -231,22 +514,52 @@ func TestTasks_ListByColumnID(t *testing.T) {
 			wantCode: http.StatusOK,
 			wantBody: []map[string]any{
 				{
-					"id":          first.ID.String(),
-					"columnId":    first.ColumnID.String(),
-					"name":        first.Name.String(),
-					"description": first.Description.String(),
-					"position":    first.Position.Int64(),
-					"createdAt":   first.CreatedAt.Format(testutil.TimeFormat),
-					"updatedAt":   first.UpdatedAt.Format(testutil.TimeFormat),
+					"id":           first.ID.String(),
+					"columnId":     first.ColumnID.String(),
+					"laneId":       nil,
+					"parentId":     nil,
+					"sprintId":     nil,
+					"estimate":     nil,
+					"customFields": map[string]any{},
+					"name":         first.Name.String(),
+					"description":  first.Description.String(),
+					"position":     first.Position.Int64(),
+					"checklist":    []any{},
+					"links": []map[string]any{{
+						"id":        link.ID.String(),
+						"type":      "blocks",
+						"taskId":    second.ID.String(),
+						"createdAt": link.CreatedAt.Format(testutil.TimeFormat),
+					}},
+					"rollup":     emptyTaskRollup(),
+					"archivedAt": nil,
+					"createdAt":  first.CreatedAt.Format(testutil.TimeFormat),
+					"updatedAt":  first.UpdatedAt.Format(testutil.TimeFormat),
+					"version":    first.Version.Int64(),
 				},
 				{
-					"id":          second.ID.String(),
-					"columnId":    second.ColumnID.String(),
-					"name":        second.Name.String(),
-					"description": second.Description.String(),
-					"position":    second.Position.Int64(),
-					"createdAt":   second.CreatedAt.Format(testutil.TimeFormat),
-					"updatedAt":   second.UpdatedAt.Format(testutil.TimeFormat),
+					"id":           second.ID.String(),
+					"columnId":     second.ColumnID.String(),
+					"laneId":       nil,
+					"parentId":     nil,
+					"sprintId":     nil,
+					"estimate":     nil,
+					"customFields": map[string]any{},
+					"name":         second.Name.String(),
+					"description":  second.Description.String(),
+					"position":     second.Position.Int64(),
+					"checklist":    []any{},
+					"links": []map[string]any{{
+						"id":        link.ID.String(),
+						"type":      "blocked_by",
+						"taskId":    first.ID.String(),
+						"createdAt": link.CreatedAt.Format(testutil.TimeFormat),
+					}},
+					"rollup":     emptyTaskRollup(),
+					"archivedAt": nil,
+					"createdAt":  second.CreatedAt.Format(testutil.TimeFormat),
+					"updatedAt":  second.UpdatedAt.Format(testutil.TimeFormat),
+					"version":    second.Version.Int64(),
 				},
 			},
 		},
@@ -264,6 +577,88 @@ func TestTasks_ListByColumnID(t *testing.T) {
 			wantCode: http.StatusBadRequest,
 			wantBody: validationError("columnId", []string{"Invalid column id"}),
 		},
+		{
+			name:     "Filter by custom field",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			query:    "?customField=" + envField.ID.String() + ":staging",
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error) {
+					want := map[domain.CustomFieldID][]string{envField.ID: {"staging"}}
+					if diff := cmp.Diff(want, filters, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("filters mismatch (-want +got):\n%s", diff)
+					}
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []map[string]any{},
+		},
+		{
+			name:     "Success including archived",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			query:    "?includeArchived=true",
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error) {
+					if !includeArchived {
+						t.Errorf("got active tasks only, want include archived")
+					}
+					return []domain.Task{archived}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []map[string]any{
+				{
+					"id":           archived.ID.String(),
+					"columnId":     archived.ColumnID.String(),
+					"laneId":       nil,
+					"parentId":     nil,
+					"sprintId":     nil,
+					"estimate":     nil,
+					"customFields": map[string]any{},
+					"name":         archived.Name.String(),
+					"description":  archived.Description.String(),
+					"position":     archived.Position.Int64(),
+					"checklist":    []any{},
+					"links":        []any{},
+					"rollup":       emptyTaskRollup(),
+					"archivedAt":   testutil.FixedNowStr(),
+					"createdAt":    archived.CreatedAt.Format(testutil.TimeFormat),
+					"updatedAt":    archived.UpdatedAt.Format(testutil.TimeFormat),
+					"version":      archived.Version.Int64(),
+				},
+			},
+		},
+		{
+			name:     "Invalid include archived",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			query:    "?includeArchived=maybe",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("includeArchived", []string{"Must be true or false"}),
+		},
+		{
+			name:     "Custom field filter without value",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			query:    "?customField=" + envField.ID.String(),
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("customField", []string{"Must be <fieldId>:<value>"}),
+		},
+		{
+			name:     "Invalid custom field filter",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			query:    "?customField=" + envField.ID.String() + ":qa",
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error) {
+					return nil, &service.CustomFieldValuesError{Issues: map[domain.CustomFieldID][]string{envField.ID: {domain.ErrCustomFieldOptionValue}}}
+				}
+			},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("customFields."+envField.ID.String(), []string{domain.ErrCustomFieldOptionValue}),
+		},
 		{
 			name:     "Missing context user",
 			boardID:  validBoard.ID.String(),
@@ -277,7 +672,7 @@ func TestTasks_ListByColumnID(t *testing.T) {
 			boardID:  validBoard.ID.String(),
 			columnID: validColumn.ID.String(),
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) ([]domain.Task, error) {
+				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error) {
 					return nil, service.ErrColumnNotFound
 				}
 			},
@@ -289,7 +684,7 @@ func TestTasks_ListByColumnID(t *testing.T) {
 			boardID:  validBoard.ID.String(),
 			columnID: validColumn.ID.String(),
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID) ([]domain.Task, error) {
+				s.ListByColumnIDFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, filters map[domain.CustomFieldID][]string, includeArchived bool) ([]domain.Task, error) {
 					return nil, service.ErrInternal
 				}
 			},
@@ -302,7 +697,7 @@ func TestTasks_ListByColumnID(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks"
+			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks" + tt.query
 			req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
 			ctx := tt.context
 			if ctx == nil {
@@ -354,9 +749,11 @@ func TestTasks_Update(t *testing.T) {
 		columnID         string
 		taskID           string
 		inputBody        any
+		ifMatch          string
 		context          context.Context
 		setupTaskService func(t *testing.T, s *MockTaskService)
 		wantCode         int
+		wantETag         string
 		wantBody         any
 	}{
 		{
@@ -365,8 +762,13 @@ func TestTasks_Update(t *testing.T) {
 			columnID:  validColumn.ID.String(),
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]string{"name": updatedName.String(), "description": updatedDescription.String()},
+			ifMatch:   `"1"`,
+			wantETag:  `"1"`,
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
+					if ifMatch != validTask.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validTask.Version)
+					}
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -390,15 +792,106 @@ func TestTasks_Update(t *testing.T) {
 			},
 			wantCode: http.StatusOK,
 			wantBody: map[string]any{
-				"id":          updatedTask.ID.String(),
-				"columnId":    updatedTask.ColumnID.String(),
-				"name":        updatedTask.Name.String(),
-				"description": updatedTask.Description.String(),
-				"position":    updatedTask.Position.Int64(),
-				"createdAt":   updatedTask.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   updatedTask.UpdatedAt.Format(testutil.TimeFormat),
+				"id":           updatedTask.ID.String(),
+				"columnId":     updatedTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         updatedTask.Name.String(),
+				"description":  updatedTask.Description.String(),
+				"position":     updatedTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    updatedTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    updatedTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      updatedTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success (estimate set)",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"estimate": 2.5},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
+					if estimate == nil || !estimate.IsSet() || estimate.Float64() != 2.5 {
+						t.Errorf("got estimate %+v, want 2.5", estimate)
+					}
+					estimated := validTask
+					estimated.Estimate = *estimate
+					return estimated, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     2.5,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success (null estimate clears it)",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"estimate": nil},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
+					if estimate == nil || estimate.IsSet() {
+						t.Errorf("got estimate %+v, want a cleared estimate", estimate)
+					}
+					return validTask, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
 			},
 		},
+		{
+			name:      "Invalid estimate",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"estimate": "five"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("estimate", []string{domain.ErrTaskEstimateValue}),
+		},
 		{
 			name:      "Success (empty body no-op)",
 			boardID:   validBoard.ID.String(),
@@ -406,25 +899,73 @@ func TestTasks_Update(t *testing.T) {
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]any{},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
 					if name != nil {
 						t.Errorf("got name %+v, want nil", name)
 					}
 					if description != nil {
 						t.Errorf("got description %+v, want nil", description)
 					}
+					if checklist != nil {
+						t.Errorf("got checklist %+v, want nil", checklist)
+					}
 					return validTask, nil
 				}
 			},
 			wantCode: http.StatusOK,
 			wantBody: map[string]any{
-				"id":          validTask.ID.String(),
-				"columnId":    validTask.ColumnID.String(),
-				"name":        validTask.Name.String(),
-				"description": validTask.Description.String(),
-				"position":    validTask.Position.Int64(),
-				"createdAt":   validTask.CreatedAt.Format(testutil.TimeFormat),
-				"updatedAt":   validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success (clear checklist)",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"checklist": []any{}},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
+					if checklist == nil || checklist.Len() != 0 {
+						t.Errorf("got checklist %+v, want empty", checklist)
+					}
+					return validTask, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
 			},
 		},
 		{
@@ -472,6 +1013,31 @@ func TestTasks_Update(t *testing.T) {
 			wantCode:  http.StatusBadRequest,
 			wantBody:  validationError("name", []string{"Name is too short"}),
 		},
+		{
+			name:      "Invalid If-Match",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]string{"name": "Renamed"},
+			ifMatch:   `W/"1"`,
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:      "Version conflict",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]string{"name": "Renamed"},
+			ifMatch:   `"1"`,
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
+					return domain.Task{}, service.ErrVersionConflict
+				}
+			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Task has changed"),
+		},
 		{
 			name:      "Missing context user",
 			boardID:   validBoard.ID.String(),
@@ -489,7 +1055,7 @@ func TestTasks_Update(t *testing.T) {
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]string{"name": "Renamed"},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
 					return domain.Task{}, service.ErrTaskNotFound
 				}
 			},
@@ -503,7 +1069,7 @@ func TestTasks_Update(t *testing.T) {
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]string{"name": "Renamed"},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				s.UpdateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error) {
 					return domain.Task{}, service.ErrInternal
 				}
 			},
@@ -527,6 +1093,9 @@ func TestTasks_Update(t *testing.T) {
 
 			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks/" + tt.taskID
 			req := buildTaskRequest(t, http.MethodPatch, path, tt.inputBody)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx := tt.context
 			if ctx == nil {
 				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
@@ -548,6 +1117,9 @@ func TestTasks_Update(t *testing.T) {
 
 			testutil.AssertStatusCode(t, rr, tt.wantCode)
 			testutil.AssertContentType(t, rr, "application/json")
+			if got := rr.Header().Get("ETag"); tt.wantETag != "" && got != tt.wantETag {
+				t.Errorf("got ETag %q, want %q", got, tt.wantETag)
+			}
 			testutil.AssertResponseBody(t, rr, tt.wantBody)
 		})
 	}
@@ -560,7 +1132,10 @@ func TestTasks_Move(t *testing.T) {
 	validColumn := testutil.ValidColumn(validBoard.ID)
 	validTask := testutil.ValidTask(validColumn.ID)
 	targetColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	targetLane := testutil.ValidLane(validBoard.ID)
 	targetPosition := testutil.NewValidTaskPosition(t, 2)
+	blockerID := domain.NewTaskID()
+	movedVersion := testutil.UpdateValidTask(t, &validTask, "Renamed", "Renamed description", testutil.FixedNow()).Version
 
 	tests := []struct {
 		name             string
@@ -568,9 +1143,11 @@ func TestTasks_Move(t *testing.T) {
 		columnID         string
 		taskID           string
 		inputBody        any
+		ifMatch          string
 		context          context.Context
 		setupTaskService func(t *testing.T, s *MockTaskService)
 		wantCode         int
+		wantETag         string
 		wantBody         any
 	}{
 		{
@@ -583,7 +1160,7 @@ func TestTasks_Move(t *testing.T) {
 				"targetPosition": targetPosition.Int64(),
 			},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, targetLaneID *domain.LaneID, gotTargetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -599,41 +1176,150 @@ func TestTasks_Move(t *testing.T) {
 					if gotTargetColumnID != targetColumn.ID {
 						t.Errorf("got target column id %v, want %v", gotTargetColumnID, targetColumn.ID)
 					}
+					if targetLaneID != nil {
+						t.Errorf("got target lane id %v, want nil", *targetLaneID)
+					}
 					if gotTargetPosition != targetPosition {
 						t.Errorf("got target position %v, want %v", gotTargetPosition, targetPosition)
 					}
-					return targetColumn.ID, targetPosition, nil
+					return domain.TaskPlacement{ColumnID: targetColumn.ID, Position: targetPosition, Version: movedVersion}, nil
 				}
 			},
 			wantCode: http.StatusOK,
+			wantETag: `"2"`,
 			wantBody: map[string]any{
 				"columnId": targetColumn.ID.String(),
+				"laneId":   nil,
 				"position": targetPosition.Int64(),
+				"version":  movedVersion.Int64(),
 			},
 		},
 		{
-			name:      "Invalid board id",
-			boardID:   "not-a-uuid",
-			columnID:  validColumn.ID.String(),
-			taskID:    validTask.ID.String(),
-			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
-			wantCode:  http.StatusBadRequest,
-			wantBody:  validationError("boardId", []string{"Invalid board id"}),
-		},
-		{
-			name:      "Invalid column id",
-			boardID:   validBoard.ID.String(),
-			columnID:  "not-a-uuid",
-			taskID:    validTask.ID.String(),
-			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
-			wantCode:  http.StatusBadRequest,
-			wantBody:  validationError("columnId", []string{"Invalid column id"}),
+			name:     "Success into lane",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			inputBody: map[string]any{
+				"targetColumnId": targetColumn.ID.String(),
+				"targetLaneId":   targetLane.ID.String(),
+				"targetPosition": targetPosition.Int64(),
+			},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					if targetLaneID == nil || *targetLaneID != targetLane.ID {
+						t.Errorf("got target lane id %v, want %v", targetLaneID, targetLane.ID)
+					}
+					return domain.TaskPlacement{ColumnID: targetColumnID, LaneID: *targetLaneID, Position: targetPosition, Version: movedVersion}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantETag: `"2"`,
+			wantBody: map[string]any{
+				"columnId": targetColumn.ID.String(),
+				"laneId":   targetLane.ID.String(),
+				"position": targetPosition.Int64(),
+				"version":  movedVersion.Int64(),
+			},
 		},
 		{
-			name:      "Invalid task id",
-			boardID:   validBoard.ID.String(),
-			columnID:  validColumn.ID.String(),
-			taskID:    "not-a-uuid",
+			name:     "Success into default lane",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			inputBody: map[string]any{
+				"targetColumnId": targetColumn.ID.String(),
+				"targetLaneId":   nil,
+				"targetPosition": targetPosition.Int64(),
+			},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					if targetLaneID == nil || !targetLaneID.IsNil() {
+						t.Errorf("got target lane id %v, want pointer to nil id", targetLaneID)
+					}
+					return domain.TaskPlacement{ColumnID: targetColumnID, Position: targetPosition, Version: movedVersion}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantETag: `"2"`,
+			wantBody: map[string]any{
+				"columnId": targetColumn.ID.String(),
+				"laneId":   nil,
+				"position": targetPosition.Int64(),
+				"version":  movedVersion.Int64(),
+			},
+		},
+		{
+			name:      "Success with If-Match",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": targetPosition.Int64()},
+			ifMatch:   `"1"`,
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					if ifMatch != validTask.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validTask.Version)
+					}
+					return domain.TaskPlacement{ColumnID: targetColumnID, Position: targetPosition, Version: movedVersion}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantETag: `"2"`,
+			wantBody: map[string]any{
+				"columnId": targetColumn.ID.String(),
+				"laneId":   nil,
+				"position": targetPosition.Int64(),
+				"version":  movedVersion.Int64(),
+			},
+		},
+		{
+			name:      "Invalid If-Match",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			ifMatch:   "1",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:      "Version conflict",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			ifMatch:   `"1"`,
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, service.ErrVersionConflict
+				}
+			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Task has changed"),
+		},
+		{
+			name:      "Invalid board id",
+			boardID:   "not-a-uuid",
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:      "Invalid column id",
+			boardID:   validBoard.ID.String(),
+			columnID:  "not-a-uuid",
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("columnId", []string{"Invalid column id"}),
+		},
+		{
+			name:      "Invalid task id",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    "not-a-uuid",
 			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
 			wantCode:  http.StatusBadRequest,
 			wantBody:  validationError("taskId", []string{"Invalid task id"}),
@@ -656,6 +1342,15 @@ func TestTasks_Move(t *testing.T) {
 			wantCode:  http.StatusBadRequest,
 			wantBody:  validationError("targetColumnId", []string{"Invalid target column id"}),
 		},
+		{
+			name:      "Invalid target lane id",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetLaneId": "not-a-uuid", "targetPosition": 1},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("targetLaneId", []string{"Invalid target lane id"}),
+		},
 		{
 			name:      "Invalid target position",
 			boardID:   validBoard.ID.String(),
@@ -682,13 +1377,68 @@ func TestTasks_Move(t *testing.T) {
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 10},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					return domain.ColumnID{}, domain.TaskPosition{}, service.ErrIndexOutOfBounds
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, service.ErrIndexOutOfBounds
 				}
 			},
 			wantCode: http.StatusBadRequest,
 			wantBody: validationError("targetPosition", []string{"Index out of bounds"}),
 		},
+		{
+			name:      "Task blocked",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, &service.TaskBlockedError{BlockerIDs: []domain.TaskID{blockerID}}
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: taskBlockedError(blockerID.String()),
+		},
+		{
+			name:      "Transition not allowed",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, &service.TransitionNotAllowedError{
+						Restricted:      true,
+						UnmetConditions: []domain.ColumnEntryCondition{domain.ColumnEntryChecklistDone, domain.ColumnEntryEstimateSet},
+					}
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: transitionNotAllowedError(
+				map[string]any{"field": "targetColumnId", "issues": []string{"Tasks can't be moved from this column to the target column"}},
+				map[string]any{"field": "targetColumnId", "issues": []string{"The target column doesn't let you move tasks into it"}},
+				map[string]any{"field": "checklist", "issues": []string{"All checklist items must be done"}},
+				map[string]any{"field": "estimate", "issues": []string{"Estimate must be set"}},
+			),
+		},
+		{
+			name:      "Entry condition unmet",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, fmt.Errorf("task service: move: %w", &service.TransitionNotAllowedError{
+						Allowed:         true,
+						UnmetConditions: []domain.ColumnEntryCondition{domain.ColumnEntryEstimateSet},
+					})
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: transitionNotAllowedError(
+				map[string]any{"field": "estimate", "issues": []string{"Estimate must be set"}},
+			),
+		},
 		{
 			name:      "Task not found",
 			boardID:   validBoard.ID.String(),
@@ -696,8 +1446,8 @@ func TestTasks_Move(t *testing.T) {
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					return domain.ColumnID{}, domain.TaskPosition{}, service.ErrTaskNotFound
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, service.ErrTaskNotFound
 				}
 			},
 			wantCode: http.StatusNotFound,
@@ -710,13 +1460,41 @@ func TestTasks_Move(t *testing.T) {
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					return domain.ColumnID{}, domain.TaskPosition{}, service.ErrColumnNotFound
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, service.ErrColumnNotFound
 				}
 			},
 			wantCode: http.StatusNotFound,
 			wantBody: columnNotFoundError("targetColumnId"),
 		},
+		{
+			name:      "Target lane not found",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetLaneId": targetLane.ID.String(), "targetPosition": 1},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, service.ErrLaneNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: laneNotFoundError("targetLaneId"),
+		},
+		{
+			name:      "Task archived",
+			boardID:   validBoard.ID.String(),
+			columnID:  validColumn.ID.String(),
+			taskID:    validTask.ID.String(),
+			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, service.ErrTaskArchived
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: taskArchivedError(),
+		},
 		{
 			name:      "Internal error",
 			boardID:   validBoard.ID.String(),
@@ -724,8 +1502,8 @@ func TestTasks_Move(t *testing.T) {
 			taskID:    validTask.ID.String(),
 			inputBody: map[string]any{"targetColumnId": targetColumn.ID.String(), "targetPosition": 1},
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					return domain.ColumnID{}, domain.TaskPosition{}, service.ErrInternal
+				s.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, service.ErrInternal
 				}
 			},
 			wantCode: http.StatusInternalServerError,
@@ -748,6 +1526,9 @@ func TestTasks_Move(t *testing.T) {
 
 			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks/" + tt.taskID + "/position"
 			req := buildTaskRequest(t, http.MethodPut, path, tt.inputBody)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx := tt.context
 			if ctx == nil {
 				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
@@ -769,6 +1550,9 @@ func TestTasks_Move(t *testing.T) {
 
 			testutil.AssertStatusCode(t, rr, tt.wantCode)
 			testutil.AssertContentType(t, rr, "application/json")
+			if got := rr.Header().Get("ETag"); got != tt.wantETag {
+				t.Errorf("got ETag %q, want %q", got, tt.wantETag)
+			}
 			testutil.AssertResponseBody(t, rr, tt.wantBody)
 		})
 	}
@@ -786,6 +1570,8 @@ func TestTasks_Delete(t *testing.T) {
 		boardID          string
 		columnID         string
 		taskID           string
+		query            string
+		ifMatch          string
 		context          context.Context
 		setupTaskService func(t *testing.T, s *MockTaskService)
 		wantCode         int
@@ -796,8 +1582,12 @@ func TestTasks_Delete(t *testing.T) {
 			boardID:  validBoard.ID.String(),
 			columnID: validColumn.ID.String(),
 			taskID:   validTask.ID.String(),
+			ifMatch:  `"1"`,
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error {
+					if ifMatch != validTask.Version {
+						t.Errorf("got if-match %v, want %v", ifMatch, validTask.Version)
+					}
 					if callerID != validBoard.OwnerID {
 						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
 					}
@@ -810,12 +1600,41 @@ func TestTasks_Delete(t *testing.T) {
 					if taskID != validTask.ID {
 						t.Errorf("got task id %v, want %v", taskID, validTask.ID)
 					}
+					if cascade {
+						t.Errorf("got cascade true, want false")
+					}
+					return nil
+				}
+			},
+			wantCode: http.StatusNoContent,
+			wantBody: nil,
+		},
+		{
+			name:     "Success cascade",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			query:    "?children=cascade",
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error {
+					if !cascade {
+						t.Errorf("got cascade false, want true")
+					}
 					return nil
 				}
 			},
 			wantCode: http.StatusNoContent,
 			wantBody: nil,
 		},
+		{
+			name:     "Invalid children mode",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			query:    "?children=keep",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("children", []string{"Must be orphan or cascade"}),
+		},
 		{
 			name:     "Invalid board id",
 			boardID:  "not-a-uuid",
@@ -840,6 +1659,29 @@ func TestTasks_Delete(t *testing.T) {
 			wantCode: http.StatusBadRequest,
 			wantBody: validationError("taskId", []string{"Invalid task id"}),
 		},
+		{
+			name:     "Invalid If-Match",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			ifMatch:  `W/"1"`,
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("If-Match", []string{"Invalid version"}),
+		},
+		{
+			name:     "Version conflict",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			ifMatch:  `"1"`,
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error {
+					return service.ErrVersionConflict
+				}
+			},
+			wantCode: http.StatusPreconditionFailed,
+			wantBody: versionConflictError("Task has changed"),
+		},
 		{
 			name:     "Missing context user",
 			boardID:  validBoard.ID.String(),
@@ -855,7 +1697,7 @@ func TestTasks_Delete(t *testing.T) {
 			columnID: validColumn.ID.String(),
 			taskID:   validTask.ID.String(),
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error {
 					return service.ErrTaskNotFound
 				}
 			},
@@ -868,7 +1710,7 @@ func TestTasks_Delete(t *testing.T) {
 			columnID: validColumn.ID.String(),
 			taskID:   validTask.ID.String(),
 			setupTaskService: func(t *testing.T, s *MockTaskService) {
-				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+				s.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error {
 					return service.ErrInternal
 				}
 			},
@@ -881,8 +1723,11 @@ func TestTasks_Delete(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks/" + tt.taskID
+			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks/" + tt.taskID + tt.query
 			req := httptest.NewRequest(http.MethodDelete, path, http.NoBody)
+			if tt.ifMatch != "" {
+				req.Header.Set("If-Match", tt.ifMatch)
+			}
 			ctx := tt.context
 			if ctx == nil {
 				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
@@ -922,3 +1767,894 @@ func buildTaskRequest(t *testing.T, method, path string, body any) *http.Request
 	req, _ := testutil.NewJSONRequestAndRecorder(t, method, path, body)
 	return req
 }
+
+func TestTasks_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	copiedTask := validTask
+	copiedTask.ID = domain.NewTaskID()
+
+	tests := []struct {
+		name             string
+		boardID          string
+		columnID         string
+		taskID           string
+		context          context.Context
+		setupTaskService func(t *testing.T, s *MockTaskService)
+		wantCode         int
+		wantBody         any
+	}{
+		{
+			name:     "Success",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.DuplicateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if columnID != validColumn.ID {
+						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
+					}
+					if taskID != validTask.ID {
+						t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+					}
+					return copiedTask, nil
+				}
+			},
+			wantCode: http.StatusCreated,
+			wantBody: map[string]any{
+				"id":           copiedTask.ID.String(),
+				"columnId":     copiedTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         copiedTask.Name.String(),
+				"description":  copiedTask.Description.String(),
+				"position":     copiedTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    copiedTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    copiedTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      copiedTask.Version.Int64(),
+			},
+		},
+		{
+			name:     "Invalid task id",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("taskId", []string{"Invalid task id"}),
+		},
+		{
+			name:     "Missing context user",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:     "Task not found",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.DuplicateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrTaskNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskNotFoundError("taskId"),
+		},
+		{
+			name:     "Task archived",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.DuplicateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrTaskArchived
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: taskArchivedError(),
+		},
+		{
+			name:     "Internal error",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.DuplicateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks/" + tt.taskID + "/duplicate"
+			req := httptest.NewRequest(http.MethodPost, path, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+			req.SetPathValue("columnId", tt.columnID)
+			req.SetPathValue("taskId", tt.taskID)
+
+			rr := httptest.NewRecorder()
+			mockTasks := NewMockTaskService(t)
+			if tt.setupTaskService != nil {
+				tt.setupTaskService(t, mockTasks)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTasks(logger, mockTasks, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Duplicate(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTasks_Restore(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+
+	tests := []struct {
+		name             string
+		boardID          string
+		columnID         string
+		taskID           string
+		context          context.Context
+		setupTaskService func(t *testing.T, s *MockTaskService)
+		wantCode         int
+		wantBody         any
+	}{
+		{
+			name:     "Success",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.RestoreFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if columnID != validColumn.ID {
+						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
+					}
+					if taskID != validTask.ID {
+						t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+					}
+					return validTask, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
+			},
+		},
+		{
+			name:     "Invalid task id",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("taskId", []string{"Invalid task id"}),
+		},
+		{
+			name:     "Missing context user",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:     "Task not found",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.RestoreFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrTaskNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskNotFoundError("taskId"),
+		},
+		{
+			name:     "Internal error",
+			boardID:  validBoard.ID.String(),
+			columnID: validColumn.ID.String(),
+			taskID:   validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.RestoreFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := "/v1/boards/" + tt.boardID + "/columns/" + tt.columnID + "/tasks/" + tt.taskID + "/restore"
+			req := httptest.NewRequest(http.MethodPost, path, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", tt.boardID)
+			req.SetPathValue("columnId", tt.columnID)
+			req.SetPathValue("taskId", tt.taskID)
+
+			rr := httptest.NewRecorder()
+			mockTasks := NewMockTaskService(t)
+			if tt.setupTaskService != nil {
+				tt.setupTaskService(t, mockTasks)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTasks(logger, mockTasks, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Restore(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTasks_ListChildren(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	child := testutil.ValidTask(domain.NewColumnID())
+	child.ParentID = validTask.ID
+
+	tests := []struct {
+		name             string
+		taskID           string
+		context          context.Context
+		setupTaskService func(t *testing.T, s *MockTaskService)
+		wantCode         int
+		wantBody         any
+	}{
+		{
+			name:   "Success",
+			taskID: validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.ListChildrenFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) ([]domain.Task, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if taskID != validTask.ID {
+						t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+					}
+					return []domain.Task{child}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []map[string]any{{
+				"id":           child.ID.String(),
+				"columnId":     child.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     validTask.ID.String(),
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         child.Name.String(),
+				"description":  child.Description.String(),
+				"position":     child.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    child.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    child.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      child.Version.Int64(),
+			}},
+		},
+		{
+			name:   "No children",
+			taskID: validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.ListChildrenFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) ([]domain.Task, error) {
+					return nil, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: []any{},
+		},
+		{
+			name:     "Invalid task id",
+			taskID:   "not-a-uuid",
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("taskId", []string{"Invalid task id"}),
+		},
+		{
+			name:     "Missing context user",
+			taskID:   validTask.ID.String(),
+			context:  context.Background(),
+			wantCode: http.StatusUnauthorized,
+			wantBody: unauthorizedTokenError(),
+		},
+		{
+			name:   "Task not found",
+			taskID: validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.ListChildrenFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) ([]domain.Task, error) {
+					return nil, service.ErrTaskNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskNotFoundError("taskId"),
+		},
+		{
+			name:   "Internal error",
+			taskID: validTask.ID.String(),
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.ListChildrenFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) ([]domain.Task, error) {
+					return nil, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardID, columnID := validBoard.ID.String(), validColumn.ID.String()
+			path := "/v1/boards/" + boardID + "/columns/" + columnID + "/tasks/" + tt.taskID + "/children"
+			req := httptest.NewRequest(http.MethodGet, path, http.NoBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", boardID)
+			req.SetPathValue("columnId", columnID)
+			req.SetPathValue("taskId", tt.taskID)
+
+			rr := httptest.NewRecorder()
+			mockTasks := NewMockTaskService(t)
+			if tt.setupTaskService != nil {
+				tt.setupTaskService(t, mockTasks)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTasks(logger, mockTasks, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.ListChildren(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTasks_SetParent(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	parentID := domain.NewTaskID()
+	childTask := validTask
+	childTask.ParentID = parentID
+
+	tests := []struct {
+		name             string
+		inputBody        any
+		context          context.Context
+		setupTaskService func(t *testing.T, s *MockTaskService)
+		wantCode         int
+		wantBody         any
+	}{
+		{
+			name:      "Success",
+			inputBody: map[string]any{"parentId": parentID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.SetParentFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotParentID domain.TaskID) (domain.Task, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if taskID != validTask.ID {
+						t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+					}
+					if gotParentID != parentID {
+						t.Errorf("got parent id %v, want %v", gotParentID, parentID)
+					}
+					return childTask, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":           childTask.ID.String(),
+				"columnId":     childTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     parentID.String(),
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         childTask.Name.String(),
+				"description":  childTask.Description.String(),
+				"position":     childTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    childTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    childTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      childTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Success clear parent",
+			inputBody: map[string]any{"parentId": nil},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.SetParentFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotParentID domain.TaskID) (domain.Task, error) {
+					if !gotParentID.IsNil() {
+						t.Errorf("got parent id %v, want nil", gotParentID)
+					}
+					return validTask, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{
+				"id":           validTask.ID.String(),
+				"columnId":     validTask.ColumnID.String(),
+				"laneId":       nil,
+				"parentId":     nil,
+				"sprintId":     nil,
+				"estimate":     nil,
+				"customFields": map[string]any{},
+				"name":         validTask.Name.String(),
+				"description":  validTask.Description.String(),
+				"position":     validTask.Position.Int64(),
+				"checklist":    []any{},
+				"links":        []any{},
+				"rollup":       emptyTaskRollup(),
+				"archivedAt":   nil,
+				"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+				"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+				"version":      validTask.Version.Int64(),
+			},
+		},
+		{
+			name:      "Invalid JSON",
+			inputBody: "{",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "Invalid parent id",
+			inputBody: map[string]any{"parentId": "not-a-uuid"},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("parentId", []string{"Invalid parent id"}),
+		},
+		{
+			name:      "Self parent",
+			inputBody: map[string]any{"parentId": validTask.ID.String()},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("parentId", []string{domain.ErrTaskParentSelf}),
+		},
+		{
+			name:      "Missing context user",
+			inputBody: map[string]any{"parentId": parentID.String()},
+			context:   context.Background(),
+			wantCode:  http.StatusUnauthorized,
+			wantBody:  unauthorizedTokenError(),
+		},
+		{
+			name:      "Task not found",
+			inputBody: map[string]any{"parentId": parentID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.SetParentFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotParentID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrTaskNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: taskNotFoundError("taskId"),
+		},
+		{
+			name:      "Parent task not found",
+			inputBody: map[string]any{"parentId": parentID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.SetParentFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotParentID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrParentTaskNotFound
+				}
+			},
+			wantCode: http.StatusNotFound,
+			wantBody: parentTaskNotFoundError(),
+		},
+		{
+			name:      "Cycle",
+			inputBody: map[string]any{"parentId": parentID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.SetParentFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotParentID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrTaskParentCycle
+				}
+			},
+			wantCode: http.StatusConflict,
+			wantBody: map[string]any{
+				"code":      "TASK_PARENT_CYCLE",
+				"message":   "Task parent would create a cycle",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "parentId", "issues": []string{"Parent is a descendant of the task"}},
+				},
+			},
+		},
+		{
+			name:      "Internal error",
+			inputBody: map[string]any{"parentId": parentID.String()},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.SetParentFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, gotParentID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, service.ErrInternal
+				}
+			},
+			wantCode: http.StatusInternalServerError,
+			wantBody: internalError(),
+		},
+		{
+			name:      "Body too large",
+			inputBody: testutil.Valid25KBJSON(),
+			wantCode:  http.StatusRequestEntityTooLarge,
+			wantBody:  payloadTooLargeError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardID, columnID, taskID := validBoard.ID.String(), validColumn.ID.String(), validTask.ID.String()
+			path := "/v1/boards/" + boardID + "/columns/" + columnID + "/tasks/" + taskID + "/parent"
+			req := buildTaskRequest(t, http.MethodPut, path, tt.inputBody)
+			ctx := tt.context
+			if ctx == nil {
+				ctx = context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID)
+			}
+			req = req.WithContext(ctx)
+			req.SetPathValue("boardId", boardID)
+			req.SetPathValue("columnId", columnID)
+			req.SetPathValue("taskId", taskID)
+
+			rr := httptest.NewRecorder()
+			mockTasks := NewMockTaskService(t)
+			if tt.setupTaskService != nil {
+				tt.setupTaskService(t, mockTasks)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTasks(logger, mockTasks, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.SetParent(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestTasks_Batch(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	targetColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	validTask := testutil.ValidTask(validColumn.ID)
+	otherTask := testutil.NewValidTask(t, validColumn.ID, "Other", "", 2)
+	envField := testutil.ValidCustomField(validBoard.ID)
+	targetPosition := testutil.NewValidTaskPosition(t, 1)
+	blockerID := domain.NewTaskID()
+
+	moveOperation := map[string]any{
+		"type":           "move",
+		"taskId":         validTask.ID.String(),
+		"targetColumnId": targetColumn.ID.String(),
+		"targetPosition": targetPosition.Int64(),
+	}
+	deleteOperation := map[string]any{"type": "delete", "taskId": otherTask.ID.String()}
+	tooManyOperations := make([]any, domain.MaxTaskBatchOperations+1)
+	for i := range tooManyOperations {
+		tooManyOperations[i] = deleteOperation
+	}
+
+	failWith := func(err error) func(t *testing.T, s *MockTaskService) {
+		return func(t *testing.T, s *MockTaskService) {
+			s.BatchFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, operations []domain.TaskBatchOperation) ([]domain.TaskBatchResult, error) {
+				return nil, err
+			}
+		}
+	}
+
+	tests := []struct {
+		name             string
+		boardID          string
+		inputBody        any
+		setupTaskService func(t *testing.T, s *MockTaskService)
+		wantCode         int
+		wantBody         any
+	}{
+		{
+			name:    "Success",
+			boardID: validBoard.ID.String(),
+			inputBody: map[string]any{"operations": []any{
+				moveOperation,
+				map[string]any{"type": "set_label", "taskId": validTask.ID.String(), "fieldId": envField.ID.String(), "value": "staging"},
+				deleteOperation,
+			}},
+			setupTaskService: func(t *testing.T, s *MockTaskService) {
+				s.BatchFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, operations []domain.TaskBatchOperation) ([]domain.TaskBatchResult, error) {
+					if callerID != validBoard.OwnerID {
+						t.Errorf("got caller id %v, want %v", callerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					want := []domain.TaskBatchOperation{
+						{Type: domain.TaskBatchMove, TaskID: validTask.ID, TargetColumnID: targetColumn.ID, TargetPosition: targetPosition},
+						{Type: domain.TaskBatchSetLabel, TaskID: validTask.ID, FieldID: envField.ID, Value: json.RawMessage(`"staging"`)},
+						{Type: domain.TaskBatchDelete, TaskID: otherTask.ID},
+					}
+					if diff := cmp.Diff(want, operations, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("operations mismatch (-want +got):\n%s", diff)
+					}
+					return []domain.TaskBatchResult{
+						{Type: domain.TaskBatchMove, TaskID: validTask.ID, Placement: domain.TaskPlacement{ColumnID: targetColumn.ID, Position: targetPosition, Version: validTask.Version}},
+						{Type: domain.TaskBatchSetLabel, TaskID: validTask.ID, Task: validTask},
+						{Type: domain.TaskBatchDelete, TaskID: otherTask.ID},
+					}, nil
+				}
+			},
+			wantCode: http.StatusOK,
+			wantBody: map[string]any{"results": []any{
+				map[string]any{
+					"type":      "move",
+					"taskId":    validTask.ID.String(),
+					"placement": map[string]any{"columnId": targetColumn.ID.String(), "laneId": nil, "position": targetPosition.Int64(), "version": validTask.Version.Int64()},
+					"task":      nil,
+				},
+				map[string]any{
+					"type":      "set_label",
+					"taskId":    validTask.ID.String(),
+					"placement": nil,
+					"task": map[string]any{
+						"id":           validTask.ID.String(),
+						"columnId":     validTask.ColumnID.String(),
+						"laneId":       nil,
+						"parentId":     nil,
+						"sprintId":     nil,
+						"estimate":     nil,
+						"customFields": map[string]any{},
+						"name":         validTask.Name.String(),
+						"description":  validTask.Description.String(),
+						"position":     validTask.Position.Int64(),
+						"checklist":    []any{},
+						"links":        []any{},
+						"rollup":       emptyTaskRollup(),
+						"archivedAt":   nil,
+						"createdAt":    validTask.CreatedAt.Format(testutil.TimeFormat),
+						"updatedAt":    validTask.UpdatedAt.Format(testutil.TimeFormat),
+						"version":      validTask.Version.Int64(),
+					},
+				},
+				map[string]any{"type": "delete", "taskId": otherTask.ID.String(), "placement": nil, "task": nil},
+			}},
+		},
+		{
+			name:      "Invalid board id",
+			boardID:   "invalid",
+			inputBody: map[string]any{"operations": []any{deleteOperation}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("boardId", []string{"Invalid board id"}),
+		},
+		{
+			name:      "Invalid JSON",
+			boardID:   validBoard.ID.String(),
+			inputBody: "{",
+			wantCode:  http.StatusBadRequest,
+			wantBody:  invalidJSONError(),
+		},
+		{
+			name:      "No operations",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"operations": []any{}},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations", []string{domain.ErrTaskBatchOperationCount}),
+		},
+		{
+			name:      "Too many operations",
+			boardID:   validBoard.ID.String(),
+			inputBody: map[string]any{"operations": tooManyOperations},
+			wantCode:  http.StatusBadRequest,
+			wantBody:  validationError("operations", []string{domain.ErrTaskBatchOperationCount}),
+		},
+		{
+			name:    "Unknown operation type",
+			boardID: validBoard.ID.String(),
+			inputBody: map[string]any{"operations": []any{
+				deleteOperation,
+				map[string]any{"type": "archive", "taskId": validTask.ID.String()},
+			}},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[1].type", []string{domain.ErrTaskBatchOperationType}),
+		},
+		{
+			name:    "Invalid move",
+			boardID: validBoard.ID.String(),
+			inputBody: map[string]any{"operations": []any{
+				map[string]any{"type": "move", "taskId": validTask.ID.String(), "targetColumnId": "invalid", "targetPosition": 1},
+			}},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[0].targetColumnId", []string{"Invalid target column id"}),
+		},
+		{
+			name:    "Label without value",
+			boardID: validBoard.ID.String(),
+			inputBody: map[string]any{"operations": []any{
+				map[string]any{"type": "set_label", "taskId": validTask.ID.String(), "fieldId": envField.ID.String()},
+			}},
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[0].value", []string{"Value is required, null clears the label"}),
+		},
+		{
+			name:             "Board not found",
+			boardID:          validBoard.ID.String(),
+			inputBody:        map[string]any{"operations": []any{deleteOperation}},
+			setupTaskService: failWith(service.ErrBoardNotFound),
+			wantCode:         http.StatusNotFound,
+			wantBody:         boardNotFoundError(),
+		},
+		{
+			name:             "Task not found",
+			boardID:          validBoard.ID.String(),
+			inputBody:        map[string]any{"operations": []any{moveOperation, deleteOperation}},
+			setupTaskService: failWith(&service.TaskBatchError{Index: 1, Err: service.ErrTaskNotFound}),
+			wantCode:         http.StatusNotFound,
+			wantBody:         taskNotFoundError("operations[1].taskId"),
+		},
+		{
+			name:             "Target column not found",
+			boardID:          validBoard.ID.String(),
+			inputBody:        map[string]any{"operations": []any{moveOperation}},
+			setupTaskService: failWith(&service.TaskBatchError{Index: 0, Err: service.ErrColumnNotFound}),
+			wantCode:         http.StatusNotFound,
+			wantBody:         columnNotFoundError("operations[0].targetColumnId"),
+		},
+		{
+			name:             "Index out of bounds",
+			boardID:          validBoard.ID.String(),
+			inputBody:        map[string]any{"operations": []any{moveOperation}},
+			setupTaskService: failWith(&service.TaskBatchError{Index: 0, Err: service.ErrIndexOutOfBounds}),
+			wantCode:         http.StatusBadRequest,
+			wantBody:         validationError("operations[0].targetPosition", []string{"Index out of bounds"}),
+		},
+		{
+			name:             "Task blocked",
+			boardID:          validBoard.ID.String(),
+			inputBody:        map[string]any{"operations": []any{moveOperation}},
+			setupTaskService: failWith(&service.TaskBatchError{Index: 0, Err: &service.TaskBlockedError{BlockerIDs: []domain.TaskID{blockerID}}}),
+			wantCode:         http.StatusConflict,
+			wantBody: map[string]any{
+				"code":      "TASK_BLOCKED",
+				"message":   "Task is blocked by unfinished tasks",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "operations[0].blockedBy", "issues": []string{blockerID.String()}},
+				},
+			},
+		},
+		{
+			name:             "Transition not allowed",
+			boardID:          validBoard.ID.String(),
+			inputBody:        map[string]any{"operations": []any{moveOperation}},
+			setupTaskService: failWith(&service.TaskBatchError{Index: 0, Err: &service.TransitionNotAllowedError{Allowed: true, UnmetConditions: []domain.ColumnEntryCondition{domain.ColumnEntryEstimateSet}}}),
+			wantCode:         http.StatusConflict,
+			wantBody:         transitionNotAllowedError(map[string]any{"field": "operations[0].estimate", "issues": []string{"Estimate must be set"}}),
+		},
+		{
+			name:    "Label on a field that is not a select field",
+			boardID: validBoard.ID.String(),
+			inputBody: map[string]any{"operations": []any{
+				map[string]any{"type": "set_label", "taskId": validTask.ID.String(), "fieldId": envField.ID.String(), "value": "staging"},
+			}},
+			setupTaskService: failWith(&service.TaskBatchError{Index: 0, Err: &service.CustomFieldValuesError{
+				Issues: map[domain.CustomFieldID][]string{envField.ID: {domain.ErrTaskBatchLabelField}},
+			}}),
+			wantCode: http.StatusBadRequest,
+			wantBody: validationError("operations[0].fieldId", []string{domain.ErrTaskBatchLabelField}),
+		},
+		{
+			name:             "Internal error",
+			boardID:          validBoard.ID.String(),
+			inputBody:        map[string]any{"operations": []any{deleteOperation}},
+			setupTaskService: failWith(service.ErrInternal),
+			wantCode:         http.StatusInternalServerError,
+			wantBody:         internalError(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := buildTaskRequest(t, http.MethodPost, "/v1/boards/"+tt.boardID+"/tasks:batch", tt.inputBody)
+			req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, validBoard.OwnerID))
+			req.SetPathValue("boardId", tt.boardID)
+
+			rr := httptest.NewRecorder()
+			mockTasks := NewMockTaskService(t)
+			if tt.setupTaskService != nil {
+				tt.setupTaskService(t, mockTasks)
+			}
+
+			logger := testutil.NewLogger(t)
+			h := handler.NewTasks(logger, mockTasks, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			h.Batch(rr, req)
+
+			testutil.AssertStatusCode(t, rr, tt.wantCode)
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
@@ -31,6 +31,15 @@ func (s *spyAuthMiddleware) Wrap(next http.Handler) http.Handler {
 	})
 }
 
+type spyIdempotencyMiddleware struct{}
+
+func (s *spyIdempotencyMiddleware) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Idempotency-Tracked", "true")
+		next.ServeHTTP(w, r)
+	})
+}
+
 type spyRequestIDMiddleware struct{}
 
 func (s *spyRequestIDMiddleware) Wrap(next http.Handler) http.Handler {
@@ -1,18 +1,37 @@
 package httpschema
 
 var codeMap = map[string]string{
-	"INVALID_CREDENTIALS":   "Invalid login or password",
-	"VALIDATION_ERROR":      "Some fields are invalid",
-	"INTERNAL_SERVER_ERROR": "Internal server error",
-	"USER_ALREADY_EXISTS":   "User already exists",
-	"BOARD_NOT_FOUND":       "Board not found",
-	"COLUMN_NOT_FOUND":      "Column not found",
-	"TASK_NOT_FOUND":        "Task not found",
-	"INDEX_OUT_OF_BOUNDS":   "Index out of bounds",
-	"INVALID_AUTH_HEADER":   "Invalid authorization header",
-	"USER_NOT_FOUND":        "User not found",
-	"INVALID_TOKEN":         "Invalid token",
-	"PAYLOAD_TOO_LARGE":     "Request body too large",
+	"INVALID_CREDENTIALS":      "Invalid login or password",
+	"VALIDATION_ERROR":         "Some fields are invalid",
+	"INTERNAL_SERVER_ERROR":    "Internal server error",
+	"USER_ALREADY_EXISTS":      "User already exists",
+	"BOARD_NOT_FOUND":          "Board not found",
+	"COLUMN_NOT_FOUND":         "Column not found",
+	"LANE_NOT_FOUND":           "Lane not found",
+	"TASK_NOT_FOUND":           "Task not found",
+	"BOARD_TEMPLATE_NOT_FOUND": "Board template not found",
+	"TASK_TEMPLATE_NOT_FOUND":  "Task template not found",
+	"RECURRENCE_NOT_FOUND":     "Recurrence not found",
+	"AUTOMATION_NOT_FOUND":     "Automation not found",
+	"SPRINT_NOT_FOUND":         "Sprint not found",
+	"CUSTOM_FIELD_NOT_FOUND":   "Custom field not found",
+	"BOARD_IMPORT_NOT_FOUND":   "Board import not found",
+	"SPRINT_COMPLETED":         "Sprint is already completed",
+	"TASK_LINK_NOT_FOUND":      "Task link not found",
+	"TASK_LINK_ALREADY_EXISTS": "Task link already exists",
+	"TASK_LINK_CYCLE":          "Task link would create a dependency cycle",
+	"TASK_BLOCKED":             "Task is blocked by unfinished tasks",
+	"TRANSITION_NOT_ALLOWED":   "Task transition is not allowed by the board workflow",
+	"TASK_PARENT_CYCLE":        "Task parent would create a cycle",
+	"TASK_ARCHIVED":            "Task is archived",
+	"VERSION_CONFLICT":         "Resource has changed since the given version",
+	"IDEMPOTENCY_KEY_IN_USE":   "A request with this idempotency key is in progress",
+	"IDEMPOTENCY_KEY_REUSED":   "Idempotency key was used for a different request",
+	"INDEX_OUT_OF_BOUNDS":      "Index out of bounds",
+	"INVALID_AUTH_HEADER":      "Invalid authorization header",
+	"USER_NOT_FOUND":           "User not found",
+	"INVALID_TOKEN":            "Invalid token",
+	"PAYLOAD_TOO_LARGE":        "Request body too large",
 }
 
 func mapCodeToDescription(code string) string {
@@ -23,3 +42,9 @@ func mapCodeToDescription(code string) string {
 
 	return description
 }
+
+// DescribeCode returns the message error responses carry with the code, for errors reported
+// within a successful response.
+func DescribeCode(code string) string {
+	return mapCodeToDescription(code)
+}
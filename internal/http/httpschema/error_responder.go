@@ -55,10 +55,86 @@ func (r *ErrorResponder) ColumnNotFound(w http.ResponseWriter, details []Detail)
 	r.detailedError(w, http.StatusNotFound, "COLUMN_NOT_FOUND", details)
 }
 
+func (r *ErrorResponder) LaneNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "LANE_NOT_FOUND", details)
+}
+
 func (r *ErrorResponder) TaskNotFound(w http.ResponseWriter, details []Detail) {
 	r.detailedError(w, http.StatusNotFound, "TASK_NOT_FOUND", details)
 }
 
+func (r *ErrorResponder) BoardTemplateNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "BOARD_TEMPLATE_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) TaskTemplateNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "TASK_TEMPLATE_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) RecurrenceNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "RECURRENCE_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) AutomationNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "AUTOMATION_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) SprintNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "SPRINT_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) CustomFieldNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "CUSTOM_FIELD_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) BoardImportNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "BOARD_IMPORT_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) SprintCompleted(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "SPRINT_COMPLETED", details)
+}
+
+func (r *ErrorResponder) TaskArchived(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "TASK_ARCHIVED", details)
+}
+
+func (r *ErrorResponder) TaskLinkNotFound(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusNotFound, "TASK_LINK_NOT_FOUND", details)
+}
+
+func (r *ErrorResponder) TaskLinkAlreadyExists(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "TASK_LINK_ALREADY_EXISTS", details)
+}
+
+func (r *ErrorResponder) TaskLinkCycle(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "TASK_LINK_CYCLE", details)
+}
+
+func (r *ErrorResponder) TaskParentCycle(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "TASK_PARENT_CYCLE", details)
+}
+
+func (r *ErrorResponder) TaskBlocked(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "TASK_BLOCKED", details)
+}
+
+func (r *ErrorResponder) TransitionNotAllowed(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "TRANSITION_NOT_ALLOWED", details)
+}
+
+func (r *ErrorResponder) VersionConflict(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusPreconditionFailed, "VERSION_CONFLICT", details)
+}
+
+func (r *ErrorResponder) IdempotencyKeyInUse(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusConflict, "IDEMPOTENCY_KEY_IN_USE", details)
+}
+
+func (r *ErrorResponder) IdempotencyKeyReused(w http.ResponseWriter, details []Detail) {
+	r.detailedError(w, http.StatusUnprocessableEntity, "IDEMPOTENCY_KEY_REUSED", details)
+}
+
 func (r *ErrorResponder) UserNotFound(w http.ResponseWriter, details []Detail) {
 	r.detailedError(w, http.StatusUnauthorized, "USER_NOT_FOUND", details)
 }
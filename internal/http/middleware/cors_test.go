@@ -26,7 +26,8 @@ func TestCors_Wrap(t *testing.T) {
 	filledGoodCORSHeaders := map[string]string{
 		"Access-Control-Allow-Origin":      goodSite,
 		"Access-Control-Allow-Methods":     "DELETE, GET, OPTIONS, PATCH, POST, PUT",
-		"Access-Control-Allow-Headers":     "Content-Type, Authorization",
+		"Access-Control-Allow-Headers":     "Content-Type, Authorization, If-Match, Idempotency-Key, Last-Event-ID",
+		"Access-Control-Expose-Headers":    "ETag, Idempotent-Replayed",
 		"Access-Control-Allow-Credentials": "true",
 		"Access-Control-Max-Age":           "86400",
 		"Vary":                             "Origin",
@@ -39,6 +40,7 @@ func TestCors_Wrap(t *testing.T) {
 		"Access-Control-Allow-Origin":      "",
 		"Access-Control-Allow-Methods":     "",
 		"Access-Control-Allow-Headers":     "",
+		"Access-Control-Expose-Headers":    "",
 		"Access-Control-Allow-Credentials": "",
 		"Access-Control-Max-Age":           "",
 		"Vary":                             "Origin",
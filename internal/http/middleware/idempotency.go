@@ -0,0 +1,188 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/logging"
+	"goroutine/internal/service"
+)
+
+// idempotencyMaxBodySize is the largest body an endpoint takes, the one of board imports.
+const idempotencyMaxBodySize = 5 << 20
+
+// idempotencyReplayedHeaders are the response headers that describe the result of a request
+// rather than the exchange, so they are replayed with the body.
+var idempotencyReplayedHeaders = []string{"Content-Type", "Location", "ETag"}
+
+type idempotencyKeeper interface {
+	Begin(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error)
+	Finish(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error
+}
+
+type idempotency struct {
+	logger    *slog.Logger
+	keeper    idempotencyKeeper
+	responder *httpschema.ErrorResponder
+}
+
+func NewIdempotency(logger *slog.Logger, keeper idempotencyKeeper, responder *httpschema.ErrorResponder) *idempotency {
+	moduleLogger := logging.WithModule(logger, "middleware.idempotency")
+
+	return &idempotency{logger: moduleLogger, keeper: keeper, responder: responder}
+}
+
+// Wrap runs a request with an Idempotency-Key header once per user, route and key. Retries get
+// the response of the first run with an Idempotent-Replayed header, and a retry arriving while
+// the first run is in progress waits for it. Must be wrapped by auth.
+func (m *idempotency) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Idempotency-Key")
+		if header == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		key, err := domain.NewIdempotencyKey(header)
+		if err != nil {
+			m.responder.ValidationError(
+				w, []httpschema.Detail{{Field: "Idempotency-Key", Issues: domain.ExtractValidationIssues(err)}},
+			)
+			return
+		}
+
+		userID, ok := r.Context().Value(httpschema.ContextKeyUserID).(domain.UserID)
+		if !ok {
+			m.logger.ErrorContext(r.Context(), "BUG: valid UserID not found in context. Auth middleware should have run first.")
+			m.responder.InvalidToken(w, []httpschema.Detail{{Field: "Authorization", Issues: []string{"Invalid token"}}})
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, idempotencyMaxBodySize))
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				m.responder.PayloadTooLarge(w)
+				return
+			}
+			m.responder.InternalError(w, r, err)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		req := domain.IdempotentRequest{
+			UserID:      userID,
+			Route:       r.Method + " " + r.URL.Path,
+			Key:         key,
+			Fingerprint: requestFingerprint(r.URL.RawQuery, body),
+			Attempt:     rand.Text(),
+		}
+
+		stored, replayed, err := m.keeper.Begin(r.Context(), req)
+		if err != nil {
+			switch {
+			case errors.Is(err, service.ErrIdempotencyKeyInUse):
+				m.responder.IdempotencyKeyInUse(
+					w, []httpschema.Detail{{Field: "Idempotency-Key", Issues: []string{"Request with this key is still in progress"}}},
+				)
+			case errors.Is(err, service.ErrIdempotencyKeyReused):
+				m.responder.IdempotencyKeyReused(
+					w, []httpschema.Detail{{Field: "Idempotency-Key", Issues: []string{"Key was used for a request with another body"}}},
+				)
+			default:
+				m.responder.InternalError(w, r, err)
+			}
+			return
+		}
+
+		if replayed {
+			for name, value := range stored.Header {
+				w.Header().Set(name, value)
+			}
+			w.Header().Set("Idempotent-Replayed", "true")
+			w.WriteHeader(stored.StatusCode)
+			_, err = w.Write(stored.Body)
+			if err != nil {
+				m.logger.DebugContext(r.Context(), "Failed to replay response", slog.String("err", err.Error()))
+			}
+			return
+		}
+
+		recorder := &idempotencyRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+		handled := false
+		// Finishing is deferred so a panicking handler doesn't keep the key locked until the lock
+		// expires. Its response is incomplete, so it is finished as a server error, which frees the
+		// key for a retry without being stored.
+		defer func() {
+			resp := recorder.response()
+			if !handled {
+				resp = domain.IdempotentResponse{StatusCode: http.StatusInternalServerError}
+			}
+
+			// The response is stored even if the client has gone, since its retry is on the way.
+			err := m.keeper.Finish(context.WithoutCancel(r.Context()), req, resp)
+			if err != nil {
+				m.logger.ErrorContext(r.Context(), "Failed to store idempotent response", slog.String("err", err.Error()))
+			}
+		}()
+
+		next.ServeHTTP(recorder, r)
+		handled = true
+	})
+}
+
+func requestFingerprint(query string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(query))
+	h.Write([]byte{0})
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// idempotencyRecorder passes the response through while keeping a copy of it to store.
+type idempotencyRecorder struct {
+	http.ResponseWriter
+	statusCode  int
+	header      map[string]string
+	body        bytes.Buffer
+	wroteHeader bool
+}
+
+func (w *idempotencyRecorder) WriteHeader(code int) {
+	if !w.wroteHeader {
+		w.wroteHeader = true
+		w.statusCode = code
+		w.header = map[string]string{}
+		for _, name := range idempotencyReplayedHeaders {
+			if value := w.Header().Get(name); value != "" {
+				w.header[name] = value
+			}
+		}
+	}
+	w.ResponseWriter.WriteHeader(code)
+}
+
+func (w *idempotencyRecorder) Write(b []byte) (int, error) {
+	if !w.wroteHeader {
+		w.WriteHeader(http.StatusOK)
+	}
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyRecorder) response() domain.IdempotentResponse {
+	return domain.IdempotentResponse{
+		StatusCode: w.statusCode,
+		Header:     w.header,
+		Body:       w.body.Bytes(),
+	}
+}
@@ -0,0 +1,345 @@
+package middleware_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/http/httpschema"
+	"goroutine/internal/http/middleware"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestIdempotency(t *testing.T) {
+	t.Parallel()
+
+	userID := testutil.ValidUserID()
+	key := testutil.ValidIdempotencyKey()
+	requestBody := `{"name":"Write docs"}`
+	bodyHash := sha256.Sum256([]byte("\x00" + requestBody))
+	wantReq := domain.IdempotentRequest{
+		UserID:      userID,
+		Route:       "POST /v1/boards",
+		Key:         key,
+		Fingerprint: hex.EncodeToString(bodyHash[:]),
+	}
+	createdBody := `{"id":"board"}`
+	storedResp := domain.IdempotentResponse{
+		Fingerprint: wantReq.Fingerprint,
+		StatusCode:  http.StatusCreated,
+		Header:      map[string]string{"Content-Type": "application/json", "Location": "/v1/boards/board"},
+		Body:        []byte(createdBody),
+	}
+	keyError := func(code, message, issue string) map[string]any {
+		return map[string]any{
+			"code":      code,
+			"message":   message,
+			"timestamp": testutil.FixedNowStr(),
+			"details": []any{
+				map[string]any{"field": "Idempotency-Key", "issues": []string{issue}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name            string
+		key             string
+		body            string
+		noUser          bool
+		setupService    func(s *MockIdempotencyService)
+		wantHandlerRun  bool
+		wantStatus      int
+		wantHeader      map[string]string
+		wantBody        any
+		wantPlainBody   string
+		wantNotReplayed bool
+	}{
+		{
+			name:           "Success without key runs handler",
+			body:           requestBody,
+			setupService:   func(s *MockIdempotencyService) {},
+			wantHandlerRun: true,
+			wantStatus:     http.StatusCreated,
+			wantPlainBody:  createdBody,
+		},
+		{
+			name: "Success runs handler and stores response",
+			key:  key.String(),
+			body: requestBody,
+			setupService: func(s *MockIdempotencyService) {
+				var attempt string
+				s.BeginFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+					if req.Attempt == "" {
+						t.Errorf("got empty attempt, want a random one")
+					}
+					attempt = req.Attempt
+					wantBeginReq := wantReq
+					wantBeginReq.Attempt = attempt
+					if diff := cmp.Diff(wantBeginReq, req, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("request mismatch (-want +got):\n%s", diff)
+					}
+					return domain.IdempotentResponse{}, false, nil
+				}
+				s.FinishFunc = func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+					wantFinishReq := wantReq
+					wantFinishReq.Attempt = attempt
+					if diff := cmp.Diff(wantFinishReq, req, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("request mismatch (-want +got):\n%s", diff)
+					}
+					wantResp := storedResp
+					wantResp.Fingerprint = ""
+					if diff := cmp.Diff(wantResp, resp); diff != "" {
+						t.Errorf("response mismatch (-want +got):\n%s", diff)
+					}
+					return nil
+				}
+			},
+			wantHandlerRun:  true,
+			wantStatus:      http.StatusCreated,
+			wantHeader:      map[string]string{"X-Handler": "true"},
+			wantPlainBody:   createdBody,
+			wantNotReplayed: true,
+		},
+		{
+			name: "Success replays stored response",
+			key:  key.String(),
+			body: requestBody,
+			setupService: func(s *MockIdempotencyService) {
+				s.BeginFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+					return storedResp, true, nil
+				}
+			},
+			wantStatus: http.StatusCreated,
+			wantHeader: map[string]string{
+				"Content-Type":        "application/json",
+				"Location":            "/v1/boards/board",
+				"Idempotent-Replayed": "true",
+			},
+			wantPlainBody: createdBody,
+		},
+		{
+			name: "Success ignores store failure",
+			key:  key.String(),
+			body: requestBody,
+			setupService: func(s *MockIdempotencyService) {
+				s.BeginFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+					return domain.IdempotentResponse{}, false, nil
+				}
+				s.FinishFunc = func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+					return service.ErrInternal
+				}
+			},
+			wantHandlerRun: true,
+			wantStatus:     http.StatusCreated,
+			wantPlainBody:  createdBody,
+		},
+		{
+			name:         "Invalid key",
+			key:          strings.Repeat("k", 256),
+			body:         requestBody,
+			setupService: func(s *MockIdempotencyService) {},
+			wantStatus:   http.StatusBadRequest,
+			wantBody: map[string]any{
+				"code":      "VALIDATION_ERROR",
+				"message":   "Some fields are invalid",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "Idempotency-Key", "issues": []string{domain.ErrIdempotencyKeyValue}},
+				},
+			},
+		},
+		{
+			name: "Key in use",
+			key:  key.String(),
+			body: requestBody,
+			setupService: func(s *MockIdempotencyService) {
+				s.BeginFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+					return domain.IdempotentResponse{}, false, service.ErrIdempotencyKeyInUse
+				}
+			},
+			wantStatus: http.StatusConflict,
+			wantBody: keyError("IDEMPOTENCY_KEY_IN_USE", "A request with this idempotency key is in progress",
+				"Request with this key is still in progress"),
+		},
+		{
+			name: "Key reused",
+			key:  key.String(),
+			body: requestBody,
+			setupService: func(s *MockIdempotencyService) {
+				s.BeginFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+					return domain.IdempotentResponse{}, false, service.ErrIdempotencyKeyReused
+				}
+			},
+			wantStatus: http.StatusUnprocessableEntity,
+			wantBody: keyError("IDEMPOTENCY_KEY_REUSED", "Idempotency key was used for a different request",
+				"Key was used for a request with another body"),
+		},
+		{
+			name: "Internal error",
+			key:  key.String(),
+			body: requestBody,
+			setupService: func(s *MockIdempotencyService) {
+				s.BeginFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+					return domain.IdempotentResponse{}, false, errors.New("redis exploded")
+				}
+			},
+			wantStatus: http.StatusInternalServerError,
+			wantBody: map[string]any{
+				"code":      "INTERNAL_SERVER_ERROR",
+				"message":   "Internal server error",
+				"timestamp": testutil.FixedNowStr(),
+			},
+		},
+		{
+			name:         "Body too large",
+			key:          key.String(),
+			body:         strings.Repeat("a", 5<<20+1),
+			setupService: func(s *MockIdempotencyService) {},
+			wantStatus:   http.StatusRequestEntityTooLarge,
+			wantBody: map[string]any{
+				"code":      "PAYLOAD_TOO_LARGE",
+				"message":   "Request body too large",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "body", "issues": []string{"Please stop spamming >_<"}},
+				},
+			},
+		},
+		{
+			name:         "Missing user",
+			key:          key.String(),
+			body:         requestBody,
+			noUser:       true,
+			setupService: func(s *MockIdempotencyService) {},
+			wantStatus:   http.StatusUnauthorized,
+			wantBody: map[string]any{
+				"code":      "INVALID_TOKEN",
+				"message":   "Invalid token",
+				"timestamp": testutil.FixedNowStr(),
+				"details": []any{
+					map[string]any{"field": "Authorization", "issues": []string{"Invalid token"}},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := NewMockIdempotencyService(t)
+			tt.setupService(s)
+
+			handlerRun := false
+			h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				handlerRun = true
+
+				body, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Fatalf("io.ReadAll(request body) error = %v", err)
+				}
+				if string(body) != tt.body {
+					t.Errorf("got request body %q, want %q", body, tt.body)
+				}
+
+				w.Header().Set("Content-Type", "application/json")
+				w.Header().Set("Location", "/v1/boards/board")
+				w.Header().Set("X-Handler", "true")
+				w.WriteHeader(http.StatusCreated)
+				_, _ = w.Write([]byte(createdBody))
+			})
+
+			logger := testutil.NewLogger(t)
+			m := middleware.NewIdempotency(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+			wrapped := m.Wrap(h)
+
+			req := httptest.NewRequest(http.MethodPost, "/v1/boards", bytes.NewBufferString(tt.body))
+			if !tt.noUser {
+				req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, userID))
+			}
+			if tt.key != "" {
+				req.Header.Set("Idempotency-Key", tt.key)
+			}
+			rr := httptest.NewRecorder()
+
+			wrapped.ServeHTTP(rr, req)
+
+			if handlerRun != tt.wantHandlerRun {
+				t.Errorf("got handler run %t, want %t", handlerRun, tt.wantHandlerRun)
+			}
+			testutil.AssertStatusCode(t, rr, tt.wantStatus)
+			for name, want := range tt.wantHeader {
+				if got := rr.Header().Get(name); got != want {
+					t.Errorf("got header %s %q, want %q", name, got, want)
+				}
+			}
+			if tt.wantNotReplayed && rr.Header().Get("Idempotent-Replayed") != "" {
+				t.Errorf("got Idempotent-Replayed header on the first run, want none")
+			}
+			if tt.wantPlainBody != "" {
+				if rr.Body.String() != tt.wantPlainBody {
+					t.Errorf("got body %q, want %q", rr.Body.String(), tt.wantPlainBody)
+				}
+				return
+			}
+			testutil.AssertContentType(t, rr, "application/json")
+			testutil.AssertResponseBody(t, rr, tt.wantBody)
+		})
+	}
+}
+
+func TestIdempotency_HandlerPanics(t *testing.T) {
+	t.Parallel()
+
+	var attempt string
+	finished := false
+	s := NewMockIdempotencyService(t)
+	s.BeginFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+		attempt = req.Attempt
+		return domain.IdempotentResponse{}, false, nil
+	}
+	s.FinishFunc = func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+		finished = true
+		if req.Attempt != attempt {
+			t.Errorf("got attempt %q, want %q", req.Attempt, attempt)
+		}
+		if resp.StatusCode != http.StatusInternalServerError {
+			t.Errorf("got status code %d, want %d", resp.StatusCode, http.StatusInternalServerError)
+		}
+		return nil
+	}
+
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		panic("handler exploded")
+	})
+
+	logger := testutil.NewLogger(t)
+	m := middleware.NewIdempotency(logger, s, httpschema.MustNewErrorResponder(logger, testutil.FixedNowStr))
+	wrapped := m.Wrap(h)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/boards", bytes.NewBufferString(`{"name":"Write docs"}`))
+	req = req.WithContext(context.WithValue(req.Context(), httpschema.ContextKeyUserID, testutil.ValidUserID()))
+	req.Header.Set("Idempotency-Key", testutil.ValidIdempotencyKey().String())
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("got no panic, want the handler's panic to propagate")
+		}
+		if !finished {
+			t.Errorf("got key left locked, want Finish called")
+		}
+	}()
+	wrapped.ServeHTTP(httptest.NewRecorder(), req)
+}
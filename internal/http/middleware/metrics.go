@@ -48,6 +48,11 @@ func (w *statusSpyWriter) WriteHeader(code int) {
 	w.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the writer of the server, to flush streams.
+func (w *statusSpyWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
 func (m *metrics) Wrap(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		abstractPath := AbstractMetricPath(r.URL.Path)
@@ -116,3 +116,23 @@ func TestMetrics_Collection(t *testing.T) {
 		})
 	}
 }
+
+func TestMetrics_Flush(t *testing.T) {
+	t.Parallel()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		err := http.NewResponseController(w).Flush()
+		if err != nil {
+			t.Errorf("Flush() error = %v, want nil", err)
+		}
+	})
+
+	mw := middleware.NewMetrics(prometheus.NewRegistry())
+	req, rr := testutil.NewJSONRequestAndRecorder(t, http.MethodGet, "/v1/boards/550e8400-e29b-41d4-a716-446655440000/events", "")
+
+	mw.Wrap(handler).ServeHTTP(rr, req)
+
+	if !rr.Flushed {
+		t.Error("got response not flushed, want flushed")
+	}
+}
@@ -7,9 +7,10 @@ type middleware interface {
 }
 
 type Middlewares struct {
-	Metrics   middleware
-	CORS      middleware
-	Auth      middleware
-	RequestID middleware
-	Timeout   middleware
+	Metrics     middleware
+	CORS        middleware
+	Auth        middleware
+	Idempotency middleware
+	RequestID   middleware
+	Timeout     middleware
 }
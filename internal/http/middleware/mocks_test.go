@@ -22,3 +22,24 @@ func (m *MockAuthService) VerifyToken(ctx context.Context, token domain.AuthToke
 	testutil.AssertFuncNotNil(m.t, "AuthService.VerifyTokenFunc", m.VerifyTokenFunc)
 	return m.VerifyTokenFunc(ctx, token)
 }
+
+type MockIdempotencyService struct {
+	t *testing.T
+
+	BeginFunc  func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error)
+	FinishFunc func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error
+}
+
+func NewMockIdempotencyService(t *testing.T) *MockIdempotencyService {
+	return &MockIdempotencyService{t: t}
+}
+
+func (m *MockIdempotencyService) Begin(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+	testutil.AssertFuncNotNil(m.t, "IdempotencyService.BeginFunc", m.BeginFunc)
+	return m.BeginFunc(ctx, req)
+}
+
+func (m *MockIdempotencyService) Finish(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+	testutil.AssertFuncNotNil(m.t, "IdempotencyService.FinishFunc", m.FinishFunc)
+	return m.FinishFunc(ctx, req, resp)
+}
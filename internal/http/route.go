@@ -22,32 +22,105 @@ func NewRouter(handlers *handler.Handlers, middlewares *middleware.Middlewares)
 	protected := func(h http.HandlerFunc) http.Handler {
 		return middlewares.Metrics.Wrap(middlewares.Auth.Wrap(h))
 	}
+	// idempotent is protected for the create and move endpoints mobile clients retry.
+	idempotent := func(h http.HandlerFunc) http.Handler {
+		return middlewares.Metrics.Wrap(middlewares.Auth.Wrap(middlewares.Idempotency.Wrap(h)))
+	}
 
 	mux.Handle("POST /v1/register", public(handlers.Auth.Register))
 	mux.Handle("POST "+loginPath, public(handlers.Auth.Login))
 	mux.Handle("GET /v1/health", public(handlers.Health.Health))
 	mux.Handle("GET /v1/whoami", protected(handlers.Auth.WhoAmI))
 	mux.Handle("POST /v1/users/me/telegram/link", protected(handlers.User.CreateTelegramLinkToken))
-	mux.Handle("POST /v1/boards", protected(handlers.Boards.Create))
+	mux.Handle("GET /v1/users/me/stats", protected(handlers.Stats.UserStats))
+	mux.Handle("POST /v1/boards", idempotent(handlers.Boards.Create))
 	mux.Handle("GET /v1/boards/{boardId}", protected(handlers.Boards.Get))
 	mux.Handle("GET /v1/boards/{boardId}/aggregate", protected(handlers.Boards.GetAggregate))
 	mux.Handle("PATCH /v1/boards/{boardId}", protected(handlers.Boards.Update))
 	mux.Handle("DELETE /v1/boards/{boardId}", protected(handlers.Boards.Delete))
+	mux.Handle("POST /v1/boards/{boardId}/duplicate", idempotent(handlers.Boards.Duplicate))
 	mux.Handle("GET /v1/boards", protected(handlers.Boards.ListByOwnerID))
-	mux.Handle("POST /v1/boards/{boardId}/columns", protected(handlers.Columns.Create))
+	mux.Handle("POST /v1/boards/import", idempotent(handlers.BoardImports.Create))
+	mux.Handle("GET /v1/board-imports/{importId}", protected(handlers.BoardImports.Get))
+	mux.Handle("POST /v1/boards/{boardId}/save-as-template", idempotent(handlers.BoardTemplates.SaveFromBoard))
+	mux.Handle("GET /v1/board-templates", protected(handlers.BoardTemplates.List))
+	mux.Handle("DELETE /v1/board-templates/{templateId}", protected(handlers.BoardTemplates.Delete))
+	mux.Handle("POST /v1/boards/{boardId}/task-templates", idempotent(handlers.TaskTemplates.Create))
+	mux.Handle("GET /v1/boards/{boardId}/task-templates", protected(handlers.TaskTemplates.ListByBoardID))
+	mux.Handle("PATCH /v1/boards/{boardId}/task-templates/{templateId}", protected(handlers.TaskTemplates.Update))
+	mux.Handle("DELETE /v1/boards/{boardId}/task-templates/{templateId}", protected(handlers.TaskTemplates.Delete))
+	mux.Handle("POST /v1/boards/{boardId}/recurrences", idempotent(handlers.Recurrences.Create))
+	mux.Handle("GET /v1/boards/{boardId}/recurrences", protected(handlers.Recurrences.ListByBoardID))
+	mux.Handle("PATCH /v1/boards/{boardId}/recurrences/{recurrenceId}", protected(handlers.Recurrences.Update))
+	mux.Handle("DELETE /v1/boards/{boardId}/recurrences/{recurrenceId}", protected(handlers.Recurrences.Delete))
+	mux.Handle("POST /v1/boards/{boardId}/sprints", idempotent(handlers.Sprints.Create))
+	mux.Handle("GET /v1/boards/{boardId}/sprints", protected(handlers.Sprints.ListByBoardID))
+	mux.Handle("PATCH /v1/boards/{boardId}/sprints/{sprintId}", protected(handlers.Sprints.Update))
+	mux.Handle("DELETE /v1/boards/{boardId}/sprints/{sprintId}", protected(handlers.Sprints.Delete))
+	mux.Handle("PUT /v1/boards/{boardId}/sprints/{sprintId}/tasks/{taskId}", protected(handlers.Sprints.AddTask))
+	mux.Handle("DELETE /v1/boards/{boardId}/sprints/{sprintId}/tasks/{taskId}", protected(handlers.Sprints.RemoveTask))
+	mux.Handle("POST /v1/boards/{boardId}/sprints/{sprintId}/complete", protected(handlers.Sprints.Complete))
+	mux.Handle("GET /v1/boards/{boardId}/sprints/{sprintId}/report", protected(handlers.Sprints.Report))
+	mux.Handle("GET /v1/boards/{boardId}/analytics/burndown", protected(handlers.Analytics.Burndown))
+	mux.Handle("GET /v1/boards/{boardId}/analytics/cfd", protected(handlers.Analytics.CumulativeFlow))
+	mux.Handle("GET /v1/boards/{boardId}/analytics/cycle-time", protected(handlers.Analytics.CycleTimes))
+	mux.Handle("GET /v1/boards/{boardId}/analytics/throughput", protected(handlers.Analytics.Throughput))
+	mux.Handle("POST /v1/boards/{boardId}/forecast", protected(handlers.Analytics.Forecast))
+	mux.Handle("GET /v1/boards/{boardId}/stats", protected(handlers.Stats.BoardStats))
+	mux.Handle("GET /v1/boards/{boardId}/export", protected(handlers.Exports.Export))
+	mux.Handle("POST /v1/boards/{boardId}/columns", idempotent(handlers.Columns.Create))
 	mux.Handle("GET /v1/boards/{boardId}/columns", protected(handlers.Columns.ListByBoardID))
 	mux.Handle("PATCH /v1/boards/{boardId}/columns/{columnId}", protected(handlers.Columns.Update))
-	mux.Handle("PUT /v1/boards/{boardId}/columns/{columnId}/position", protected(handlers.Columns.Move))
+	mux.Handle("PUT /v1/boards/{boardId}/columns/{columnId}/position", idempotent(handlers.Columns.Move))
 	mux.Handle("DELETE /v1/boards/{boardId}/columns/{columnId}", protected(handlers.Columns.Delete))
-	mux.Handle("POST /v1/boards/{boardId}/columns/{columnId}/tasks", protected(handlers.Tasks.Create))
+	mux.Handle("POST /v1/boards/{boardId}/columns/{columnId}/duplicate", idempotent(handlers.Columns.Duplicate))
+	mux.Handle("POST /v1/boards/{boardId}/lanes", idempotent(handlers.Lanes.Create))
+	mux.Handle("GET /v1/boards/{boardId}/lanes", protected(handlers.Lanes.ListByBoardID))
+	mux.Handle("PATCH /v1/boards/{boardId}/lanes/{laneId}", protected(handlers.Lanes.Update))
+	mux.Handle("PUT /v1/boards/{boardId}/lanes/{laneId}/position", idempotent(handlers.Lanes.Move))
+	mux.Handle("DELETE /v1/boards/{boardId}/lanes/{laneId}", protected(handlers.Lanes.Delete))
+	mux.Handle("POST /v1/boards/{boardId}/custom-fields", idempotent(handlers.CustomFields.Create))
+	mux.Handle("GET /v1/boards/{boardId}/custom-fields", protected(handlers.CustomFields.ListByBoardID))
+	mux.Handle("PATCH /v1/boards/{boardId}/custom-fields/{fieldId}", protected(handlers.CustomFields.Update))
+	mux.Handle("DELETE /v1/boards/{boardId}/custom-fields/{fieldId}", protected(handlers.CustomFields.Delete))
+
+	mux.Handle("POST /v1/boards/{boardId}/automations", idempotent(handlers.Automations.Create))
+	mux.Handle("GET /v1/boards/{boardId}/automations", protected(handlers.Automations.ListByBoardID))
+	mux.Handle("PATCH /v1/boards/{boardId}/automations/{automationId}", protected(handlers.Automations.Update))
+	mux.Handle("DELETE /v1/boards/{boardId}/automations/{automationId}", protected(handlers.Automations.Delete))
+	mux.Handle("GET /v1/boards/{boardId}/automations/runs", protected(handlers.Automations.ListRuns))
+	mux.Handle("POST /v1/boards/{boardId}/automations/dry-run", protected(handlers.Automations.DryRun))
+	mux.Handle("POST /v1/boards/{boardId}/tasks:batch", idempotent(handlers.Tasks.Batch))
+	mux.Handle("POST /v1/boards/{boardId}/columns/{columnId}/tasks", idempotent(handlers.Tasks.Create))
 	mux.Handle("GET /v1/boards/{boardId}/columns/{columnId}/tasks", protected(handlers.Tasks.ListByColumnID))
 	mux.Handle("PATCH /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}", protected(handlers.Tasks.Update))
-	mux.Handle("PUT /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/position", protected(handlers.Tasks.Move))
+	mux.Handle("PUT /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/position", idempotent(handlers.Tasks.Move))
 	mux.Handle("DELETE /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}", protected(handlers.Tasks.Delete))
+	mux.Handle("POST /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/duplicate", idempotent(handlers.Tasks.Duplicate))
+	mux.Handle("POST /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/restore", protected(handlers.Tasks.Restore))
+	mux.Handle("GET /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/children", protected(handlers.Tasks.ListChildren))
+	mux.Handle("PUT /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/parent", protected(handlers.Tasks.SetParent))
+	mux.Handle("POST /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/links", idempotent(handlers.TaskLinks.Create))
+	mux.Handle("DELETE /v1/boards/{boardId}/columns/{columnId}/tasks/{taskId}/links/{linkId}", protected(handlers.TaskLinks.Delete))
+	mux.Handle("GET /v1/sync", protected(handlers.Sync.Pull))
+	mux.Handle("POST /v1/sync", idempotent(handlers.Sync.Push))
 	mux.Handle("POST /webhook/telegram", public(handlers.Telegram.Webhook))
 	mux.Handle("GET "+swaggerBasePath, newSwaggerHandler(swaggerBasePath, loginPath))
 
-	return middlewares.Timeout.Wrap(middlewares.RequestID.Wrap(middlewares.CORS.Wrap(mux)))
+	// Streams last as long as their clients listen, so they are kept out of the timeout.
+	streams := http.NewServeMux()
+	streams.Handle("GET /v1/boards/{boardId}/events", protected(handlers.BoardEvents.Stream))
+
+	timed := middlewares.Timeout.Wrap(mux)
+	root := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, pattern := streams.Handler(r); pattern != "" {
+			streams.ServeHTTP(w, r)
+			return
+		}
+		timed.ServeHTTP(w, r)
+	})
+
+	return middlewares.RequestID.Wrap(middlewares.CORS.Wrap(root))
 }
 
 func NewAdminRouter() *http.ServeMux {
@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	app "goroutine/internal/http"
 	"goroutine/internal/http/handler"
@@ -22,19 +23,34 @@ func TestNewRouter_Full(t *testing.T) {
 	responder := httpschema.MustNewErrorResponder(logger, service.TimeNowRFC3339Millis)
 
 	handlers := &handler.Handlers{
-		Auth:     handler.NewAuth(logger, nil, responder),
-		Health:   handler.NewHealth(logger),
-		Boards:   handler.NewBoards(logger, nil, responder),
-		Columns:  handler.NewColumns(logger, nil, responder),
-		Tasks:    handler.NewTasks(logger, nil, responder),
-		Telegram: handler.NewTelegram(logger, nil, nil),
+		Auth:           handler.NewAuth(logger, nil, responder),
+		Health:         handler.NewHealth(logger),
+		Boards:         handler.NewBoards(logger, nil, responder),
+		Columns:        handler.NewColumns(logger, nil, responder),
+		Lanes:          handler.NewLanes(logger, nil, responder),
+		Tasks:          handler.NewTasks(logger, nil, responder),
+		Telegram:       handler.NewTelegram(logger, nil, nil),
+		BoardTemplates: handler.NewBoardTemplates(logger, nil, responder),
+		TaskTemplates:  handler.NewTaskTemplates(logger, nil, responder),
+		Recurrences:    handler.NewRecurrences(logger, nil, responder),
+		Sprints:        handler.NewSprints(logger, nil, responder),
+		TaskLinks:      handler.NewTaskLinks(logger, nil, responder),
+		Analytics:      handler.NewAnalytics(logger, nil, responder),
+		Stats:          handler.NewStats(logger, nil, responder),
+		CustomFields:   handler.NewCustomFields(logger, nil, responder),
+		Automations:    handler.NewAutomations(logger, nil, responder),
+		Exports:        handler.NewExports(logger, nil, responder),
+		BoardImports:   handler.NewBoardImports(logger, nil, responder),
+		BoardEvents:    handler.NewBoardEvents(logger, nil, responder, time.Second),
+		Sync:           handler.NewSync(logger, nil, responder),
 	}
 	middlewares := &middleware.Middlewares{
-		Metrics:   &spyMetricsMiddleware{},
-		CORS:      &spyCorsMiddleware{},
-		Auth:      &spyAuthMiddleware{},
-		RequestID: &spyRequestIDMiddleware{},
-		Timeout:   &spyTimeoutMiddleware{},
+		Metrics:     &spyMetricsMiddleware{},
+		CORS:        &spyCorsMiddleware{},
+		Auth:        &spyAuthMiddleware{},
+		Idempotency: &spyIdempotencyMiddleware{},
+		RequestID:   &spyRequestIDMiddleware{},
+		Timeout:     &spyTimeoutMiddleware{},
 	}
 
 	router := app.NewRouter(handlers, middlewares)
@@ -46,12 +62,13 @@ func TestNewRouter_Full(t *testing.T) {
 	}
 
 	tests := []struct {
-		entry     entry
-		auth      bool
-		metrics   bool
-		cors      bool
-		requestID bool
-		timeout   bool
+		entry       entry
+		auth        bool
+		metrics     bool
+		cors        bool
+		requestID   bool
+		timeout     bool
+		idempotency bool
 	}{
 		{
 			entry: entry{"Register", http.MethodPost, "/v1/register"},
@@ -65,6 +82,10 @@ func TestNewRouter_Full(t *testing.T) {
 			entry: entry{"Health", http.MethodGet, "/v1/health"},
 			auth:  false, metrics: true, cors: true, requestID: true, timeout: true,
 		},
+		{
+			entry: entry{"Create board", http.MethodPost, "/v1/boards"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
 		{
 			entry: entry{"Boards list", http.MethodGet, "/v1/boards"},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
@@ -86,9 +107,73 @@ func TestNewRouter_Full(t *testing.T) {
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
 		},
 		{
-			entry: entry{"Create column", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns"},
+			entry: entry{"Duplicate board", http.MethodPost, "/v1/boards/" + UUIDv7 + "/duplicate"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Save board as template", http.MethodPost, "/v1/boards/" + UUIDv7 + "/save-as-template"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Board templates list", http.MethodGet, "/v1/board-templates"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Delete board template", http.MethodDelete, "/v1/board-templates/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Create task template", http.MethodPost, "/v1/boards/" + UUIDv7 + "/task-templates"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"List task templates", http.MethodGet, "/v1/boards/" + UUIDv7 + "/task-templates"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Update task template", http.MethodPatch, "/v1/boards/" + UUIDv7 + "/task-templates/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Delete task template", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/task-templates/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"List task children", http.MethodGet, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7 + "/children"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Set task parent", http.MethodPut, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7 + "/parent"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Create task link", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7 + "/links"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Delete task link", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7 + "/links/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Create recurrence", http.MethodPost, "/v1/boards/" + UUIDv7 + "/recurrences"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"List recurrences", http.MethodGet, "/v1/boards/" + UUIDv7 + "/recurrences"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Update recurrence", http.MethodPatch, "/v1/boards/" + UUIDv7 + "/recurrences/" + UUIDv7},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
 		},
+		{
+			entry: entry{"Delete recurrence", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/recurrences/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Create column", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
 		{
 			entry: entry{"List columns", http.MethodGet, "/v1/boards/" + UUIDv7 + "/columns"},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
@@ -99,16 +184,164 @@ func TestNewRouter_Full(t *testing.T) {
 		},
 		{
 			entry: entry{"Move column", http.MethodPut, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/position"},
-			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
 		},
 		{
 			entry: entry{"Delete column", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
 		},
 		{
-			entry: entry{"Create task", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks"},
+			entry: entry{"Duplicate column", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/duplicate"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Create sprint", http.MethodPost, "/v1/boards/" + UUIDv7 + "/sprints"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"List sprints", http.MethodGet, "/v1/boards/" + UUIDv7 + "/sprints"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Update sprint", http.MethodPatch, "/v1/boards/" + UUIDv7 + "/sprints/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Delete sprint", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/sprints/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Add task to sprint", http.MethodPut, "/v1/boards/" + UUIDv7 + "/sprints/" + UUIDv7 + "/tasks/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Remove task from sprint", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/sprints/" + UUIDv7 + "/tasks/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Complete sprint", http.MethodPost, "/v1/boards/" + UUIDv7 + "/sprints/" + UUIDv7 + "/complete"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Get sprint report", http.MethodGet, "/v1/boards/" + UUIDv7 + "/sprints/" + UUIDv7 + "/report"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Get burndown", http.MethodGet, "/v1/boards/" + UUIDv7 + "/analytics/burndown"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Get cumulative flow", http.MethodGet, "/v1/boards/" + UUIDv7 + "/analytics/cfd"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Get cycle times", http.MethodGet, "/v1/boards/" + UUIDv7 + "/analytics/cycle-time"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Get throughput", http.MethodGet, "/v1/boards/" + UUIDv7 + "/analytics/throughput"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Forecast delivery", http.MethodPost, "/v1/boards/" + UUIDv7 + "/forecast"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Get board stats", http.MethodGet, "/v1/boards/" + UUIDv7 + "/stats"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Get user stats", http.MethodGet, "/v1/users/me/stats"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Export board", http.MethodGet, "/v1/boards/" + UUIDv7 + "/export"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Stream board events", http.MethodGet, "/v1/boards/" + UUIDv7 + "/events"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: false,
+		},
+		{
+			entry: entry{"Pull sync changes", http.MethodGet, "/v1/sync"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Push sync operations", http.MethodPost, "/v1/sync"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Import board", http.MethodPost, "/v1/boards/import"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Get board import", http.MethodGet, "/v1/board-imports/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Create lane", http.MethodPost, "/v1/boards/" + UUIDv7 + "/lanes"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"List lanes", http.MethodGet, "/v1/boards/" + UUIDv7 + "/lanes"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Update lane", http.MethodPatch, "/v1/boards/" + UUIDv7 + "/lanes/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Move lane", http.MethodPut, "/v1/boards/" + UUIDv7 + "/lanes/" + UUIDv7 + "/position"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Delete lane", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/lanes/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Create custom field", http.MethodPost, "/v1/boards/" + UUIDv7 + "/custom-fields"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"List custom fields", http.MethodGet, "/v1/boards/" + UUIDv7 + "/custom-fields"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Update custom field", http.MethodPatch, "/v1/boards/" + UUIDv7 + "/custom-fields/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Delete custom field", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/custom-fields/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Create automation", http.MethodPost, "/v1/boards/" + UUIDv7 + "/automations"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"List automations", http.MethodGet, "/v1/boards/" + UUIDv7 + "/automations"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Update automation", http.MethodPatch, "/v1/boards/" + UUIDv7 + "/automations/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Delete automation", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/automations/" + UUIDv7},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"List automation runs", http.MethodGet, "/v1/boards/" + UUIDv7 + "/automations/runs"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
+		{
+			entry: entry{"Dry-run automation", http.MethodPost, "/v1/boards/" + UUIDv7 + "/automations/dry-run"},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
 		},
+		{
+			entry: entry{"Create task", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
 		{
 			entry: entry{"List tasks", http.MethodGet, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks"},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
@@ -117,14 +350,26 @@ func TestNewRouter_Full(t *testing.T) {
 			entry: entry{"Update task", http.MethodPatch, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
 		},
+		{
+			entry: entry{"Batch tasks", http.MethodPost, "/v1/boards/" + UUIDv7 + "/tasks:batch"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
 		{
 			entry: entry{"Move task", http.MethodPut, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7 + "/position"},
-			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
 		},
 		{
 			entry: entry{"Delete task", http.MethodDelete, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7},
 			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
 		},
+		{
+			entry: entry{"Duplicate task", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7 + "/duplicate"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true, idempotency: true,
+		},
+		{
+			entry: entry{"Restore task", http.MethodPost, "/v1/boards/" + UUIDv7 + "/columns/" + UUIDv7 + "/tasks/" + UUIDv7 + "/restore"},
+			auth:  true, metrics: true, cors: true, requestID: true, timeout: true,
+		},
 		{
 			entry: entry{"Swagger", http.MethodGet, "/v1/swagger/index.html"},
 			auth:  false, metrics: false, cors: true, requestID: true, timeout: true,
@@ -172,6 +417,11 @@ func TestNewRouter_Full(t *testing.T) {
 			if hasTimeout != tt.timeout {
 				t.Errorf("got timeout middleware=%v for %q, want %v", hasTimeout, tt.entry.path, tt.timeout)
 			}
+
+			hasIdempotency := rr.Header().Get("X-Idempotency-Tracked") == "true"
+			if hasIdempotency != tt.idempotency {
+				t.Errorf("got idempotency middleware=%v for %q, want %v", hasIdempotency, tt.entry.path, tt.idempotency)
+			}
 		})
 	}
 
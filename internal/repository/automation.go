@@ -0,0 +1,708 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const automationColumns = `id, board_id, name, trigger_type, trigger_column_id, trigger_field_id, trigger_label, action_type, action, enabled, created_at, updated_at`
+
+// Transitions and field events are read as automation events of the same shape. A field event
+// keeps the column the task was in as its to_column_id.
+const (
+	transitionEventColumns = `id, CASE WHEN from_column_id IS NULL THEN 'task_created' ELSE 'task_moved' END AS type,
+		board_id, task_id, from_column_id, to_column_id, NULL::uuid AS field_id, '' AS value, transitioned_at AS occurred_at`
+	fieldEventColumns      = `id, type, board_id, task_id, NULL::uuid AS from_column_id, column_id AS to_column_id, field_id, value, occurred_at`
+	automationEventColumns = `id, type, board_id, task_id, from_column_id, to_column_id, field_id, value, occurred_at`
+)
+
+const automationRunColumns = `id, automation_id, board_id, event_id, task_id, status, message, ran_at`
+
+type PGAutomation struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGAutomation(pgPool *pgxpool.Pool) *PGAutomation {
+	return &PGAutomation{pgPool: pgPool}
+}
+
+func (r *PGAutomation) Create(ctx context.Context, automation domain.Automation) (domain.Automation, error) {
+	const query = `
+		INSERT INTO automations (board_id, name, trigger_type, trigger_column_id, trigger_field_id, trigger_label, action_type, action, enabled)
+		VALUES (@board_id, @name, @trigger_type, @trigger_column_id, @trigger_field_id, @trigger_label, @action_type, @action, @enabled)
+		RETURNING ` + automationColumns
+
+	args, err := automationArgs(&automation)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation repo: create: %v: %w", err, ErrInternal)
+	}
+
+	created, err := ScanAutomation(r.pgPool.QueryRow(ctx, query, args))
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation repo: create: %v: %w", err, ErrInternal)
+	}
+
+	return created, nil
+}
+
+func (r *PGAutomation) Get(ctx context.Context, automationID domain.AutomationID) (domain.Automation, error) {
+	const query = `SELECT ` + automationColumns + ` FROM automations WHERE id = $1`
+
+	automation, err := ScanAutomation(r.pgPool.QueryRow(ctx, query, automationID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Automation{}, ErrRowNotFound
+		}
+		return domain.Automation{}, fmt.Errorf("automation repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return automation, nil
+}
+
+func (r *PGAutomation) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Automation, error) {
+	const query = `
+		SELECT ` + automationColumns + `
+		FROM automations
+		WHERE board_id = $1
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("automation repo: list by board id: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var automations []domain.Automation
+	for rows.Next() {
+		automation, scanErr := ScanAutomation(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("automation repo: list by board id: scan: %v: %w", scanErr, ErrInternal)
+		}
+
+		automations = append(automations, automation)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("automation repo: list by board id: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return automations, nil
+}
+
+// Update overwrites the mutable fields of automation, matched by board and id.
+func (r *PGAutomation) Update(ctx context.Context, automation domain.Automation) (domain.Automation, error) {
+	const query = `
+		UPDATE automations
+		SET
+			name = @name,
+			trigger_type = @trigger_type,
+			trigger_column_id = @trigger_column_id,
+			trigger_field_id = @trigger_field_id,
+			trigger_label = @trigger_label,
+			action_type = @action_type,
+			action = @action,
+			enabled = @enabled,
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+		WHERE board_id = @board_id
+		  AND id = @id
+		RETURNING ` + automationColumns
+
+	args, err := automationArgs(&automation)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation repo: update: %v: %w", err, ErrInternal)
+	}
+	args["id"] = automation.ID
+
+	updated, err := ScanAutomation(r.pgPool.QueryRow(ctx, query, args))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Automation{}, ErrRowNotFound
+		}
+		return domain.Automation{}, fmt.Errorf("automation repo: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+func (r *PGAutomation) Delete(ctx context.Context, boardID domain.BoardID, automationID domain.AutomationID) error {
+	const query = `DELETE FROM automations WHERE board_id = $1 AND id = $2`
+
+	cmd, err := r.pgPool.Exec(ctx, query, boardID, automationID)
+	if err != nil {
+		return fmt.Errorf("automation repo: delete: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+// RecordPassedDueDates records up to limit due_date_passed events, one for every date custom
+// field value of a task that is not archived once its date is over in UTC. Only fields an
+// enabled due_date_passed automation watches are checked. The event occurs at the end of the
+// date, and a date passes once per task and field however often the runner records it.
+func (r *PGAutomation) RecordPassedDueDates(ctx context.Context, now time.Time, limit int) error {
+	const query = `
+		INSERT INTO task_field_events (board_id, task_id, column_id, field_id, type, value, occurred_at)
+		SELECT f.board_id, t.id, t.column_id, f.id, 'due_date_passed', due.date, (due.date::date + 1)::timestamp
+		FROM custom_fields f
+		JOIN columns c ON c.board_id = f.board_id
+		JOIN tasks t ON t.column_id = c.id
+		CROSS JOIN LATERAL (SELECT t.custom_fields ->> f.id::text AS date) due
+		WHERE f.type = 'date'
+		  AND EXISTS (
+			SELECT 1
+			FROM automations a
+			WHERE a.board_id = f.board_id
+			  AND a.trigger_field_id = f.id
+			  AND a.trigger_type = 'due_date_passed'
+			  AND a.enabled
+		  )
+		  AND t.archived_at IS NULL
+		  AND due.date::date < @today::date
+		  AND NOT EXISTS (
+			SELECT 1
+			FROM task_field_events e
+			WHERE e.task_id = t.id
+			  AND e.field_id = f.id
+			  AND e.type = 'due_date_passed'
+			  AND e.value = due.date
+		  )
+		LIMIT @limit
+		ON CONFLICT DO NOTHING`
+
+	_, err := r.pgPool.Exec(ctx, query, pgx.NamedArgs{
+		"today": now.UTC().Format(time.DateOnly),
+		"limit": limit,
+	})
+	if err != nil {
+		return fmt.Errorf("automation repo: record passed due dates: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// ClaimEvents claims up to limit task transitions and up to limit field events that automations
+// have not seen yet and returns them oldest first. Events locked by another replica are skipped,
+// so every event is claimed by exactly one replica even when several of them run concurrently.
+func (r *PGAutomation) ClaimEvents(ctx context.Context, now time.Time, limit int) ([]domain.AutomationEvent, error) {
+	const query = `
+	WITH pending_transitions AS (
+		SELECT id
+		FROM task_transitions
+		WHERE automations_run_at IS NULL
+		ORDER BY transitioned_at ASC, id ASC
+		LIMIT @limit
+		FOR UPDATE SKIP LOCKED
+	),
+	pending_field_events AS (
+		SELECT id
+		FROM task_field_events
+		WHERE automations_run_at IS NULL
+		ORDER BY occurred_at ASC, id ASC
+		LIMIT @limit
+		FOR UPDATE SKIP LOCKED
+	),
+	claimed_transitions AS (
+		UPDATE task_transitions tt
+		SET automations_run_at = @now
+		FROM pending_transitions pending
+		WHERE tt.id = pending.id
+		RETURNING tt.*
+	),
+	claimed_field_events AS (
+		UPDATE task_field_events fe
+		SET automations_run_at = @now
+		FROM pending_field_events pending
+		WHERE fe.id = pending.id
+		RETURNING fe.*
+	)
+	SELECT ` + automationEventColumns + `
+	FROM (
+		SELECT ` + transitionEventColumns + ` FROM claimed_transitions
+		UNION ALL
+		SELECT ` + fieldEventColumns + ` FROM claimed_field_events
+	) claimed
+	ORDER BY occurred_at ASC, id ASC`
+
+	return r.listEvents(ctx, "claim events", query, pgx.NamedArgs{
+		"now":   now,
+		"limit": limit,
+	})
+}
+
+// ListRecentEvents returns the latest limit events of the board, oldest first, whether
+// automations have seen them or not.
+func (r *PGAutomation) ListRecentEvents(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationEvent, error) {
+	const query = `
+	SELECT ` + automationEventColumns + `
+	FROM (
+		(
+			SELECT ` + transitionEventColumns + `
+			FROM task_transitions
+			WHERE board_id = @board_id
+			ORDER BY transitioned_at DESC, id DESC
+			LIMIT @limit
+		)
+		UNION ALL
+		(
+			SELECT ` + fieldEventColumns + `
+			FROM task_field_events
+			WHERE board_id = @board_id
+			ORDER BY occurred_at DESC, id DESC
+			LIMIT @limit
+		)
+		ORDER BY occurred_at DESC, id DESC
+		LIMIT @limit
+	) recent
+	ORDER BY occurred_at ASC, id ASC`
+
+	return r.listEvents(ctx, "list recent events", query, pgx.NamedArgs{
+		"board_id": boardID,
+		"limit":    limit,
+	})
+}
+
+func (r *PGAutomation) listEvents(ctx context.Context, op, query string, args pgx.NamedArgs) ([]domain.AutomationEvent, error) {
+	rows, err := r.pgPool.Query(ctx, query, args)
+	if err != nil {
+		return nil, fmt.Errorf("automation repo: %s: %v: %w", op, err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var events []domain.AutomationEvent
+	for rows.Next() {
+		event, scanErr := scanAutomationEvent(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("automation repo: %s: %v: %w", op, scanErr, ErrInternal)
+		}
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("automation repo: %s: rows final error: %v: %w", op, err, ErrInternal)
+	}
+
+	return events, nil
+}
+
+// CountRuns counts the runs of the automation on the task since since that were not skipped.
+func (r *PGAutomation) CountRuns(ctx context.Context, automationID domain.AutomationID, taskID domain.TaskID, since time.Time) (int, error) {
+	const query = `
+		SELECT COUNT(*)
+		FROM automation_runs
+		WHERE automation_id = @automation_id
+		  AND task_id = @task_id
+		  AND ran_at >= @since
+		  AND status <> 'skipped'`
+
+	var count int
+	err := r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"automation_id": automationID,
+		"task_id":       taskID,
+		"since":         since,
+	}).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf("automation repo: count runs: %v: %w", err, ErrInternal)
+	}
+
+	return count, nil
+}
+
+// RecordRun appends the run to the execution log of its board.
+func (r *PGAutomation) RecordRun(ctx context.Context, run domain.AutomationRun) error {
+	const query = `
+		INSERT INTO automation_runs (automation_id, board_id, event_id, task_id, status, message, ran_at)
+		VALUES (@automation_id, @board_id, @event_id, @task_id, @status, @message, @ran_at)`
+
+	_, err := r.pgPool.Exec(ctx, query, pgx.NamedArgs{
+		"automation_id": run.AutomationID,
+		"board_id":      run.BoardID,
+		"event_id":      run.EventID,
+		"task_id":       run.TaskID,
+		"status":        run.Status.String(),
+		"message":       run.Message,
+		"ran_at":        run.RanAt,
+	})
+	if err != nil {
+		return fmt.Errorf("automation repo: record run: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// ListRuns returns the latest limit runs of the automations of the board, newest first.
+func (r *PGAutomation) ListRuns(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationRun, error) {
+	const query = `
+		SELECT ` + automationRunColumns + `
+		FROM automation_runs
+		WHERE board_id = @board_id
+		ORDER BY ran_at DESC, id DESC
+		LIMIT @limit`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"board_id": boardID,
+		"limit":    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("automation repo: list runs: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var runs []domain.AutomationRun
+	for rows.Next() {
+		run, scanErr := scanAutomationRun(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("automation repo: list runs: %v: %w", scanErr, ErrInternal)
+		}
+		runs = append(runs, run)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("automation repo: list runs: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return runs, nil
+}
+
+// CreateFollowUpTask appends a task to the default lane of the column. Its creation is claimed
+// for automations right away, so follow-up tasks never fire task_created automations and
+// an automation can't multiply tasks endlessly. It returns ErrRowNotFound if the column does
+// not exist.
+func (r *PGAutomation) CreateFollowUpTask(
+	ctx context.Context,
+	columnID domain.ColumnID,
+	name domain.TaskName,
+	description domain.TaskDescription,
+	now time.Time,
+) (domain.Task, error) {
+	const claimCreationQuery = `
+		UPDATE task_transitions
+		SET automations_run_at = @now
+		WHERE task_id = @task_id`
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("automation repo: create follow-up task begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	task, err := insertTask(ctx, tx, columnID, domain.LaneID{}, domain.TaskID{}, name, description, domain.TaskChecklist{}, domain.TaskEstimate{}, domain.TaskCustomFields{})
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) {
+			return domain.Task{}, ErrRowNotFound
+		}
+		return domain.Task{}, fmt.Errorf("automation repo: create follow-up task: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, claimCreationQuery, pgx.NamedArgs{
+		"task_id": task.ID,
+		"now":     now,
+	})
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("automation repo: create follow-up task: claim creation: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("automation repo: create follow-up task commit: %v: %w", err, ErrInternal)
+	}
+
+	return task, nil
+}
+
+// automationActionRecord is the stored form of the parameters of an action.
+type automationActionRecord struct {
+	ColumnID string          `json:"columnId,omitempty"`
+	FieldID  string          `json:"fieldId,omitempty"`
+	Value    json.RawMessage `json:"value,omitempty"`
+	Message  string          `json:"message,omitempty"`
+	TaskName string          `json:"taskName,omitempty"`
+	Label    string          `json:"label,omitempty"`
+}
+
+func automationArgs(automation *domain.Automation) (pgx.NamedArgs, error) {
+	action := automation.Action
+	record := automationActionRecord{
+		Value:    action.Value,
+		Message:  action.Message.String(),
+		TaskName: action.TaskName.String(),
+		Label:    action.Label,
+	}
+	if !action.ColumnID.IsNil() {
+		record.ColumnID = action.ColumnID.String()
+	}
+	if !action.FieldID.IsNil() {
+		record.FieldID = action.FieldID.String()
+	}
+	rawAction, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("marshal action: %w", err)
+	}
+
+	var triggerLabel *string
+	if automation.Trigger.Label != "" {
+		triggerLabel = &automation.Trigger.Label
+	}
+
+	return pgx.NamedArgs{
+		"board_id":          automation.BoardID,
+		"name":              automation.Name.String(),
+		"trigger_type":      automation.Trigger.Type.String(),
+		"trigger_column_id": NullColumnID(automation.Trigger.ColumnID),
+		"trigger_field_id":  NullCustomFieldID(automation.Trigger.FieldID),
+		"trigger_label":     triggerLabel,
+		"action_type":       action.Type.String(),
+		"action":            string(rawAction),
+		"enabled":           automation.Enabled,
+	}, nil
+}
+
+func decodeAutomationAction(rawType string, raw []byte) (domain.AutomationAction, error) {
+	actionType, err := domain.NewAutomationActionType(rawType)
+	if err != nil {
+		return domain.AutomationAction{}, err
+	}
+	var record automationActionRecord
+	err = json.Unmarshal(raw, &record)
+	if err != nil {
+		return domain.AutomationAction{}, fmt.Errorf("unmarshal action: %w", err)
+	}
+
+	switch actionType {
+	case domain.AutomationMoveToColumn:
+		columnID, parseErr := domain.ParseColumnID(record.ColumnID)
+		if parseErr != nil {
+			return domain.AutomationAction{}, fmt.Errorf("column id: %w", parseErr)
+		}
+		return domain.NewMoveToColumnAction(columnID), nil
+	case domain.AutomationSetCustomField:
+		fieldID, parseErr := domain.ParseCustomFieldID(record.FieldID)
+		if parseErr != nil {
+			return domain.AutomationAction{}, fmt.Errorf("field id: %w", parseErr)
+		}
+		return domain.NewSetCustomFieldAction(fieldID, record.Value)
+	case domain.AutomationAddLabel:
+		fieldID, parseErr := domain.ParseCustomFieldID(record.FieldID)
+		if parseErr != nil {
+			return domain.AutomationAction{}, fmt.Errorf("field id: %w", parseErr)
+		}
+		return domain.NewAddLabelAction(fieldID, record.Label)
+	case domain.AutomationNotifyTelegram:
+		message, parseErr := domain.NewAutomationMessage(record.Message)
+		if parseErr != nil {
+			return domain.AutomationAction{}, fmt.Errorf("message: %w", parseErr)
+		}
+		return domain.NewNotifyTelegramAction(message), nil
+	default:
+		columnID, parseErr := domain.ParseColumnID(record.ColumnID)
+		if parseErr != nil {
+			return domain.AutomationAction{}, fmt.Errorf("column id: %w", parseErr)
+		}
+		name, parseErr := domain.NewTaskName(record.TaskName)
+		if parseErr != nil {
+			return domain.AutomationAction{}, fmt.Errorf("task name: %w", parseErr)
+		}
+		return domain.NewCreateTaskAction(columnID, name), nil
+	}
+}
+
+func ScanAutomation(row interface{ Scan(...any) error }) (domain.Automation, error) {
+	var (
+		rawID              uuid.UUID
+		rawBoardID         uuid.UUID
+		rawName            string
+		rawTriggerType     string
+		rawTriggerColumnID uuid.NullUUID
+		rawTriggerFieldID  uuid.NullUUID
+		rawTriggerLabel    *string
+		rawActionType      string
+		rawAction          []byte
+		enabled            bool
+		createdAt          time.Time
+		updatedAt          time.Time
+	)
+	err := row.Scan(&rawID, &rawBoardID, &rawName, &rawTriggerType, &rawTriggerColumnID, &rawTriggerFieldID, &rawTriggerLabel, &rawActionType, &rawAction, &enabled, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("scan automation: %w", err)
+	}
+	id, err := domain.NewAutomationIDFromUUID(rawID)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("scan automation: id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("scan automation: board id: %v: %w", err, errDataCorrupted)
+	}
+	name, err := domain.NewAutomationName(rawName)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("scan automation: name: %v: %w", err, errDataCorrupted)
+	}
+	triggerType, err := domain.NewAutomationTriggerType(rawTriggerType)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("scan automation: trigger type: %v: %w", err, errDataCorrupted)
+	}
+	var triggerColumnID domain.ColumnID
+	if rawTriggerColumnID.Valid {
+		triggerColumnID, err = domain.NewColumnIDFromUUID(rawTriggerColumnID.UUID)
+		if err != nil {
+			return domain.Automation{}, fmt.Errorf("scan automation: trigger column id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	trigger := domain.AutomationTrigger{Type: triggerType, ColumnID: triggerColumnID}
+	if rawTriggerFieldID.Valid {
+		trigger.FieldID, err = domain.NewCustomFieldIDFromUUID(rawTriggerFieldID.UUID)
+		if err != nil {
+			return domain.Automation{}, fmt.Errorf("scan automation: trigger field id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	if rawTriggerLabel != nil {
+		trigger.Label = *rawTriggerLabel
+	}
+	action, err := decodeAutomationAction(rawActionType, rawAction)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("scan automation: action: %v: %w", err, errDataCorrupted)
+	}
+
+	return domain.Automation{
+		ID:        id,
+		BoardID:   boardID,
+		Name:      name,
+		Trigger:   trigger,
+		Action:    action,
+		Enabled:   enabled,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+func scanAutomationEvent(row interface{ Scan(...any) error }) (domain.AutomationEvent, error) {
+	var (
+		rawID           uuid.UUID
+		rawType         string
+		rawBoardID      uuid.UUID
+		rawTaskID       uuid.UUID
+		rawFromColumnID uuid.NullUUID
+		rawToColumnID   uuid.UUID
+		rawFieldID      uuid.NullUUID
+		value           string
+		occurredAt      time.Time
+	)
+	err := row.Scan(&rawID, &rawType, &rawBoardID, &rawTaskID, &rawFromColumnID, &rawToColumnID, &rawFieldID, &value, &occurredAt)
+	if err != nil {
+		return domain.AutomationEvent{}, fmt.Errorf("scan automation event: %w", err)
+	}
+	id, err := domain.NewAutomationEventIDFromUUID(rawID)
+	if err != nil {
+		return domain.AutomationEvent{}, fmt.Errorf("scan automation event: id: %v: %w", err, errDataCorrupted)
+	}
+	eventType, err := domain.NewAutomationTriggerType(rawType)
+	if err != nil {
+		return domain.AutomationEvent{}, fmt.Errorf("scan automation event: type: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.AutomationEvent{}, fmt.Errorf("scan automation event: board id: %v: %w", err, errDataCorrupted)
+	}
+	taskID, err := domain.NewTaskIDFromUUID(rawTaskID)
+	if err != nil {
+		return domain.AutomationEvent{}, fmt.Errorf("scan automation event: task id: %v: %w", err, errDataCorrupted)
+	}
+	var fromColumnID domain.ColumnID
+	if rawFromColumnID.Valid {
+		fromColumnID, err = domain.NewColumnIDFromUUID(rawFromColumnID.UUID)
+		if err != nil {
+			return domain.AutomationEvent{}, fmt.Errorf("scan automation event: from column id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	toColumnID, err := domain.NewColumnIDFromUUID(rawToColumnID)
+	if err != nil {
+		return domain.AutomationEvent{}, fmt.Errorf("scan automation event: to column id: %v: %w", err, errDataCorrupted)
+	}
+	var fieldID domain.CustomFieldID
+	if rawFieldID.Valid {
+		fieldID, err = domain.NewCustomFieldIDFromUUID(rawFieldID.UUID)
+		if err != nil {
+			return domain.AutomationEvent{}, fmt.Errorf("scan automation event: field id: %v: %w", err, errDataCorrupted)
+		}
+	}
+
+	return domain.AutomationEvent{
+		ID:           id,
+		Type:         eventType,
+		BoardID:      boardID,
+		TaskID:       taskID,
+		FromColumnID: fromColumnID,
+		ToColumnID:   toColumnID,
+		FieldID:      fieldID,
+		Value:        value,
+		OccurredAt:   occurredAt,
+	}, nil
+}
+
+func scanAutomationRun(row interface{ Scan(...any) error }) (domain.AutomationRun, error) {
+	var (
+		rawID           uuid.UUID
+		rawAutomationID uuid.UUID
+		rawBoardID      uuid.UUID
+		rawEventID      uuid.UUID
+		rawTaskID       uuid.UUID
+		rawStatus       string
+		message         string
+		ranAt           time.Time
+	)
+	err := row.Scan(&rawID, &rawAutomationID, &rawBoardID, &rawEventID, &rawTaskID, &rawStatus, &message, &ranAt)
+	if err != nil {
+		return domain.AutomationRun{}, fmt.Errorf("scan automation run: %w", err)
+	}
+	id, err := domain.NewAutomationRunIDFromUUID(rawID)
+	if err != nil {
+		return domain.AutomationRun{}, fmt.Errorf("scan automation run: id: %v: %w", err, errDataCorrupted)
+	}
+	automationID, err := domain.NewAutomationIDFromUUID(rawAutomationID)
+	if err != nil {
+		return domain.AutomationRun{}, fmt.Errorf("scan automation run: automation id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.AutomationRun{}, fmt.Errorf("scan automation run: board id: %v: %w", err, errDataCorrupted)
+	}
+	eventID, err := domain.NewAutomationEventIDFromUUID(rawEventID)
+	if err != nil {
+		return domain.AutomationRun{}, fmt.Errorf("scan automation run: event id: %v: %w", err, errDataCorrupted)
+	}
+	taskID, err := domain.NewTaskIDFromUUID(rawTaskID)
+	if err != nil {
+		return domain.AutomationRun{}, fmt.Errorf("scan automation run: task id: %v: %w", err, errDataCorrupted)
+	}
+	status, err := domain.NewAutomationRunStatus(rawStatus)
+	if err != nil {
+		return domain.AutomationRun{}, fmt.Errorf("scan automation run: status: %v: %w", err, errDataCorrupted)
+	}
+
+	return domain.AutomationRun{
+		ID:           id,
+		AutomationID: automationID,
+		BoardID:      boardID,
+		EventID:      eventID,
+		TaskID:       taskID,
+		Status:       status,
+		Message:      message,
+		RanAt:        ranAt,
+	}, nil
+}
@@ -0,0 +1,279 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestAutomationRepository_CRUD(t *testing.T) {
+	pool, r := automationRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	created, err := r.Create(context.Background(), testutil.ValidAutomation(board.ID, column.ID))
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.ID.IsNil() {
+		t.Errorf("got empty automation ID, want generated ID")
+	}
+	AssertTimestampPrecisionAtLeastMillis(t, pool, "automations", "created_at", "updated_at")
+
+	action, err := domain.NewSetCustomFieldAction(domain.NewCustomFieldID(), json.RawMessage(`"production"`))
+	if err != nil {
+		t.Fatalf("NewSetCustomFieldAction() error = %v", err)
+	}
+	created.Trigger = domain.AutomationTrigger{Type: domain.AutomationTaskMoved, ColumnID: column.ID}
+	created.Action = action
+	created.Enabled = false
+	updated, err := r.Update(context.Background(), created)
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	if updated.Trigger != created.Trigger || updated.Enabled {
+		t.Errorf("got trigger %+v enabled %v, want %+v false", updated.Trigger, updated.Enabled, created.Trigger)
+	}
+	if updated.Action.FieldID != action.FieldID || string(updated.Action.Value) != `"production"` {
+		t.Errorf("got action %+v, want %+v", updated.Action, action)
+	}
+
+	listed, err := r.ListByBoardID(context.Background(), board.ID)
+	if err != nil {
+		t.Fatalf("ListByBoardID() error = %v", err)
+	}
+	if diff := cmp.Diff([]domain.Automation{updated}, listed, testutil.CmpAllowUnexported()); diff != "" {
+		t.Errorf("listed automations mismatch (-want +got):\n%s", diff)
+	}
+
+	err = r.Delete(context.Background(), domain.NewBoardID(), updated.ID)
+	if !errors.Is(err, repository.ErrRowNotFound) {
+		t.Errorf("got delete on another board error %v, want %v", err, repository.ErrRowNotFound)
+	}
+	err = r.Delete(context.Background(), board.ID, updated.ID)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	_, err = r.Get(context.Background(), updated.ID)
+	if !errors.Is(err, repository.ErrRowNotFound) {
+		t.Errorf("got get after delete error %v, want %v", err, repository.ErrRowNotFound)
+	}
+}
+
+func TestAutomationRepository_ClaimEvents(t *testing.T) {
+	pool, r := automationRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+	CreateColumn(t, pool, &done)
+
+	task := createTaskWithHistory(t, taskRepo, todo.ID)
+	_, err := taskRepo.Move(context.Background(), board.ID, todo.ID, task.ID, done.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 1), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+	followUp, err := r.CreateFollowUpTask(context.Background(), done.ID, task.Name, task.Description, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("CreateFollowUpTask() error = %v", err)
+	}
+	if followUp.ColumnID != done.ID {
+		t.Errorf("got follow-up column %v, want %v", followUp.ColumnID, done.ID)
+	}
+
+	events, err := r.ClaimEvents(context.Background(), time.Now().UTC(), 10)
+	if err != nil {
+		t.Fatalf("ClaimEvents() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("got %d events, want creation and move of the task", len(events))
+	}
+	if events[0].Type != domain.AutomationTaskCreated || events[1].Type != domain.AutomationTaskMoved {
+		t.Errorf("got event types %v %v, want task_created task_moved", events[0].Type, events[1].Type)
+	}
+	if events[1].TaskID != task.ID || events[1].FromColumnID != todo.ID || events[1].ToColumnID != done.ID {
+		t.Errorf("got move event %+v, want task %v from %v to %v", events[1], task.ID, todo.ID, done.ID)
+	}
+
+	again, err := r.ClaimEvents(context.Background(), time.Now().UTC(), 10)
+	if err != nil {
+		t.Fatalf("ClaimEvents() again error = %v", err)
+	}
+	if len(again) != 0 {
+		t.Errorf("got %d events claimed twice, want 0", len(again))
+	}
+
+	recent, err := r.ListRecentEvents(context.Background(), board.ID, 2)
+	if err != nil {
+		t.Fatalf("ListRecentEvents() error = %v", err)
+	}
+	if len(recent) != 2 || recent[0].ID != events[1].ID || recent[1].TaskID != followUp.ID {
+		t.Errorf("got recent events %+v, want the move and the follow-up creation", recent)
+	}
+}
+
+func TestAutomationRepository_FieldEvents(t *testing.T) {
+	pool, r := automationRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+	fieldRepo := repository.NewPGCustomField(pool)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	tags := createCustomField(t, fieldRepo, board.ID, domain.CustomFieldMultiSelect, "backend", "frontend")
+	due := createCustomField(t, fieldRepo, board.ID, domain.CustomFieldDate)
+	unwatched := createCustomField(t, fieldRepo, board.ID, domain.CustomFieldDate)
+
+	dueAutomation := testutil.ValidAutomation(board.ID, todo.ID)
+	dueAutomation.Trigger = domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: due.ID}
+	_, err := r.Create(context.Background(), dueAutomation)
+	if err != nil {
+		t.Fatalf("Create() due automation error = %v", err)
+	}
+
+	action, err := domain.NewAddLabelAction(tags.ID, "frontend")
+	if err != nil {
+		t.Fatalf("NewAddLabelAction() error = %v", err)
+	}
+	automation := testutil.ValidAutomation(board.ID, todo.ID)
+	automation.Trigger = domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: tags.ID, Label: "backend"}
+	automation.Action = action
+	created, err := r.Create(context.Background(), automation)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if created.Trigger != automation.Trigger || created.Action.FieldID != tags.ID || created.Action.Label != "frontend" {
+		t.Errorf("got trigger %+v action %+v, want %+v %+v", created.Trigger, created.Action, automation.Trigger, action)
+	}
+
+	task := createTaskWithCustomField(t, taskRepo, todo.ID, &tags, `["backend"]`)
+	task = setTaskCustomField(t, taskRepo, &task, &tags, `["backend", "frontend"]`)
+	task = setTaskCustomField(t, taskRepo, &task, &due, `"2026-01-01"`)
+	task = setTaskCustomField(t, taskRepo, &task, &unwatched, `"2026-01-01"`)
+	createTaskWithCustomField(t, taskRepo, todo.ID, &due, `"2999-01-01"`)
+
+	// Only the field of the due_date_passed automation is checked, and the second run finds
+	// nothing left to record.
+	now := time.Now().UTC()
+	for range 2 {
+		err = r.RecordPassedDueDates(context.Background(), now, 1)
+		if err != nil {
+			t.Fatalf("RecordPassedDueDates() error = %v", err)
+		}
+	}
+
+	events, err := r.ClaimEvents(context.Background(), now, 10)
+	if err != nil {
+		t.Fatalf("ClaimEvents() error = %v", err)
+	}
+	type fieldEvent struct {
+		Type    domain.AutomationTriggerType
+		TaskID  domain.TaskID
+		FieldID domain.CustomFieldID
+		Value   string
+	}
+	var got []fieldEvent
+	for _, event := range events {
+		if event.Type.IsFieldTrigger() {
+			if event.ToColumnID != todo.ID || !event.FromColumnID.IsNil() {
+				t.Errorf("got field event columns %v %v, want none and %v", event.FromColumnID, event.ToColumnID, todo.ID)
+			}
+			got = append(got, fieldEvent{Type: event.Type, TaskID: event.TaskID, FieldID: event.FieldID, Value: event.Value})
+		}
+	}
+	want := []fieldEvent{
+		{Type: domain.AutomationDueDatePassed, TaskID: task.ID, FieldID: due.ID, Value: "2026-01-01"},
+		{Type: domain.AutomationLabelAdded, TaskID: task.ID, FieldID: tags.ID, Value: "backend"},
+		{Type: domain.AutomationLabelAdded, TaskID: task.ID, FieldID: tags.ID, Value: "frontend"},
+	}
+	if diff := cmp.Diff(want, got, testutil.CmpAllowUnexported()); diff != "" {
+		t.Errorf("claimed field events mismatch (-want +got):\n%s", diff)
+	}
+	if len(events) != len(want)+2 {
+		t.Errorf("got %d events, want the field events and 2 task creations", len(events))
+	}
+
+	again, err := r.ClaimEvents(context.Background(), now, 10)
+	if err != nil {
+		t.Fatalf("ClaimEvents() again error = %v", err)
+	}
+	if len(again) != 0 {
+		t.Errorf("got %d events claimed twice, want 0", len(again))
+	}
+
+	recent, err := r.ListRecentEvents(context.Background(), board.ID, 2)
+	if err != nil {
+		t.Fatalf("ListRecentEvents() error = %v", err)
+	}
+	if len(recent) != 2 || recent[0].Value != "frontend" || recent[1].Type != domain.AutomationTaskCreated {
+		t.Errorf("got recent events %+v, want the frontend label and the creation of the last task", recent)
+	}
+}
+
+func TestAutomationRepository_Runs(t *testing.T) {
+	pool, r := automationRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	automation, err := r.Create(context.Background(), testutil.ValidAutomation(board.ID, column.ID))
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	taskID := domain.NewTaskID()
+	now := time.Now().UTC()
+	for i, status := range []domain.AutomationRunStatus{domain.AutomationRunSucceeded, domain.AutomationRunSkipped, domain.AutomationRunFailed} {
+		err = r.RecordRun(context.Background(), domain.AutomationRun{
+			AutomationID: automation.ID,
+			BoardID:      board.ID,
+			EventID:      domain.NewAutomationEventID(),
+			TaskID:       taskID,
+			Status:       status,
+			Message:      status.String(),
+			RanAt:        now.Add(time.Duration(i) * time.Second),
+		})
+		if err != nil {
+			t.Fatalf("RecordRun() error = %v", err)
+		}
+	}
+
+	count, err := r.CountRuns(context.Background(), automation.ID, taskID, now.Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("CountRuns() error = %v", err)
+	}
+	if count != 2 {
+		t.Errorf("got %d runs, want 2 without the skipped one", count)
+	}
+
+	runs, err := r.ListRuns(context.Background(), board.ID, 2)
+	if err != nil {
+		t.Fatalf("ListRuns() error = %v", err)
+	}
+	if len(runs) != 2 || runs[0].Status != domain.AutomationRunFailed || runs[1].Status != domain.AutomationRunSkipped {
+		t.Errorf("got runs %+v, want the failed and the skipped run, newest first", runs)
+	}
+}
+
+func automationRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGAutomation) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGAutomation(pool)
+}
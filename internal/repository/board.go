@@ -24,7 +24,7 @@ func NewPGBoard(pgPool *pgxpool.Pool) *PGBoard {
 }
 
 func (r *PGBoard) Create(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error) {
-	const query = `INSERT INTO boards (owner_id, name, description) VALUES ($1, $2, $3) RETURNING id, owner_id, name, description, created_at, updated_at`
+	const query = `INSERT INTO boards (owner_id, name, description) VALUES ($1, $2, $3) RETURNING id, owner_id, name, description, created_at, updated_at, version`
 
 	board, err := ScanBoard(r.pgPool.QueryRow(ctx, query, ownerID, name, description))
 	if err != nil {
@@ -34,13 +34,103 @@ func (r *PGBoard) Create(ctx context.Context, ownerID domain.UserID, name domain
 	return board, nil
 }
 
+func (r *PGBoard) CreateWithColumns(
+	ctx context.Context,
+	ownerID domain.UserID,
+	name domain.BoardName,
+	description domain.BoardDescription,
+	columns []domain.BoardTemplateColumn,
+) (domain.Board, error) {
+	const (
+		insertBoardQuery = `
+		INSERT INTO boards (owner_id, name, description)
+		VALUES (@owner_id, @name, @description)
+		RETURNING id, owner_id, name, description, created_at, updated_at, version`
+		insertColumnQuery = `
+		INSERT INTO columns (board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done)
+		VALUES (@board_id, @name, @description, @position, @wip_limit, @sla_hours, @archive_after_days, @is_started, @is_done)
+		RETURNING id`
+		insertTaskQuery = `
+		WITH task AS (
+			INSERT INTO tasks (column_id, name, description, position)
+			VALUES (@column_id, @name, @description, @position)
+			RETURNING id, column_id
+		),
+		transitions AS (
+			INSERT INTO task_transitions (task_id, board_id, to_column_id)
+			SELECT id, @board_id, column_id
+			FROM task
+			RETURNING board_id, from_column_id, transitioned_at
+		)` + countTransitionsQuery
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: create with columns begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	board, err := ScanBoard(tx.QueryRow(ctx, insertBoardQuery, pgx.NamedArgs{
+		"owner_id":    ownerID,
+		"name":        name,
+		"description": description,
+	}))
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: create with columns insert board: %v: %w", err, ErrInternal)
+	}
+
+	for i, column := range columns {
+		var columnID uuid.UUID
+		err = tx.QueryRow(ctx, insertColumnQuery, pgx.NamedArgs{
+			"board_id":           board.ID,
+			"name":               column.Name,
+			"description":        column.Description,
+			"position":           i + 1,
+			"wip_limit":          column.WIPLimit,
+			"sla_hours":          column.SLA,
+			"archive_after_days": column.ArchiveAfter,
+			"is_started":         column.IsStarted,
+			"is_done":            column.IsDone,
+		}).Scan(&columnID)
+		if err != nil {
+			return domain.Board{}, fmt.Errorf("board repo: create with columns insert column: %v: %w", err, ErrInternal)
+		}
+
+		for j, task := range column.Tasks {
+			_, err = tx.Exec(ctx, insertTaskQuery, pgx.NamedArgs{
+				"board_id":    board.ID,
+				"column_id":   columnID,
+				"name":        task.Name,
+				"description": task.Description,
+				"position":    j + 1,
+			})
+			if err != nil {
+				return domain.Board{}, fmt.Errorf("board repo: create with columns insert task: %v: %w", err, ErrInternal)
+			}
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: create with columns commit: %v: %w", err, ErrInternal)
+	}
+
+	return board, nil
+}
+
 func (r *PGBoard) Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+	return getBoard(ctx, r.pgPool, boardID)
+}
+
+func getBoard(ctx context.Context, q rowQuerier, boardID domain.BoardID) (domain.Board, error) {
 	const query = `
-		SELECT id, owner_id, name, description, created_at, updated_at
+		SELECT id, owner_id, name, description, created_at, updated_at, version
 		FROM boards
 		WHERE id = $1`
 
-	board, err := ScanBoard(r.pgPool.QueryRow(ctx, query, boardID))
+	board, err := ScanBoard(q.QueryRow(ctx, query, boardID))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Board{}, ErrRowNotFound
@@ -53,7 +143,7 @@ func (r *PGBoard) Get(ctx context.Context, boardID domain.BoardID) (domain.Board
 
 func (r *PGBoard) ListByOwnerID(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error) {
 	const query = `
-		SELECT id, owner_id, name, description, created_at, updated_at
+		SELECT id, owner_id, name, description, created_at, updated_at, version
 		FROM boards
 		WHERE owner_id = $1
 		ORDER BY created_at ASC`
@@ -82,20 +172,37 @@ func (r *PGBoard) ListByOwnerID(ctx context.Context, ownerID domain.UserID) ([]d
 	return boards, nil
 }
 
-func (r *PGBoard) Update(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
-	const query = `
+// Update changes the board if it is at the expected version, or at any version if expected
+// is zero, and bumps its version.
+func (r *PGBoard) Update(
+	ctx context.Context,
+	boardID domain.BoardID,
+	name *domain.BoardName,
+	description *domain.BoardDescription,
+	expected domain.Version,
+) (domain.Board, error) {
+	const (
+		query = `
 		UPDATE boards
 		SET
 			name = COALESCE($1, name),
 			description = COALESCE($2, description),
-			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+			version = version + 1
 		WHERE id = $3
-		RETURNING id, owner_id, name, description, created_at, updated_at`
+		  AND ($4 = 0 OR version = $4)
+		RETURNING id, owner_id, name, description, created_at, updated_at, version`
+
+		existsQuery = `SELECT 1 FROM boards WHERE id = $1`
+	)
 
-	board, err := ScanBoard(r.pgPool.QueryRow(ctx, query, name, description, boardID))
+	board, err := ScanBoard(r.pgPool.QueryRow(ctx, query, name, description, boardID, expected))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.Board{}, ErrRowNotFound
+			err = versionMismatch(ctx, r.pgPool, expected, existsQuery, boardID)
+			if errors.Is(err, ErrRowNotFound) || errors.Is(err, ErrVersionConflict) {
+				return domain.Board{}, err
+			}
 		}
 		return domain.Board{}, fmt.Errorf("board repo: update: %v: %w", err, ErrInternal)
 	}
@@ -103,20 +210,291 @@ func (r *PGBoard) Update(ctx context.Context, boardID domain.BoardID, name *doma
 	return board, nil
 }
 
-func (r *PGBoard) Delete(ctx context.Context, boardID domain.BoardID) error {
-	const query = `DELETE FROM boards WHERE id = $1`
+// Delete deletes the board if it is at the expected version, or at any version if expected
+// is zero.
+func (r *PGBoard) Delete(ctx context.Context, boardID domain.BoardID, expected domain.Version) error {
+	const (
+		query = `DELETE FROM boards WHERE id = $1 AND ($2 = 0 OR version = $2)`
+
+		existsQuery = `SELECT 1 FROM boards WHERE id = $1`
+	)
 
-	cmd, err := r.pgPool.Exec(ctx, query, boardID)
+	cmd, err := r.pgPool.Exec(ctx, query, boardID, expected)
 	if err != nil {
 		return fmt.Errorf("board repo: delete: %v: %w", err, ErrInternal)
 	}
 	if cmd.RowsAffected() == 0 {
-		return ErrRowNotFound
+		err = versionMismatch(ctx, r.pgPool, expected, existsQuery, boardID)
+		if errors.Is(err, ErrRowNotFound) || errors.Is(err, ErrVersionConflict) {
+			return err
+		}
+		return fmt.Errorf("board repo: delete: %v: %w", err, ErrInternal)
 	}
 
 	return nil
 }
 
+// copiedTaskPairsCTE pairs every active task of @board_id with its copy on @copy_board_id.
+// Copies keep the column, lane and task positions, so the triple of positions identifies the
+// copy of every source task.
+const copiedTaskPairsCTE = `pairs AS (
+			SELECT src.id AS src_id, copy.id AS copy_id
+			FROM tasks src
+			JOIN columns src_col ON src_col.id = src.column_id
+			LEFT JOIN lanes src_lane ON src_lane.id = src.lane_id
+			JOIN columns copy_col ON copy_col.board_id = @copy_board_id AND copy_col.position = src_col.position
+			JOIN tasks copy ON copy.column_id = copy_col.id AND copy.position = src.position
+			LEFT JOIN lanes copy_lane ON copy_lane.id = copy.lane_id
+			WHERE src_col.board_id = @board_id
+			  AND src.archived_at IS NULL
+			  AND copy_lane.position IS NOT DISTINCT FROM src_lane.position
+		)`
+
+// Duplicate copies the board with its lanes, custom fields, columns, active tasks, parents and
+// the links between them. Sprints are not copied, so every task copy lands in the backlog of the
+// new board, and archived tasks stay with the source board.
+func (r *PGBoard) Duplicate(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+	const (
+		// 1. Lock the source board so it can't be deleted while it is copied.
+		lockBoardQuery = `
+		SELECT 1
+		FROM boards
+		WHERE id = @board_id
+		FOR SHARE`
+
+		// 2. Lock the source columns so their tasks can't change while they are copied.
+		lockColumnsQuery = `
+		SELECT id
+		FROM columns
+		WHERE board_id = @board_id
+		ORDER BY id
+		FOR SHARE`
+
+		// 3. Insert the board copy. Boards are listed by creation time, so the copy lands at the end.
+		insertBoardCopyQuery = `
+		INSERT INTO boards (owner_id, name, description)
+		SELECT owner_id, name, description
+		FROM boards
+		WHERE id = @board_id
+		RETURNING id, owner_id, name, description, created_at, updated_at, version`
+
+		// 4. Copy the lanes keeping their positions.
+		insertLaneCopiesQuery = `
+		INSERT INTO lanes (board_id, name, position)
+		SELECT @copy_board_id, name, position
+		FROM lanes
+		WHERE board_id = @board_id
+		ORDER BY position ASC`
+
+		// 5. Copy the custom fields, keeping their order. The copy id of every source field is
+		//    returned as a JSON object keyed by the source id, to rewrite the task values.
+		insertCustomFieldCopiesQuery = `
+		WITH pairs AS MATERIALIZED (
+			SELECT id AS src_id, uuidv7() AS copy_id, name, type, options
+			FROM custom_fields
+			WHERE board_id = @board_id
+			ORDER BY created_at ASC, id ASC
+		),
+		copies AS (
+			INSERT INTO custom_fields (id, board_id, name, type, options)
+			SELECT copy_id, @copy_board_id, name, type, options
+			FROM pairs
+		)
+		SELECT COALESCE(jsonb_object_agg(src_id::text, copy_id::text), '{}')
+		FROM pairs`
+
+		// 6. Insert a column copy at the same position under the new board.
+		insertColumnCopyQuery = `
+		INSERT INTO columns (board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, entry_conditions, entry_roles)
+		SELECT @copy_board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, entry_conditions, entry_roles
+		FROM columns
+		WHERE id = @column_id
+		RETURNING id`
+
+		// 7. Copy the column's active tasks keeping their positions. The lane copy is found by the lane
+		//    position and custom field values are moved to the field copies. Every copy starts its own
+		//    history in the column copy.
+		insertTaskCopiesQuery = `
+		WITH copies AS (
+			INSERT INTO tasks (column_id, lane_id, name, description, position, checklist, estimate, custom_fields)
+			SELECT @copy_column_id, copy_lane.id, t.name, t.description, t.position, t.checklist, t.estimate,
+			       (SELECT COALESCE(jsonb_object_agg(@field_ids::jsonb ->> f.key, f.value), '{}')
+			        FROM jsonb_each(t.custom_fields) f
+			        WHERE @field_ids::jsonb ? f.key)
+			FROM tasks t
+			LEFT JOIN lanes src_lane ON src_lane.id = t.lane_id
+			LEFT JOIN lanes copy_lane ON copy_lane.board_id = @copy_board_id AND copy_lane.position = src_lane.position
+			WHERE t.column_id = @column_id
+			  AND t.archived_at IS NULL
+			ORDER BY t.position ASC
+			RETURNING id, column_id
+		),
+		transitions AS (
+			INSERT INTO task_transitions (task_id, board_id, to_column_id)
+			SELECT id, @copy_board_id, column_id
+			FROM copies
+			RETURNING board_id, from_column_id, transitioned_at
+		)` + countTransitionsQuery
+
+		// 8. Restore parents between the copies.
+		restoreParentsQuery = `
+		WITH ` + copiedTaskPairsCTE + `
+		UPDATE tasks copy_child
+		SET parent_id = parent_pair.copy_id
+		FROM pairs child_pair
+		JOIN tasks src_child ON src_child.id = child_pair.src_id
+		JOIN pairs parent_pair ON parent_pair.src_id = src_child.parent_id
+		WHERE copy_child.id = child_pair.copy_id`
+
+		// 9. Point the allowed transitions of the column copies at the copies of their targets,
+		//    again found by column position.
+		restoreTransitionsQuery = `
+		UPDATE columns copy_col
+		SET allowed_transitions = ARRAY(
+			SELECT copy_target.id
+			FROM unnest(src_col.allowed_transitions) WITH ORDINALITY AS t(target_id, ord)
+			JOIN columns src_target ON src_target.id = t.target_id
+			JOIN columns copy_target ON copy_target.board_id = @copy_board_id AND copy_target.position = src_target.position
+			ORDER BY t.ord
+		)
+		FROM columns src_col
+		WHERE src_col.board_id = @board_id
+		  AND copy_col.board_id = @copy_board_id
+		  AND copy_col.position = src_col.position
+		  AND cardinality(src_col.allowed_transitions) > 0`
+
+		// 10. Copy the links between the copied tasks. Links to tasks on other boards stay with the
+		//    source. A relates_to link keeps the smaller id as its source.
+		insertLinkCopiesQuery = `
+		WITH ` + copiedTaskPairsCTE + `
+		INSERT INTO task_links (source_task_id, target_task_id, type)
+		SELECT CASE WHEN l.type = 'relates_to' THEN LEAST(src_pair.copy_id, target_pair.copy_id) ELSE src_pair.copy_id END,
+		       CASE WHEN l.type = 'relates_to' THEN GREATEST(src_pair.copy_id, target_pair.copy_id) ELSE target_pair.copy_id END,
+		       l.type
+		FROM task_links l
+		JOIN pairs src_pair ON src_pair.src_id = l.source_task_id
+		JOIN pairs target_pair ON target_pair.src_id = l.target_task_id`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var locked int
+	err = tx.QueryRow(ctx, lockBoardQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	}).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Board{}, ErrRowNotFound
+		}
+		return domain.Board{}, fmt.Errorf("board repo: duplicate lock board: %v: %w", err, ErrInternal)
+	}
+
+	rows, err := tx.Query(ctx, lockColumnsQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	})
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate lock columns: %v: %w", err, ErrInternal)
+	}
+	var columnIDs []uuid.UUID
+	for rows.Next() {
+		var columnID uuid.UUID
+		scanErr := rows.Scan(&columnID)
+		if scanErr != nil {
+			rows.Close()
+			return domain.Board{}, fmt.Errorf("board repo: duplicate lock columns: scan: %v: %w", scanErr, ErrInternal)
+		}
+		columnIDs = append(columnIDs, columnID)
+	}
+	rows.Close()
+
+	err = rows.Err()
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate lock columns: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	board, err := ScanBoard(tx.QueryRow(ctx, insertBoardCopyQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	}))
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate insert board copy: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, insertLaneCopiesQuery, pgx.NamedArgs{
+		"copy_board_id": board.ID,
+		"board_id":      boardID,
+	})
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate insert lane copies: %v: %w", err, ErrInternal)
+	}
+
+	var fieldIDs []byte
+	err = tx.QueryRow(ctx, insertCustomFieldCopiesQuery, pgx.NamedArgs{
+		"copy_board_id": board.ID,
+		"board_id":      boardID,
+	}).Scan(&fieldIDs)
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate insert custom field copies: %v: %w", err, ErrInternal)
+	}
+
+	for _, columnID := range columnIDs {
+		var copyColumnID uuid.UUID
+		err = tx.QueryRow(ctx, insertColumnCopyQuery, pgx.NamedArgs{
+			"copy_board_id": board.ID,
+			"column_id":     columnID,
+		}).Scan(&copyColumnID)
+		if err != nil {
+			return domain.Board{}, fmt.Errorf("board repo: duplicate insert column copy: %v: %w", err, ErrInternal)
+		}
+
+		_, err = tx.Exec(ctx, insertTaskCopiesQuery, pgx.NamedArgs{
+			"copy_board_id":  board.ID,
+			"copy_column_id": copyColumnID,
+			"column_id":      columnID,
+			"field_ids":      string(fieldIDs),
+		})
+		if err != nil {
+			return domain.Board{}, fmt.Errorf("board repo: duplicate insert task copies: %v: %w", err, ErrInternal)
+		}
+	}
+
+	_, err = tx.Exec(ctx, restoreParentsQuery, pgx.NamedArgs{
+		"copy_board_id": board.ID,
+		"board_id":      boardID,
+	})
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate restore parents: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, restoreTransitionsQuery, pgx.NamedArgs{
+		"copy_board_id": board.ID,
+		"board_id":      boardID,
+	})
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate restore transitions: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, insertLinkCopiesQuery, pgx.NamedArgs{
+		"copy_board_id": board.ID,
+		"board_id":      boardID,
+	})
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate insert link copies: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board repo: duplicate commit: %v: %w", err, ErrInternal)
+	}
+
+	return board, nil
+}
+
 func ScanBoard(row interface{ Scan(...any) error }) (domain.Board, error) {
 	var (
 		rawID      uuid.UUID
@@ -125,8 +503,9 @@ func ScanBoard(row interface{ Scan(...any) error }) (domain.Board, error) {
 		rawDesc    string
 		createdAt  time.Time
 		updatedAt  time.Time
+		rawVersion int64
 	)
-	err := row.Scan(&rawID, &rawOwnerID, &rawName, &rawDesc, &createdAt, &updatedAt)
+	err := row.Scan(&rawID, &rawOwnerID, &rawName, &rawDesc, &createdAt, &updatedAt, &rawVersion)
 	if err != nil {
 		return domain.Board{}, fmt.Errorf("scan board: %w", err)
 	}
@@ -146,6 +525,10 @@ func ScanBoard(row interface{ Scan(...any) error }) (domain.Board, error) {
 	if err != nil {
 		return domain.Board{}, fmt.Errorf("scan board: owner id: %v: %w", err, errDataCorrupted)
 	}
+	version, err := domain.NewVersion(rawVersion)
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("scan board: version: %v: %w", err, errDataCorrupted)
+	}
 	return domain.Board{
 		ID:          id,
 		OwnerID:     ownerID,
@@ -153,5 +536,6 @@ func ScanBoard(row interface{ Scan(...any) error }) (domain.Board, error) {
 		Description: desc,
 		CreatedAt:   createdAt,
 		UpdatedAt:   updatedAt,
+		Version:     version,
 	}, nil
 }
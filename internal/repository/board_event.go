@@ -0,0 +1,185 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const boardEventColumns = `tx_id, id, entity, action, board_id, entity_id, column_id, version, occurred_at`
+
+// boardEventsChannel is the notification channel the board_events triggers send board ids to.
+const boardEventsChannel = "board_events"
+
+// settledTxIDExpr is the oldest transaction still running. Every transaction before it has
+// committed or rolled back, so no event ordered before it can appear later.
+const settledTxIDExpr = `pg_snapshot_xmin(pg_current_snapshot())::text::bigint`
+
+type PGBoardEvent struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGBoardEvent(pgPool *pgxpool.Pool) *PGBoardEvent {
+	return &PGBoardEvent{pgPool: pgPool}
+}
+
+// Head returns the cursor after every event written so far.
+func (r *PGBoardEvent) Head(ctx context.Context) (domain.BoardEventCursor, error) {
+	const query = `SELECT ` + settledTxIDExpr
+
+	var txID int64
+	err := r.pgPool.QueryRow(ctx, query).Scan(&txID)
+	if err != nil {
+		return domain.BoardEventCursor{}, fmt.Errorf("board event repo: head: %v: %w", err, ErrInternal)
+	}
+
+	return domain.NewBoardEventCursor(txID, 0), nil
+}
+
+// ListAfter returns up to limit events of the board after the cursor, oldest first. Events of
+// transactions that are still running, or that began before one still running, are held back.
+func (r *PGBoardEvent) ListAfter(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor, limit int) ([]domain.BoardEvent, error) {
+	const query = `
+	SELECT ` + boardEventColumns + `
+	FROM board_events
+	WHERE board_id = @board_id
+	  AND (tx_id, id) > (@tx_id, @id)
+	  AND tx_id < ` + settledTxIDExpr + `
+	ORDER BY tx_id ASC, id ASC
+	LIMIT @limit`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"board_id": boardID,
+		"tx_id":    after.TxID(),
+		"id":       after.ID(),
+		"limit":    limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("board event repo: list after: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var events []domain.BoardEvent
+	for rows.Next() {
+		event, scanErr := ScanBoardEvent(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("board event repo: list after: scan: %v: %w", scanErr, ErrInternal)
+		}
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("board event repo: list after: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return events, nil
+}
+
+// Listen calls notify with the board of every event committed by any replica until ctx is done.
+// It holds a connection of its own, taken out of the pool for good. Notifications sent while
+// nobody listens are lost, so callers must not rely on them alone.
+func (r *PGBoardEvent) Listen(ctx context.Context, notify func(domain.BoardID)) error {
+	poolConn, err := r.pgPool.Acquire(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("board event repo: listen: acquire: %v: %w", err, ErrInternal)
+	}
+	conn := poolConn.Hijack()
+	defer func() {
+		closeCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 5*time.Second)
+		defer cancel()
+		_ = conn.Close(closeCtx)
+	}()
+
+	_, err = conn.Exec(ctx, "LISTEN "+boardEventsChannel)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return fmt.Errorf("board event repo: listen: %v: %w", err, ErrInternal)
+	}
+
+	for {
+		notification, waitErr := conn.WaitForNotification(ctx)
+		if waitErr != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("board event repo: listen: wait: %v: %w", waitErr, ErrInternal)
+		}
+
+		boardID, parseErr := domain.ParseBoardID(notification.Payload)
+		if parseErr != nil {
+			continue
+		}
+		notify(boardID)
+	}
+}
+
+func ScanBoardEvent(row interface{ Scan(...any) error }) (domain.BoardEvent, error) {
+	var (
+		txID        int64
+		id          int64
+		rawEntity   string
+		rawAction   string
+		rawBoardID  uuid.UUID
+		rawEntityID uuid.UUID
+		rawColumnID uuid.NullUUID
+		rawVersion  int64
+		occurredAt  time.Time
+	)
+	err := row.Scan(&txID, &id, &rawEntity, &rawAction, &rawBoardID, &rawEntityID, &rawColumnID, &rawVersion, &occurredAt)
+	if err != nil {
+		return domain.BoardEvent{}, fmt.Errorf("scan board event: %w", err)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.BoardEvent{}, fmt.Errorf("scan board event: board id: %v: %w", err, errDataCorrupted)
+	}
+	version, err := domain.NewVersion(rawVersion)
+	if err != nil {
+		return domain.BoardEvent{}, fmt.Errorf("scan board event: version: %v: %w", err, errDataCorrupted)
+	}
+	event := domain.BoardEvent{
+		Cursor:     domain.NewBoardEventCursor(txID, id),
+		Entity:     domain.BoardEventEntity(rawEntity),
+		Action:     domain.BoardEventAction(rawAction),
+		BoardID:    boardID,
+		Version:    version,
+		OccurredAt: occurredAt,
+	}
+
+	switch event.Entity {
+	case domain.BoardEventBoard:
+	case domain.BoardEventColumn:
+		event.ColumnID, err = domain.NewColumnIDFromUUID(rawEntityID)
+		if err != nil {
+			return domain.BoardEvent{}, fmt.Errorf("scan board event: column id: %v: %w", err, errDataCorrupted)
+		}
+	case domain.BoardEventTask:
+		event.TaskID, err = domain.NewTaskIDFromUUID(rawEntityID)
+		if err != nil {
+			return domain.BoardEvent{}, fmt.Errorf("scan board event: task id: %v: %w", err, errDataCorrupted)
+		}
+		if !rawColumnID.Valid {
+			return domain.BoardEvent{}, fmt.Errorf("scan board event: task without column: %w", errDataCorrupted)
+		}
+		event.ColumnID, err = domain.NewColumnIDFromUUID(rawColumnID.UUID)
+		if err != nil {
+			return domain.BoardEvent{}, fmt.Errorf("scan board event: column id: %v: %w", err, errDataCorrupted)
+		}
+	default:
+		return domain.BoardEvent{}, fmt.Errorf("scan board event: entity %q: %w", rawEntity, errDataCorrupted)
+	}
+
+	return event, nil
+}
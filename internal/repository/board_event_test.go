@@ -0,0 +1,256 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestBoardEventRepository_ListAfter(t *testing.T) {
+	pool, r := boardEventRepoPrelude(t)
+	ctx := context.Background()
+
+	testutil.TruncateAllTables(t, pool)
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	task := testutil.ValidTask(column.ID)
+	CreateTask(t, pool, &task)
+
+	head, err := r.Head(ctx)
+	if err != nil {
+		t.Fatalf("Head() error = %v", err)
+	}
+
+	execQuery(t, pool, `UPDATE tasks SET description = 'Edited', version = version + 1 WHERE id = $1`, task.ID)
+	execQuery(t, pool, `UPDATE tasks SET position = position + 1, version = version + 1 WHERE id = $1`, task.ID)
+	// Writes that leave the version alone are not events.
+	execQuery(t, pool, `UPDATE tasks SET position = position WHERE id = $1`, task.ID)
+	execQuery(t, pool, `UPDATE columns SET name = 'Renamed', version = version + 1 WHERE id = $1`, column.ID)
+	// The task goes with its column and only the column is reported.
+	execQuery(t, pool, `DELETE FROM columns WHERE id = $1`, column.ID)
+
+	type gotEvent struct {
+		Entity domain.BoardEventEntity
+		Action domain.BoardEventAction
+	}
+	summarize := func(events []domain.BoardEvent) []gotEvent {
+		var got []gotEvent
+		for _, event := range events {
+			if event.BoardID != board.ID {
+				t.Errorf("got event of board %v, want %v", event.BoardID, board.ID)
+			}
+			got = append(got, gotEvent{Entity: event.Entity, Action: event.Action})
+		}
+		return got
+	}
+
+	all, err := r.ListAfter(ctx, board.ID, domain.BoardEventCursor{}, 100)
+	if err != nil {
+		t.Fatalf("ListAfter() error = %v", err)
+	}
+	want := []gotEvent{
+		{Entity: domain.BoardEventBoard, Action: domain.BoardEventCreated},
+		{Entity: domain.BoardEventColumn, Action: domain.BoardEventCreated},
+		{Entity: domain.BoardEventTask, Action: domain.BoardEventCreated},
+		{Entity: domain.BoardEventTask, Action: domain.BoardEventUpdated},
+		{Entity: domain.BoardEventTask, Action: domain.BoardEventMoved},
+		{Entity: domain.BoardEventColumn, Action: domain.BoardEventUpdated},
+		{Entity: domain.BoardEventColumn, Action: domain.BoardEventDeleted},
+	}
+	if diff := cmp.Diff(want, summarize(all)); diff != "" {
+		t.Fatalf("ListAfter() from start mismatch (-want +got):\n%s", diff)
+	}
+	if all[2].TaskID != task.ID || all[2].ColumnID != column.ID {
+		t.Errorf("got task event ids (%v, %v), want (%v, %v)", all[2].TaskID, all[2].ColumnID, task.ID, column.ID)
+	}
+
+	afterHead, err := r.ListAfter(ctx, board.ID, head, 100)
+	if err != nil {
+		t.Fatalf("ListAfter() error = %v", err)
+	}
+	if diff := cmp.Diff(want[3:], summarize(afterHead)); diff != "" {
+		t.Errorf("ListAfter() from head mismatch (-want +got):\n%s", diff)
+	}
+
+	page, err := r.ListAfter(ctx, board.ID, all[1].Cursor, 2)
+	if err != nil {
+		t.Fatalf("ListAfter() error = %v", err)
+	}
+	if diff := cmp.Diff(want[2:4], summarize(page)); diff != "" {
+		t.Errorf("ListAfter() page mismatch (-want +got):\n%s", diff)
+	}
+
+	execQuery(t, pool, `DELETE FROM boards WHERE id = $1`, board.ID)
+	last, err := r.ListAfter(ctx, board.ID, all[len(all)-1].Cursor, 100)
+	if err != nil {
+		t.Fatalf("ListAfter() error = %v", err)
+	}
+	if diff := cmp.Diff([]gotEvent{{Entity: domain.BoardEventBoard, Action: domain.BoardEventDeleted}}, summarize(last)); diff != "" {
+		t.Errorf("ListAfter() after board deletion mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBoardEventRepository_MoveRecordsShiftedNeighbours(t *testing.T) {
+	pool, r := boardEventRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+	ctx := context.Background()
+
+	testutil.TruncateAllTables(t, pool)
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	first, second := insertTwoTasks(t, pool, column.ID)
+	third := testutil.NewValidTask(t, column.ID, "Third", "", 3)
+	third.ID = domain.NewTaskID()
+	CreateTask(t, pool, &third)
+
+	head, err := r.Head(ctx)
+	if err != nil {
+		t.Fatalf("Head() error = %v", err)
+	}
+
+	_, err = taskRepo.Move(ctx, board.ID, column.ID, first.ID, column.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 3), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+
+	events, err := r.ListAfter(ctx, board.ID, head, 100)
+	if err != nil {
+		t.Fatalf("ListAfter() error = %v", err)
+	}
+	type gotEvent struct {
+		Action  domain.BoardEventAction
+		Version int64
+	}
+	got := map[domain.TaskID]gotEvent{}
+	for _, event := range events {
+		if event.Entity != domain.BoardEventTask {
+			t.Fatalf("got %s event, want only task events", event.Entity)
+		}
+		got[event.TaskID] = gotEvent{Action: event.Action, Version: event.Version.Int64()}
+	}
+	want := map[domain.TaskID]gotEvent{
+		first.ID:  {Action: domain.BoardEventMoved, Version: 2},
+		second.ID: {Action: domain.BoardEventMoved, Version: 2},
+		third.ID:  {Action: domain.BoardEventMoved, Version: 2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ListAfter() after move mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestBoardEventRepository_HoldsBackRunningTransactions(t *testing.T) {
+	pool, r := boardEventRepoPrelude(t)
+	ctx := context.Background()
+
+	testutil.TruncateAllTables(t, pool)
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+
+	// The older transaction writes an event with a lower tx id but commits last.
+	older, err := pool.Begin(ctx)
+	if err != nil {
+		t.Fatalf("Begin() error = %v", err)
+	}
+	defer func() { _ = older.Rollback(ctx) }()
+	_, err = older.Exec(ctx, `SELECT pg_current_xact_id()`)
+	if err != nil {
+		t.Fatalf("pg_current_xact_id() error = %v", err)
+	}
+
+	execQuery(t, pool, `UPDATE columns SET name = 'Renamed', version = version + 1 WHERE id = $1`, column.ID)
+
+	head, err := r.Head(ctx)
+	if err != nil {
+		t.Fatalf("Head() error = %v", err)
+	}
+	held, err := r.ListAfter(ctx, board.ID, domain.BoardEventCursor{}, 100)
+	if err != nil {
+		t.Fatalf("ListAfter() error = %v", err)
+	}
+	if len(held) != 2 {
+		t.Fatalf("got %d events while a transaction runs, want the 2 written before it", len(held))
+	}
+
+	_, err = older.Exec(ctx, `UPDATE boards SET name = 'Renamed', version = version + 1 WHERE id = $1`, board.ID)
+	if err != nil {
+		t.Fatalf("UPDATE boards error = %v", err)
+	}
+	err = older.Commit(ctx)
+	if err != nil {
+		t.Fatalf("Commit() error = %v", err)
+	}
+
+	released, err := r.ListAfter(ctx, board.ID, head, 100)
+	if err != nil {
+		t.Fatalf("ListAfter() error = %v", err)
+	}
+	if len(released) != 2 || released[0].Entity != domain.BoardEventBoard || released[1].Entity != domain.BoardEventColumn {
+		t.Errorf("got %v after commit, want the board update and then the column update", released)
+	}
+}
+
+func TestBoardEventRepository_Listen(t *testing.T) {
+	pool, r := boardEventRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+	CreateFixedUser(t, pool)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	notified := make(chan domain.BoardID, 10)
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- r.Listen(ctx, func(boardID domain.BoardID) { notified <- boardID })
+	}()
+
+	board := testutil.ValidBoard()
+	deadline := time.After(5 * time.Second)
+	// LISTEN may not have run yet when the board is written, so it is written again until heard.
+	for heard := false; !heard; {
+		execQuery(t, pool, `DELETE FROM boards WHERE id = $1`, board.ID)
+		CreateBoard(t, pool, &board)
+		select {
+		case got := <-notified:
+			if got != board.ID {
+				t.Fatalf("got notification for %v, want %v", got, board.ID)
+			}
+			heard = true
+		case <-time.After(100 * time.Millisecond):
+		case <-deadline:
+			t.Fatal("got no notification")
+		}
+	}
+
+	cancel()
+	select {
+	case err := <-stopped:
+		if err != nil {
+			t.Errorf("Listen() error = %v, want nil after cancel", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Listen() did not stop after cancel")
+	}
+}
+
+func execQuery(t *testing.T, pool *pgxpool.Pool, query string, args ...any) {
+	t.Helper()
+
+	_, err := pool.Exec(context.Background(), query, args...)
+	if err != nil {
+		t.Fatalf("Exec(%q) error = %v", query, err)
+	}
+}
+
+func boardEventRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGBoardEvent) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGBoardEvent(pool)
+}
@@ -0,0 +1,514 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const boardImportColumns = `id, owner_id, source, name, mapping, content, status, board_id, errors, created_at, started_at, finished_at`
+
+type PGBoardImport struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGBoardImport(pgPool *pgxpool.Pool) *PGBoardImport {
+	return &PGBoardImport{pgPool: pgPool}
+}
+
+// Create stores a pending import.
+func (r *PGBoardImport) Create(ctx context.Context, boardImport domain.BoardImport) (domain.BoardImport, error) {
+	const query = `
+		INSERT INTO board_imports (owner_id, source, name, mapping, content)
+		VALUES (@owner_id, @source, @name, @mapping, @content)
+		RETURNING ` + boardImportColumns
+
+	mapping, err := json.Marshal(boardImportMappingJSON(boardImport.Mapping))
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("board import repo: create: marshal mapping: %v: %w", err, ErrInternal)
+	}
+
+	created, err := ScanBoardImport(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"owner_id": boardImport.OwnerID,
+		"source":   boardImport.Source.String(),
+		"name":     boardImport.Name,
+		"mapping":  string(mapping),
+		"content":  boardImport.Content,
+	}))
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("board import repo: create: %v: %w", err, ErrInternal)
+	}
+
+	return created, nil
+}
+
+func (r *PGBoardImport) Get(ctx context.Context, importID domain.BoardImportID) (domain.BoardImport, error) {
+	const query = `SELECT ` + boardImportColumns + ` FROM board_imports WHERE id = $1`
+
+	boardImport, err := ScanBoardImport(r.pgPool.QueryRow(ctx, query, importID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.BoardImport{}, ErrRowNotFound
+		}
+		return domain.BoardImport{}, fmt.Errorf("board import repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return boardImport, nil
+}
+
+// Claim marks up to limit pending imports as running and returns them oldest first. Imports that
+// have been running since before staleBefore are claimed again, as the replica running them is
+// gone. Imports locked by another replica are skipped.
+func (r *PGBoardImport) Claim(ctx context.Context, now, staleBefore time.Time, limit int) ([]domain.BoardImport, error) {
+	const query = `
+	WITH claimable AS (
+		SELECT id
+		FROM board_imports
+		WHERE status = 'pending'
+		   OR (status = 'running' AND started_at < @stale_before)
+		ORDER BY created_at ASC, id ASC
+		LIMIT @limit
+		FOR UPDATE SKIP LOCKED
+	),
+	claimed AS (
+		UPDATE board_imports bi
+		SET status = 'running',
+		    started_at = @now
+		FROM claimable
+		WHERE bi.id = claimable.id
+		RETURNING bi.*
+	)
+	SELECT ` + boardImportColumns + `
+	FROM claimed
+	ORDER BY created_at ASC, id ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"now":          now,
+		"stale_before": staleBefore,
+		"limit":        limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("board import repo: claim: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var imports []domain.BoardImport
+	for rows.Next() {
+		boardImport, scanErr := ScanBoardImport(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("board import repo: claim: scan: %v: %w", scanErr, ErrInternal)
+		}
+		imports = append(imports, boardImport)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("board import repo: claim: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return imports, nil
+}
+
+// Complete writes the imported board and marks the import succeeded in one transaction. The
+// board and its parts are inserted with the ids they were given, so nothing has to be remapped
+// here. Every task starts its history in its column, as if it had been created there.
+// It returns ErrRowNotFound if the import is no longer running, which happens when it was
+// completed by another replica that claimed it as stale.
+func (r *PGBoardImport) Complete(ctx context.Context, importID domain.BoardImportID, imported *domain.ImportedBoard, now time.Time) error {
+	const (
+		// 1. Lock the import so it is completed only once.
+		lockImportQuery = `
+		SELECT 1
+		FROM board_imports
+		WHERE id = @import_id
+		  AND status = 'running'
+		FOR UPDATE`
+
+		// 2. Insert the board.
+		insertBoardQuery = `
+		INSERT INTO boards (id, owner_id, name, description)
+		VALUES (@id, @owner_id, @name, @description)`
+
+		// 3. Insert the columns. Allowed transitions point at columns of the same board, which
+		//    already have their ids.
+		insertColumnQuery = `
+		INSERT INTO columns (id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles)
+		VALUES (@id, @board_id, @name, @description, @position, @wip_limit, @sla_hours, @archive_after_days, @is_started, @is_done, @allowed_transitions, @entry_conditions, @entry_roles)`
+
+		// 4. Insert the lanes.
+		insertLaneQuery = `
+		INSERT INTO lanes (id, board_id, name, position)
+		VALUES (@id, @board_id, @name, @position)`
+
+		// 5. Insert the custom fields.
+		insertCustomFieldQuery = `
+		INSERT INTO custom_fields (id, board_id, name, type, options)
+		VALUES (@id, @board_id, @name, @type, @options)`
+
+		// 6. Insert the sprints. Completed sprints keep their completion time; their report is
+		//    written once the tasks are in.
+		insertSprintQuery = `
+		INSERT INTO sprints (id, board_id, name, goal, start_date, end_date, completed_at, committed_count, completed_count)
+		VALUES (@id, @board_id, @name, @goal, @start_date, @end_date, @completed_at,
+		        CASE WHEN @completed_at::timestamp IS NULL THEN NULL ELSE 0 END,
+		        CASE WHEN @completed_at::timestamp IS NULL THEN NULL ELSE 0 END)`
+
+		// 7. Insert a task without its parent, which may not be inserted yet, and record its
+		//    creation.
+		insertTaskQuery = `
+		WITH task AS (
+			INSERT INTO tasks (id, column_id, lane_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at)
+			VALUES (@id, @column_id, @lane_id, @sprint_id, @name, @description, @position, @checklist, @estimate, @custom_fields, @archived_at)
+			RETURNING id, column_id
+		),
+		transitions AS (
+			INSERT INTO task_transitions (task_id, board_id, to_column_id)
+			SELECT id, @board_id, column_id
+			FROM task
+			RETURNING board_id, from_column_id, transitioned_at
+		)` + countTransitionsQuery
+
+		// 8. Set the parents now that every task is in.
+		setParentsQuery = `
+		UPDATE tasks t
+		SET parent_id = p.parent_id
+		FROM unnest(@task_ids::uuid[], @parent_ids::uuid[]) AS p(task_id, parent_id)
+		WHERE t.id = p.task_id`
+
+		// 9. Insert the links.
+		insertLinkQuery = `
+		INSERT INTO task_links (id, source_task_id, target_task_id, type)
+		VALUES (@id, @source_task_id, @target_task_id, @type)`
+
+		// 10. Report completed sprints from the tasks they hold: every task was committed and the
+		//     ones in done columns were completed. Carry-overs are not imported.
+		reportSprintsQuery = `
+		UPDATE sprints s
+		SET committed_count = counts.committed,
+		    completed_count = counts.completed
+		FROM (
+			SELECT t.sprint_id, COUNT(*) AS committed, COUNT(*) FILTER (WHERE c.is_done) AS completed
+			FROM tasks t
+			JOIN columns c ON c.id = t.column_id
+			WHERE c.board_id = @board_id
+			  AND t.sprint_id IS NOT NULL
+			GROUP BY t.sprint_id
+		) counts
+		WHERE s.id = counts.sprint_id
+		  AND s.completed_at IS NOT NULL`
+
+		// 11. Mark the import succeeded and drop the file.
+		succeedQuery = `
+		UPDATE board_imports
+		SET status = 'succeeded',
+		    board_id = @board_id,
+		    content = '',
+		    errors = '[]',
+		    finished_at = @now
+		WHERE id = @import_id`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("board import repo: complete begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var locked int
+	err = tx.QueryRow(ctx, lockImportQuery, pgx.NamedArgs{
+		"import_id": importID,
+	}).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRowNotFound
+		}
+		return fmt.Errorf("board import repo: complete lock import: %v: %w", err, ErrInternal)
+	}
+
+	board := &imported.Board
+	_, err = tx.Exec(ctx, insertBoardQuery, pgx.NamedArgs{
+		"id":          board.ID,
+		"owner_id":    board.OwnerID,
+		"name":        board.Name,
+		"description": board.Description,
+	})
+	if err != nil {
+		return fmt.Errorf("board import repo: complete insert board: %v: %w", err, ErrInternal)
+	}
+
+	for i := range imported.Columns {
+		column := &imported.Columns[i]
+		_, err = tx.Exec(ctx, insertColumnQuery, pgx.NamedArgs{
+			"id":                  column.ID,
+			"board_id":            board.ID,
+			"name":                column.Name.String(),
+			"description":         column.Description.String(),
+			"position":            column.Position,
+			"wip_limit":           column.WIPLimit,
+			"sla_hours":           column.SLA,
+			"archive_after_days":  column.ArchiveAfter,
+			"is_started":          column.IsStarted,
+			"is_done":             column.IsDone,
+			"allowed_transitions": column.Transitions,
+			"entry_conditions":    column.EntryConditions,
+			"entry_roles":         column.EntryRoles,
+		})
+		if err != nil {
+			return fmt.Errorf("board import repo: complete insert column: %v: %w", err, ErrInternal)
+		}
+	}
+
+	for i := range imported.Lanes {
+		lane := &imported.Lanes[i]
+		_, err = tx.Exec(ctx, insertLaneQuery, pgx.NamedArgs{
+			"id":       lane.ID,
+			"board_id": board.ID,
+			"name":     lane.Name.String(),
+			"position": lane.Position,
+		})
+		if err != nil {
+			return fmt.Errorf("board import repo: complete insert lane: %v: %w", err, ErrInternal)
+		}
+	}
+
+	for i := range imported.CustomFields {
+		field := &imported.CustomFields[i]
+		_, err = tx.Exec(ctx, insertCustomFieldQuery, pgx.NamedArgs{
+			"id":       field.ID,
+			"board_id": board.ID,
+			"name":     field.Name,
+			"type":     string(field.Type),
+			"options":  field.Options,
+		})
+		if err != nil {
+			return fmt.Errorf("board import repo: complete insert custom field: %v: %w", err, ErrInternal)
+		}
+	}
+
+	for i := range imported.Sprints {
+		sprint := &imported.Sprints[i]
+		_, err = tx.Exec(ctx, insertSprintQuery, pgx.NamedArgs{
+			"id":           sprint.ID,
+			"board_id":     board.ID,
+			"name":         sprint.Name.String(),
+			"goal":         sprint.Goal.String(),
+			"start_date":   sprint.StartDate,
+			"end_date":     sprint.EndDate,
+			"completed_at": nullDate(sprint.CompletedAt),
+		})
+		if err != nil {
+			return fmt.Errorf("board import repo: complete insert sprint: %v: %w", err, ErrInternal)
+		}
+	}
+
+	var taskIDs, parentIDs []uuid.UUID
+	for i := range imported.Tasks {
+		task := &imported.Tasks[i]
+		_, err = tx.Exec(ctx, insertTaskQuery, pgx.NamedArgs{
+			"id":            task.ID,
+			"board_id":      board.ID,
+			"column_id":     task.ColumnID,
+			"lane_id":       NullLaneID(task.LaneID),
+			"sprint_id":     NullSprintID(task.SprintID),
+			"name":          task.Name,
+			"description":   task.Description,
+			"position":      task.Position,
+			"checklist":     task.Checklist,
+			"estimate":      task.Estimate,
+			"custom_fields": task.CustomFields,
+			"archived_at":   nullDate(task.ArchivedAt),
+		})
+		if err != nil {
+			return fmt.Errorf("board import repo: complete insert task: %v: %w", err, ErrInternal)
+		}
+		if task.HasParent() {
+			taskIDs = append(taskIDs, task.ID.UUID())
+			parentIDs = append(parentIDs, task.ParentID.UUID())
+		}
+	}
+
+	if len(taskIDs) > 0 {
+		_, err = tx.Exec(ctx, setParentsQuery, pgx.NamedArgs{
+			"task_ids":   taskIDs,
+			"parent_ids": parentIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("board import repo: complete set parents: %v: %w", err, ErrInternal)
+		}
+	}
+
+	for i := range imported.Links {
+		link := &imported.Links[i]
+		_, err = tx.Exec(ctx, insertLinkQuery, pgx.NamedArgs{
+			"id":             link.ID,
+			"source_task_id": link.SourceID,
+			"target_task_id": link.TargetID,
+			"type":           link.Type.String(),
+		})
+		if err != nil {
+			return fmt.Errorf("board import repo: complete insert link: %v: %w", err, ErrInternal)
+		}
+	}
+
+	_, err = tx.Exec(ctx, reportSprintsQuery, pgx.NamedArgs{
+		"board_id": board.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("board import repo: complete report sprints: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, succeedQuery, pgx.NamedArgs{
+		"import_id": importID,
+		"board_id":  board.ID,
+		"now":       now,
+	})
+	if err != nil {
+		return fmt.Errorf("board import repo: complete succeed: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("board import repo: complete commit: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// Fail marks a running import failed with its error report and drops the file. It returns
+// ErrRowNotFound if the import is no longer running.
+func (r *PGBoardImport) Fail(ctx context.Context, importID domain.BoardImportID, importErrors []domain.BoardImportError, now time.Time) error {
+	const query = `
+		UPDATE board_imports
+		SET status = 'failed',
+		    content = '',
+		    errors = @errors,
+		    finished_at = @now
+		WHERE id = @import_id
+		  AND status = 'running'`
+
+	rawErrors := make([]boardImportErrorJSON, len(importErrors))
+	for i, importError := range importErrors {
+		rawErrors[i] = boardImportErrorJSON(importError)
+	}
+	errorsJSON, err := json.Marshal(rawErrors)
+	if err != nil {
+		return fmt.Errorf("board import repo: fail: marshal errors: %v: %w", err, ErrInternal)
+	}
+
+	cmd, err := r.pgPool.Exec(ctx, query, pgx.NamedArgs{
+		"import_id": importID,
+		"errors":    string(errorsJSON),
+		"now":       now,
+	})
+	if err != nil {
+		return fmt.Errorf("board import repo: fail: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+type boardImportMappingJSON struct {
+	Name        string `json:"name,omitempty"`
+	Column      string `json:"column,omitempty"`
+	Lane        string `json:"lane,omitempty"`
+	Description string `json:"description,omitempty"`
+	Checklist   string `json:"checklist,omitempty"`
+	Estimate    string `json:"estimate,omitempty"`
+}
+
+type boardImportErrorJSON struct {
+	Item   string   `json:"item"`
+	Field  string   `json:"field"`
+	Issues []string `json:"issues"`
+}
+
+func ScanBoardImport(row interface{ Scan(...any) error }) (domain.BoardImport, error) {
+	var (
+		rawID      uuid.UUID
+		rawOwnerID uuid.UUID
+		rawSource  string
+		rawName    string
+		rawMapping []byte
+		content    []byte
+		rawStatus  string
+		rawBoardID uuid.NullUUID
+		rawErrors  []byte
+		createdAt  time.Time
+		startedAt  *time.Time
+		finishedAt *time.Time
+	)
+	err := row.Scan(&rawID, &rawOwnerID, &rawSource, &rawName, &rawMapping, &content, &rawStatus, &rawBoardID, &rawErrors, &createdAt, &startedAt, &finishedAt)
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("scan board import: %w", err)
+	}
+	id, err := domain.NewBoardImportIDFromUUID(rawID)
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("scan board import: id: %v: %w", err, errDataCorrupted)
+	}
+	ownerID, err := domain.NewUserIDFromUUID(rawOwnerID)
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("scan board import: owner id: %v: %w", err, errDataCorrupted)
+	}
+	source, err := domain.NewBoardImportSource(rawSource)
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("scan board import: source: %v: %w", err, errDataCorrupted)
+	}
+	var name domain.BoardName
+	if rawName != "" {
+		name, err = domain.NewBoardName(rawName)
+		if err != nil {
+			return domain.BoardImport{}, fmt.Errorf("scan board import: name: %v: %w", err, errDataCorrupted)
+		}
+	}
+	var mapping boardImportMappingJSON
+	err = json.Unmarshal(rawMapping, &mapping)
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("scan board import: mapping: %v: %w", err, errDataCorrupted)
+	}
+	var boardID domain.BoardID
+	if rawBoardID.Valid {
+		boardID, err = domain.NewBoardIDFromUUID(rawBoardID.UUID)
+		if err != nil {
+			return domain.BoardImport{}, fmt.Errorf("scan board import: board id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	var importErrors []boardImportErrorJSON
+	err = json.Unmarshal(rawErrors, &importErrors)
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("scan board import: errors: %v: %w", err, errDataCorrupted)
+	}
+	boardImport := domain.BoardImport{
+		ID:        id,
+		OwnerID:   ownerID,
+		Source:    source,
+		Name:      name,
+		Mapping:   domain.BoardImportMapping(mapping),
+		Content:   content,
+		Status:    domain.BoardImportStatus(rawStatus),
+		BoardID:   boardID,
+		CreatedAt: createdAt,
+	}
+	for _, importError := range importErrors {
+		boardImport.Errors = append(boardImport.Errors, domain.BoardImportError(importError))
+	}
+	if startedAt != nil {
+		boardImport.StartedAt = *startedAt
+	}
+	if finishedAt != nil {
+		boardImport.FinishedAt = *finishedAt
+	}
+	return boardImport, nil
+}
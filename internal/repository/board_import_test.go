@@ -0,0 +1,158 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestBoardImportRepository_Lifecycle(t *testing.T) {
+	pool, r := boardImportRepoPrelude(t)
+	now := testutil.FixedNow()
+
+	t.Run("Complete writes the board", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+		CreateFixedUser(t, pool)
+
+		created, err := r.Create(context.Background(), testutil.ValidBoardImport(testutil.ValidUserID()))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if created.Status != domain.BoardImportPending {
+			t.Errorf("got status %v, want %v", created.Status, domain.BoardImportPending)
+		}
+
+		claimed, err := r.Claim(context.Background(), now, now.Add(-time.Minute), 10)
+		if err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+		if len(claimed) != 1 || claimed[0].ID != created.ID || claimed[0].Status != domain.BoardImportRunning {
+			t.Fatalf("got claimed %v, want only running %v", claimed, created.ID)
+		}
+		again, err := r.Claim(context.Background(), now, now.Add(-time.Minute), 10)
+		if err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+		if len(again) != 0 {
+			t.Errorf("got %d imports claimed again, want 0", len(again))
+		}
+
+		imported := newImportedBoard(t, now)
+		err = r.Complete(context.Background(), created.ID, &imported, now)
+		if err != nil {
+			t.Fatalf("Complete() error = %v", err)
+		}
+
+		got, err := r.Get(context.Background(), created.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if got.Status != domain.BoardImportSucceeded || got.BoardID != imported.Board.ID || len(got.Content) != 0 {
+			t.Errorf("got import %v, want succeeded with board %v and no content", got, imported.Board.ID)
+		}
+		tasks := ListTasksByColumnID(t, pool, imported.Columns[0].ID)
+		if len(tasks) != 1 || tasks[0].ID != imported.Tasks[0].ID {
+			t.Errorf("got tasks %v, want only %v", tasks, imported.Tasks[0].ID)
+		}
+
+		err = r.Complete(context.Background(), created.ID, &imported, now)
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Fail records the errors", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+		CreateFixedUser(t, pool)
+
+		created, err := r.Create(context.Background(), testutil.ValidBoardImport(testutil.ValidUserID()))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		err = r.Fail(context.Background(), created.ID, nil, now)
+		assertErrRowNotFound(t, err)
+
+		_, err = r.Claim(context.Background(), now, now.Add(-time.Minute), 10)
+		if err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+		importErrors := []domain.BoardImportError{{Item: "card c1", Field: "name", Issues: []string{domain.ErrTaskNameTooShort}}}
+		err = r.Fail(context.Background(), created.ID, importErrors, now)
+		if err != nil {
+			t.Fatalf("Fail() error = %v", err)
+		}
+
+		got, err := r.Get(context.Background(), created.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if got.Status != domain.BoardImportFailed || len(got.Errors) != 1 || got.Errors[0].Item != "card c1" {
+			t.Errorf("got import %v, want failed with the card error", got)
+		}
+		if boards := ListBoards(t, pool); len(boards) != 0 {
+			t.Errorf("got %d boards, want 0", len(boards))
+		}
+	})
+
+	t.Run("Claim takes over stale imports", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+		CreateFixedUser(t, pool)
+
+		created, err := r.Create(context.Background(), testutil.ValidBoardImport(testutil.ValidUserID()))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		_, err = r.Claim(context.Background(), now, now.Add(-time.Minute), 10)
+		if err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+
+		later := now.Add(time.Hour)
+		claimed, err := r.Claim(context.Background(), later, later.Add(-time.Minute), 10)
+		if err != nil {
+			t.Fatalf("Claim() error = %v", err)
+		}
+		if len(claimed) != 1 || claimed[0].ID != created.ID {
+			t.Errorf("got claimed %v, want the stale %v", claimed, created.ID)
+		}
+	})
+
+	t.Run("Get not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, err := r.Get(context.Background(), domain.NewBoardImportID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+// newImportedBoard builds a board with a column and a task on it, owned by the fixed user.
+func newImportedBoard(t *testing.T, now time.Time) domain.ImportedBoard {
+	t.Helper()
+
+	board := testutil.ValidBoard()
+	board.ID = domain.NewBoardID()
+	board.CreatedAt, board.UpdatedAt = now, now
+	column := testutil.NewValidColumn(t, board.ID, "To do", 1)
+	task := testutil.NewValidTask(t, column.ID, "Write docs", "", 1)
+
+	return domain.ImportedBoard{
+		Board:   board,
+		Columns: []domain.Column{column},
+		Tasks:   []domain.Task{task},
+	}
+}
+
+func boardImportRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGBoardImport) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGBoardImport(pool)
+}
@@ -0,0 +1,264 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGBoardTemplate struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGBoardTemplate(pgPool *pgxpool.Pool) *PGBoardTemplate {
+	return &PGBoardTemplate{pgPool: pgPool}
+}
+
+// templateColumnJSON and templateTaskJSON mirror the board_templates.columns JSONB layout.
+type templateColumnJSON struct {
+	Name             string             `json:"name"`
+	Description      string             `json:"description"`
+	WIPLimit         int64              `json:"wip_limit"`
+	SLAHours         int64              `json:"sla_hours"`
+	ArchiveAfterDays int64              `json:"archive_after_days"`
+	IsStarted        bool               `json:"is_started"`
+	IsDone           bool               `json:"is_done"`
+	Tasks            []templateTaskJSON `json:"tasks"`
+}
+
+type templateTaskJSON struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+func (r *PGBoardTemplate) CreateFromBoard(
+	ctx context.Context,
+	ownerID domain.UserID,
+	boardID domain.BoardID,
+	name domain.BoardName,
+	description domain.BoardDescription,
+	includeTasks bool,
+) (domain.BoardTemplate, error) {
+	// A single statement reads the board from one snapshot, so the template never mixes
+	// columns and tasks from before and after a concurrent move.
+	const query = `
+		INSERT INTO board_templates (owner_id, name, description, columns)
+		SELECT @owner_id, @name, @description, COALESCE((
+			SELECT jsonb_agg(jsonb_build_object(
+				'name', c.name,
+				'description', c.description,
+				'wip_limit', c.wip_limit,
+				'sla_hours', c.sla_hours,
+				'archive_after_days', c.archive_after_days,
+				'is_started', c.is_started,
+				'is_done', c.is_done,
+				'tasks', CASE WHEN @include_tasks THEN COALESCE((
+					SELECT jsonb_agg(jsonb_build_object(
+						'name', t.name,
+						'description', t.description
+					) ORDER BY l.position NULLS FIRST, t.position)
+					FROM tasks t
+					LEFT JOIN lanes l ON l.id = t.lane_id
+					WHERE t.column_id = c.id
+					  AND t.archived_at IS NULL
+				), '[]'::jsonb) ELSE '[]'::jsonb END
+			) ORDER BY c.position)
+			FROM columns c
+			WHERE c.board_id = b.id
+		), '[]'::jsonb)
+		FROM boards b
+		WHERE b.id = @board_id
+		RETURNING id, owner_id, name, description, columns, created_at, updated_at`
+
+	template, err := ScanBoardTemplate(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"owner_id":      ownerID,
+		"board_id":      boardID,
+		"name":          name,
+		"description":   description,
+		"include_tasks": includeTasks,
+	}))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.BoardTemplate{}, ErrRowNotFound
+		}
+		return domain.BoardTemplate{}, fmt.Errorf("board template repo: create from board: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (r *PGBoardTemplate) Get(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+	const query = `
+		SELECT id, owner_id, name, description, columns, created_at, updated_at
+		FROM board_templates
+		WHERE id = $1`
+
+	template, err := ScanBoardTemplate(r.pgPool.QueryRow(ctx, query, templateID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.BoardTemplate{}, ErrRowNotFound
+		}
+		return domain.BoardTemplate{}, fmt.Errorf("board template repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (r *PGBoardTemplate) ListAvailable(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+	const query = `
+		SELECT id, owner_id, name, description, columns, created_at, updated_at
+		FROM board_templates
+		WHERE owner_id IS NULL OR owner_id = $1
+		ORDER BY owner_id IS NOT NULL, created_at ASC, id ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("board template repo: list available: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var templates []domain.BoardTemplate
+	for rows.Next() {
+		template, scanErr := ScanBoardTemplate(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("board template repo: list available: scan: %v: %w", scanErr, ErrInternal)
+		}
+
+		templates = append(templates, template)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("board template repo: list available: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return templates, nil
+}
+
+func (r *PGBoardTemplate) Delete(ctx context.Context, templateID domain.BoardTemplateID) error {
+	const query = `DELETE FROM board_templates WHERE id = $1`
+
+	cmd, err := r.pgPool.Exec(ctx, query, templateID)
+	if err != nil {
+		return fmt.Errorf("board template repo: delete: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+func ScanBoardTemplate(row interface{ Scan(...any) error }) (domain.BoardTemplate, error) {
+	var (
+		rawID      uuid.UUID
+		rawOwnerID uuid.NullUUID
+		rawName    string
+		rawDesc    string
+		rawColumns []byte
+		createdAt  time.Time
+		updatedAt  time.Time
+	)
+	err := row.Scan(&rawID, &rawOwnerID, &rawName, &rawDesc, &rawColumns, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.BoardTemplate{}, fmt.Errorf("scan board template: %w", err)
+	}
+	id, err := domain.NewBoardTemplateIDFromUUID(rawID)
+	if err != nil {
+		return domain.BoardTemplate{}, fmt.Errorf("scan board template: id: %v: %w", err, errDataCorrupted)
+	}
+	var ownerID domain.UserID
+	if rawOwnerID.Valid {
+		ownerID, err = domain.NewUserIDFromUUID(rawOwnerID.UUID)
+		if err != nil {
+			return domain.BoardTemplate{}, fmt.Errorf("scan board template: owner id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	name, err := domain.NewBoardName(rawName)
+	if err != nil {
+		return domain.BoardTemplate{}, fmt.Errorf("scan board template: name: %v: %w", err, errDataCorrupted)
+	}
+	desc, err := domain.NewBoardDescription(rawDesc)
+	if err != nil {
+		return domain.BoardTemplate{}, fmt.Errorf("scan board template: description: %v: %w", err, errDataCorrupted)
+	}
+	columns, err := decodeTemplateColumns(rawColumns)
+	if err != nil {
+		return domain.BoardTemplate{}, fmt.Errorf("scan board template: columns: %v: %w", err, errDataCorrupted)
+	}
+	return domain.BoardTemplate{
+		ID:          id,
+		OwnerID:     ownerID,
+		Name:        name,
+		Description: desc,
+		Columns:     columns,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
+
+func decodeTemplateColumns(raw []byte) ([]domain.BoardTemplateColumn, error) {
+	var rawColumns []templateColumnJSON
+	err := json.Unmarshal(raw, &rawColumns)
+	if err != nil {
+		return nil, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	columns := make([]domain.BoardTemplateColumn, len(rawColumns))
+	for i, rawColumn := range rawColumns {
+		name, err := domain.NewColumnName(rawColumn.Name)
+		if err != nil {
+			return nil, fmt.Errorf("column %d name: %w", i, err)
+		}
+		desc, err := domain.NewColumnDescription(rawColumn.Description)
+		if err != nil {
+			return nil, fmt.Errorf("column %d description: %w", i, err)
+		}
+		wipLimit, err := domain.NewColumnWIPLimit(rawColumn.WIPLimit)
+		if err != nil {
+			return nil, fmt.Errorf("column %d wip limit: %w", i, err)
+		}
+		sla, err := domain.NewColumnSLA(rawColumn.SLAHours)
+		if err != nil {
+			return nil, fmt.Errorf("column %d sla: %w", i, err)
+		}
+		archiveAfter, err := domain.NewColumnArchiveAfter(rawColumn.ArchiveAfterDays)
+		if err != nil {
+			return nil, fmt.Errorf("column %d archive after: %w", i, err)
+		}
+
+		tasks := make([]domain.BoardTemplateTask, len(rawColumn.Tasks))
+		for j, rawTask := range rawColumn.Tasks {
+			taskName, err := domain.NewTaskName(rawTask.Name)
+			if err != nil {
+				return nil, fmt.Errorf("column %d task %d name: %w", i, j, err)
+			}
+			taskDesc, err := domain.NewTaskDescription(rawTask.Description)
+			if err != nil {
+				return nil, fmt.Errorf("column %d task %d description: %w", i, j, err)
+			}
+			tasks[j] = domain.BoardTemplateTask{Name: taskName, Description: taskDesc}
+		}
+
+		columns[i] = domain.BoardTemplateColumn{
+			Name:         name,
+			Description:  desc,
+			WIPLimit:     wipLimit,
+			SLA:          sla,
+			ArchiveAfter: archiveAfter,
+			IsStarted:    rawColumn.IsStarted,
+			IsDone:       rawColumn.IsDone,
+			Tasks:        tasks,
+		}
+	}
+
+	return columns, nil
+}
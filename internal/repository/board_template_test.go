@@ -0,0 +1,232 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestBoardTemplateRepository_CreateFromBoard(t *testing.T) {
+	pool, r := boardTemplateRepoPrelude(t)
+
+	templateName := testutil.ValidBoardName()
+	templateDescription := testutil.ValidBoardDescription()
+
+	t.Run("Success with tasks", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, first := insertFixedUserBoardAndColumn(t, pool)
+		second := testutil.NewValidColumn(t, board.ID, "Done", 2)
+		second.WIPLimit, _ = domain.NewColumnWIPLimit(4)
+		CreateColumn(t, pool, &second)
+
+		firstTask := testutil.NewValidTask(t, first.ID, "First", "", 1)
+		CreateTask(t, pool, &firstTask)
+		secondTask := testutil.NewValidTask(t, first.ID, "Second", "Body", 2)
+		CreateTask(t, pool, &secondTask)
+
+		template, err := r.CreateFromBoard(context.Background(), board.OwnerID, board.ID, templateName, templateDescription, true)
+		if err != nil {
+			t.Fatalf("CreateFromBoard() error = %v", err)
+		}
+
+		if template.ID.IsNil() {
+			t.Errorf("got empty template ID, want generated ID")
+		}
+		if template.OwnerID != board.OwnerID {
+			t.Errorf("got ownerID %q, want %q", template.OwnerID, board.OwnerID)
+		}
+		if template.IsBuiltIn() {
+			t.Errorf("got built-in template, want user-owned")
+		}
+		wantColumns := []domain.BoardTemplateColumn{
+			{
+				Name:        first.Name,
+				Description: first.Description,
+				WIPLimit:    first.WIPLimit,
+				Tasks: []domain.BoardTemplateTask{
+					{Name: firstTask.Name, Description: firstTask.Description},
+					{Name: secondTask.Name, Description: secondTask.Description},
+				},
+			},
+			{
+				Name:        second.Name,
+				Description: second.Description,
+				WIPLimit:    second.WIPLimit,
+				Tasks:       []domain.BoardTemplateTask{},
+			},
+		}
+		if diff := cmp.Diff(wantColumns, template.Columns, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got columns mismatch (-want +got):\n%s", diff)
+		}
+
+		stored, err := r.Get(context.Background(), template.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if diff := cmp.Diff(template, stored, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("stored template mismatch (-returned +stored):\n%s", diff)
+		}
+	})
+
+	t.Run("Success without tasks", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		task := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &task)
+
+		template, err := r.CreateFromBoard(context.Background(), board.OwnerID, board.ID, templateName, templateDescription, false)
+		if err != nil {
+			t.Fatalf("CreateFromBoard() error = %v", err)
+		}
+
+		if len(template.Columns) != 1 {
+			t.Fatalf("got %d columns, want 1", len(template.Columns))
+		}
+		if len(template.Columns[0].Tasks) != 0 {
+			t.Errorf("got %d tasks, want 0", len(template.Columns[0].Tasks))
+		}
+	})
+
+	t.Run("Success empty board", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		template, err := r.CreateFromBoard(context.Background(), board.OwnerID, board.ID, templateName, templateDescription, true)
+		if err != nil {
+			t.Fatalf("CreateFromBoard() error = %v", err)
+		}
+		if len(template.Columns) != 0 {
+			t.Errorf("got %d columns, want 0", len(template.Columns))
+		}
+	})
+
+	t.Run("Not found when board missing", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		CreateFixedUser(t, pool)
+
+		_, err := r.CreateFromBoard(context.Background(), testutil.ValidUserID(), domain.NewBoardID(), templateName, templateDescription, true)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestBoardTemplateRepository_Get(t *testing.T) {
+	pool, r := boardTemplateRepoPrelude(t)
+
+	t.Run("Success built-in", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		id := CreateBuiltInBoardTemplate(t, pool, "Kanban", `[{"name": "To Do", "description": "", "wip_limit": 2, "tasks": [{"name": "Start here", "description": ""}]}]`)
+
+		template, err := r.Get(context.Background(), id)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if !template.IsBuiltIn() {
+			t.Errorf("got owner %q, want built-in", template.OwnerID)
+		}
+		if len(template.Columns) != 1 || template.Columns[0].WIPLimit.Int64() != 2 {
+			t.Fatalf("got columns %+v, want one column with wip limit 2", template.Columns)
+		}
+		if len(template.Columns[0].Tasks) != 1 || template.Columns[0].Tasks[0].Name.String() != "Start here" {
+			t.Errorf("got tasks %+v, want one starter task", template.Columns[0].Tasks)
+		}
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, err := r.Get(context.Background(), domain.NewBoardTemplateID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestBoardTemplateRepository_ListAvailable(t *testing.T) {
+	pool, r := boardTemplateRepoPrelude(t)
+
+	t.Run("Built-in first then own, foreign excluded", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		otherUserID := domain.NewUserID()
+		otherEmail, _ := domain.NewEmail("other@example.com")
+		CreateUser(t, pool, otherUserID, otherEmail, testutil.ValidPasswordHash())
+		otherBoard := testutil.ValidBoard()
+		otherBoard.OwnerID = otherUserID
+		CreateBoard(t, pool, &otherBoard)
+
+		own, err := r.CreateFromBoard(context.Background(), board.OwnerID, board.ID, testutil.ValidBoardName(), testutil.ValidBoardDescription(), false)
+		if err != nil {
+			t.Fatalf("CreateFromBoard() error = %v", err)
+		}
+		_, err = r.CreateFromBoard(context.Background(), otherUserID, otherBoard.ID, testutil.ValidBoardName(), testutil.ValidBoardDescription(), false)
+		if err != nil {
+			t.Fatalf("CreateFromBoard() error = %v", err)
+		}
+		builtInID := CreateBuiltInBoardTemplate(t, pool, "Kanban", `[]`)
+
+		templates, err := r.ListAvailable(context.Background(), board.OwnerID)
+		if err != nil {
+			t.Fatalf("ListAvailable() error = %v", err)
+		}
+
+		if len(templates) != 2 {
+			t.Fatalf("got %d templates, want 2", len(templates))
+		}
+		if templates[0].ID != builtInID {
+			t.Errorf("got first template %q, want built-in %q", templates[0].ID, builtInID)
+		}
+		if templates[1].ID != own.ID {
+			t.Errorf("got second template %q, want own %q", templates[1].ID, own.ID)
+		}
+	})
+}
+
+func TestBoardTemplateRepository_Delete(t *testing.T) {
+	pool, r := boardTemplateRepoPrelude(t)
+
+	t.Run("Success", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		template, err := r.CreateFromBoard(context.Background(), board.OwnerID, board.ID, testutil.ValidBoardName(), testutil.ValidBoardDescription(), false)
+		if err != nil {
+			t.Fatalf("CreateFromBoard() error = %v", err)
+		}
+
+		err = r.Delete(context.Background(), template.ID)
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		_, err = r.Get(context.Background(), template.ID)
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		err := r.Delete(context.Background(), domain.NewBoardTemplateID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func boardTemplateRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGBoardTemplate) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGBoardTemplate(pool)
+}
@@ -4,6 +4,7 @@ package repository_test
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
@@ -237,7 +238,7 @@ func TestBoardRepository_Update(t *testing.T) {
 		CreateFixedUser(t, pool)
 		CreateBoard(t, pool, &validBoard)
 
-		got, err := r.Update(context.Background(), validBoard.ID, &updatedName, &updatedDescription)
+		got, err := r.Update(context.Background(), validBoard.ID, &updatedName, &updatedDescription, domain.Version{})
 		if err != nil {
 			t.Errorf("Update() error = %v", err)
 		}
@@ -250,7 +251,7 @@ func TestBoardRepository_Update(t *testing.T) {
 		CreateFixedUser(t, pool)
 		CreateBoard(t, pool, &validBoard)
 
-		got, err := r.Update(context.Background(), validBoard.ID, &updatedNameOnly, nil)
+		got, err := r.Update(context.Background(), validBoard.ID, &updatedNameOnly, nil, domain.Version{})
 		if err != nil {
 			t.Errorf("Update() error = %v", err)
 		}
@@ -263,19 +264,49 @@ func TestBoardRepository_Update(t *testing.T) {
 		CreateFixedUser(t, pool)
 		CreateBoard(t, pool, &validBoard)
 
-		got, err := r.Update(context.Background(), validBoard.ID, nil, &updatedDescriptionOnly)
+		got, err := r.Update(context.Background(), validBoard.ID, nil, &updatedDescriptionOnly, domain.Version{})
 		if err != nil {
 			t.Errorf("Update() error = %v", err)
 		}
 		assertUpdatedBoard(t, got, updatedDescriptionOnlyBoard)
 	})
 
+	t.Run("Success with matching version", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		CreateFixedUser(t, pool)
+		CreateBoard(t, pool, &validBoard)
+
+		got, err := r.Update(context.Background(), validBoard.ID, &updatedName, &updatedDescription, validBoard.Version)
+		if err != nil {
+			t.Errorf("Update() error = %v", err)
+		}
+		assertUpdatedBoard(t, got, updatedValidBoard)
+	})
+
+	t.Run("Version conflict", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		CreateFixedUser(t, pool)
+		CreateBoard(t, pool, &validBoard)
+
+		_, err := r.Update(context.Background(), validBoard.ID, &updatedName, &updatedDescription, updatedValidBoard.Version)
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			t.Fatalf("Update() error = %v, want ErrVersionConflict", err)
+		}
+
+		storedBoards := ListBoards(t, pool)
+		if diff := cmp.Diff(validBoard, storedBoards[0], testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got stored board mismatch (-want +got):\n%s", diff)
+		}
+	})
+
 	t.Run("Not found when missing", func(t *testing.T) {
 		testutil.TruncateAllTables(t, pool)
 
 		CreateFixedUser(t, pool)
 
-		_, err := r.Update(context.Background(), domain.NewBoardID(), &updatedName, &updatedDescription)
+		_, err := r.Update(context.Background(), domain.NewBoardID(), &updatedName, &updatedDescription, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 }
@@ -288,7 +319,7 @@ func TestBoardRepository_Delete(t *testing.T) {
 
 		board := insertFixedUserAndBoard(t, pool)
 
-		err := r.Delete(context.Background(), board.ID)
+		err := r.Delete(context.Background(), board.ID, domain.Version{})
 		if err != nil {
 			t.Errorf("Delete() error = %v", err)
 		}
@@ -299,16 +330,180 @@ func TestBoardRepository_Delete(t *testing.T) {
 		}
 	})
 
+	t.Run("Version conflict", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		staleVersion := testutil.UpdateValidBoard(t, &board, "Renamed", "", testutil.FixedNow()).Version
+
+		err := r.Delete(context.Background(), board.ID, staleVersion)
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			t.Fatalf("Delete() error = %v, want ErrVersionConflict", err)
+		}
+
+		storedBoards := ListBoards(t, pool)
+		if len(storedBoards) != 1 {
+			t.Errorf("ListBoards() returned %d boards after conflict, want 1", len(storedBoards))
+		}
+	})
+
+	t.Run("Not found when missing", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		CreateFixedUser(t, pool)
+
+		err := r.Delete(context.Background(), domain.NewBoardID(), domain.Version{})
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestBoardRepository_Duplicate(t *testing.T) {
+	pool, r := boardRepoPrelude(t)
+
+	t.Run("Success copies columns and tasks", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		second := testutil.NewValidColumn(t, board.ID, "Done", 2)
+		CreateColumn(t, pool, &second)
+
+		task := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &task)
+
+		copied, err := r.Duplicate(context.Background(), board.ID)
+		if err != nil {
+			t.Fatalf("Duplicate() error = %v", err)
+		}
+
+		if copied.ID == board.ID {
+			t.Errorf("got copy id %q, want new id", copied.ID)
+		}
+		if copied.OwnerID != board.OwnerID {
+			t.Errorf("got ownerID %q, want %q", copied.OwnerID, board.OwnerID)
+		}
+		if copied.Name != board.Name {
+			t.Errorf("got name %q, want %q", copied.Name, board.Name)
+		}
+
+		storedBoards := ListBoards(t, pool)
+		if len(storedBoards) != 2 {
+			t.Fatalf("ListBoards() returned %d boards, want 2", len(storedBoards))
+		}
+
+		gotColumns := ListColumnsByBoardID(t, pool, copied.ID)
+		if len(gotColumns) != 2 {
+			t.Fatalf("got %d copied columns, want 2", len(gotColumns))
+		}
+		if gotColumns[0].Name != column.Name || gotColumns[0].Position != column.Position {
+			t.Errorf("got first column %q at %d, want %q at %d",
+				gotColumns[0].Name, gotColumns[0].Position.Int64(), column.Name, column.Position.Int64())
+		}
+		if gotColumns[1].Name != second.Name || gotColumns[1].Position != second.Position {
+			t.Errorf("got second column %q at %d, want %q at %d",
+				gotColumns[1].Name, gotColumns[1].Position.Int64(), second.Name, second.Position.Int64())
+		}
+
+		gotTasks := ListTasksByColumnID(t, pool, gotColumns[0].ID)
+		if len(gotTasks) != 1 {
+			t.Fatalf("got %d copied tasks, want 1", len(gotTasks))
+		}
+		if gotTasks[0].ID == task.ID {
+			t.Errorf("got task id %q, want new id", gotTasks[0].ID)
+		}
+		if gotTasks[0].Name != task.Name {
+			t.Errorf("got task name %q, want %q", gotTasks[0].Name, task.Name)
+		}
+	})
+
+	t.Run("Success copies links between tasks", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		first, second := insertTwoTasks(t, pool, column.ID)
+		execQuery(t, pool, `INSERT INTO task_links (source_task_id, target_task_id, type) VALUES ($1, $2, 'blocks')`, first.ID, second.ID)
+
+		copied, err := r.Duplicate(context.Background(), board.ID)
+		if err != nil {
+			t.Fatalf("Duplicate() error = %v", err)
+		}
+
+		gotTasks := ListTasksByColumnID(t, pool, ListColumnsByBoardID(t, pool, copied.ID)[0].ID)
+		if len(gotTasks) != 2 {
+			t.Fatalf("got %d copied tasks, want 2", len(gotTasks))
+		}
+		var sourceID, targetID domain.TaskID
+		err = pool.QueryRow(
+			context.Background(), `
+			SELECT source_task_id, target_task_id
+			FROM task_links
+			WHERE type = 'blocks' AND source_task_id = ANY($1)`,
+			[]domain.TaskID{gotTasks[0].ID, gotTasks[1].ID},
+		).Scan(&sourceID, &targetID)
+		if err != nil {
+			t.Fatalf("query copied link error = %v", err)
+		}
+		if sourceID != gotTasks[0].ID || targetID != gotTasks[1].ID {
+			t.Errorf("got link %v -> %v, want %v -> %v", sourceID, targetID, gotTasks[0].ID, gotTasks[1].ID)
+		}
+	})
+
 	t.Run("Not found when missing", func(t *testing.T) {
 		testutil.TruncateAllTables(t, pool)
 
 		CreateFixedUser(t, pool)
 
-		err := r.Delete(context.Background(), domain.NewBoardID())
+		_, err := r.Duplicate(context.Background(), domain.NewBoardID())
 		assertErrRowNotFound(t, err)
 	})
 }
 
+func TestBoardRepository_CreateWithColumns(t *testing.T) {
+	pool, r := boardRepoPrelude(t)
+
+	t.Run("Success creates columns and tasks in order", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		CreateFixedUser(t, pool)
+		template := testutil.ValidBoardTemplate(testutil.ValidUserID())
+		doneName, _ := domain.NewColumnName("Done")
+		template.Columns = append(template.Columns, domain.BoardTemplateColumn{Name: doneName})
+
+		board, err := r.CreateWithColumns(context.Background(), testutil.ValidUserID(), testutil.ValidBoardName(), testutil.ValidBoardDescription(), template.Columns)
+		if err != nil {
+			t.Fatalf("CreateWithColumns() error = %v", err)
+		}
+
+		storedBoards := ListBoards(t, pool)
+		if len(storedBoards) != 1 {
+			t.Fatalf("ListBoards() returned %d boards, want exactly 1", len(storedBoards))
+		}
+		if diff := cmp.Diff(board, storedBoards[0], testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("stored board mismatch (-returned +stored):\n%s", diff)
+		}
+
+		gotColumns := ListColumnsByBoardID(t, pool, board.ID)
+		if len(gotColumns) != 2 {
+			t.Fatalf("got %d columns, want 2", len(gotColumns))
+		}
+		for i, want := range template.Columns {
+			got := gotColumns[i]
+			if got.Name != want.Name || got.Position.Int64() != int64(i+1) || got.WIPLimit != want.WIPLimit {
+				t.Errorf("got column %q at %d with wip %d, want %q at %d with wip %d",
+					got.Name, got.Position.Int64(), got.WIPLimit.Int64(), want.Name, i+1, want.WIPLimit.Int64())
+			}
+		}
+
+		gotTasks := ListTasksByColumnID(t, pool, gotColumns[0].ID)
+		if len(gotTasks) != 1 {
+			t.Fatalf("got %d tasks, want 1", len(gotTasks))
+		}
+		if gotTasks[0].Name != template.Columns[0].Tasks[0].Name {
+			t.Errorf("got task name %q, want %q", gotTasks[0].Name, template.Columns[0].Tasks[0].Name)
+		}
+	})
+}
+
 func boardRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGBoard) {
 	t.Helper()
 
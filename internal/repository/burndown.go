@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGBurndown struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGBurndown(pgPool *pgxpool.Pool) *PGBurndown {
+	return &PGBurndown{pgPool: pgPool}
+}
+
+// Snapshot records today's points of every scope and returns the number of recorded scopes.
+// A scope is each running sprint of a board that has sprints, or the whole board otherwise.
+// Today is taken from the DB clock in UTC, so replicas in different time zones agree on the
+// day. Running it again on the same day overwrites that day with the latest points.
+func (r *PGBurndown) Snapshot(ctx context.Context) (int64, error) {
+	const query = `
+		WITH today AS (
+			SELECT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')::date AS day
+		),
+		scopes AS (
+			SELECT s.board_id, s.id AS sprint_id
+			FROM sprints s, today
+			WHERE s.completed_at IS NULL
+			  AND today.day BETWEEN s.start_date AND s.end_date
+			UNION ALL
+			SELECT b.id, NULL::uuid
+			FROM boards b
+			WHERE NOT EXISTS (SELECT 1 FROM sprints s WHERE s.board_id = b.id)
+		)
+		INSERT INTO burndown_snapshots (board_id, sprint_id, day, remaining_points, completed_points)
+		SELECT
+			sc.board_id,
+			sc.sprint_id,
+			(SELECT day FROM today),
+			COALESCE(SUM(t.estimate) FILTER (WHERE NOT c.is_done), 0),
+			COALESCE(SUM(t.estimate) FILTER (WHERE c.is_done), 0)
+		FROM scopes sc
+		LEFT JOIN columns c ON c.board_id = sc.board_id
+		LEFT JOIN tasks t ON t.column_id = c.id
+			AND (sc.sprint_id IS NULL OR t.sprint_id = sc.sprint_id)
+		GROUP BY sc.board_id, sc.sprint_id
+		ON CONFLICT (board_id, sprint_id, day) DO UPDATE
+		SET
+			remaining_points = EXCLUDED.remaining_points,
+			completed_points = EXCLUDED.completed_points,
+			captured_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'`
+
+	tag, err := r.pgPool.Exec(ctx, query)
+	if err != nil {
+		return 0, fmt.Errorf("burndown repo: snapshot: %v: %w", err, ErrInternal)
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// List returns the snapshots of the board, or of one of its sprints when sprintID is set,
+// between from and to inclusive in day order. A zero from or to leaves that side unbounded.
+func (r *PGBurndown) List(
+	ctx context.Context,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+	from time.Time,
+	to time.Time,
+) ([]domain.BurndownPoint, error) {
+	const query = `
+		SELECT day, remaining_points::float8, completed_points::float8
+		FROM burndown_snapshots
+		WHERE board_id = @board_id
+		  AND sprint_id IS NOT DISTINCT FROM @sprint_id
+		  AND (@from::date IS NULL OR day >= @from::date)
+		  AND (@to::date IS NULL OR day <= @to::date)
+		ORDER BY day ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"board_id":  boardID,
+		"sprint_id": NullSprintID(sprintID),
+		"from":      nullDate(from),
+		"to":        nullDate(to),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("burndown repo: list: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var points []domain.BurndownPoint
+	for rows.Next() {
+		var point domain.BurndownPoint
+		err = rows.Scan(&point.Day, &point.Remaining, &point.Completed)
+		if err != nil {
+			return nil, fmt.Errorf("burndown repo: list: scan: %v: %w", err, ErrInternal)
+		}
+
+		points = append(points, point)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("burndown repo: list: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return points, nil
+}
+
+// nullDate maps the zero time to SQL NULL.
+func nullDate(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
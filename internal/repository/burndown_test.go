@@ -0,0 +1,129 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestBurndownRepository_Snapshot(t *testing.T) {
+	pool, r := burndownRepoPrelude(t)
+
+	t.Run("Whole board without sprints", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, todo := insertFixedUserBoardAndColumn(t, pool)
+		done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+		done.IsDone = true
+		CreateColumn(t, pool, &done)
+		insertEstimatedTask(t, pool, todo.ID, 1, 5)
+		insertEstimatedTask(t, pool, done.ID, 1, 3)
+		unestimated := testutil.NewValidTask(t, todo.ID, "Unestimated", "", 2)
+		unestimated.ID = domain.NewTaskID()
+		CreateTask(t, pool, &unestimated)
+
+		_, err := r.Snapshot(context.Background())
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+		insertEstimatedTask(t, pool, todo.ID, 3, 2)
+		_, err = r.Snapshot(context.Background())
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+
+		points, err := r.List(context.Background(), board.ID, domain.SprintID{}, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if len(points) != 1 {
+			t.Fatalf("got %d points, want one point per day", len(points))
+		}
+		if points[0].Remaining != 7 || points[0].Completed != 3 {
+			t.Errorf("got remaining %v and completed %v, want 7 and 3", points[0].Remaining, points[0].Completed)
+		}
+		if today := dbToday(t, pool); !points[0].Day.Equal(today) {
+			t.Errorf("got day %v, want DB day %v", points[0].Day, today)
+		}
+	})
+
+	t.Run("Running sprint only", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		today := dbToday(t, pool)
+		sprint := testutil.ValidSprint(board.ID)
+		sprint.StartDate = today.AddDate(0, 0, -3)
+		sprint.EndDate = today.AddDate(0, 0, 10)
+		sprint, err := repository.NewPGSprint(pool).Create(context.Background(), sprint)
+		if err != nil {
+			t.Fatalf("Create() sprint error = %v", err)
+		}
+		planned := insertEstimatedTask(t, pool, column.ID, 1, 8)
+		insertEstimatedTask(t, pool, column.ID, 2, 13)
+		err = repository.NewPGSprint(pool).AddTask(context.Background(), board.ID, sprint.ID, planned.ID)
+		if err != nil {
+			t.Fatalf("AddTask() error = %v", err)
+		}
+
+		_, err = r.Snapshot(context.Background())
+		if err != nil {
+			t.Fatalf("Snapshot() error = %v", err)
+		}
+
+		points, err := r.List(context.Background(), board.ID, sprint.ID, sprint.StartDate, sprint.EndDate)
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if len(points) != 1 || points[0].Remaining != 8 || points[0].Completed != 0 {
+			t.Errorf("got points %+v, want 8 remaining points of the sprint", points)
+		}
+		boardPoints, err := r.List(context.Background(), board.ID, domain.SprintID{}, time.Time{}, time.Time{})
+		if err != nil {
+			t.Fatalf("List() error = %v", err)
+		}
+		if len(boardPoints) != 0 {
+			t.Errorf("got board points %+v, want none for a board with sprints", boardPoints)
+		}
+	})
+}
+
+func insertEstimatedTask(t *testing.T, pool *pgxpool.Pool, columnID domain.ColumnID, position int64, points float64) domain.Task {
+	t.Helper()
+
+	task := testutil.NewValidTask(t, columnID, "Estimated", "", position)
+	task.ID = domain.NewTaskID()
+	task.Estimate = testutil.NewValidTaskEstimate(t, points)
+	CreateTask(t, pool, &task)
+
+	return task
+}
+
+func dbToday(t *testing.T, pool *pgxpool.Pool) time.Time {
+	t.Helper()
+
+	var today time.Time
+	err := pool.QueryRow(context.Background(), `SELECT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')::date`).Scan(&today)
+	if err != nil {
+		t.Fatalf("dbToday() error = %v", err)
+	}
+
+	return today
+}
+
+func burndownRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGBurndown) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGBurndown(pool)
+}
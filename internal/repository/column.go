@@ -40,7 +40,7 @@ func (r *PGColumn) Create(
 		insertColumnQuery = `
 		INSERT INTO columns (board_id, name, description, position)
 		VALUES (@board_id, @name, @description, @position)
-		RETURNING id, board_id, name, description, position, created_at, updated_at`
+		RETURNING id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles, created_at, updated_at, version`
 	)
 
 	tx, err := r.pgPool.Begin(ctx)
@@ -89,13 +89,17 @@ func (r *PGColumn) Create(
 }
 
 func (r *PGColumn) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+	return listColumnsByBoardID(ctx, r.pgPool, boardID)
+}
+
+func listColumnsByBoardID(ctx context.Context, q querier, boardID domain.BoardID) ([]domain.Column, error) {
 	const query = `
-		SELECT id, board_id, name, description, position, created_at, updated_at
+		SELECT id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles, created_at, updated_at, version
 		FROM columns
 		WHERE board_id = $1
 		ORDER BY position ASC`
 
-	rows, err := r.pgPool.Query(ctx, query, boardID)
+	rows, err := q.Query(ctx, query, boardID)
 	if err != nil {
 		return nil, fmt.Errorf("column repo: list by board id: %v: %w", err, ErrInternal)
 	}
@@ -120,7 +124,7 @@ func (r *PGColumn) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([
 
 func (r *PGColumn) Get(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 	const query = `
-		SELECT id, board_id, name, description, position, created_at, updated_at
+		SELECT id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles, created_at, updated_at, version
 		FROM columns
 		WHERE id = $1`
 
@@ -135,27 +139,50 @@ func (r *PGColumn) Get(ctx context.Context, columnID domain.ColumnID) (domain.Co
 	return column, nil
 }
 
+// Update changes the column if it is at the expected version, or at any version if expected
+// is zero, and bumps its version.
 func (r *PGColumn) Update(
 	ctx context.Context,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
-	name *domain.ColumnName,
-	description *domain.ColumnDescription,
+	patch domain.ColumnPatch,
+	expected domain.Version,
 ) (domain.Column, error) {
-	const query = `
+	const (
+		query = `
 		UPDATE columns
 		SET
 			name = COALESCE($1, name),
 			description = COALESCE($2, description),
-			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
-		WHERE board_id = $3
-		  AND id = $4
-		RETURNING id, board_id, name, description, position, created_at, updated_at`
+			wip_limit = COALESCE($3, wip_limit),
+			sla_hours = COALESCE($4, sla_hours),
+			archive_after_days = COALESCE($5, archive_after_days),
+			is_started = COALESCE($6, is_started),
+			is_done = COALESCE($7, is_done),
+			allowed_transitions = COALESCE($8::uuid[], allowed_transitions),
+			entry_conditions = COALESCE($9::text[], entry_conditions),
+			entry_roles = COALESCE($10::text[], entry_roles),
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+			version = version + 1
+		WHERE board_id = $11
+		  AND id = $12
+		  AND ($13 = 0 OR version = $13)
+		RETURNING id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles, created_at, updated_at, version`
+
+		existsQuery = `SELECT 1 FROM columns WHERE board_id = $1 AND id = $2`
+	)
 
-	column, err := ScanColumn(r.pgPool.QueryRow(ctx, query, name, description, boardID, columnID))
+	column, err := ScanColumn(r.pgPool.QueryRow(
+		ctx, query,
+		patch.Name, patch.Description, patch.WIPLimit, patch.SLA, patch.ArchiveAfter, patch.IsStarted, patch.IsDone,
+		patch.Transitions, patch.EntryConditions, patch.EntryRoles, boardID, columnID, expected,
+	))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.Column{}, ErrRowNotFound
+			err = versionMismatch(ctx, r.pgPool, expected, existsQuery, boardID, columnID)
+			if errors.Is(err, ErrRowNotFound) || errors.Is(err, ErrVersionConflict) {
+				return domain.Column{}, err
+			}
 		}
 		return domain.Column{}, fmt.Errorf("column repo: update: %v: %w", err, ErrInternal)
 	}
@@ -163,12 +190,16 @@ func (r *PGColumn) Update(
 	return column, nil
 }
 
+// Move moves the column if it is at the expected version, or at any version if expected is
+// zero. Moving the column bumps its version, and so does shifting a column to make room, so
+// every renumbered column is a change of its own.
 func (r *PGColumn) Move(
 	ctx context.Context,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
 	targetPosition domain.ColumnPosition,
-) (domain.ColumnPosition, error) {
+	expected domain.Version,
+) (domain.ColumnPlacement, error) {
 	const (
 		// SET position order is not guaranteed, so we disable uniqueness constraint for this transaction.
 
@@ -183,9 +214,9 @@ func (r *PGColumn) Move(
 		deferPositionConstraintQuery = `
 		SET CONSTRAINTS columns_board_id_position_key DEFERRED`
 
-		// 3. Read the current position of the column we are moving.
+		// 3. Read the current position and version of the column we are moving.
 		getCurrentPositionQuery = `
-		SELECT position
+		SELECT position, version
 		FROM columns
 		WHERE board_id = @board_id
 		  AND id = @column_id`
@@ -200,7 +231,8 @@ func (r *PGColumn) Move(
 		//    Example: moving 2 -> 5 means 3,4,5 become 2,3,4.
 		moveNeighborsDownQuery = `
 		UPDATE columns
-		SET position = position - 1
+		SET position = position - 1,
+		    version = version + 1
 		WHERE board_id = @board_id
 		  AND position > @current_position
 		  AND position <= @target_position`
@@ -209,7 +241,8 @@ func (r *PGColumn) Move(
 		//    Example: moving 5 -> 2 means 2,3,4 become 3,4,5.
 		moveNeighborsUpQuery = `
 		UPDATE columns
-		SET position = position + 1
+		SET position = position + 1,
+		    version = version + 1
 		WHERE board_id = @board_id
 		  AND position >= @target_position
 		  AND position < @current_position`
@@ -217,14 +250,17 @@ func (r *PGColumn) Move(
 		// 6. Put the moved column into targetPosition after neighbors have been shifted.
 		moveColumnIntoTargetQuery = `
 		UPDATE columns
-		SET position = @target_position
+		SET
+			position = @target_position,
+			version = version + 1
 		WHERE board_id = @board_id
-		  AND id = @column_id`
+		  AND id = @column_id
+		RETURNING version`
 	)
 
 	tx, err := r.pgPool.Begin(ctx)
 	if err != nil {
-		return domain.ColumnPosition{}, fmt.Errorf("column repo: move begin tx: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move begin tx: %v: %w", err, ErrInternal)
 	}
 	defer func() {
 		_ = tx.Rollback(ctx)
@@ -236,26 +272,29 @@ func (r *PGColumn) Move(
 	}).Scan(&locked)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.ColumnPosition{}, ErrRowNotFound
+			return domain.ColumnPlacement{}, ErrRowNotFound
 		}
-		return domain.ColumnPosition{}, fmt.Errorf("column repo: move lock board: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move lock board: %v: %w", err, ErrInternal)
 	}
 
 	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
 	if err != nil {
-		return domain.ColumnPosition{}, fmt.Errorf("column repo: move defer position constraint: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move defer position constraint: %v: %w", err, ErrInternal)
 	}
 
-	var currentPosition int64
+	var currentPosition, currentVersion int64
 	err = tx.QueryRow(ctx, getCurrentPositionQuery, pgx.NamedArgs{
 		"board_id":  boardID.UUID(),
 		"column_id": columnID.UUID(),
-	}).Scan(&currentPosition)
+	}).Scan(&currentPosition, &currentVersion)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.ColumnPosition{}, ErrRowNotFound
+			return domain.ColumnPlacement{}, ErrRowNotFound
 		}
-		return domain.ColumnPosition{}, fmt.Errorf("column repo: move get current position: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move get current position: %v: %w", err, ErrInternal)
+	}
+	if expected.IsSet() && expected.Int64() != currentVersion {
+		return domain.ColumnPlacement{}, ErrVersionConflict
 	}
 
 	var columnsCount int64
@@ -263,15 +302,19 @@ func (r *PGColumn) Move(
 		"board_id": boardID.UUID(),
 	}).Scan(&columnsCount)
 	if err != nil {
-		return domain.ColumnPosition{}, fmt.Errorf("column repo: move count columns: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move count columns: %v: %w", err, ErrInternal)
 	}
 
 	targetPositionInt := targetPosition.Int64()
 	if targetPositionInt > columnsCount {
-		return domain.ColumnPosition{}, ErrIndexOutOfBounds
+		return domain.ColumnPlacement{}, ErrIndexOutOfBounds
 	}
 	if targetPositionInt == currentPosition {
-		return targetPosition, nil
+		version, err := domain.NewVersion(currentVersion)
+		if err != nil {
+			return domain.ColumnPlacement{}, fmt.Errorf("column repo: move: version: %v: %w", err, ErrInternal)
+		}
+		return domain.ColumnPlacement{Position: targetPosition, Version: version}, nil
 	}
 
 	moveNeighborsArgs := pgx.NamedArgs{
@@ -282,33 +325,41 @@ func (r *PGColumn) Move(
 	if currentPosition < targetPositionInt {
 		_, err = tx.Exec(ctx, moveNeighborsDownQuery, moveNeighborsArgs)
 		if err != nil {
-			return domain.ColumnPosition{}, fmt.Errorf("column repo: move neighbors down: %v: %w", err, ErrInternal)
+			return domain.ColumnPlacement{}, fmt.Errorf("column repo: move neighbors down: %v: %w", err, ErrInternal)
 		}
 	} else {
 		_, err = tx.Exec(ctx, moveNeighborsUpQuery, moveNeighborsArgs)
 		if err != nil {
-			return domain.ColumnPosition{}, fmt.Errorf("column repo: move neighbors up: %v: %w", err, ErrInternal)
+			return domain.ColumnPlacement{}, fmt.Errorf("column repo: move neighbors up: %v: %w", err, ErrInternal)
 		}
 	}
 
-	_, err = tx.Exec(ctx, moveColumnIntoTargetQuery, pgx.NamedArgs{
+	var movedVersion int64
+	err = tx.QueryRow(ctx, moveColumnIntoTargetQuery, pgx.NamedArgs{
 		"board_id":        boardID,
 		"column_id":       columnID,
 		"target_position": targetPosition,
-	})
+	}).Scan(&movedVersion)
+	if err != nil {
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move column into target: %v: %w", err, ErrInternal)
+	}
+
+	version, err := domain.NewVersion(movedVersion)
 	if err != nil {
-		return domain.ColumnPosition{}, fmt.Errorf("column repo: move column into target: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move: version: %v: %w", err, ErrInternal)
 	}
 
 	err = tx.Commit(ctx)
 	if err != nil {
-		return domain.ColumnPosition{}, fmt.Errorf("column repo: move commit: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column repo: move commit: %v: %w", err, ErrInternal)
 	}
 
-	return targetPosition, nil
+	return domain.ColumnPlacement{Position: targetPosition, Version: version}, nil
 }
 
-func (r *PGColumn) Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+// Delete deletes the column and its tasks if the column is at the expected version, or at any
+// version if expected is zero.
+func (r *PGColumn) Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
 	const (
 		// 1. Lock the board row so no concurrent operation can reorder columns in the same board.
 		lockBoardQuery = `
@@ -321,19 +372,38 @@ func (r *PGColumn) Delete(ctx context.Context, boardID domain.BoardID, columnID
 		deferPositionConstraintQuery = `
 		SET CONSTRAINTS columns_board_id_position_key DEFERRED`
 
-		// 3. Delete the target column and remember its position.
+		// 3. Delete the target column if it is at the expected version and remember its position
+		//    and the number of its tasks, which are deleted along with it.
 		deleteColumnQuery = `
 		DELETE FROM columns
 		WHERE board_id = @board_id
 		  AND id = @column_id
-		RETURNING position`
+		  AND (@expected = 0 OR version = @expected)
+		RETURNING position, (SELECT COUNT(*) FROM tasks WHERE column_id = columns.id)`
+
+		// 3a. If nothing was deleted, tell a missing column from a changed one.
+		columnExistsQuery = `
+		SELECT 1
+		FROM columns
+		WHERE board_id = @board_id
+		  AND id = @column_id`
 
 		// 4. Close the gap left by the deleted column.
 		compactTrailingColumnsQuery = `
 		UPDATE columns
-		SET position = position - 1
+		SET position = position - 1,
+		    version = version + 1
 		WHERE board_id = @board_id
 		  AND position > @deleted_position`
+
+		// 5. Drop the deleted column from the allowed transitions of the remaining columns.
+		removeTransitionsQuery = `
+		UPDATE columns
+		SET
+			allowed_transitions = array_remove(allowed_transitions, @column_id),
+			version = version + 1
+		WHERE board_id = @board_id
+		  AND @column_id = ANY(allowed_transitions)`
 	)
 
 	tx, err := r.pgPool.Begin(ctx)
@@ -360,14 +430,21 @@ func (r *PGColumn) Delete(ctx context.Context, boardID domain.BoardID, columnID
 		return fmt.Errorf("column repo: delete defer position constraint: %v: %w", err, ErrInternal)
 	}
 
-	var deletedPosition int64
+	var deletedPosition, deletedTasks int64
 	err = tx.QueryRow(ctx, deleteColumnQuery, pgx.NamedArgs{
 		"board_id":  boardID.UUID(),
 		"column_id": columnID.UUID(),
-	}).Scan(&deletedPosition)
+		"expected":  expected,
+	}).Scan(&deletedPosition, &deletedTasks)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return ErrRowNotFound
+			err = versionMismatch(ctx, tx, expected, columnExistsQuery, pgx.NamedArgs{
+				"board_id":  boardID.UUID(),
+				"column_id": columnID.UUID(),
+			})
+			if errors.Is(err, ErrRowNotFound) || errors.Is(err, ErrVersionConflict) {
+				return err
+			}
 		}
 		return fmt.Errorf("column repo: delete column: %v: %w", err, ErrInternal)
 	}
@@ -380,6 +457,19 @@ func (r *PGColumn) Delete(ctx context.Context, boardID domain.BoardID, columnID
 		return fmt.Errorf("column repo: delete compact trailing columns: %v: %w", err, ErrInternal)
 	}
 
+	_, err = tx.Exec(ctx, removeTransitionsQuery, pgx.NamedArgs{
+		"board_id":  boardID.UUID(),
+		"column_id": columnID.UUID(),
+	})
+	if err != nil {
+		return fmt.Errorf("column repo: delete remove transitions: %v: %w", err, ErrInternal)
+	}
+
+	err = recordTaskDeletions(ctx, tx, boardID, deletedTasks)
+	if err != nil {
+		return fmt.Errorf("column repo: delete: %v: %w", err, ErrInternal)
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return fmt.Errorf("column repo: delete commit: %v: %w", err, ErrInternal)
@@ -388,6 +478,140 @@ func (r *PGColumn) Delete(ctx context.Context, boardID domain.BoardID, columnID
 	return nil
 }
 
+func (r *PGColumn) Duplicate(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+	const (
+		// 1. Lock the board row so no concurrent operation can reorder columns in the same board.
+		lockBoardQuery = `
+		SELECT 1
+		FROM boards
+		WHERE id = @board_id
+		FOR UPDATE`
+
+		// 3. Defer the unique constraint until COMMIT for this transaction only.
+		deferPositionConstraintQuery = `
+		SET CONSTRAINTS columns_board_id_position_key DEFERRED`
+
+		// 4. Read the position of the column we are copying.
+		getSourcePositionQuery = `
+		SELECT position
+		FROM columns
+		WHERE board_id = @board_id
+		  AND id = @column_id`
+
+		// 5. Open a slot right after the source column.
+		openSlotQuery = `
+		UPDATE columns
+		SET position = position + 1,
+		    version = version + 1
+		WHERE board_id = @board_id
+		  AND position > @source_position`
+
+		// 6. Insert the column copy into the opened slot, keeping the workflow rules of the source.
+		insertColumnCopyQuery = `
+		INSERT INTO columns (board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles)
+		SELECT board_id, name, description, @source_position + 1, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles
+		FROM columns
+		WHERE id = @column_id
+		RETURNING id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles, created_at, updated_at, version`
+
+		// 7. Copy the active tasks keeping their lanes and positions. Every copy starts its own
+		//    history in the column copy.
+		insertTaskCopiesQuery = `
+		WITH copies AS (
+			INSERT INTO tasks (column_id, lane_id, name, description, position, checklist, custom_fields)
+			SELECT @copy_column_id, lane_id, name, description, position, checklist, custom_fields
+			FROM tasks
+			WHERE column_id = @column_id
+			  AND archived_at IS NULL
+			ORDER BY position ASC
+			RETURNING id, column_id
+		),
+		transitions AS (
+			INSERT INTO task_transitions (task_id, board_id, to_column_id)
+			SELECT id, @board_id, column_id
+			FROM copies
+			RETURNING board_id, from_column_id, transitioned_at
+		)` + countTransitionsQuery
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("column repo: duplicate begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var locked int
+	err = tx.QueryRow(ctx, lockBoardQuery, pgx.NamedArgs{
+		"board_id": boardID.UUID(),
+	}).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Column{}, ErrRowNotFound
+		}
+		return domain.Column{}, fmt.Errorf("column repo: duplicate lock board: %v: %w", err, ErrInternal)
+	}
+
+	// 2. Lock the source column so its tasks can't change while they are copied.
+	err = LockTaskColumns(ctx, tx, boardID, columnID)
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) {
+			return domain.Column{}, ErrRowNotFound
+		}
+		return domain.Column{}, fmt.Errorf("column repo: duplicate lock column: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("column repo: duplicate defer position constraint: %v: %w", err, ErrInternal)
+	}
+
+	var sourcePosition int64
+	err = tx.QueryRow(ctx, getSourcePositionQuery, pgx.NamedArgs{
+		"board_id":  boardID.UUID(),
+		"column_id": columnID.UUID(),
+	}).Scan(&sourcePosition)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Column{}, ErrRowNotFound
+		}
+		return domain.Column{}, fmt.Errorf("column repo: duplicate get source position: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, openSlotQuery, pgx.NamedArgs{
+		"board_id":        boardID,
+		"source_position": sourcePosition,
+	})
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("column repo: duplicate open slot: %v: %w", err, ErrInternal)
+	}
+
+	column, err := ScanColumn(tx.QueryRow(ctx, insertColumnCopyQuery, pgx.NamedArgs{
+		"column_id":       columnID,
+		"source_position": sourcePosition,
+	}))
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("column repo: duplicate insert column copy: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, insertTaskCopiesQuery, pgx.NamedArgs{
+		"board_id":       boardID.UUID(),
+		"copy_column_id": column.ID,
+		"column_id":      columnID,
+	})
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("column repo: duplicate insert task copies: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("column repo: duplicate commit: %v: %w", err, ErrInternal)
+	}
+
+	return column, nil
+}
+
 func ScanColumn(row interface{ Scan(...any) error }) (domain.Column, error) {
 	var (
 		rawID      uuid.UUID
@@ -395,10 +619,19 @@ func ScanColumn(row interface{ Scan(...any) error }) (domain.Column, error) {
 		rawName    string
 		rawDesc    string
 		rawPos     int64
+		rawWIP     int64
+		rawSLA     int64
+		rawArchive int64
+		isStarted  bool
+		isDone     bool
+		rawTargets []uuid.UUID
+		rawEntry   []string
+		rawRoles   []string
 		createdAt  time.Time
 		updatedAt  time.Time
+		rawVersion int64
 	)
-	err := row.Scan(&rawID, &rawBoardID, &rawName, &rawDesc, &rawPos, &createdAt, &updatedAt)
+	err := row.Scan(&rawID, &rawBoardID, &rawName, &rawDesc, &rawPos, &rawWIP, &rawSLA, &rawArchive, &isStarted, &isDone, &rawTargets, &rawEntry, &rawRoles, &createdAt, &updatedAt, &rawVersion)
 	if err != nil {
 		return domain.Column{}, fmt.Errorf("scan column: %w", err)
 	}
@@ -414,6 +647,37 @@ func ScanColumn(row interface{ Scan(...any) error }) (domain.Column, error) {
 	if err != nil {
 		return domain.Column{}, fmt.Errorf("scan column: position: %v: %w", err, errDataCorrupted)
 	}
+	wipLimit, err := domain.NewColumnWIPLimit(rawWIP)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("scan column: wip limit: %v: %w", err, errDataCorrupted)
+	}
+	sla, err := domain.NewColumnSLA(rawSLA)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("scan column: sla: %v: %w", err, errDataCorrupted)
+	}
+	archiveAfter, err := domain.NewColumnArchiveAfter(rawArchive)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("scan column: archive after: %v: %w", err, errDataCorrupted)
+	}
+	targets := make([]domain.ColumnID, len(rawTargets))
+	for i, rawTarget := range rawTargets {
+		targets[i], err = domain.NewColumnIDFromUUID(rawTarget)
+		if err != nil {
+			return domain.Column{}, fmt.Errorf("scan column: transition target: %v: %w", err, errDataCorrupted)
+		}
+	}
+	transitions, err := domain.NewColumnTransitions(targets)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("scan column: transitions: %v: %w", err, errDataCorrupted)
+	}
+	entryConditions, err := domain.NewColumnEntryConditions(rawEntry)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("scan column: entry conditions: %v: %w", err, errDataCorrupted)
+	}
+	entryRoles, err := domain.NewColumnEntryRoles(rawRoles)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("scan column: entry roles: %v: %w", err, errDataCorrupted)
+	}
 	id, err := domain.NewColumnIDFromUUID(rawID)
 	if err != nil {
 		return domain.Column{}, fmt.Errorf("scan column: id: %v: %w", err, errDataCorrupted)
@@ -422,13 +686,31 @@ func ScanColumn(row interface{ Scan(...any) error }) (domain.Column, error) {
 	if err != nil {
 		return domain.Column{}, fmt.Errorf("scan column: board id: %v: %w", err, errDataCorrupted)
 	}
+	version, err := domain.NewVersion(rawVersion)
+	if err != nil {
+		return domain.Column{}, fmt.Errorf("scan column: version: %v: %w", err, errDataCorrupted)
+	}
 	return domain.Column{
-		ID:          id,
-		BoardID:     boardID,
-		Name:        name,
-		Description: desc,
-		Position:    pos,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
+		ID:              id,
+		BoardID:         boardID,
+		Name:            name,
+		Description:     desc,
+		Position:        pos,
+		WIPLimit:        wipLimit,
+		SLA:             sla,
+		ArchiveAfter:    archiveAfter,
+		IsStarted:       isStarted,
+		IsDone:          isDone,
+		Transitions:     transitions,
+		EntryConditions: entryConditions,
+		EntryRoles:      entryRoles,
+		CreatedAt:       createdAt,
+		UpdatedAt:       updatedAt,
+		Version:         version,
 	}, nil
 }
+
+// NullColumnID maps a nil column ID to SQL NULL.
+func NullColumnID(id domain.ColumnID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id.UUID(), Valid: !id.IsNil()}
+}
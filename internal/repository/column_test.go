@@ -226,7 +226,7 @@ func TestColumnRepository_Update(t *testing.T) {
 		CreateColumn(t, pool, &created)
 
 		want := testutil.UpdateValidColumn(t, &created, "Renamed", created.Description.String(), testutil.Fixed5mFromNow())
-		updated, err := r.Update(context.Background(), board.ID, created.ID, &want.Name, nil)
+		updated, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{Name: &want.Name}, domain.Version{})
 		if err != nil {
 			t.Fatalf("Update() error = %v", err)
 		}
@@ -249,7 +249,7 @@ func TestColumnRepository_Update(t *testing.T) {
 		if err != nil {
 			t.Fatalf("NewColumnDescription() error = %v", err)
 		}
-		updated, err := r.Update(context.Background(), board.ID, created.ID, nil, &newDesc)
+		updated, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{Description: &newDesc}, domain.Version{})
 		if err != nil {
 			t.Fatalf("Update() error = %v", err)
 		}
@@ -270,13 +270,166 @@ func TestColumnRepository_Update(t *testing.T) {
 		}
 	})
 
+	t.Run("Success WIP limit only", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		created := testutil.ValidColumn(board.ID)
+		CreateColumn(t, pool, &created)
+
+		newLimit, err := domain.NewColumnWIPLimit(3)
+		if err != nil {
+			t.Fatalf("NewColumnWIPLimit() error = %v", err)
+		}
+		updated, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{WIPLimit: &newLimit}, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		if updated.Name != created.Name {
+			t.Errorf("got name %q, want %q", updated.Name, created.Name)
+		}
+		if updated.WIPLimit != newLimit {
+			t.Errorf("got wip limit %d, want %d", updated.WIPLimit.Int64(), newLimit.Int64())
+		}
+		storedColumns := ListColumnsByBoardID(t, pool, created.BoardID)
+		if len(storedColumns) != 1 {
+			t.Fatalf("ListColumnsByBoardID() returned %d columns, want exactly 1", len(storedColumns))
+		}
+		if storedColumns[0].WIPLimit != newLimit {
+			t.Errorf("stored wip limit %d, want %d", storedColumns[0].WIPLimit.Int64(), newLimit.Int64())
+		}
+	})
+
+	t.Run("Success SLA only", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		created := testutil.ValidColumn(board.ID)
+		CreateColumn(t, pool, &created)
+
+		sla, err := domain.NewColumnSLA(48)
+		if err != nil {
+			t.Fatalf("NewColumnSLA() error = %v", err)
+		}
+		updated, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{SLA: &sla}, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		if updated.SLA != sla {
+			t.Errorf("got sla %d hours, want %d", updated.SLA.Hours(), sla.Hours())
+		}
+		if updated.WIPLimit != created.WIPLimit {
+			t.Errorf("got wip limit %d, want %d", updated.WIPLimit.Int64(), created.WIPLimit.Int64())
+		}
+	})
+
+	t.Run("Success archive delay only", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		created := testutil.ValidColumn(board.ID)
+		CreateColumn(t, pool, &created)
+
+		archiveAfter, err := domain.NewColumnArchiveAfter(14)
+		if err != nil {
+			t.Fatalf("NewColumnArchiveAfter() error = %v", err)
+		}
+		updated, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{ArchiveAfter: &archiveAfter}, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		if updated.ArchiveAfter != archiveAfter {
+			t.Errorf("got archive delay %d days, want %d", updated.ArchiveAfter.Days(), archiveAfter.Days())
+		}
+		if updated.SLA != created.SLA {
+			t.Errorf("got sla %d hours, want %d", updated.SLA.Hours(), created.SLA.Hours())
+		}
+	})
+
+	t.Run("Success done flag only", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		created := testutil.ValidColumn(board.ID)
+		CreateColumn(t, pool, &created)
+
+		isDone := true
+		updated, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{IsDone: &isDone}, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		if !updated.IsDone {
+			t.Errorf("got is done false, want true")
+		}
+		if updated.Name != created.Name {
+			t.Errorf("got name %q, want %q", updated.Name, created.Name)
+		}
+	})
+
+	t.Run("Success workflow rules", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		created := testutil.ValidColumn(board.ID)
+		CreateColumn(t, pool, &created)
+		review := testutil.NewValidColumn(t, board.ID, "Review", 2)
+		CreateColumn(t, pool, &review)
+
+		transitions, err := domain.NewColumnTransitions([]domain.ColumnID{review.ID})
+		if err != nil {
+			t.Fatalf("NewColumnTransitions() error = %v", err)
+		}
+		entryConditions, err := domain.NewColumnEntryConditions([]string{"estimate_set", "checklist_done"})
+		if err != nil {
+			t.Fatalf("NewColumnEntryConditions() error = %v", err)
+		}
+		entryRoles, err := domain.NewColumnEntryRoles([]string{"owner"})
+		if err != nil {
+			t.Fatalf("NewColumnEntryRoles() error = %v", err)
+		}
+		updated, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{Transitions: &transitions, EntryConditions: &entryConditions, EntryRoles: &entryRoles}, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		if diff := cmp.Diff(transitions, updated.Transitions, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got transitions mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(entryConditions, updated.EntryConditions, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got entry conditions mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(entryRoles, updated.EntryRoles, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got entry roles mismatch (-want +got):\n%s", diff)
+		}
+
+		cleared, err := r.Update(context.Background(), board.ID, created.ID, domain.ColumnPatch{Transitions: &domain.ColumnTransitions{}}, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if len(cleared.Transitions.Targets()) != 0 {
+			t.Errorf("got transitions %v, want none", cleared.Transitions.Targets())
+		}
+		if diff := cmp.Diff(entryConditions, cleared.EntryConditions, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got entry conditions mismatch after clearing transitions (-want +got):\n%s", diff)
+		}
+	})
+
 	t.Run("Not found by column id", func(t *testing.T) {
 		testutil.TruncateAllTables(t, pool)
 
 		board := insertFixedUserAndBoard(t, pool)
 
 		updatedName, _ := domain.NewColumnName("Renamed")
-		_, err := r.Update(context.Background(), board.ID, domain.NewColumnID(), &updatedName, nil)
+		_, err := r.Update(context.Background(), board.ID, domain.NewColumnID(), domain.ColumnPatch{Name: &updatedName}, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 
@@ -289,7 +442,7 @@ func TestColumnRepository_Update(t *testing.T) {
 		CreateColumn(t, pool, &created)
 
 		want := testutil.UpdateValidColumn(t, &created, "Renamed", created.Description.String(), testutil.Fixed5mFromNow())
-		_, err := r.Update(context.Background(), domain.NewBoardID(), created.ID, &want.Name, nil)
+		_, err := r.Update(context.Background(), domain.NewBoardID(), created.ID, domain.ColumnPatch{Name: &want.Name}, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 }
@@ -312,12 +465,15 @@ func TestColumnRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidColumnPosition(t, 3)
 
-		gotPosition, err := r.Move(context.Background(), board.ID, first.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, first.ID, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
+		}
+		if placement.Version.Int64() != 2 {
+			t.Fatalf("Move() version = %d, want 2", placement.Version.Int64())
 		}
 
 		got := ListColumnsByBoardID(t, pool, board.ID)
@@ -344,12 +500,15 @@ func TestColumnRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidColumnPosition(t, 1)
 
-		gotPosition, err := r.Move(context.Background(), board.ID, third.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, third.ID, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
+		}
+		if placement.Version.Int64() != 2 {
+			t.Fatalf("Move() version = %d, want 2", placement.Version.Int64())
 		}
 
 		got := ListColumnsByBoardID(t, pool, board.ID)
@@ -374,12 +533,15 @@ func TestColumnRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidColumnPosition(t, 2)
 
-		gotPosition, err := r.Move(context.Background(), board.ID, second.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, second.ID, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
+		}
+		if placement.Version.Int64() != 1 {
+			t.Fatalf("Move() version = %d, want 1", placement.Version.Int64())
 		}
 
 		got := ListColumnsByBoardID(t, pool, board.ID)
@@ -390,6 +552,30 @@ func TestColumnRepository_Move(t *testing.T) {
 		assertColumnIDAndPosition(t, &got[1], second.ID, 2)
 	})
 
+	t.Run("Version conflict", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		first := testutil.ValidColumn(board.ID)
+		second := testutil.NewValidColumn(t, board.ID, "In Progress", 2)
+
+		CreateColumn(t, pool, &first)
+		CreateColumn(t, pool, &second)
+
+		targetPosition := testutil.NewValidColumnPosition(t, 2)
+		staleVersion := testutil.UpdateValidColumn(t, &first, "Renamed", "", testutil.FixedNow()).Version
+
+		_, err := r.Move(context.Background(), board.ID, first.ID, targetPosition, staleVersion)
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			t.Fatalf("Move() error = %v, want ErrVersionConflict", err)
+		}
+
+		got := ListColumnsByBoardID(t, pool, board.ID)
+		assertColumnIDAndPosition(t, &got[0], first.ID, 1)
+		assertColumnIDAndPosition(t, &got[1], second.ID, 2)
+	})
+
 	t.Run("Index out of bounds", func(t *testing.T) {
 		testutil.TruncateAllTables(t, pool)
 
@@ -405,7 +591,7 @@ func TestColumnRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidColumnPosition(t, 4)
 
-		_, err := r.Move(context.Background(), board.ID, second.ID, targetPosition)
+		_, err := r.Move(context.Background(), board.ID, second.ID, targetPosition, domain.Version{})
 		if !errors.Is(err, repository.ErrIndexOutOfBounds) {
 			t.Fatalf("Move() error = %v, want ErrIndexOutOfBounds", err)
 		}
@@ -426,7 +612,7 @@ func TestColumnRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidColumnPosition(t, 1)
 
-		_, err := r.Move(context.Background(), board.ID, domain.NewColumnID(), targetPosition)
+		_, err := r.Move(context.Background(), board.ID, domain.NewColumnID(), targetPosition, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 
@@ -440,7 +626,7 @@ func TestColumnRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidColumnPosition(t, 1)
 
-		_, err := r.Move(context.Background(), domain.NewBoardID(), created.ID, targetPosition)
+		_, err := r.Move(context.Background(), domain.NewBoardID(), created.ID, targetPosition, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 }
@@ -461,7 +647,7 @@ func TestColumnRepository_Delete(t *testing.T) {
 		CreateColumn(t, pool, &first)
 		CreateColumn(t, pool, &second)
 
-		err := r.Delete(context.Background(), board.ID, second.ID)
+		err := r.Delete(context.Background(), board.ID, second.ID, domain.Version{})
 		if err != nil {
 			t.Fatalf("Delete() error = %v", err)
 		}
@@ -475,12 +661,124 @@ func TestColumnRepository_Delete(t *testing.T) {
 		assertColumnIDAndPosition(t, &got[1], third.ID, 2)
 	})
 
+	t.Run("Success drops the column from allowed transitions", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		review := testutil.NewValidColumn(t, board.ID, "Review", 2)
+		done := testutil.NewValidColumn(t, board.ID, "Done", 3)
+		first := testutil.ValidColumn(board.ID)
+		var err error
+		first.Transitions, err = domain.NewColumnTransitions([]domain.ColumnID{review.ID, done.ID})
+		if err != nil {
+			t.Fatalf("NewColumnTransitions() error = %v", err)
+		}
+		CreateColumn(t, pool, &first)
+		CreateColumn(t, pool, &review)
+		CreateColumn(t, pool, &done)
+
+		err = r.Delete(context.Background(), board.ID, review.ID, domain.Version{})
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		got, err := r.Get(context.Background(), first.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if targets := got.Transitions.Targets(); len(targets) != 1 || targets[0] != done.ID {
+			t.Errorf("got transitions %v, want only %v", targets, done.ID)
+		}
+	})
+
+	t.Run("Not found by column id", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		err := r.Delete(context.Background(), board.ID, domain.NewColumnID(), domain.Version{})
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Not found by board id", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		created := testutil.ValidColumn(board.ID)
+		CreateColumn(t, pool, &created)
+
+		err := r.Delete(context.Background(), domain.NewBoardID(), created.ID, domain.Version{})
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestColumnRepository_Duplicate(t *testing.T) {
+	pool, r := columnRepoPrelude(t)
+
+	t.Run("Success copies tasks and places copy after original", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		first := testutil.ValidColumn(board.ID)
+		second := testutil.NewValidColumn(t, board.ID, "Done", 2)
+
+		CreateColumn(t, pool, &first)
+		CreateColumn(t, pool, &second)
+
+		firstTask := testutil.ValidTask(first.ID)
+		secondTask := testutil.NewValidTask(t, first.ID, "Second", "second", 2)
+
+		CreateTask(t, pool, &firstTask)
+		CreateTask(t, pool, &secondTask)
+
+		copied, err := r.Duplicate(context.Background(), board.ID, first.ID)
+		if err != nil {
+			t.Fatalf("Duplicate() error = %v", err)
+		}
+
+		if copied.ID == first.ID {
+			t.Errorf("got copy id %q, want new id", copied.ID)
+		}
+		if copied.Name != first.Name {
+			t.Errorf("got name %q, want %q", copied.Name, first.Name)
+		}
+
+		gotColumns := ListColumnsByBoardID(t, pool, board.ID)
+
+		if len(gotColumns) != 3 {
+			t.Fatalf("got %d columns after duplicate, want 3", len(gotColumns))
+		}
+		assertColumnIDAndPosition(t, &gotColumns[0], first.ID, 1)
+		assertColumnIDAndPosition(t, &gotColumns[1], copied.ID, 2)
+		assertColumnIDAndPosition(t, &gotColumns[2], second.ID, 3)
+
+		gotTasks := ListTasksByColumnID(t, pool, copied.ID)
+
+		if len(gotTasks) != 2 {
+			t.Fatalf("got %d copied tasks, want 2", len(gotTasks))
+		}
+		for i, want := range []domain.Task{firstTask, secondTask} {
+			if gotTasks[i].ID == want.ID {
+				t.Errorf("got task id %q, want new id", gotTasks[i].ID)
+			}
+			if gotTasks[i].Name != want.Name {
+				t.Errorf("got task name %q, want %q", gotTasks[i].Name, want.Name)
+			}
+			if gotTasks[i].Position != want.Position {
+				t.Errorf("got task position %d, want %d", gotTasks[i].Position.Int64(), want.Position.Int64())
+			}
+		}
+	})
+
 	t.Run("Not found by column id", func(t *testing.T) {
 		testutil.TruncateAllTables(t, pool)
 
 		board := insertFixedUserAndBoard(t, pool)
 
-		err := r.Delete(context.Background(), board.ID, domain.NewColumnID())
+		_, err := r.Duplicate(context.Background(), board.ID, domain.NewColumnID())
 		assertErrRowNotFound(t, err)
 	})
 
@@ -492,7 +790,7 @@ func TestColumnRepository_Delete(t *testing.T) {
 		created := testutil.ValidColumn(board.ID)
 		CreateColumn(t, pool, &created)
 
-		err := r.Delete(context.Background(), domain.NewBoardID(), created.ID)
+		_, err := r.Duplicate(context.Background(), domain.NewBoardID(), created.ID)
 		assertErrRowNotFound(t, err)
 	})
 }
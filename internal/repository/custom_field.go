@@ -0,0 +1,297 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGCustomField struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGCustomField(pgPool *pgxpool.Pool) *PGCustomField {
+	return &PGCustomField{pgPool: pgPool}
+}
+
+func (r *PGCustomField) Create(
+	ctx context.Context,
+	boardID domain.BoardID,
+	name domain.CustomFieldName,
+	fieldType domain.CustomFieldType,
+	options domain.CustomFieldOptions,
+) (domain.CustomField, error) {
+	const query = `
+		INSERT INTO custom_fields (board_id, name, type, options)
+		VALUES (@board_id, @name, @type, @options)
+		RETURNING id, board_id, name, type, options, created_at, updated_at`
+
+	field, err := ScanCustomField(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"board_id": boardID,
+		"name":     name,
+		"type":     string(fieldType),
+		"options":  options,
+	}))
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("custom field repo: create: %v: %w", err, ErrInternal)
+	}
+
+	return field, nil
+}
+
+func (r *PGCustomField) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error) {
+	return listCustomFieldsByBoardID(ctx, r.pgPool, boardID)
+}
+
+func listCustomFieldsByBoardID(ctx context.Context, q querier, boardID domain.BoardID) ([]domain.CustomField, error) {
+	const query = `
+		SELECT id, board_id, name, type, options, created_at, updated_at
+		FROM custom_fields
+		WHERE board_id = $1
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := q.Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("custom field repo: list by board id: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var result []domain.CustomField
+	for rows.Next() {
+		field, scanErr := ScanCustomField(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("custom field repo: list by board id: scan: %v: %w", scanErr, ErrInternal)
+		}
+		result = append(result, field)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("custom field repo: list by board id: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return result, nil
+}
+
+func (r *PGCustomField) Get(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+	const query = `
+		SELECT id, board_id, name, type, options, created_at, updated_at
+		FROM custom_fields
+		WHERE id = $1`
+
+	field, err := ScanCustomField(r.pgPool.QueryRow(ctx, query, fieldID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.CustomField{}, ErrRowNotFound
+		}
+		return domain.CustomField{}, fmt.Errorf("custom field repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return field, nil
+}
+
+// Update renames the field or replaces the options of a select field. Task values holding
+// an option that is no longer offered lose that option, and single select values are removed.
+func (r *PGCustomField) Update(
+	ctx context.Context,
+	boardID domain.BoardID,
+	fieldID domain.CustomFieldID,
+	name *domain.CustomFieldName,
+	options *domain.CustomFieldOptions,
+) (domain.CustomField, error) {
+	const (
+		updateFieldQuery = `
+		UPDATE custom_fields
+		SET
+			name = COALESCE(@name, name),
+			options = COALESCE(@options::jsonb, options),
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+		WHERE board_id = @board_id
+		  AND id = @field_id
+		RETURNING id, board_id, name, type, options, created_at, updated_at`
+
+		// Drop the options that are gone from the values of the board's tasks. A value left
+		// without options is removed, so a field without a value stays absent.
+		pruneValuesQuery = `
+		WITH pruned AS (
+			SELECT t.id,
+			       (SELECT jsonb_agg(o.value ORDER BY o.ordinality)
+			        FROM jsonb_array_elements(
+			                 CASE jsonb_typeof(t.custom_fields -> @field_key)
+			                     WHEN 'array' THEN t.custom_fields -> @field_key
+			                     ELSE jsonb_build_array(t.custom_fields -> @field_key)
+			                 END) WITH ORDINALITY o(value, ordinality)
+			        WHERE @options::jsonb @> jsonb_build_array(o.value)) AS kept,
+			       jsonb_typeof(t.custom_fields -> @field_key) = 'array' AS multi
+			FROM tasks t
+			JOIN columns c ON c.id = t.column_id
+			WHERE c.board_id = @board_id
+			  AND t.custom_fields ? @field_key
+		)
+		UPDATE tasks t
+		SET custom_fields = CASE
+		        WHEN pruned.kept IS NULL THEN t.custom_fields - @field_key
+		        WHEN pruned.multi THEN jsonb_set(t.custom_fields, ARRAY[@field_key], pruned.kept)
+		        ELSE jsonb_set(t.custom_fields, ARRAY[@field_key], pruned.kept -> 0)
+		    END,
+		    version = t.version + 1
+		FROM pruned
+		WHERE t.id = pruned.id
+		  AND (pruned.kept IS NULL OR (pruned.multi AND pruned.kept <> t.custom_fields -> @field_key))`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("custom field repo: update begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	field, err := ScanCustomField(tx.QueryRow(ctx, updateFieldQuery, pgx.NamedArgs{
+		"name":     name,
+		"options":  options,
+		"board_id": boardID,
+		"field_id": fieldID,
+	}))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.CustomField{}, ErrRowNotFound
+		}
+		return domain.CustomField{}, fmt.Errorf("custom field repo: update: %v: %w", err, ErrInternal)
+	}
+
+	if options != nil && field.Type.IsSelect() {
+		_, err = tx.Exec(ctx, pruneValuesQuery, pgx.NamedArgs{
+			"field_key": fieldID.String(),
+			"options":   field.Options,
+			"board_id":  boardID,
+		})
+		if err != nil {
+			return domain.CustomField{}, fmt.Errorf("custom field repo: update prune values: %v: %w", err, ErrInternal)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("custom field repo: update commit: %v: %w", err, ErrInternal)
+	}
+
+	return field, nil
+}
+
+// Delete removes the field together with its values on the board's tasks.
+func (r *PGCustomField) Delete(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+	const (
+		deleteFieldQuery = `
+		DELETE FROM custom_fields
+		WHERE board_id = @board_id
+		  AND id = @field_id`
+		deleteValuesQuery = `
+		UPDATE tasks t
+		SET custom_fields = t.custom_fields - @field_key,
+		    version = t.version + 1
+		FROM columns c
+		WHERE c.id = t.column_id
+		  AND c.board_id = @board_id
+		  AND t.custom_fields ? @field_key`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("custom field repo: delete begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	cmd, err := tx.Exec(ctx, deleteFieldQuery, pgx.NamedArgs{
+		"board_id": boardID,
+		"field_id": fieldID,
+	})
+	if err != nil {
+		return fmt.Errorf("custom field repo: delete: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	_, err = tx.Exec(ctx, deleteValuesQuery, pgx.NamedArgs{
+		"field_key": fieldID.String(),
+		"board_id":  boardID,
+	})
+	if err != nil {
+		return fmt.Errorf("custom field repo: delete values: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("custom field repo: delete commit: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+func ScanCustomField(row interface{ Scan(...any) error }) (domain.CustomField, error) {
+	var (
+		rawID      uuid.UUID
+		rawBoardID uuid.UUID
+		rawName    string
+		rawType    string
+		rawOptions []byte
+		createdAt  time.Time
+		updatedAt  time.Time
+	)
+	err := row.Scan(&rawID, &rawBoardID, &rawName, &rawType, &rawOptions, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("scan custom field: %w", err)
+	}
+	id, err := domain.NewCustomFieldIDFromUUID(rawID)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("scan custom field: id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("scan custom field: board id: %v: %w", err, errDataCorrupted)
+	}
+	name, err := domain.NewCustomFieldName(rawName)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("scan custom field: name: %v: %w", err, errDataCorrupted)
+	}
+	fieldType, err := domain.NewCustomFieldType(rawType)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("scan custom field: type: %v: %w", err, errDataCorrupted)
+	}
+	var optionTexts []string
+	err = json.Unmarshal(rawOptions, &optionTexts)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("scan custom field: options: %v: %w", err, errDataCorrupted)
+	}
+	options, err := domain.NewCustomFieldOptions(optionTexts)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("scan custom field: options: %v: %w", err, errDataCorrupted)
+	}
+
+	return domain.CustomField{
+		ID:        id,
+		BoardID:   boardID,
+		Name:      name,
+		Type:      fieldType,
+		Options:   options,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// NullCustomFieldID maps a nil custom field ID to SQL NULL.
+func NullCustomFieldID(id domain.CustomFieldID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id.UUID(), Valid: !id.IsNil()}
+}
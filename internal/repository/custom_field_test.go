@@ -0,0 +1,194 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestCustomFieldRepository_CreateAndList(t *testing.T) {
+	pool, r := customFieldRepoPrelude(t)
+
+	t.Run("Success", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		valid := testutil.ValidCustomField(board.ID)
+
+		created, err := r.Create(context.Background(), board.ID, valid.Name, valid.Type, valid.Options)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if created.ID.IsNil() {
+			t.Error("got empty field id, want generated id")
+		}
+		if got := created.Options.Strings(); len(got) != 2 || got[0] != "staging" || got[1] != "production" {
+			t.Errorf("got options %v, want [staging production]", got)
+		}
+
+		fields, err := r.ListByBoardID(context.Background(), board.ID)
+		if err != nil {
+			t.Fatalf("ListByBoardID() error = %v", err)
+		}
+		if len(fields) != 1 || fields[0].ID != created.ID {
+			t.Errorf("got fields %v, want only %v", fields, created.ID)
+		}
+		AssertTimestampPrecisionAtLeastMillis(t, pool, "custom_fields", "created_at", "updated_at")
+	})
+
+	t.Run("Get not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, err := r.Get(context.Background(), domain.NewCustomFieldID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestCustomFieldRepository_TaskValues(t *testing.T) {
+	pool, r := customFieldRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+
+	t.Run("Filter by value", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		field := createCustomField(t, r, board.ID, domain.CustomFieldMultiSelect, "staging", "production")
+		tagged := createTaskWithCustomField(t, taskRepo, column.ID, &field, `["staging","production"]`)
+		createTaskWithCustomField(t, taskRepo, column.ID, &field, `["staging"]`)
+
+		filterValue, err := domain.ParseCustomFieldFilterValue(&field, "production")
+		if err != nil {
+			t.Fatalf("ParseCustomFieldFilterValue() error = %v", err)
+		}
+		tasks, err := taskRepo.ListByColumnID(context.Background(), column.ID, domain.TaskCustomFields{}.With(field.ID, filterValue), false)
+		if err != nil {
+			t.Fatalf("ListByColumnID() error = %v", err)
+		}
+		if len(tasks) != 1 || tasks[0].ID != tagged.ID {
+			t.Errorf("got %d tasks, want only %v", len(tasks), tagged.ID)
+		}
+	})
+
+	t.Run("Update prunes removed options", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		multi := createCustomField(t, r, board.ID, domain.CustomFieldMultiSelect, "staging", "production")
+		single := createCustomField(t, r, board.ID, domain.CustomFieldSingleSelect, "staging", "production")
+		task := createTaskWithCustomField(t, taskRepo, column.ID, &multi, `["staging","production"]`)
+		task = setTaskCustomField(t, taskRepo, &task, &single, `"staging"`)
+
+		options, err := domain.NewCustomFieldOptions([]string{"production"})
+		if err != nil {
+			t.Fatalf("NewCustomFieldOptions() error = %v", err)
+		}
+		_, err = r.Update(context.Background(), board.ID, multi.ID, nil, &options)
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		_, err = r.Update(context.Background(), board.ID, single.ID, nil, &options)
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		got, err := taskRepo.Get(context.Background(), task.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		value, ok := got.CustomFields.Get(multi.ID)
+		if !ok || string(value.JSON()) != `["production"]` {
+			t.Errorf("got multi select value %s, want [\"production\"]", value.JSON())
+		}
+		if _, ok = got.CustomFields.Get(single.ID); ok {
+			t.Error("got single select value, want it removed")
+		}
+	})
+
+	t.Run("Delete removes task values", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		field := createCustomField(t, r, board.ID, domain.CustomFieldSingleSelect, "staging", "production")
+		task := createTaskWithCustomField(t, taskRepo, column.ID, &field, `"staging"`)
+
+		err := r.Delete(context.Background(), board.ID, field.ID)
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		got, err := taskRepo.Get(context.Background(), task.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if got.CustomFields.Len() != 0 {
+			t.Errorf("got custom fields %v, want none", got.CustomFields.JSON())
+		}
+
+		err = r.Delete(context.Background(), board.ID, field.ID)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func createCustomField(t *testing.T, r *repository.PGCustomField, boardID domain.BoardID, fieldType domain.CustomFieldType, options ...string) domain.CustomField {
+	t.Helper()
+
+	fieldOptions, err := domain.NewCustomFieldOptions(options)
+	if err != nil {
+		t.Fatalf("NewCustomFieldOptions() error = %v", err)
+	}
+	field, err := r.Create(context.Background(), boardID, testutil.ValidCustomField(boardID).Name, fieldType, fieldOptions)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return field
+}
+
+func createTaskWithCustomField(t *testing.T, r *repository.PGTask, columnID domain.ColumnID, field *domain.CustomField, raw string) domain.Task {
+	t.Helper()
+
+	value, err := domain.NewCustomFieldValue(field, json.RawMessage(raw))
+	if err != nil {
+		t.Fatalf("NewCustomFieldValue() error = %v", err)
+	}
+	valid := testutil.ValidTask(columnID)
+	task, err := r.Create(context.Background(), columnID, domain.LaneID{}, domain.TaskID{}, valid.Name, valid.Description, domain.TaskChecklist{}, domain.TaskEstimate{}, domain.TaskCustomFields{}.With(field.ID, value))
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return task
+}
+
+func setTaskCustomField(t *testing.T, r *repository.PGTask, task *domain.Task, field *domain.CustomField, raw string) domain.Task {
+	t.Helper()
+
+	value, err := domain.NewCustomFieldValue(field, json.RawMessage(raw))
+	if err != nil {
+		t.Fatalf("NewCustomFieldValue() error = %v", err)
+	}
+	values := task.CustomFields.With(field.ID, value)
+	updated, err := r.Update(context.Background(), task.ColumnID, task.ID, nil, nil, nil, nil, &values, domain.Version{})
+	if err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	return updated
+}
+
+func customFieldRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGCustomField) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGCustomField(pool)
+}
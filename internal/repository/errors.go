@@ -1,14 +1,35 @@
 package repository
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+)
 
 var (
 	ErrRowNotFound      = errors.New("row not found")
 	ErrUniqueViolation  = errors.New("attempt to insert unique value twice")
 	ErrIndexOutOfBounds = errors.New("index out of bounds")
+	ErrCycle            = errors.New("dependency cycle")
+	ErrCompleted        = errors.New("row is already completed")
+	ErrVersionConflict  = errors.New("row version changed")
 	errDataCorrupted    = errors.New("invalid data appeared in the database")
 
 	ErrKeyExists   = errors.New("key already exists")
 	ErrKeyNotFound = errors.New("key not found")
 	ErrInternal    = errors.New("internal error")
 )
+
+// BatchOperationError reports the operation of a batch that failed with Err, either
+// ErrRowNotFound or ErrIndexOutOfBounds. Index is the position of the operation in the batch.
+type BatchOperationError struct {
+	Index int
+	Err   error
+}
+
+func (e *BatchOperationError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.Index, e.Err)
+}
+
+func (e *BatchOperationError) Unwrap() error {
+	return e.Err
+}
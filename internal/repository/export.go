@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"goroutine/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGExport struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGExport(pgPool *pgxpool.Pool) *PGExport {
+	return &PGExport{pgPool: pgPool}
+}
+
+// Stream reads the board from one snapshot and passes it on: begin gets the board with its
+// columns, lanes, custom fields and sprints, then taskFn is called for every task in the order
+// of PGTask.StreamByBoardID and linkFn for every link in the order of
+// PGTaskLink.StreamByBoardID. It returns ErrRowNotFound if the board doesn't exist, and stops
+// at the first error of a callback and returns it as is.
+func (r *PGExport) Stream(
+	ctx context.Context,
+	boardID domain.BoardID,
+	begin func(domain.BoardSnapshot) error,
+	taskFn func(domain.Task) error,
+	linkFn func(domain.TaskLink) error,
+) error {
+	tx, err := r.pgPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return fmt.Errorf("export repo: stream begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var snapshot domain.BoardSnapshot
+	snapshot.Board, err = getBoard(ctx, tx, boardID)
+	if err != nil {
+		return err
+	}
+	snapshot.Columns, err = listColumnsByBoardID(ctx, tx, boardID)
+	if err != nil {
+		return err
+	}
+	snapshot.Lanes, err = listLanesByBoardID(ctx, tx, boardID)
+	if err != nil {
+		return err
+	}
+	snapshot.CustomFields, err = listCustomFieldsByBoardID(ctx, tx, boardID)
+	if err != nil {
+		return err
+	}
+	snapshot.Sprints, err = listSprintsByBoardID(ctx, tx, boardID)
+	if err != nil {
+		return err
+	}
+
+	err = begin(snapshot)
+	if err != nil {
+		return err
+	}
+	err = streamTasksByBoardID(ctx, tx, boardID, taskFn)
+	if err != nil {
+		return err
+	}
+	err = streamTaskLinksByBoardID(ctx, tx, boardID, linkFn)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("export repo: stream commit: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
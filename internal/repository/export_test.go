@@ -0,0 +1,123 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestExportRepository_Stream(t *testing.T) {
+	pool, r := exportRepoPrelude(t)
+
+	t.Run("Success reads the board, tasks and links in order", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		first, second := insertTwoTasks(t, pool, column.ID)
+		execQuery(t, pool, `INSERT INTO task_links (source_task_id, target_task_id, type) VALUES ($1, $2, 'blocks')`, first.ID, second.ID)
+
+		var (
+			calls    []string
+			snapshot domain.BoardSnapshot
+			taskIDs  []domain.TaskID
+			links    []domain.TaskLink
+		)
+		err := r.Stream(
+			context.Background(), board.ID,
+			func(got domain.BoardSnapshot) error {
+				calls = append(calls, "begin")
+				snapshot = got
+				return nil
+			},
+			func(task domain.Task) error {
+				calls = append(calls, "task")
+				taskIDs = append(taskIDs, task.ID)
+				return nil
+			},
+			func(link domain.TaskLink) error {
+				calls = append(calls, "link")
+				links = append(links, link)
+				return nil
+			},
+		)
+		if err != nil {
+			t.Fatalf("Stream() error = %v", err)
+		}
+
+		if diff := cmp.Diff([]string{"begin", "task", "task", "link"}, calls); diff != "" {
+			t.Errorf("calls mismatch (-want +got):\n%s", diff)
+		}
+		if diff := cmp.Diff(board, snapshot.Board, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("board mismatch (-want +got):\n%s", diff)
+		}
+		if len(snapshot.Columns) != 1 || snapshot.Columns[0].ID != column.ID {
+			t.Errorf("got columns %+v, want only %v", snapshot.Columns, column.ID)
+		}
+		if diff := cmp.Diff([]domain.TaskID{first.ID, second.ID}, taskIDs, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("task ids mismatch (-want +got):\n%s", diff)
+		}
+		if len(links) != 1 || links[0].SourceID != first.ID || links[0].TargetID != second.ID {
+			t.Errorf("got links %+v, want %v -> %v", links, first.ID, second.ID)
+		}
+	})
+
+	t.Run("Callback error stops the stream", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		insertTwoTasks(t, pool, column.ID)
+
+		tasks := 0
+		err := r.Stream(
+			context.Background(), board.ID,
+			func(domain.BoardSnapshot) error { return nil },
+			func(domain.Task) error {
+				tasks++
+				return context.Canceled
+			},
+			func(domain.TaskLink) error {
+				t.Error("got link after a task error, want none")
+				return nil
+			},
+		)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %v, want %v", err, context.Canceled)
+		}
+		if tasks != 1 {
+			t.Errorf("got %d tasks, want the stream to stop after 1", tasks)
+		}
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		err := r.Stream(
+			context.Background(), domain.NewBoardID(),
+			func(domain.BoardSnapshot) error {
+				t.Error("got begin for a missing board")
+				return nil
+			},
+			func(domain.Task) error { return nil },
+			func(domain.TaskLink) error { return nil },
+		)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func exportRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGExport) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGExport(pool)
+}
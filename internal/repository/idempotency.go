@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/redis/go-redis/v9"
+)
+
+type RedisIdempotency struct {
+	redisClient *redis.Client
+	responseTTL time.Duration
+	lockTTL     time.Duration
+}
+
+// NewRedisIdempotency keeps responses for responseTTL. A lock expires after lockTTL even if its
+// request never finishes, so it must outlive the longest request.
+func NewRedisIdempotency(redisClient *redis.Client, responseTTL, lockTTL time.Duration) *RedisIdempotency {
+	return &RedisIdempotency{
+		redisClient: redisClient,
+		responseTTL: responseTTL,
+		lockTTL:     lockTTL,
+	}
+}
+
+const (
+	idempotencyResponsePrefix = "idempotency:"
+	idempotencyLockPrefix     = "idempotency_lock:"
+)
+
+// idempotencyScope names a key of a user on a route. The client's key is hashed, since it may
+// contain the colons the parts are separated by, and comes before the route for the same reason.
+func idempotencyScope(req domain.IdempotentRequest) string {
+	keyHash := sha256.Sum256([]byte(req.Key.String()))
+	return req.UserID.String() + ":" + hex.EncodeToString(keyHash[:]) + ":" + req.Route
+}
+
+type idempotentResponseRecord struct {
+	Fingerprint string            `json:"fingerprint"`
+	StatusCode  int               `json:"statusCode"`
+	Header      map[string]string `json:"header"`
+	Body        []byte            `json:"body"`
+}
+
+func (r *RedisIdempotency) GetResponse(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+	data, err := r.redisClient.Get(ctx, idempotencyResponsePrefix+idempotencyScope(req)).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return domain.IdempotentResponse{}, fmt.Errorf("redis: get idempotent response: response not found: %w", ErrKeyNotFound)
+		}
+		return domain.IdempotentResponse{}, fmt.Errorf("redis: get idempotent response: %v: %w", err, ErrInternal)
+	}
+
+	var record idempotentResponseRecord
+	err = json.Unmarshal(data, &record)
+	if err != nil {
+		return domain.IdempotentResponse{}, fmt.Errorf("redis: get idempotent response: corrupted data: %v: %w", err, ErrInternal)
+	}
+
+	return domain.IdempotentResponse(record), nil
+}
+
+// Lock claims the key for the attempt of the request, failing with ErrKeyExists while another
+// attempt holds it.
+func (r *RedisIdempotency) Lock(ctx context.Context, req domain.IdempotentRequest) error {
+	locked, err := r.redisClient.SetNX(ctx, idempotencyLockPrefix+idempotencyScope(req), req.Attempt, r.lockTTL).Result()
+	if err != nil {
+		return fmt.Errorf("redis: lock idempotency key: %v: %w", err, ErrInternal)
+	}
+
+	if !locked {
+		return fmt.Errorf("redis: lock idempotency key: %w", ErrKeyExists)
+	}
+
+	return nil
+}
+
+// unlockIdempotencyScript deletes the lock KEYS[1] only while the attempt ARGV[1] holds it.
+var unlockIdempotencyScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// saveIdempotentResponseScript stores the response ARGV[1] at KEYS[1] for ARGV[2] milliseconds and
+// deletes the lock KEYS[2] only while the attempt ARGV[3] holds it.
+var saveIdempotentResponseScript = redis.NewScript(`
+redis.call("SET", KEYS[1], ARGV[1], "PX", ARGV[2])
+if redis.call("GET", KEYS[2]) == ARGV[3] then
+	redis.call("DEL", KEYS[2])
+end
+return 1
+`)
+
+// Unlock releases the key if the attempt of the request still holds it. A lock that expired and
+// was claimed by another attempt is left to that attempt.
+func (r *RedisIdempotency) Unlock(ctx context.Context, req domain.IdempotentRequest) error {
+	err := unlockIdempotencyScript.Run(ctx, r.redisClient, []string{idempotencyLockPrefix + idempotencyScope(req)}, req.Attempt).Err()
+	if err != nil {
+		return fmt.Errorf("redis: unlock idempotency key: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// SaveResponse stores the response and releases the lock at once, so whoever waits for the lock
+// finds the response as soon as the lock is gone. As with Unlock, the lock is only released if the
+// attempt of the request still holds it.
+func (r *RedisIdempotency) SaveResponse(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+	data, err := json.Marshal(idempotentResponseRecord(resp))
+	if err != nil {
+		return fmt.Errorf("redis: save idempotent response: marshal: %v: %w", err, ErrInternal)
+	}
+
+	scope := idempotencyScope(req)
+	err = saveIdempotentResponseScript.Run(
+		ctx, r.redisClient, []string{idempotencyResponsePrefix + scope, idempotencyLockPrefix + scope},
+		data, r.responseTTL.Milliseconds(), req.Attempt,
+	).Err()
+	if err != nil {
+		return fmt.Errorf("redis: save idempotent response: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
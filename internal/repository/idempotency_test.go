@@ -0,0 +1,197 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestIdempotencyRepository_Lock(t *testing.T) {
+	repo := idempotencyRepoPrelude(t)
+
+	ctx := context.Background()
+	req := testutil.ValidIdempotentRequest()
+
+	err := repo.Lock(ctx, req)
+	if err != nil {
+		t.Fatalf("Lock() error = %v, want nil", err)
+	}
+
+	err = repo.Lock(ctx, req)
+	if !errors.Is(err, repository.ErrKeyExists) {
+		t.Fatalf("Lock() second call error = %v, want ErrKeyExists", err)
+	}
+
+	anotherUserReq := req
+	anotherUserReq.UserID = domain.NewUserID()
+	err = repo.Lock(ctx, anotherUserReq)
+	if err != nil {
+		t.Fatalf("Lock() for another user error = %v, want nil", err)
+	}
+
+	anotherRouteReq := req
+	anotherRouteReq.Route = "POST /v1/boards"
+	err = repo.Lock(ctx, anotherRouteReq)
+	if err != nil {
+		t.Fatalf("Lock() for another route error = %v, want nil", err)
+	}
+
+	err = repo.Unlock(ctx, req)
+	if err != nil {
+		t.Fatalf("Unlock() error = %v, want nil", err)
+	}
+
+	err = repo.Lock(ctx, req)
+	if err != nil {
+		t.Fatalf("Lock() after Unlock() error = %v, want nil", err)
+	}
+}
+
+func TestIdempotencyRepository_SaveResponse(t *testing.T) {
+	repo := idempotencyRepoPrelude(t)
+
+	ctx := context.Background()
+	req := testutil.ValidIdempotentRequest()
+	resp := domain.IdempotentResponse{
+		Fingerprint: req.Fingerprint,
+		StatusCode:  http.StatusCreated,
+		Header:      map[string]string{"Content-Type": "application/json"},
+		Body:        []byte(`{"id":"task"}`),
+	}
+
+	_, err := repo.GetResponse(ctx, req)
+	if !errors.Is(err, repository.ErrKeyNotFound) {
+		t.Fatalf("GetResponse() error = %v, want ErrKeyNotFound", err)
+	}
+
+	err = repo.Lock(ctx, req)
+	if err != nil {
+		t.Fatalf("Lock() error = %v, want nil", err)
+	}
+
+	err = repo.SaveResponse(ctx, req, resp)
+	if err != nil {
+		t.Fatalf("SaveResponse() error = %v, want nil", err)
+	}
+
+	gotResp, err := repo.GetResponse(ctx, req)
+	if err != nil {
+		t.Fatalf("GetResponse() error = %v, want nil", err)
+	}
+	if diff := cmp.Diff(resp, gotResp); diff != "" {
+		t.Errorf("GetResponse() mismatch (-want +got):\n%s", diff)
+	}
+
+	// Saving releases the lock.
+	err = repo.Lock(ctx, req)
+	if err != nil {
+		t.Fatalf("Lock() after SaveResponse() error = %v, want nil", err)
+	}
+
+	anotherKey, err := domain.NewIdempotencyKey("another-key")
+	if err != nil {
+		t.Fatalf("NewIdempotencyKey() error = %v", err)
+	}
+	anotherKeyReq := req
+	anotherKeyReq.Key = anotherKey
+	_, err = repo.GetResponse(ctx, anotherKeyReq)
+	if !errors.Is(err, repository.ErrKeyNotFound) {
+		t.Fatalf("GetResponse() for another key error = %v, want ErrKeyNotFound", err)
+	}
+}
+
+func TestIdempotencyRepository_LockExpires(t *testing.T) {
+	repo := idempotencyRepoPrelude(t)
+
+	ctx := context.Background()
+	req := testutil.ValidIdempotentRequest()
+
+	err := repo.Lock(ctx, req)
+	if err != nil {
+		t.Fatalf("Lock() error = %v, want nil", err)
+	}
+
+	time.Sleep(1500 * time.Millisecond)
+
+	err = repo.Lock(ctx, req)
+	if err != nil {
+		t.Fatalf("Lock() after lock TTL error = %v, want nil", err)
+	}
+}
+
+func TestIdempotencyRepository_ExpiredLockKeepsNewOwner(t *testing.T) {
+	repo := idempotencyRepoPrelude(t)
+
+	ctx := context.Background()
+	staleReq := testutil.ValidIdempotentRequest()
+	retryReq := staleReq
+	retryReq.Attempt = "retry-attempt"
+	otherReq := staleReq
+	otherReq.Attempt = "other-attempt"
+
+	err := repo.Lock(ctx, staleReq)
+	if err != nil {
+		t.Fatalf("Lock() error = %v, want nil", err)
+	}
+
+	time.Sleep(1500 * time.Millisecond)
+
+	err = repo.Lock(ctx, retryReq)
+	if err != nil {
+		t.Fatalf("Lock() for retry after lock TTL error = %v, want nil", err)
+	}
+
+	// The attempt whose lock expired finishes late and must not free the lock of the retry.
+	err = repo.Unlock(ctx, staleReq)
+	if err != nil {
+		t.Fatalf("Unlock() for stale attempt error = %v, want nil", err)
+	}
+	err = repo.Lock(ctx, otherReq)
+	if !errors.Is(err, repository.ErrKeyExists) {
+		t.Fatalf("Lock() after stale Unlock() error = %v, want ErrKeyExists", err)
+	}
+
+	err = repo.SaveResponse(ctx, staleReq, domain.IdempotentResponse{Fingerprint: staleReq.Fingerprint, StatusCode: http.StatusCreated})
+	if err != nil {
+		t.Fatalf("SaveResponse() for stale attempt error = %v, want nil", err)
+	}
+	err = repo.Lock(ctx, otherReq)
+	if !errors.Is(err, repository.ErrKeyExists) {
+		t.Fatalf("Lock() after stale SaveResponse() error = %v, want ErrKeyExists", err)
+	}
+
+	err = repo.Unlock(ctx, retryReq)
+	if err != nil {
+		t.Fatalf("Unlock() for retry error = %v, want nil", err)
+	}
+	err = repo.Lock(ctx, otherReq)
+	if err != nil {
+		t.Fatalf("Lock() after retry Unlock() error = %v, want nil", err)
+	}
+}
+
+func idempotencyRepoPrelude(t *testing.T) *repository.RedisIdempotency {
+	t.Helper()
+
+	redisClient := testutil.SetupRedis(t)
+	testutil.FlushRedisDB(t, redisClient)
+	t.Cleanup(func() {
+		testutil.FlushRedisDB(t, redisClient)
+		err := redisClient.Close()
+		if err != nil {
+			t.Fatalf("Failed to close Redis client: %v", err)
+		}
+	})
+
+	return repository.NewRedisIdempotency(redisClient, time.Hour, time.Second)
+}
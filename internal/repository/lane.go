@@ -0,0 +1,464 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGLane struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGLane(pgPool *pgxpool.Pool) *PGLane {
+	return &PGLane{pgPool: pgPool}
+}
+
+func (r *PGLane) Create(ctx context.Context, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+	const (
+		lockBoardQuery = `
+		SELECT 1
+		FROM boards
+		WHERE id = @board_id
+		FOR UPDATE`
+		nextPositionQuery = `
+		SELECT COALESCE(MAX(position), 0) + 1
+		FROM lanes
+		WHERE board_id = @board_id`
+		insertLaneQuery = `
+		INSERT INTO lanes (board_id, name, position)
+		VALUES (@board_id, @name, @position)
+		RETURNING id, board_id, name, position, created_at, updated_at`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("lane repo: create begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var locked int
+	err = tx.QueryRow(ctx, lockBoardQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	}).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Lane{}, ErrRowNotFound
+		}
+		return domain.Lane{}, fmt.Errorf("lane repo: create lock board: %v: %w", err, ErrInternal)
+	}
+
+	var nextPosition int64
+	err = tx.QueryRow(ctx, nextPositionQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	}).Scan(&nextPosition)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("lane repo: create next position: %v: %w", err, ErrInternal)
+	}
+
+	lane, err := ScanLane(tx.QueryRow(ctx, insertLaneQuery, pgx.NamedArgs{
+		"board_id": boardID,
+		"name":     name,
+		"position": nextPosition,
+	}))
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("lane repo: create insert: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("lane repo: create commit: %v: %w", err, ErrInternal)
+	}
+
+	return lane, nil
+}
+
+func (r *PGLane) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error) {
+	return listLanesByBoardID(ctx, r.pgPool, boardID)
+}
+
+func listLanesByBoardID(ctx context.Context, q querier, boardID domain.BoardID) ([]domain.Lane, error) {
+	const query = `
+		SELECT id, board_id, name, position, created_at, updated_at
+		FROM lanes
+		WHERE board_id = $1
+		ORDER BY position ASC`
+
+	rows, err := q.Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("lane repo: list by board id: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var result []domain.Lane
+	for rows.Next() {
+		lane, scanErr := ScanLane(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("lane repo: list by board id: scan: %v: %w", scanErr, ErrInternal)
+		}
+		result = append(result, lane)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("lane repo: list by board id: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return result, nil
+}
+
+func (r *PGLane) Get(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+	const query = `
+		SELECT id, board_id, name, position, created_at, updated_at
+		FROM lanes
+		WHERE id = $1`
+
+	lane, err := ScanLane(r.pgPool.QueryRow(ctx, query, laneID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Lane{}, ErrRowNotFound
+		}
+		return domain.Lane{}, fmt.Errorf("lane repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return lane, nil
+}
+
+func (r *PGLane) Update(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error) {
+	const query = `
+		UPDATE lanes
+		SET
+			name = COALESCE($1, name),
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+		WHERE board_id = $2
+		  AND id = $3
+		RETURNING id, board_id, name, position, created_at, updated_at`
+
+	lane, err := ScanLane(r.pgPool.QueryRow(ctx, query, name, boardID, laneID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Lane{}, ErrRowNotFound
+		}
+		return domain.Lane{}, fmt.Errorf("lane repo: update: %v: %w", err, ErrInternal)
+	}
+
+	return lane, nil
+}
+
+func (r *PGLane) Move(
+	ctx context.Context,
+	boardID domain.BoardID,
+	laneID domain.LaneID,
+	targetPosition domain.LanePosition,
+) (domain.LanePosition, error) {
+	const (
+		// 1. Lock the board row so no concurrent operation can reorder lanes in the same board.
+		lockBoardQuery = `
+		SELECT 1
+		FROM boards
+		WHERE id = @board_id
+		FOR UPDATE`
+
+		// 2. Defer the unique constraint until COMMIT for this transaction only.
+		deferPositionConstraintQuery = `
+		SET CONSTRAINTS lanes_board_id_position_key DEFERRED`
+
+		// 3. Read the current position of the lane we are moving.
+		getCurrentPositionQuery = `
+		SELECT position
+		FROM lanes
+		WHERE board_id = @board_id
+		  AND id = @lane_id`
+
+		// 4. Read how many lanes the board currently has to validate targetPosition.
+		countLanesQuery = `
+		SELECT COUNT(*)
+		FROM lanes
+		WHERE board_id = @board_id`
+
+		// 5. If the moved lane goes down, shift neighbors from (current, target] one slot up.
+		moveNeighborsDownQuery = `
+		UPDATE lanes
+		SET position = position - 1
+		WHERE board_id = @board_id
+		  AND position > @current_position
+		  AND position <= @target_position`
+
+		// 5. If the moved lane goes up, shift neighbors from [target, current) one slot down.
+		moveNeighborsUpQuery = `
+		UPDATE lanes
+		SET position = position + 1
+		WHERE board_id = @board_id
+		  AND position >= @target_position
+		  AND position < @current_position`
+
+		// 6. Put the moved lane into targetPosition after neighbors have been shifted.
+		moveLaneIntoTargetQuery = `
+		UPDATE lanes
+		SET position = @target_position
+		WHERE board_id = @board_id
+		  AND id = @lane_id`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.LanePosition{}, fmt.Errorf("lane repo: move begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var locked int
+	err = tx.QueryRow(ctx, lockBoardQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	}).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.LanePosition{}, ErrRowNotFound
+		}
+		return domain.LanePosition{}, fmt.Errorf("lane repo: move lock board: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
+	if err != nil {
+		return domain.LanePosition{}, fmt.Errorf("lane repo: move defer position constraint: %v: %w", err, ErrInternal)
+	}
+
+	var currentPosition int64
+	err = tx.QueryRow(ctx, getCurrentPositionQuery, pgx.NamedArgs{
+		"board_id": boardID,
+		"lane_id":  laneID,
+	}).Scan(&currentPosition)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.LanePosition{}, ErrRowNotFound
+		}
+		return domain.LanePosition{}, fmt.Errorf("lane repo: move get current position: %v: %w", err, ErrInternal)
+	}
+
+	var lanesCount int64
+	err = tx.QueryRow(ctx, countLanesQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	}).Scan(&lanesCount)
+	if err != nil {
+		return domain.LanePosition{}, fmt.Errorf("lane repo: move count lanes: %v: %w", err, ErrInternal)
+	}
+
+	targetPositionInt := targetPosition.Int64()
+	if targetPositionInt > lanesCount {
+		return domain.LanePosition{}, ErrIndexOutOfBounds
+	}
+	if targetPositionInt == currentPosition {
+		return targetPosition, nil
+	}
+
+	moveNeighborsArgs := pgx.NamedArgs{
+		"board_id":         boardID,
+		"current_position": currentPosition,
+		"target_position":  targetPositionInt,
+	}
+	if currentPosition < targetPositionInt {
+		_, err = tx.Exec(ctx, moveNeighborsDownQuery, moveNeighborsArgs)
+		if err != nil {
+			return domain.LanePosition{}, fmt.Errorf("lane repo: move neighbors down: %v: %w", err, ErrInternal)
+		}
+	} else {
+		_, err = tx.Exec(ctx, moveNeighborsUpQuery, moveNeighborsArgs)
+		if err != nil {
+			return domain.LanePosition{}, fmt.Errorf("lane repo: move neighbors up: %v: %w", err, ErrInternal)
+		}
+	}
+
+	_, err = tx.Exec(ctx, moveLaneIntoTargetQuery, pgx.NamedArgs{
+		"board_id":        boardID,
+		"lane_id":         laneID,
+		"target_position": targetPosition,
+	})
+	if err != nil {
+		return domain.LanePosition{}, fmt.Errorf("lane repo: move lane into target: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.LanePosition{}, fmt.Errorf("lane repo: move commit: %v: %w", err, ErrInternal)
+	}
+
+	return targetPosition, nil
+}
+
+// Delete removes the lane. Its tasks are appended to the default lane of their columns,
+// keeping their relative order.
+func (r *PGLane) Delete(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error {
+	const (
+		// 1. Lock the board row so no concurrent operation can reorder lanes in the same board.
+		lockBoardQuery = `
+		SELECT 1
+		FROM boards
+		WHERE id = @board_id
+		FOR UPDATE`
+
+		// 3. Defer the unique constraints until COMMIT for this transaction only.
+		deferPositionConstraintsQuery = `
+		SET CONSTRAINTS lanes_board_id_position_key, tasks_cell_position_key DEFERRED`
+
+		// 4. Append the lane's tasks to the default lane of each column. Archived tasks keep
+		//    their positions, which are outside the sequence of the active ones.
+		moveTasksToDefaultLaneQuery = `
+		UPDATE tasks t
+		SET lane_id = NULL,
+		    position = COALESCE(moved.position, t.position),
+		    version = t.version + 1
+		FROM (
+			SELECT t.id,
+			       CASE WHEN t.archived_at IS NULL THEN
+			           ROW_NUMBER() OVER (PARTITION BY t.column_id, t.archived_at IS NULL ORDER BY t.position) + COALESCE((
+			               SELECT MAX(d.position)
+			               FROM tasks d
+			               WHERE d.column_id = t.column_id
+			                 AND d.lane_id IS NULL
+			                 AND d.archived_at IS NULL
+			           ), 0)
+			       END AS position
+			FROM tasks t
+			WHERE t.lane_id = @lane_id
+		) moved
+		WHERE t.id = moved.id`
+
+		// 5. Delete the lane and remember its position.
+		deleteLaneQuery = `
+		DELETE FROM lanes
+		WHERE board_id = @board_id
+		  AND id = @lane_id
+		RETURNING position`
+
+		// 6. Close the gap left by the deleted lane.
+		compactTrailingLanesQuery = `
+		UPDATE lanes
+		SET position = position - 1
+		WHERE board_id = @board_id
+		  AND position > @deleted_position`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("lane repo: delete begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var locked int
+	err = tx.QueryRow(ctx, lockBoardQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	}).Scan(&locked)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRowNotFound
+		}
+		return fmt.Errorf("lane repo: delete lock board: %v: %w", err, ErrInternal)
+	}
+
+	// 2. Every cell of the lane changes, so all columns of the board are locked the way task moves lock them.
+	columnIDs, err := listBoardColumnIDs(ctx, tx, boardID)
+	if err != nil {
+		return fmt.Errorf("lane repo: delete list board columns: %v: %w", err, ErrInternal)
+	}
+	if len(columnIDs) > 0 {
+		err = LockTaskColumns(ctx, tx, boardID, columnIDs...)
+		if err != nil {
+			return fmt.Errorf("lane repo: delete lock columns: %v: %w", err, ErrInternal)
+		}
+	}
+
+	_, err = tx.Exec(ctx, deferPositionConstraintsQuery)
+	if err != nil {
+		return fmt.Errorf("lane repo: delete defer position constraints: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, moveTasksToDefaultLaneQuery, pgx.NamedArgs{
+		"lane_id": laneID,
+	})
+	if err != nil {
+		return fmt.Errorf("lane repo: delete move tasks to default lane: %v: %w", err, ErrInternal)
+	}
+
+	var deletedPosition int64
+	err = tx.QueryRow(ctx, deleteLaneQuery, pgx.NamedArgs{
+		"board_id": boardID,
+		"lane_id":  laneID,
+	}).Scan(&deletedPosition)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRowNotFound
+		}
+		return fmt.Errorf("lane repo: delete lane: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, compactTrailingLanesQuery, pgx.NamedArgs{
+		"board_id":         boardID,
+		"deleted_position": deletedPosition,
+	})
+	if err != nil {
+		return fmt.Errorf("lane repo: delete compact trailing lanes: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("lane repo: delete commit: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+func ScanLane(row interface{ Scan(...any) error }) (domain.Lane, error) {
+	var (
+		rawID      uuid.UUID
+		rawBoardID uuid.UUID
+		rawName    string
+		rawPos     int64
+		createdAt  time.Time
+		updatedAt  time.Time
+	)
+	err := row.Scan(&rawID, &rawBoardID, &rawName, &rawPos, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("scan lane: %w", err)
+	}
+	id, err := domain.NewLaneIDFromUUID(rawID)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("scan lane: id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("scan lane: board id: %v: %w", err, errDataCorrupted)
+	}
+	name, err := domain.NewLaneName(rawName)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("scan lane: name: %v: %w", err, errDataCorrupted)
+	}
+	pos, err := domain.NewLanePosition(rawPos)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("scan lane: position: %v: %w", err, errDataCorrupted)
+	}
+	return domain.Lane{
+		ID:        id,
+		BoardID:   boardID,
+		Name:      name,
+		Position:  pos,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}, nil
+}
+
+// NullLaneID maps a nil lane ID, the default lane, to SQL NULL.
+func NullLaneID(id domain.LaneID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id.UUID(), Valid: !id.IsNil()}
+}
@@ -0,0 +1,177 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestLaneRepository_Create(t *testing.T) {
+	pool, r := laneRepoPrelude(t)
+
+	t.Run("Appends position", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+
+		first, err := r.Create(context.Background(), board.ID, testutil.ValidLane(board.ID).Name)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		second, err := r.Create(context.Background(), board.ID, testutil.NewValidLane(t, board.ID, "Support", 1).Name)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		if first.ID.IsNil() {
+			t.Error("got empty lane id, want generated id")
+		}
+		if first.BoardID != board.ID {
+			t.Errorf("got boardID %q, want %q", first.BoardID, board.ID)
+		}
+		if first.Position.Int64() != 1 {
+			t.Errorf("got first position %d, want 1", first.Position.Int64())
+		}
+		if second.Position.Int64() != 2 {
+			t.Errorf("got second position %d, want 2", second.Position.Int64())
+		}
+		AssertTimestampPrecisionAtLeastMillis(t, pool, "lanes", "created_at", "updated_at")
+	})
+
+	t.Run("Board not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, err := r.Create(context.Background(), domain.NewBoardID(), testutil.ValidLane(domain.NewBoardID()).Name)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestLaneRepository_Move(t *testing.T) {
+	pool, r := laneRepoPrelude(t)
+
+	t.Run("Success move up", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		first := testutil.ValidLane(board.ID)
+		second := testutil.NewValidLane(t, board.ID, "Support", 2)
+		third := testutil.NewValidLane(t, board.ID, "Expedite", 3)
+		CreateLane(t, pool, &first)
+		CreateLane(t, pool, &second)
+		CreateLane(t, pool, &third)
+
+		targetPosition := testutil.NewValidLane(t, board.ID, "unused", 1).Position
+		position, err := r.Move(context.Background(), board.ID, third.ID, targetPosition)
+		if err != nil {
+			t.Fatalf("Move() error = %v", err)
+		}
+		if position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", position, targetPosition)
+		}
+
+		got, err := r.ListByBoardID(context.Background(), board.ID)
+		if err != nil {
+			t.Fatalf("ListByBoardID() error = %v", err)
+		}
+		wantIDs := []domain.LaneID{third.ID, first.ID, second.ID}
+		if len(got) != len(wantIDs) {
+			t.Fatalf("got %d lanes, want %d", len(got), len(wantIDs))
+		}
+		for i, lane := range got {
+			if lane.ID != wantIDs[i] || lane.Position.Int64() != int64(i+1) {
+				t.Errorf("got lane %d = (%v, %d), want (%v, %d)", i, lane.ID, lane.Position.Int64(), wantIDs[i], i+1)
+			}
+		}
+	})
+
+	t.Run("Index out of bounds", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		lane := testutil.ValidLane(board.ID)
+		CreateLane(t, pool, &lane)
+
+		targetPosition := testutil.NewValidLane(t, board.ID, "unused", 2).Position
+		_, err := r.Move(context.Background(), board.ID, lane.ID, targetPosition)
+		if !errors.Is(err, repository.ErrIndexOutOfBounds) {
+			t.Fatalf("Move() error = %v, want ErrIndexOutOfBounds", err)
+		}
+	})
+}
+
+func TestLaneRepository_Delete(t *testing.T) {
+	pool, r := laneRepoPrelude(t)
+
+	t.Run("Moves tasks to the default lane and compacts positions", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		removed := testutil.ValidLane(board.ID)
+		kept := testutil.NewValidLane(t, board.ID, "Support", 2)
+		CreateLane(t, pool, &removed)
+		CreateLane(t, pool, &kept)
+
+		defaultTask := testutil.ValidTask(column.ID)
+		laneFirst := testutil.NewValidTask(t, column.ID, "Lane first", "first", 1)
+		laneFirst.LaneID = removed.ID
+		laneSecond := testutil.NewValidTask(t, column.ID, "Lane second", "second", 2)
+		laneSecond.LaneID = removed.ID
+		CreateTask(t, pool, &defaultTask)
+		CreateTask(t, pool, &laneFirst)
+		CreateTask(t, pool, &laneSecond)
+
+		err := r.Delete(context.Background(), board.ID, removed.ID)
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		got := ListTasksByColumnID(t, pool, column.ID)
+		if len(got) != 3 {
+			t.Fatalf("got %d tasks after delete, want 3", len(got))
+		}
+		for i := range got {
+			if !got[i].LaneID.IsNil() {
+				t.Errorf("got task %v in lane %v, want default lane", got[i].ID, got[i].LaneID)
+			}
+		}
+		assertTaskIDAndPosition(t, &got[0], defaultTask.ID, 1)
+		assertTaskIDAndPosition(t, &got[1], laneFirst.ID, 2)
+		assertTaskIDAndPosition(t, &got[2], laneSecond.ID, 3)
+
+		lanes, err := r.ListByBoardID(context.Background(), board.ID)
+		if err != nil {
+			t.Fatalf("ListByBoardID() error = %v", err)
+		}
+		if len(lanes) != 1 || lanes[0].ID != kept.ID || lanes[0].Position.Int64() != 1 {
+			t.Fatalf("got lanes %v, want only %v at position 1", lanes, kept.ID)
+		}
+	})
+
+	t.Run("Lane of another board", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		lane := testutil.ValidLane(board.ID)
+		CreateLane(t, pool, &lane)
+
+		err := r.Delete(context.Background(), domain.NewBoardID(), lane.ID)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func laneRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGLane) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGLane(pool)
+}
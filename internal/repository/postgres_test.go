@@ -10,6 +10,7 @@ import (
 	"goroutine/internal/repository"
 	"goroutine/internal/testutil"
 
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -41,8 +42,8 @@ func CreateBoard(t *testing.T, pool *pgxpool.Pool, board *domain.Board) {
 	defer cancel()
 
 	const query = `
-			INSERT INTO boards (id, owner_id, name, description, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6)`
+			INSERT INTO boards (id, owner_id, name, description, created_at, updated_at, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7)`
 	_, err := pool.Exec(
 		ctx, query,
 		board.ID,
@@ -51,6 +52,7 @@ func CreateBoard(t *testing.T, pool *pgxpool.Pool, board *domain.Board) {
 		board.Description,
 		board.CreatedAt,
 		board.UpdatedAt,
+		initialVersion(board.Version),
 	)
 	if err != nil {
 		t.Fatalf("CreateBoard() error = %v", err)
@@ -64,7 +66,7 @@ func ListBoards(t *testing.T, pool *pgxpool.Pool) []domain.Board {
 	defer cancel()
 
 	const query = `
-			SELECT id, owner_id, name, description, created_at, updated_at
+			SELECT id, owner_id, name, description, created_at, updated_at, version
 			FROM boards
 			ORDER BY created_at ASC`
 
@@ -98,8 +100,8 @@ func CreateColumn(t *testing.T, pool *pgxpool.Pool, column *domain.Column) {
 	defer cancel()
 
 	const query = `
-			INSERT INTO columns (id, board_id, name, description, position, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7)`
+			INSERT INTO columns (id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles, created_at, updated_at, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16)`
 	_, err := pool.Exec(
 		ctx, query,
 		column.ID,
@@ -107,8 +109,17 @@ func CreateColumn(t *testing.T, pool *pgxpool.Pool, column *domain.Column) {
 		column.Name,
 		column.Description,
 		column.Position,
+		column.WIPLimit,
+		column.SLA,
+		column.ArchiveAfter,
+		column.IsStarted,
+		column.IsDone,
+		column.Transitions,
+		column.EntryConditions,
+		column.EntryRoles,
 		column.CreatedAt,
 		column.UpdatedAt,
+		initialVersion(column.Version),
 	)
 	if err != nil {
 		t.Fatalf("CreateColumn() error = %v", err)
@@ -122,7 +133,7 @@ func ListColumnsByBoardID(t *testing.T, pool *pgxpool.Pool, boardID domain.Board
 	defer cancel()
 
 	const query = `
-			SELECT id, board_id, name, description, position, created_at, updated_at
+			SELECT id, board_id, name, description, position, wip_limit, sla_hours, archive_after_days, is_started, is_done, allowed_transitions, entry_conditions, entry_roles, created_at, updated_at, version
 			FROM columns
 			WHERE board_id = $1
 			ORDER BY position ASC`
@@ -151,6 +162,29 @@ func ListColumnsByBoardID(t *testing.T, pool *pgxpool.Pool, boardID domain.Board
 	return columns
 }
 
+func CreateLane(t *testing.T, pool *pgxpool.Pool, lane *domain.Lane) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	const query = `
+			INSERT INTO lanes (id, board_id, name, position, created_at, updated_at)
+			VALUES ($1, $2, $3, $4, $5, $6)`
+	_, err := pool.Exec(
+		ctx, query,
+		lane.ID,
+		lane.BoardID,
+		lane.Name.String(),
+		lane.Position,
+		lane.CreatedAt,
+		lane.UpdatedAt,
+	)
+	if err != nil {
+		t.Fatalf("CreateLane() error = %v", err)
+	}
+}
+
 func CreateTask(t *testing.T, pool *pgxpool.Pool, task *domain.Task) {
 	t.Helper()
 
@@ -158,17 +192,25 @@ func CreateTask(t *testing.T, pool *pgxpool.Pool, task *domain.Task) {
 	defer cancel()
 
 	const query = `
-			INSERT INTO tasks (id, column_id, name, description, position, created_at, updated_at)
-			VALUES ($1, $2, $3, $4, $5, $6, $7)`
+			INSERT INTO tasks (id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version)
+			VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15)`
 	_, err := pool.Exec(
 		ctx, query,
 		task.ID,
 		task.ColumnID,
+		repository.NullLaneID(task.LaneID),
+		repository.NullTaskID(task.ParentID),
+		repository.NullSprintID(task.SprintID),
 		task.Name,
 		task.Description,
 		task.Position,
+		task.Checklist,
+		task.Estimate,
+		task.CustomFields,
+		nullTime(task.ArchivedAt),
 		task.CreatedAt,
 		task.UpdatedAt,
+		initialVersion(task.Version),
 	)
 	if err != nil {
 		t.Fatalf("CreateTask() error = %v", err)
@@ -182,10 +224,10 @@ func ListTasksByColumnID(t *testing.T, pool *pgxpool.Pool, columnID domain.Colum
 	defer cancel()
 
 	const query = `
-			SELECT id, column_id, name, description, position, created_at, updated_at
+			SELECT id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version
 			FROM tasks
 			WHERE column_id = $1
-			ORDER BY position ASC`
+			ORDER BY archived_at NULLS FIRST, position ASC`
 
 	rows, err := pool.Query(ctx, query, columnID)
 	if err != nil {
@@ -211,6 +253,30 @@ func ListTasksByColumnID(t *testing.T, pool *pgxpool.Pool, columnID domain.Colum
 	return tasks
 }
 
+func CreateBuiltInBoardTemplate(t *testing.T, pool *pgxpool.Pool, name, columnsJSON string) domain.BoardTemplateID {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	const query = `
+			INSERT INTO board_templates (owner_id, name, columns)
+			VALUES (NULL, $1, $2::jsonb)
+			RETURNING id`
+	var rawID uuid.UUID
+	err := pool.QueryRow(ctx, query, name, columnsJSON).Scan(&rawID)
+	if err != nil {
+		t.Fatalf("CreateBuiltInBoardTemplate() error = %v", err)
+	}
+
+	id, err := domain.NewBoardTemplateIDFromUUID(rawID)
+	if err != nil {
+		t.Fatalf("NewBoardTemplateIDFromUUID() error = %v", err)
+	}
+
+	return id
+}
+
 func insertFixedUserAndBoard(t *testing.T, pool *pgxpool.Pool) domain.Board {
 	t.Helper()
 
@@ -294,3 +360,19 @@ func assertErrRowNotFound(t *testing.T, err error) {
 		t.Errorf("got error %v, want ErrRowNotFound", err)
 	}
 }
+
+// nullTime maps the zero time to SQL NULL.
+func nullTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+// initialVersion stores the zero version as 1, the version of a new row.
+func initialVersion(v domain.Version) int64 {
+	if !v.IsSet() {
+		return 1
+	}
+	return v.Int64()
+}
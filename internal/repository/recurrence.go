@@ -0,0 +1,282 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const recurrenceColumns = `id, board_id, column_id, name, description, rrule, starts_at, catch_up, next_run_at, created_at, updated_at`
+
+type PGRecurrence struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGRecurrence(pgPool *pgxpool.Pool) *PGRecurrence {
+	return &PGRecurrence{pgPool: pgPool}
+}
+
+func (r *PGRecurrence) Create(ctx context.Context, recurrence domain.Recurrence) (domain.Recurrence, error) {
+	const query = `
+		INSERT INTO recurrences (board_id, column_id, name, description, rrule, starts_at, catch_up, next_run_at)
+		VALUES (@board_id, @column_id, @name, @description, @rrule, @starts_at, @catch_up, @next_run_at)
+		RETURNING ` + recurrenceColumns
+
+	created, err := ScanRecurrence(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"board_id":    recurrence.BoardID,
+		"column_id":   recurrence.ColumnID,
+		"name":        recurrence.Name,
+		"description": recurrence.Description,
+		"rrule":       recurrence.Rule,
+		"starts_at":   recurrence.StartsAt,
+		"catch_up":    recurrence.CatchUp.String(),
+		"next_run_at": recurrence.NextRunAt,
+	}))
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("recurrence repo: create: %v: %w", err, ErrInternal)
+	}
+
+	return created, nil
+}
+
+func (r *PGRecurrence) Get(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error) {
+	const query = `SELECT ` + recurrenceColumns + ` FROM recurrences WHERE id = $1`
+
+	recurrence, err := ScanRecurrence(r.pgPool.QueryRow(ctx, query, recurrenceID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Recurrence{}, ErrRowNotFound
+		}
+		return domain.Recurrence{}, fmt.Errorf("recurrence repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return recurrence, nil
+}
+
+func (r *PGRecurrence) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Recurrence, error) {
+	const query = `
+		SELECT ` + recurrenceColumns + `
+		FROM recurrences
+		WHERE board_id = $1
+		ORDER BY created_at ASC, id ASC`
+
+	return r.list(ctx, "list by board id", query, boardID)
+}
+
+// ListDue returns up to limit recurrences whose next run is at or before now, oldest first.
+func (r *PGRecurrence) ListDue(ctx context.Context, now time.Time, limit int) ([]domain.Recurrence, error) {
+	const query = `
+		SELECT ` + recurrenceColumns + `
+		FROM recurrences
+		WHERE next_run_at <= $1
+		ORDER BY next_run_at ASC, id ASC
+		LIMIT $2`
+
+	return r.list(ctx, "list due", query, now, limit)
+}
+
+func (r *PGRecurrence) list(ctx context.Context, op, query string, args ...any) ([]domain.Recurrence, error) {
+	rows, err := r.pgPool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("recurrence repo: %s: %v: %w", op, err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var recurrences []domain.Recurrence
+	for rows.Next() {
+		recurrence, scanErr := ScanRecurrence(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("recurrence repo: %s: scan: %v: %w", op, scanErr, ErrInternal)
+		}
+
+		recurrences = append(recurrences, recurrence)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("recurrence repo: %s: rows final error: %v: %w", op, err, ErrInternal)
+	}
+
+	return recurrences, nil
+}
+
+// Update overwrites the mutable fields of recurrence, matched by board and id. NextRunAt is
+// only written when reschedule is set, so an update does not undo a concurrent Advance.
+func (r *PGRecurrence) Update(ctx context.Context, recurrence domain.Recurrence, reschedule bool) (domain.Recurrence, error) {
+	const query = `
+		UPDATE recurrences
+		SET
+			column_id = @column_id,
+			name = @name,
+			description = @description,
+			rrule = @rrule,
+			starts_at = @starts_at,
+			catch_up = @catch_up,
+			next_run_at = CASE WHEN @reschedule::boolean THEN @next_run_at ELSE next_run_at END,
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+		WHERE board_id = @board_id
+		  AND id = @id
+		RETURNING ` + recurrenceColumns
+
+	updated, err := ScanRecurrence(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"id":          recurrence.ID,
+		"board_id":    recurrence.BoardID,
+		"column_id":   recurrence.ColumnID,
+		"name":        recurrence.Name,
+		"description": recurrence.Description,
+		"rrule":       recurrence.Rule,
+		"starts_at":   recurrence.StartsAt,
+		"catch_up":    recurrence.CatchUp.String(),
+		"next_run_at": recurrence.NextRunAt,
+		"reschedule":  reschedule,
+	}))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Recurrence{}, ErrRowNotFound
+		}
+		return domain.Recurrence{}, fmt.Errorf("recurrence repo: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+func (r *PGRecurrence) Delete(ctx context.Context, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error {
+	const query = `DELETE FROM recurrences WHERE board_id = $1 AND id = $2`
+
+	cmd, err := r.pgPool.Exec(ctx, query, boardID, recurrenceID)
+	if err != nil {
+		return fmt.Errorf("recurrence repo: delete: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+// Advance moves the recurrence from its current NextRunAt to nextRunAt and, if materialize
+// is set, creates the task in the same transaction. The move is a compare-and-set on
+// next_run_at, so when several replicas race on the same occurrence exactly one wins
+// and the others get ErrRowNotFound. The returned task is zero when nothing was created.
+func (r *PGRecurrence) Advance(
+	ctx context.Context,
+	recurrence domain.Recurrence,
+	nextRunAt time.Time,
+	materialize bool,
+) (domain.Task, error) {
+	const advanceQuery = `
+		UPDATE recurrences
+		SET next_run_at = @next_run_at
+		WHERE id = @id
+		  AND next_run_at = @expected_next_run_at`
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("recurrence repo: advance begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	cmd, err := tx.Exec(ctx, advanceQuery, pgx.NamedArgs{
+		"id":                   recurrence.ID,
+		"next_run_at":          nextRunAt,
+		"expected_next_run_at": recurrence.NextRunAt,
+	})
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("recurrence repo: advance: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return domain.Task{}, ErrRowNotFound
+	}
+
+	var task domain.Task
+	if materialize {
+		task, err = insertTask(ctx, tx, recurrence.ColumnID, domain.LaneID{}, domain.TaskID{}, recurrence.Name, recurrence.Description, domain.TaskChecklist{}, domain.TaskEstimate{}, domain.TaskCustomFields{})
+		if err != nil {
+			// The column FK cascades to recurrences, so a missing column means a concurrent delete.
+			if errors.Is(err, ErrRowNotFound) {
+				return domain.Task{}, ErrRowNotFound
+			}
+			return domain.Task{}, fmt.Errorf("recurrence repo: advance: create task: %v: %w", err, ErrInternal)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("recurrence repo: advance commit: %v: %w", err, ErrInternal)
+	}
+
+	return task, nil
+}
+
+func ScanRecurrence(row interface{ Scan(...any) error }) (domain.Recurrence, error) {
+	var (
+		rawID          uuid.UUID
+		rawBoardID     uuid.UUID
+		rawColumnID    uuid.UUID
+		rawName        string
+		rawDescription string
+		rawRule        string
+		startsAt       time.Time
+		rawCatchUp     string
+		nextRunAt      time.Time
+		createdAt      time.Time
+		updatedAt      time.Time
+	)
+	err := row.Scan(
+		&rawID, &rawBoardID, &rawColumnID, &rawName, &rawDescription, &rawRule,
+		&startsAt, &rawCatchUp, &nextRunAt, &createdAt, &updatedAt,
+	)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: %w", err)
+	}
+	id, err := domain.NewRecurrenceIDFromUUID(rawID)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: board id: %v: %w", err, errDataCorrupted)
+	}
+	columnID, err := domain.NewColumnIDFromUUID(rawColumnID)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: column id: %v: %w", err, errDataCorrupted)
+	}
+	name, err := domain.NewTaskName(rawName)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: name: %v: %w", err, errDataCorrupted)
+	}
+	description, err := domain.NewTaskDescription(rawDescription)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: description: %v: %w", err, errDataCorrupted)
+	}
+	rule, err := domain.NewRecurrenceRule(rawRule)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: rule: %v: %w", err, errDataCorrupted)
+	}
+	catchUp, err := domain.NewRecurrenceCatchUp(rawCatchUp)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("scan recurrence: catch up: %v: %w", err, errDataCorrupted)
+	}
+	return domain.Recurrence{
+		ID:          id,
+		BoardID:     boardID,
+		ColumnID:    columnID,
+		Name:        name,
+		Description: description,
+		Rule:        rule,
+		StartsAt:    startsAt,
+		CatchUp:     catchUp,
+		NextRunAt:   nextRunAt,
+		CreatedAt:   createdAt,
+		UpdatedAt:   updatedAt,
+	}, nil
+}
@@ -0,0 +1,237 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestRecurrenceRepository_Create(t *testing.T) {
+	pool, r := recurrenceRepoPrelude(t)
+
+	t.Run("Success", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		want := testutil.ValidRecurrence(board.ID, column.ID)
+
+		created, err := r.Create(context.Background(), want)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		if created.ID.IsNil() {
+			t.Errorf("got empty recurrence ID, want generated ID")
+		}
+		if created.Rule != want.Rule {
+			t.Errorf("got rule %v, want %v", created.Rule, want.Rule)
+		}
+		if !created.StartsAt.Equal(want.StartsAt) || !created.NextRunAt.Equal(want.NextRunAt) {
+			t.Errorf("got startsAt=%v nextRunAt=%v, want %v %v", created.StartsAt, created.NextRunAt, want.StartsAt, want.NextRunAt)
+		}
+		AssertTimestampPrecisionAtLeastMillis(t, pool, "recurrences", "created_at", "updated_at")
+
+		stored, err := r.Get(context.Background(), created.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if diff := cmp.Diff(created, stored, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("stored recurrence mismatch (-returned +stored):\n%s", diff)
+		}
+	})
+}
+
+func TestRecurrenceRepository_ListDue(t *testing.T) {
+	pool, r := recurrenceRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	now := time.Now().UTC().Truncate(time.Second)
+
+	due := testutil.ValidRecurrence(board.ID, column.ID)
+	due.NextRunAt = now.Add(-time.Minute)
+	due, err := r.Create(context.Background(), due)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	notDue := testutil.ValidRecurrence(board.ID, column.ID)
+	notDue.NextRunAt = now.Add(time.Minute)
+	_, err = r.Create(context.Background(), notDue)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	got, err := r.ListDue(context.Background(), now, 10)
+	if err != nil {
+		t.Fatalf("ListDue() error = %v", err)
+	}
+	if diff := cmp.Diff([]domain.Recurrence{due}, got, testutil.CmpAllowUnexported()); diff != "" {
+		t.Errorf("got due recurrences mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestRecurrenceRepository_Update(t *testing.T) {
+	pool, r := recurrenceRepoPrelude(t)
+
+	t.Run("Success keeps next run without reschedule", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		created, err := r.Create(context.Background(), testutil.ValidRecurrence(board.ID, column.ID))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		patched := created
+		patched.Name, _ = domain.NewTaskName("Renamed")
+		patched.NextRunAt = created.NextRunAt.Add(time.Hour)
+
+		updated, err := r.Update(context.Background(), patched, false)
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if updated.Name != patched.Name {
+			t.Errorf("got name %q, want %q", updated.Name, patched.Name)
+		}
+		if !updated.NextRunAt.Equal(created.NextRunAt) {
+			t.Errorf("got next run %v, want unchanged %v", updated.NextRunAt, created.NextRunAt)
+		}
+
+		updated, err = r.Update(context.Background(), patched, true)
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if !updated.NextRunAt.Equal(patched.NextRunAt) {
+			t.Errorf("got next run %v, want %v", updated.NextRunAt, patched.NextRunAt)
+		}
+	})
+
+	t.Run("Not found by board id", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		created, err := r.Create(context.Background(), testutil.ValidRecurrence(board.ID, column.ID))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		created.BoardID = domain.NewBoardID()
+		_, err = r.Update(context.Background(), created, false)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestRecurrenceRepository_Advance(t *testing.T) {
+	pool, r := recurrenceRepoPrelude(t)
+
+	t.Run("Success materializes task once", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		existing := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &existing)
+
+		created, err := r.Create(context.Background(), testutil.ValidRecurrence(board.ID, column.ID))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		nextRunAt := created.NextRunAt.AddDate(0, 0, 7)
+
+		task, err := r.Advance(context.Background(), created, nextRunAt, true)
+		if err != nil {
+			t.Fatalf("Advance() error = %v", err)
+		}
+		if task.Name != created.Name || task.ColumnID != column.ID {
+			t.Errorf("got task %q in %v, want %q in %v", task.Name, task.ColumnID, created.Name, column.ID)
+		}
+		if task.Position.Int64() != 2 {
+			t.Errorf("got position %d, want 2", task.Position.Int64())
+		}
+
+		// A second replica holding the same snapshot loses the compare-and-set.
+		_, err = r.Advance(context.Background(), created, nextRunAt, true)
+		assertErrRowNotFound(t, err)
+
+		if got := ListTasksByColumnID(t, pool, column.ID); len(got) != 2 {
+			t.Errorf("got %d tasks, want 2", len(got))
+		}
+		stored, err := r.Get(context.Background(), created.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if !stored.NextRunAt.Equal(nextRunAt) {
+			t.Errorf("got next run %v, want %v", stored.NextRunAt, nextRunAt)
+		}
+	})
+
+	t.Run("Success skip does not create task", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		created, err := r.Create(context.Background(), testutil.ValidRecurrence(board.ID, column.ID))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		task, err := r.Advance(context.Background(), created, created.NextRunAt.AddDate(0, 0, 7), false)
+		if err != nil {
+			t.Fatalf("Advance() error = %v", err)
+		}
+		if !task.ID.IsNil() {
+			t.Errorf("got task %v, want none", task.ID)
+		}
+		if got := ListTasksByColumnID(t, pool, column.ID); len(got) != 0 {
+			t.Errorf("got %d tasks, want 0", len(got))
+		}
+	})
+}
+
+func TestRecurrenceRepository_Delete(t *testing.T) {
+	pool, r := recurrenceRepoPrelude(t)
+
+	t.Run("Success", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		created, err := r.Create(context.Background(), testutil.ValidRecurrence(board.ID, column.ID))
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		err = r.Delete(context.Background(), board.ID, created.ID)
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		_, err = r.Get(context.Background(), created.ID)
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+
+		err := r.Delete(context.Background(), board.ID, domain.NewRecurrenceID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func recurrenceRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGRecurrence) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGRecurrence(pool)
+}
@@ -0,0 +1,600 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const sprintColumns = `id, board_id, name, goal, start_date, end_date, completed_at, created_at, updated_at`
+
+type PGSprint struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGSprint(pgPool *pgxpool.Pool) *PGSprint {
+	return &PGSprint{pgPool: pgPool}
+}
+
+func (r *PGSprint) Create(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+	const query = `
+		INSERT INTO sprints (board_id, name, goal, start_date, end_date)
+		VALUES (@board_id, @name, @goal, @start_date, @end_date)
+		RETURNING ` + sprintColumns
+
+	created, err := ScanSprint(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"board_id":   sprint.BoardID,
+		"name":       sprint.Name.String(),
+		"goal":       sprint.Goal.String(),
+		"start_date": sprint.StartDate,
+		"end_date":   sprint.EndDate,
+	}))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return domain.Sprint{}, ErrRowNotFound
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint repo: create: %v: %w", err, ErrInternal)
+	}
+
+	return created, nil
+}
+
+func (r *PGSprint) Get(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+	const query = `SELECT ` + sprintColumns + ` FROM sprints WHERE id = $1`
+
+	sprint, err := ScanSprint(r.pgPool.QueryRow(ctx, query, sprintID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Sprint{}, ErrRowNotFound
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return sprint, nil
+}
+
+func (r *PGSprint) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Sprint, error) {
+	return listSprintsByBoardID(ctx, r.pgPool, boardID)
+}
+
+func listSprintsByBoardID(ctx context.Context, q querier, boardID domain.BoardID) ([]domain.Sprint, error) {
+	const query = `
+		SELECT ` + sprintColumns + `
+		FROM sprints
+		WHERE board_id = $1
+		ORDER BY start_date ASC, id ASC`
+
+	rows, err := q.Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("sprint repo: list by board id: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var sprints []domain.Sprint
+	for rows.Next() {
+		sprint, scanErr := ScanSprint(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("sprint repo: list by board id: scan: %v: %w", scanErr, ErrInternal)
+		}
+
+		sprints = append(sprints, sprint)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("sprint repo: list by board id: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return sprints, nil
+}
+
+// Update overwrites the mutable fields of an open sprint, matched by board and id. It returns
+// ErrCompleted if the sprint was completed in the meantime.
+func (r *PGSprint) Update(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+	const query = `
+		UPDATE sprints
+		SET
+			name = @name,
+			goal = @goal,
+			start_date = @start_date,
+			end_date = @end_date,
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+		WHERE board_id = @board_id
+		  AND id = @id
+		  AND completed_at IS NULL
+		RETURNING ` + sprintColumns
+
+	updated, err := ScanSprint(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"id":         sprint.ID,
+		"board_id":   sprint.BoardID,
+		"name":       sprint.Name.String(),
+		"goal":       sprint.Goal.String(),
+		"start_date": sprint.StartDate,
+		"end_date":   sprint.EndDate,
+	}))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Sprint{}, r.missingOrCompleted(ctx, "update", sprint.BoardID, sprint.ID)
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint repo: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+// Delete removes the sprint; its tasks fall back to the backlog through ON DELETE SET NULL.
+func (r *PGSprint) Delete(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID) error {
+	const query = `DELETE FROM sprints WHERE board_id = $1 AND id = $2`
+
+	cmd, err := r.pgPool.Exec(ctx, query, boardID, sprintID)
+	if err != nil {
+		return fmt.Errorf("sprint repo: delete: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+// AddTask moves a task of the board into the sprint, taking it from the backlog or from
+// another sprint. The sprint row is share-locked so that the task cannot slip in while
+// Complete is taking the snapshot. It returns ErrRowNotFound if the sprint or the task is
+// not on the board and ErrCompleted if the sprint is already completed.
+func (r *PGSprint) AddTask(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+	const (
+		lockSprintQuery = `
+		SELECT completed_at IS NOT NULL
+		FROM sprints
+		WHERE board_id = @board_id
+		  AND id = @sprint_id
+		FOR SHARE`
+		updateTaskQuery = `
+		UPDATE tasks t
+		SET sprint_id = @sprint_id,
+		    version = t.version + 1
+		FROM columns c
+		WHERE t.id = @task_id
+		  AND c.id = t.column_id
+		  AND c.board_id = @board_id`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("sprint repo: add task begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var completed bool
+	err = tx.QueryRow(ctx, lockSprintQuery, pgx.NamedArgs{
+		"board_id":  boardID,
+		"sprint_id": sprintID,
+	}).Scan(&completed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRowNotFound
+		}
+		return fmt.Errorf("sprint repo: add task lock sprint: %v: %w", err, ErrInternal)
+	}
+	if completed {
+		return ErrCompleted
+	}
+
+	cmd, err := tx.Exec(ctx, updateTaskQuery, pgx.NamedArgs{
+		"board_id":  boardID,
+		"sprint_id": sprintID,
+		"task_id":   taskID,
+	})
+	if err != nil {
+		return fmt.Errorf("sprint repo: add task update: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("sprint repo: add task commit: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// RemoveTask returns a task of an open sprint to the backlog. It returns ErrRowNotFound if
+// the task is not in the sprint and ErrCompleted if the sprint is already completed.
+func (r *PGSprint) RemoveTask(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+	const query = `
+		UPDATE tasks
+		SET sprint_id = NULL,
+		    version = version + 1
+		WHERE id = @task_id
+		  AND sprint_id = (
+			SELECT id
+			FROM sprints
+			WHERE board_id = @board_id
+			  AND id = @sprint_id
+			  AND completed_at IS NULL
+			FOR SHARE
+		  )`
+
+	cmd, err := r.pgPool.Exec(ctx, query, pgx.NamedArgs{
+		"board_id":  boardID,
+		"sprint_id": sprintID,
+		"task_id":   taskID,
+	})
+	if err != nil {
+		return fmt.Errorf("sprint repo: remove task: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return r.missingOrCompleted(ctx, "remove task", boardID, sprintID)
+	}
+
+	return nil
+}
+
+// Complete closes the sprint and records its report in one transaction:
+//  1. Lock the sprint, so concurrent completes and AddTask calls serialize on it.
+//  2. With toNextSprint, pick the open sprint that starts next and share-lock it.
+//  3. Count committed and completed tasks.
+//  4. Copy the unfinished tasks into the carry-over list in board order.
+//  5. Move the unfinished tasks to the next sprint, or to the backlog if there is none.
+//  6. Store the snapshot on the sprint.
+//
+// Tasks in a done column stay in the sprint as its history. It returns ErrRowNotFound if
+// the sprint is not on the board and ErrCompleted if it was completed before.
+func (r *PGSprint) Complete(
+	ctx context.Context,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+	toNextSprint bool,
+) (domain.SprintReport, error) {
+	const (
+		lockSprintQuery = `
+		SELECT start_date, completed_at IS NOT NULL
+		FROM sprints
+		WHERE board_id = @board_id
+		  AND id = @sprint_id
+		FOR UPDATE`
+		nextSprintQuery = `
+		SELECT id
+		FROM sprints
+		WHERE board_id = @board_id
+		  AND completed_at IS NULL
+		  AND (start_date, id) > (@start_date::date, @sprint_id::uuid)
+		ORDER BY start_date ASC, id ASC
+		LIMIT 1
+		FOR SHARE`
+		countTasksQuery = `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE c.is_done)
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		WHERE t.sprint_id = @sprint_id`
+		insertCarryOversQuery = `
+		INSERT INTO sprint_carry_overs (sprint_id, task_id, task_name, ordinal)
+		SELECT @sprint_id, t.id, t.name,
+			ROW_NUMBER() OVER (ORDER BY c.position ASC, l.position ASC NULLS FIRST, t.position ASC)
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		LEFT JOIN lanes l ON l.id = t.lane_id
+		WHERE t.sprint_id = @sprint_id
+		  AND NOT c.is_done`
+		moveTasksQuery = `
+		UPDATE tasks t
+		SET sprint_id = @next_sprint_id,
+		    version = t.version + 1
+		FROM columns c
+		WHERE c.id = t.column_id
+		  AND t.sprint_id = @sprint_id
+		  AND NOT c.is_done`
+		completeSprintQuery = `
+		UPDATE sprints
+		SET
+			completed_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+			committed_count = @committed,
+			completed_count = @completed,
+			carried_over_to = @next_sprint_id,
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+		WHERE id = @sprint_id
+		RETURNING completed_at`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// 1. Lock the sprint.
+	var (
+		startDate time.Time
+		completed bool
+	)
+	err = tx.QueryRow(ctx, lockSprintQuery, pgx.NamedArgs{
+		"board_id":  boardID,
+		"sprint_id": sprintID,
+	}).Scan(&startDate, &completed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.SprintReport{}, ErrRowNotFound
+		}
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete lock sprint: %v: %w", err, ErrInternal)
+	}
+	if completed {
+		return domain.SprintReport{}, ErrCompleted
+	}
+
+	// 2. Pick the next sprint.
+	var nextSprintID uuid.NullUUID
+	if toNextSprint {
+		err = tx.QueryRow(ctx, nextSprintQuery, pgx.NamedArgs{
+			"board_id":   boardID,
+			"sprint_id":  sprintID,
+			"start_date": startDate,
+		}).Scan(&nextSprintID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return domain.SprintReport{}, fmt.Errorf("sprint repo: complete next sprint: %v: %w", err, ErrInternal)
+		}
+	}
+
+	// 3. Count tasks.
+	report := domain.SprintReport{SprintID: sprintID}
+	err = tx.QueryRow(ctx, countTasksQuery, pgx.NamedArgs{
+		"sprint_id": sprintID,
+	}).Scan(&report.Committed, &report.Completed)
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete count tasks: %v: %w", err, ErrInternal)
+	}
+
+	// 4. Copy the carry-over list.
+	_, err = tx.Exec(ctx, insertCarryOversQuery, pgx.NamedArgs{
+		"sprint_id": sprintID,
+	})
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete insert carry-overs: %v: %w", err, ErrInternal)
+	}
+
+	// 5. Move unfinished tasks.
+	_, err = tx.Exec(ctx, moveTasksQuery, pgx.NamedArgs{
+		"sprint_id":      sprintID,
+		"next_sprint_id": nextSprintID,
+	})
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete move tasks: %v: %w", err, ErrInternal)
+	}
+
+	// 6. Store the snapshot.
+	err = tx.QueryRow(ctx, completeSprintQuery, pgx.NamedArgs{
+		"sprint_id":      sprintID,
+		"committed":      report.Committed,
+		"completed":      report.Completed,
+		"next_sprint_id": nextSprintID,
+	}).Scan(&report.CompletedAt)
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete update sprint: %v: %w", err, ErrInternal)
+	}
+
+	report.CarryOver, err = listCarryOvers(ctx, tx, sprintID)
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete: %w", err)
+	}
+	if nextSprintID.Valid {
+		report.CarriedOverTo, err = domain.NewSprintIDFromUUID(nextSprintID.UUID)
+		if err != nil {
+			return domain.SprintReport{}, fmt.Errorf("sprint repo: complete: next sprint id: %v: %w", err, ErrInternal)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: complete commit: %v: %w", err, ErrInternal)
+	}
+
+	return report, nil
+}
+
+// GetReport returns the snapshot stored by Complete. For an open sprint it computes the same
+// figures from the current tasks instead, leaving CompletedAt and CarriedOverTo zero.
+func (r *PGSprint) GetReport(ctx context.Context, sprintID domain.SprintID) (domain.SprintReport, error) {
+	const (
+		snapshotQuery = `
+		SELECT completed_at, committed_count, completed_count, carried_over_to
+		FROM sprints
+		WHERE id = $1`
+		previewQuery = `
+		SELECT COUNT(*), COUNT(*) FILTER (WHERE c.is_done)
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		WHERE t.sprint_id = $1`
+		previewCarryOverQuery = `
+		SELECT t.id, t.name
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		LEFT JOIN lanes l ON l.id = t.lane_id
+		WHERE t.sprint_id = $1
+		  AND NOT c.is_done
+		ORDER BY c.position ASC, l.position ASC NULLS FIRST, t.position ASC`
+	)
+
+	// A repeatable-read snapshot keeps the counts and the list consistent with each other.
+	tx, err := r.pgPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: get report begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	var (
+		completedAt      *time.Time
+		committed        *int
+		completed        *int
+		rawCarriedOverTo uuid.NullUUID
+	)
+	err = tx.QueryRow(ctx, snapshotQuery, sprintID).Scan(&completedAt, &committed, &completed, &rawCarriedOverTo)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.SprintReport{}, ErrRowNotFound
+		}
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: get report: %v: %w", err, ErrInternal)
+	}
+
+	report := domain.SprintReport{SprintID: sprintID}
+	if completedAt != nil && committed != nil && completed != nil {
+		report.CompletedAt = *completedAt
+		report.Committed = *committed
+		report.Completed = *completed
+		if rawCarriedOverTo.Valid {
+			report.CarriedOverTo, err = domain.NewSprintIDFromUUID(rawCarriedOverTo.UUID)
+			if err != nil {
+				return domain.SprintReport{}, fmt.Errorf("sprint repo: get report: carried over to: %v: %w", err, ErrInternal)
+			}
+		}
+		report.CarryOver, err = listCarryOvers(ctx, tx, sprintID)
+		if err != nil {
+			return domain.SprintReport{}, fmt.Errorf("sprint repo: get report: %w", err)
+		}
+		return report, nil
+	}
+
+	err = tx.QueryRow(ctx, previewQuery, sprintID).Scan(&report.Committed, &report.Completed)
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: get report preview: %v: %w", err, ErrInternal)
+	}
+	report.CarryOver, err = scanCarryOvers(tx.Query(ctx, previewCarryOverQuery, sprintID))
+	if err != nil {
+		return domain.SprintReport{}, fmt.Errorf("sprint repo: get report preview: %w", err)
+	}
+
+	return report, nil
+}
+
+// missingOrCompleted tells why a write guarded by completed_at IS NULL matched no rows.
+func (r *PGSprint) missingOrCompleted(ctx context.Context, op string, boardID domain.BoardID, sprintID domain.SprintID) error {
+	const query = `SELECT completed_at IS NOT NULL FROM sprints WHERE board_id = $1 AND id = $2`
+
+	var completed bool
+	err := r.pgPool.QueryRow(ctx, query, boardID, sprintID).Scan(&completed)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRowNotFound
+		}
+		return fmt.Errorf("sprint repo: %s: check sprint: %v: %w", op, err, ErrInternal)
+	}
+	if completed {
+		return ErrCompleted
+	}
+
+	return ErrRowNotFound
+}
+
+func listCarryOvers(ctx context.Context, tx pgx.Tx, sprintID domain.SprintID) ([]domain.SprintCarryOverTask, error) {
+	const query = `
+		SELECT task_id, task_name
+		FROM sprint_carry_overs
+		WHERE sprint_id = $1
+		ORDER BY ordinal ASC`
+
+	return scanCarryOvers(tx.Query(ctx, query, sprintID))
+}
+
+func scanCarryOvers(rows pgx.Rows, err error) ([]domain.SprintCarryOverTask, error) {
+	if err != nil {
+		return nil, fmt.Errorf("carry-overs: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var tasks []domain.SprintCarryOverTask
+	for rows.Next() {
+		var (
+			rawTaskID uuid.UUID
+			rawName   string
+		)
+		err = rows.Scan(&rawTaskID, &rawName)
+		if err != nil {
+			return nil, fmt.Errorf("carry-overs: scan: %v: %w", err, ErrInternal)
+		}
+		taskID, idErr := domain.NewTaskIDFromUUID(rawTaskID)
+		if idErr != nil {
+			return nil, fmt.Errorf("carry-overs: task id: %v: %w", idErr, errDataCorrupted)
+		}
+		name, nameErr := domain.NewTaskName(rawName)
+		if nameErr != nil {
+			return nil, fmt.Errorf("carry-overs: task name: %v: %w", nameErr, errDataCorrupted)
+		}
+
+		tasks = append(tasks, domain.SprintCarryOverTask{TaskID: taskID, Name: name})
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("carry-overs: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return tasks, nil
+}
+
+func ScanSprint(row interface{ Scan(...any) error }) (domain.Sprint, error) {
+	var (
+		rawID       uuid.UUID
+		rawBoardID  uuid.UUID
+		rawName     string
+		rawGoal     string
+		startDate   time.Time
+		endDate     time.Time
+		completedAt *time.Time
+		createdAt   time.Time
+		updatedAt   time.Time
+	)
+	err := row.Scan(&rawID, &rawBoardID, &rawName, &rawGoal, &startDate, &endDate, &completedAt, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.Sprint{}, fmt.Errorf("scan sprint: %w", err)
+	}
+	id, err := domain.NewSprintIDFromUUID(rawID)
+	if err != nil {
+		return domain.Sprint{}, fmt.Errorf("scan sprint: id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.Sprint{}, fmt.Errorf("scan sprint: board id: %v: %w", err, errDataCorrupted)
+	}
+	name, err := domain.NewSprintName(rawName)
+	if err != nil {
+		return domain.Sprint{}, fmt.Errorf("scan sprint: name: %v: %w", err, errDataCorrupted)
+	}
+	goal, err := domain.NewSprintGoal(rawGoal)
+	if err != nil {
+		return domain.Sprint{}, fmt.Errorf("scan sprint: goal: %v: %w", err, errDataCorrupted)
+	}
+	sprint := domain.Sprint{
+		ID:        id,
+		BoardID:   boardID,
+		Name:      name,
+		Goal:      goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+		CreatedAt: createdAt,
+		UpdatedAt: updatedAt,
+	}
+	if completedAt != nil {
+		sprint.CompletedAt = *completedAt
+	}
+	return sprint, nil
+}
+
+// NullSprintID maps a nil sprint ID, the backlog, to SQL NULL.
+func NullSprintID(id domain.SprintID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id.UUID(), Valid: !id.IsNil()}
+}
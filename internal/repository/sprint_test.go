@@ -0,0 +1,169 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestSprintRepository_AddTask(t *testing.T) {
+	pool, r := sprintRepoPrelude(t)
+
+	t.Run("Task of another board", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		task, _ := insertTwoTasks(t, pool, column.ID)
+		sprint := insertSprint(t, r, board.ID, 5)
+
+		err := r.AddTask(context.Background(), domain.NewBoardID(), sprint.ID, task.ID)
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Completed sprint", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		task, _ := insertTwoTasks(t, pool, column.ID)
+		sprint := insertSprint(t, r, board.ID, 5)
+
+		_, err := r.Complete(context.Background(), board.ID, sprint.ID, true)
+		if err != nil {
+			t.Fatalf("Complete() error = %v", err)
+		}
+		err = r.AddTask(context.Background(), board.ID, sprint.ID, task.ID)
+		if !errors.Is(err, repository.ErrCompleted) {
+			t.Errorf("got error %v, want ErrCompleted", err)
+		}
+	})
+}
+
+func TestSprintRepository_Complete(t *testing.T) {
+	pool, r := sprintRepoPrelude(t)
+
+	t.Run("Carries unfinished tasks to next sprint", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, todo := insertFixedUserBoardAndColumn(t, pool)
+		done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+		done.IsDone = true
+		CreateColumn(t, pool, &done)
+		open, _ := insertTwoTasks(t, pool, todo.ID)
+		finished := testutil.NewValidTask(t, done.ID, "Finished", "", 1)
+		finished.ID = domain.NewTaskID()
+		CreateTask(t, pool, &finished)
+
+		current := insertSprint(t, r, board.ID, 5)
+		next := insertSprint(t, r, board.ID, 19)
+		for _, taskID := range []domain.TaskID{open.ID, finished.ID} {
+			err := r.AddTask(context.Background(), board.ID, current.ID, taskID)
+			if err != nil {
+				t.Fatalf("AddTask() error = %v", err)
+			}
+		}
+
+		report, err := r.Complete(context.Background(), board.ID, current.ID, true)
+		if err != nil {
+			t.Fatalf("Complete() error = %v", err)
+		}
+		if report.Committed != 2 || report.Completed != 1 {
+			t.Errorf("got committed %d and completed %d, want 2 and 1", report.Committed, report.Completed)
+		}
+		if report.CarriedOverTo != next.ID {
+			t.Errorf("got carried over to %v, want %v", report.CarriedOverTo, next.ID)
+		}
+		if len(report.CarryOver) != 1 || report.CarryOver[0].TaskID != open.ID {
+			t.Errorf("got carry-over %+v, want only task %v", report.CarryOver, open.ID)
+		}
+
+		tasks := ListTasksByColumnID(t, pool, todo.ID)
+		if tasks[0].SprintID != next.ID {
+			t.Errorf("got unfinished task in sprint %v, want %v", tasks[0].SprintID, next.ID)
+		}
+		tasks = ListTasksByColumnID(t, pool, done.ID)
+		if tasks[0].SprintID != current.ID {
+			t.Errorf("got finished task in sprint %v, want %v", tasks[0].SprintID, current.ID)
+		}
+
+		stored, err := r.GetReport(context.Background(), current.ID)
+		if err != nil {
+			t.Fatalf("GetReport() error = %v", err)
+		}
+		if stored.Committed != 2 || stored.CarriedOverTo != next.ID || len(stored.CarryOver) != 1 {
+			t.Errorf("got stored report %+v, want the completion snapshot", stored)
+		}
+	})
+
+	t.Run("Carries to backlog without next sprint", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		open, _ := insertTwoTasks(t, pool, column.ID)
+		sprint := insertSprint(t, r, board.ID, 5)
+		err := r.AddTask(context.Background(), board.ID, sprint.ID, open.ID)
+		if err != nil {
+			t.Fatalf("AddTask() error = %v", err)
+		}
+
+		report, err := r.Complete(context.Background(), board.ID, sprint.ID, true)
+		if err != nil {
+			t.Fatalf("Complete() error = %v", err)
+		}
+		if !report.CarriedOverTo.IsNil() {
+			t.Errorf("got carried over to %v, want backlog", report.CarriedOverTo)
+		}
+		for _, task := range ListTasksByColumnID(t, pool, column.ID) {
+			if !task.SprintID.IsNil() {
+				t.Errorf("task %v got sprint %v, want backlog", task.ID, task.SprintID)
+			}
+		}
+	})
+
+	t.Run("Already completed", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board := insertFixedUserAndBoard(t, pool)
+		sprint := insertSprint(t, r, board.ID, 5)
+
+		_, err := r.Complete(context.Background(), board.ID, sprint.ID, false)
+		if err != nil {
+			t.Fatalf("Complete() error = %v", err)
+		}
+		_, err = r.Complete(context.Background(), board.ID, sprint.ID, false)
+		if !errors.Is(err, repository.ErrCompleted) {
+			t.Errorf("got error %v, want ErrCompleted", err)
+		}
+	})
+}
+
+func insertSprint(t *testing.T, r *repository.PGSprint, boardID domain.BoardID, startDay int) domain.Sprint {
+	t.Helper()
+
+	sprint := testutil.ValidSprint(boardID)
+	sprint.StartDate = time.Date(2026, time.January, startDay, 0, 0, 0, 0, time.UTC)
+	sprint.EndDate = sprint.StartDate.AddDate(0, 0, 13)
+	created, err := r.Create(context.Background(), sprint)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return created
+}
+
+func sprintRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGSprint) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGSprint(pool)
+}
@@ -0,0 +1,267 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// countTransitionsQuery adds the rows of a preceding transitions CTE, which returns the inserted
+// task_transitions rows, to the daily task activity of their boards. A transition without a
+// from column records the creation of a task.
+const countTransitionsQuery = `
+	INSERT INTO board_task_activity (board_id, day, created, moved)
+	SELECT board_id,
+	       transitioned_at::date,
+	       COUNT(*) FILTER (WHERE from_column_id IS NULL),
+	       COUNT(*) FILTER (WHERE from_column_id IS NOT NULL)
+	FROM transitions
+	GROUP BY board_id, transitioned_at::date
+	ON CONFLICT (board_id, day) DO UPDATE
+	SET created = board_task_activity.created + EXCLUDED.created,
+	    moved = board_task_activity.moved + EXCLUDED.moved`
+
+// recordTaskDeletions adds deleted tasks to today's activity of the board within tx.
+func recordTaskDeletions(ctx context.Context, tx pgx.Tx, boardID domain.BoardID, deleted int64) error {
+	if deleted == 0 {
+		return nil
+	}
+
+	const query = `
+		INSERT INTO board_task_activity (board_id, day, deleted)
+		VALUES (@board_id, (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')::date, @deleted)
+		ON CONFLICT (board_id, day) DO UPDATE
+		SET deleted = board_task_activity.deleted + EXCLUDED.deleted`
+
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"board_id": boardID,
+		"deleted":  deleted,
+	})
+	if err != nil {
+		return fmt.Errorf("record task deletions: %w", err)
+	}
+
+	return nil
+}
+
+type PGStats struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGStats(pgPool *pgxpool.Pool) *PGStats {
+	return &PGStats{pgPool: pgPool}
+}
+
+// The stats queries are written against a scope CTE holding the ids of the counted boards.
+const (
+	boardScopeCTE = `
+	WITH scope AS (
+		SELECT id
+		FROM boards
+		WHERE id = @board_id
+	)`
+	ownerScopeCTE = `
+	WITH scope AS (
+		SELECT id
+		FROM boards
+		WHERE owner_id = @owner_id
+	)`
+)
+
+// BoardStats computes the statistics of the board as of now.
+func (r *PGStats) BoardStats(ctx context.Context, boardID domain.BoardID, now time.Time) (domain.BoardStats, error) {
+	const columnsQuery = `
+	SELECT c.id, c.name, COUNT(t.id)
+	FROM columns c
+	LEFT JOIN tasks t ON t.column_id = c.id
+	WHERE c.board_id = @board_id
+	GROUP BY c.id
+	ORDER BY c.position ASC`
+
+	tx, err := r.pgPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return domain.BoardStats{}, fmt.Errorf("stats repo: board stats begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	stats := domain.BoardStats{BoardID: boardID, AsOf: now}
+
+	rows, err := tx.Query(ctx, columnsQuery, pgx.NamedArgs{"board_id": boardID})
+	if err != nil {
+		return domain.BoardStats{}, fmt.Errorf("stats repo: board stats columns: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rawID   uuid.UUID
+			rawName string
+			count   domain.ColumnTaskCount
+		)
+		err = rows.Scan(&rawID, &rawName, &count.Tasks)
+		if err != nil {
+			return domain.BoardStats{}, fmt.Errorf("stats repo: board stats columns: scan: %v: %w", err, ErrInternal)
+		}
+		count.ColumnID, err = domain.NewColumnIDFromUUID(rawID)
+		if err != nil {
+			return domain.BoardStats{}, fmt.Errorf("stats repo: board stats columns: scan: id: %v: %w", err, ErrInternal)
+		}
+		count.Name, err = domain.NewColumnName(rawName)
+		if err != nil {
+			return domain.BoardStats{}, fmt.Errorf("stats repo: board stats columns: scan: name: %v: %w", err, ErrInternal)
+		}
+		stats.Columns = append(stats.Columns, count)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return domain.BoardStats{}, fmt.Errorf("stats repo: board stats columns: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	stats.TaskStats, err = queryTaskStats(ctx, tx, boardScopeCTE, pgx.NamedArgs{"board_id": boardID}, now)
+	if err != nil {
+		return domain.BoardStats{}, fmt.Errorf("stats repo: board stats: %v: %w", err, ErrInternal)
+	}
+
+	return stats, nil
+}
+
+// UserStats computes the statistics of all boards owned by the user as of now.
+func (r *PGStats) UserStats(ctx context.Context, ownerID domain.UserID, now time.Time) (domain.UserStats, error) {
+	tx, err := r.pgPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return domain.UserStats{}, fmt.Errorf("stats repo: user stats begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	stats := domain.UserStats{UserID: ownerID, AsOf: now}
+
+	err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM boards WHERE owner_id = $1`, ownerID).Scan(&stats.Boards)
+	if err != nil {
+		return domain.UserStats{}, fmt.Errorf("stats repo: user stats boards: %v: %w", err, ErrInternal)
+	}
+
+	stats.TaskStats, err = queryTaskStats(ctx, tx, ownerScopeCTE, pgx.NamedArgs{"owner_id": ownerID}, now)
+	if err != nil {
+		return domain.UserStats{}, fmt.Errorf("stats repo: user stats: %v: %w", err, ErrInternal)
+	}
+
+	return stats, nil
+}
+
+// queryTaskStats computes the task statistics of the boards in scopeCTE, whose parameters are
+// in args, as of now.
+func queryTaskStats(ctx context.Context, tx pgx.Tx, scopeCTE string, args pgx.NamedArgs, now time.Time) (domain.TaskStats, error) {
+	totalsQuery := scopeCTE + `
+	SELECT COUNT(t.id),
+	       COUNT(t.id) FILTER (WHERE NOT c.is_done),
+	       COALESCE(EXTRACT(EPOCH FROM AVG(@now::timestamp - t.created_at) FILTER (WHERE NOT c.is_done)), 0)::float8
+	FROM scope s
+	JOIN columns c ON c.board_id = s.id
+	JOIN tasks t ON t.column_id = c.id`
+	activityQuery := scopeCTE + `
+	SELECT COALESCE(SUM(a.created) FILTER (WHERE a.day > @now::date - 7), 0)::bigint,
+	       COALESCE(SUM(a.moved) FILTER (WHERE a.day > @now::date - 7), 0)::bigint,
+	       COALESCE(SUM(a.deleted) FILTER (WHERE a.day > @now::date - 7), 0)::bigint,
+	       COALESCE(SUM(a.created), 0)::bigint,
+	       COALESCE(SUM(a.moved), 0)::bigint,
+	       COALESCE(SUM(a.deleted), 0)::bigint
+	FROM scope s
+	JOIN board_task_activity a ON a.board_id = s.id
+	WHERE a.day > @now::date - 30
+	  AND a.day <= @now::date`
+	oldestQuery := scopeCTE + `
+	SELECT t.id, c.board_id, t.column_id, t.name, t.created_at
+	FROM scope s
+	JOIN columns c ON c.board_id = s.id
+	JOIN tasks t ON t.column_id = c.id
+	WHERE NOT c.is_done
+	ORDER BY t.created_at ASC, t.id ASC
+	LIMIT @limit`
+
+	queryArgs := pgx.NamedArgs{"now": now, "limit": domain.OldestOpenTasksLimit}
+	maps.Copy(queryArgs, args)
+
+	var (
+		stats          domain.TaskStats
+		averageSeconds float64
+	)
+	err := tx.QueryRow(ctx, totalsQuery, queryArgs).Scan(&stats.Tasks, &stats.OpenTasks, &averageSeconds)
+	if err != nil {
+		return domain.TaskStats{}, fmt.Errorf("totals: %w", err)
+	}
+	stats.AverageOpenTaskAge = time.Duration(averageSeconds * float64(time.Second))
+
+	err = tx.QueryRow(ctx, activityQuery, queryArgs).Scan(
+		&stats.Last7Days.Created, &stats.Last7Days.Moved, &stats.Last7Days.Deleted,
+		&stats.Last30Days.Created, &stats.Last30Days.Moved, &stats.Last30Days.Deleted,
+	)
+	if err != nil {
+		return domain.TaskStats{}, fmt.Errorf("activity: %w", err)
+	}
+
+	rows, err := tx.Query(ctx, oldestQuery, queryArgs)
+	if err != nil {
+		return domain.TaskStats{}, fmt.Errorf("oldest open tasks: %w", err)
+	}
+	defer rows.Close()
+
+	stats.OldestOpenTasks = []domain.OpenTask{}
+	for rows.Next() {
+		task, scanErr := scanOpenTask(rows)
+		if scanErr != nil {
+			return domain.TaskStats{}, fmt.Errorf("oldest open tasks: %w", scanErr)
+		}
+		stats.OldestOpenTasks = append(stats.OldestOpenTasks, task)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return domain.TaskStats{}, fmt.Errorf("oldest open tasks: rows final error: %w", err)
+	}
+
+	return stats, nil
+}
+
+func scanOpenTask(row interface{ Scan(...any) error }) (domain.OpenTask, error) {
+	var (
+		rawID       uuid.UUID
+		rawBoardID  uuid.UUID
+		rawColumnID uuid.UUID
+		rawName     string
+		task        domain.OpenTask
+	)
+	err := row.Scan(&rawID, &rawBoardID, &rawColumnID, &rawName, &task.CreatedAt)
+	if err != nil {
+		return domain.OpenTask{}, fmt.Errorf("scan open task: %w", err)
+	}
+	task.ID, err = domain.NewTaskIDFromUUID(rawID)
+	if err != nil {
+		return domain.OpenTask{}, fmt.Errorf("scan open task: id: %v: %w", err, errDataCorrupted)
+	}
+	task.BoardID, err = domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.OpenTask{}, fmt.Errorf("scan open task: board id: %v: %w", err, errDataCorrupted)
+	}
+	task.ColumnID, err = domain.NewColumnIDFromUUID(rawColumnID)
+	if err != nil {
+		return domain.OpenTask{}, fmt.Errorf("scan open task: column id: %v: %w", err, errDataCorrupted)
+	}
+	task.Name, err = domain.NewTaskName(rawName)
+	if err != nil {
+		return domain.OpenTask{}, fmt.Errorf("scan open task: name: %v: %w", err, errDataCorrupted)
+	}
+
+	return task, nil
+}
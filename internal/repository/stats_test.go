@@ -0,0 +1,117 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestStatsRepository_BoardAndUserStats(t *testing.T) {
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+	r := repository.NewPGStats(pool)
+	taskRepo := repository.NewPGTask(pool)
+	columnRepo := repository.NewPGColumn(pool)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+	done.IsDone = true
+	CreateColumn(t, pool, &done)
+	archive := testutil.NewValidColumn(t, board.ID, "Archive", 3)
+	CreateColumn(t, pool, &archive)
+
+	shipped := createTaskWithHistory(t, taskRepo, todo.ID)
+	parent := createTaskWithHistory(t, taskRepo, todo.ID)
+	valid := testutil.ValidTask(todo.ID)
+	_, err := taskRepo.Create(context.Background(), todo.ID, domain.LaneID{}, parent.ID, valid.Name, valid.Description, domain.TaskChecklist{}, domain.TaskEstimate{}, domain.TaskCustomFields{})
+	if err != nil {
+		t.Fatalf("Create() subtask error = %v", err)
+	}
+	createTaskWithHistory(t, taskRepo, archive.ID)
+	open := createTaskWithHistory(t, taskRepo, todo.ID)
+
+	_, err = taskRepo.Move(context.Background(), board.ID, todo.ID, shipped.ID, done.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 1), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+	err = taskRepo.Delete(context.Background(), board.ID, todo.ID, parent.ID, true, domain.Version{})
+	if err != nil {
+		t.Fatalf("Delete() task error = %v", err)
+	}
+	err = columnRepo.Delete(context.Background(), board.ID, archive.ID, domain.Version{})
+	if err != nil {
+		t.Fatalf("Delete() column error = %v", err)
+	}
+
+	now := time.Now().UTC().Add(time.Hour)
+	wantActivity := domain.TaskActivity{Created: 5, Moved: 1, Deleted: 3}
+	assertTaskStats := func(t *testing.T, got *domain.TaskStats) {
+		t.Helper()
+
+		if got.Tasks != 2 || got.OpenTasks != 1 {
+			t.Errorf("got %d tasks with %d open, want 2 with 1 open", got.Tasks, got.OpenTasks)
+		}
+		if got.Last7Days != wantActivity || got.Last30Days != wantActivity {
+			t.Errorf("got activity %+v and %+v, want %+v", got.Last7Days, got.Last30Days, wantActivity)
+		}
+		if len(got.OldestOpenTasks) != 1 || got.OldestOpenTasks[0].ID != open.ID || got.OldestOpenTasks[0].BoardID != board.ID {
+			t.Fatalf("got oldest open tasks %+v, want task %v", got.OldestOpenTasks, open.ID)
+		}
+		wantAge := now.Sub(got.OldestOpenTasks[0].CreatedAt)
+		if diff := got.AverageOpenTaskAge - wantAge; diff < -time.Millisecond || diff > time.Millisecond {
+			t.Errorf("got average open task age %v, want %v", got.AverageOpenTaskAge, wantAge)
+		}
+	}
+
+	boardStats, err := r.BoardStats(context.Background(), board.ID, now)
+	if err != nil {
+		t.Fatalf("BoardStats() error = %v", err)
+	}
+	wantColumns := []domain.ColumnTaskCount{
+		{ColumnID: todo.ID, Name: todo.Name, Tasks: 1},
+		{ColumnID: done.ID, Name: done.Name, Tasks: 1},
+	}
+	if len(boardStats.Columns) != len(wantColumns) {
+		t.Fatalf("got columns %+v, want %+v", boardStats.Columns, wantColumns)
+	}
+	for i, want := range wantColumns {
+		got := boardStats.Columns[i]
+		if got.ColumnID != want.ColumnID || got.Name != want.Name || got.Tasks != want.Tasks {
+			t.Errorf("got column %+v, want %+v", got, want)
+		}
+	}
+	assertTaskStats(t, &boardStats.TaskStats)
+
+	later, err := r.BoardStats(context.Background(), board.ID, now.AddDate(0, 0, 8))
+	if err != nil {
+		t.Fatalf("BoardStats() later error = %v", err)
+	}
+	if later.Last7Days != (domain.TaskActivity{}) || later.Last30Days != wantActivity {
+		t.Errorf("got activity %+v and %+v a week later, want none and %+v", later.Last7Days, later.Last30Days, wantActivity)
+	}
+
+	userStats, err := r.UserStats(context.Background(), board.OwnerID, now)
+	if err != nil {
+		t.Fatalf("UserStats() error = %v", err)
+	}
+	if userStats.Boards != 1 {
+		t.Errorf("got %d boards, want 1", userStats.Boards)
+	}
+	assertTaskStats(t, &userStats.TaskStats)
+
+	otherStats, err := r.UserStats(context.Background(), domain.NewUserID(), now)
+	if err != nil {
+		t.Fatalf("UserStats() other user error = %v", err)
+	}
+	if otherStats.Boards != 0 || otherStats.Tasks != 0 || len(otherStats.OldestOpenTasks) != 0 {
+		t.Errorf("got stats %+v for a user without boards, want none", otherStats)
+	}
+}
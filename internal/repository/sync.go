@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"goroutine/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	syncBoardsQuery = `
+	SELECT id, owner_id, name, description, created_at, updated_at, version
+	FROM boards
+	WHERE owner_id = @owner_id
+	  AND (@all OR id = ANY(@board_ids))
+	ORDER BY created_at ASC`
+	syncColumnsQuery = `
+	SELECT c.id, c.board_id, c.name, c.description, c.position, c.wip_limit, c.sla_hours, c.archive_after_days, c.is_started, c.is_done, c.allowed_transitions, c.entry_conditions, c.entry_roles, c.created_at, c.updated_at, c.version
+	FROM columns c JOIN boards b ON b.id = c.board_id
+	WHERE b.owner_id = @owner_id
+	  AND (@all OR c.id = ANY(@column_ids))
+	ORDER BY b.created_at ASC, c.position ASC`
+	syncTasksQuery = `
+	SELECT t.id, t.column_id, t.lane_id, t.parent_id, t.sprint_id, t.name, t.description, t.position, t.checklist, t.estimate, t.custom_fields, t.archived_at, t.created_at, t.updated_at, t.version
+	FROM tasks t JOIN columns c ON c.id = t.column_id JOIN boards b ON b.id = c.board_id
+	WHERE b.owner_id = @owner_id
+	  AND (@all OR t.id = ANY(@task_ids))
+	ORDER BY b.created_at ASC, c.position ASC, t.archived_at ASC NULLS FIRST, t.position ASC`
+)
+
+type PGSync struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGSync(pgPool *pgxpool.Pool) *PGSync {
+	return &PGSync{pgPool: pgPool}
+}
+
+// Snapshot returns every board, column and task of the owner, with the cursor their changes
+// start after. Everything is read from one snapshot; a change that commits while it is taken
+// may show in it and after the cursor both.
+func (r *PGSync) Snapshot(ctx context.Context, ownerID domain.UserID) (domain.SyncChanges, error) {
+	tx, err := r.pgPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: snapshot begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	settled, err := querySettledTxID(ctx, tx)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: snapshot: %v: %w", err, ErrInternal)
+	}
+
+	changes := domain.SyncChanges{Cursor: domain.NewBoardEventCursor(settled, 0), Full: true}
+	args := pgx.NamedArgs{
+		"owner_id":   ownerID,
+		"all":        true,
+		"board_ids":  []domain.BoardID{},
+		"column_ids": []domain.ColumnID{},
+		"task_ids":   []domain.TaskID{},
+	}
+	err = querySyncEntities(ctx, tx, args, &changes)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: snapshot: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: snapshot commit: %v: %w", err, ErrInternal)
+	}
+
+	err = attachTaskRelations(ctx, r.pgPool, changes.Tasks)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: snapshot: relations: %v: %w", err, ErrInternal)
+	}
+
+	return changes, nil
+}
+
+// ChangesAfter returns what changed for the owner after the cursor, reading up to limit events.
+// Every entity named by them comes in its current state, or as its deletion event if it is
+// gone. Entities removed along with their board or column are left out: the deletion of the
+// parent comes with these events or later ones.
+func (r *PGSync) ChangesAfter(ctx context.Context, ownerID domain.UserID, after domain.BoardEventCursor, limit int) (domain.SyncChanges, error) {
+	const eventsQuery = `
+	SELECT ` + boardEventColumns + `
+	FROM board_events
+	WHERE owner_id = @owner_id
+	  AND (tx_id, id) > (@tx_id, @id)
+	  AND tx_id < @settled
+	ORDER BY tx_id ASC, id ASC
+	LIMIT @limit`
+
+	tx, err := r.pgPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	settled, err := querySettledTxID(ctx, tx)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after: %v: %w", err, ErrInternal)
+	}
+
+	rows, err := tx.Query(ctx, eventsQuery, pgx.NamedArgs{
+		"owner_id": ownerID,
+		"tx_id":    after.TxID(),
+		"id":       after.ID(),
+		"settled":  settled,
+		"limit":    limit + 1,
+	})
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after events: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var events []domain.BoardEvent
+	for rows.Next() {
+		event, scanErr := ScanBoardEvent(rows)
+		if scanErr != nil {
+			return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after events: scan: %v: %w", scanErr, ErrInternal)
+		}
+		events = append(events, event)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after events: rows final error: %v: %w", err, ErrInternal)
+	}
+	rows.Close()
+
+	var changes domain.SyncChanges
+	switch {
+	case len(events) > limit:
+		events = events[:limit]
+		changes.Cursor = events[limit-1].Cursor
+		changes.HasMore = true
+	case settled > after.TxID():
+		// Every event before the settled transaction has been read.
+		changes.Cursor = domain.NewBoardEventCursor(settled, 0)
+	default:
+		changes.Cursor = after
+	}
+	if len(events) == 0 {
+		return changes, nil
+	}
+
+	// The last event of an entity tells whether it is gone; otherwise its state is read.
+	latest := make(map[any]int, len(events))
+	for i := range events {
+		latest[syncEntityKey(&events[i])] = i
+	}
+	var (
+		boardIDs  []domain.BoardID
+		columnIDs []domain.ColumnID
+		taskIDs   []domain.TaskID
+	)
+	for i := range events {
+		event := &events[i]
+		if latest[syncEntityKey(event)] != i {
+			continue
+		}
+		if event.Action == domain.BoardEventDeleted {
+			changes.Deleted = append(changes.Deleted, *event)
+			continue
+		}
+		switch event.Entity {
+		case domain.BoardEventBoard:
+			boardIDs = append(boardIDs, event.BoardID)
+		case domain.BoardEventColumn:
+			columnIDs = append(columnIDs, event.ColumnID)
+		case domain.BoardEventTask:
+			taskIDs = append(taskIDs, event.TaskID)
+		}
+	}
+
+	args := pgx.NamedArgs{
+		"owner_id":   ownerID,
+		"all":        false,
+		"board_ids":  append([]domain.BoardID{}, boardIDs...),
+		"column_ids": append([]domain.ColumnID{}, columnIDs...),
+		"task_ids":   append([]domain.TaskID{}, taskIDs...),
+	}
+	err = querySyncEntities(ctx, tx, args, &changes)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after commit: %v: %w", err, ErrInternal)
+	}
+
+	err = attachTaskRelations(ctx, r.pgPool, changes.Tasks)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync repo: changes after: relations: %v: %w", err, ErrInternal)
+	}
+
+	return changes, nil
+}
+
+func querySettledTxID(ctx context.Context, tx pgx.Tx) (int64, error) {
+	var settled int64
+	err := tx.QueryRow(ctx, `SELECT `+settledTxIDExpr).Scan(&settled)
+	if err != nil {
+		return 0, fmt.Errorf("settled transaction: %w", err)
+	}
+
+	return settled, nil
+}
+
+// querySyncEntities reads the boards, columns and tasks of args into changes.
+func querySyncEntities(ctx context.Context, tx pgx.Tx, args pgx.NamedArgs, changes *domain.SyncChanges) error {
+	var err error
+
+	changes.Boards, err = querySyncRows(ctx, tx, syncBoardsQuery, args, ScanBoard)
+	if err != nil {
+		return fmt.Errorf("boards: %w", err)
+	}
+
+	changes.Columns, err = querySyncRows(ctx, tx, syncColumnsQuery, args, ScanColumn)
+	if err != nil {
+		return fmt.Errorf("columns: %w", err)
+	}
+
+	changes.Tasks, err = querySyncRows(ctx, tx, syncTasksQuery, args, ScanTask)
+	if err != nil {
+		return fmt.Errorf("tasks: %w", err)
+	}
+
+	return nil
+}
+
+func querySyncRows[T any](
+	ctx context.Context,
+	tx pgx.Tx,
+	query string,
+	args pgx.NamedArgs,
+	scan func(row interface{ Scan(...any) error }) (T, error),
+) ([]T, error) {
+	rows, err := tx.Query(ctx, query, args)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var result []T
+	for rows.Next() {
+		value, scanErr := scan(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("scan: %w", scanErr)
+		}
+		result = append(result, value)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("rows final error: %w", err)
+	}
+
+	return result, nil
+}
+
+// syncEntityKey tells the entity an event is about apart from every other one.
+func syncEntityKey(event *domain.BoardEvent) any {
+	switch event.Entity {
+	case domain.BoardEventColumn:
+		return event.ColumnID
+	case domain.BoardEventTask:
+		return event.TaskID
+	default:
+		return event.BoardID
+	}
+}
@@ -0,0 +1,168 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestSyncRepository_Snapshot(t *testing.T) {
+	pool, r := syncRepoPrelude(t)
+	ctx := context.Background()
+
+	testutil.TruncateAllTables(t, pool)
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	task := testutil.ValidTask(column.ID)
+	CreateTask(t, pool, &task)
+	otherUserID := domain.NewUserID()
+	otherEmail, _ := domain.NewEmail("other@example.com")
+	CreateUser(t, pool, otherUserID, otherEmail, testutil.ValidPasswordHash())
+	otherBoard := testutil.ValidBoard()
+	otherBoard.OwnerID = otherUserID
+	CreateBoard(t, pool, &otherBoard)
+
+	changes, err := r.Snapshot(ctx, board.OwnerID)
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+	if !changes.Full || changes.HasMore {
+		t.Errorf("got full %v and has more %v, want full only", changes.Full, changes.HasMore)
+	}
+	if len(changes.Boards) != 1 || changes.Boards[0].ID != board.ID {
+		t.Errorf("got boards %v, want only %v", changes.Boards, board.ID)
+	}
+	if len(changes.Columns) != 1 || changes.Columns[0].ID != column.ID {
+		t.Errorf("got columns %v, want only %v", changes.Columns, column.ID)
+	}
+	if len(changes.Tasks) != 1 || changes.Tasks[0].ID != task.ID {
+		t.Errorf("got tasks %v, want only %v", changes.Tasks, task.ID)
+	}
+
+	// Everything before the snapshot is in it.
+	after, err := r.ChangesAfter(ctx, board.OwnerID, changes.Cursor, 100)
+	if err != nil {
+		t.Fatalf("ChangesAfter() error = %v", err)
+	}
+	if len(after.Boards)+len(after.Columns)+len(after.Tasks)+len(after.Deleted) != 0 {
+		t.Errorf("got changes after the snapshot %+v, want none", after)
+	}
+}
+
+func TestSyncRepository_ChangesAfter(t *testing.T) {
+	pool, r := syncRepoPrelude(t)
+	ctx := context.Background()
+
+	testutil.TruncateAllTables(t, pool)
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	task := testutil.ValidTask(column.ID)
+	CreateTask(t, pool, &task)
+	deletedTask := testutil.NewValidTask(t, column.ID, "Deleted", "", 2)
+	CreateTask(t, pool, &deletedTask)
+
+	start, err := r.Snapshot(ctx, board.OwnerID)
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	execQuery(t, pool, `UPDATE tasks SET description = 'Edited', version = version + 1 WHERE id = $1`, task.ID)
+	execQuery(t, pool, `UPDATE tasks SET name = 'Edited again', version = version + 1 WHERE id = $1`, task.ID)
+	execQuery(t, pool, `DELETE FROM tasks WHERE id = $1`, deletedTask.ID)
+
+	changes, err := r.ChangesAfter(ctx, board.OwnerID, start.Cursor, 100)
+	if err != nil {
+		t.Fatalf("ChangesAfter() error = %v", err)
+	}
+	if changes.Full || changes.HasMore {
+		t.Errorf("got full %v and has more %v, want neither", changes.Full, changes.HasMore)
+	}
+	// A task changed twice comes once, as it is now.
+	if len(changes.Tasks) != 1 || changes.Tasks[0].ID != task.ID || changes.Tasks[0].Name.String() != "Edited again" {
+		t.Errorf("got tasks %+v, want %v edited again", changes.Tasks, task.ID)
+	}
+	if len(changes.Deleted) != 1 || changes.Deleted[0].TaskID != deletedTask.ID || changes.Deleted[0].Action != domain.BoardEventDeleted {
+		t.Errorf("got deleted %+v, want task %v", changes.Deleted, deletedTask.ID)
+	}
+
+	page, err := r.ChangesAfter(ctx, board.OwnerID, start.Cursor, 1)
+	if err != nil {
+		t.Fatalf("ChangesAfter() error = %v", err)
+	}
+	if !page.HasMore || len(page.Tasks) != 1 {
+		t.Fatalf("got page %+v, want one task and more", page)
+	}
+
+	// The deletion of a board reaches its owner with nothing left to read.
+	execQuery(t, pool, `DELETE FROM boards WHERE id = $1`, board.ID)
+	last, err := r.ChangesAfter(ctx, board.OwnerID, changes.Cursor, 100)
+	if err != nil {
+		t.Fatalf("ChangesAfter() error = %v", err)
+	}
+	if len(last.Deleted) != 1 || last.Deleted[0].Entity != domain.BoardEventBoard || last.Deleted[0].BoardID != board.ID {
+		t.Errorf("got deleted %+v, want board %v", last.Deleted, board.ID)
+	}
+	if len(last.Boards)+len(last.Columns)+len(last.Tasks) != 0 {
+		t.Errorf("got changes %+v, want only the deletion", last)
+	}
+}
+
+func TestSyncRepository_ChangesAfterMove(t *testing.T) {
+	pool, r := syncRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+	ctx := context.Background()
+
+	testutil.TruncateAllTables(t, pool)
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	first, second := insertTwoTasks(t, pool, column.ID)
+	third := testutil.NewValidTask(t, column.ID, "Third", "", 3)
+	CreateTask(t, pool, &third)
+
+	start, err := r.Snapshot(ctx, board.OwnerID)
+	if err != nil {
+		t.Fatalf("Snapshot() error = %v", err)
+	}
+
+	_, err = taskRepo.Move(ctx, board.ID, column.ID, first.ID, column.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 3), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+
+	changes, err := r.ChangesAfter(ctx, board.OwnerID, start.Cursor, 100)
+	if err != nil {
+		t.Fatalf("ChangesAfter() error = %v", err)
+	}
+	// The neighbours shifted to make room come along with the moved task, at their new
+	// positions and with versions newer than the replica has.
+	type gotTask struct {
+		Position int64
+		Version  int64
+	}
+	got := map[domain.TaskID]gotTask{}
+	for _, task := range changes.Tasks {
+		got[task.ID] = gotTask{Position: task.Position.Int64(), Version: task.Version.Int64()}
+	}
+	want := map[domain.TaskID]gotTask{
+		first.ID:  {Position: 3, Version: 2},
+		second.ID: {Position: 1, Version: 2},
+		third.ID:  {Position: 2, Version: 2},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ChangesAfter() tasks mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func syncRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGSync) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGSync(pool)
+}
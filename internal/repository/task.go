@@ -2,14 +2,17 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"slices"
 	"time"
 
 	"goroutine/internal/domain"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -84,8 +87,53 @@ func LockTaskColumns(
 func (r *PGTask) Create(
 	ctx context.Context,
 	columnID domain.ColumnID,
+	laneID domain.LaneID,
+	parentID domain.TaskID,
 	name domain.TaskName,
 	description domain.TaskDescription,
+	checklist domain.TaskChecklist,
+	estimate domain.TaskEstimate,
+	customFields domain.TaskCustomFields,
+) (domain.Task, error) {
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: create begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	task, err := insertTask(ctx, tx, columnID, laneID, parentID, name, description, checklist, estimate, customFields)
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) {
+			return domain.Task{}, ErrRowNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task repo: create: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: create commit: %v: %w", err, ErrInternal)
+	}
+
+	return task, nil
+}
+
+// insertTask appends a task to the end of the (columnID, laneID) cell within tx. A nil laneID places
+// the task in the default lane and a nil parentID makes a top-level task. The creation is recorded
+// as the first transition of the task.
+// It returns ErrRowNotFound if the column does not exist.
+func insertTask(
+	ctx context.Context,
+	tx pgx.Tx,
+	columnID domain.ColumnID,
+	laneID domain.LaneID,
+	parentID domain.TaskID,
+	name domain.TaskName,
+	description domain.TaskDescription,
+	checklist domain.TaskChecklist,
+	estimate domain.TaskEstimate,
+	customFields domain.TaskCustomFields,
 ) (domain.Task, error) {
 	const (
 		lockColumnQuery = `
@@ -96,67 +144,71 @@ func (r *PGTask) Create(
 		nextPositionQuery = `
 		SELECT COALESCE(MAX(position), 0) + 1
 		FROM tasks
-		WHERE column_id = @column_id`
+		WHERE column_id = @column_id
+		  AND lane_id IS NOT DISTINCT FROM @lane_id
+		  AND archived_at IS NULL`
 		insertTaskQuery = `
-		INSERT INTO tasks (column_id, name, description, position)
-		VALUES (@column_id, @name, @description, @position)
-		RETURNING id, column_id, name, description, position, created_at, updated_at`
+		INSERT INTO tasks (column_id, lane_id, parent_id, name, description, position, checklist, estimate, custom_fields)
+		VALUES (@column_id, @lane_id, @parent_id, @name, @description, @position, @checklist, @estimate, @custom_fields)
+		RETURNING id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version`
 	)
 
-	tx, err := r.pgPool.Begin(ctx)
-	if err != nil {
-		return domain.Task{}, fmt.Errorf("task repo: create begin tx: %v: %w", err, ErrInternal)
-	}
-	defer func() {
-		_ = tx.Rollback(ctx)
-	}()
-
 	var locked int
-	err = tx.QueryRow(ctx, lockColumnQuery, pgx.NamedArgs{
+	err := tx.QueryRow(ctx, lockColumnQuery, pgx.NamedArgs{
 		"column_id": columnID,
 	}).Scan(&locked)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Task{}, ErrRowNotFound
 		}
-		return domain.Task{}, fmt.Errorf("task repo: create lock column: %v: %w", err, ErrInternal)
+		return domain.Task{}, fmt.Errorf("lock column: %w", err)
 	}
 
 	var nextPosition int64
 	err = tx.QueryRow(ctx, nextPositionQuery, pgx.NamedArgs{
 		"column_id": columnID,
+		"lane_id":   NullLaneID(laneID),
 	}).Scan(&nextPosition)
 	if err != nil {
-		return domain.Task{}, fmt.Errorf("task repo: create next position: %v: %w", err, ErrInternal)
+		return domain.Task{}, fmt.Errorf("next position: %w", err)
 	}
 
 	task, err := ScanTask(tx.QueryRow(ctx, insertTaskQuery, pgx.NamedArgs{
-		"column_id":   columnID,
-		"name":        name,
-		"description": description,
-		"position":    nextPosition,
+		"column_id":     columnID,
+		"lane_id":       NullLaneID(laneID),
+		"parent_id":     NullTaskID(parentID),
+		"name":          name,
+		"description":   description,
+		"position":      nextPosition,
+		"checklist":     checklist,
+		"estimate":      estimate,
+		"custom_fields": customFields,
 	}))
 	if err != nil {
-		return domain.Task{}, fmt.Errorf("task repo: create insert: %v: %w", err, ErrInternal)
+		return domain.Task{}, fmt.Errorf("insert: %w", err)
 	}
 
-	err = tx.Commit(ctx)
+	err = recordTaskTransition(ctx, tx, task.ID, domain.ColumnID{}, columnID)
 	if err != nil {
-		return domain.Task{}, fmt.Errorf("task repo: create commit: %v: %w", err, ErrInternal)
+		return domain.Task{}, err
 	}
 
 	return task, nil
 }
 
-func (r *PGTask) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error) {
+// ListByBoardID returns the tasks of the board. Archived tasks are left out unless
+// includeArchived is set, in which case they follow the active tasks of their cell.
+func (r *PGTask) ListByBoardID(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
 	const query = `
-	SELECT t.id, t.column_id, t.name, t.description, t.position, t.created_at, t.updated_at
+	SELECT t.id, t.column_id, t.lane_id, t.parent_id, t.sprint_id, t.name, t.description, t.position, t.checklist, t.estimate, t.custom_fields, t.archived_at, t.created_at, t.updated_at, t.version
 	FROM tasks t JOIN columns c ON t.column_id = c.id
+	LEFT JOIN lanes l ON t.lane_id = l.id
 	WHERE c.board_id = $1
-	ORDER BY c.position ASC, t.position ASC
+	  AND ($2 OR t.archived_at IS NULL)
+	ORDER BY c.position ASC, l.position ASC NULLS FIRST, t.archived_at ASC NULLS FIRST, t.position ASC
 	`
 
-	rows, err := r.pgPool.Query(ctx, query, boardID)
+	rows, err := r.pgPool.Query(ctx, query, boardID, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("task repo: list by board id: %v: %w", err, ErrInternal)
 	}
@@ -176,17 +228,114 @@ func (r *PGTask) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]d
 		return nil, fmt.Errorf("task repo: list by board id: rows final error: %v: %w", err, ErrInternal)
 	}
 
+	err = attachTaskRelations(ctx, r.pgPool, result)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list by board id: relations: %v: %w", err, ErrInternal)
+	}
+
 	return result, nil
 }
 
-func (r *PGTask) ListByColumnID(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+// StreamByBoardID calls fn for every task of the board, archived ones included, in the order of
+// ListByBoardID without holding the board in memory. Links and rollups are not loaded. It stops
+// at the first error of fn and returns it as is.
+func (r *PGTask) StreamByBoardID(ctx context.Context, boardID domain.BoardID, fn func(domain.Task) error) error {
+	return streamTasksByBoardID(ctx, r.pgPool, boardID, fn)
+}
+
+func streamTasksByBoardID(ctx context.Context, q querier, boardID domain.BoardID, fn func(domain.Task) error) error {
 	const query = `
-		SELECT id, column_id, name, description, position, created_at, updated_at
-		FROM tasks
-		WHERE column_id = $1
-		ORDER BY position ASC`
+	SELECT t.id, t.column_id, t.lane_id, t.parent_id, t.sprint_id, t.name, t.description, t.position, t.checklist, t.estimate, t.custom_fields, t.archived_at, t.created_at, t.updated_at, t.version
+	FROM tasks t JOIN columns c ON t.column_id = c.id
+	LEFT JOIN lanes l ON t.lane_id = l.id
+	WHERE c.board_id = $1
+	ORDER BY c.position ASC, l.position ASC NULLS FIRST, t.archived_at ASC NULLS FIRST, t.position ASC
+	`
+
+	rows, err := q.Query(ctx, query, boardID)
+	if err != nil {
+		return fmt.Errorf("task repo: stream by board id: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		task, scanErr := ScanTask(rows)
+		if scanErr != nil {
+			return fmt.Errorf("task repo: stream by board id: scan: %v: %w", scanErr, ErrInternal)
+		}
+		err = fn(task)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("task repo: stream by board id: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// ListColumnEntries returns when each task of the board entered its current column, taken
+// from its latest transition into that column. Tasks without such a transition are left out.
+func (r *PGTask) ListColumnEntries(ctx context.Context, boardID domain.BoardID) (map[domain.TaskID]time.Time, error) {
+	const query = `
+	SELECT DISTINCT ON (tt.task_id) tt.task_id, tt.transitioned_at
+	FROM task_transitions tt
+	JOIN tasks t ON t.id = tt.task_id AND t.column_id = tt.to_column_id
+	WHERE tt.board_id = $1
+	ORDER BY tt.task_id, tt.transitioned_at DESC, tt.id DESC
+	`
+
+	rows, err := r.pgPool.Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list column entries: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	entries := make(map[domain.TaskID]time.Time)
+	for rows.Next() {
+		var (
+			rawTaskID uuid.UUID
+			enteredAt time.Time
+		)
+		err = rows.Scan(&rawTaskID, &enteredAt)
+		if err != nil {
+			return nil, fmt.Errorf("task repo: list column entries: scan: %v: %w", err, ErrInternal)
+		}
+		taskID, idErr := domain.NewTaskIDFromUUID(rawTaskID)
+		if idErr != nil {
+			return nil, fmt.Errorf("task repo: list column entries: task id: %v: %w", idErr, ErrInternal)
+		}
+		entries[taskID] = enteredAt
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list column entries: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return entries, nil
+}
 
-	rows, err := r.pgPool.Query(ctx, query, columnID)
+// ListByColumnID returns the tasks of the column holding every custom field value of filter.
+// An empty filter matches every task. Archived tasks are left out unless includeArchived is set.
+func (r *PGTask) ListByColumnID(
+	ctx context.Context,
+	columnID domain.ColumnID,
+	filter domain.TaskCustomFields,
+	includeArchived bool,
+) ([]domain.Task, error) {
+	const query = `
+		SELECT t.id, t.column_id, t.lane_id, t.parent_id, t.sprint_id, t.name, t.description, t.position, t.checklist, t.estimate, t.custom_fields, t.archived_at, t.created_at, t.updated_at, t.version
+		FROM tasks t LEFT JOIN lanes l ON t.lane_id = l.id
+		WHERE t.column_id = $1
+		  AND t.custom_fields @> $2::jsonb
+		  AND ($3 OR t.archived_at IS NULL)
+		ORDER BY l.position ASC NULLS FIRST, t.archived_at ASC NULLS FIRST, t.position ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, columnID, filter, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("task repo: list by column id: %v: %w", err, ErrInternal)
 	}
@@ -206,12 +355,17 @@ func (r *PGTask) ListByColumnID(ctx context.Context, columnID domain.ColumnID) (
 		return nil, fmt.Errorf("task repo: list by column id: rows final error: %v: %w", err, ErrInternal)
 	}
 
+	err = attachTaskRelations(ctx, r.pgPool, result)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list by column id: relations: %v: %w", err, ErrInternal)
+	}
+
 	return result, nil
 }
 
 func (r *PGTask) Get(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 	const query = `
-		SELECT id, column_id, name, description, position, created_at, updated_at
+		SELECT id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version
 		FROM tasks
 		WHERE id = $1`
 
@@ -223,260 +377,579 @@ func (r *PGTask) Get(ctx context.Context, taskID domain.TaskID) (domain.Task, er
 		return domain.Task{}, fmt.Errorf("task repo: get: %v: %w", err, ErrInternal)
 	}
 
-	return task, nil
+	tasks := []domain.Task{task}
+	err = attachTaskRelations(ctx, r.pgPool, tasks)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: get: relations: %v: %w", err, ErrInternal)
+	}
+
+	return tasks[0], nil
 }
 
+// Update changes the task if it is at the expected version, or at any version if expected
+// is zero, and bumps its version.
 func (r *PGTask) Update(
 	ctx context.Context,
 	columnID domain.ColumnID,
 	taskID domain.TaskID,
 	name *domain.TaskName,
 	description *domain.TaskDescription,
+	checklist *domain.TaskChecklist,
+	estimate *domain.TaskEstimate,
+	customFields *domain.TaskCustomFields,
+	expected domain.Version,
 ) (domain.Task, error) {
-	const query = `
+	task, err := updateTask(ctx, r.pgPool, columnID, taskID, name, description, checklist, estimate, customFields, expected)
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) || errors.Is(err, ErrVersionConflict) {
+			return domain.Task{}, err
+		}
+		return domain.Task{}, fmt.Errorf("task repo: update: %v: %w", err, ErrInternal)
+	}
+
+	tasks := []domain.Task{task}
+	err = attachTaskRelations(ctx, r.pgPool, tasks)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: update: relations: %v: %w", err, ErrInternal)
+	}
+
+	return tasks[0], nil
+}
+
+// rowQuerier is what updateTask needs of a pool or a transaction.
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// querier is what the list and stream helpers need of a pool or a transaction.
+type querier interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+}
+
+// updateTask writes the non-nil attributes of the task without its relations.
+// It returns ErrRowNotFound if the task is not in columnID and ErrVersionConflict if it is
+// not at the expected version.
+func updateTask(
+	ctx context.Context,
+	q rowQuerier,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	name *domain.TaskName,
+	description *domain.TaskDescription,
+	checklist *domain.TaskChecklist,
+	estimate *domain.TaskEstimate,
+	customFields *domain.TaskCustomFields,
+	expected domain.Version,
+) (domain.Task, error) {
+	const (
+		query = `
 		UPDATE tasks
 		SET
 			name = COALESCE($3, name),
 			description = COALESCE($4, description),
-			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+			checklist = COALESCE($5::jsonb, checklist),
+			estimate = CASE WHEN $6 THEN $7::numeric ELSE estimate END,
+			custom_fields = COALESCE($8::jsonb, custom_fields),
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+			version = version + 1
 		WHERE column_id = $1
 		  AND id = $2
-		RETURNING id, column_id, name, description, position, created_at, updated_at`
+		  AND ($9 = 0 OR version = $9)
+		RETURNING id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version`
+
+		existsQuery = `SELECT 1 FROM tasks WHERE column_id = $1 AND id = $2`
+	)
 
-	task, err := ScanTask(r.pgPool.QueryRow(ctx, query, columnID, taskID, name, description))
+	// A non-nil estimate is written even when it is unset, which clears the estimate.
+	var newEstimate domain.TaskEstimate
+	if estimate != nil {
+		newEstimate = *estimate
+	}
+	task, err := ScanTask(q.QueryRow(ctx, query, columnID, taskID, name, description, checklist, estimate != nil, newEstimate, customFields, expected))
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.Task{}, ErrRowNotFound
+			return domain.Task{}, versionMismatch(ctx, q, expected, existsQuery, columnID, taskID)
 		}
-		return domain.Task{}, fmt.Errorf("task repo: update: %v: %w", err, ErrInternal)
+		return domain.Task{}, err
 	}
 
 	return task, nil
 }
 
+// SetParent makes parentID the parent of the task, or a top-level task when parentID is nil.
+// The parent must be on boardID. It returns ErrCycle if the task is an ancestor of parentID
+// and ErrRowNotFound if either task is missing.
+func (r *PGTask) SetParent(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	parentID domain.TaskID,
+) (domain.Task, error) {
+	const (
+		// 2. The task would become its own ancestor if it is reachable walking up from the new parent.
+		detectCycleQuery = `
+		WITH RECURSIVE ancestors(task_id) AS (
+			SELECT @parent_id::uuid
+			UNION
+			SELECT t.parent_id
+			FROM tasks t
+			JOIN ancestors a ON t.id = a.task_id
+			WHERE t.parent_id IS NOT NULL
+		)
+		SELECT EXISTS (SELECT 1 FROM ancestors WHERE task_id = @task_id)`
+
+		// 3. Attach the task to its new parent.
+		updateParentQuery = `
+		UPDATE tasks
+		SET
+			parent_id = @parent_id,
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+			version = version + 1
+		WHERE column_id = @column_id
+		  AND id = @task_id
+		RETURNING id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: set parent begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// 1. Serialize hierarchy changes of the board, so concurrent updates can't close a cycle together.
+	err = lockTaskHierarchy(ctx, tx, boardID)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: set parent: %v: %w", err, ErrInternal)
+	}
+
+	if !parentID.IsNil() {
+		var cycle bool
+		err = tx.QueryRow(ctx, detectCycleQuery, pgx.NamedArgs{
+			"parent_id": parentID,
+			"task_id":   taskID,
+		}).Scan(&cycle)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("task repo: set parent detect cycle: %v: %w", err, ErrInternal)
+		}
+		if cycle {
+			return domain.Task{}, ErrCycle
+		}
+	}
+
+	task, err := ScanTask(tx.QueryRow(ctx, updateParentQuery, pgx.NamedArgs{
+		"parent_id": NullTaskID(parentID),
+		"column_id": columnID,
+		"task_id":   taskID,
+	}))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.Is(err, pgx.ErrNoRows) || errors.As(err, &pgErr) && pgErr.Code == pgForeignKeyViolation {
+			return domain.Task{}, ErrRowNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task repo: set parent update: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: set parent commit: %v: %w", err, ErrInternal)
+	}
+
+	tasks := []domain.Task{task}
+	err = attachTaskRelations(ctx, r.pgPool, tasks)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: set parent: relations: %v: %w", err, ErrInternal)
+	}
+
+	return tasks[0], nil
+}
+
+// ListChildren returns the direct children of parentID ordered as they appear on the board.
+func (r *PGTask) ListChildren(ctx context.Context, parentID domain.TaskID) ([]domain.Task, error) {
+	const query = `
+	SELECT t.id, t.column_id, t.lane_id, t.parent_id, t.sprint_id, t.name, t.description, t.position, t.checklist, t.estimate, t.custom_fields, t.archived_at, t.created_at, t.updated_at, t.version
+	FROM tasks t JOIN columns c ON t.column_id = c.id
+	LEFT JOIN lanes l ON t.lane_id = l.id
+	WHERE t.parent_id = $1
+	ORDER BY c.position ASC, l.position ASC NULLS FIRST, t.position ASC
+	`
+
+	rows, err := r.pgPool.Query(ctx, query, parentID)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list children: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	result := []domain.Task{}
+	for rows.Next() {
+		task, scanErr := ScanTask(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("task repo: list children: scan: %v: %w", scanErr, ErrInternal)
+		}
+		result = append(result, task)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list children: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	err = attachTaskRelations(ctx, r.pgPool, result)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list children: relations: %v: %w", err, ErrInternal)
+	}
+
+	return result, nil
+}
+
+// Move places the task at targetPosition of the (targetColumnID, targetLaneID) cell.
+// A nil targetLaneID is the default lane. The task must be at the expected version, or at any
+// version if expected is zero. Moving the task bumps its version, and so does shifting a task
+// to make room, so every renumbered task is a change of its own.
 func (r *PGTask) Move(
 	ctx context.Context,
 	boardID domain.BoardID,
 	currentColumnID domain.ColumnID,
 	taskID domain.TaskID,
 	targetColumnID domain.ColumnID,
+	targetLaneID domain.LaneID,
 	targetPosition domain.TaskPosition,
-) (domain.ColumnID, domain.TaskPosition, error) {
+	expected domain.Version,
+) (domain.TaskPlacement, error) {
 	const (
 		// 2. SET position order is not guaranteed, so we disable uniqueness constraint for this transaction.
 		deferPositionConstraintQuery = `
-		SET CONSTRAINTS tasks_column_id_position_key DEFERRED`
+		SET CONSTRAINTS tasks_cell_position_key DEFERRED`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.TaskPlacement{}, fmt.Errorf("task repo: move begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// 1. Lock affected columns so concurrent operations can't interrupt the move.
+	//    A column lock covers every lane of the column.
+	if currentColumnID == targetColumnID {
+		err = LockTaskColumns(ctx, tx, boardID, currentColumnID)
+	} else {
+		err = LockTaskColumns(ctx, tx, boardID, currentColumnID, targetColumnID)
+	}
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) {
+			return domain.TaskPlacement{}, ErrRowNotFound
+		}
+		return domain.TaskPlacement{}, fmt.Errorf("task repo: move lock columns: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
+	if err != nil {
+		return domain.TaskPlacement{}, fmt.Errorf("task repo: move defer position constraint: %v: %w", err, ErrInternal)
+	}
+
+	placement, err := moveTask(ctx, tx, boardID, currentColumnID, taskID, targetColumnID, targetLaneID, targetPosition, expected)
+	if err != nil {
+		return domain.TaskPlacement{}, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.TaskPlacement{}, fmt.Errorf("task repo: move commit: %v: %w", err, ErrInternal)
+	}
 
-		// 3. Read the current position of the task we are moving in its source column.
+	return placement, nil
+}
+
+// moveTask runs steps 3 to 8 of Move within tx. The caller has locked the current and target
+// columns and deferred the position constraint. ErrRowNotFound, ErrIndexOutOfBounds and
+// ErrVersionConflict are returned as is.
+func moveTask(
+	ctx context.Context,
+	tx pgx.Tx,
+	boardID domain.BoardID,
+	currentColumnID domain.ColumnID,
+	taskID domain.TaskID,
+	targetColumnID domain.ColumnID,
+	targetLaneID domain.LaneID,
+	targetPosition domain.TaskPosition,
+	expected domain.Version,
+) (domain.TaskPlacement, error) {
+	const (
+		// 3. Read the current cell position and version of the task we are moving. Archived tasks stay put.
 		getCurrentPositionQuery = `
-		SELECT position
+		SELECT position, lane_id, version
 		FROM tasks
 		WHERE column_id = @current_column_id
-		  AND id = @task_id`
+		  AND id = @task_id
+		  AND archived_at IS NULL`
 
-		// 4. Read how many tasks the target column currently has to validate targetPosition.
+		// 4. Make sure the target lane belongs to the board and can't be deleted until COMMIT.
+		lockTargetLaneQuery = `
+		SELECT 1
+		FROM lanes
+		WHERE board_id = @board_id
+		  AND id = @target_lane_id
+		FOR KEY SHARE`
+
+		// 5. Read how many tasks the target cell currently has to validate targetPosition.
 		countTargetTasksQuery = `
 		SELECT COUNT(*)
 		FROM tasks
-		WHERE column_id = @target_column_id`
+		WHERE column_id = @target_column_id
+		  AND lane_id IS NOT DISTINCT FROM @target_lane_id
+		  AND archived_at IS NULL`
 
-		// 5a. Same column, moving down: shift neighbors from (current, target] one slot up.
+		// 6a. Same cell, moving down: shift neighbors from (current, target] one slot up.
 		//     Example: moving 2 -> 5 means 3,4,5 become 2,3,4.
 		moveNeighborsDownQuery = `
 		UPDATE tasks
-		SET position = position - 1
+		SET position = position - 1,
+		    version = version + 1
 		WHERE column_id = @current_column_id
+		  AND lane_id IS NOT DISTINCT FROM @current_lane_id
+		  AND archived_at IS NULL
 		  AND position > @current_position
 		  AND position <= @target_position`
 
-		// 5b. Same column, moving up: shift neighbors from [target, current) one slot down.
+		// 6b. Same cell, moving up: shift neighbors from [target, current) one slot down.
 		//     Example: moving 5 -> 2 means 2,3,4 become 3,4,5.
 		moveNeighborsUpQuery = `
 		UPDATE tasks
-		SET position = position + 1
+		SET position = position + 1,
+		    version = version + 1
 		WHERE column_id = @current_column_id
+		  AND lane_id IS NOT DISTINCT FROM @current_lane_id
+		  AND archived_at IS NULL
 		  AND position >= @target_position
 		  AND position < @current_position`
 
-		// 5c. Cross-column compaction of the source column: shift everything below the
+		// 6c. Cross-cell compaction of the source cell: shift everything below the
 		//     moved task one slot up to close the gap.
 		compactSourceQuery = `
 		UPDATE tasks
-		SET position = position - 1
+		SET position = position - 1,
+		    version = version + 1
 		WHERE column_id = @current_column_id
+		  AND lane_id IS NOT DISTINCT FROM @current_lane_id
+		  AND archived_at IS NULL
 		  AND position > @current_position`
 
-		// 5d. Cross-column slot opening in the target column: shift positions >= target
+		// 6d. Cross-cell slot opening in the target cell: shift positions >= target
 		//     one slot down to make room.
 		openTargetSlotQuery = `
 		UPDATE tasks
-		SET position = position + 1
+		SET position = position + 1,
+		    version = version + 1
 		WHERE column_id = @target_column_id
+		  AND lane_id IS NOT DISTINCT FROM @target_lane_id
+		  AND archived_at IS NULL
 		  AND position >= @target_position`
 
-		// 6a. Same-column move: place the task at the target position.
-		moveTaskWithinColumnQuery = `
+		// 7a. Same-cell move: place the task at the target position.
+		moveTaskWithinCellQuery = `
 		UPDATE tasks
-		SET position = @target_position
-		WHERE id = @task_id`
+		SET position = @target_position,
+		    version = version + 1
+		WHERE id = @task_id
+		RETURNING version`
 
-		// 6b. Cross-column move: switch column_id and place the task at the target position.
-		moveTaskAcrossColumnsQuery = `
+		// 7b. Cross-cell move: switch column_id and lane_id and place the task at the target position.
+		moveTaskAcrossCellsQuery = `
 		UPDATE tasks
 		SET column_id = @target_column_id,
-		    position = @target_position
-		WHERE id = @task_id`
+		    lane_id = @target_lane_id,
+		    position = @target_position,
+		    version = version + 1
+		WHERE id = @task_id
+		RETURNING version`
 	)
 
-	tx, err := r.pgPool.Begin(ctx)
+	var (
+		currentPosition int64
+		currentLaneID   uuid.NullUUID
+		currentVersion  int64
+	)
+	err := tx.QueryRow(ctx, getCurrentPositionQuery, pgx.NamedArgs{
+		"current_column_id": currentColumnID,
+		"task_id":           taskID,
+	}).Scan(&currentPosition, &currentLaneID, &currentVersion)
 	if err != nil {
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move begin tx: %v: %w", err, ErrInternal)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TaskPlacement{}, ErrRowNotFound
+		}
+		return domain.TaskPlacement{}, fmt.Errorf("task repo: move get current position: %v: %w", err, ErrInternal)
+	}
+	if expected.IsSet() && expected.Int64() != currentVersion {
+		return domain.TaskPlacement{}, ErrVersionConflict
 	}
-	defer func() {
-		_ = tx.Rollback(ctx)
-	}()
 
-	sameColumn := currentColumnID == targetColumnID
-
-	// 1. Lock affected columns so concurrent operations can't interrupt the move.
-	if sameColumn {
-		err = LockTaskColumns(ctx, tx, boardID, currentColumnID)
-	} else {
-		err = LockTaskColumns(ctx, tx, boardID, currentColumnID, targetColumnID)
-	}
-	if err != nil {
-		if errors.Is(err, ErrRowNotFound) {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrRowNotFound
-		}
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move lock columns: %v: %w", err, ErrInternal)
-	}
-
-	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
-	if err != nil {
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move defer position constraint: %v: %w", err, ErrInternal)
-	}
-
-	var currentPosition int64
-	err = tx.QueryRow(ctx, getCurrentPositionQuery, pgx.NamedArgs{
-		"current_column_id": currentColumnID,
-		"task_id":           taskID,
-	}).Scan(&currentPosition)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrRowNotFound
-		}
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move get current position: %v: %w", err, ErrInternal)
-	}
+	if !targetLaneID.IsNil() {
+		var locked int
+		err = tx.QueryRow(ctx, lockTargetLaneQuery, pgx.NamedArgs{
+			"board_id":       boardID,
+			"target_lane_id": targetLaneID,
+		}).Scan(&locked)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return domain.TaskPlacement{}, ErrRowNotFound
+			}
+			return domain.TaskPlacement{}, fmt.Errorf("task repo: move lock target lane: %v: %w", err, ErrInternal)
+		}
+	}
 
 	targetPositionInt := targetPosition.Int64()
+	targetLane := NullLaneID(targetLaneID)
+	placement := domain.TaskPlacement{ColumnID: targetColumnID, LaneID: targetLaneID, Position: targetPosition}
 
 	var targetTasksCount int64
 	err = tx.QueryRow(ctx, countTargetTasksQuery, pgx.NamedArgs{
 		"target_column_id": targetColumnID,
+		"target_lane_id":   targetLane,
 	}).Scan(&targetTasksCount)
 	if err != nil {
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move count target tasks: %v: %w", err, ErrInternal)
+		return domain.TaskPlacement{}, fmt.Errorf("task repo: move count target tasks: %v: %w", err, ErrInternal)
 	}
 
-	if sameColumn {
-		// In the same column, moving the task does not grow the column, so the
+	var movedVersion int64
+	if currentColumnID == targetColumnID && currentLaneID == targetLane {
+		// In the same cell, moving the task does not grow the cell, so the
 		// upper bound is the current task count.
 		if targetPositionInt > targetTasksCount {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrIndexOutOfBounds
+			return domain.TaskPlacement{}, ErrIndexOutOfBounds
 		}
 		if targetPositionInt == currentPosition {
-			return currentColumnID, targetPosition, nil
+			placement.Version, err = domain.NewVersion(currentVersion)
+			if err != nil {
+				return domain.TaskPlacement{}, fmt.Errorf("task repo: move: version: %v: %w", err, ErrInternal)
+			}
+			return placement, nil
 		}
 
 		moveNeighborsArgs := pgx.NamedArgs{
 			"current_column_id": currentColumnID,
+			"current_lane_id":   currentLaneID,
 			"current_position":  currentPosition,
 			"target_position":   targetPositionInt,
 		}
 		if currentPosition < targetPositionInt {
 			_, err = tx.Exec(ctx, moveNeighborsDownQuery, moveNeighborsArgs)
 			if err != nil {
-				return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move neighbors down: %v: %w", err, ErrInternal)
+				return domain.TaskPlacement{}, fmt.Errorf("task repo: move neighbors down: %v: %w", err, ErrInternal)
 			}
 		} else {
 			_, err = tx.Exec(ctx, moveNeighborsUpQuery, moveNeighborsArgs)
 			if err != nil {
-				return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move neighbors up: %v: %w", err, ErrInternal)
+				return domain.TaskPlacement{}, fmt.Errorf("task repo: move neighbors up: %v: %w", err, ErrInternal)
 			}
 		}
 
-		_, err = tx.Exec(ctx, moveTaskWithinColumnQuery, pgx.NamedArgs{
+		err = tx.QueryRow(ctx, moveTaskWithinCellQuery, pgx.NamedArgs{
 			"task_id":         taskID,
 			"target_position": targetPositionInt,
-		})
+		}).Scan(&movedVersion)
 		if err != nil {
-			return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move task within column: %v: %w", err, ErrInternal)
+			return domain.TaskPlacement{}, fmt.Errorf("task repo: move task within cell: %v: %w", err, ErrInternal)
 		}
 	} else {
-		// Across columns the target column grows by one, so an append at
+		// Across cells the target cell grows by one, so an append at
 		// targetTasksCount+1 is valid.
 		if targetPositionInt > targetTasksCount+1 {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrIndexOutOfBounds
+			return domain.TaskPlacement{}, ErrIndexOutOfBounds
 		}
 
 		_, err = tx.Exec(ctx, compactSourceQuery, pgx.NamedArgs{
 			"current_column_id": currentColumnID,
+			"current_lane_id":   currentLaneID,
 			"current_position":  currentPosition,
 		})
 		if err != nil {
-			return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move compact source: %v: %w", err, ErrInternal)
+			return domain.TaskPlacement{}, fmt.Errorf("task repo: move compact source: %v: %w", err, ErrInternal)
 		}
 
 		_, err = tx.Exec(ctx, openTargetSlotQuery, pgx.NamedArgs{
 			"target_column_id": targetColumnID,
+			"target_lane_id":   targetLane,
 			"target_position":  targetPositionInt,
 		})
 		if err != nil {
-			return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move open target slot: %v: %w", err, ErrInternal)
+			return domain.TaskPlacement{}, fmt.Errorf("task repo: move open target slot: %v: %w", err, ErrInternal)
 		}
 
-		_, err = tx.Exec(ctx, moveTaskAcrossColumnsQuery, pgx.NamedArgs{
+		err = tx.QueryRow(ctx, moveTaskAcrossCellsQuery, pgx.NamedArgs{
 			"task_id":          taskID,
 			"target_column_id": targetColumnID,
+			"target_lane_id":   targetLane,
 			"target_position":  targetPositionInt,
-		})
+		}).Scan(&movedVersion)
 		if err != nil {
-			return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move task across columns: %v: %w", err, ErrInternal)
+			return domain.TaskPlacement{}, fmt.Errorf("task repo: move task across cells: %v: %w", err, ErrInternal)
+		}
+
+		// 8. A move between lanes of the same column is not a column change.
+		if currentColumnID != targetColumnID {
+			err = recordTaskTransition(ctx, tx, taskID, currentColumnID, targetColumnID)
+			if err != nil {
+				return domain.TaskPlacement{}, fmt.Errorf("task repo: move record transition: %v: %w", err, ErrInternal)
+			}
 		}
 	}
 
-	err = tx.Commit(ctx)
+	placement.Version, err = domain.NewVersion(movedVersion)
 	if err != nil {
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task repo: move commit: %v: %w", err, ErrInternal)
+		return domain.TaskPlacement{}, fmt.Errorf("task repo: move: version: %v: %w", err, ErrInternal)
 	}
 
-	return targetColumnID, targetPosition, nil
+	return placement, nil
 }
 
+// Delete removes the task if it is at the expected version, or at any version if expected is
+// zero. Its children become top-level tasks, unless cascade is set, in which case all of its
+// descendants are removed with it.
 func (r *PGTask) Delete(
 	ctx context.Context,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
 	taskID domain.TaskID,
+	cascade bool,
+	expected domain.Version,
 ) error {
 	const (
 		// 2. Defer the unique constraint until COMMIT for this transaction only.
 		deferPositionConstraintQuery = `
-		SET CONSTRAINTS tasks_column_id_position_key DEFERRED`
-
-		// 3. Delete the target task and remember its position.
-		deleteTaskQuery = `
+		SET CONSTRAINTS tasks_cell_position_key DEFERRED`
+
+		// 3. Delete the descendants first: once the task is gone, ON DELETE SET NULL
+		//    detaches its children and they can no longer be found.
+		deleteDescendantsQuery = `
+		WITH RECURSIVE descendants(id) AS (
+			SELECT id
+			FROM tasks
+			WHERE parent_id = @task_id
+			UNION
+			SELECT t.id
+			FROM tasks t
+			JOIN descendants d ON t.parent_id = d.id
+		)
 		DELETE FROM tasks
-		WHERE column_id = @column_id
-		  AND id = @task_id
-		RETURNING position`
-
-		// 4. Close the gap left by the deleted task.
-		compactTrailingTasksQuery = `
-		UPDATE tasks
-		SET position = position - 1
-		WHERE column_id = @column_id
-		  AND position > @deleted_position`
+		WHERE id IN (SELECT id FROM descendants)`
+
+		// 5 (cascade). Gaps can be in any cell, so all cells of the locked columns are renumbered.
+		renumberTasksQuery = `
+		UPDATE tasks t
+		SET position = ordered.position,
+		    version = t.version + 1
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY column_id, lane_id ORDER BY position) AS position
+			FROM tasks
+			WHERE column_id = ANY(@column_ids)
+			  AND archived_at IS NULL
+		) ordered
+		WHERE t.id = ordered.id
+		  AND t.position <> ordered.position`
 	)
 
 	tx, err := r.pgPool.Begin(ctx)
@@ -488,7 +961,24 @@ func (r *PGTask) Delete(
 	}()
 
 	// 1. Lock affected columns so concurrent operations can't interrupt the delete.
-	err = LockTaskColumns(ctx, tx, boardID, columnID)
+	//    Descendants may sit in any column of the board, so a cascade locks them all.
+	lockedColumnIDs := []domain.ColumnID{columnID}
+	if cascade {
+		err = lockTaskHierarchy(ctx, tx, boardID)
+		if err != nil {
+			return fmt.Errorf("task repo: delete: %v: %w", err, ErrInternal)
+		}
+
+		lockedColumnIDs, err = listBoardColumnIDs(ctx, tx, boardID)
+		if err != nil {
+			return fmt.Errorf("task repo: delete list board columns: %v: %w", err, ErrInternal)
+		}
+		if !slices.Contains(lockedColumnIDs, columnID) {
+			return ErrRowNotFound
+		}
+	}
+
+	err = LockTaskColumns(ctx, tx, boardID, lockedColumnIDs...)
 	if err != nil {
 		if errors.Is(err, ErrRowNotFound) {
 			return ErrRowNotFound
@@ -501,29 +991,766 @@ func (r *PGTask) Delete(
 		return fmt.Errorf("task repo: delete defer position constraint: %v: %w", err, ErrInternal)
 	}
 
-	var deletedPosition int64
-	err = tx.QueryRow(ctx, deleteTaskQuery, pgx.NamedArgs{
+	var deletedDescendants pgconn.CommandTag
+	if cascade {
+		deletedDescendants, err = tx.Exec(ctx, deleteDescendantsQuery, pgx.NamedArgs{
+			"task_id": taskID,
+		})
+		if err != nil {
+			return fmt.Errorf("task repo: delete descendants: %v: %w", err, ErrInternal)
+		}
+	}
+
+	// 4. Delete the target task. A cascade leaves the gap to the renumbering below.
+	err = removeTask(ctx, tx, columnID, taskID, !cascade, expected)
+	if err != nil {
+		return err
+	}
+
+	if cascade {
+		_, err = tx.Exec(ctx, renumberTasksQuery, pgx.NamedArgs{
+			"column_ids": lockedColumnIDs,
+		})
+		if err != nil {
+			return fmt.Errorf("task repo: delete renumber tasks: %v: %w", err, ErrInternal)
+		}
+	}
+
+	err = recordTaskDeletions(ctx, tx, boardID, deletedDescendants.RowsAffected()+1)
+	if err != nil {
+		return fmt.Errorf("task repo: delete: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("task repo: delete commit: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// ApplyBatch applies changes in order within one transaction, so either all of them are
+// written or none is. Every column a change reads or writes is locked up front in one call,
+// which takes the locks in a fixed order, so batches over the same columns queue instead of
+// deadlocking. A change whose task is not in its ColumnID, whose target lane is missing or
+// whose target position is out of bounds fails the batch with a *BatchOperationError.
+func (r *PGTask) ApplyBatch(
+	ctx context.Context,
+	boardID domain.BoardID,
+	changes []domain.TaskChange,
+) ([]domain.TaskBatchResult, error) {
+	const (
+		// 2. Defer the unique constraint until COMMIT for this transaction only.
+		deferPositionConstraintQuery = `
+		SET CONSTRAINTS tasks_cell_position_key DEFERRED`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: apply batch begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// 1. Lock every column the batch touches at once.
+	var columnIDs []domain.ColumnID
+	for _, change := range changes {
+		if !slices.Contains(columnIDs, change.ColumnID) {
+			columnIDs = append(columnIDs, change.ColumnID)
+		}
+		if change.Type == domain.TaskBatchMove && !slices.Contains(columnIDs, change.TargetColumnID) {
+			columnIDs = append(columnIDs, change.TargetColumnID)
+		}
+	}
+	err = LockTaskColumns(ctx, tx, boardID, columnIDs...)
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) {
+			return nil, ErrRowNotFound
+		}
+		return nil, fmt.Errorf("task repo: apply batch lock columns: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: apply batch defer position constraint: %v: %w", err, ErrInternal)
+	}
+
+	// 3. Apply the changes in order, each seeing what the ones before it wrote.
+	results := make([]domain.TaskBatchResult, len(changes))
+	var (
+		updated []int
+		deleted int64
+	)
+	for i, change := range changes {
+		result := domain.TaskBatchResult{Type: change.Type, TaskID: change.TaskID}
+
+		switch change.Type {
+		case domain.TaskBatchMove:
+			result.Placement, err = moveTask(ctx, tx, boardID, change.ColumnID, change.TaskID, change.TargetColumnID, change.TargetLaneID, change.TargetPosition, domain.Version{})
+		case domain.TaskBatchUpdate:
+			result.Task, err = updateTask(ctx, tx, change.ColumnID, change.TaskID, change.Name, change.Description, change.Checklist, change.Estimate, change.CustomFields, domain.Version{})
+			if err != nil && !errors.Is(err, ErrRowNotFound) {
+				err = fmt.Errorf("task repo: apply batch update: %v: %w", err, ErrInternal)
+			}
+			updated = append(updated, i)
+		case domain.TaskBatchDelete:
+			err = removeTask(ctx, tx, change.ColumnID, change.TaskID, true, domain.Version{})
+			deleted++
+		default:
+			err = fmt.Errorf("task repo: apply batch: unknown operation type %q: %w", change.Type, ErrInternal)
+		}
+		if err != nil {
+			if errors.Is(err, ErrRowNotFound) || errors.Is(err, ErrIndexOutOfBounds) {
+				return nil, &BatchOperationError{Index: i, Err: err}
+			}
+			return nil, err
+		}
+
+		results[i] = result
+	}
+
+	// 4. Deletions count in the board stats like single deletes do.
+	if deleted > 0 {
+		err = recordTaskDeletions(ctx, tx, boardID, deleted)
+		if err != nil {
+			return nil, fmt.Errorf("task repo: apply batch: %v: %w", err, ErrInternal)
+		}
+	}
+
+	// 5. Load the relations of the written tasks before committing, so a failure can't report a
+	//    committed batch as failed.
+	tasks := make([]domain.Task, len(updated))
+	for i, index := range updated {
+		tasks[i] = results[index].Task
+	}
+	err = attachTaskRelations(ctx, tx, tasks)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: apply batch: relations: %v: %w", err, ErrInternal)
+	}
+	for i, index := range updated {
+		results[index].Task = tasks[i]
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("task repo: apply batch commit: %v: %w", err, ErrInternal)
+	}
+
+	return results, nil
+}
+
+// removeTask deletes the task from columnID within tx if it is at the expected version and,
+// when closeGap is set, closes the gap it leaves in its cell. The caller has locked the column
+// and deferred the position constraint. ErrRowNotFound and ErrVersionConflict are returned as is.
+func removeTask(
+	ctx context.Context,
+	tx pgx.Tx,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	closeGap bool,
+	expected domain.Version,
+) error {
+	const (
+		// Delete the target task and remember its cell position.
+		deleteTaskQuery = `
+		DELETE FROM tasks
+		WHERE column_id = @column_id
+		  AND id = @task_id
+		  AND (@expected = 0 OR version = @expected)
+		RETURNING position, lane_id, archived_at IS NOT NULL`
+
+		// If nothing was deleted, tell a missing task from a changed one.
+		taskExistsQuery = `
+		SELECT 1
+		FROM tasks
+		WHERE column_id = @column_id
+		  AND id = @task_id`
+
+		// Close the gap left by the deleted task. Archived tasks leave no gap.
+		compactTrailingTasksQuery = `
+		UPDATE tasks
+		SET position = position - 1,
+		    version = version + 1
+		WHERE column_id = @column_id
+		  AND lane_id IS NOT DISTINCT FROM @lane_id
+		  AND archived_at IS NULL
+		  AND position > @deleted_position`
+	)
+
+	var (
+		deletedPosition int64
+		deletedLaneID   uuid.NullUUID
+		deletedArchived bool
+	)
+	err := tx.QueryRow(ctx, deleteTaskQuery, pgx.NamedArgs{
 		"column_id": columnID,
 		"task_id":   taskID,
-	}).Scan(&deletedPosition)
+		"expected":  expected,
+	}).Scan(&deletedPosition, &deletedLaneID, &deletedArchived)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
-			return ErrRowNotFound
+			err = versionMismatch(ctx, tx, expected, taskExistsQuery, pgx.NamedArgs{
+				"column_id": columnID,
+				"task_id":   taskID,
+			})
+			if errors.Is(err, ErrRowNotFound) || errors.Is(err, ErrVersionConflict) {
+				return err
+			}
 		}
 		return fmt.Errorf("task repo: delete task: %v: %w", err, ErrInternal)
 	}
 
+	if !closeGap || deletedArchived {
+		return nil
+	}
+
 	_, err = tx.Exec(ctx, compactTrailingTasksQuery, pgx.NamedArgs{
 		"column_id":        columnID,
+		"lane_id":          deletedLaneID,
 		"deleted_position": deletedPosition,
 	})
 	if err != nil {
 		return fmt.Errorf("task repo: delete compact trailing tasks: %v: %w", err, ErrInternal)
 	}
 
+	return nil
+}
+
+func listBoardColumnIDs(ctx context.Context, tx pgx.Tx, boardID domain.BoardID) ([]domain.ColumnID, error) {
+	const query = `
+		SELECT id
+		FROM columns
+		WHERE board_id = @board_id`
+
+	rows, err := tx.Query(ctx, query, pgx.NamedArgs{"board_id": boardID})
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var columnIDs []domain.ColumnID
+	for rows.Next() {
+		var rawColumnID uuid.UUID
+		err = rows.Scan(&rawColumnID)
+		if err != nil {
+			return nil, fmt.Errorf("scan: %w", err)
+		}
+		columnID, idErr := domain.NewColumnIDFromUUID(rawColumnID)
+		if idErr != nil {
+			return nil, fmt.Errorf("scan: column id: %v: %w", idErr, errDataCorrupted)
+		}
+		columnIDs = append(columnIDs, columnID)
+	}
+
+	return columnIDs, rows.Err()
+}
+
+// Duplicate copies the active task right after itself in its cell along with its active
+// subtasks, recursively, and the links between the copied tasks. Copies of subtasks go to the
+// end of the cells of their sources. Links to tasks outside the copied subtree stay with the
+// source. Every copy keeps the sprint of its source and starts its own history in its column.
+func (r *PGTask) Duplicate(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) (domain.Task, error) {
+	const (
+		// 1. Lock the columns of the board, since subtasks can be in any of them, so the copied
+		//    tasks can't change while they are copied.
+		lockColumnsQuery = `
+		SELECT id
+		FROM columns
+		WHERE board_id = @board_id
+		ORDER BY id
+		FOR UPDATE`
+
+		// 2. Defer the unique constraint until COMMIT for this transaction only.
+		deferPositionConstraintQuery = `
+		SET CONSTRAINTS tasks_cell_position_key DEFERRED`
+
+		// 3. Read the cell position of the task we are copying. Archived tasks are not copied.
+		getSourcePositionQuery = `
+		SELECT position, lane_id
+		FROM tasks
+		WHERE column_id = @column_id
+		  AND id = @task_id
+		  AND archived_at IS NULL`
+
+		// 4. Open a slot right after the source task.
+		openSlotQuery = `
+		UPDATE tasks
+		SET position = position + 1,
+		    version = version + 1
+		WHERE column_id = @column_id
+		  AND lane_id IS NOT DISTINCT FROM @lane_id
+		  AND archived_at IS NULL
+		  AND position > @source_position`
+
+		// 5. Insert the copy into the opened slot. The copy stays in the same lane, sprint and under the same parent.
+		insertCopyQuery = `
+		INSERT INTO tasks (column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields)
+		SELECT column_id, lane_id, parent_id, sprint_id, name, description, @source_position + 1, checklist, estimate, custom_fields
+		FROM tasks
+		WHERE id = @task_id
+		RETURNING id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version`
+
+		// 6. Copy the active subtasks to the end of their cells under the copies of their parents
+		//    and the links between the copied tasks. A relates_to link keeps the smaller id as its
+		//    source. The copy and the subtask copies start their own history.
+		insertSubtreeCopiesQuery = `
+		WITH RECURSIVE subtree AS (
+			SELECT id
+			FROM tasks
+			WHERE parent_id = @task_id
+			  AND archived_at IS NULL
+			UNION ALL
+			SELECT t.id
+			FROM tasks t
+			JOIN subtree s ON t.parent_id = s.id
+			WHERE t.archived_at IS NULL
+		),
+		pairs AS MATERIALIZED (
+			SELECT id AS src_id, uuidv7() AS copy_id
+			FROM subtree
+			UNION ALL
+			SELECT @task_id::uuid, @copy_id::uuid
+		),
+		copies AS (
+			INSERT INTO tasks (id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields)
+			SELECT pair.copy_id, t.column_id, t.lane_id, parent_pair.copy_id, t.sprint_id, t.name, t.description,
+			       (SELECT COALESCE(MAX(cell.position), 0)
+			        FROM tasks cell
+			        WHERE cell.column_id = t.column_id
+			          AND cell.lane_id IS NOT DISTINCT FROM t.lane_id
+			          AND cell.archived_at IS NULL)
+			       + row_number() OVER (PARTITION BY t.column_id, t.lane_id ORDER BY t.position ASC, t.id ASC),
+			       t.checklist, t.estimate, t.custom_fields
+			FROM subtree s
+			JOIN tasks t ON t.id = s.id
+			JOIN pairs pair ON pair.src_id = t.id
+			JOIN pairs parent_pair ON parent_pair.src_id = t.parent_id
+			RETURNING id, column_id
+		),
+		links AS (
+			INSERT INTO task_links (source_task_id, target_task_id, type)
+			SELECT CASE WHEN l.type = 'relates_to' THEN LEAST(src_pair.copy_id, target_pair.copy_id) ELSE src_pair.copy_id END,
+			       CASE WHEN l.type = 'relates_to' THEN GREATEST(src_pair.copy_id, target_pair.copy_id) ELSE target_pair.copy_id END,
+			       l.type
+			FROM task_links l
+			JOIN pairs src_pair ON src_pair.src_id = l.source_task_id
+			JOIN pairs target_pair ON target_pair.src_id = l.target_task_id
+		),
+		transitions AS (
+			INSERT INTO task_transitions (task_id, board_id, to_column_id)
+			SELECT id, @board_id, column_id
+			FROM copies
+			UNION ALL
+			SELECT @copy_id, @board_id, @column_id
+			RETURNING board_id, from_column_id, transitioned_at
+		)` + countTransitionsQuery
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: duplicate begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	rows, err := tx.Query(ctx, lockColumnsQuery, pgx.NamedArgs{
+		"board_id": boardID,
+	})
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: duplicate lock columns: %v: %w", err, ErrInternal)
+	}
+	columnLocked := false
+	for rows.Next() {
+		var lockedID uuid.UUID
+		scanErr := rows.Scan(&lockedID)
+		if scanErr != nil {
+			rows.Close()
+			return domain.Task{}, fmt.Errorf("task repo: duplicate lock columns: scan: %v: %w", scanErr, ErrInternal)
+		}
+		columnLocked = columnLocked || lockedID == columnID.UUID()
+	}
+	rows.Close()
+
+	err = rows.Err()
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: duplicate lock columns: rows final error: %v: %w", err, ErrInternal)
+	}
+	if !columnLocked {
+		return domain.Task{}, ErrRowNotFound
+	}
+
+	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: duplicate defer position constraint: %v: %w", err, ErrInternal)
+	}
+
+	var (
+		sourcePosition int64
+		sourceLaneID   uuid.NullUUID
+	)
+	err = tx.QueryRow(ctx, getSourcePositionQuery, pgx.NamedArgs{
+		"column_id": columnID,
+		"task_id":   taskID,
+	}).Scan(&sourcePosition, &sourceLaneID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Task{}, ErrRowNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task repo: duplicate get source position: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, openSlotQuery, pgx.NamedArgs{
+		"column_id":       columnID,
+		"lane_id":         sourceLaneID,
+		"source_position": sourcePosition,
+	})
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: duplicate open slot: %v: %w", err, ErrInternal)
+	}
+
+	task, err := ScanTask(tx.QueryRow(ctx, insertCopyQuery, pgx.NamedArgs{
+		"task_id":         taskID,
+		"source_position": sourcePosition,
+	}))
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: duplicate insert copy: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, insertSubtreeCopiesQuery, pgx.NamedArgs{
+		"board_id":  boardID,
+		"column_id": columnID,
+		"task_id":   taskID,
+		"copy_id":   task.ID,
+	})
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: duplicate insert subtree copies: %v: %w", err, ErrInternal)
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
-		return fmt.Errorf("task repo: delete commit: %v: %w", err, ErrInternal)
+		return domain.Task{}, fmt.Errorf("task repo: duplicate commit: %v: %w", err, ErrInternal)
+	}
+
+	return task, nil
+}
+
+// ListArchivalsDue returns up to limit active tasks that have stayed in their column for its
+// archive delay at now, grouped by column. A task enters its column with its latest transition
+// into it, or when it was created if that transition is unknown, and a restore starts the
+// delay over.
+func (r *PGTask) ListArchivalsDue(ctx context.Context, now time.Time, limit int) ([]domain.TaskArchival, error) {
+	const query = `
+	SELECT c.board_id, t.column_id, t.id
+	FROM tasks t
+	JOIN columns c ON c.id = t.column_id AND c.archive_after_days > 0
+	LEFT JOIN LATERAL (
+		SELECT tt.transitioned_at
+		FROM task_transitions tt
+		WHERE tt.task_id = t.id
+		  AND tt.to_column_id = t.column_id
+		ORDER BY tt.transitioned_at DESC, tt.id DESC
+		LIMIT 1
+	) entry ON true
+	WHERE t.archived_at IS NULL
+	  AND GREATEST(COALESCE(entry.transitioned_at, t.created_at), t.restored_at)
+	      + make_interval(days => c.archive_after_days) <= @now
+	ORDER BY c.board_id, t.column_id, t.id
+	LIMIT @limit`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"now":   now,
+		"limit": limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list archivals due: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var archivals []domain.TaskArchival
+	for rows.Next() {
+		var rawBoardID, rawColumnID, rawTaskID uuid.UUID
+		err = rows.Scan(&rawBoardID, &rawColumnID, &rawTaskID)
+		if err != nil {
+			return nil, fmt.Errorf("task repo: list archivals due: scan: %v: %w", err, ErrInternal)
+		}
+		boardID, idErr := domain.NewBoardIDFromUUID(rawBoardID)
+		if idErr != nil {
+			return nil, fmt.Errorf("task repo: list archivals due: board id: %v: %w", idErr, ErrInternal)
+		}
+		columnID, idErr := domain.NewColumnIDFromUUID(rawColumnID)
+		if idErr != nil {
+			return nil, fmt.Errorf("task repo: list archivals due: column id: %v: %w", idErr, ErrInternal)
+		}
+		taskID, idErr := domain.NewTaskIDFromUUID(rawTaskID)
+		if idErr != nil {
+			return nil, fmt.Errorf("task repo: list archivals due: task id: %v: %w", idErr, ErrInternal)
+		}
+		archivals = append(archivals, domain.TaskArchival{BoardID: boardID, ColumnID: columnID, TaskID: taskID})
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task repo: list archivals due: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return archivals, nil
+}
+
+// Archive archives the tasks of taskIDs that are still active in the column and closes the gaps
+// they leave in their cells. It returns the number of archived tasks, which is lower than
+// len(taskIDs) when some of them were moved, deleted or archived meanwhile, and ErrRowNotFound
+// if the column no longer exists.
+func (r *PGTask) Archive(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskIDs []domain.TaskID,
+	now time.Time,
+) (int64, error) {
+	const (
+		// 2. Defer the unique constraint until COMMIT for this transaction only.
+		deferPositionConstraintQuery = `
+		SET CONSTRAINTS tasks_cell_position_key DEFERRED`
+
+		// 3. Archive the tasks. They keep their positions outside the sequence of the active tasks.
+		archiveTasksQuery = `
+		UPDATE tasks
+		SET archived_at = @now,
+		    updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+		    version = version + 1
+		WHERE column_id = @column_id
+		  AND id = ANY(@task_ids)
+		  AND archived_at IS NULL`
+
+		// 4. Renumber the active tasks of every cell of the column to close the gaps.
+		renumberTasksQuery = `
+		UPDATE tasks t
+		SET position = ordered.position,
+		    version = t.version + 1
+		FROM (
+			SELECT id, ROW_NUMBER() OVER (PARTITION BY lane_id ORDER BY position) AS position
+			FROM tasks
+			WHERE column_id = @column_id
+			  AND archived_at IS NULL
+		) ordered
+		WHERE t.id = ordered.id
+		  AND t.position <> ordered.position`
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("task repo: archive begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// 1. Lock the column so concurrent operations can't interrupt the renumbering.
+	err = LockTaskColumns(ctx, tx, boardID, columnID)
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) {
+			return 0, ErrRowNotFound
+		}
+		return 0, fmt.Errorf("task repo: archive lock column: %v: %w", err, ErrInternal)
+	}
+
+	_, err = tx.Exec(ctx, deferPositionConstraintQuery)
+	if err != nil {
+		return 0, fmt.Errorf("task repo: archive defer position constraint: %v: %w", err, ErrInternal)
+	}
+
+	archived, err := tx.Exec(ctx, archiveTasksQuery, pgx.NamedArgs{
+		"column_id": columnID,
+		"task_ids":  taskIDs,
+		"now":       now,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("task repo: archive tasks: %v: %w", err, ErrInternal)
+	}
+	if archived.RowsAffected() == 0 {
+		return 0, nil
+	}
+
+	_, err = tx.Exec(ctx, renumberTasksQuery, pgx.NamedArgs{
+		"column_id": columnID,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("task repo: archive renumber tasks: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("task repo: archive commit: %v: %w", err, ErrInternal)
+	}
+
+	return archived.RowsAffected(), nil
+}
+
+// Restore brings the archived task back to the end of its cell and starts its archive delay
+// over. It returns ErrRowNotFound if the column has no such archived task.
+func (r *PGTask) Restore(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) (domain.Task, error) {
+	const restoreTaskQuery = `
+		UPDATE tasks t
+		SET archived_at = NULL,
+		    restored_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+		    position = (
+		        SELECT COALESCE(MAX(a.position), 0) + 1
+		        FROM tasks a
+		        WHERE a.column_id = t.column_id
+		          AND a.lane_id IS NOT DISTINCT FROM t.lane_id
+		          AND a.archived_at IS NULL
+		    ),
+		    updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC',
+		    version = t.version + 1
+		WHERE t.column_id = @column_id
+		  AND t.id = @task_id
+		  AND t.archived_at IS NOT NULL
+		RETURNING id, column_id, lane_id, parent_id, sprint_id, name, description, position, checklist, estimate, custom_fields, archived_at, created_at, updated_at, version`
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: restore begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	// Lock the column so the task is appended after every concurrent insert or move.
+	err = LockTaskColumns(ctx, tx, boardID, columnID)
+	if err != nil {
+		if errors.Is(err, ErrRowNotFound) {
+			return domain.Task{}, ErrRowNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task repo: restore lock column: %v: %w", err, ErrInternal)
+	}
+
+	task, err := ScanTask(tx.QueryRow(ctx, restoreTaskQuery, pgx.NamedArgs{
+		"column_id": columnID,
+		"task_id":   taskID,
+	}))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Task{}, ErrRowNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task repo: restore: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: restore commit: %v: %w", err, ErrInternal)
+	}
+
+	tasks := []domain.Task{task}
+	err = attachTaskRelations(ctx, r.pgPool, tasks)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task repo: restore: relations: %v: %w", err, ErrInternal)
+	}
+
+	return tasks[0], nil
+}
+
+// lockTaskHierarchy serializes parent changes on boardID until tx ends.
+func lockTaskHierarchy(ctx context.Context, tx pgx.Tx, boardID domain.BoardID) error {
+	const query = `
+		SELECT pg_advisory_xact_lock(hashtextextended('task_parents:' || @board_id::text, 0))`
+
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{"board_id": boardID})
+	if err != nil {
+		return fmt.Errorf("lock task hierarchy: %w", err)
+	}
+
+	return nil
+}
+
+// attachTaskRelations fills the links and the children rollup of tasks.
+func attachTaskRelations(ctx context.Context, q querier, tasks []domain.Task) error {
+	err := attachTaskLinks(ctx, q, tasks)
+	if err != nil {
+		return fmt.Errorf("links: %w", err)
+	}
+
+	err = attachTaskRollups(ctx, q, tasks)
+	if err != nil {
+		return fmt.Errorf("rollups: %w", err)
+	}
+
+	return nil
+}
+
+func attachTaskRollups(ctx context.Context, q querier, tasks []domain.Task) error {
+	const query = `
+		SELECT t.parent_id, c.id, c.is_done, COUNT(*)
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		WHERE t.parent_id = ANY(@task_ids)
+		GROUP BY t.parent_id, c.id
+		ORDER BY c.position ASC`
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	index := make(map[domain.TaskID]int, len(tasks))
+	taskIDs := make([]domain.TaskID, len(tasks))
+	for i := range tasks {
+		index[tasks[i].ID] = i
+		taskIDs[i] = tasks[i].ID
+		tasks[i].Rollup = domain.TaskRollup{ByColumn: []domain.TaskRollupColumn{}}
+	}
+
+	rows, err := q.Query(ctx, query, pgx.NamedArgs{"task_ids": taskIDs})
+	if err != nil {
+		return fmt.Errorf("query rollups: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rawParentID uuid.UUID
+			rawColumnID uuid.UUID
+			isDone      bool
+			count       int
+		)
+		err = rows.Scan(&rawParentID, &rawColumnID, &isDone, &count)
+		if err != nil {
+			return fmt.Errorf("scan rollup: %w", err)
+		}
+		parentID, idErr := domain.NewTaskIDFromUUID(rawParentID)
+		if idErr != nil {
+			return fmt.Errorf("scan rollup: parent id: %v: %w", idErr, errDataCorrupted)
+		}
+		columnID, idErr := domain.NewColumnIDFromUUID(rawColumnID)
+		if idErr != nil {
+			return fmt.Errorf("scan rollup: column id: %v: %w", idErr, errDataCorrupted)
+		}
+
+		i, ok := index[parentID]
+		if !ok {
+			continue
+		}
+		rollup := &tasks[i].Rollup
+		rollup.Total += count
+		if isDone {
+			rollup.Done += count
+		}
+		rollup.ByColumn = append(rollup.ByColumn, domain.TaskRollupColumn{ColumnID: columnID, Count: count})
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("rollups rows final error: %w", err)
 	}
 
 	return nil
@@ -531,15 +1758,23 @@ func (r *PGTask) Delete(
 
 func ScanTask(row interface{ Scan(...any) error }) (domain.Task, error) {
 	var (
-		rawID       uuid.UUID
-		rawColumnID uuid.UUID
-		rawName     string
-		rawDesc     string
-		rawPos      int64
-		createdAt   time.Time
-		updatedAt   time.Time
+		rawID        uuid.UUID
+		rawColumnID  uuid.UUID
+		rawLaneID    uuid.NullUUID
+		rawParentID  uuid.NullUUID
+		rawSprintID  uuid.NullUUID
+		rawName      string
+		rawDesc      string
+		rawPos       int64
+		rawChecklist []byte
+		rawEstimate  *float64
+		rawFields    []byte
+		archivedAt   *time.Time
+		createdAt    time.Time
+		updatedAt    time.Time
+		rawVersion   int64
 	)
-	err := row.Scan(&rawID, &rawColumnID, &rawName, &rawDesc, &rawPos, &createdAt, &updatedAt)
+	err := row.Scan(&rawID, &rawColumnID, &rawLaneID, &rawParentID, &rawSprintID, &rawName, &rawDesc, &rawPos, &rawChecklist, &rawEstimate, &rawFields, &archivedAt, &createdAt, &updatedAt, &rawVersion)
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("scan task: %w", err)
 	}
@@ -555,6 +1790,14 @@ func ScanTask(row interface{ Scan(...any) error }) (domain.Task, error) {
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("scan task: position: %v: %w", err, errDataCorrupted)
 	}
+	checklist, err := decodeTaskChecklist(rawChecklist)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("scan task: checklist: %v: %w", err, errDataCorrupted)
+	}
+	customFields, err := domain.ParseTaskCustomFields(rawFields)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("scan task: custom fields: %v: %w", err, errDataCorrupted)
+	}
 	id, err := domain.NewTaskIDFromUUID(rawID)
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("scan task: id: %v: %w", err, errDataCorrupted)
@@ -563,13 +1806,79 @@ func ScanTask(row interface{ Scan(...any) error }) (domain.Task, error) {
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("scan task: column id: %v: %w", err, errDataCorrupted)
 	}
-	return domain.Task{
-		ID:          id,
-		ColumnID:    columnID,
-		Name:        name,
-		Description: desc,
-		Position:    pos,
-		CreatedAt:   createdAt,
-		UpdatedAt:   updatedAt,
-	}, nil
+	var laneID domain.LaneID
+	if rawLaneID.Valid {
+		laneID, err = domain.NewLaneIDFromUUID(rawLaneID.UUID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("scan task: lane id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	var parentID domain.TaskID
+	if rawParentID.Valid {
+		parentID, err = domain.NewTaskIDFromUUID(rawParentID.UUID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("scan task: parent id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	var sprintID domain.SprintID
+	if rawSprintID.Valid {
+		sprintID, err = domain.NewSprintIDFromUUID(rawSprintID.UUID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("scan task: sprint id: %v: %w", err, errDataCorrupted)
+		}
+	}
+	var estimate domain.TaskEstimate
+	if rawEstimate != nil {
+		estimate, err = domain.NewTaskEstimate(*rawEstimate)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("scan task: estimate: %v: %w", err, errDataCorrupted)
+		}
+	}
+	version, err := domain.NewVersion(rawVersion)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("scan task: version: %v: %w", err, errDataCorrupted)
+	}
+	task := domain.Task{
+		ID:           id,
+		ColumnID:     columnID,
+		LaneID:       laneID,
+		ParentID:     parentID,
+		SprintID:     sprintID,
+		Name:         name,
+		Description:  desc,
+		Position:     pos,
+		Checklist:    checklist,
+		Estimate:     estimate,
+		CustomFields: customFields,
+		CreatedAt:    createdAt,
+		UpdatedAt:    updatedAt,
+		Version:      version,
+	}
+	if archivedAt != nil {
+		task.ArchivedAt = *archivedAt
+	}
+	return task, nil
+}
+
+// NullTaskID maps a nil task ID to SQL NULL.
+func NullTaskID(id domain.TaskID) uuid.NullUUID {
+	return uuid.NullUUID{UUID: id.UUID(), Valid: !id.IsNil()}
+}
+
+func decodeTaskChecklist(raw []byte) (domain.TaskChecklist, error) {
+	var rawItems []struct {
+		Text string `json:"text"`
+		Done bool   `json:"done"`
+	}
+	err := json.Unmarshal(raw, &rawItems)
+	if err != nil {
+		return domain.TaskChecklist{}, fmt.Errorf("unmarshal: %w", err)
+	}
+
+	items := make([]domain.TaskChecklistItem, len(rawItems))
+	for i, rawItem := range rawItems {
+		items[i] = domain.TaskChecklistItem{Text: rawItem.Text, Done: rawItem.Done}
+	}
+
+	return domain.NewTaskChecklist(items)
 }
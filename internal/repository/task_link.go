@@ -0,0 +1,323 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	taskLinkColumns       = `id, source_task_id, target_task_id, type, created_at`
+	pgForeignKeyViolation = "23503"
+)
+
+type PGTaskLink struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGTaskLink(pgPool *pgxpool.Pool) *PGTaskLink {
+	return &PGTaskLink{pgPool: pgPool}
+}
+
+// Create stores link between two tasks owned by ownerID. It returns ErrRowNotFound if either
+// task is missing or belongs to another owner, ErrCycle if a blocks link would close a
+// dependency cycle and ErrUniqueViolation if the link already exists.
+func (r *PGTaskLink) Create(ctx context.Context, ownerID domain.UserID, link domain.TaskLink) (domain.TaskLink, error) {
+	const (
+		// 1. Serialize link changes of one owner. Row locks are not enough: two concurrent
+		//    transactions adding A->B and B->A don't touch common rows but close a cycle.
+		//    Links never cross owners, so one owner's dependency graph is all that can cycle.
+		lockOwnerGraphQuery = `
+		SELECT pg_advisory_xact_lock(hashtextextended('task_links:' || @owner_id::text, 0))`
+
+		// 2. Both tasks must be on boards of the owner.
+		countOwnedTasksQuery = `
+		SELECT COUNT(*)
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		JOIN boards b ON b.id = c.board_id
+		WHERE t.id = ANY(@task_ids)
+		  AND b.owner_id = @owner_id`
+
+		// 3. The new source -> target link closes a cycle if source is already reachable from target.
+		//    UNION drops repeated rows, so the walk terminates on any graph.
+		detectCycleQuery = `
+		WITH RECURSIVE reachable(task_id) AS (
+			SELECT @target_task_id::uuid
+			UNION
+			SELECT l.target_task_id
+			FROM task_links l
+			JOIN reachable r ON l.source_task_id = r.task_id
+			WHERE l.type = 'blocks'
+		)
+		SELECT EXISTS (SELECT 1 FROM reachable WHERE task_id = @source_task_id)`
+
+		// 4. Insert the link.
+		insertLinkQuery = `
+		INSERT INTO task_links (source_task_id, target_task_id, type)
+		VALUES (@source_task_id, @target_task_id, @type)
+		RETURNING ` + taskLinkColumns
+	)
+
+	tx, err := r.pgPool.Begin(ctx)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("task link repo: create begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	_, err = tx.Exec(ctx, lockOwnerGraphQuery, pgx.NamedArgs{"owner_id": ownerID})
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("task link repo: create lock owner graph: %v: %w", err, ErrInternal)
+	}
+
+	var owned int
+	err = tx.QueryRow(ctx, countOwnedTasksQuery, pgx.NamedArgs{
+		"task_ids": []domain.TaskID{link.SourceID, link.TargetID},
+		"owner_id": ownerID,
+	}).Scan(&owned)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("task link repo: create count owned tasks: %v: %w", err, ErrInternal)
+	}
+	if owned != 2 {
+		return domain.TaskLink{}, ErrRowNotFound
+	}
+
+	if link.Type == domain.TaskLinkBlocks {
+		var cycle bool
+		err = tx.QueryRow(ctx, detectCycleQuery, pgx.NamedArgs{
+			"source_task_id": link.SourceID,
+			"target_task_id": link.TargetID,
+		}).Scan(&cycle)
+		if err != nil {
+			return domain.TaskLink{}, fmt.Errorf("task link repo: create detect cycle: %v: %w", err, ErrInternal)
+		}
+		if cycle {
+			return domain.TaskLink{}, ErrCycle
+		}
+	}
+
+	created, err := ScanTaskLink(tx.QueryRow(ctx, insertLinkQuery, pgx.NamedArgs{
+		"source_task_id": link.SourceID,
+		"target_task_id": link.TargetID,
+		"type":           link.Type.String(),
+	}))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) {
+			switch pgErr.Code {
+			case pgUniqueViolation:
+				return domain.TaskLink{}, ErrUniqueViolation
+			case pgForeignKeyViolation:
+				// One of the tasks was deleted after the ownership check.
+				return domain.TaskLink{}, ErrRowNotFound
+			}
+		}
+		return domain.TaskLink{}, fmt.Errorf("task link repo: create insert: %v: %w", err, ErrInternal)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("task link repo: create commit: %v: %w", err, ErrInternal)
+	}
+
+	return created, nil
+}
+
+// Delete removes the link if taskID is on either end of it.
+func (r *PGTaskLink) Delete(ctx context.Context, taskID domain.TaskID, linkID domain.TaskLinkID) error {
+	const query = `
+		DELETE FROM task_links
+		WHERE id = @link_id
+		  AND (source_task_id = @task_id OR target_task_id = @task_id)`
+
+	cmd, err := r.pgPool.Exec(ctx, query, pgx.NamedArgs{
+		"link_id": linkID,
+		"task_id": taskID,
+	})
+	if err != nil {
+		return fmt.Errorf("task link repo: delete: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+// ListUnfinishedBlockers returns the tasks blocking taskID that are not in a done column.
+func (r *PGTaskLink) ListUnfinishedBlockers(ctx context.Context, taskID domain.TaskID) ([]domain.TaskID, error) {
+	const query = `
+		SELECT l.source_task_id
+		FROM task_links l
+		JOIN tasks t ON t.id = l.source_task_id
+		JOIN columns c ON c.id = t.column_id
+		WHERE l.target_task_id = $1
+		  AND l.type = 'blocks'
+		  AND NOT c.is_done
+		ORDER BY l.source_task_id`
+
+	rows, err := r.pgPool.Query(ctx, query, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("task link repo: list unfinished blockers: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var result []domain.TaskID
+	for rows.Next() {
+		var rawID uuid.UUID
+		err = rows.Scan(&rawID)
+		if err != nil {
+			return nil, fmt.Errorf("task link repo: list unfinished blockers: scan: %v: %w", err, ErrInternal)
+		}
+		id, idErr := domain.NewTaskIDFromUUID(rawID)
+		if idErr != nil {
+			return nil, fmt.Errorf("task link repo: list unfinished blockers: id: %v: %w", idErr, ErrInternal)
+		}
+		result = append(result, id)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task link repo: list unfinished blockers: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return result, nil
+}
+
+// StreamByBoardID calls fn for every link between two tasks of the board, oldest first. Links to
+// tasks on other boards are left out. It stops at the first error of fn and returns it as is.
+func (r *PGTaskLink) StreamByBoardID(ctx context.Context, boardID domain.BoardID, fn func(domain.TaskLink) error) error {
+	return streamTaskLinksByBoardID(ctx, r.pgPool, boardID, fn)
+}
+
+func streamTaskLinksByBoardID(ctx context.Context, q querier, boardID domain.BoardID, fn func(domain.TaskLink) error) error {
+	const query = `
+		SELECT l.id, l.source_task_id, l.target_task_id, l.type, l.created_at
+		FROM task_links l
+		JOIN tasks s ON s.id = l.source_task_id
+		JOIN columns sc ON sc.id = s.column_id
+		JOIN tasks t ON t.id = l.target_task_id
+		JOIN columns tc ON tc.id = t.column_id
+		WHERE sc.board_id = $1
+		  AND tc.board_id = $1
+		ORDER BY l.created_at ASC, l.id ASC`
+
+	rows, err := q.Query(ctx, query, boardID)
+	if err != nil {
+		return fmt.Errorf("task link repo: stream by board id: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		link, scanErr := ScanTaskLink(rows)
+		if scanErr != nil {
+			return fmt.Errorf("task link repo: stream by board id: scan: %v: %w", scanErr, ErrInternal)
+		}
+		err = fn(link)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("task link repo: stream by board id: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// attachTaskLinks loads the links of tasks into their Links fields.
+func attachTaskLinks(ctx context.Context, q querier, tasks []domain.Task) error {
+	const query = `
+		SELECT ` + taskLinkColumns + `
+		FROM task_links
+		WHERE source_task_id = ANY(@task_ids)
+		   OR target_task_id = ANY(@task_ids)
+		ORDER BY created_at ASC, id ASC`
+
+	if len(tasks) == 0 {
+		return nil
+	}
+
+	index := make(map[domain.TaskID]int, len(tasks))
+	taskIDs := make([]domain.TaskID, len(tasks))
+	for i := range tasks {
+		index[tasks[i].ID] = i
+		taskIDs[i] = tasks[i].ID
+		tasks[i].Links = []domain.TaskLink{}
+	}
+
+	rows, err := q.Query(ctx, query, pgx.NamedArgs{"task_ids": taskIDs})
+	if err != nil {
+		return fmt.Errorf("query links: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		link, scanErr := ScanTaskLink(rows)
+		if scanErr != nil {
+			return fmt.Errorf("scan link: %w", scanErr)
+		}
+		if i, ok := index[link.SourceID]; ok {
+			tasks[i].Links = append(tasks[i].Links, link)
+		}
+		if i, ok := index[link.TargetID]; ok {
+			tasks[i].Links = append(tasks[i].Links, link)
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return fmt.Errorf("links rows final error: %w", err)
+	}
+
+	return nil
+}
+
+func ScanTaskLink(row interface{ Scan(...any) error }) (domain.TaskLink, error) {
+	var (
+		rawID       uuid.UUID
+		rawSourceID uuid.UUID
+		rawTargetID uuid.UUID
+		rawType     string
+		createdAt   time.Time
+	)
+	err := row.Scan(&rawID, &rawSourceID, &rawTargetID, &rawType, &createdAt)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("scan task link: %w", err)
+	}
+	id, err := domain.NewTaskLinkIDFromUUID(rawID)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("scan task link: id: %v: %w", err, errDataCorrupted)
+	}
+	sourceID, err := domain.NewTaskIDFromUUID(rawSourceID)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("scan task link: source task id: %v: %w", err, errDataCorrupted)
+	}
+	targetID, err := domain.NewTaskIDFromUUID(rawTargetID)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("scan task link: target task id: %v: %w", err, errDataCorrupted)
+	}
+	linkType, err := domain.NewTaskLinkType(rawType)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("scan task link: type: %v: %w", err, errDataCorrupted)
+	}
+	return domain.TaskLink{
+		ID:        id,
+		SourceID:  sourceID,
+		TargetID:  targetID,
+		Type:      linkType,
+		CreatedAt: createdAt,
+	}, nil
+}
@@ -0,0 +1,208 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestTaskLinkRepository_Create(t *testing.T) {
+	pool, r := taskLinkRepoPrelude(t)
+
+	t.Run("Success and attached to both tasks", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		first, second := insertTwoTasks(t, pool, column.ID)
+
+		created, err := r.Create(context.Background(), board.OwnerID, domain.TaskLink{SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkBlocks})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if created.ID.IsNil() {
+			t.Errorf("got empty link ID, want generated ID")
+		}
+
+		tasks, err := repository.NewPGTask(pool).ListByColumnID(context.Background(), column.ID, domain.TaskCustomFields{}, false)
+		if err != nil {
+			t.Fatalf("ListByColumnID() error = %v", err)
+		}
+		for _, task := range tasks {
+			if len(task.Links) != 1 || task.Links[0].ID != created.ID {
+				t.Errorf("task %v got links %+v, want the created link", task.ID, task.Links)
+			}
+		}
+	})
+
+	t.Run("Duplicate link", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		first, second := insertTwoTasks(t, pool, column.ID)
+		link := domain.TaskLink{SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkRelatesTo}
+
+		_, err := r.Create(context.Background(), board.OwnerID, link)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		_, err = r.Create(context.Background(), board.OwnerID, link)
+		if !errors.Is(err, repository.ErrUniqueViolation) {
+			t.Errorf("got error %v, want ErrUniqueViolation", err)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		first, second := insertTwoTasks(t, pool, column.ID)
+
+		_, err := r.Create(context.Background(), board.OwnerID, domain.TaskLink{SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkBlocks})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		_, err = r.Create(context.Background(), board.OwnerID, domain.TaskLink{SourceID: second.ID, TargetID: first.ID, Type: domain.TaskLinkBlocks})
+		if !errors.Is(err, repository.ErrCycle) {
+			t.Errorf("got error %v, want ErrCycle", err)
+		}
+	})
+
+	t.Run("Foreign owner", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, column := insertFixedUserBoardAndColumn(t, pool)
+		first, second := insertTwoTasks(t, pool, column.ID)
+
+		_, err := r.Create(context.Background(), domain.NewUserID(), domain.TaskLink{SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkBlocks})
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestTaskLinkRepository_ListUnfinishedBlockers(t *testing.T) {
+	pool, r := taskLinkRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	doneColumn := testutil.NewValidColumn(t, board.ID, "Done", 2)
+	doneColumn.ID = domain.NewColumnID()
+	doneColumn.IsDone = true
+	CreateColumn(t, pool, &doneColumn)
+
+	first, second := insertTwoTasks(t, pool, column.ID)
+	finished := testutil.NewValidTask(t, doneColumn.ID, "Finished", "", 1)
+	finished.ID = domain.NewTaskID()
+	CreateTask(t, pool, &finished)
+
+	for _, blocker := range []domain.TaskID{first.ID, finished.ID} {
+		_, err := r.Create(context.Background(), board.OwnerID, domain.TaskLink{SourceID: blocker, TargetID: second.ID, Type: domain.TaskLinkBlocks})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	}
+
+	got, err := r.ListUnfinishedBlockers(context.Background(), second.ID)
+	if err != nil {
+		t.Fatalf("ListUnfinishedBlockers() error = %v", err)
+	}
+	if want := []domain.TaskID{first.ID}; !slices.Equal(got, want) {
+		t.Errorf("got blockers %v, want %v", got, want)
+	}
+}
+
+func TestTaskLinkRepository_StreamByBoardID(t *testing.T) {
+	pool, r := taskLinkRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	first, second := insertTwoTasks(t, pool, column.ID)
+	otherBoard := testutil.ValidBoard()
+	CreateBoard(t, pool, &otherBoard)
+	otherBoardColumn := testutil.ValidColumn(otherBoard.ID)
+	CreateColumn(t, pool, &otherBoardColumn)
+	otherBoardTask := testutil.ValidTask(otherBoardColumn.ID)
+	CreateTask(t, pool, &otherBoardTask)
+
+	var want []domain.TaskLinkID
+	for _, link := range []domain.TaskLink{
+		{SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkBlocks},
+		{SourceID: second.ID, TargetID: first.ID, Type: domain.TaskLinkRelatesTo},
+		{SourceID: first.ID, TargetID: otherBoardTask.ID, Type: domain.TaskLinkDuplicates},
+	} {
+		created, err := r.Create(context.Background(), board.OwnerID, link)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		if link.TargetID != otherBoardTask.ID {
+			want = append(want, created.ID)
+		}
+	}
+
+	var got []domain.TaskLinkID
+	err := r.StreamByBoardID(context.Background(), board.ID, func(link domain.TaskLink) error {
+		got = append(got, link.ID)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("StreamByBoardID() error = %v", err)
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("got links %v, want %v", got, want)
+	}
+}
+
+func TestTaskLinkRepository_Delete(t *testing.T) {
+	pool, r := taskLinkRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	first, second := insertTwoTasks(t, pool, column.ID)
+	created, err := r.Create(context.Background(), board.OwnerID, domain.TaskLink{SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkDuplicates})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	err = r.Delete(context.Background(), domain.NewTaskID(), created.ID)
+	assertErrRowNotFound(t, err)
+
+	err = r.Delete(context.Background(), second.ID, created.ID)
+	if err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	err = r.Delete(context.Background(), second.ID, created.ID)
+	assertErrRowNotFound(t, err)
+}
+
+func insertTwoTasks(t *testing.T, pool *pgxpool.Pool, columnID domain.ColumnID) (domain.Task, domain.Task) {
+	t.Helper()
+
+	first := testutil.NewValidTask(t, columnID, "First", "", 1)
+	first.ID = domain.NewTaskID()
+	CreateTask(t, pool, &first)
+	second := testutil.NewValidTask(t, columnID, "Second", "", 2)
+	second.ID = domain.NewTaskID()
+	CreateTask(t, pool, &second)
+
+	return first, second
+}
+
+func taskLinkRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGTaskLink) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGTaskLink(pool)
+}
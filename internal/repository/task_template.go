@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGTaskTemplate struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGTaskTemplate(pgPool *pgxpool.Pool) *PGTaskTemplate {
+	return &PGTaskTemplate{pgPool: pgPool}
+}
+
+func (r *PGTaskTemplate) Create(
+	ctx context.Context,
+	boardID domain.BoardID,
+	namePattern domain.TaskNamePattern,
+	descriptionPattern domain.TaskDescriptionPattern,
+	checklist domain.TaskChecklist,
+) (domain.TaskTemplate, error) {
+	const query = `
+		INSERT INTO task_templates (board_id, name_pattern, description_pattern, checklist)
+		VALUES (@board_id, @name_pattern, @description_pattern, @checklist)
+		RETURNING id, board_id, name_pattern, description_pattern, checklist, created_at, updated_at`
+
+	template, err := ScanTaskTemplate(r.pgPool.QueryRow(ctx, query, pgx.NamedArgs{
+		"board_id":            boardID,
+		"name_pattern":        namePattern,
+		"description_pattern": descriptionPattern,
+		"checklist":           checklist,
+	}))
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("task template repo: create: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (r *PGTaskTemplate) Get(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error) {
+	const query = `
+		SELECT id, board_id, name_pattern, description_pattern, checklist, created_at, updated_at
+		FROM task_templates
+		WHERE id = $1`
+
+	template, err := ScanTaskTemplate(r.pgPool.QueryRow(ctx, query, templateID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TaskTemplate{}, ErrRowNotFound
+		}
+		return domain.TaskTemplate{}, fmt.Errorf("task template repo: get: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (r *PGTaskTemplate) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+	const query = `
+		SELECT id, board_id, name_pattern, description_pattern, checklist, created_at, updated_at
+		FROM task_templates
+		WHERE board_id = $1
+		ORDER BY created_at ASC, id ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("task template repo: list by board id: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var templates []domain.TaskTemplate
+	for rows.Next() {
+		template, scanErr := ScanTaskTemplate(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("task template repo: list by board id: scan: %v: %w", scanErr, ErrInternal)
+		}
+
+		templates = append(templates, template)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task template repo: list by board id: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return templates, nil
+}
+
+func (r *PGTaskTemplate) Update(
+	ctx context.Context,
+	boardID domain.BoardID,
+	templateID domain.TaskTemplateID,
+	namePattern *domain.TaskNamePattern,
+	descriptionPattern *domain.TaskDescriptionPattern,
+	checklist *domain.TaskChecklist,
+) (domain.TaskTemplate, error) {
+	const query = `
+		UPDATE task_templates
+		SET
+			name_pattern = COALESCE($3, name_pattern),
+			description_pattern = COALESCE($4, description_pattern),
+			checklist = COALESCE($5::jsonb, checklist),
+			updated_at = CURRENT_TIMESTAMP AT TIME ZONE 'UTC'
+		WHERE board_id = $1
+		  AND id = $2
+		RETURNING id, board_id, name_pattern, description_pattern, checklist, created_at, updated_at`
+
+	template, err := ScanTaskTemplate(r.pgPool.QueryRow(ctx, query, boardID, templateID, namePattern, descriptionPattern, checklist))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TaskTemplate{}, ErrRowNotFound
+		}
+		return domain.TaskTemplate{}, fmt.Errorf("task template repo: update: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (r *PGTaskTemplate) Delete(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+	const query = `DELETE FROM task_templates WHERE board_id = $1 AND id = $2`
+
+	cmd, err := r.pgPool.Exec(ctx, query, boardID, templateID)
+	if err != nil {
+		return fmt.Errorf("task template repo: delete: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+func ScanTaskTemplate(row interface{ Scan(...any) error }) (domain.TaskTemplate, error) {
+	var (
+		rawID          uuid.UUID
+		rawBoardID     uuid.UUID
+		rawNamePattern string
+		rawDescPattern string
+		rawChecklist   []byte
+		createdAt      time.Time
+		updatedAt      time.Time
+	)
+	err := row.Scan(&rawID, &rawBoardID, &rawNamePattern, &rawDescPattern, &rawChecklist, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("scan task template: %w", err)
+	}
+	id, err := domain.NewTaskTemplateIDFromUUID(rawID)
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("scan task template: id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("scan task template: board id: %v: %w", err, errDataCorrupted)
+	}
+	namePattern, err := domain.NewTaskNamePattern(rawNamePattern)
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("scan task template: name pattern: %v: %w", err, errDataCorrupted)
+	}
+	descPattern, err := domain.NewTaskDescriptionPattern(rawDescPattern)
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("scan task template: description pattern: %v: %w", err, errDataCorrupted)
+	}
+	checklist, err := decodeTaskChecklist(rawChecklist)
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("scan task template: checklist: %v: %w", err, errDataCorrupted)
+	}
+	return domain.TaskTemplate{
+		ID:                 id,
+		BoardID:            boardID,
+		NamePattern:        namePattern,
+		DescriptionPattern: descPattern,
+		Checklist:          checklist,
+		CreatedAt:          createdAt,
+		UpdatedAt:          updatedAt,
+	}, nil
+}
@@ -0,0 +1,222 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestTaskTemplateRepository_Create(t *testing.T) {
+	pool, r := taskTemplateRepoPrelude(t)
+
+	t.Run("Success", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+		want := testutil.ValidTaskTemplate(board.ID)
+
+		template, err := r.Create(context.Background(), board.ID, want.NamePattern, want.DescriptionPattern, want.Checklist)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		if template.ID.IsNil() {
+			t.Errorf("got empty template ID, want generated ID")
+		}
+		if template.BoardID != board.ID {
+			t.Errorf("got boardID %q, want %q", template.BoardID, board.ID)
+		}
+		if template.NamePattern != want.NamePattern {
+			t.Errorf("got name pattern %q, want %q", template.NamePattern, want.NamePattern)
+		}
+		if template.DescriptionPattern != want.DescriptionPattern {
+			t.Errorf("got description pattern %q, want %q", template.DescriptionPattern, want.DescriptionPattern)
+		}
+		if diff := cmp.Diff(want.Checklist, template.Checklist, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got checklist mismatch (-want +got):\n%s", diff)
+		}
+		AssertTimestampPrecisionAtLeastMillis(t, pool, "task_templates", "created_at", "updated_at")
+
+		stored, err := r.Get(context.Background(), template.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if diff := cmp.Diff(template, stored, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("stored template mismatch (-returned +stored):\n%s", diff)
+		}
+	})
+}
+
+func TestTaskTemplateRepository_Get(t *testing.T) {
+	pool, r := taskTemplateRepoPrelude(t)
+
+	t.Run("Not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, err := r.Get(context.Background(), domain.NewTaskTemplateID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestTaskTemplateRepository_ListByBoardID(t *testing.T) {
+	pool, r := taskTemplateRepoPrelude(t)
+
+	t.Run("Success", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+		valid := testutil.ValidTaskTemplate(board.ID)
+
+		first, err := r.Create(context.Background(), board.ID, valid.NamePattern, valid.DescriptionPattern, valid.Checklist)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+		second, err := r.Create(context.Background(), board.ID, valid.NamePattern, valid.DescriptionPattern, domain.TaskChecklist{})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		got, err := r.ListByBoardID(context.Background(), board.ID)
+		if err != nil {
+			t.Fatalf("ListByBoardID() error = %v", err)
+		}
+		if diff := cmp.Diff([]domain.TaskTemplate{first, second}, got, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got templates mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Success empty", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+
+		got, err := r.ListByBoardID(context.Background(), board.ID)
+		if err != nil {
+			t.Fatalf("ListByBoardID() error = %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("got %d templates, want 0", len(got))
+		}
+	})
+}
+
+func TestTaskTemplateRepository_Update(t *testing.T) {
+	pool, r := taskTemplateRepoPrelude(t)
+
+	t.Run("Success name pattern only", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+		valid := testutil.ValidTaskTemplate(board.ID)
+
+		created, err := r.Create(context.Background(), board.ID, valid.NamePattern, valid.DescriptionPattern, valid.Checklist)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		namePattern, _ := domain.NewTaskNamePattern("Retro {{week}}")
+		updated, err := r.Update(context.Background(), board.ID, created.ID, &namePattern, nil, nil)
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		if updated.NamePattern != namePattern {
+			t.Errorf("got name pattern %q, want %q", updated.NamePattern, namePattern)
+		}
+		if updated.DescriptionPattern != created.DescriptionPattern {
+			t.Errorf("got description pattern %q, want %q", updated.DescriptionPattern, created.DescriptionPattern)
+		}
+		if diff := cmp.Diff(created.Checklist, updated.Checklist, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got checklist mismatch (-want +got):\n%s", diff)
+		}
+		if !updated.UpdatedAt.After(created.UpdatedAt) {
+			t.Errorf("got updatedAt %v, want after %v", updated.UpdatedAt, created.UpdatedAt)
+		}
+	})
+
+	t.Run("Success clear checklist", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+		valid := testutil.ValidTaskTemplate(board.ID)
+
+		created, err := r.Create(context.Background(), board.ID, valid.NamePattern, valid.DescriptionPattern, valid.Checklist)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		empty := domain.TaskChecklist{}
+		updated, err := r.Update(context.Background(), board.ID, created.ID, nil, nil, &empty)
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if updated.Checklist.Len() != 0 {
+			t.Errorf("got %d checklist items, want 0", updated.Checklist.Len())
+		}
+	})
+
+	t.Run("Not found by board id", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+		valid := testutil.ValidTaskTemplate(board.ID)
+
+		created, err := r.Create(context.Background(), board.ID, valid.NamePattern, valid.DescriptionPattern, valid.Checklist)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		_, err = r.Update(context.Background(), domain.NewBoardID(), created.ID, &valid.NamePattern, nil, nil)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestTaskTemplateRepository_Delete(t *testing.T) {
+	pool, r := taskTemplateRepoPrelude(t)
+
+	t.Run("Success", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+		valid := testutil.ValidTaskTemplate(board.ID)
+
+		created, err := r.Create(context.Background(), board.ID, valid.NamePattern, valid.DescriptionPattern, valid.Checklist)
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+
+		err = r.Delete(context.Background(), board.ID, created.ID)
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		_, err = r.Get(context.Background(), created.ID)
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+
+		err := r.Delete(context.Background(), board.ID, domain.NewTaskTemplateID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func taskTemplateRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGTaskTemplate) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGTaskTemplate(pool)
+}
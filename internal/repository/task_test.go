@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"testing"
 	"time"
 
@@ -28,12 +29,18 @@ func TestTaskRepository_Create(t *testing.T) {
 		_, column := insertFixedUserBoardAndColumn(t, pool)
 
 		validTask := testutil.ValidTask(column.ID)
+		validTask.Checklist = testutil.NewValidTaskChecklist(t, domain.TaskChecklistItem{Text: "Write tests"}, domain.TaskChecklistItem{Text: "Ship it", Done: true})
 
 		task, err := r.Create(
 			context.Background(),
 			column.ID,
+			domain.LaneID{},
+			domain.TaskID{},
 			validTask.Name,
 			validTask.Description,
+			validTask.Checklist,
+			testutil.NewValidTaskEstimate(t, 3),
+			domain.TaskCustomFields{},
 		)
 		if err != nil {
 			t.Fatalf("Create() error = %v", err)
@@ -51,6 +58,12 @@ func TestTaskRepository_Create(t *testing.T) {
 		if task.Description != validTask.Description {
 			t.Errorf("got description %q, want %q", task.Description, validTask.Description)
 		}
+		if diff := cmp.Diff(validTask.Checklist, task.Checklist, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got checklist mismatch (-want +got):\n%s", diff)
+		}
+		if task.Estimate.Float64() != 3 {
+			t.Errorf("got estimate %v, want 3", task.Estimate.Float64())
+		}
 		if task.Position.Int64() != 1 {
 			t.Errorf("got position %d, want 1", task.Position.Int64())
 		}
@@ -90,8 +103,13 @@ func TestTaskRepository_Create_AppendsPosition(t *testing.T) {
 	second, err := r.Create(
 		context.Background(),
 		column.ID,
+		domain.LaneID{},
+		domain.TaskID{},
 		toCreate.Name,
 		toCreate.Description,
+		toCreate.Checklist,
+		domain.TaskEstimate{},
+		domain.TaskCustomFields{},
 	)
 	if err != nil {
 		t.Fatalf("Create() error = %v", err)
@@ -109,7 +127,7 @@ func TestTaskRepository_ListByColumnID(t *testing.T) {
 
 		_, column := insertFixedUserBoardAndColumn(t, pool)
 
-		tasks, err := r.ListByColumnID(context.Background(), column.ID)
+		tasks, err := r.ListByColumnID(context.Background(), column.ID, domain.TaskCustomFields{}, false)
 		if err != nil {
 			t.Fatalf("ListByColumnID() error = %v", err)
 		}
@@ -133,7 +151,7 @@ func TestTaskRepository_ListByColumnID(t *testing.T) {
 		CreateTask(t, pool, &first)
 		CreateTask(t, pool, &otherColumnTask)
 
-		got, err := r.ListByColumnID(context.Background(), columnA.ID)
+		got, err := r.ListByColumnID(context.Background(), columnA.ID, domain.TaskCustomFields{}, false)
 		if err != nil {
 			t.Fatalf("ListByColumnID() error = %v", err)
 		}
@@ -170,7 +188,7 @@ func TestTaskRepository_ListByBoardID(t *testing.T) {
 		CreateTask(t, pool, &thirdTask)
 		CreateTask(t, pool, &otherBoardTask)
 
-		got, err := r.ListByBoardID(context.Background(), board.ID)
+		got, err := r.ListByBoardID(context.Background(), board.ID, false)
 		if err != nil {
 			t.Fatalf("ListByBoardID() error = %v", err)
 		}
@@ -180,6 +198,100 @@ func TestTaskRepository_ListByBoardID(t *testing.T) {
 			t.Errorf("ListByBoardID() mismatch (-want +got):\n%s", diff)
 		}
 	})
+
+	t.Run("Archived tasks only on request", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		archived := testutil.ValidTask(column.ID)
+		archived.ArchivedAt = testutil.FixedNow().Add(time.Hour)
+		active := testutil.NewValidTask(t, column.ID, "Active", "active", 1)
+
+		CreateTask(t, pool, &archived)
+		CreateTask(t, pool, &active)
+
+		got, err := r.ListByBoardID(context.Background(), board.ID, false)
+		if err != nil {
+			t.Fatalf("ListByBoardID() error = %v", err)
+		}
+		if diff := cmp.Diff([]domain.Task{active}, got, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("ListByBoardID() active mismatch (-want +got):\n%s", diff)
+		}
+
+		got, err = r.ListByBoardID(context.Background(), board.ID, true)
+		if err != nil {
+			t.Fatalf("ListByBoardID() error = %v", err)
+		}
+		if diff := cmp.Diff([]domain.Task{active, archived}, got, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("ListByBoardID() with archived mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestTaskRepository_StreamByBoardID(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	t.Run("Success ordered like ListByBoardID with archived tasks", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, firstColumn := insertFixedUserBoardAndColumn(t, pool)
+		secondColumn := testutil.NewValidColumn(t, board.ID, "Done", 2)
+		CreateColumn(t, pool, &secondColumn)
+
+		otherBoard := testutil.ValidBoard()
+		CreateBoard(t, pool, &otherBoard)
+		otherBoardColumn := testutil.ValidColumn(otherBoard.ID)
+		CreateColumn(t, pool, &otherBoardColumn)
+
+		archived := testutil.ValidTask(firstColumn.ID)
+		archived.ArchivedAt = testutil.FixedNow().Add(time.Hour)
+		active := testutil.NewValidTask(t, firstColumn.ID, "Active", "active", 1)
+		done := testutil.ValidTask(secondColumn.ID)
+		otherBoardTask := testutil.ValidTask(otherBoardColumn.ID)
+
+		CreateTask(t, pool, &done)
+		CreateTask(t, pool, &archived)
+		CreateTask(t, pool, &active)
+		CreateTask(t, pool, &otherBoardTask)
+
+		var got []domain.Task
+		err := r.StreamByBoardID(context.Background(), board.ID, func(task domain.Task) error {
+			got = append(got, task)
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("StreamByBoardID() error = %v", err)
+		}
+
+		want := []domain.Task{active, archived, done}
+		if diff := cmp.Diff(want, got, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("StreamByBoardID() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Stops at the first error of fn", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		first := testutil.ValidTask(column.ID)
+		second := testutil.NewValidTask(t, column.ID, "Second", "second", 2)
+		CreateTask(t, pool, &first)
+		CreateTask(t, pool, &second)
+
+		stop := errors.New("stop")
+		calls := 0
+		err := r.StreamByBoardID(context.Background(), board.ID, func(task domain.Task) error {
+			calls++
+			return stop
+		})
+		if !errors.Is(err, stop) {
+			t.Errorf("got error %v, want %v", err, stop)
+		}
+		if calls != 1 {
+			t.Errorf("got %d calls, want 1", calls)
+		}
+	})
 }
 
 func TestTaskRepository_Get(t *testing.T) {
@@ -261,7 +373,7 @@ func TestTaskRepository_Update(t *testing.T) {
 		CreateTask(t, pool, &created)
 
 		want := testutil.UpdateValidTask(t, &created, "Renamed", "Renamed description", testutil.Fixed5mFromNow())
-		updated, err := r.Update(context.Background(), column.ID, created.ID, &want.Name, &want.Description)
+		updated, err := r.Update(context.Background(), column.ID, created.ID, &want.Name, &want.Description, nil, nil, nil, domain.Version{})
 		if err != nil {
 			t.Fatalf("Update() error = %v", err)
 		}
@@ -269,13 +381,93 @@ func TestTaskRepository_Update(t *testing.T) {
 		assertUpdatedTask(t, updated, want)
 	})
 
+	t.Run("Success checklist only", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, column := insertFixedUserBoardAndColumn(t, pool)
+
+		created := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &created)
+
+		checklist := testutil.NewValidTaskChecklist(t, domain.TaskChecklistItem{Text: "Review", Done: true}, domain.TaskChecklistItem{Text: "Deploy"})
+		updated, err := r.Update(context.Background(), column.ID, created.ID, nil, nil, &checklist, nil, nil, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+
+		if updated.Name != created.Name {
+			t.Errorf("got name %q, want %q", updated.Name, created.Name)
+		}
+		if diff := cmp.Diff(checklist, updated.Checklist, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("got checklist mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Sets and clears estimate", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, column := insertFixedUserBoardAndColumn(t, pool)
+
+		created := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &created)
+
+		estimate := testutil.NewValidTaskEstimate(t, 2.5)
+		updated, err := r.Update(context.Background(), column.ID, created.ID, nil, nil, nil, &estimate, nil, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if !updated.Estimate.IsSet() || updated.Estimate.Float64() != 2.5 {
+			t.Errorf("got estimate %v (set %v), want 2.5", updated.Estimate.Float64(), updated.Estimate.IsSet())
+		}
+
+		updated, err = r.Update(context.Background(), column.ID, created.ID, nil, nil, nil, &domain.TaskEstimate{}, nil, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if updated.Estimate.IsSet() {
+			t.Errorf("got estimate %v, want cleared", updated.Estimate.Float64())
+		}
+	})
+
+	t.Run("Version conflict after a concurrent update", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, column := insertFixedUserBoardAndColumn(t, pool)
+
+		created := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &created)
+
+		firstName, _ := domain.NewTaskName("First edit")
+		updated, err := r.Update(context.Background(), column.ID, created.ID, &firstName, nil, nil, nil, nil, created.Version)
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+		if updated.Version.Int64() != created.Version.Int64()+1 {
+			t.Errorf("got version %d, want %d", updated.Version.Int64(), created.Version.Int64()+1)
+		}
+
+		secondName, _ := domain.NewTaskName("Second edit")
+		_, err = r.Update(context.Background(), column.ID, created.ID, &secondName, nil, nil, nil, nil, created.Version)
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			t.Fatalf("Update() error = %v, want ErrVersionConflict", err)
+		}
+
+		got, err := r.Get(context.Background(), created.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if got.Name != firstName {
+			t.Errorf("got name %v, want %v", got.Name, firstName)
+		}
+	})
+
 	t.Run("Not found by task id", func(t *testing.T) {
 		testutil.TruncateAllTables(t, pool)
 
 		_, column := insertFixedUserBoardAndColumn(t, pool)
 
 		updatedName, _ := domain.NewTaskName("Renamed")
-		_, err := r.Update(context.Background(), column.ID, domain.NewTaskID(), &updatedName, nil)
+		_, err := r.Update(context.Background(), column.ID, domain.NewTaskID(), &updatedName, nil, nil, nil, nil, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 
@@ -288,7 +480,7 @@ func TestTaskRepository_Update(t *testing.T) {
 		CreateTask(t, pool, &created)
 
 		want := testutil.UpdateValidTask(t, &created, "Renamed", "Renamed description", testutil.Fixed5mFromNow())
-		_, err := r.Update(context.Background(), domain.NewColumnID(), created.ID, &want.Name, &want.Description)
+		_, err := r.Update(context.Background(), domain.NewColumnID(), created.ID, &want.Name, &want.Description, nil, nil, nil, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 }
@@ -311,15 +503,15 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 3)
 
-		gotColumn, gotPosition, err := r.Move(context.Background(), board.ID, column.ID, first.ID, column.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, column.ID, first.ID, column.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotColumn != column.ID {
-			t.Fatalf("Move() column = %v, want %v", gotColumn, column.ID)
+		if placement.ColumnID != column.ID {
+			t.Fatalf("Move() column = %v, want %v", placement.ColumnID, column.ID)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
 		}
 
 		got := ListTasksByColumnID(t, pool, column.ID)
@@ -346,15 +538,15 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 1)
 
-		gotColumn, gotPosition, err := r.Move(context.Background(), board.ID, column.ID, third.ID, column.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, column.ID, third.ID, column.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotColumn != column.ID {
-			t.Fatalf("Move() column = %v, want %v", gotColumn, column.ID)
+		if placement.ColumnID != column.ID {
+			t.Fatalf("Move() column = %v, want %v", placement.ColumnID, column.ID)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
 		}
 
 		got := ListTasksByColumnID(t, pool, column.ID)
@@ -379,15 +571,15 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 2)
 
-		gotColumn, gotPosition, err := r.Move(context.Background(), board.ID, column.ID, second.ID, column.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, column.ID, second.ID, column.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotColumn != column.ID {
-			t.Fatalf("Move() column = %v, want %v", gotColumn, column.ID)
+		if placement.ColumnID != column.ID {
+			t.Fatalf("Move() column = %v, want %v", placement.ColumnID, column.ID)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
 		}
 
 		got := ListTasksByColumnID(t, pool, column.ID)
@@ -413,7 +605,7 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 4)
 
-		_, _, err := r.Move(context.Background(), board.ID, column.ID, second.ID, column.ID, targetPosition)
+		_, err := r.Move(context.Background(), board.ID, column.ID, second.ID, column.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		if !errors.Is(err, repository.ErrIndexOutOfBounds) {
 			t.Fatalf("Move() error = %v, want ErrIndexOutOfBounds", err)
 		}
@@ -449,15 +641,15 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 2)
 
-		gotColumn, gotPosition, err := r.Move(context.Background(), board.ID, columnA.ID, a2.ID, columnB.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, columnA.ID, a2.ID, columnB.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotColumn != columnB.ID {
-			t.Fatalf("Move() column = %v, want %v", gotColumn, columnB.ID)
+		if placement.ColumnID != columnB.ID {
+			t.Fatalf("Move() column = %v, want %v", placement.ColumnID, columnB.ID)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
 		}
 
 		gotA := ListTasksByColumnID(t, pool, columnA.ID)
@@ -491,15 +683,15 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 2)
 
-		gotColumn, gotPosition, err := r.Move(context.Background(), board.ID, columnA.ID, a1.ID, columnB.ID, targetPosition)
+		placement, err := r.Move(context.Background(), board.ID, columnA.ID, a1.ID, columnB.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		if err != nil {
 			t.Fatalf("Move() error = %v", err)
 		}
-		if gotColumn != columnB.ID {
-			t.Fatalf("Move() column = %v, want %v", gotColumn, columnB.ID)
+		if placement.ColumnID != columnB.ID {
+			t.Fatalf("Move() column = %v, want %v", placement.ColumnID, columnB.ID)
 		}
-		if gotPosition != targetPosition {
-			t.Fatalf("Move() position = %v, want %v", gotPosition, targetPosition)
+		if placement.Position != targetPosition {
+			t.Fatalf("Move() position = %v, want %v", placement.Position, targetPosition)
 		}
 
 		gotA := ListTasksByColumnID(t, pool, columnA.ID)
@@ -515,6 +707,62 @@ func TestTaskRepository_Move(t *testing.T) {
 		assertTaskIDAndPosition(t, &gotB[1], a1.ID, 2)
 	})
 
+	t.Run("Success move into lane within column", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		lane := testutil.ValidLane(board.ID)
+		CreateLane(t, pool, &lane)
+
+		first := testutil.ValidTask(column.ID)
+		second := testutil.NewValidTask(t, column.ID, "Second", "second", 2)
+		laneTask := testutil.NewValidTask(t, column.ID, "Lane task", "lane", 1)
+		laneTask.LaneID = lane.ID
+
+		CreateTask(t, pool, &first)
+		CreateTask(t, pool, &second)
+		CreateTask(t, pool, &laneTask)
+
+		targetPosition := testutil.NewValidTaskPosition(t, 1)
+
+		placement, err := r.Move(context.Background(), board.ID, column.ID, first.ID, column.ID, lane.ID, targetPosition, domain.Version{})
+		if err != nil {
+			t.Fatalf("Move() error = %v", err)
+		}
+		if placement.ColumnID != column.ID || placement.LaneID != lane.ID || placement.Position != targetPosition {
+			t.Fatalf("Move() placement = %+v, want column %v lane %v position %v", placement, column.ID, lane.ID, targetPosition)
+		}
+
+		got := ListTasksByColumnID(t, pool, column.ID)
+		if len(got) != 3 {
+			t.Fatalf("got %d tasks after move, want 3", len(got))
+		}
+		positions := make(map[domain.TaskID]domain.Task, len(got))
+		for _, task := range got {
+			positions[task.ID] = task
+		}
+		if task := positions[second.ID]; !task.LaneID.IsNil() || task.Position.Int64() != 1 {
+			t.Errorf("got second task lane %v position %d, want default lane position 1", task.LaneID, task.Position.Int64())
+		}
+		if task := positions[first.ID]; task.LaneID != lane.ID || task.Position.Int64() != 1 {
+			t.Errorf("got moved task lane %v position %d, want lane %v position 1", task.LaneID, task.Position.Int64(), lane.ID)
+		}
+		if task := positions[laneTask.ID]; task.LaneID != lane.ID || task.Position.Int64() != 2 {
+			t.Errorf("got lane task lane %v position %d, want lane %v position 2", task.LaneID, task.Position.Int64(), lane.ID)
+		}
+	})
+
+	t.Run("Lane of another board", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		task := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &task)
+
+		_, err := r.Move(context.Background(), board.ID, column.ID, task.ID, column.ID, domain.NewLaneID(), testutil.NewValidTaskPosition(t, 1), domain.Version{})
+		assertErrRowNotFound(t, err)
+	})
+
 	t.Run("Index out of bounds across columns", func(t *testing.T) {
 		testutil.TruncateAllTables(t, pool)
 
@@ -530,7 +778,7 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 3)
 
-		_, _, err := r.Move(context.Background(), board.ID, columnA.ID, a1.ID, columnB.ID, targetPosition)
+		_, err := r.Move(context.Background(), board.ID, columnA.ID, a1.ID, columnB.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		if !errors.Is(err, repository.ErrIndexOutOfBounds) {
 			t.Fatalf("Move() error = %v, want ErrIndexOutOfBounds", err)
 		}
@@ -555,7 +803,7 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 1)
 
-		_, _, err := r.Move(context.Background(), board.ID, column.ID, domain.NewTaskID(), column.ID, targetPosition)
+		_, err := r.Move(context.Background(), board.ID, column.ID, domain.NewTaskID(), column.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 
@@ -569,7 +817,7 @@ func TestTaskRepository_Move(t *testing.T) {
 
 		targetPosition := testutil.NewValidTaskPosition(t, 1)
 
-		_, _, err := r.Move(context.Background(), domain.NewBoardID(), column.ID, created.ID, column.ID, targetPosition)
+		_, err := r.Move(context.Background(), domain.NewBoardID(), column.ID, created.ID, column.ID, domain.LaneID{}, targetPosition, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 }
@@ -590,7 +838,7 @@ func TestTaskRepository_Delete(t *testing.T) {
 		CreateTask(t, pool, &first)
 		CreateTask(t, pool, &second)
 
-		err := r.Delete(context.Background(), board.ID, column.ID, second.ID)
+		err := r.Delete(context.Background(), board.ID, column.ID, second.ID, false, domain.Version{})
 		if err != nil {
 			t.Fatalf("Delete() error = %v", err)
 		}
@@ -609,7 +857,7 @@ func TestTaskRepository_Delete(t *testing.T) {
 
 		board, column := insertFixedUserBoardAndColumn(t, pool)
 
-		err := r.Delete(context.Background(), board.ID, column.ID, domain.NewTaskID())
+		err := r.Delete(context.Background(), board.ID, column.ID, domain.NewTaskID(), false, domain.Version{})
 		assertErrRowNotFound(t, err)
 	})
 
@@ -621,7 +869,588 @@ func TestTaskRepository_Delete(t *testing.T) {
 		created := testutil.ValidTask(column.ID)
 		CreateTask(t, pool, &created)
 
-		err := r.Delete(context.Background(), domain.NewBoardID(), column.ID, created.ID)
+		err := r.Delete(context.Background(), domain.NewBoardID(), column.ID, created.ID, false, domain.Version{})
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Version conflict", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		created := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &created)
+		staleVersion := testutil.UpdateValidTask(t, &created, "Renamed", "", testutil.FixedNow()).Version
+
+		err := r.Delete(context.Background(), board.ID, column.ID, created.ID, false, staleVersion)
+		if !errors.Is(err, repository.ErrVersionConflict) {
+			t.Fatalf("Delete() error = %v, want ErrVersionConflict", err)
+		}
+
+		if got := ListTasksByColumnID(t, pool, column.ID); len(got) != 1 {
+			t.Errorf("got %d tasks after conflict, want 1", len(got))
+		}
+	})
+
+	t.Run("Orphan children", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		parent, child := insertTwoTasks(t, pool, column.ID)
+		_, err := r.SetParent(context.Background(), board.ID, column.ID, child.ID, parent.ID)
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+
+		err = r.Delete(context.Background(), board.ID, column.ID, parent.ID, false, domain.Version{})
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		got, err := r.Get(context.Background(), child.ID)
+		if err != nil {
+			t.Fatalf("Get() error = %v", err)
+		}
+		if got.HasParent() {
+			t.Errorf("got parent %v, want orphaned child", got.ParentID)
+		}
+	})
+
+	t.Run("Cascade deletes descendants in every column", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		otherColumn := testutil.NewValidColumn(t, board.ID, "Other", 2)
+		otherColumn.ID = domain.NewColumnID()
+		CreateColumn(t, pool, &otherColumn)
+
+		parent, sibling := insertTwoTasks(t, pool, column.ID)
+		child := testutil.NewValidTask(t, otherColumn.ID, "Child", "", 1)
+		child.ID = domain.NewTaskID()
+		CreateTask(t, pool, &child)
+		grandchild := testutil.NewValidTask(t, otherColumn.ID, "Grandchild", "", 2)
+		grandchild.ID = domain.NewTaskID()
+		CreateTask(t, pool, &grandchild)
+		kept := testutil.NewValidTask(t, otherColumn.ID, "Kept", "", 3)
+		kept.ID = domain.NewTaskID()
+		CreateTask(t, pool, &kept)
+
+		_, err := r.SetParent(context.Background(), board.ID, otherColumn.ID, child.ID, parent.ID)
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+		_, err = r.SetParent(context.Background(), board.ID, otherColumn.ID, grandchild.ID, child.ID)
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+
+		err = r.Delete(context.Background(), board.ID, column.ID, parent.ID, true, domain.Version{})
+		if err != nil {
+			t.Fatalf("Delete() error = %v", err)
+		}
+
+		got := ListTasksByColumnID(t, pool, column.ID)
+		if len(got) != 1 {
+			t.Fatalf("got %d tasks in column after delete, want 1", len(got))
+		}
+		assertTaskIDAndPosition(t, &got[0], sibling.ID, 1)
+
+		got = ListTasksByColumnID(t, pool, otherColumn.ID)
+		if len(got) != 1 {
+			t.Fatalf("got %d tasks in other column after delete, want 1", len(got))
+		}
+		assertTaskIDAndPosition(t, &got[0], kept.ID, 1)
+	})
+}
+
+func TestTaskRepository_ApplyBatch(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	t.Run("Success applies changes in order", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		doneColumn := testutil.NewValidColumn(t, board.ID, "Done", 2)
+		doneColumn.ID = domain.NewColumnID()
+		CreateColumn(t, pool, &doneColumn)
+
+		first := testutil.ValidTask(column.ID)
+		second := testutil.NewValidTask(t, column.ID, "Second", "second", 2)
+		third := testutil.NewValidTask(t, column.ID, "Third", "third", 3)
+		CreateTask(t, pool, &first)
+		CreateTask(t, pool, &second)
+		CreateTask(t, pool, &third)
+
+		renamed := testutil.UpdateValidTask(t, &second, "Renamed", "second", second.UpdatedAt)
+		results, err := r.ApplyBatch(context.Background(), board.ID, []domain.TaskChange{
+			{Type: domain.TaskBatchMove, TaskID: first.ID, ColumnID: column.ID, TargetColumnID: doneColumn.ID, TargetPosition: testutil.NewValidTaskPosition(t, 1)},
+			{Type: domain.TaskBatchUpdate, TaskID: second.ID, ColumnID: column.ID, Name: &renamed.Name},
+			{Type: domain.TaskBatchDelete, TaskID: third.ID, ColumnID: column.ID},
+		})
+		if err != nil {
+			t.Fatalf("ApplyBatch() error = %v", err)
+		}
+
+		if len(results) != 3 {
+			t.Fatalf("got %d results, want 3", len(results))
+		}
+		if results[0].Placement.ColumnID != doneColumn.ID || results[0].Placement.Position.Int64() != 1 {
+			t.Errorf("got placement %+v, want position 1 in the done column", results[0].Placement)
+		}
+		if results[1].Task.Name != renamed.Name {
+			t.Errorf("got name %q, want %q", results[1].Task.Name, renamed.Name)
+		}
+		if results[2].TaskID != third.ID {
+			t.Errorf("got deleted task id %v, want %v", results[2].TaskID, third.ID)
+		}
+
+		got := ListTasksByColumnID(t, pool, column.ID)
+		if len(got) != 1 {
+			t.Fatalf("got %d tasks in column after batch, want 1", len(got))
+		}
+		assertTaskIDAndPosition(t, &got[0], second.ID, 1)
+
+		got = ListTasksByColumnID(t, pool, doneColumn.ID)
+		if len(got) != 1 {
+			t.Fatalf("got %d tasks in done column after batch, want 1", len(got))
+		}
+		assertTaskIDAndPosition(t, &got[0], first.ID, 1)
+	})
+
+	t.Run("Failing change rolls back the batch", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		first, second := insertTwoTasks(t, pool, column.ID)
+
+		_, err := r.ApplyBatch(context.Background(), board.ID, []domain.TaskChange{
+			{Type: domain.TaskBatchDelete, TaskID: first.ID, ColumnID: column.ID},
+			{Type: domain.TaskBatchMove, TaskID: second.ID, ColumnID: column.ID, TargetColumnID: column.ID, TargetPosition: testutil.NewValidTaskPosition(t, 2)},
+		})
+
+		var operationErr *repository.BatchOperationError
+		if !errors.As(err, &operationErr) {
+			t.Fatalf("got error %v, want *repository.BatchOperationError", err)
+		}
+		if operationErr.Index != 1 || !errors.Is(err, repository.ErrIndexOutOfBounds) {
+			t.Errorf("got error %v, want operation 1 out of bounds", err)
+		}
+
+		got := ListTasksByColumnID(t, pool, column.ID)
+		if len(got) != 2 {
+			t.Fatalf("got %d tasks after failed batch, want 2", len(got))
+		}
+		assertTaskIDAndPosition(t, &got[0], first.ID, 1)
+		assertTaskIDAndPosition(t, &got[1], second.ID, 2)
+	})
+
+	t.Run("Task not in its column", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		_, err := r.ApplyBatch(context.Background(), board.ID, []domain.TaskChange{
+			{Type: domain.TaskBatchDelete, TaskID: domain.NewTaskID(), ColumnID: column.ID},
+		})
+
+		var operationErr *repository.BatchOperationError
+		if !errors.As(err, &operationErr) || operationErr.Index != 0 {
+			t.Fatalf("got error %v, want *repository.BatchOperationError of operation 0", err)
+		}
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Column of another board", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, column := insertFixedUserBoardAndColumn(t, pool)
+		created := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &created)
+
+		_, err := r.ApplyBatch(context.Background(), domain.NewBoardID(), []domain.TaskChange{
+			{Type: domain.TaskBatchDelete, TaskID: created.ID, ColumnID: column.ID},
+		})
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestTaskRepository_SetParent(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	t.Run("Success and clear", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		parent, child := insertTwoTasks(t, pool, column.ID)
+
+		got, err := r.SetParent(context.Background(), board.ID, column.ID, child.ID, parent.ID)
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+		if got.ParentID != parent.ID {
+			t.Errorf("got parent %v, want %v", got.ParentID, parent.ID)
+		}
+
+		got, err = r.SetParent(context.Background(), board.ID, column.ID, child.ID, domain.TaskID{})
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+		if got.HasParent() {
+			t.Errorf("got parent %v, want none", got.ParentID)
+		}
+	})
+
+	t.Run("Cycle", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		parent, child := insertTwoTasks(t, pool, column.ID)
+
+		_, err := r.SetParent(context.Background(), board.ID, column.ID, child.ID, parent.ID)
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+		_, err = r.SetParent(context.Background(), board.ID, column.ID, parent.ID, child.ID)
+		if !errors.Is(err, repository.ErrCycle) {
+			t.Errorf("got error %v, want ErrCycle", err)
+		}
+	})
+
+	t.Run("Parent not found", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		created := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &created)
+
+		_, err := r.SetParent(context.Background(), board.ID, column.ID, created.ID, domain.NewTaskID())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestTaskRepository_ListChildren(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, column := insertFixedUserBoardAndColumn(t, pool)
+	doneColumn := testutil.NewValidColumn(t, board.ID, "Done", 2)
+	doneColumn.ID = domain.NewColumnID()
+	doneColumn.IsDone = true
+	CreateColumn(t, pool, &doneColumn)
+
+	parent, open := insertTwoTasks(t, pool, column.ID)
+	finished := testutil.NewValidTask(t, doneColumn.ID, "Finished", "", 1)
+	finished.ID = domain.NewTaskID()
+	CreateTask(t, pool, &finished)
+
+	for _, child := range []domain.Task{finished, open} {
+		_, err := r.SetParent(context.Background(), board.ID, child.ColumnID, child.ID, parent.ID)
+		if err != nil {
+			t.Fatalf("SetParent() error = %v", err)
+		}
+	}
+
+	children, err := r.ListChildren(context.Background(), parent.ID)
+	if err != nil {
+		t.Fatalf("ListChildren() error = %v", err)
+	}
+	gotIDs := make([]domain.TaskID, 0, len(children))
+	for _, child := range children {
+		gotIDs = append(gotIDs, child.ID)
+	}
+	if want := []domain.TaskID{open.ID, finished.ID}; !slices.Equal(gotIDs, want) {
+		t.Errorf("got children %v, want %v", gotIDs, want)
+	}
+
+	got, err := r.Get(context.Background(), parent.ID)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got.Rollup.Total != 2 || got.Rollup.Done != 1 {
+		t.Errorf("got rollup total %d done %d, want 2 and 1", got.Rollup.Total, got.Rollup.Done)
+	}
+	if len(got.Rollup.ByColumn) != 2 || got.Rollup.ByColumn[0].ColumnID != column.ID || got.Rollup.ByColumn[1].ColumnID != doneColumn.ID {
+		t.Errorf("got rollup by column %+v, want one entry per column in board order", got.Rollup.ByColumn)
+	}
+}
+
+func TestTaskRepository_Duplicate(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	t.Run("Success places copy after original", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		first := testutil.ValidTask(column.ID)
+		second := testutil.NewValidTask(t, column.ID, "Second", "second", 2)
+		third := testutil.NewValidTask(t, column.ID, "Third", "third", 3)
+
+		CreateTask(t, pool, &first)
+		CreateTask(t, pool, &second)
+		CreateTask(t, pool, &third)
+
+		copied, err := r.Duplicate(context.Background(), board.ID, column.ID, second.ID)
+		if err != nil {
+			t.Fatalf("Duplicate() error = %v", err)
+		}
+
+		if copied.ID == second.ID {
+			t.Errorf("got copy id %q, want new id", copied.ID)
+		}
+		if copied.Name != second.Name {
+			t.Errorf("got name %q, want %q", copied.Name, second.Name)
+		}
+		if copied.Description != second.Description {
+			t.Errorf("got description %q, want %q", copied.Description, second.Description)
+		}
+
+		got := ListTasksByColumnID(t, pool, column.ID)
+
+		if len(got) != 4 {
+			t.Fatalf("got %d tasks after duplicate, want 4", len(got))
+		}
+		assertTaskIDAndPosition(t, &got[0], first.ID, 1)
+		assertTaskIDAndPosition(t, &got[1], second.ID, 2)
+		assertTaskIDAndPosition(t, &got[2], copied.ID, 3)
+		assertTaskIDAndPosition(t, &got[3], third.ID, 4)
+	})
+
+	t.Run("Success copies subtasks and links between them", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+		done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+		CreateColumn(t, pool, &done)
+
+		parent := testutil.NewValidTask(t, column.ID, "Parent", "", 1)
+		parent.ID = domain.NewTaskID()
+		CreateTask(t, pool, &parent)
+		child := testutil.NewValidTask(t, done.ID, "Child", "", 1)
+		child.ID = domain.NewTaskID()
+		child.ParentID = parent.ID
+		CreateTask(t, pool, &child)
+		archivedChild := testutil.NewValidTask(t, done.ID, "Archived child", "", 2)
+		archivedChild.ID = domain.NewTaskID()
+		archivedChild.ParentID = parent.ID
+		archivedChild.ArchivedAt = testutil.FixedNow()
+		CreateTask(t, pool, &archivedChild)
+		execQuery(t, pool, `INSERT INTO task_links (source_task_id, target_task_id, type) VALUES ($1, $2, 'blocks')`, parent.ID, child.ID)
+
+		copied, err := r.Duplicate(context.Background(), board.ID, column.ID, parent.ID)
+		if err != nil {
+			t.Fatalf("Duplicate() error = %v", err)
+		}
+
+		gotDone := ListTasksByColumnID(t, pool, done.ID)
+		var childCopy domain.Task
+		for _, task := range gotDone {
+			if task.ParentID == copied.ID {
+				if !childCopy.ID.IsNil() {
+					t.Fatalf("got more than one subtask copy, want only the active child")
+				}
+				childCopy = task
+			}
+		}
+		if childCopy.ID.IsNil() {
+			t.Fatalf("got no subtask copy under %q", copied.ID)
+		}
+		if childCopy.ID == child.ID || childCopy.Name != child.Name {
+			t.Errorf("got subtask copy %q named %q, want a new task named %q", childCopy.ID, childCopy.Name, child.Name)
+		}
+		if childCopy.Position.Int64() != 2 {
+			t.Errorf("got subtask copy at %d, want 2 at the end of its cell", childCopy.Position.Int64())
+		}
+
+		var sourceID, targetID domain.TaskID
+		err = pool.QueryRow(
+			context.Background(), `
+			SELECT source_task_id, target_task_id
+			FROM task_links
+			WHERE type = 'blocks' AND source_task_id = $1`,
+			copied.ID,
+		).Scan(&sourceID, &targetID)
+		if err != nil {
+			t.Fatalf("query copied link error = %v", err)
+		}
+		if targetID != childCopy.ID {
+			t.Errorf("got link %v -> %v, want %v -> %v", sourceID, targetID, copied.ID, childCopy.ID)
+		}
+	})
+
+	t.Run("Not found by task id", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		_, err := r.Duplicate(context.Background(), board.ID, column.ID, domain.NewTaskID())
+		assertErrRowNotFound(t, err)
+	})
+
+	t.Run("Not found by board id", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		_, column := insertFixedUserBoardAndColumn(t, pool)
+
+		created := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &created)
+
+		_, err := r.Duplicate(context.Background(), domain.NewBoardID(), column.ID, created.ID)
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestTaskRepository_ListArchivalsDue(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+	done.ArchiveAfter = testutil.NewValidColumnArchiveAfter(t, 14)
+	CreateColumn(t, pool, &done)
+
+	due := testutil.ValidTask(done.ID)
+	archived := testutil.NewValidTask(t, done.ID, "Archived", "archived", 1)
+	archived.ArchivedAt = testutil.FixedNow().Add(time.Hour)
+	todoTask := testutil.ValidTask(todo.ID)
+	moved := testutil.NewValidTask(t, todo.ID, "Moved", "moved", 2)
+
+	CreateTask(t, pool, &due)
+	CreateTask(t, pool, &archived)
+	CreateTask(t, pool, &todoTask)
+	CreateTask(t, pool, &moved)
+
+	// The move records a transition now, which restarts the archive delay of the task.
+	_, err := r.Move(context.Background(), board.ID, todo.ID, moved.ID, done.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 2), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+
+	t.Run("Success lists tasks past the delay", func(t *testing.T) {
+		got, err := r.ListArchivalsDue(context.Background(), testutil.FixedNow().AddDate(0, 0, 15), 10)
+		if err != nil {
+			t.Fatalf("ListArchivalsDue() error = %v", err)
+		}
+
+		want := []domain.TaskArchival{{BoardID: board.ID, ColumnID: done.ID, TaskID: due.ID}}
+		if diff := cmp.Diff(want, got, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("ListArchivalsDue() mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Nothing due before the delay", func(t *testing.T) {
+		got, err := r.ListArchivalsDue(context.Background(), testutil.FixedNow().AddDate(0, 0, 13), 10)
+		if err != nil {
+			t.Fatalf("ListArchivalsDue() error = %v", err)
+		}
+		if len(got) != 0 {
+			t.Errorf("got %d archivals, want none", len(got))
+		}
+	})
+}
+
+func TestTaskRepository_Archive(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	t.Run("Success closes the gap", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		first := testutil.ValidTask(column.ID)
+		second := testutil.NewValidTask(t, column.ID, "Second", "second", 2)
+		third := testutil.NewValidTask(t, column.ID, "Third", "third", 3)
+
+		CreateTask(t, pool, &first)
+		CreateTask(t, pool, &second)
+		CreateTask(t, pool, &third)
+
+		now := testutil.FixedNow().AddDate(0, 0, 30)
+		count, err := r.Archive(context.Background(), board.ID, column.ID, []domain.TaskID{second.ID}, now)
+		if err != nil {
+			t.Fatalf("Archive() error = %v", err)
+		}
+		if count != 1 {
+			t.Errorf("got archived count %d, want 1", count)
+		}
+
+		got := ListTasksByColumnID(t, pool, column.ID)
+
+		if len(got) != 3 {
+			t.Fatalf("got %d tasks after archive, want 3", len(got))
+		}
+		assertTaskIDAndPosition(t, &got[0], first.ID, 1)
+		assertTaskIDAndPosition(t, &got[1], third.ID, 2)
+		assertTaskIDAndPosition(t, &got[2], second.ID, 2)
+		if !got[2].ArchivedAt.Equal(now) {
+			t.Errorf("got archived at %v, want %v", got[2].ArchivedAt, now)
+		}
+	})
+
+	t.Run("Skips tasks no longer active in the column", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		archived := testutil.ValidTask(column.ID)
+		archived.ArchivedAt = testutil.FixedNow().Add(time.Hour)
+		CreateTask(t, pool, &archived)
+
+		count, err := r.Archive(context.Background(), board.ID, column.ID, []domain.TaskID{archived.ID, domain.NewTaskID()}, testutil.FixedNow())
+		if err != nil {
+			t.Fatalf("Archive() error = %v", err)
+		}
+		if count != 0 {
+			t.Errorf("got archived count %d, want 0", count)
+		}
+	})
+
+	t.Run("Not found by column id", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, _ := insertFixedUserBoardAndColumn(t, pool)
+
+		_, err := r.Archive(context.Background(), board.ID, domain.NewColumnID(), []domain.TaskID{domain.NewTaskID()}, testutil.FixedNow())
+		assertErrRowNotFound(t, err)
+	})
+}
+
+func TestTaskRepository_Restore(t *testing.T) {
+	pool, r := taskRepoPrelude(t)
+
+	t.Run("Success appends to the cell", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		archived := testutil.ValidTask(column.ID)
+		archived.ArchivedAt = testutil.FixedNow().Add(time.Hour)
+		active := testutil.NewValidTask(t, column.ID, "Active", "active", 1)
+
+		CreateTask(t, pool, &archived)
+		CreateTask(t, pool, &active)
+
+		restored, err := r.Restore(context.Background(), board.ID, column.ID, archived.ID)
+		if err != nil {
+			t.Fatalf("Restore() error = %v", err)
+		}
+		if restored.IsArchived() {
+			t.Errorf("got archived at %v, want active task", restored.ArchivedAt)
+		}
+		assertTaskIDAndPosition(t, &restored, archived.ID, 2)
+	})
+
+	t.Run("Not found for active task", func(t *testing.T) {
+		testutil.TruncateAllTables(t, pool)
+
+		board, column := insertFixedUserBoardAndColumn(t, pool)
+
+		active := testutil.ValidTask(column.ID)
+		CreateTask(t, pool, &active)
+
+		_, err := r.Restore(context.Background(), board.ID, column.ID, active.ID)
 		assertErrRowNotFound(t, err)
 	})
 }
@@ -0,0 +1,457 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type PGTaskTransition struct {
+	pgPool *pgxpool.Pool
+}
+
+func NewPGTaskTransition(pgPool *pgxpool.Pool) *PGTaskTransition {
+	return &PGTaskTransition{pgPool: pgPool}
+}
+
+// CumulativeFlow counts the tasks of every column of the board at the end of each UTC day from
+// from through to, stepping by interval. Columns without tasks on a day are left out.
+func (r *PGTaskTransition) CumulativeFlow(
+	ctx context.Context,
+	boardID domain.BoardID,
+	from time.Time,
+	to time.Time,
+	interval domain.FlowInterval,
+) ([]domain.CumulativeFlowCount, error) {
+	// A task sits in the column of a transition from that transition until the next one,
+	// so a day counts the spans that started before the day ended and did not end before.
+	const query = `
+		WITH days AS (
+			SELECT d::date AS day
+			FROM generate_series(@from::date, @to::date, make_interval(days => @step_days)) AS d
+		),
+		spans AS (
+			SELECT tt.to_column_id AS column_id,
+			       tt.transitioned_at AS entered_at,
+			       LEAD(tt.transitioned_at) OVER (PARTITION BY tt.task_id ORDER BY tt.transitioned_at, tt.id) AS left_at
+			FROM task_transitions tt
+			WHERE tt.board_id = @board_id
+			  AND tt.transitioned_at < @to::date + 1
+		)
+		SELECT d.day, s.column_id, COUNT(*)
+		FROM days d
+		JOIN spans s ON s.entered_at < d.day + 1
+		            AND (s.left_at IS NULL OR s.left_at >= d.day + 1)
+		GROUP BY d.day, s.column_id
+		ORDER BY d.day ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"board_id":  boardID,
+		"from":      from,
+		"to":        to,
+		"step_days": interval.Days(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: cumulative flow: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var counts []domain.CumulativeFlowCount
+	for rows.Next() {
+		var (
+			count       domain.CumulativeFlowCount
+			rawColumnID uuid.UUID
+		)
+		err = rows.Scan(&count.Day, &rawColumnID, &count.Count)
+		if err != nil {
+			return nil, fmt.Errorf("task transition repo: cumulative flow: scan: %v: %w", err, ErrInternal)
+		}
+		count.ColumnID, err = domain.NewColumnIDFromUUID(rawColumnID)
+		if err != nil {
+			return nil, fmt.Errorf("task transition repo: cumulative flow: scan: column id: %v: %w", err, ErrInternal)
+		}
+		counts = append(counts, count)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: cumulative flow: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return counts, nil
+}
+
+// completedTasksCTE selects as "completed" the tasks of @board_id that sit in a done column and
+// were finished from @from through the end of @to. A task starts when it first enters a started
+// or done column and is finished when it last enters a done column from a column that is not
+// done, so moves between done columns keep the original finish time.
+const completedTasksCTE = `
+	WITH history AS (
+		SELECT t.id, t.name, t.created_at,
+		       MIN(tt.transitioned_at) FILTER (WHERE tc.is_started OR tc.is_done) AS started_at,
+		       MAX(tt.transitioned_at) FILTER (WHERE tc.is_done AND NOT COALESCE(fc.is_done, false)) AS done_at
+		FROM tasks t
+		JOIN columns c ON c.id = t.column_id
+		JOIN task_transitions tt ON tt.task_id = t.id
+		JOIN columns tc ON tc.id = tt.to_column_id
+		LEFT JOIN columns fc ON fc.id = tt.from_column_id
+		WHERE c.board_id = @board_id
+		  AND c.is_done
+		GROUP BY t.id
+	),
+	completed AS (
+		SELECT id, name, created_at, started_at, done_at
+		FROM history
+		WHERE done_at >= @from::date
+		  AND done_at < @to::date + 1
+	)`
+
+// CycleTimes returns the lead and cycle time percentiles of the tasks of the board finished
+// from from through to, along with the tasks themselves.
+func (r *PGTaskTransition) CycleTimes(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error) {
+	const (
+		percentilesQuery = completedTasksCTE + `
+		SELECT percentile_cont(ARRAY[0.5, 0.85, 0.95]) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM done_at - created_at)::float8),
+		       percentile_cont(ARRAY[0.5, 0.85, 0.95]) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM done_at - started_at)::float8)
+		FROM completed`
+		tasksQuery = completedTasksCTE + `
+		SELECT id, name, created_at, started_at, done_at
+		FROM completed
+		ORDER BY done_at ASC, id ASC`
+	)
+
+	// A repeatable-read snapshot keeps the percentiles consistent with the listed tasks.
+	tx, err := r.pgPool.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return domain.CycleTimeReport{}, fmt.Errorf("task transition repo: cycle times begin tx: %v: %w", err, ErrInternal)
+	}
+	defer func() {
+		_ = tx.Rollback(ctx)
+	}()
+
+	args := pgx.NamedArgs{
+		"board_id": boardID,
+		"from":     from,
+		"to":       to,
+	}
+	report := domain.CycleTimeReport{BoardID: boardID, From: from, To: to}
+
+	var leadTimes, cycleTimes []float64
+	err = tx.QueryRow(ctx, percentilesQuery, args).Scan(&leadTimes, &cycleTimes)
+	if err != nil {
+		return domain.CycleTimeReport{}, fmt.Errorf("task transition repo: cycle times percentiles: %v: %w", err, ErrInternal)
+	}
+	report.LeadTime = newDurationPercentiles(leadTimes)
+	report.CycleTime = newDurationPercentiles(cycleTimes)
+
+	rows, err := tx.Query(ctx, tasksQuery, args)
+	if err != nil {
+		return domain.CycleTimeReport{}, fmt.Errorf("task transition repo: cycle times tasks: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			rawID   uuid.UUID
+			rawName string
+			task    domain.CompletedTask
+		)
+		err = rows.Scan(&rawID, &rawName, &task.CreatedAt, &task.StartedAt, &task.DoneAt)
+		if err != nil {
+			return domain.CycleTimeReport{}, fmt.Errorf("task transition repo: cycle times tasks: scan: %v: %w", err, ErrInternal)
+		}
+		task.ID, err = domain.NewTaskIDFromUUID(rawID)
+		if err != nil {
+			return domain.CycleTimeReport{}, fmt.Errorf("task transition repo: cycle times tasks: scan: id: %v: %w", err, ErrInternal)
+		}
+		task.Name, err = domain.NewTaskName(rawName)
+		if err != nil {
+			return domain.CycleTimeReport{}, fmt.Errorf("task transition repo: cycle times tasks: scan: name: %v: %w", err, ErrInternal)
+		}
+		report.Tasks = append(report.Tasks, task)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return domain.CycleTimeReport{}, fmt.Errorf("task transition repo: cycle times tasks: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return report, nil
+}
+
+// Throughput counts the tasks of the board finished from from through to by the Monday-based
+// week they were finished in. Weeks without finished tasks get a zero count.
+func (r *PGTaskTransition) Throughput(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]domain.ThroughputWeek, error) {
+	const query = completedTasksCTE + `,
+	weeks AS (
+		SELECT w AS start
+		FROM generate_series(date_trunc('week', @from::timestamp), date_trunc('week', @to::timestamp), interval '7 days') AS w
+	)
+	SELECT weeks.start, COUNT(completed.id)
+	FROM weeks
+	LEFT JOIN completed ON date_trunc('week', completed.done_at) = weeks.start
+	GROUP BY weeks.start
+	ORDER BY weeks.start ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"board_id": boardID,
+		"from":     from,
+		"to":       to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: throughput: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var weeks []domain.ThroughputWeek
+	for rows.Next() {
+		var week domain.ThroughputWeek
+		err = rows.Scan(&week.Start, &week.Completed)
+		if err != nil {
+			return nil, fmt.Errorf("task transition repo: throughput: scan: %v: %w", err, ErrInternal)
+		}
+		weeks = append(weeks, week)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: throughput: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return weeks, nil
+}
+
+// DailyThroughput counts the tasks of the board finished on each day from from through to,
+// in day order. Days without finished tasks get a zero count.
+func (r *PGTaskTransition) DailyThroughput(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]int64, error) {
+	const query = completedTasksCTE + `,
+	days AS (
+		SELECT d::date AS day
+		FROM generate_series(@from::date, @to::date, interval '1 day') AS d
+	)
+	SELECT COUNT(completed.id)
+	FROM days
+	LEFT JOIN completed ON completed.done_at >= days.day
+	                   AND completed.done_at < days.day + 1
+	GROUP BY days.day
+	ORDER BY days.day ASC`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"board_id": boardID,
+		"from":     from,
+		"to":       to,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: daily throughput: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var counts []int64
+	for rows.Next() {
+		var count int64
+		err = rows.Scan(&count)
+		if err != nil {
+			return nil, fmt.Errorf("task transition repo: daily throughput: scan: %v: %w", err, ErrInternal)
+		}
+		counts = append(counts, count)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: daily throughput: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return counts, nil
+}
+
+// ListSLABreaches returns up to limit tasks that have been in their column for longer than
+// its SLA at now and whose stay was not notified yet, oldest entries first. Only boards whose
+// owner linked a Telegram chat are considered.
+func (r *PGTaskTransition) ListSLABreaches(ctx context.Context, now time.Time, limit int) ([]domain.SLABreach, error) {
+	const query = `
+	WITH entries AS (
+		SELECT DISTINCT ON (tt.task_id) tt.id, tt.task_id, tt.to_column_id, tt.transitioned_at, tt.sla_notified_at
+		FROM task_transitions tt
+		JOIN tasks t ON t.id = tt.task_id AND t.column_id = tt.to_column_id AND t.archived_at IS NULL
+		JOIN columns c ON c.id = tt.to_column_id AND c.sla_hours > 0
+		ORDER BY tt.task_id, tt.transitioned_at DESC, tt.id DESC
+	)
+	SELECT e.id, b.id, b.name, c.id, c.name, c.sla_hours, t.id, t.name, e.transitioned_at, u.telegram_chat_id
+	FROM entries e
+	JOIN tasks t ON t.id = e.task_id
+	JOIN columns c ON c.id = e.to_column_id
+	JOIN boards b ON b.id = c.board_id
+	JOIN users u ON u.id = b.owner_id
+	WHERE e.sla_notified_at IS NULL
+	  AND e.transitioned_at + make_interval(hours => c.sla_hours) <= @now
+	  AND u.telegram_chat_id IS NOT NULL
+	ORDER BY e.transitioned_at ASC, e.id ASC
+	LIMIT @limit`
+
+	rows, err := r.pgPool.Query(ctx, query, pgx.NamedArgs{
+		"now":   now,
+		"limit": limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: list sla breaches: %v: %w", err, ErrInternal)
+	}
+	defer rows.Close()
+
+	var breaches []domain.SLABreach
+	for rows.Next() {
+		breach, scanErr := scanSLABreach(rows)
+		if scanErr != nil {
+			return nil, fmt.Errorf("task transition repo: list sla breaches: %v: %w", scanErr, ErrInternal)
+		}
+		breaches = append(breaches, breach)
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return nil, fmt.Errorf("task transition repo: list sla breaches: rows final error: %v: %w", err, ErrInternal)
+	}
+
+	return breaches, nil
+}
+
+// MarkSLANotified claims the breach of the stay that started with the transition, so that it
+// is notified once. It returns ErrRowNotFound if the breach was already claimed, possibly by
+// another replica.
+func (r *PGTaskTransition) MarkSLANotified(ctx context.Context, transitionID domain.TaskTransitionID, now time.Time) error {
+	const query = `
+		UPDATE task_transitions
+		SET sla_notified_at = @now
+		WHERE id = @id
+		  AND sla_notified_at IS NULL`
+
+	cmd, err := r.pgPool.Exec(ctx, query, pgx.NamedArgs{
+		"id":  transitionID,
+		"now": now,
+	})
+	if err != nil {
+		return fmt.Errorf("task transition repo: mark sla notified: %v: %w", err, ErrInternal)
+	}
+	if cmd.RowsAffected() == 0 {
+		return ErrRowNotFound
+	}
+
+	return nil
+}
+
+func scanSLABreach(row interface{ Scan(...any) error }) (domain.SLABreach, error) {
+	var (
+		rawTransitionID uuid.UUID
+		rawBoardID      uuid.UUID
+		rawBoardName    string
+		rawColumnID     uuid.UUID
+		rawColumnName   string
+		rawSLA          int64
+		rawTaskID       uuid.UUID
+		rawTaskName     string
+		enteredAt       time.Time
+		rawChatID       int64
+	)
+	err := row.Scan(&rawTransitionID, &rawBoardID, &rawBoardName, &rawColumnID, &rawColumnName, &rawSLA, &rawTaskID, &rawTaskName, &enteredAt, &rawChatID)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: %w", err)
+	}
+	transitionID, err := domain.NewTaskTransitionIDFromUUID(rawTransitionID)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: transition id: %v: %w", err, errDataCorrupted)
+	}
+	boardID, err := domain.NewBoardIDFromUUID(rawBoardID)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: board id: %v: %w", err, errDataCorrupted)
+	}
+	boardName, err := domain.NewBoardName(rawBoardName)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: board name: %v: %w", err, errDataCorrupted)
+	}
+	columnID, err := domain.NewColumnIDFromUUID(rawColumnID)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: column id: %v: %w", err, errDataCorrupted)
+	}
+	columnName, err := domain.NewColumnName(rawColumnName)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: column name: %v: %w", err, errDataCorrupted)
+	}
+	sla, err := domain.NewColumnSLA(rawSLA)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: sla: %v: %w", err, errDataCorrupted)
+	}
+	taskID, err := domain.NewTaskIDFromUUID(rawTaskID)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: task id: %v: %w", err, errDataCorrupted)
+	}
+	taskName, err := domain.NewTaskName(rawTaskName)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: task name: %v: %w", err, errDataCorrupted)
+	}
+	chatID, err := domain.NewTelegramChatID(rawChatID)
+	if err != nil {
+		return domain.SLABreach{}, fmt.Errorf("scan sla breach: chat id: %v: %w", err, errDataCorrupted)
+	}
+
+	return domain.SLABreach{
+		TransitionID: transitionID,
+		BoardID:      boardID,
+		BoardName:    boardName,
+		ColumnID:     columnID,
+		ColumnName:   columnName,
+		SLA:          sla,
+		TaskID:       taskID,
+		TaskName:     taskName,
+		EnteredAt:    enteredAt,
+		ChatID:       chatID,
+	}, nil
+}
+
+// newDurationPercentiles maps the p50, p85 and p95 seconds computed by percentile_cont.
+// Without any tasks percentile_cont gives NULL and the percentiles stay zero.
+func newDurationPercentiles(seconds []float64) domain.DurationPercentiles {
+	if len(seconds) != 3 {
+		return domain.DurationPercentiles{}
+	}
+
+	return domain.DurationPercentiles{
+		P50: time.Duration(seconds[0] * float64(time.Second)),
+		P85: time.Duration(seconds[1] * float64(time.Second)),
+		P95: time.Duration(seconds[2] * float64(time.Second)),
+	}
+}
+
+// recordTaskTransition appends a column change of the task to its history within tx.
+// A nil fromColumnID records the creation of the task.
+func recordTaskTransition(
+	ctx context.Context,
+	tx pgx.Tx,
+	taskID domain.TaskID,
+	fromColumnID domain.ColumnID,
+	toColumnID domain.ColumnID,
+) error {
+	const query = `
+		WITH transitions AS (
+			INSERT INTO task_transitions (task_id, board_id, from_column_id, to_column_id)
+			SELECT @task_id, board_id, @from_column_id, id
+			FROM columns
+			WHERE id = @to_column_id
+			RETURNING board_id, from_column_id, transitioned_at
+		)` + countTransitionsQuery
+
+	_, err := tx.Exec(ctx, query, pgx.NamedArgs{
+		"task_id":        taskID,
+		"from_column_id": NullColumnID(fromColumnID),
+		"to_column_id":   toColumnID,
+	})
+	if err != nil {
+		return fmt.Errorf("record task transition: %w", err)
+	}
+
+	return nil
+}
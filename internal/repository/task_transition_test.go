@@ -0,0 +1,297 @@
+//go:build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/jackc/pgx/v5/pgxpool"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/testutil"
+)
+
+func TestTaskTransitionRepository_Record(t *testing.T) {
+	pool, _ := taskTransitionRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+	CreateColumn(t, pool, &done)
+	task := createTaskWithHistory(t, taskRepo, todo.ID)
+
+	_, err := taskRepo.Move(context.Background(), board.ID, todo.ID, task.ID, todo.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 1), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() within column error = %v", err)
+	}
+	_, err = taskRepo.Move(context.Background(), board.ID, todo.ID, task.ID, done.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 1), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() across columns error = %v", err)
+	}
+
+	rows, err := pool.Query(context.Background(), `
+		SELECT from_column_id, to_column_id
+		FROM task_transitions
+		WHERE task_id = $1
+		ORDER BY transitioned_at, id`, task.ID)
+	if err != nil {
+		t.Fatalf("query transitions error = %v", err)
+	}
+	defer rows.Close()
+
+	type transition struct{ From, To string }
+	var got []transition
+	for rows.Next() {
+		var from, to *string
+		err = rows.Scan(&from, &to)
+		if err != nil {
+			t.Fatalf("scan transition error = %v", err)
+		}
+		row := transition{To: *to}
+		if from != nil {
+			row.From = *from
+		}
+		got = append(got, row)
+	}
+
+	want := []transition{
+		{To: todo.ID.String()},
+		{From: todo.ID.String(), To: done.ID.String()},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("transitions mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTaskTransitionRepository_CumulativeFlow(t *testing.T) {
+	pool, r := taskTransitionRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	done := testutil.NewValidColumn(t, board.ID, "Done", 2)
+	CreateColumn(t, pool, &done)
+	createTaskWithHistory(t, taskRepo, todo.ID)
+	moved := createTaskWithHistory(t, taskRepo, todo.ID)
+	_, err := taskRepo.Move(context.Background(), board.ID, todo.ID, moved.ID, done.ID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 1), domain.Version{})
+	if err != nil {
+		t.Fatalf("Move() error = %v", err)
+	}
+	today := dbToday(t, pool)
+
+	got, err := r.CumulativeFlow(context.Background(), board.ID, today.AddDate(0, 0, -1), today, domain.FlowIntervalDay)
+	if err != nil {
+		t.Fatalf("CumulativeFlow() error = %v", err)
+	}
+
+	want := map[domain.ColumnID]int64{todo.ID: 1, done.ID: 1}
+	if len(got) != len(want) {
+		t.Fatalf("got counts %+v, want one count per column today only", got)
+	}
+	for _, count := range got {
+		if !count.Day.Equal(today) {
+			t.Errorf("got day %v, want %v", count.Day, today)
+		}
+		if count.Count != want[count.ColumnID] {
+			t.Errorf("got %d tasks in column %v, want %d", count.Count, count.ColumnID, want[count.ColumnID])
+		}
+	}
+}
+
+func TestTaskTransitionRepository_CompletedTasks(t *testing.T) {
+	pool, r := taskTransitionRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+	columnRepo := repository.NewPGColumn(pool)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	doing := testutil.NewValidColumn(t, board.ID, "Doing", 2)
+	CreateColumn(t, pool, &doing)
+	done := testutil.NewValidColumn(t, board.ID, "Done", 3)
+	CreateColumn(t, pool, &done)
+	isTrue := true
+	_, err := columnRepo.Update(context.Background(), board.ID, doing.ID, domain.ColumnPatch{IsStarted: &isTrue}, domain.Version{})
+	if err != nil {
+		t.Fatalf("Update() started flag error = %v", err)
+	}
+	_, err = columnRepo.Update(context.Background(), board.ID, done.ID, domain.ColumnPatch{IsDone: &isTrue}, domain.Version{})
+	if err != nil {
+		t.Fatalf("Update() done flag error = %v", err)
+	}
+
+	finished := createTaskWithHistory(t, taskRepo, todo.ID)
+	createTaskWithHistory(t, taskRepo, todo.ID)
+	for _, columnID := range []domain.ColumnID{doing.ID, done.ID} {
+		fromColumnID := todo.ID
+		if columnID == done.ID {
+			fromColumnID = doing.ID
+		}
+		_, err = taskRepo.Move(context.Background(), board.ID, fromColumnID, finished.ID, columnID, domain.LaneID{}, testutil.NewValidTaskPosition(t, 1), domain.Version{})
+		if err != nil {
+			t.Fatalf("Move() error = %v", err)
+		}
+	}
+	today := dbToday(t, pool)
+
+	report, err := r.CycleTimes(context.Background(), board.ID, today, today)
+	if err != nil {
+		t.Fatalf("CycleTimes() error = %v", err)
+	}
+	if len(report.Tasks) != 1 || report.Tasks[0].ID != finished.ID {
+		t.Fatalf("got completed tasks %+v, want only %v", report.Tasks, finished.ID)
+	}
+	task := report.Tasks[0]
+	if task.StartedAt.Before(task.CreatedAt) || task.DoneAt.Before(task.StartedAt) {
+		t.Errorf("got created %v, started %v, done %v, want them in order", task.CreatedAt, task.StartedAt, task.DoneAt)
+	}
+	if report.LeadTime.P50 != task.LeadTime() {
+		t.Errorf("got lead time p50 %v, want %v", report.LeadTime.P50, task.LeadTime())
+	}
+
+	weeks, err := r.Throughput(context.Background(), board.ID, today, today)
+	if err != nil {
+		t.Fatalf("Throughput() error = %v", err)
+	}
+	if len(weeks) != 1 || weeks[0].Completed != 1 {
+		t.Errorf("got weeks %+v, want one week with one finished task", weeks)
+	}
+
+	days, err := r.DailyThroughput(context.Background(), board.ID, today.AddDate(0, 0, -2), today)
+	if err != nil {
+		t.Fatalf("DailyThroughput() error = %v", err)
+	}
+	if diff := cmp.Diff([]int64{0, 0, 1}, days); diff != "" {
+		t.Errorf("daily throughput mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestTaskTransitionRepository_SLABreaches(t *testing.T) {
+	pool, r := taskTransitionRepoPrelude(t)
+	taskRepo := repository.NewPGTask(pool)
+	columnRepo := repository.NewPGColumn(pool)
+	userRepo := repository.NewPGUser(pool)
+
+	testutil.TruncateAllTables(t, pool)
+
+	board, todo := insertFixedUserBoardAndColumn(t, pool)
+	review := testutil.NewValidColumn(t, board.ID, "Review", 2)
+	CreateColumn(t, pool, &review)
+	sla := testutil.NewValidColumnSLA(t, 1)
+	_, err := columnRepo.Update(context.Background(), board.ID, review.ID, domain.ColumnPatch{SLA: &sla}, domain.Version{})
+	if err != nil {
+		t.Fatalf("Update() sla error = %v", err)
+	}
+
+	task := createTaskWithHistory(t, taskRepo, todo.ID)
+	createTaskWithHistory(t, taskRepo, todo.ID)
+	moveTo := func(from, to domain.ColumnID) {
+		t.Helper()
+		_, err := taskRepo.Move(context.Background(), board.ID, from, task.ID, to, domain.LaneID{}, testutil.NewValidTaskPosition(t, 1), domain.Version{})
+		if err != nil {
+			t.Fatalf("Move() error = %v", err)
+		}
+	}
+	moveTo(todo.ID, review.ID)
+
+	entries, err := taskRepo.ListColumnEntries(context.Background(), board.ID)
+	if err != nil {
+		t.Fatalf("ListColumnEntries() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("got %d column entries, want one per task", len(entries))
+	}
+
+	now := time.Now().UTC()
+	later := now.Add(2 * time.Hour)
+	breaches, err := r.ListSLABreaches(context.Background(), later, 10)
+	if err != nil {
+		t.Fatalf("ListSLABreaches() error = %v", err)
+	}
+	if len(breaches) != 0 {
+		t.Fatalf("got breaches %+v, want none without a linked Telegram chat", breaches)
+	}
+
+	err = userRepo.UpdateTelegramInfo(context.Background(), board.OwnerID, testutil.ValidTelegramChatID(), testutil.ValidTelegramUsername())
+	if err != nil {
+		t.Fatalf("UpdateTelegramInfo() error = %v", err)
+	}
+
+	breaches, err = r.ListSLABreaches(context.Background(), now, 10)
+	if err != nil {
+		t.Fatalf("ListSLABreaches() error = %v", err)
+	}
+	if len(breaches) != 0 {
+		t.Fatalf("got breaches %+v, want none within the SLA", breaches)
+	}
+
+	breaches, err = r.ListSLABreaches(context.Background(), later, 10)
+	if err != nil {
+		t.Fatalf("ListSLABreaches() error = %v", err)
+	}
+	if len(breaches) != 1 {
+		t.Fatalf("got %d breaches, want 1", len(breaches))
+	}
+	breach := breaches[0]
+	if breach.TaskID != task.ID || breach.ColumnID != review.ID || breach.ChatID != testutil.ValidTelegramChatID() {
+		t.Errorf("got breach %+v, want task %v in column %v", breach, task.ID, review.ID)
+	}
+	if !breach.EnteredAt.Equal(entries[task.ID]) {
+		t.Errorf("got entered at %v, want %v", breach.EnteredAt, entries[task.ID])
+	}
+
+	err = r.MarkSLANotified(context.Background(), breach.TransitionID, later)
+	if err != nil {
+		t.Fatalf("MarkSLANotified() error = %v", err)
+	}
+	err = r.MarkSLANotified(context.Background(), breach.TransitionID, later)
+	assertErrRowNotFound(t, err)
+
+	breaches, err = r.ListSLABreaches(context.Background(), later, 10)
+	if err != nil {
+		t.Fatalf("ListSLABreaches() error = %v", err)
+	}
+	if len(breaches) != 0 {
+		t.Fatalf("got breaches %+v, want none once notified", breaches)
+	}
+
+	// Leaving and re-entering the column re-arms the breach.
+	moveTo(review.ID, todo.ID)
+	moveTo(todo.ID, review.ID)
+
+	breaches, err = r.ListSLABreaches(context.Background(), later, 10)
+	if err != nil {
+		t.Fatalf("ListSLABreaches() error = %v", err)
+	}
+	if len(breaches) != 1 || breaches[0].TransitionID == breach.TransitionID {
+		t.Errorf("got breaches %+v, want one for the new stay", breaches)
+	}
+}
+
+func createTaskWithHistory(t *testing.T, r *repository.PGTask, columnID domain.ColumnID) domain.Task {
+	t.Helper()
+
+	valid := testutil.ValidTask(columnID)
+	task, err := r.Create(context.Background(), columnID, domain.LaneID{}, domain.TaskID{}, valid.Name, valid.Description, domain.TaskChecklist{}, domain.TaskEstimate{}, domain.TaskCustomFields{})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	return task
+}
+
+func taskTransitionRepoPrelude(t *testing.T) (*pgxpool.Pool, *repository.PGTaskTransition) {
+	t.Helper()
+
+	pool := testutil.SetupPostgres(t, "../../migrations")
+	t.Cleanup(func() { pool.Close() })
+
+	return pool, repository.NewPGTaskTransition(pool)
+}
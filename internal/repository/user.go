@@ -56,6 +56,20 @@ func (r *PGUser) GetByEmail(ctx context.Context, email domain.Email) (domain.Use
 	return user, nil
 }
 
+func (r *PGUser) Get(ctx context.Context, userID domain.UserID) (domain.User, error) {
+	const query = `SELECT id, email, password_hash, telegram_chat_id, telegram_username FROM users WHERE id = $1`
+
+	user, err := ScanUser(r.pgPool.QueryRow(ctx, query, userID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.User{}, ErrRowNotFound
+		}
+		return domain.User{}, fmt.Errorf("user repo: get user: %v: %w", err, ErrInternal)
+	}
+
+	return user, nil
+}
+
 func (r *PGUser) UpdateTelegramInfo(ctx context.Context, userID domain.UserID, chatID domain.TelegramChatID, username domain.TelegramUsername) error {
 	const query = `UPDATE users SET telegram_chat_id = $1, telegram_username = $2 WHERE id = $3`
 
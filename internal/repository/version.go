@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"goroutine/internal/domain"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// versionMismatch tells why a write conditioned on the expected version found no row:
+// ErrVersionConflict if existsQuery still finds the row, ErrRowNotFound if it doesn't.
+// An unconditional write finds no row only when the row is missing.
+func versionMismatch(ctx context.Context, q rowQuerier, expected domain.Version, existsQuery string, args ...any) error {
+	if !expected.IsSet() {
+		return ErrRowNotFound
+	}
+
+	var exists int
+	err := q.QueryRow(ctx, existsQuery, args...).Scan(&exists)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return ErrRowNotFound
+		}
+		return fmt.Errorf("check row exists: %v: %w", err, ErrInternal)
+	}
+
+	return ErrVersionConflict
+}
@@ -0,0 +1,284 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type analyticsBurndownRepository interface {
+	Snapshot(ctx context.Context) (int64, error)
+	List(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) ([]domain.BurndownPoint, error)
+}
+
+type analyticsTransitionRepository interface {
+	CumulativeFlow(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) ([]domain.CumulativeFlowCount, error)
+	CycleTimes(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error)
+	Throughput(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]domain.ThroughputWeek, error)
+	DailyThroughput(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]int64, error)
+}
+
+type analyticsBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type analyticsColumnRepository interface {
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error)
+}
+
+type analyticsSprintRepository interface {
+	Get(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error)
+}
+
+type analytics struct {
+	burndownRepo   analyticsBurndownRepository
+	transitionRepo analyticsTransitionRepository
+	boardRepo      analyticsBoardRepository
+	columnRepo     analyticsColumnRepository
+	sprintRepo     analyticsSprintRepository
+}
+
+func NewAnalytics(
+	burndownRepo analyticsBurndownRepository,
+	transitionRepo analyticsTransitionRepository,
+	boardRepo analyticsBoardRepository,
+	columnRepo analyticsColumnRepository,
+	sprintRepo analyticsSprintRepository,
+) *analytics {
+	return &analytics{
+		burndownRepo:   burndownRepo,
+		transitionRepo: transitionRepo,
+		boardRepo:      boardRepo,
+		columnRepo:     columnRepo,
+		sprintRepo:     sprintRepo,
+	}
+}
+
+// Burndown returns the daily points of the board, or of one of its sprints when sprintID is set.
+// For a sprint, zero from and to default to the sprint dates; otherwise they leave the range
+// unbounded on that side.
+func (s *analytics) Burndown(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+	from time.Time,
+	to time.Time,
+) (domain.BurndownSeries, error) {
+	err := s.checkOwner(ctx, "burndown", callerID, boardID)
+	if err != nil {
+		return domain.BurndownSeries{}, err
+	}
+
+	if !sprintID.IsNil() {
+		sprint, err := s.sprintRepo.Get(ctx, sprintID)
+		if err != nil {
+			if errors.Is(err, repository.ErrRowNotFound) {
+				return domain.BurndownSeries{}, ErrSprintNotFound
+			}
+			return domain.BurndownSeries{}, fmt.Errorf("analytics service: burndown get sprint: %v: %w", err, ErrInternal)
+		}
+		if sprint.BoardID != boardID {
+			return domain.BurndownSeries{}, ErrSprintNotFound
+		}
+
+		if from.IsZero() {
+			from = sprint.StartDate
+		}
+		if to.IsZero() {
+			to = sprint.EndDate
+		}
+	}
+
+	err = domain.ValidateBurndownRange(from, to)
+	if err != nil {
+		return domain.BurndownSeries{}, ErrBurndownRangeInvalid
+	}
+
+	points, err := s.burndownRepo.List(ctx, boardID, sprintID, from, to)
+	if err != nil {
+		return domain.BurndownSeries{}, fmt.Errorf("analytics service: burndown list: %v: %w", err, ErrInternal)
+	}
+
+	return domain.BurndownSeries{
+		BoardID:  boardID,
+		SprintID: sprintID,
+		From:     from,
+		To:       to,
+		Points:   points,
+	}, nil
+}
+
+// CumulativeFlow returns the number of tasks in every current column of the board at the end
+// of each interval step from from through to. Steps without tasks get zero counts, and the
+// history of deleted columns is left out.
+func (s *analytics) CumulativeFlow(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	from time.Time,
+	to time.Time,
+	interval domain.FlowInterval,
+) (domain.CumulativeFlow, error) {
+	err := s.checkOwner(ctx, "cumulative flow", callerID, boardID)
+	if err != nil {
+		return domain.CumulativeFlow{}, err
+	}
+
+	columns, err := s.columnRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return domain.CumulativeFlow{}, fmt.Errorf("analytics service: cumulative flow list columns: %v: %w", err, ErrInternal)
+	}
+
+	counts, err := s.transitionRepo.CumulativeFlow(ctx, boardID, from, to, interval)
+	if err != nil {
+		return domain.CumulativeFlow{}, fmt.Errorf("analytics service: cumulative flow: %v: %w", err, ErrInternal)
+	}
+
+	columnIndexes := make(map[domain.ColumnID]int, len(columns))
+	for i, column := range columns {
+		columnIndexes[column.ID] = i
+	}
+
+	var points []domain.CumulativeFlowPoint
+	pointIndexes := make(map[string]int)
+	for day := from; !day.After(to); day = day.AddDate(0, 0, interval.Days()) {
+		pointIndexes[day.Format(time.DateOnly)] = len(points)
+		points = append(points, domain.CumulativeFlowPoint{Day: day, Counts: make([]int64, len(columns))})
+	}
+	for _, count := range counts {
+		pointIndex, ok := pointIndexes[count.Day.Format(time.DateOnly)]
+		if !ok {
+			continue
+		}
+		columnIndex, ok := columnIndexes[count.ColumnID]
+		if !ok {
+			continue
+		}
+		points[pointIndex].Counts[columnIndex] = count.Count
+	}
+
+	return domain.CumulativeFlow{
+		BoardID:  boardID,
+		Interval: interval,
+		From:     from,
+		To:       to,
+		Columns:  columns,
+		Points:   points,
+	}, nil
+}
+
+// CycleTimes returns the lead and cycle times of the tasks of the board finished from from
+// through to.
+func (s *analytics) CycleTimes(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	from time.Time,
+	to time.Time,
+) (domain.CycleTimeReport, error) {
+	err := s.checkOwner(ctx, "cycle times", callerID, boardID)
+	if err != nil {
+		return domain.CycleTimeReport{}, err
+	}
+
+	report, err := s.transitionRepo.CycleTimes(ctx, boardID, from, to)
+	if err != nil {
+		return domain.CycleTimeReport{}, fmt.Errorf("analytics service: cycle times: %v: %w", err, ErrInternal)
+	}
+
+	return report, nil
+}
+
+// Throughput returns the weekly number of tasks of the board finished from from through to.
+func (s *analytics) Throughput(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	from time.Time,
+	to time.Time,
+) (domain.ThroughputReport, error) {
+	err := s.checkOwner(ctx, "throughput", callerID, boardID)
+	if err != nil {
+		return domain.ThroughputReport{}, err
+	}
+
+	weeks, err := s.transitionRepo.Throughput(ctx, boardID, from, to)
+	if err != nil {
+		return domain.ThroughputReport{}, fmt.Errorf("analytics service: throughput: %v: %w", err, ErrInternal)
+	}
+
+	return domain.ThroughputReport{BoardID: boardID, From: from, To: to, Weeks: weeks}, nil
+}
+
+// Forecast simulates the future throughput of the board by sampling its daily throughput over
+// the settings.HistoryDays full days before today, and answers target for every confidence
+// level. Simulated days start tomorrow.
+func (s *analytics) Forecast(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	target domain.ForecastTarget,
+	settings domain.ForecastSettings,
+) (domain.Forecast, error) {
+	err := s.checkOwner(ctx, "forecast", callerID, boardID)
+	if err != nil {
+		return domain.Forecast{}, err
+	}
+
+	now := timeNow()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	err = domain.ValidateForecastTargetDate(target, today)
+	if err != nil {
+		return domain.Forecast{}, ErrForecastTargetDate
+	}
+
+	historyFrom := today.AddDate(0, 0, -int(settings.HistoryDays.Int64()))
+	historyTo := today.AddDate(0, 0, -1)
+	history, err := s.transitionRepo.DailyThroughput(ctx, boardID, historyFrom, historyTo)
+	if err != nil {
+		return domain.Forecast{}, fmt.Errorf("analytics service: forecast daily throughput: %v: %w", err, ErrInternal)
+	}
+
+	start := today.AddDate(0, 0, 1)
+	return domain.Forecast{
+		BoardID:     boardID,
+		Target:      target,
+		Settings:    settings,
+		Start:       start,
+		HistoryFrom: historyFrom,
+		HistoryTo:   historyTo,
+		History:     history,
+		Outcomes:    domain.SimulateForecast(history, start, target, settings),
+	}, nil
+}
+
+// SnapshotBurndown records today's burndown points of every board and running sprint and
+// returns the number of recorded scopes.
+func (s *analytics) SnapshotBurndown(ctx context.Context) (int64, error) {
+	recorded, err := s.burndownRepo.Snapshot(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("analytics service: snapshot burndown: %v: %w", err, ErrInternal)
+	}
+
+	return recorded, nil
+}
+
+func (s *analytics) checkOwner(ctx context.Context, op string, callerID domain.UserID, boardID domain.BoardID) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrBoardNotFound
+		}
+		return fmt.Errorf("analytics service: %s get board: %v: %w", op, err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return ErrBoardNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,650 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestAnalytics_Burndown(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	points := []domain.BurndownPoint{
+		{Day: validSprint.StartDate, Remaining: 13},
+		{Day: validSprint.StartDate.AddDate(0, 0, 1), Remaining: 8, Completed: 5},
+	}
+	from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.January, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		sprintID          domain.SprintID
+		from              time.Time
+		to                time.Time
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		setupSprintRepo   func(t *testing.T, r *MockSprintRepository)
+		setupBurndownRepo func(t *testing.T, r *MockBurndownRepository)
+		wantErr           error
+		wantSeries        domain.BurndownSeries
+	}{
+		{
+			name:     "Board range",
+			callerID: validBoard.OwnerID,
+			from:     from,
+			to:       to,
+			setupBurndownRepo: func(t *testing.T, r *MockBurndownRepository) {
+				r.ListFunc = func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, gotFrom time.Time, gotTo time.Time) ([]domain.BurndownPoint, error) {
+					if !sprintID.IsNil() {
+						t.Errorf("got sprint id %v, want nil", sprintID)
+					}
+					if !gotFrom.Equal(from) || !gotTo.Equal(to) {
+						t.Errorf("got range %v..%v, want %v..%v", gotFrom, gotTo, from, to)
+					}
+					return points, nil
+				}
+			},
+			wantSeries: domain.BurndownSeries{BoardID: validBoard.ID, From: from, To: to, Points: points},
+		},
+		{
+			name:     "Sprint defaults to sprint dates",
+			callerID: validBoard.OwnerID,
+			sprintID: validSprint.ID,
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+			},
+			setupBurndownRepo: func(t *testing.T, r *MockBurndownRepository) {
+				r.ListFunc = func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, gotFrom time.Time, gotTo time.Time) ([]domain.BurndownPoint, error) {
+					if sprintID != validSprint.ID {
+						t.Errorf("got sprint id %v, want %v", sprintID, validSprint.ID)
+					}
+					if !gotFrom.Equal(validSprint.StartDate) || !gotTo.Equal(validSprint.EndDate) {
+						t.Errorf("got range %v..%v, want the sprint dates", gotFrom, gotTo)
+					}
+					return points, nil
+				}
+			},
+			wantSeries: domain.BurndownSeries{
+				BoardID:  validBoard.ID,
+				SprintID: validSprint.ID,
+				From:     validSprint.StartDate,
+				To:       validSprint.EndDate,
+				Points:   points,
+			},
+		},
+		{
+			name:     "Sprint of another board",
+			callerID: validBoard.OwnerID,
+			sprintID: validSprint.ID,
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return testutil.ValidSprint(domain.NewBoardID()), nil
+				}
+			},
+			wantErr: service.ErrSprintNotFound,
+		},
+		{
+			name:     "Sprint not found",
+			callerID: validBoard.OwnerID,
+			sprintID: validSprint.ID,
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return domain.Sprint{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrSprintNotFound,
+		},
+		{
+			name:     "Sprint range ends before its start",
+			callerID: validBoard.OwnerID,
+			sprintID: validSprint.ID,
+			to:       validSprint.StartDate.AddDate(0, 0, -1),
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+			},
+			wantErr: service.ErrBurndownRangeInvalid,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Board not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "List internal error",
+			callerID: validBoard.OwnerID,
+			setupBurndownRepo: func(t *testing.T, r *MockBurndownRepository) {
+				r.ListFunc = func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) ([]domain.BurndownPoint, error) {
+					return nil, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			if tt.setupBoardRepo != nil {
+				tt.setupBoardRepo(t, boardRepo)
+			}
+			sprintRepo := NewMockSprintRepository(t)
+			if tt.setupSprintRepo != nil {
+				tt.setupSprintRepo(t, sprintRepo)
+			}
+			burndownRepo := NewMockBurndownRepository(t)
+			if tt.setupBurndownRepo != nil {
+				tt.setupBurndownRepo(t, burndownRepo)
+			}
+
+			s := service.NewAnalytics(burndownRepo, NewMockTaskTransitionRepository(t), boardRepo, NewMockColumnRepository(t), sprintRepo)
+			got, err := s.Burndown(context.Background(), tt.callerID, validBoard.ID, tt.sprintID, tt.from, tt.to)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantSeries, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Burndown() series mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalytics_SnapshotBurndown(t *testing.T) {
+	t.Parallel()
+
+	burndownRepo := NewMockBurndownRepository(t)
+	burndownRepo.SnapshotFunc = func(ctx context.Context) (int64, error) {
+		return 0, errors.New("query failed")
+	}
+
+	s := service.NewAnalytics(burndownRepo, NewMockTaskTransitionRepository(t), NewMockBoardRepository(t), NewMockColumnRepository(t), NewMockSprintRepository(t))
+	_, err := s.SnapshotBurndown(context.Background())
+	if !errors.Is(err, service.ErrInternal) {
+		t.Errorf("got error %v, want %v", err, service.ErrInternal)
+	}
+}
+
+func TestAnalytics_CumulativeFlow(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todo := testutil.NewValidColumn(t, validBoard.ID, "To Do", 1)
+	done := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	columns := []domain.Column{todo, done}
+	from := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                string
+		callerID            domain.UserID
+		to                  time.Time
+		interval            domain.FlowInterval
+		setupBoardRepo      func(t *testing.T, r *MockBoardRepository)
+		setupColumnRepo     func(t *testing.T, r *MockColumnRepository)
+		setupTransitionRepo func(t *testing.T, r *MockTaskTransitionRepository)
+		wantErr             error
+		wantFlow            domain.CumulativeFlow
+	}{
+		{
+			name:     "Fills missing counts with zeros",
+			callerID: validBoard.OwnerID,
+			to:       from.AddDate(0, 0, 2),
+			interval: domain.FlowIntervalDay,
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return columns, nil
+				}
+			},
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.CumulativeFlowFunc = func(ctx context.Context, boardID domain.BoardID, gotFrom time.Time, to time.Time, interval domain.FlowInterval) ([]domain.CumulativeFlowCount, error) {
+					if !gotFrom.Equal(from) || interval != domain.FlowIntervalDay {
+						t.Errorf("got from %v by %v, want %v by day", gotFrom, interval, from)
+					}
+					return []domain.CumulativeFlowCount{
+						{Day: from, ColumnID: todo.ID, Count: 3},
+						{Day: from.AddDate(0, 0, 2), ColumnID: todo.ID, Count: 1},
+						{Day: from.AddDate(0, 0, 2), ColumnID: done.ID, Count: 2},
+						{Day: from.AddDate(0, 0, 2), ColumnID: domain.NewColumnID(), Count: 5},
+					}, nil
+				}
+			},
+			wantFlow: domain.CumulativeFlow{
+				BoardID:  validBoard.ID,
+				Interval: domain.FlowIntervalDay,
+				From:     from,
+				To:       from.AddDate(0, 0, 2),
+				Columns:  columns,
+				Points: []domain.CumulativeFlowPoint{
+					{Day: from, Counts: []int64{3, 0}},
+					{Day: from.AddDate(0, 0, 1), Counts: []int64{0, 0}},
+					{Day: from.AddDate(0, 0, 2), Counts: []int64{1, 2}},
+				},
+			},
+		},
+		{
+			name:     "Weekly steps stop at the end of the range",
+			callerID: validBoard.OwnerID,
+			to:       from.AddDate(0, 0, 10),
+			interval: domain.FlowIntervalWeek,
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return columns, nil
+				}
+			},
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.CumulativeFlowFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) ([]domain.CumulativeFlowCount, error) {
+					return nil, nil
+				}
+			},
+			wantFlow: domain.CumulativeFlow{
+				BoardID:  validBoard.ID,
+				Interval: domain.FlowIntervalWeek,
+				From:     from,
+				To:       from.AddDate(0, 0, 10),
+				Columns:  columns,
+				Points: []domain.CumulativeFlowPoint{
+					{Day: from, Counts: []int64{0, 0}},
+					{Day: from.AddDate(0, 0, 7), Counts: []int64{0, 0}},
+				},
+			},
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			to:       from,
+			interval: domain.FlowIntervalDay,
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Board not found",
+			callerID: validBoard.OwnerID,
+			to:       from,
+			interval: domain.FlowIntervalDay,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Cumulative flow internal error",
+			callerID: validBoard.OwnerID,
+			to:       from,
+			interval: domain.FlowIntervalDay,
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return columns, nil
+				}
+			},
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.CumulativeFlowFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) ([]domain.CumulativeFlowCount, error) {
+					return nil, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			if tt.setupBoardRepo != nil {
+				tt.setupBoardRepo(t, boardRepo)
+			}
+			columnRepo := NewMockColumnRepository(t)
+			if tt.setupColumnRepo != nil {
+				tt.setupColumnRepo(t, columnRepo)
+			}
+			transitionRepo := NewMockTaskTransitionRepository(t)
+			if tt.setupTransitionRepo != nil {
+				tt.setupTransitionRepo(t, transitionRepo)
+			}
+
+			s := service.NewAnalytics(NewMockBurndownRepository(t), transitionRepo, boardRepo, columnRepo, NewMockSprintRepository(t))
+			got, err := s.CumulativeFlow(context.Background(), tt.callerID, validBoard.ID, from, tt.to, tt.interval)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantFlow, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("CumulativeFlow() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalytics_CycleTimes(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	from := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 13)
+	report := domain.CycleTimeReport{
+		BoardID:   validBoard.ID,
+		From:      from,
+		To:        to,
+		LeadTime:  domain.DurationPercentiles{P50: 48 * time.Hour, P85: 72 * time.Hour, P95: 96 * time.Hour},
+		CycleTime: domain.DurationPercentiles{P50: 24 * time.Hour, P85: 36 * time.Hour, P95: 40 * time.Hour},
+	}
+
+	tests := []struct {
+		name                string
+		callerID            domain.UserID
+		setupBoardRepo      func(t *testing.T, r *MockBoardRepository)
+		setupTransitionRepo func(t *testing.T, r *MockTaskTransitionRepository)
+		wantErr             error
+		wantReport          domain.CycleTimeReport
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.CycleTimesFunc = func(ctx context.Context, boardID domain.BoardID, gotFrom time.Time, gotTo time.Time) (domain.CycleTimeReport, error) {
+					if !gotFrom.Equal(from) || !gotTo.Equal(to) {
+						t.Errorf("got range %v..%v, want %v..%v", gotFrom, gotTo, from, to)
+					}
+					return report, nil
+				}
+			},
+			wantReport: report,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Board internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name:     "Cycle times internal error",
+			callerID: validBoard.OwnerID,
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.CycleTimesFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error) {
+					return domain.CycleTimeReport{}, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			if tt.setupBoardRepo != nil {
+				tt.setupBoardRepo(t, boardRepo)
+			}
+			transitionRepo := NewMockTaskTransitionRepository(t)
+			if tt.setupTransitionRepo != nil {
+				tt.setupTransitionRepo(t, transitionRepo)
+			}
+
+			s := service.NewAnalytics(NewMockBurndownRepository(t), transitionRepo, boardRepo, NewMockColumnRepository(t), NewMockSprintRepository(t))
+			got, err := s.CycleTimes(context.Background(), tt.callerID, validBoard.ID, from, to)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantReport, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("CycleTimes() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalytics_Throughput(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	from := time.Date(2026, time.January, 5, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 13)
+	weeks := []domain.ThroughputWeek{{Start: from, Completed: 4}, {Start: from.AddDate(0, 0, 7)}}
+
+	tests := []struct {
+		name                string
+		callerID            domain.UserID
+		setupTransitionRepo func(t *testing.T, r *MockTaskTransitionRepository)
+		wantErr             error
+		wantReport          domain.ThroughputReport
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.ThroughputFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]domain.ThroughputWeek, error) {
+					return weeks, nil
+				}
+			},
+			wantReport: domain.ThroughputReport{BoardID: validBoard.ID, From: from, To: to, Weeks: weeks},
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Throughput internal error",
+			callerID: validBoard.OwnerID,
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.ThroughputFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]domain.ThroughputWeek, error) {
+					return nil, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			transitionRepo := NewMockTaskTransitionRepository(t)
+			if tt.setupTransitionRepo != nil {
+				tt.setupTransitionRepo(t, transitionRepo)
+			}
+
+			s := service.NewAnalytics(NewMockBurndownRepository(t), transitionRepo, boardRepo, NewMockColumnRepository(t), NewMockSprintRepository(t))
+			got, err := s.Throughput(context.Background(), tt.callerID, validBoard.ID, from, to)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantReport, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Throughput() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAnalytics_Forecast(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	settings := validForecastSettings(t)
+	history := make([]int64, settings.HistoryDays.Int64())
+	for i := range history {
+		history[i] = 3
+	}
+	tasks, err := domain.NewForecastTaskCount(9)
+	if err != nil {
+		t.Fatalf("NewForecastTaskCount() error = %v", err)
+	}
+
+	tests := []struct {
+		name                string
+		callerID            domain.UserID
+		target              domain.ForecastTarget
+		setupTransitionRepo func(t *testing.T, r *MockTaskTransitionRepository)
+		wantErr             error
+		wantOutcome         domain.ForecastOutcome
+	}{
+		{
+			name:     "Tasks target",
+			callerID: validBoard.OwnerID,
+			target:   domain.ForecastTarget{Tasks: tasks},
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.DailyThroughputFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]int64, error) {
+					wantFrom := today.AddDate(0, 0, -int(settings.HistoryDays.Int64()))
+					if !from.Equal(wantFrom) || !to.Equal(today.AddDate(0, 0, -1)) {
+						t.Errorf("got history %v..%v, want %v..yesterday", from, to, wantFrom)
+					}
+					return history, nil
+				}
+			},
+			wantOutcome: domain.ForecastOutcome{Confidence: settings.Confidences[0], Date: today.AddDate(0, 0, 3)},
+		},
+		{
+			name:     "Date target",
+			callerID: validBoard.OwnerID,
+			target:   domain.ForecastTarget{Date: today.AddDate(0, 0, 10)},
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.DailyThroughputFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]int64, error) {
+					return history, nil
+				}
+			},
+			wantOutcome: domain.ForecastOutcome{Confidence: settings.Confidences[0], Tasks: 30},
+		},
+		{
+			name:     "Date target not after today",
+			callerID: validBoard.OwnerID,
+			target:   domain.ForecastTarget{Date: today},
+			wantErr:  service.ErrForecastTargetDate,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			target:   domain.ForecastTarget{Tasks: tasks},
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Daily throughput internal error",
+			callerID: validBoard.OwnerID,
+			target:   domain.ForecastTarget{Tasks: tasks},
+			setupTransitionRepo: func(t *testing.T, r *MockTaskTransitionRepository) {
+				r.DailyThroughputFunc = func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]int64, error) {
+					return nil, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			transitionRepo := NewMockTaskTransitionRepository(t)
+			if tt.setupTransitionRepo != nil {
+				tt.setupTransitionRepo(t, transitionRepo)
+			}
+
+			s := service.NewAnalytics(NewMockBurndownRepository(t), transitionRepo, boardRepo, NewMockColumnRepository(t), NewMockSprintRepository(t))
+			got, err := s.Forecast(context.Background(), tt.callerID, validBoard.ID, tt.target, settings)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if !got.Start.Equal(today.AddDate(0, 0, 1)) {
+					t.Errorf("got start %v, want tomorrow", got.Start)
+				}
+				if len(got.Outcomes) != len(settings.Confidences) {
+					t.Fatalf("got %d outcomes, want one per confidence level", len(got.Outcomes))
+				}
+				if diff := cmp.Diff(tt.wantOutcome, got.Outcomes[0], testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Forecast() outcome mismatch (-want +got):\n%s", diff)
+				}
+				if got.Completed() != 3*settings.HistoryDays.Int64() {
+					t.Errorf("got %d completed tasks, want %d", got.Completed(), 3*settings.HistoryDays.Int64())
+				}
+			}
+		})
+	}
+}
+
+func validForecastSettings(t *testing.T) domain.ForecastSettings {
+	t.Helper()
+
+	simulations, err := domain.NewForecastSimulations(1000)
+	if err != nil {
+		t.Fatalf("NewForecastSimulations() error = %v", err)
+	}
+	historyDays, err := domain.NewForecastHistoryDays(30)
+	if err != nil {
+		t.Fatalf("NewForecastHistoryDays() error = %v", err)
+	}
+	confidences, err := domain.NewForecastConfidences(domain.DefaultForecastConfidences)
+	if err != nil {
+		t.Fatalf("NewForecastConfidences() error = %v", err)
+	}
+	seed, err := domain.NewForecastSeed(1)
+	if err != nil {
+		t.Fatalf("NewForecastSeed() error = %v", err)
+	}
+
+	return domain.ForecastSettings{Simulations: simulations, HistoryDays: historyDays, Confidences: confidences, Seed: seed}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+// archiveBatchSize bounds how many tasks one ArchiveDue call archives.
+const archiveBatchSize = 500
+
+type archiveTaskRepository interface {
+	ListArchivalsDue(ctx context.Context, now time.Time, limit int) ([]domain.TaskArchival, error)
+	Archive(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskIDs []domain.TaskID, now time.Time) (int64, error)
+}
+
+type archive struct {
+	taskRepo archiveTaskRepository
+}
+
+func NewArchive(taskRepo archiveTaskRepository) *archive {
+	return &archive{taskRepo: taskRepo}
+}
+
+// ArchiveDue archives the tasks that have stayed in their column for the archive delay of the
+// column and returns the number of archived tasks. The tasks of a column are archived together,
+// so the column is renumbered once per run. Tasks moved, deleted or archived by another replica
+// meanwhile are skipped.
+func (s *archive) ArchiveDue(ctx context.Context) (int, error) {
+	now := timeNow()
+
+	archivals, err := s.taskRepo.ListArchivalsDue(ctx, now, archiveBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("archive service: archive due list: %v: %w", err, ErrInternal)
+	}
+
+	var (
+		archived int
+		errs     []error
+	)
+	// Archivals come grouped by column.
+	for start := 0; start < len(archivals); {
+		end := start
+		taskIDs := []domain.TaskID{}
+		for end < len(archivals) && archivals[end].ColumnID == archivals[start].ColumnID {
+			taskIDs = append(taskIDs, archivals[end].TaskID)
+			end++
+		}
+
+		count, archiveErr := s.taskRepo.Archive(ctx, archivals[start].BoardID, archivals[start].ColumnID, taskIDs, now)
+		switch {
+		case errors.Is(archiveErr, repository.ErrRowNotFound):
+			// The column was deleted meanwhile.
+		case archiveErr != nil:
+			errs = append(errs, fmt.Errorf("column %s: %v", archivals[start].ColumnID, archiveErr))
+		default:
+			archived += int(count)
+		}
+		start = end
+	}
+
+	if len(errs) > 0 {
+		return archived, fmt.Errorf("archive service: archive due: %v: %w", errors.Join(errs...), ErrInternal)
+	}
+
+	return archived, nil
+}
@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestArchive_ArchiveDue(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	doneColumn := testutil.ValidColumn(validBoard.ID)
+	shippedColumn := testutil.NewValidColumn(t, validBoard.ID, "Shipped", 2)
+	newArchival := func(columnID domain.ColumnID) domain.TaskArchival {
+		return domain.TaskArchival{BoardID: validBoard.ID, ColumnID: columnID, TaskID: domain.NewTaskID()}
+	}
+	firstDone := newArchival(doneColumn.ID)
+	secondDone := newArchival(doneColumn.ID)
+	shipped := newArchival(shippedColumn.ID)
+
+	tests := []struct {
+		name         string
+		archivals    []domain.TaskArchival
+		listErr      error
+		archiveCount map[domain.ColumnID]int64
+		archiveErr   map[domain.ColumnID]error
+		wantArchived map[domain.ColumnID][]domain.TaskID
+		wantCount    int
+		wantErr      error
+	}{
+		{
+			name:         "Success archives each column once",
+			archivals:    []domain.TaskArchival{firstDone, secondDone, shipped},
+			archiveCount: map[domain.ColumnID]int64{doneColumn.ID: 2, shippedColumn.ID: 1},
+			wantArchived: map[domain.ColumnID][]domain.TaskID{
+				doneColumn.ID:    {firstDone.TaskID, secondDone.TaskID},
+				shippedColumn.ID: {shipped.TaskID},
+			},
+			wantCount: 3,
+		},
+		{
+			name:         "Success counts only tasks still active",
+			archivals:    []domain.TaskArchival{firstDone, secondDone},
+			archiveCount: map[domain.ColumnID]int64{doneColumn.ID: 1},
+			wantArchived: map[domain.ColumnID][]domain.TaskID{
+				doneColumn.ID: {firstDone.TaskID, secondDone.TaskID},
+			},
+			wantCount: 1,
+		},
+		{
+			name:         "Success skips deleted columns",
+			archivals:    []domain.TaskArchival{firstDone, shipped},
+			archiveCount: map[domain.ColumnID]int64{shippedColumn.ID: 1},
+			archiveErr:   map[domain.ColumnID]error{doneColumn.ID: repository.ErrRowNotFound},
+			wantArchived: map[domain.ColumnID][]domain.TaskID{
+				doneColumn.ID:    {firstDone.TaskID},
+				shippedColumn.ID: {shipped.TaskID},
+			},
+			wantCount: 1,
+		},
+		{
+			name:         "Failure does not stop other columns",
+			archivals:    []domain.TaskArchival{firstDone, shipped},
+			archiveCount: map[domain.ColumnID]int64{shippedColumn.ID: 1},
+			archiveErr:   map[domain.ColumnID]error{doneColumn.ID: repository.ErrInternal},
+			wantArchived: map[domain.ColumnID][]domain.TaskID{
+				doneColumn.ID:    {firstDone.TaskID},
+				shippedColumn.ID: {shipped.TaskID},
+			},
+			wantCount: 1,
+			wantErr:   service.ErrInternal,
+		},
+		{
+			name:    "Internal error from list",
+			listErr: repository.ErrInternal,
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var listedAt time.Time
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.ListArchivalsDueFunc = func(ctx context.Context, now time.Time, limit int) ([]domain.TaskArchival, error) {
+				if limit <= 0 {
+					t.Errorf("got limit %d, want positive", limit)
+				}
+				listedAt = now
+				return tt.archivals, tt.listErr
+			}
+
+			archived := map[domain.ColumnID][]domain.TaskID{}
+			taskRepo.ArchiveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskIDs []domain.TaskID, now time.Time) (int64, error) {
+				if boardID != validBoard.ID {
+					t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+				}
+				if !now.Equal(listedAt) {
+					t.Errorf("got archive time %v, want %v", now, listedAt)
+				}
+				if _, ok := archived[columnID]; ok {
+					t.Errorf("got second archive of column %v, want one", columnID)
+				}
+				archived[columnID] = taskIDs
+				return tt.archiveCount[columnID], tt.archiveErr[columnID]
+			}
+
+			s := service.NewArchive(taskRepo)
+			count, err := s.ArchiveDue(context.Background())
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if count != tt.wantCount {
+				t.Errorf("got archived count %d, want %d", count, tt.wantCount)
+			}
+			if tt.wantArchived == nil {
+				tt.wantArchived = map[domain.ColumnID][]domain.TaskID{}
+			}
+			if diff := cmp.Diff(tt.wantArchived, archived, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("archived tasks mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,732 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+const (
+	// automationBatchSize bounds how many events one RunPending call processes.
+	automationBatchSize = 100
+	// maxAutomationRunsPerTask is how many times an automation may run on the same task within
+	// automationLoopWindow. Further runs are skipped, which breaks loops between automations
+	// that keep moving a task back and forth.
+	maxAutomationRunsPerTask = 5
+	automationLoopWindow     = time.Hour
+	// automationRunsLimit is how many of the latest entries of the execution log are listed.
+	automationRunsLimit = 100
+)
+
+// Messages of the execution log entries that did not succeed.
+const (
+	automationMsgLoop              = "Skipped by loop protection: the automation ran too often on this task"
+	automationMsgTaskDeleted       = "Task no longer exists"
+	automationMsgAlreadyInColumn   = "Task is already in the column"
+	automationMsgColumnNotFound    = "Column no longer exists"
+	automationMsgFieldNotFound     = "Custom field no longer exists"
+	automationMsgAlreadyLabeled    = "Task already has the label"
+	automationMsgTransition        = "Board workflow doesn't allow moving the task to the column"
+	automationMsgEntryConditions   = "Task doesn't meet the entry conditions of the column"
+	automationMsgEntryRoles        = "Column doesn't let automations move tasks into it"
+	automationMsgBlocked           = "Task is blocked by unfinished tasks"
+	automationMsgTelegramNotLinked = "Board owner has not linked a Telegram chat"
+	automationMsgTelegramFailed    = "Telegram message could not be sent"
+	automationMsgInternal          = "Internal error"
+)
+
+type automationRepository interface {
+	Create(ctx context.Context, automation domain.Automation) (domain.Automation, error)
+	Get(ctx context.Context, automationID domain.AutomationID) (domain.Automation, error)
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Automation, error)
+	Update(ctx context.Context, automation domain.Automation) (domain.Automation, error)
+	Delete(ctx context.Context, boardID domain.BoardID, automationID domain.AutomationID) error
+	RecordPassedDueDates(ctx context.Context, now time.Time, limit int) error
+	ClaimEvents(ctx context.Context, now time.Time, limit int) ([]domain.AutomationEvent, error)
+	ListRecentEvents(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationEvent, error)
+	CountRuns(ctx context.Context, automationID domain.AutomationID, taskID domain.TaskID, since time.Time) (int, error)
+	RecordRun(ctx context.Context, run domain.AutomationRun) error
+	ListRuns(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationRun, error)
+	CreateFollowUpTask(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, now time.Time) (domain.Task, error)
+}
+
+type automationBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type automationColumnRepository interface {
+	Get(ctx context.Context, columnID domain.ColumnID) (domain.Column, error)
+}
+
+type automationTaskRepository interface {
+	Get(ctx context.Context, taskID domain.TaskID) (domain.Task, error)
+	Move(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID domain.LaneID, targetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error)
+	Update(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error)
+}
+
+type automationCustomFieldRepository interface {
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error)
+}
+
+type automationBlockerRepository interface {
+	ListUnfinishedBlockers(ctx context.Context, taskID domain.TaskID) ([]domain.TaskID, error)
+}
+
+type automationUserRepository interface {
+	Get(ctx context.Context, userID domain.UserID) (domain.User, error)
+}
+
+type automationNotifier interface {
+	Notify(ctx context.Context, chatID domain.TelegramChatID, text domain.TelegramMessage) error
+}
+
+// AutomationPatch holds the automation fields to update. Nil fields are left unchanged.
+type AutomationPatch struct {
+	Name    *domain.AutomationName
+	Trigger *domain.AutomationTrigger
+	Action  *domain.AutomationAction
+	Enabled *bool
+}
+
+type automation struct {
+	automationRepo  automationRepository
+	boardRepo       automationBoardRepository
+	columnRepo      automationColumnRepository
+	taskRepo        automationTaskRepository
+	customFieldRepo automationCustomFieldRepository
+	blockerRepo     automationBlockerRepository
+	userRepo        automationUserRepository
+	notifier        automationNotifier
+	enforceBlockers bool
+}
+
+// NewAutomation creates the automation service. Moves made by automations follow the board
+// workflow and, when enforceBlockers is set, the blockers of the task just like moves of users.
+func NewAutomation(
+	automationRepo automationRepository,
+	boardRepo automationBoardRepository,
+	columnRepo automationColumnRepository,
+	taskRepo automationTaskRepository,
+	customFieldRepo automationCustomFieldRepository,
+	blockerRepo automationBlockerRepository,
+	userRepo automationUserRepository,
+	notifier automationNotifier,
+	enforceBlockers bool,
+) *automation {
+	return &automation{
+		automationRepo:  automationRepo,
+		boardRepo:       boardRepo,
+		columnRepo:      columnRepo,
+		taskRepo:        taskRepo,
+		customFieldRepo: customFieldRepo,
+		blockerRepo:     blockerRepo,
+		userRepo:        userRepo,
+		notifier:        notifier,
+		enforceBlockers: enforceBlockers,
+	}
+}
+
+func (s *automation) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	name domain.AutomationName,
+	trigger domain.AutomationTrigger,
+	action domain.AutomationAction,
+	enabled bool,
+) (domain.Automation, error) {
+	err := s.checkBoard(ctx, callerID, boardID, ErrBoardNotFound)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation service: create: %w", err)
+	}
+
+	err = s.checkRule(ctx, boardID, &trigger, &action)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation service: create: %w", err)
+	}
+
+	automation, err := s.automationRepo.Create(ctx, domain.Automation{
+		BoardID: boardID,
+		Name:    name,
+		Trigger: trigger,
+		Action:  action,
+		Enabled: enabled,
+	})
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation service: create: %v: %w", err, ErrInternal)
+	}
+
+	return automation, nil
+}
+
+func (s *automation) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Automation, error) {
+	err := s.checkBoard(ctx, callerID, boardID, ErrBoardNotFound)
+	if err != nil {
+		return nil, fmt.Errorf("automation service: list: %w", err)
+	}
+
+	automations, err := s.automationRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("automation service: list: %v: %w", err, ErrInternal)
+	}
+
+	return automations, nil
+}
+
+func (s *automation) Update(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	automationID domain.AutomationID,
+	patch AutomationPatch,
+) (domain.Automation, error) {
+	err := s.checkBoard(ctx, callerID, boardID, ErrAutomationNotFound)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation service: update: %w", err)
+	}
+
+	automation, err := s.automationRepo.Get(ctx, automationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Automation{}, ErrAutomationNotFound
+		}
+		return domain.Automation{}, fmt.Errorf("automation service: update get automation: %v: %w", err, ErrInternal)
+	}
+	if automation.BoardID != boardID {
+		return domain.Automation{}, ErrAutomationNotFound
+	}
+
+	if patch.Name != nil {
+		automation.Name = *patch.Name
+	}
+	if patch.Enabled != nil {
+		automation.Enabled = *patch.Enabled
+	}
+	if patch.Trigger != nil {
+		automation.Trigger = *patch.Trigger
+	}
+	if patch.Action != nil {
+		automation.Action = *patch.Action
+	}
+	err = s.checkRule(ctx, boardID, patch.Trigger, patch.Action)
+	if err != nil {
+		return domain.Automation{}, fmt.Errorf("automation service: update: %w", err)
+	}
+
+	updated, err := s.automationRepo.Update(ctx, automation)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Automation{}, ErrAutomationNotFound
+		}
+		return domain.Automation{}, fmt.Errorf("automation service: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+func (s *automation) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, automationID domain.AutomationID) error {
+	err := s.checkBoard(ctx, callerID, boardID, ErrAutomationNotFound)
+	if err != nil {
+		return fmt.Errorf("automation service: delete: %w", err)
+	}
+
+	err = s.automationRepo.Delete(ctx, boardID, automationID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrAutomationNotFound
+		}
+		return fmt.Errorf("automation service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// ListRuns returns the latest entries of the execution log of the board, newest first.
+func (s *automation) ListRuns(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.AutomationRun, error) {
+	err := s.checkBoard(ctx, callerID, boardID, ErrBoardNotFound)
+	if err != nil {
+		return nil, fmt.Errorf("automation service: list runs: %w", err)
+	}
+
+	runs, err := s.automationRepo.ListRuns(ctx, boardID, automationRunsLimit)
+	if err != nil {
+		return nil, fmt.Errorf("automation service: list runs: %v: %w", err, ErrInternal)
+	}
+
+	return runs, nil
+}
+
+// DryRun replays the latest events of the board against the rule without running its action
+// and returns the events the action would have run on. The rule is checked like a new one.
+func (s *automation) DryRun(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	trigger domain.AutomationTrigger,
+	action domain.AutomationAction,
+	events domain.AutomationDryRunEvents,
+) (domain.AutomationDryRun, error) {
+	err := s.checkBoard(ctx, callerID, boardID, ErrBoardNotFound)
+	if err != nil {
+		return domain.AutomationDryRun{}, fmt.Errorf("automation service: dry run: %w", err)
+	}
+
+	err = s.checkRule(ctx, boardID, &trigger, &action)
+	if err != nil {
+		return domain.AutomationDryRun{}, fmt.Errorf("automation service: dry run: %w", err)
+	}
+
+	recent, err := s.automationRepo.ListRecentEvents(ctx, boardID, int(events.Int64()))
+	if err != nil {
+		return domain.AutomationDryRun{}, fmt.Errorf("automation service: dry run list events: %v: %w", err, ErrInternal)
+	}
+
+	dryRun := domain.AutomationDryRun{Events: len(recent)}
+	for i := range recent {
+		if trigger.Matches(&recent[i]) {
+			dryRun.Matches = append(dryRun.Matches, recent[i])
+		}
+	}
+
+	return dryRun, nil
+}
+
+// RunPending runs the enabled automations on the task events that happened since the last call
+// and returns the number of successful runs. Due dates that passed on fields watched by enabled
+// automations are recorded as events first, a batch per call. Every run is appended to the
+// execution log of the board. Events are claimed before the automations run, so an event is
+// handled once even when several replicas run concurrently, and a failed run is not retried.
+// Internal failures of runs are joined into the result.
+func (s *automation) RunPending(ctx context.Context) (int, error) {
+	now := timeNow()
+	err := s.automationRepo.RecordPassedDueDates(ctx, now, automationBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("automation service: run pending record due dates: %v: %w", err, ErrInternal)
+	}
+
+	events, err := s.automationRepo.ClaimEvents(ctx, now, automationBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("automation service: run pending claim: %v: %w", err, ErrInternal)
+	}
+
+	boardAutomations := make(map[domain.BoardID][]domain.Automation)
+	succeeded := 0
+	var errs []error
+	for i := range events {
+		event := &events[i]
+
+		automations, ok := boardAutomations[event.BoardID]
+		if !ok {
+			automations, err = s.automationRepo.ListByBoardID(ctx, event.BoardID)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("event %s: list automations: %v", event.ID, err))
+				continue
+			}
+			boardAutomations[event.BoardID] = automations
+		}
+
+		for j := range automations {
+			automation := &automations[j]
+			if !automation.Enabled || automation.CreatedAt.After(event.OccurredAt) || !automation.Trigger.Matches(event) {
+				continue
+			}
+
+			run, runErr := s.run(ctx, automation, event)
+			if runErr != nil {
+				errs = append(errs, fmt.Errorf("automation %s: event %s: %v", automation.ID, event.ID, runErr))
+			}
+			err = s.automationRepo.RecordRun(ctx, run)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("automation %s: event %s: record run: %v", automation.ID, event.ID, err))
+				continue
+			}
+			if run.Status == domain.AutomationRunSucceeded {
+				succeeded++
+			}
+		}
+	}
+
+	if len(errs) > 0 {
+		return succeeded, fmt.Errorf("automation service: run pending: %v: %w", errors.Join(errs...), ErrInternal)
+	}
+
+	return succeeded, nil
+}
+
+// run applies the automation to the task of the event unless the loop protection stops it.
+// The error is set only for failures the board owner can't fix; the run is then logged as failed.
+func (s *automation) run(ctx context.Context, automation *domain.Automation, event *domain.AutomationEvent) (domain.AutomationRun, error) {
+	run := domain.AutomationRun{
+		AutomationID: automation.ID,
+		BoardID:      event.BoardID,
+		EventID:      event.ID,
+		TaskID:       event.TaskID,
+		RanAt:        timeNow(),
+	}
+
+	runs, err := s.automationRepo.CountRuns(ctx, automation.ID, event.TaskID, run.RanAt.Add(-automationLoopWindow))
+	if err != nil {
+		run.Status, run.Message = domain.AutomationRunFailed, automationMsgInternal
+		return run, fmt.Errorf("count runs: %w", err)
+	}
+	if runs >= maxAutomationRunsPerTask {
+		run.Status, run.Message = domain.AutomationRunSkipped, automationMsgLoop
+		return run, nil
+	}
+
+	run.Status, run.Message, err = s.apply(ctx, automation, event)
+	if err != nil && run.Message == "" {
+		run.Message = automationMsgInternal
+	}
+
+	return run, err
+}
+
+// apply runs the action of the automation on the task of the event and tells how it went.
+func (s *automation) apply(ctx context.Context, automation *domain.Automation, event *domain.AutomationEvent) (domain.AutomationRunStatus, string, error) {
+	task, err := s.taskRepo.Get(ctx, event.TaskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.AutomationRunSkipped, automationMsgTaskDeleted, nil
+		}
+		return domain.AutomationRunFailed, "", fmt.Errorf("get task: %w", err)
+	}
+
+	action := &automation.Action
+	switch action.Type {
+	case domain.AutomationMoveToColumn:
+		return s.applyMove(ctx, event.BoardID, &task, action.ColumnID)
+	case domain.AutomationSetCustomField:
+		return s.applySetCustomField(ctx, event.BoardID, &task, action.FieldID, action.Value)
+	case domain.AutomationAddLabel:
+		return s.applyAddLabel(ctx, event.BoardID, &task, action.FieldID, action.Label)
+	case domain.AutomationNotifyTelegram:
+		return s.applyNotify(ctx, event.BoardID, &task, action.Message)
+	default:
+		return s.applyCreateTask(ctx, event.BoardID, &task, action.ColumnID, action.TaskName)
+	}
+}
+
+// applyMove moves the task to the top of the column in its current lane.
+func (s *automation) applyMove(ctx context.Context, boardID domain.BoardID, task *domain.Task, columnID domain.ColumnID) (domain.AutomationRunStatus, string, error) {
+	if task.ColumnID == columnID {
+		return domain.AutomationRunSkipped, automationMsgAlreadyInColumn, nil
+	}
+
+	from, err := s.columnRepo.Get(ctx, task.ColumnID)
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("get column: %w", err)
+	}
+	to, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.AutomationRunFailed, automationMsgColumnNotFound, nil
+		}
+		return domain.AutomationRunFailed, "", fmt.Errorf("get target column: %w", err)
+	}
+	if to.BoardID != boardID {
+		return domain.AutomationRunFailed, automationMsgColumnNotFound, nil
+	}
+
+	var transitionErr *TransitionNotAllowedError
+	if errors.As(checkTransition(task, &from, &to, domain.ColumnEntryAutomation), &transitionErr) {
+		if !transitionErr.Allowed {
+			return domain.AutomationRunFailed, automationMsgTransition, nil
+		}
+		if transitionErr.Restricted {
+			return domain.AutomationRunFailed, automationMsgEntryRoles, nil
+		}
+		return domain.AutomationRunFailed, automationMsgEntryConditions, nil
+	}
+	if s.enforceBlockers && to.IsDone {
+		blockerIDs, blockersErr := s.blockerRepo.ListUnfinishedBlockers(ctx, task.ID)
+		if blockersErr != nil {
+			return domain.AutomationRunFailed, "", fmt.Errorf("list blockers: %w", blockersErr)
+		}
+		if len(blockerIDs) > 0 {
+			return domain.AutomationRunFailed, automationMsgBlocked, nil
+		}
+	}
+
+	top, err := domain.NewTaskPosition(1)
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("position: %w", err)
+	}
+	_, err = s.taskRepo.Move(ctx, boardID, task.ColumnID, task.ID, columnID, task.LaneID, top, domain.Version{})
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.AutomationRunSkipped, automationMsgTaskDeleted, nil
+		}
+		return domain.AutomationRunFailed, "", fmt.Errorf("move task: %w", err)
+	}
+
+	return domain.AutomationRunSucceeded, "", nil
+}
+
+// applySetCustomField sets the field of the task. The value is checked against the field as
+// it is now, and the issues are logged when it no longer fits.
+func (s *automation) applySetCustomField(
+	ctx context.Context,
+	boardID domain.BoardID,
+	task *domain.Task,
+	fieldID domain.CustomFieldID,
+	value json.RawMessage,
+) (domain.AutomationRunStatus, string, error) {
+	fields, err := s.customFieldRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("list custom fields: %w", err)
+	}
+
+	values, err := applyCustomFieldChanges(fields, task.CustomFields, map[domain.CustomFieldID]json.RawMessage{fieldID: value})
+	if err != nil {
+		var valuesErr *CustomFieldValuesError
+		if errors.As(err, &valuesErr) {
+			return domain.AutomationRunFailed, strings.Join(valuesErr.Issues[fieldID], "; "), nil
+		}
+		return domain.AutomationRunFailed, "", fmt.Errorf("apply custom field: %w", err)
+	}
+
+	_, err = s.taskRepo.Update(ctx, task.ColumnID, task.ID, nil, nil, nil, nil, &values, domain.Version{})
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.AutomationRunSkipped, automationMsgTaskDeleted, nil
+		}
+		return domain.AutomationRunFailed, "", fmt.Errorf("update task: %w", err)
+	}
+
+	return domain.AutomationRunSucceeded, "", nil
+}
+
+// applyAddLabel adds the label to the select field of the task. A single select field holds one
+// label, so the label replaces the current one there.
+func (s *automation) applyAddLabel(
+	ctx context.Context,
+	boardID domain.BoardID,
+	task *domain.Task,
+	fieldID domain.CustomFieldID,
+	label string,
+) (domain.AutomationRunStatus, string, error) {
+	fields, err := s.customFieldRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("list custom fields: %w", err)
+	}
+	field, ok := findCustomField(fields, fieldID)
+	if !ok {
+		return domain.AutomationRunFailed, automationMsgFieldNotFound, nil
+	}
+
+	labels := taskLabels(task, fieldID)
+	if slices.Contains(labels, label) {
+		return domain.AutomationRunSkipped, automationMsgAlreadyLabeled, nil
+	}
+
+	var value any = label
+	if field.Type == domain.CustomFieldMultiSelect {
+		value = append(labels, label)
+	}
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("marshal label: %w", err)
+	}
+
+	return s.applySetCustomField(ctx, boardID, task, fieldID, raw)
+}
+
+// taskLabels returns the options the task holds in the select field.
+func taskLabels(task *domain.Task, fieldID domain.CustomFieldID) []string {
+	value, ok := task.CustomFields.Get(fieldID)
+	if !ok {
+		return nil
+	}
+
+	var labels []string
+	if json.Unmarshal(value.JSON(), &labels) == nil {
+		return labels
+	}
+	var label string
+	if json.Unmarshal(value.JSON(), &label) == nil {
+		return []string{label}
+	}
+
+	return nil
+}
+
+// applyNotify sends the message about the task to the Telegram chat of the board owner.
+func (s *automation) applyNotify(ctx context.Context, boardID domain.BoardID, task *domain.Task, message domain.AutomationMessage) (domain.AutomationRunStatus, string, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("get board: %w", err)
+	}
+	owner, err := s.userRepo.Get(ctx, board.OwnerID)
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("get board owner: %w", err)
+	}
+	if owner.TelegramChatID.Int64() == 0 {
+		return domain.AutomationRunFailed, automationMsgTelegramNotLinked, nil
+	}
+
+	text, err := domain.NewTelegramMessage(domain.AutomationNotification(board.Name, task.Name, message))
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("message: %w", err)
+	}
+	err = s.notifier.Notify(ctx, owner.TelegramChatID, text)
+	if err != nil {
+		return domain.AutomationRunFailed, automationMsgTelegramFailed, fmt.Errorf("notify: %w", err)
+	}
+
+	return domain.AutomationRunSucceeded, "", nil
+}
+
+// applyCreateTask creates a follow-up of the task at the end of the column.
+func (s *automation) applyCreateTask(
+	ctx context.Context,
+	boardID domain.BoardID,
+	task *domain.Task,
+	columnID domain.ColumnID,
+	name domain.TaskName,
+) (domain.AutomationRunStatus, string, error) {
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.AutomationRunFailed, automationMsgColumnNotFound, nil
+		}
+		return domain.AutomationRunFailed, "", fmt.Errorf("get column: %w", err)
+	}
+	if column.BoardID != boardID {
+		return domain.AutomationRunFailed, automationMsgColumnNotFound, nil
+	}
+
+	description, err := domain.NewTaskDescription(fmt.Sprintf("Follow-up of %q.", task.Name.String()))
+	if err != nil {
+		return domain.AutomationRunFailed, "", fmt.Errorf("description: %w", err)
+	}
+	_, err = s.automationRepo.CreateFollowUpTask(ctx, columnID, name, description, timeNow())
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.AutomationRunFailed, automationMsgColumnNotFound, nil
+		}
+		return domain.AutomationRunFailed, "", fmt.Errorf("create follow-up task: %w", err)
+	}
+
+	return domain.AutomationRunSucceeded, "", nil
+}
+
+// checkBoard verifies that the caller owns the board and returns notFound otherwise.
+func (s *automation) checkBoard(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, notFound error) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return notFound
+		}
+		return fmt.Errorf("get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return notFound
+	}
+
+	return nil
+}
+
+// checkRule verifies that the columns and the custom fields the trigger and the action refer to
+// belong to the board, that the fields have the type the trigger and the action need, and that
+// a custom field value or a label fits its field. Nil parts are not checked.
+func (s *automation) checkRule(ctx context.Context, boardID domain.BoardID, trigger *domain.AutomationTrigger, action *domain.AutomationAction) error {
+	if trigger != nil && !trigger.ColumnID.IsNil() {
+		err := s.checkColumn(ctx, boardID, trigger.ColumnID, ErrColumnNotFound)
+		if err != nil {
+			return err
+		}
+	}
+	if trigger != nil && trigger.Type.IsFieldTrigger() {
+		err := s.checkTriggerField(ctx, boardID, trigger)
+		if err != nil {
+			return err
+		}
+	}
+	if action == nil {
+		return nil
+	}
+
+	switch action.Type {
+	case domain.AutomationMoveToColumn, domain.AutomationCreateTask:
+		return s.checkColumn(ctx, boardID, action.ColumnID, ErrActionColumnNotFound)
+	case domain.AutomationSetCustomField:
+		fields, err := s.customFieldRepo.ListByBoardID(ctx, boardID)
+		if err != nil {
+			return fmt.Errorf("list custom fields: %v: %w", err, ErrInternal)
+		}
+		if _, ok := findCustomField(fields, action.FieldID); !ok {
+			return ErrCustomFieldNotFound
+		}
+		_, err = applyCustomFieldChanges(fields, domain.TaskCustomFields{}, map[domain.CustomFieldID]json.RawMessage{action.FieldID: action.Value})
+		return err
+	case domain.AutomationAddLabel:
+		fields, err := s.customFieldRepo.ListByBoardID(ctx, boardID)
+		if err != nil {
+			return fmt.Errorf("list custom fields: %v: %w", err, ErrInternal)
+		}
+		field, ok := findCustomField(fields, action.FieldID)
+		if !ok {
+			return ErrCustomFieldNotFound
+		}
+		if !field.Type.IsSelect() {
+			return ErrActionFieldType
+		}
+		if !field.Options.Contains(action.Label) {
+			return ErrActionLabelNotFound
+		}
+		return nil
+	default:
+		return nil
+	}
+}
+
+// checkTriggerField verifies that the field of a due_date_passed trigger is a date field and
+// the field of a label_added trigger is a select field that has the label among its options.
+func (s *automation) checkTriggerField(ctx context.Context, boardID domain.BoardID, trigger *domain.AutomationTrigger) error {
+	fields, err := s.customFieldRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("list custom fields: %v: %w", err, ErrInternal)
+	}
+	field, ok := findCustomField(fields, trigger.FieldID)
+	if !ok {
+		return ErrTriggerFieldNotFound
+	}
+
+	if trigger.Type == domain.AutomationDueDatePassed {
+		if field.Type != domain.CustomFieldDate {
+			return ErrTriggerFieldType
+		}
+		return nil
+	}
+	if !field.Type.IsSelect() {
+		return ErrTriggerFieldType
+	}
+	if trigger.Label != "" && !field.Options.Contains(trigger.Label) {
+		return ErrTriggerLabelNotFound
+	}
+
+	return nil
+}
+
+func (s *automation) checkColumn(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, notFound error) error {
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return notFound
+		}
+		return fmt.Errorf("get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return notFound
+	}
+
+	return nil
+}
@@ -0,0 +1,709 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestAutomation_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validField := testutil.ValidCustomField(validBoard.ID)
+	validAutomation := testutil.ValidAutomation(validBoard.ID, validColumn.ID)
+
+	setCustomField := func(t *testing.T, fieldID domain.CustomFieldID, value string) domain.AutomationAction {
+		t.Helper()
+		action, err := domain.NewSetCustomFieldAction(fieldID, json.RawMessage(value))
+		if err != nil {
+			t.Fatalf("NewSetCustomFieldAction() error = %v", err)
+		}
+		return action
+	}
+
+	tests := []struct {
+		name            string
+		callerID        domain.UserID
+		trigger         domain.AutomationTrigger
+		action          func(t *testing.T) domain.AutomationAction
+		columns         map[domain.ColumnID]domain.Column
+		setupAutomation func(t *testing.T, r *MockAutomationRepository)
+		wantErr         error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			trigger:  domain.AutomationTrigger{Type: domain.AutomationTaskMoved, ColumnID: validColumn.ID},
+			action: func(t *testing.T) domain.AutomationAction {
+				return domain.NewMoveToColumnAction(validColumn.ID)
+			},
+			columns: map[domain.ColumnID]domain.Column{validColumn.ID: validColumn},
+			setupAutomation: func(t *testing.T, r *MockAutomationRepository) {
+				r.CreateFunc = func(ctx context.Context, automation domain.Automation) (domain.Automation, error) {
+					if automation.BoardID != validBoard.ID || !automation.Enabled {
+						t.Errorf("got board %v enabled %v, want %v true", automation.BoardID, automation.Enabled, validBoard.ID)
+					}
+					if automation.Action.ColumnID != validColumn.ID {
+						t.Errorf("got action column %v, want %v", automation.Action.ColumnID, validColumn.ID)
+					}
+					return validAutomation, nil
+				}
+			},
+		},
+		{
+			name:     "Board not found when wrong owner",
+			callerID: domain.NewUserID(),
+			trigger:  domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action: func(t *testing.T) domain.AutomationAction {
+				return domain.NewMoveToColumnAction(validColumn.ID)
+			},
+			setupAutomation: func(t *testing.T, r *MockAutomationRepository) {},
+			wantErr:         service.ErrBoardNotFound,
+		},
+		{
+			name:     "Trigger column on another board",
+			callerID: validBoard.OwnerID,
+			trigger:  domain.AutomationTrigger{Type: domain.AutomationTaskMoved, ColumnID: validColumn.ID},
+			action: func(t *testing.T) domain.AutomationAction {
+				return domain.NewMoveToColumnAction(validColumn.ID)
+			},
+			columns:         map[domain.ColumnID]domain.Column{validColumn.ID: testutil.ValidColumn(domain.NewBoardID())},
+			setupAutomation: func(t *testing.T, r *MockAutomationRepository) {},
+			wantErr:         service.ErrColumnNotFound,
+		},
+		{
+			name:     "Action column not found",
+			callerID: validBoard.OwnerID,
+			trigger:  domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action: func(t *testing.T) domain.AutomationAction {
+				return domain.NewMoveToColumnAction(domain.NewColumnID())
+			},
+			setupAutomation: func(t *testing.T, r *MockAutomationRepository) {},
+			wantErr:         service.ErrActionColumnNotFound,
+		},
+		{
+			name:     "Custom field not found",
+			callerID: validBoard.OwnerID,
+			trigger:  domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action: func(t *testing.T) domain.AutomationAction {
+				return setCustomField(t, domain.NewCustomFieldID(), `"staging"`)
+			},
+			setupAutomation: func(t *testing.T, r *MockAutomationRepository) {},
+			wantErr:         service.ErrCustomFieldNotFound,
+		},
+		{
+			name:     "Custom field value invalid",
+			callerID: validBoard.OwnerID,
+			trigger:  domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action: func(t *testing.T) domain.AutomationAction {
+				return setCustomField(t, validField.ID, `"qa"`)
+			},
+			setupAutomation: func(t *testing.T, r *MockAutomationRepository) {},
+			wantErr:         service.ErrCustomFieldValueInvalid,
+		},
+		{
+			name:     "Create internal error",
+			callerID: validBoard.OwnerID,
+			trigger:  domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action: func(t *testing.T) domain.AutomationAction {
+				return setCustomField(t, validField.ID, `"production"`)
+			},
+			setupAutomation: func(t *testing.T, r *MockAutomationRepository) {
+				r.CreateFunc = func(ctx context.Context, automation domain.Automation) (domain.Automation, error) {
+					return domain.Automation{}, errors.New("insert failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				column, ok := tt.columns[columnID]
+				if !ok {
+					return domain.Column{}, repository.ErrRowNotFound
+				}
+				return column, nil
+			}
+			customFieldRepo := NewMockCustomFieldRepository(t)
+			customFieldRepo.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error) {
+				return []domain.CustomField{validField}, nil
+			}
+			automationRepo := NewMockAutomationRepository(t)
+			tt.setupAutomation(t, automationRepo)
+
+			s := service.NewAutomation(
+				automationRepo, boardRepo, columnRepo, NewMockTaskRepository(t), customFieldRepo,
+				NewMockTaskBlockerRepository(t), NewMockUserRepository(t), NewMockTelegramNotifier(t), false,
+			)
+			got, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validAutomation.Name, tt.trigger, tt.action(t), true)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(validAutomation, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Create() automation mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAutomation_RunPending(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todo := testutil.NewValidColumn(t, validBoard.ID, "To do", 1)
+	done := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	validTask := testutil.NewValidTask(t, todo.ID, "Write docs", "", 1)
+	event := domain.AutomationEvent{
+		ID:         domain.NewAutomationEventID(),
+		Type:       domain.AutomationTaskCreated,
+		BoardID:    validBoard.ID,
+		TaskID:     validTask.ID,
+		ToColumnID: todo.ID,
+		OccurredAt: testutil.FixedNow().Add(time.Hour),
+	}
+	moveToDone := testutil.ValidAutomation(validBoard.ID, done.ID)
+
+	onlyToItself, err := domain.NewColumnTransitions([]domain.ColumnID{todo.ID})
+	if err != nil {
+		t.Fatalf("NewColumnTransitions() error = %v", err)
+	}
+	restrictedTodo := todo
+	restrictedTodo.Transitions = onlyToItself
+	ownerOnlyDone := done
+	ownerOnlyDone.EntryRoles, err = domain.NewColumnEntryRoles([]string{"owner"})
+	if err != nil {
+		t.Fatalf("NewColumnEntryRoles() error = %v", err)
+	}
+
+	message, err := domain.NewAutomationMessage("New task")
+	if err != nil {
+		t.Fatalf("NewAutomationMessage() error = %v", err)
+	}
+	notify := moveToDone
+	notify.Action = domain.NewNotifyTelegramAction(message)
+
+	createdLater := moveToDone
+	createdLater.CreatedAt = event.OccurredAt.Add(time.Minute)
+	disabled := moveToDone
+	disabled.Enabled = false
+	movedEvent := event
+	movedEvent.Type = domain.AutomationTaskMoved
+	movedEvent.FromColumnID = done.ID
+
+	environment := testutil.ValidCustomField(validBoard.ID)
+	tags := testutil.ValidCustomField(validBoard.ID)
+	tags.ID = domain.NewCustomFieldID()
+	tags.Type = domain.CustomFieldMultiSelect
+	due := testutil.ValidCustomField(validBoard.ID)
+	due.ID = domain.NewCustomFieldID()
+	due.Type = domain.CustomFieldDate
+	due.Options = domain.CustomFieldOptions{}
+	fields := []domain.CustomField{environment, tags, due}
+
+	dueEvent := event
+	dueEvent.Type = domain.AutomationDueDatePassed
+	dueEvent.FieldID = due.ID
+	dueEvent.Value = "2026-01-01"
+	labelEvent := event
+	labelEvent.Type = domain.AutomationLabelAdded
+	labelEvent.FieldID = tags.ID
+	labelEvent.Value = "staging"
+
+	notifyWhenDue := notify
+	notifyWhenDue.Trigger = domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: due.ID}
+	notifyWhenOtherDue := notify
+	notifyWhenOtherDue.Trigger = domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: environment.ID}
+	addLabel := func(trigger domain.AutomationTrigger, fieldID domain.CustomFieldID, label string) domain.Automation {
+		action, actionErr := domain.NewAddLabelAction(fieldID, label)
+		if actionErr != nil {
+			t.Fatalf("NewAddLabelAction() error = %v", actionErr)
+		}
+		automation := moveToDone
+		automation.Trigger = trigger
+		automation.Action = action
+		return automation
+	}
+	stagingAdded := domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: tags.ID, Label: "staging"}
+	productionAdded := domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: tags.ID, Label: "production"}
+	taggedTask := validTask
+	taggedTask.CustomFields, err = domain.ParseTaskCustomFields(
+		fmt.Appendf(nil, `{%q: ["staging"], %q: "staging"}`, tags.ID, environment.ID),
+	)
+	if err != nil {
+		t.Fatalf("ParseTaskCustomFields() error = %v", err)
+	}
+
+	type runResult struct {
+		Status  domain.AutomationRunStatus
+		Message string
+	}
+
+	tests := []struct {
+		name         string
+		events       []domain.AutomationEvent
+		recordErr    error
+		claimErr     error
+		automations  []domain.Automation
+		todoColumn   domain.Column
+		doneColumn   *domain.Column
+		task         *domain.Task
+		runs         int
+		chatID       domain.TelegramChatID
+		wantMoved    bool
+		wantNotified bool
+		wantFields   map[string]string
+		wantRuns     []runResult
+		wantCount    int
+		wantErr      error
+	}{
+		{
+			name:        "Move succeeds",
+			events:      []domain.AutomationEvent{event},
+			automations: []domain.Automation{moveToDone},
+			todoColumn:  todo,
+			wantMoved:   true,
+			wantRuns:    []runResult{{Status: domain.AutomationRunSucceeded}},
+			wantCount:   1,
+		},
+		{
+			name:        "Loop protection skips the run",
+			events:      []domain.AutomationEvent{event},
+			automations: []domain.Automation{moveToDone},
+			todoColumn:  todo,
+			runs:        5,
+			wantRuns: []runResult{{
+				Status:  domain.AutomationRunSkipped,
+				Message: "Skipped by loop protection: the automation ran too often on this task",
+			}},
+		},
+		{
+			name:        "Workflow forbids the move",
+			events:      []domain.AutomationEvent{event},
+			automations: []domain.Automation{moveToDone},
+			todoColumn:  restrictedTodo,
+			wantRuns: []runResult{{
+				Status:  domain.AutomationRunFailed,
+				Message: "Board workflow doesn't allow moving the task to the column",
+			}},
+		},
+		{
+			name:        "Owner only column refuses the move",
+			events:      []domain.AutomationEvent{event},
+			automations: []domain.Automation{moveToDone},
+			todoColumn:  todo,
+			doneColumn:  &ownerOnlyDone,
+			wantRuns: []runResult{{
+				Status:  domain.AutomationRunFailed,
+				Message: "Column doesn't let automations move tasks into it",
+			}},
+		},
+		{
+			name:        "Disabled, newer and unmatched automations are ignored",
+			events:      []domain.AutomationEvent{event, movedEvent},
+			automations: []domain.Automation{disabled, createdLater},
+			todoColumn:  todo,
+		},
+		{
+			name:         "Notify sends the message",
+			events:       []domain.AutomationEvent{event},
+			automations:  []domain.Automation{notify},
+			todoColumn:   todo,
+			chatID:       testutil.ValidTelegramChatID(),
+			wantNotified: true,
+			wantRuns:     []runResult{{Status: domain.AutomationRunSucceeded}},
+			wantCount:    1,
+		},
+		{
+			name:        "Notify fails without a linked chat",
+			events:      []domain.AutomationEvent{event},
+			automations: []domain.Automation{notify},
+			todoColumn:  todo,
+			wantRuns: []runResult{{
+				Status:  domain.AutomationRunFailed,
+				Message: "Board owner has not linked a Telegram chat",
+			}},
+		},
+		{
+			name:         "Due date passed notifies",
+			events:       []domain.AutomationEvent{dueEvent, event},
+			automations:  []domain.Automation{notifyWhenDue, notifyWhenOtherDue},
+			todoColumn:   todo,
+			chatID:       testutil.ValidTelegramChatID(),
+			wantNotified: true,
+			wantRuns:     []runResult{{Status: domain.AutomationRunSucceeded}},
+			wantCount:    1,
+		},
+		{
+			name:        "Label added adds a label to a multi select field",
+			events:      []domain.AutomationEvent{labelEvent},
+			automations: []domain.Automation{addLabel(stagingAdded, tags.ID, "production"), addLabel(productionAdded, tags.ID, "staging")},
+			todoColumn:  todo,
+			task:        &taggedTask,
+			wantFields: map[string]string{
+				tags.ID.String():        `["staging","production"]`,
+				environment.ID.String(): `"staging"`,
+			},
+			wantRuns:  []runResult{{Status: domain.AutomationRunSucceeded}},
+			wantCount: 1,
+		},
+		{
+			name:        "Add label replaces the label of a single select field",
+			events:      []domain.AutomationEvent{labelEvent},
+			automations: []domain.Automation{addLabel(stagingAdded, environment.ID, "production")},
+			todoColumn:  todo,
+			task:        &taggedTask,
+			wantFields: map[string]string{
+				tags.ID.String():        `["staging"]`,
+				environment.ID.String(): `"production"`,
+			},
+			wantRuns:  []runResult{{Status: domain.AutomationRunSucceeded}},
+			wantCount: 1,
+		},
+		{
+			name:        "Add label skips a task that has the label",
+			events:      []domain.AutomationEvent{labelEvent},
+			automations: []domain.Automation{addLabel(stagingAdded, tags.ID, "staging")},
+			todoColumn:  todo,
+			task:        &taggedTask,
+			wantRuns:    []runResult{{Status: domain.AutomationRunSkipped, Message: "Task already has the label"}},
+		},
+		{
+			name:        "Add label fails when the field is gone",
+			events:      []domain.AutomationEvent{event},
+			automations: []domain.Automation{addLabel(domain.AutomationTrigger{Type: domain.AutomationTaskCreated}, domain.NewCustomFieldID(), "staging")},
+			todoColumn:  todo,
+			wantRuns:    []runResult{{Status: domain.AutomationRunFailed, Message: "Custom field no longer exists"}},
+		},
+		{
+			name:      "Internal error from recording due dates",
+			recordErr: repository.ErrInternal,
+			wantErr:   service.ErrInternal,
+		},
+		{
+			name:     "Internal error from claim",
+			claimErr: repository.ErrInternal,
+			wantErr:  service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var runs []runResult
+			automationRepo := NewMockAutomationRepository(t)
+			recorded := false
+			automationRepo.RecordPassedDueDatesFunc = func(ctx context.Context, now time.Time, limit int) error {
+				recorded = true
+				return tt.recordErr
+			}
+			automationRepo.ClaimEventsFunc = func(ctx context.Context, now time.Time, limit int) ([]domain.AutomationEvent, error) {
+				if !recorded {
+					t.Error("got events claimed before passed due dates were recorded")
+				}
+				if limit <= 0 {
+					t.Errorf("got limit %d, want positive", limit)
+				}
+				return tt.events, tt.claimErr
+			}
+			automationRepo.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Automation, error) {
+				return tt.automations, nil
+			}
+			automationRepo.CountRunsFunc = func(ctx context.Context, automationID domain.AutomationID, taskID domain.TaskID, since time.Time) (int, error) {
+				return tt.runs, nil
+			}
+			automationRepo.RecordRunFunc = func(ctx context.Context, run domain.AutomationRun) error {
+				if run.EventID != event.ID || run.TaskID != validTask.ID {
+					t.Errorf("got run of event %v task %v, want %v %v", run.EventID, run.TaskID, event.ID, validTask.ID)
+				}
+				runs = append(runs, runResult{Status: run.Status, Message: run.Message})
+				return nil
+			}
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				if columnID == todo.ID {
+					return tt.todoColumn, nil
+				}
+				if tt.doneColumn != nil {
+					return *tt.doneColumn, nil
+				}
+				return done, nil
+			}
+
+			moved := false
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				if tt.task != nil {
+					return *tt.task, nil
+				}
+				return validTask, nil
+			}
+			var gotFields map[string]string
+			taskRepo.UpdateFunc = func(
+				ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName,
+				description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate,
+				customFields *domain.TaskCustomFields, expected domain.Version,
+			) (domain.Task, error) {
+				gotFields = make(map[string]string)
+				for fieldID, value := range customFields.JSON() {
+					gotFields[fieldID] = string(value)
+				}
+				return validTask, nil
+			}
+			taskRepo.MoveFunc = func(
+				ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID,
+				targetColumnID domain.ColumnID, targetLaneID domain.LaneID, targetPosition domain.TaskPosition,
+				expected domain.Version,
+			) (domain.TaskPlacement, error) {
+				if targetColumnID != done.ID {
+					t.Errorf("got target column %v, want %v", targetColumnID, done.ID)
+				}
+				moved = true
+				return domain.TaskPlacement{}, nil
+			}
+
+			userRepo := NewMockUserRepository(t)
+			userRepo.GetFunc = func(ctx context.Context, userID domain.UserID) (domain.User, error) {
+				if userID != validBoard.OwnerID {
+					t.Errorf("got user %v, want board owner %v", userID, validBoard.OwnerID)
+				}
+				return domain.User{ID: userID, TelegramChatID: tt.chatID}, nil
+			}
+			notified := false
+			notifier := NewMockTelegramNotifier(t)
+			notifier.NotifyFunc = func(ctx context.Context, chatID domain.TelegramChatID, text domain.TelegramMessage) error {
+				if !strings.Contains(text.String(), message.String()) {
+					t.Errorf("got message %q, want it to contain %q", text.String(), message.String())
+				}
+				notified = true
+				return nil
+			}
+
+			customFieldRepo := NewMockCustomFieldRepository(t)
+			customFieldRepo.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error) {
+				return fields, nil
+			}
+
+			s := service.NewAutomation(
+				automationRepo, boardRepo, columnRepo, taskRepo, customFieldRepo,
+				NewMockTaskBlockerRepository(t), userRepo, notifier, false,
+			)
+			count, err := s.RunPending(context.Background())
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if count != tt.wantCount {
+				t.Errorf("got succeeded count %d, want %d", count, tt.wantCount)
+			}
+			if moved != tt.wantMoved {
+				t.Errorf("got moved %v, want %v", moved, tt.wantMoved)
+			}
+			if notified != tt.wantNotified {
+				t.Errorf("got notified %v, want %v", notified, tt.wantNotified)
+			}
+			if diff := cmp.Diff(tt.wantFields, gotFields); diff != "" {
+				t.Errorf("updated custom fields mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantRuns, runs); diff != "" {
+				t.Errorf("recorded runs mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAutomation_DryRun(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todo := testutil.NewValidColumn(t, validBoard.ID, "To do", 1)
+	done := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	environment := testutil.ValidCustomField(validBoard.ID)
+	due := testutil.ValidCustomField(validBoard.ID)
+	due.ID = domain.NewCustomFieldID()
+	due.Type = domain.CustomFieldDate
+	due.Options = domain.CustomFieldOptions{}
+
+	newEvent := func(eventType domain.AutomationTriggerType, from, to domain.ColumnID) domain.AutomationEvent {
+		return domain.AutomationEvent{
+			ID:           domain.NewAutomationEventID(),
+			Type:         eventType,
+			BoardID:      validBoard.ID,
+			TaskID:       domain.NewTaskID(),
+			FromColumnID: from,
+			ToColumnID:   to,
+			OccurredAt:   testutil.FixedNow(),
+		}
+	}
+	created := newEvent(domain.AutomationTaskCreated, domain.ColumnID{}, todo.ID)
+	movedToDone := newEvent(domain.AutomationTaskMoved, todo.ID, done.ID)
+	movedToTodo := newEvent(domain.AutomationTaskMoved, done.ID, todo.ID)
+	duePassed := newEvent(domain.AutomationDueDatePassed, domain.ColumnID{}, todo.ID)
+	duePassed.FieldID, duePassed.Value = due.ID, "2026-01-01"
+	labelAdded := newEvent(domain.AutomationLabelAdded, domain.ColumnID{}, done.ID)
+	labelAdded.FieldID, labelAdded.Value = environment.ID, "production"
+	recent := []domain.AutomationEvent{created, movedToDone, movedToTodo, duePassed, labelAdded}
+
+	addLabel := func(fieldID domain.CustomFieldID, label string) domain.AutomationAction {
+		action, err := domain.NewAddLabelAction(fieldID, label)
+		if err != nil {
+			t.Fatalf("NewAddLabelAction() error = %v", err)
+		}
+		return action
+	}
+
+	events, err := domain.NewAutomationDryRunEvents(10)
+	if err != nil {
+		t.Fatalf("NewAutomationDryRunEvents() error = %v", err)
+	}
+
+	tests := []struct {
+		name        string
+		trigger     domain.AutomationTrigger
+		action      domain.AutomationAction
+		wantMatches []domain.AutomationEvent
+		wantErr     error
+	}{
+		{
+			name:        "Task moved into the column",
+			trigger:     domain.AutomationTrigger{Type: domain.AutomationTaskMoved, ColumnID: done.ID},
+			action:      domain.NewMoveToColumnAction(todo.ID),
+			wantMatches: []domain.AutomationEvent{movedToDone},
+		},
+		{
+			name:        "Due date passed",
+			trigger:     domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: due.ID},
+			action:      domain.NewMoveToColumnAction(done.ID),
+			wantMatches: []domain.AutomationEvent{duePassed},
+		},
+		{
+			name:        "Label added",
+			trigger:     domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: environment.ID, Label: "production"},
+			action:      addLabel(environment.ID, "staging"),
+			wantMatches: []domain.AutomationEvent{labelAdded},
+		},
+		{
+			name:    "Another label added",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: environment.ID, Label: "staging"},
+			action:  domain.NewMoveToColumnAction(done.ID),
+		},
+		{
+			name:    "Trigger field not found",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: domain.NewCustomFieldID()},
+			action:  domain.NewMoveToColumnAction(done.ID),
+			wantErr: service.ErrTriggerFieldNotFound,
+		},
+		{
+			name:    "Due date passed on a select field",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationDueDatePassed, FieldID: environment.ID},
+			action:  domain.NewMoveToColumnAction(done.ID),
+			wantErr: service.ErrTriggerFieldType,
+		},
+		{
+			name:    "Label added on a date field",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: due.ID},
+			action:  domain.NewMoveToColumnAction(done.ID),
+			wantErr: service.ErrTriggerFieldType,
+		},
+		{
+			name:    "Trigger label not an option",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationLabelAdded, FieldID: environment.ID, Label: "qa"},
+			action:  domain.NewMoveToColumnAction(done.ID),
+			wantErr: service.ErrTriggerLabelNotFound,
+		},
+		{
+			name:    "Add label to a date field",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action:  addLabel(due.ID, "staging"),
+			wantErr: service.ErrActionFieldType,
+		},
+		{
+			name:    "Add label that is not an option",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action:  addLabel(environment.ID, "qa"),
+			wantErr: service.ErrActionLabelNotFound,
+		},
+		{
+			name:    "Add label to a missing field",
+			trigger: domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+			action:  addLabel(domain.NewCustomFieldID(), "staging"),
+			wantErr: service.ErrCustomFieldNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			automationRepo := NewMockAutomationRepository(t)
+			automationRepo.ListRecentEventsFunc = func(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationEvent, error) {
+				if limit != 10 {
+					t.Errorf("got limit %d, want 10", limit)
+				}
+				return recent, nil
+			}
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				if columnID == todo.ID {
+					return todo, nil
+				}
+				return done, nil
+			}
+			customFieldRepo := NewMockCustomFieldRepository(t)
+			customFieldRepo.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error) {
+				return []domain.CustomField{environment, due}, nil
+			}
+
+			s := service.NewAutomation(
+				automationRepo, boardRepo, columnRepo, NewMockTaskRepository(t), customFieldRepo,
+				NewMockTaskBlockerRepository(t), NewMockUserRepository(t), NewMockTelegramNotifier(t), false,
+			)
+			got, err := s.DryRun(context.Background(), validBoard.OwnerID, validBoard.ID, tt.trigger, tt.action, events)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			want := domain.AutomationDryRun{Events: len(recent), Matches: tt.wantMatches}
+			if diff := cmp.Diff(want, got, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("DryRun() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"time"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/repository"
@@ -12,40 +13,78 @@ import (
 
 type boardRepository interface {
 	Create(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
+	CreateWithColumns(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, columns []domain.BoardTemplateColumn) (domain.Board, error)
 	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
 	ListByOwnerID(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error)
-	Update(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error)
-	Delete(ctx context.Context, boardID domain.BoardID) error
+	Update(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error)
+	Delete(ctx context.Context, boardID domain.BoardID, expected domain.Version) error
+	Duplicate(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
 }
 
 type boardColumnRepository interface {
 	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error)
 }
 
+type boardLaneRepository interface {
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error)
+}
+
 type boardTaskRepository interface {
-	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error)
+	ListByBoardID(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error)
+	ListColumnEntries(ctx context.Context, boardID domain.BoardID) (map[domain.TaskID]time.Time, error)
+}
+
+type boardTemplateRepository interface {
+	Get(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error)
 }
 
 type board struct {
-	boardRepo  boardRepository
-	columnRepo boardColumnRepository
-	taskRepo   boardTaskRepository
+	boardRepo    boardRepository
+	columnRepo   boardColumnRepository
+	laneRepo     boardLaneRepository
+	taskRepo     boardTaskRepository
+	templateRepo boardTemplateRepository
 }
 
-func NewBoard(boardRepo boardRepository, columnRepo boardColumnRepository, taskRepo boardTaskRepository) *board {
-	return &board{boardRepo: boardRepo, columnRepo: columnRepo, taskRepo: taskRepo}
+func NewBoard(
+	boardRepo boardRepository,
+	columnRepo boardColumnRepository,
+	laneRepo boardLaneRepository,
+	taskRepo boardTaskRepository,
+	templateRepo boardTemplateRepository,
+) *board {
+	return &board{boardRepo: boardRepo, columnRepo: columnRepo, laneRepo: laneRepo, taskRepo: taskRepo, templateRepo: templateRepo}
 }
 
 type AggregateBoard struct {
 	Board   domain.Board
 	Columns []AggregateColumn
+	// Lanes is the lane × column grid. The default lane always comes first.
+	Lanes []AggregateLane
+	// ColumnEnteredAt holds when each task entered its current column. Tasks without a
+	// recorded entry are missing.
+	ColumnEnteredAt map[domain.TaskID]time.Time
+	// AsOf is the time the aggregate was read, against which the age of tasks is measured.
+	AsOf time.Time
 }
 
+// AggregateColumn holds the tasks of the column ordered by lane and then by position.
 type AggregateColumn struct {
 	Column domain.Column
 	Tasks  []domain.Task
 }
 
+// AggregateLane holds one cell per board column, in column order.
+type AggregateLane struct {
+	Lane  domain.Lane // Zero for the default lane.
+	Cells []AggregateCell
+}
+
+type AggregateCell struct {
+	ColumnID domain.ColumnID
+	TaskIDs  []domain.TaskID
+}
+
 func (s *board) Create(ctx context.Context, callerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error) {
 	board, err := s.boardRepo.Create(ctx, callerID, name, description)
 	if err != nil {
@@ -55,6 +94,32 @@ func (s *board) Create(ctx context.Context, callerID domain.UserID, name domain.
 	return board, nil
 }
 
+func (s *board) CreateFromTemplate(
+	ctx context.Context,
+	callerID domain.UserID,
+	name domain.BoardName,
+	description domain.BoardDescription,
+	templateID domain.BoardTemplateID,
+) (domain.Board, error) {
+	template, err := s.templateRepo.Get(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Board{}, ErrBoardTemplateNotFound
+		}
+		return domain.Board{}, fmt.Errorf("board service: create from template: get template: %v: %w", err, ErrInternal)
+	}
+	if !template.IsBuiltIn() && template.OwnerID != callerID {
+		return domain.Board{}, ErrBoardTemplateNotFound
+	}
+
+	board, err := s.boardRepo.CreateWithColumns(ctx, callerID, name, description, template.Columns)
+	if err != nil {
+		return domain.Board{}, fmt.Errorf("board service: create from template: %v: %w", err, ErrInternal)
+	}
+
+	return board, nil
+}
+
 func (s *board) ListByOwnerID(ctx context.Context, callerID domain.UserID) ([]domain.Board, error) {
 	boards, err := s.boardRepo.ListByOwnerID(ctx, callerID)
 	if err != nil {
@@ -79,7 +144,14 @@ func (s *board) Get(ctx context.Context, callerID domain.UserID, boardID domain.
 	return board, nil
 }
 
-func (s *board) GetAggregate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (AggregateBoard, error) {
+// GetAggregate returns the board with its columns, lanes and tasks. Archived tasks are left out
+// unless includeArchived is set, in which case they follow the active tasks of their cell.
+func (s *board) GetAggregate(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	includeArchived bool,
+) (AggregateBoard, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
@@ -95,19 +167,41 @@ func (s *board) GetAggregate(ctx context.Context, callerID domain.UserID, boardI
 		return AggregateBoard{}, fmt.Errorf("board service: get aggregate: list columns by board id: %v: %w", err, ErrInternal)
 	}
 
-	tasks, err := s.taskRepo.ListByBoardID(ctx, boardID)
+	lanes, err := s.laneRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return AggregateBoard{}, fmt.Errorf("board service: get aggregate: list lanes by board id: %v: %w", err, ErrInternal)
+	}
+
+	tasks, err := s.taskRepo.ListByBoardID(ctx, boardID, includeArchived)
 	if err != nil {
 		return AggregateBoard{}, fmt.Errorf("board service: get aggregate: list tasks by board id: %v: %w", err, ErrInternal)
 	}
 
+	columnEnteredAt, err := s.taskRepo.ListColumnEntries(ctx, boardID)
+	if err != nil {
+		return AggregateBoard{}, fmt.Errorf("board service: get aggregate: list column entries: %v: %w", err, ErrInternal)
+	}
+
 	aggregate := AggregateBoard{
-		Board:   board,
-		Columns: make([]AggregateColumn, len(columns)),
+		Board:           board,
+		Columns:         make([]AggregateColumn, len(columns)),
+		Lanes:           make([]AggregateLane, len(lanes)+1),
+		ColumnEnteredAt: columnEnteredAt,
+		AsOf:            timeNow(),
 	}
 
 	sort.Slice(columns, func(i, j int) bool {
 		return columns[i].Position.Int64() < columns[j].Position.Int64()
 	})
+	sort.Slice(lanes, func(i, j int) bool {
+		return lanes[i].Position.Int64() < lanes[j].Position.Int64()
+	})
+
+	// The default lane ranks 0, so its tasks come first in every column.
+	laneRank := make(map[domain.LaneID]int, len(lanes)+1)
+	for i, lane := range lanes {
+		laneRank[lane.ID] = i + 1
+	}
 
 	columnIDToTaskMap := make(map[domain.ColumnID][]domain.Task, len(columns))
 	for _, t := range tasks {
@@ -115,6 +209,17 @@ func (s *board) GetAggregate(ctx context.Context, callerID domain.UserID, boardI
 	}
 	for columnID, colTasks := range columnIDToTaskMap {
 		sort.Slice(colTasks, func(i, j int) bool {
+			li, lj := laneRank[colTasks[i].LaneID], laneRank[colTasks[j].LaneID]
+			if li != lj {
+				return li < lj
+			}
+			// Archived tasks follow the active ones, in the order they were archived.
+			if ai, aj := colTasks[i].ArchivedAt, colTasks[j].ArchivedAt; !ai.Equal(aj) {
+				if ai.IsZero() || aj.IsZero() {
+					return ai.IsZero()
+				}
+				return ai.Before(aj)
+			}
 			return colTasks[i].Position.Int64() < colTasks[j].Position.Int64()
 		})
 		columnIDToTaskMap[columnID] = colTasks
@@ -131,15 +236,39 @@ func (s *board) GetAggregate(ctx context.Context, callerID domain.UserID, boardI
 		}
 	}
 
+	for i := range aggregate.Lanes {
+		if i > 0 {
+			aggregate.Lanes[i].Lane = lanes[i-1]
+		}
+		cells := make([]AggregateCell, len(columns))
+		for j, column := range columns {
+			cells[j] = AggregateCell{ColumnID: column.ID, TaskIDs: []domain.TaskID{}}
+		}
+		aggregate.Lanes[i].Cells = cells
+	}
+	for i := range aggregate.Columns {
+		for _, t := range aggregate.Columns[i].Tasks {
+			rank, ok := laneRank[t.LaneID]
+			if !ok && !t.LaneID.IsNil() {
+				continue
+			}
+			cell := &aggregate.Lanes[rank].Cells[i]
+			cell.TaskIDs = append(cell.TaskIDs, t.ID)
+		}
+	}
+
 	return aggregate, nil
 }
 
+// Update changes the board. A set ifMatch is the version the caller last saw: if the board has
+// changed since, nothing is written and ErrVersionConflict is returned.
 func (s *board) Update(
 	ctx context.Context,
 	callerID domain.UserID,
 	boardID domain.BoardID,
 	name *domain.BoardName,
 	description *domain.BoardDescription,
+	ifMatch domain.Version,
 ) (domain.Board, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
@@ -151,23 +280,30 @@ func (s *board) Update(
 	if board.OwnerID != callerID {
 		return domain.Board{}, ErrBoardNotFound
 	}
+	if ifMatch.IsSet() && ifMatch != board.Version {
+		return domain.Board{}, ErrVersionConflict
+	}
 
 	if name == nil && description == nil {
 		return board, nil
 	}
 
-	updated, err := s.boardRepo.Update(ctx, boardID, name, description)
+	updated, err := s.boardRepo.Update(ctx, boardID, name, description, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
 			return domain.Board{}, ErrBoardNotFound
 		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return domain.Board{}, ErrVersionConflict
+		}
 		return domain.Board{}, fmt.Errorf("board service: update: %v: %w", err, ErrInternal)
 	}
 
 	return updated, nil
 }
 
-func (s *board) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) error {
+// Delete deletes the board unless ifMatch is set and the board has changed since that version.
+func (s *board) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
@@ -178,14 +314,43 @@ func (s *board) Delete(ctx context.Context, callerID domain.UserID, boardID doma
 	if board.OwnerID != callerID {
 		return ErrBoardNotFound
 	}
+	if ifMatch.IsSet() && ifMatch != board.Version {
+		return ErrVersionConflict
+	}
 
-	err = s.boardRepo.Delete(ctx, boardID)
+	err = s.boardRepo.Delete(ctx, boardID, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
 			return ErrBoardNotFound
 		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return ErrVersionConflict
+		}
 		return fmt.Errorf("board service: delete: %v: %w", err, ErrInternal)
 	}
 
 	return nil
 }
+
+func (s *board) Duplicate(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.Board, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Board{}, ErrBoardNotFound
+		}
+		return domain.Board{}, fmt.Errorf("board service: duplicate: get: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Board{}, ErrBoardNotFound
+	}
+
+	duplicated, err := s.boardRepo.Duplicate(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Board{}, ErrBoardNotFound
+		}
+		return domain.Board{}, fmt.Errorf("board service: duplicate: %v: %w", err, ErrInternal)
+	}
+
+	return duplicated, nil
+}
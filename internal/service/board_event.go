@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+// boardEventsPageSize bounds the events read at once for a stream.
+const boardEventsPageSize = 100
+
+type boardEventRepository interface {
+	Head(ctx context.Context) (domain.BoardEventCursor, error)
+	ListAfter(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor, limit int) ([]domain.BoardEvent, error)
+	Listen(ctx context.Context, notify func(domain.BoardID)) error
+}
+
+type boardEventBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+// boardEvents hands board events to the streams of this replica. Events are read from the log
+// every replica writes to, and a notification from the database only wakes the streams of the
+// board up to read them.
+type boardEvents struct {
+	eventRepo boardEventRepository
+	boardRepo boardEventBoardRepository
+
+	mu       sync.Mutex
+	watchers map[domain.BoardID]map[chan struct{}]struct{}
+	closed   bool
+}
+
+func NewBoardEvents(eventRepo boardEventRepository, boardRepo boardEventBoardRepository) *boardEvents {
+	return &boardEvents{
+		eventRepo: eventRepo,
+		boardRepo: boardRepo,
+		watchers:  map[domain.BoardID]map[chan struct{}]struct{}{},
+	}
+}
+
+// BoardEventSubscription is a stream of the events of a board.
+type BoardEventSubscription struct {
+	// Cursor is where the stream starts: the event the client last got, or the latest one.
+	Cursor domain.BoardEventCursor
+	// Changes receives when the board may have new events, and is closed on shutdown.
+	Changes <-chan struct{}
+	// Stop ends the subscription. It must be called once the stream is over.
+	Stop func()
+}
+
+// Subscribe starts a stream of the events of the caller's board after lastEventID, or after the
+// latest event if it's nil.
+func (s *boardEvents) Subscribe(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	lastEventID *domain.BoardEventCursor,
+) (BoardEventSubscription, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return BoardEventSubscription{}, ErrBoardNotFound
+		}
+		return BoardEventSubscription{}, fmt.Errorf("board events service: subscribe: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return BoardEventSubscription{}, ErrBoardNotFound
+	}
+
+	// Watching starts before the head is read, so nothing written in between goes unnoticed.
+	changes, stop := s.watch(boardID)
+
+	if lastEventID != nil {
+		return BoardEventSubscription{Cursor: *lastEventID, Changes: changes, Stop: stop}, nil
+	}
+
+	head, err := s.eventRepo.Head(ctx)
+	if err != nil {
+		stop()
+		return BoardEventSubscription{}, fmt.Errorf("board events service: subscribe: head: %v: %w", err, ErrInternal)
+	}
+
+	return BoardEventSubscription{Cursor: head, Changes: changes, Stop: stop}, nil
+}
+
+// ListAfter returns the next events of a board after the cursor, oldest first, a page at a time.
+// Access is checked by Subscribe, and not again here so that a stream still gets the deletion
+// of its board.
+func (s *boardEvents) ListAfter(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor) ([]domain.BoardEvent, error) {
+	events, err := s.eventRepo.ListAfter(ctx, boardID, after, boardEventsPageSize)
+	if err != nil {
+		return nil, fmt.Errorf("board events service: list after: %v: %w", err, ErrInternal)
+	}
+
+	return events, nil
+}
+
+// Listen wakes the streams of every board an event is written for, on any replica, until ctx is
+// done. Streams are woken up once it returns as well, since events may have been missed while
+// nobody listened.
+func (s *boardEvents) Listen(ctx context.Context) error {
+	err := s.eventRepo.Listen(ctx, s.wake)
+	s.wakeAll()
+	if err != nil {
+		return fmt.Errorf("board events service: listen: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// Shutdown closes the changes of every subscription, current or future, so that streams end and
+// the server can stop.
+func (s *boardEvents) Shutdown() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.closed = true
+	for boardID, changes := range s.watchers {
+		for ch := range changes {
+			close(ch)
+		}
+		delete(s.watchers, boardID)
+	}
+}
+
+func (s *boardEvents) watch(boardID domain.BoardID) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		close(ch)
+		return ch, func() {}
+	}
+	if s.watchers[boardID] == nil {
+		s.watchers[boardID] = map[chan struct{}]struct{}{}
+	}
+	s.watchers[boardID][ch] = struct{}{}
+
+	stop := func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		// Shutdown may have closed and removed it already.
+		if _, ok := s.watchers[boardID][ch]; !ok {
+			return
+		}
+		delete(s.watchers[boardID], ch)
+		if len(s.watchers[boardID]) == 0 {
+			delete(s.watchers, boardID)
+		}
+	}
+
+	return ch, stop
+}
+
+func (s *boardEvents) wake(boardID domain.BoardID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for ch := range s.watchers[boardID] {
+		notifyWatcher(ch)
+	}
+}
+
+func (s *boardEvents) wakeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, changes := range s.watchers {
+		for ch := range changes {
+			notifyWatcher(ch)
+		}
+	}
+}
+
+// notifyWatcher leaves a wake-up in ch unless one is pending already, which covers the new
+// events too.
+func notifyWatcher(ch chan struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
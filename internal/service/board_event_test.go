@@ -0,0 +1,262 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestBoardEvents_Subscribe(t *testing.T) {
+	t.Parallel()
+
+	board := testutil.ValidBoard()
+	head := domain.NewBoardEventCursor(742, 0)
+	lastEventID := domain.NewBoardEventCursor(700, 1093)
+	getBoard := func(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+		return board, nil
+	}
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		lastEventID    *domain.BoardEventCursor
+		setupBoardRepo func(r *MockBoardRepository)
+		setupEventRepo func(r *MockBoardEventRepository)
+		wantCursor     domain.BoardEventCursor
+		wantErr        error
+	}{
+		{
+			name:           "Success starts at head",
+			callerID:       board.OwnerID,
+			setupBoardRepo: func(r *MockBoardRepository) { r.GetFunc = getBoard },
+			setupEventRepo: func(r *MockBoardEventRepository) {
+				r.HeadFunc = func(ctx context.Context) (domain.BoardEventCursor, error) {
+					return head, nil
+				}
+			},
+			wantCursor: head,
+		},
+		{
+			name:           "Success resumes after last event",
+			callerID:       board.OwnerID,
+			lastEventID:    &lastEventID,
+			setupBoardRepo: func(r *MockBoardRepository) { r.GetFunc = getBoard },
+			setupEventRepo: func(r *MockBoardEventRepository) {},
+			wantCursor:     lastEventID,
+		},
+		{
+			name:     "Board not found",
+			callerID: board.OwnerID,
+			setupBoardRepo: func(r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			setupEventRepo: func(r *MockBoardEventRepository) {},
+			wantErr:        service.ErrBoardNotFound,
+		},
+		{
+			name:           "Board of another user",
+			callerID:       domain.NewUserID(),
+			setupBoardRepo: func(r *MockBoardRepository) { r.GetFunc = getBoard },
+			setupEventRepo: func(r *MockBoardEventRepository) {},
+			wantErr:        service.ErrBoardNotFound,
+		},
+		{
+			name:     "Board repo error",
+			callerID: board.OwnerID,
+			setupBoardRepo: func(r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrInternal
+				}
+			},
+			setupEventRepo: func(r *MockBoardEventRepository) {},
+			wantErr:        service.ErrInternal,
+		},
+		{
+			name:           "Head error",
+			callerID:       board.OwnerID,
+			setupBoardRepo: func(r *MockBoardRepository) { r.GetFunc = getBoard },
+			setupEventRepo: func(r *MockBoardEventRepository) {
+				r.HeadFunc = func(ctx context.Context) (domain.BoardEventCursor, error) {
+					return domain.BoardEventCursor{}, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			tt.setupBoardRepo(boardRepo)
+			eventRepo := NewMockBoardEventRepository(t)
+			tt.setupEventRepo(eventRepo)
+			s := service.NewBoardEvents(eventRepo, boardRepo)
+
+			sub, err := s.Subscribe(context.Background(), tt.callerID, board.ID, tt.lastEventID)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			defer sub.Stop()
+
+			if sub.Cursor != tt.wantCursor {
+				t.Errorf("got cursor %v, want %v", sub.Cursor, tt.wantCursor)
+			}
+			if sub.Changes == nil {
+				t.Error("got nil changes channel")
+			}
+		})
+	}
+}
+
+func TestBoardEvents_ListAfter(t *testing.T) {
+	t.Parallel()
+
+	boardID := domain.NewBoardID()
+	after := domain.NewBoardEventCursor(742, 1093)
+	events := []domain.BoardEvent{{
+		Cursor:  domain.NewBoardEventCursor(742, 1094),
+		Entity:  domain.BoardEventBoard,
+		Action:  domain.BoardEventDeleted,
+		BoardID: boardID,
+	}}
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		eventRepo := NewMockBoardEventRepository(t)
+		eventRepo.ListAfterFunc = func(ctx context.Context, gotBoardID domain.BoardID, gotAfter domain.BoardEventCursor, limit int) ([]domain.BoardEvent, error) {
+			if gotBoardID != boardID || gotAfter != after {
+				t.Errorf("got board %v after %v, want %v after %v", gotBoardID, gotAfter, boardID, after)
+			}
+			if limit != 100 {
+				t.Errorf("got limit %d, want 100", limit)
+			}
+			return events, nil
+		}
+		s := service.NewBoardEvents(eventRepo, NewMockBoardRepository(t))
+
+		got, err := s.ListAfter(context.Background(), boardID, after)
+		if err != nil {
+			t.Fatalf("got error %v, want nil", err)
+		}
+		if diff := cmp.Diff(events, got, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("events mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Repo error", func(t *testing.T) {
+		t.Parallel()
+
+		eventRepo := NewMockBoardEventRepository(t)
+		eventRepo.ListAfterFunc = func(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor, limit int) ([]domain.BoardEvent, error) {
+			return nil, repository.ErrInternal
+		}
+		s := service.NewBoardEvents(eventRepo, NewMockBoardRepository(t))
+
+		_, err := s.ListAfter(context.Background(), boardID, after)
+		if !errors.Is(err, service.ErrInternal) {
+			t.Fatalf("got error %v, want %v", err, service.ErrInternal)
+		}
+	})
+}
+
+func TestBoardEvents_Listen(t *testing.T) {
+	t.Parallel()
+
+	board := testutil.ValidBoard()
+	otherBoard := testutil.ValidBoard()
+	boardRepo := NewMockBoardRepository(t)
+	boardRepo.GetFunc = func(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+		if boardID == otherBoard.ID {
+			return otherBoard, nil
+		}
+		return board, nil
+	}
+	eventRepo := NewMockBoardEventRepository(t)
+	eventRepo.HeadFunc = func(ctx context.Context) (domain.BoardEventCursor, error) {
+		return domain.BoardEventCursor{}, nil
+	}
+	s := service.NewBoardEvents(eventRepo, boardRepo)
+
+	subscribe := func(boardID domain.BoardID) service.BoardEventSubscription {
+		t.Helper()
+		sub, err := s.Subscribe(context.Background(), board.OwnerID, boardID, nil)
+		if err != nil {
+			t.Fatalf("Subscribe() error = %v", err)
+		}
+		return sub
+	}
+	first := subscribe(board.ID)
+	second := subscribe(board.ID)
+	other := subscribe(otherBoard.ID)
+	stopped := subscribe(board.ID)
+	stopped.Stop()
+
+	eventRepo.ListenFunc = func(ctx context.Context, notify func(domain.BoardID)) error {
+		notify(board.ID)
+		notify(board.ID)
+		assertWoken(t, "first", first.Changes, true)
+		assertWoken(t, "second", second.Changes, true)
+		assertWoken(t, "other", other.Changes, false)
+		assertWoken(t, "stopped", stopped.Changes, false)
+		return nil
+	}
+	err := s.Listen(context.Background())
+	if err != nil {
+		t.Fatalf("Listen() error = %v", err)
+	}
+	// Streams catch up once listening ends, as notifications may have been missed.
+	assertWoken(t, "other after listening", other.Changes, true)
+
+	eventRepo.ListenFunc = func(ctx context.Context, notify func(domain.BoardID)) error {
+		return repository.ErrInternal
+	}
+	err = s.Listen(context.Background())
+	if !errors.Is(err, service.ErrInternal) {
+		t.Fatalf("Listen() error = %v, want %v", err, service.ErrInternal)
+	}
+
+	s.Shutdown()
+	// Ranging ends once the channel is closed, after any pending wake-up.
+	for range first.Changes {
+	}
+	for range other.Changes {
+	}
+	first.Stop()
+	afterShutdown := subscribe(board.ID)
+	if _, open := <-afterShutdown.Changes; open {
+		t.Error("got open changes after shutdown, want closed")
+	}
+}
+
+func assertWoken(t *testing.T, name string, changes <-chan struct{}, want bool) {
+	t.Helper()
+
+	select {
+	case _, open := <-changes:
+		if !open {
+			t.Errorf("got %s changes closed", name)
+		}
+		if !want {
+			t.Errorf("got %s woken, want not", name)
+		}
+	default:
+		if want {
+			t.Errorf("got %s not woken, want woken", name)
+		}
+	}
+}
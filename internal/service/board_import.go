@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+const (
+	// boardImportBatchSize bounds how many imports one RunPending call processes. A large board
+	// can take the whole deadline of a run, so imports run one at a time.
+	boardImportBatchSize = 1
+	// boardImportStaleAfter is how long an import may run before another replica takes it over.
+	boardImportStaleAfter = 10 * time.Minute
+	// boardImportFailTimeout bounds marking an import as failed. The run may have failed because
+	// its context is done, so failing doesn't depend on it.
+	boardImportFailTimeout = 10 * time.Second
+)
+
+type boardImportRepository interface {
+	Create(ctx context.Context, boardImport domain.BoardImport) (domain.BoardImport, error)
+	Get(ctx context.Context, importID domain.BoardImportID) (domain.BoardImport, error)
+	Claim(ctx context.Context, now, staleBefore time.Time, limit int) ([]domain.BoardImport, error)
+	Complete(ctx context.Context, importID domain.BoardImportID, imported *domain.ImportedBoard, now time.Time) error
+	Fail(ctx context.Context, importID domain.BoardImportID, importErrors []domain.BoardImportError, now time.Time) error
+}
+
+type boardImport struct {
+	importRepo boardImportRepository
+}
+
+func NewBoardImport(importRepo boardImportRepository) *boardImport {
+	return &boardImport{importRepo: importRepo}
+}
+
+// Create queues an import of the caller. The file is only read by RunPending, which reports
+// what is wrong with it on the import.
+func (s *boardImport) Create(ctx context.Context, callerID domain.UserID, boardImport domain.BoardImport) (domain.BoardImport, error) {
+	boardImport.OwnerID = callerID
+
+	created, err := s.importRepo.Create(ctx, boardImport)
+	if err != nil {
+		return domain.BoardImport{}, fmt.Errorf("board import service: create: %v: %w", err, ErrInternal)
+	}
+
+	return created, nil
+}
+
+// Get returns an import of the caller. Imports of other users are reported as not found.
+func (s *boardImport) Get(ctx context.Context, callerID domain.UserID, importID domain.BoardImportID) (domain.BoardImport, error) {
+	boardImport, err := s.importRepo.Get(ctx, importID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.BoardImport{}, ErrBoardImportNotFound
+		}
+		return domain.BoardImport{}, fmt.Errorf("board import service: get: %v: %w", err, ErrInternal)
+	}
+
+	if boardImport.OwnerID != callerID {
+		return domain.BoardImport{}, ErrBoardImportNotFound
+	}
+
+	return boardImport, nil
+}
+
+// RunPending claims a batch of pending imports and runs them, returning how many finished. A file
+// with problems fails the import with a report of every problem found, up to
+// domain.MaxBoardImportErrors. Otherwise the board is written in one transaction; if that fails
+// nothing of the board is kept and the import fails asking to start it again.
+func (s *boardImport) RunPending(ctx context.Context) (int, error) {
+	now := timeNow()
+
+	imports, err := s.importRepo.Claim(ctx, now, now.Add(-boardImportStaleAfter), boardImportBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("board import service: run pending claim: %v: %w", err, ErrInternal)
+	}
+
+	var (
+		finished int
+		errs     []error
+	)
+	for i := range imports {
+		runErr := s.run(ctx, &imports[i], now)
+		switch {
+		case errors.Is(runErr, repository.ErrRowNotFound):
+			// Another replica finished the import meanwhile.
+		case runErr != nil:
+			errs = append(errs, fmt.Errorf("import %s: %v", imports[i].ID, runErr))
+		default:
+			finished++
+		}
+	}
+
+	if len(errs) > 0 {
+		return finished, fmt.Errorf("board import service: run pending: %v: %w", errors.Join(errs...), ErrInternal)
+	}
+
+	return finished, nil
+}
+
+func (s *boardImport) run(ctx context.Context, boardImport *domain.BoardImport, now time.Time) error {
+	imported, importErrors := convertBoardImport(boardImport, now)
+	if len(importErrors) > 0 {
+		return s.fail(ctx, boardImport.ID, importErrors)
+	}
+
+	err := s.importRepo.Complete(ctx, boardImport.ID, &imported, timeNow())
+	if err == nil || errors.Is(err, repository.ErrRowNotFound) {
+		return err
+	}
+
+	failErr := s.fail(ctx, boardImport.ID, []domain.BoardImportError{{
+		Item:   boardImportBoardItem,
+		Issues: []string{domain.ErrBoardImportSaveFailed},
+	}})
+	if failErr != nil && !errors.Is(failErr, repository.ErrRowNotFound) {
+		return errors.Join(err, failErr)
+	}
+	return err
+}
+
+// fail finishes the import with importErrors even when ctx is already done, so that an import
+// isn't left running when its run runs out of time.
+func (s *boardImport) fail(ctx context.Context, importID domain.BoardImportID, importErrors []domain.BoardImportError) error {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), boardImportFailTimeout)
+	defer cancel()
+
+	return s.importRepo.Fail(ctx, importID, importErrors, timeNow())
+}
+
+// convertBoardImport reads the file of the import into a board owned by the importer.
+func convertBoardImport(boardImport *domain.BoardImport, now time.Time) (domain.ImportedBoard, []domain.BoardImportError) {
+	b := newBoardImportBuilder(boardImport, now)
+	switch boardImport.Source {
+	case domain.BoardImportTrello:
+		b.convertTrello(boardImport.Content)
+	case domain.BoardImportCSV:
+		b.convertCSV(boardImport.Content, boardImport.Mapping)
+	case domain.BoardImportJSON:
+		b.convertJSON(boardImport.Content)
+	}
+	return b.result()
+}
+
+const (
+	boardImportFileItem  = "file"
+	boardImportBoardItem = "board"
+)
+
+// boardImportBuilder collects an imported board and the problems of the file found on the way.
+// Conversion goes on after a problem so that one run reports as many of them as it can.
+type boardImportBuilder struct {
+	board        domain.ImportedBoard
+	nameOverride domain.BoardName
+	errs         []domain.BoardImportError
+	now          time.Time
+}
+
+func newBoardImportBuilder(boardImport *domain.BoardImport, now time.Time) *boardImportBuilder {
+	return &boardImportBuilder{
+		board: domain.ImportedBoard{
+			Board: domain.Board{
+				ID:        domain.NewBoardID(),
+				OwnerID:   boardImport.OwnerID,
+				CreatedAt: now,
+				UpdatedAt: now,
+			},
+		},
+		nameOverride: boardImport.Name,
+		now:          now,
+	}
+}
+
+// report records a problem of item. Problems past domain.MaxBoardImportErrors are dropped.
+func (b *boardImportBuilder) report(item, field string, issues ...string) {
+	if len(b.errs) == domain.MaxBoardImportErrors {
+		return
+	}
+	b.errs = append(b.errs, domain.BoardImportError{Item: item, Field: field, Issues: issues})
+}
+
+// check reports the validation issues of err, if any, and tells whether there were none.
+func (b *boardImportBuilder) check(item, field string, err error) bool {
+	if err == nil {
+		return true
+	}
+	b.report(item, field, domain.ExtractValidationIssues(err)...)
+	return false
+}
+
+func (b *boardImportBuilder) failed() bool {
+	return len(b.errs) > 0
+}
+
+// setBoard names the board, unless the import overrides the name.
+func (b *boardImportBuilder) setBoard(item, name, description string) {
+	if b.nameOverride.String() != "" {
+		b.board.Board.Name = b.nameOverride
+	} else {
+		boardName, err := domain.NewBoardName(name)
+		if b.check(item, "name", err) {
+			b.board.Board.Name = boardName
+		}
+	}
+
+	boardDescription, err := domain.NewBoardDescription(description)
+	if b.check(item, "description", err) {
+		b.board.Board.Description = boardDescription
+	}
+}
+
+// addColumn appends the column to the board. A nil id is assigned a new one.
+func (b *boardImportBuilder) addColumn(item string, column domain.Column) domain.ColumnID {
+	if column.ID.IsNil() {
+		column.ID = domain.NewColumnID()
+	}
+	position, err := domain.NewColumnPosition(int64(len(b.board.Columns) + 1))
+	b.check(item, "position", err)
+	column.BoardID = b.board.Board.ID
+	column.Position = position
+	column.CreatedAt = b.now
+	column.UpdatedAt = b.now
+	b.board.Columns = append(b.board.Columns, column)
+	return column.ID
+}
+
+// addLane appends the lane to the board. A nil id is assigned a new one.
+func (b *boardImportBuilder) addLane(item string, lane domain.Lane) domain.LaneID {
+	if lane.ID.IsNil() {
+		lane.ID = domain.NewLaneID()
+	}
+	position, err := domain.NewLanePosition(int64(len(b.board.Lanes) + 1))
+	b.check(item, "position", err)
+	lane.BoardID = b.board.Board.ID
+	lane.Position = position
+	lane.CreatedAt = b.now
+	lane.UpdatedAt = b.now
+	b.board.Lanes = append(b.board.Lanes, lane)
+	return lane.ID
+}
+
+// addCustomField adds the field to the board. A nil id is assigned a new one.
+func (b *boardImportBuilder) addCustomField(field domain.CustomField) domain.CustomFieldID {
+	if field.ID.IsNil() {
+		field.ID = domain.NewCustomFieldID()
+	}
+	field.BoardID = b.board.Board.ID
+	field.CreatedAt = b.now
+	field.UpdatedAt = b.now
+	b.board.CustomFields = append(b.board.CustomFields, field)
+	return field.ID
+}
+
+// addSprint adds the sprint to the board. A nil id is assigned a new one.
+func (b *boardImportBuilder) addSprint(sprint domain.Sprint) domain.SprintID {
+	if sprint.ID.IsNil() {
+		sprint.ID = domain.NewSprintID()
+	}
+	sprint.BoardID = b.board.Board.ID
+	sprint.CreatedAt = b.now
+	sprint.UpdatedAt = b.now
+	b.board.Sprints = append(b.board.Sprints, sprint)
+	return sprint.ID
+}
+
+// addTask adds the task to the board. A nil id is assigned a new one. Positions are assigned by
+// result in the order tasks were added.
+func (b *boardImportBuilder) addTask(task domain.Task) domain.TaskID {
+	if task.ID.IsNil() {
+		task.ID = domain.NewTaskID()
+	}
+	task.CreatedAt = b.now
+	task.UpdatedAt = b.now
+	b.board.Tasks = append(b.board.Tasks, task)
+	return task.ID
+}
+
+// result numbers the tasks of every cell in the order they were added, archived tasks after the
+// active ones, and returns the board. If there were problems it returns them instead.
+func (b *boardImportBuilder) result() (domain.ImportedBoard, []domain.BoardImportError) {
+	type cell struct {
+		columnID domain.ColumnID
+		laneID   domain.LaneID
+	}
+
+	active := make(map[cell]int64)
+	for _, task := range b.board.Tasks {
+		if !task.IsArchived() {
+			active[cell{task.ColumnID, task.LaneID}]++
+		}
+	}
+
+	placed := make(map[cell]int64)
+	archived := make(map[cell]int64)
+	for i := range b.board.Tasks {
+		task := &b.board.Tasks[i]
+		key := cell{task.ColumnID, task.LaneID}
+		var position int64
+		if task.IsArchived() {
+			archived[key]++
+			position = active[key] + archived[key]
+		} else {
+			placed[key]++
+			position = placed[key]
+		}
+		var err error
+		task.Position, err = domain.NewTaskPosition(position)
+		b.check(boardImportBoardItem, "position", err)
+	}
+
+	if b.failed() {
+		return domain.ImportedBoard{}, b.errs
+	}
+	return b.board, nil
+}
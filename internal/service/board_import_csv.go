@@ -0,0 +1,148 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+
+	"goroutine/internal/domain"
+)
+
+// convertCSV imports a CSV file with a header row and a task per row. The mapping tells which
+// headers hold which task attributes. Columns and lanes are created in the order their names
+// first appear; a row with an empty lane goes to the default lane. Rows are numbered as in a
+// spreadsheet, the header being row 1.
+func (b *boardImportBuilder) convertCSV(content []byte, mapping domain.BoardImportMapping) {
+	b.setBoard(boardImportBoardItem, "", "")
+
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		b.report(boardImportFileItem, "content", domain.ErrBoardImportCSVContent)
+		return
+	}
+
+	columnIndexes := make(map[string]int, len(header))
+	for i, name := range header {
+		name = strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+		if _, ok := columnIndexes[name]; !ok {
+			columnIndexes[name] = i
+		}
+	}
+	index := func(field, name string) int {
+		if name == "" {
+			return -1
+		}
+		i, ok := columnIndexes[name]
+		if !ok {
+			b.report(boardImportFileItem, "mapping."+field, domain.ErrBoardImportHeaderMissing)
+			return -1
+		}
+		return i
+	}
+	var (
+		nameIndex        = index("name", mapping.Name)
+		columnIndex      = index("column", mapping.Column)
+		laneIndex        = index("lane", mapping.Lane)
+		descriptionIndex = index("description", mapping.Description)
+		checklistIndex   = index("checklist", mapping.Checklist)
+		estimateIndex    = index("estimate", mapping.Estimate)
+	)
+	if b.failed() {
+		return
+	}
+
+	columnIDs := make(map[string]domain.ColumnID)
+	laneIDs := make(map[string]domain.LaneID)
+	for row := 2; ; row++ {
+		record, readErr := reader.Read()
+		if errors.Is(readErr, io.EOF) {
+			break
+		}
+		if readErr != nil {
+			b.report(boardImportFileItem, "content", domain.ErrBoardImportCSVContent)
+			return
+		}
+		cell := func(i int) string {
+			if i < 0 || i >= len(record) {
+				return ""
+			}
+			return record[i]
+		}
+
+		item := "row " + strconv.Itoa(row)
+		task := domain.Task{}
+
+		columnName := strings.TrimSpace(cell(columnIndex))
+		columnID, ok := columnIDs[columnName]
+		if !ok {
+			name, nameErr := domain.NewColumnName(columnName)
+			if !b.check(item, "column", nameErr) {
+				continue
+			}
+			columnID = b.addColumn(item, domain.Column{Name: name})
+			columnIDs[columnName] = columnID
+		}
+		task.ColumnID = columnID
+
+		laneName := strings.TrimSpace(cell(laneIndex))
+		if laneName != "" {
+			laneID, seen := laneIDs[laneName]
+			if !seen {
+				name, nameErr := domain.NewLaneName(laneName)
+				if !b.check(item, "lane", nameErr) {
+					continue
+				}
+				laneID = b.addLane(item, domain.Lane{Name: name})
+				laneIDs[laneName] = laneID
+			}
+			task.LaneID = laneID
+		}
+
+		task.Name, err = domain.NewTaskName(cell(nameIndex))
+		b.check(item, "name", err)
+		task.Description, err = domain.NewTaskDescription(cell(descriptionIndex))
+		b.check(item, "description", err)
+		task.Checklist, err = domain.NewTaskChecklist(parseCSVChecklist(cell(checklistIndex)))
+		b.check(item, "checklist", err)
+
+		estimate := strings.TrimSpace(cell(estimateIndex))
+		if estimate != "" {
+			points, parseErr := strconv.ParseFloat(estimate, 64)
+			if parseErr != nil {
+				b.report(item, "estimate", domain.ErrTaskEstimateValue)
+			} else {
+				task.Estimate, err = domain.NewTaskEstimate(points)
+				b.check(item, "estimate", err)
+			}
+		}
+
+		b.addTask(task)
+	}
+}
+
+// parseCSVChecklist reads a checklist written one item per line, the way the CSV export writes
+// it: "[x] " marks a done item and "[ ] " an open one. Lines without a mark are open items and
+// blank lines are skipped.
+func parseCSVChecklist(text string) []domain.TaskChecklistItem {
+	var items []domain.TaskChecklistItem
+	for line := range strings.Lines(text) {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		item := domain.TaskChecklistItem{Text: line}
+		switch {
+		case strings.HasPrefix(line, "[x]"), strings.HasPrefix(line, "[X]"):
+			item = domain.TaskChecklistItem{Text: line[len("[x]"):], Done: true}
+		case strings.HasPrefix(line, "[ ]"):
+			item.Text = line[len("[ ]"):]
+		}
+		items = append(items, item)
+	}
+	return items
+}
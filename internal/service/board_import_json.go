@@ -0,0 +1,374 @@
+package service
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"goroutine/internal/domain"
+)
+
+// jsonBoardExport is the JSON export read back. Only what an import needs is decoded: ids are
+// replaced and timestamps restart at the import.
+type jsonBoardExport struct {
+	Format  string `json:"format"`
+	Version int    `json:"version"`
+	Board   *struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"board"`
+	Columns []struct {
+		ID                 string   `json:"id"`
+		Name               string   `json:"name"`
+		Description        string   `json:"description"`
+		WIPLimit           int64    `json:"wipLimit"`
+		SLAHours           int64    `json:"slaHours"`
+		ArchiveAfterDays   int64    `json:"archiveAfterDays"`
+		IsStarted          bool     `json:"isStarted"`
+		IsDone             bool     `json:"isDone"`
+		AllowedTransitions []string `json:"allowedTransitions"`
+		EntryConditions    []string `json:"entryConditions"`
+		EntryRoles         []string `json:"entryRoles"`
+	} `json:"columns"`
+	Lanes []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"lanes"`
+	CustomFields []struct {
+		ID      string   `json:"id"`
+		Name    string   `json:"name"`
+		Type    string   `json:"type"`
+		Options []string `json:"options"`
+	} `json:"customFields"`
+	Sprints []struct {
+		ID          string  `json:"id"`
+		Name        string  `json:"name"`
+		Goal        string  `json:"goal"`
+		StartDate   string  `json:"startDate"`
+		EndDate     string  `json:"endDate"`
+		CompletedAt *string `json:"completedAt"`
+	} `json:"sprints"`
+	Tasks []struct {
+		ID          string  `json:"id"`
+		ColumnID    string  `json:"columnId"`
+		LaneID      *string `json:"laneId"`
+		ParentID    *string `json:"parentId"`
+		SprintID    *string `json:"sprintId"`
+		Name        string  `json:"name"`
+		Description string  `json:"description"`
+		Checklist   []struct {
+			Text string `json:"text"`
+			Done bool   `json:"done"`
+		} `json:"checklist"`
+		Estimate     *float64                   `json:"estimate"`
+		CustomFields map[string]json.RawMessage `json:"customFields"`
+		ArchivedAt   *string                    `json:"archivedAt"`
+	} `json:"tasks"`
+	Links []struct {
+		ID       string `json:"id"`
+		SourceID string `json:"sourceId"`
+		TargetID string `json:"targetId"`
+		Type     string `json:"type"`
+	} `json:"links"`
+}
+
+// convertJSON imports a board exported as JSON. Everything of the export is recreated under new
+// ids: columns with their workflow rules, lanes, custom fields, sprints, tasks with their parents
+// and values, archived tasks and links. Completed sprints are reported from the tasks they hold,
+// as their carry-overs are not in the export.
+func (b *boardImportBuilder) convertJSON(content []byte) {
+	var export jsonBoardExport
+	err := json.Unmarshal(content, &export)
+	if err != nil || export.Format != BoardExportFormat || export.Board == nil {
+		b.report(boardImportFileItem, "content", domain.ErrBoardImportJSONContent)
+		return
+	}
+	if export.Version != BoardExportVersion {
+		b.report(boardImportFileItem, "version", domain.ErrBoardImportJSONVersion)
+		return
+	}
+
+	b.setBoard(boardImportBoardItem, export.Board.Name, export.Board.Description)
+
+	// Columns may allow transitions into columns that come later, so every column gets its id first.
+	columnIDs := make(map[string]domain.ColumnID, len(export.Columns))
+	for _, raw := range export.Columns {
+		if claimID(b, "column "+raw.ID, columnIDs, raw.ID) {
+			columnIDs[raw.ID] = domain.NewColumnID()
+		}
+	}
+	for _, raw := range export.Columns {
+		item := "column " + raw.ID
+		column := domain.Column{ID: columnIDs[raw.ID], IsStarted: raw.IsStarted, IsDone: raw.IsDone}
+		column.Name, err = domain.NewColumnName(raw.Name)
+		b.check(item, "name", err)
+		column.Description, err = domain.NewColumnDescription(raw.Description)
+		b.check(item, "description", err)
+		column.WIPLimit, err = domain.NewColumnWIPLimit(raw.WIPLimit)
+		b.check(item, "wipLimit", err)
+		column.SLA, err = domain.NewColumnSLA(raw.SLAHours)
+		b.check(item, "slaHours", err)
+		column.ArchiveAfter, err = domain.NewColumnArchiveAfter(raw.ArchiveAfterDays)
+		b.check(item, "archiveAfterDays", err)
+
+		targets := make([]domain.ColumnID, 0, len(raw.AllowedTransitions))
+		for _, rawTarget := range raw.AllowedTransitions {
+			target, ok := columnIDs[rawTarget]
+			if !ok {
+				b.report(item, "allowedTransitions", domain.ErrBoardImportReference)
+				continue
+			}
+			targets = append(targets, target)
+		}
+		column.Transitions, err = domain.NewColumnTransitions(targets)
+		b.check(item, "allowedTransitions", err)
+		column.EntryConditions, err = domain.NewColumnEntryConditions(raw.EntryConditions)
+		b.check(item, "entryConditions", err)
+		column.EntryRoles, err = domain.NewColumnEntryRoles(raw.EntryRoles)
+		b.check(item, "entryRoles", err)
+
+		b.addColumn(item, column)
+	}
+
+	laneIDs := make(map[string]domain.LaneID, len(export.Lanes))
+	for _, raw := range export.Lanes {
+		item := "lane " + raw.ID
+		if !claimID(b, item, laneIDs, raw.ID) {
+			continue
+		}
+		name, nameErr := domain.NewLaneName(raw.Name)
+		b.check(item, "name", nameErr)
+		laneIDs[raw.ID] = b.addLane(item, domain.Lane{Name: name})
+	}
+
+	fields := make(map[string]*domain.CustomField, len(export.CustomFields))
+	for _, raw := range export.CustomFields {
+		item := "custom field " + raw.ID
+		if !claimID(b, item, fields, raw.ID) {
+			continue
+		}
+		field := domain.CustomField{ID: domain.NewCustomFieldID()}
+		field.Name, err = domain.NewCustomFieldName(raw.Name)
+		b.check(item, "name", err)
+		field.Type, err = domain.NewCustomFieldType(raw.Type)
+		typeOK := b.check(item, "type", err)
+		field.Options, err = domain.NewCustomFieldOptions(raw.Options)
+		if b.check(item, "options", err) && typeOK {
+			b.check(item, "options", domain.CheckCustomFieldOptions(field.Type, field.Options))
+		}
+		b.addCustomField(field)
+		fields[raw.ID] = &field
+	}
+
+	sprintIDs := make(map[string]domain.SprintID, len(export.Sprints))
+	for _, raw := range export.Sprints {
+		item := "sprint " + raw.ID
+		if !claimID(b, item, sprintIDs, raw.ID) {
+			continue
+		}
+		sprint := domain.Sprint{}
+		sprint.Name, err = domain.NewSprintName(raw.Name)
+		b.check(item, "name", err)
+		sprint.Goal, err = domain.NewSprintGoal(raw.Goal)
+		b.check(item, "goal", err)
+		sprint.StartDate, err = domain.ParseSprintDate(raw.StartDate)
+		startOK := b.check(item, "startDate", err)
+		sprint.EndDate, err = domain.ParseSprintDate(raw.EndDate)
+		if b.check(item, "endDate", err) && startOK {
+			b.check(item, "endDate", domain.ValidateSprintDates(sprint.StartDate, sprint.EndDate))
+		}
+		if raw.CompletedAt != nil {
+			sprint.CompletedAt, err = time.Parse(time.RFC3339, *raw.CompletedAt)
+			if err != nil {
+				b.report(item, "completedAt", domain.ErrBoardImportTimestamp)
+			}
+			sprint.CompletedAt = sprint.CompletedAt.UTC()
+		}
+		sprintIDs[raw.ID] = b.addSprint(sprint)
+	}
+
+	// Parents may come after their children, so every task gets its id first.
+	taskIDs := make(map[string]domain.TaskID, len(export.Tasks))
+	for _, raw := range export.Tasks {
+		if claimID(b, "task "+raw.ID, taskIDs, raw.ID) {
+			taskIDs[raw.ID] = domain.NewTaskID()
+		}
+	}
+	var parentEdges []boardImportEdge
+	for _, raw := range export.Tasks {
+		item := "task " + raw.ID
+		task := domain.Task{ID: taskIDs[raw.ID]}
+
+		var ok bool
+		task.ColumnID, ok = columnIDs[raw.ColumnID]
+		if !ok {
+			b.report(item, "columnId", domain.ErrBoardImportReference)
+		}
+		if raw.LaneID != nil {
+			task.LaneID, ok = laneIDs[*raw.LaneID]
+			if !ok {
+				b.report(item, "laneId", domain.ErrBoardImportReference)
+			}
+		}
+		if raw.SprintID != nil {
+			task.SprintID, ok = sprintIDs[*raw.SprintID]
+			if !ok {
+				b.report(item, "sprintId", domain.ErrBoardImportReference)
+			}
+		}
+		if raw.ParentID != nil {
+			task.ParentID, ok = taskIDs[*raw.ParentID]
+			switch {
+			case !ok:
+				b.report(item, "parentId", domain.ErrBoardImportReference)
+			case task.ParentID == task.ID:
+				b.report(item, "parentId", domain.ErrTaskParentSelf)
+			default:
+				parentEdges = append(parentEdges, boardImportEdge{from: task.ID, to: task.ParentID, item: item, field: "parentId"})
+			}
+		}
+
+		task.Name, err = domain.NewTaskName(raw.Name)
+		b.check(item, "name", err)
+		task.Description, err = domain.NewTaskDescription(raw.Description)
+		b.check(item, "description", err)
+		items := make([]domain.TaskChecklistItem, len(raw.Checklist))
+		for i, rawItem := range raw.Checklist {
+			items[i] = domain.TaskChecklistItem{Text: rawItem.Text, Done: rawItem.Done}
+		}
+		task.Checklist, err = domain.NewTaskChecklist(items)
+		b.check(item, "checklist", err)
+		if raw.Estimate != nil {
+			task.Estimate, err = domain.NewTaskEstimate(*raw.Estimate)
+			b.check(item, "estimate", err)
+		}
+
+		for _, rawFieldID := range slices.Sorted(maps.Keys(raw.CustomFields)) {
+			rawValue := raw.CustomFields[rawFieldID]
+			field, found := fields[rawFieldID]
+			if !found {
+				b.report(item, "customFields."+rawFieldID, domain.ErrBoardImportReference)
+				continue
+			}
+			value, valueErr := domain.NewCustomFieldValue(field, rawValue)
+			if b.check(item, "customFields."+rawFieldID, valueErr) {
+				task.CustomFields = task.CustomFields.With(field.ID, value)
+			}
+		}
+
+		if raw.ArchivedAt != nil {
+			task.ArchivedAt, err = time.Parse(time.RFC3339, *raw.ArchivedAt)
+			if err != nil {
+				b.report(item, "archivedAt", domain.ErrBoardImportTimestamp)
+			}
+			task.ArchivedAt = task.ArchivedAt.UTC()
+		}
+
+		b.addTask(task)
+	}
+	b.checkAcyclic(parentEdges, domain.ErrBoardImportParentCycle)
+
+	type linkKey struct {
+		sourceID, targetID domain.TaskID
+		linkType           domain.TaskLinkType
+	}
+	seenLinks := make(map[linkKey]bool, len(export.Links))
+	var blockEdges []boardImportEdge
+	for _, raw := range export.Links {
+		item := "link " + raw.ID
+		sourceID, sourceOK := taskIDs[raw.SourceID]
+		if !sourceOK {
+			b.report(item, "sourceId", domain.ErrBoardImportReference)
+		}
+		targetID, targetOK := taskIDs[raw.TargetID]
+		if !targetOK {
+			b.report(item, "targetId", domain.ErrBoardImportReference)
+		}
+		linkType, typeErr := domain.NewTaskLinkType(raw.Type)
+		if !b.check(item, "type", typeErr) || !sourceOK || !targetOK {
+			continue
+		}
+
+		// The relation of the same name builds the stored form of the link.
+		link, linkErr := domain.TaskLinkRelation(linkType).Link(sourceID, targetID)
+		if !b.check(item, "targetId", linkErr) {
+			continue
+		}
+		key := linkKey{link.SourceID, link.TargetID, link.Type}
+		if seenLinks[key] {
+			b.report(item, "targetId", domain.ErrBoardImportDuplicateLink)
+			continue
+		}
+		seenLinks[key] = true
+
+		link.ID = domain.NewTaskLinkID()
+		link.CreatedAt = b.now
+		b.board.Links = append(b.board.Links, link)
+		if link.Type == domain.TaskLinkBlocks {
+			blockEdges = append(blockEdges, boardImportEdge{from: link.SourceID, to: link.TargetID, item: item, field: "targetId"})
+		}
+	}
+	b.checkAcyclic(blockEdges, domain.ErrBoardImportLinkCycle)
+}
+
+// claimID reports a repeated id of the file. It tells whether id is new to seen.
+func claimID[V any](b *boardImportBuilder, item string, seen map[string]V, id string) bool {
+	if _, ok := seen[id]; ok {
+		b.report(item, "id", domain.ErrBoardImportDuplicateID)
+		return false
+	}
+	return true
+}
+
+// boardImportEdge is a dependency between two imported tasks, reported on item if it closes
+// a cycle.
+type boardImportEdge struct {
+	from, to    domain.TaskID
+	item, field string
+}
+
+// checkAcyclic reports every edge that closes a cycle in a depth-first walk of the edges, in
+// the order they are given.
+func (b *boardImportBuilder) checkAcyclic(edges []boardImportEdge, issue string) {
+	const (
+		unvisited = iota
+		onPath
+		done
+	)
+
+	outgoing := make(map[domain.TaskID][]int, len(edges))
+	for i, edge := range edges {
+		outgoing[edge.from] = append(outgoing[edge.from], i)
+	}
+
+	type frame struct {
+		node domain.TaskID
+		next int
+	}
+	state := make(map[domain.TaskID]int, len(edges))
+	for _, start := range edges {
+		if state[start.from] != unvisited {
+			continue
+		}
+		state[start.from] = onPath
+		path := []frame{{node: start.from}}
+		for len(path) > 0 {
+			top := &path[len(path)-1]
+			if top.next == len(outgoing[top.node]) {
+				state[top.node] = done
+				path = path[:len(path)-1]
+				continue
+			}
+			edge := edges[outgoing[top.node][top.next]]
+			top.next++
+			switch state[edge.to] {
+			case onPath:
+				b.report(edge.item, edge.field, issue)
+			case unvisited:
+				state[edge.to] = onPath
+				path = append(path, frame{node: edge.to})
+			}
+		}
+	}
+}
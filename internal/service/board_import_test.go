@@ -0,0 +1,674 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestBoardImport_Create(t *testing.T) {
+	t.Parallel()
+
+	callerID := testutil.ValidUserID()
+
+	tests := []struct {
+		name      string
+		createErr error
+		wantErr   error
+	}{
+		{name: "Success"},
+		{name: "Internal error from create", createErr: repository.ErrInternal, wantErr: service.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			input := testutil.ValidBoardImport(domain.UserID{})
+			importRepo := NewMockBoardImportRepository(t)
+			importRepo.CreateFunc = func(ctx context.Context, boardImport domain.BoardImport) (domain.BoardImport, error) {
+				if boardImport.OwnerID != callerID {
+					t.Errorf("got owner id %v, want %v", boardImport.OwnerID, callerID)
+				}
+				return boardImport, tt.createErr
+			}
+
+			s := service.NewBoardImport(importRepo)
+			got, err := s.Create(context.Background(), callerID, input)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got.OwnerID != callerID {
+				t.Errorf("got owner id %v, want %v", got.OwnerID, callerID)
+			}
+		})
+	}
+}
+
+func TestBoardImport_Get(t *testing.T) {
+	t.Parallel()
+
+	ownerID := testutil.ValidUserID()
+	validImport := testutil.ValidBoardImport(ownerID)
+
+	tests := []struct {
+		name     string
+		callerID domain.UserID
+		getErr   error
+		want     domain.BoardImport
+		wantErr  error
+	}{
+		{name: "Success", callerID: ownerID, want: validImport},
+		{name: "Not owner", callerID: domain.NewUserID(), wantErr: service.ErrBoardImportNotFound},
+		{name: "Not found", callerID: ownerID, getErr: repository.ErrRowNotFound, wantErr: service.ErrBoardImportNotFound},
+		{name: "Internal error from get", callerID: ownerID, getErr: repository.ErrInternal, wantErr: service.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			importRepo := NewMockBoardImportRepository(t)
+			importRepo.GetFunc = func(ctx context.Context, importID domain.BoardImportID) (domain.BoardImport, error) {
+				if importID != validImport.ID {
+					t.Errorf("got import id %v, want %v", importID, validImport.ID)
+				}
+				if tt.getErr != nil {
+					return domain.BoardImport{}, tt.getErr
+				}
+				return validImport, nil
+			}
+
+			s := service.NewBoardImport(importRepo)
+			got, err := s.Get(context.Background(), tt.callerID, validImport.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("import mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBoardImport_RunPending(t *testing.T) {
+	t.Parallel()
+
+	ownerID := testutil.ValidUserID()
+	validImport := testutil.ValidBoardImport(ownerID)
+	brokenImport := testutil.ValidBoardImport(ownerID)
+	brokenImport.Content = []byte(`{"name":"Roadmap"}`)
+
+	saveFailed := []domain.BoardImportError{{Item: "board", Issues: []string{domain.ErrBoardImportSaveFailed}}}
+	notTrello := []domain.BoardImportError{{Item: "file", Field: "content", Issues: []string{domain.ErrBoardImportTrelloContent}}}
+
+	tests := []struct {
+		name          string
+		imports       []domain.BoardImport
+		claimErr      error
+		completeErr   error
+		cancelRun     bool
+		failErr       error
+		wantCompleted []domain.BoardImportID
+		wantFailed    map[domain.BoardImportID][]domain.BoardImportError
+		wantCount     int
+		wantErr       error
+	}{
+		{
+			name:          "Success completes valid files and fails the others",
+			imports:       []domain.BoardImport{validImport, brokenImport},
+			wantCompleted: []domain.BoardImportID{validImport.ID},
+			wantFailed:    map[domain.BoardImportID][]domain.BoardImportError{brokenImport.ID: notTrello},
+			wantCount:     2,
+		},
+		{
+			name: "Success with nothing pending",
+		},
+		{
+			name:          "Success skips imports finished meanwhile",
+			imports:       []domain.BoardImport{validImport},
+			completeErr:   repository.ErrRowNotFound,
+			wantCompleted: []domain.BoardImportID{validImport.ID},
+		},
+		{
+			name:          "Save failure fails the import",
+			imports:       []domain.BoardImport{validImport},
+			completeErr:   repository.ErrInternal,
+			wantCompleted: []domain.BoardImportID{validImport.ID},
+			wantFailed:    map[domain.BoardImportID][]domain.BoardImportError{validImport.ID: saveFailed},
+			wantErr:       service.ErrInternal,
+		},
+		{
+			name:          "Run out of time still fails the import",
+			imports:       []domain.BoardImport{validImport},
+			cancelRun:     true,
+			wantCompleted: []domain.BoardImportID{validImport.ID},
+			wantFailed:    map[domain.BoardImportID][]domain.BoardImportError{validImport.ID: saveFailed},
+			wantErr:       service.ErrInternal,
+		},
+		{
+			name:       "Internal error from fail",
+			imports:    []domain.BoardImport{brokenImport},
+			failErr:    repository.ErrInternal,
+			wantFailed: map[domain.BoardImportID][]domain.BoardImportError{brokenImport.ID: notTrello},
+			wantErr:    service.ErrInternal,
+		},
+		{
+			name:     "Internal error from claim",
+			claimErr: repository.ErrInternal,
+			wantErr:  service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			importRepo := NewMockBoardImportRepository(t)
+			importRepo.ClaimFunc = func(ctx context.Context, now, staleBefore time.Time, limit int) ([]domain.BoardImport, error) {
+				if !staleBefore.Before(now) {
+					t.Errorf("got stale before %v, want before %v", staleBefore, now)
+				}
+				if limit <= 0 {
+					t.Errorf("got limit %d, want positive", limit)
+				}
+				return tt.imports, tt.claimErr
+			}
+
+			runCtx, cancelRun := context.WithCancel(context.Background())
+			defer cancelRun()
+
+			var completed []domain.BoardImportID
+			importRepo.CompleteFunc = func(ctx context.Context, importID domain.BoardImportID, imported *domain.ImportedBoard, now time.Time) error {
+				completed = append(completed, importID)
+				if imported.Board.OwnerID != ownerID {
+					t.Errorf("got board owner id %v, want %v", imported.Board.OwnerID, ownerID)
+				}
+				if tt.cancelRun {
+					// The run runs out of time while the board is written.
+					cancelRun()
+					return fmt.Errorf("board import repo: complete: %v: %w", ctx.Err(), repository.ErrInternal)
+				}
+				return tt.completeErr
+			}
+
+			failed := map[domain.BoardImportID][]domain.BoardImportError{}
+			importRepo.FailFunc = func(ctx context.Context, importID domain.BoardImportID, importErrors []domain.BoardImportError, now time.Time) error {
+				if ctx.Err() != nil {
+					t.Errorf("got fail context error %v, want a live context", ctx.Err())
+				}
+				if _, ok := ctx.Deadline(); !ok {
+					t.Error("got fail context without a deadline, want one")
+				}
+				failed[importID] = importErrors
+				return tt.failErr
+			}
+
+			s := service.NewBoardImport(importRepo)
+			count, err := s.RunPending(runCtx)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if count != tt.wantCount {
+				t.Errorf("got finished count %d, want %d", count, tt.wantCount)
+			}
+			if diff := cmp.Diff(tt.wantCompleted, completed, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("completed imports mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantFailed == nil {
+				tt.wantFailed = map[domain.BoardImportID][]domain.BoardImportError{}
+			}
+			if diff := cmp.Diff(tt.wantFailed, failed, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("failed imports mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// runBoardImport runs a single import and returns the board it wrote or the problems it
+// reported.
+func runBoardImport(t *testing.T, boardImport domain.BoardImport) (*domain.ImportedBoard, []domain.BoardImportError) {
+	t.Helper()
+
+	var (
+		imported     *domain.ImportedBoard
+		importErrors []domain.BoardImportError
+	)
+	importRepo := NewMockBoardImportRepository(t)
+	importRepo.ClaimFunc = func(ctx context.Context, now, staleBefore time.Time, limit int) ([]domain.BoardImport, error) {
+		return []domain.BoardImport{boardImport}, nil
+	}
+	importRepo.CompleteFunc = func(ctx context.Context, importID domain.BoardImportID, board *domain.ImportedBoard, now time.Time) error {
+		imported = board
+		return nil
+	}
+	importRepo.FailFunc = func(ctx context.Context, importID domain.BoardImportID, errs []domain.BoardImportError, now time.Time) error {
+		importErrors = errs
+		return nil
+	}
+
+	_, err := service.NewBoardImport(importRepo).RunPending(context.Background())
+	if err != nil {
+		t.Fatalf("got error %v, want nil", err)
+	}
+	return imported, importErrors
+}
+
+// describeImportedBoard lists the columns, lanes, custom fields and tasks of board in a form
+// that is easy to compare, as ids are new on every run.
+func describeImportedBoard(t *testing.T, board *domain.ImportedBoard) []string {
+	t.Helper()
+
+	names := map[any]string{}
+	lines := []string{"board " + board.Board.Name.String()}
+	for _, column := range board.Columns {
+		names[column.ID] = column.Name.String()
+		if column.BoardID != board.Board.ID {
+			t.Errorf("got column board id %v, want %v", column.BoardID, board.Board.ID)
+		}
+		line := fmt.Sprintf("column %d %s", column.Position.Int64(), column.Name)
+		if column.IsDone {
+			line += " done"
+		}
+		lines = append(lines, line)
+	}
+	for _, lane := range board.Lanes {
+		names[lane.ID] = lane.Name.String()
+		lines = append(lines, fmt.Sprintf("lane %d %s", lane.Position.Int64(), lane.Name))
+	}
+	for _, field := range board.CustomFields {
+		names[field.ID] = field.Name.String()
+		lines = append(lines, fmt.Sprintf("field %s %s %v", field.Name, field.Type, field.Options.Strings()))
+	}
+	for _, sprint := range board.Sprints {
+		names[sprint.ID] = sprint.Name.String()
+	}
+	for _, task := range board.Tasks {
+		names[task.ID] = task.Name.String()
+	}
+
+	for _, task := range board.Tasks {
+		line := fmt.Sprintf("task %s", names[task.ColumnID])
+		if !task.LaneID.IsNil() {
+			line += "/" + names[task.LaneID]
+		}
+		line += fmt.Sprintf(" %d %s", task.Position.Int64(), task.Name)
+		for _, item := range task.Checklist.Items() {
+			line += fmt.Sprintf(" [%s %t]", item.Text, item.Done)
+		}
+		if task.Estimate.IsSet() {
+			line += fmt.Sprintf(" estimate=%v", task.Estimate.Float64())
+		}
+		for _, field := range board.CustomFields {
+			if value, ok := task.CustomFields.Get(field.ID); ok {
+				line += fmt.Sprintf(" %s=%s", field.Name, value.JSON())
+			}
+		}
+		if task.HasParent() {
+			line += " parent=" + names[task.ParentID]
+		}
+		if !task.SprintID.IsNil() {
+			line += " sprint=" + names[task.SprintID]
+		}
+		if task.IsArchived() {
+			line += " archived"
+		}
+		lines = append(lines, line)
+	}
+	for _, link := range board.Links {
+		lines = append(lines, fmt.Sprintf("link %s %s %s", names[link.SourceID], link.Type, names[link.TargetID]))
+	}
+	return lines
+}
+
+func TestBoardImport_RunPendingTrello(t *testing.T) {
+	t.Parallel()
+
+	const validBoard = `{
+		"name": "Roadmap",
+		"desc": "Plans",
+		"lists": [
+			{"id": "l2", "name": "Done", "pos": 2},
+			{"id": "l1", "name": "To do", "pos": 1},
+			{"id": "l3", "name": "Old", "pos": 3, "closed": true}
+		],
+		"cards": [
+			{"id": "c1", "idList": "l1", "name": "Second", "pos": 2},
+			{"id": "c2", "idList": "l1", "name": "First", "pos": 1, "idLabels": ["b1", "b2"]},
+			{"id": "c3", "idList": "l1", "name": "Stale", "pos": 0, "closed": true},
+			{"id": "c4", "idList": "l3", "name": "Skipped", "pos": 1},
+			{"id": "c5", "idList": "l2", "name": "Shipped", "pos": 1, "due": "2026-03-01T12:00:00.000Z"}
+		],
+		"labels": [
+			{"id": "b1", "name": "Bug", "color": "red"},
+			{"id": "b2", "name": "", "color": "green"},
+			{"id": "b3", "name": "", "color": ""}
+		],
+		"checklists": [
+			{"id": "k2", "idCard": "c2", "pos": 2, "checkItems": [{"name": "Review", "state": "incomplete", "pos": 1}]},
+			{"id": "k1", "idCard": "c2", "pos": 1, "checkItems": [
+				{"name": "Test", "state": "incomplete", "pos": 2},
+				{"name": "Write", "state": "complete", "pos": 1}
+			]}
+		]
+	}`
+
+	tests := []struct {
+		name       string
+		content    string
+		importName bool
+		want       []string
+		wantErrors []domain.BoardImportError
+	}{
+		{
+			name:    "Success",
+			content: validBoard,
+			want: []string{
+				"board Roadmap",
+				"column 1 To do",
+				"column 2 Done",
+				`field Labels multi_select [Bug green]`,
+				"field Due date []",
+				"task To do 3 Stale archived",
+				`task To do 1 First [Write true] [Test false] [Review false] Labels=["Bug","green"]`,
+				"task To do 2 Second",
+				`task Done 1 Shipped Due="2026-03-01"`,
+			},
+		},
+		{
+			name:       "Success with name override",
+			content:    `{"name": "", "lists": []}`,
+			importName: true,
+			want:       []string{"board Test Board"},
+		},
+		{
+			name:    "Not a Trello export",
+			content: `{"name": "Roadmap"}`,
+			wantErrors: []domain.BoardImportError{
+				{Item: "file", Field: "content", Issues: []string{domain.ErrBoardImportTrelloContent}},
+			},
+		},
+		{
+			name: "Invalid items are all reported",
+			content: `{
+				"name": "",
+				"lists": [{"id": "l1", "name": " ", "pos": 1}],
+				"cards": [
+					{"id": "c1", "idList": "l1", "name": "", "pos": 1},
+					{"id": "c2", "idList": "l1", "name": "Due", "pos": 2, "due": "tomorrow"}
+				]
+			}`,
+			wantErrors: []domain.BoardImportError{
+				{Item: "board", Field: "name", Issues: []string{"Name is too short"}},
+				{Item: "list l1", Field: "name", Issues: []string{domain.ErrColumnNameTooShort}},
+				{Item: "card c1", Field: "name", Issues: []string{domain.ErrTaskNameTooShort}},
+				{Item: "card c2", Field: "due", Issues: []string{domain.ErrCustomFieldDateValue}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardImport := testutil.ValidBoardImport(testutil.ValidUserID())
+			boardImport.Content = []byte(tt.content)
+			if tt.importName {
+				boardImport.Name = testutil.ValidBoardName()
+			}
+
+			imported, importErrors := runBoardImport(t, boardImport)
+
+			if diff := cmp.Diff(tt.wantErrors, importErrors); diff != "" {
+				t.Errorf("import errors mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantErrors != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, describeImportedBoard(t, imported)); diff != "" {
+				t.Errorf("imported board mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBoardImport_RunPendingCSV(t *testing.T) {
+	t.Parallel()
+
+	mapping := domain.BoardImportMapping{
+		Name:        "Title",
+		Column:      "Status",
+		Lane:        "Team",
+		Description: "Details",
+		Checklist:   "Checklist",
+		Estimate:    "Points",
+	}
+
+	tests := []struct {
+		name       string
+		content    string
+		mapping    domain.BoardImportMapping
+		want       []string
+		wantErrors []domain.BoardImportError
+	}{
+		{
+			name: "Success",
+			content: "\ufeffTitle,Status,Team,Details,Checklist,Points\n" +
+				"Write docs,Doing,,Draft,\"[x] Outline\n[ ] Examples\",3\n" +
+				"Fix login,To do,Web,,,\n" +
+				"Ship,Doing,Web,,,0.5\n",
+			mapping: mapping,
+			want: []string{
+				"board Test Board",
+				"column 1 Doing",
+				"column 2 To do",
+				"lane 1 Web",
+				"task Doing 1 Write docs [Outline true] [Examples false] estimate=3",
+				"task To do/Web 1 Fix login",
+				"task Doing/Web 1 Ship estimate=0.5",
+			},
+		},
+		{
+			name:    "Success with optional headers unmapped",
+			content: "Title,Status\nWrite docs,To do\n",
+			mapping: domain.BoardImportMapping{Name: "Title", Column: "Status"},
+			want:    []string{"board Test Board", "column 1 To do", "task To do 1 Write docs"},
+		},
+		{
+			name:    "Mapped header is not in the file",
+			content: "Title,State\nWrite docs,To do\n",
+			mapping: mapping,
+			wantErrors: []domain.BoardImportError{
+				{Item: "file", Field: "mapping.column", Issues: []string{domain.ErrBoardImportHeaderMissing}},
+				{Item: "file", Field: "mapping.lane", Issues: []string{domain.ErrBoardImportHeaderMissing}},
+				{Item: "file", Field: "mapping.description", Issues: []string{domain.ErrBoardImportHeaderMissing}},
+				{Item: "file", Field: "mapping.checklist", Issues: []string{domain.ErrBoardImportHeaderMissing}},
+				{Item: "file", Field: "mapping.estimate", Issues: []string{domain.ErrBoardImportHeaderMissing}},
+			},
+		},
+		{
+			name:    "Empty file",
+			content: "",
+			mapping: mapping,
+			wantErrors: []domain.BoardImportError{
+				{Item: "file", Field: "content", Issues: []string{domain.ErrBoardImportCSVContent}},
+			},
+		},
+		{
+			name:    "Invalid rows are all reported",
+			content: "Title,Status,Points\n,To do,1\nWrite docs,,\nShip,Done,lots\n",
+			mapping: domain.BoardImportMapping{Name: "Title", Column: "Status", Estimate: "Points"},
+			wantErrors: []domain.BoardImportError{
+				{Item: "row 2", Field: "name", Issues: []string{domain.ErrTaskNameTooShort}},
+				{Item: "row 3", Field: "column", Issues: []string{domain.ErrColumnNameTooShort}},
+				{Item: "row 4", Field: "estimate", Issues: []string{domain.ErrTaskEstimateValue}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardImport := testutil.ValidBoardImport(testutil.ValidUserID())
+			boardImport.Source = domain.BoardImportCSV
+			boardImport.Name = testutil.ValidBoardName()
+			boardImport.Content = []byte(tt.content)
+			boardImport.Mapping = tt.mapping
+
+			imported, importErrors := runBoardImport(t, boardImport)
+
+			if diff := cmp.Diff(tt.wantErrors, importErrors); diff != "" {
+				t.Errorf("import errors mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantErrors != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, describeImportedBoard(t, imported)); diff != "" {
+				t.Errorf("imported board mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBoardImport_RunPendingJSON(t *testing.T) {
+	t.Parallel()
+
+	// boardExport builds an export around the given tasks and links.
+	boardExport := func(tasks, links string) string {
+		return `{
+			"format": "goroutine.board",
+			"version": 1,
+			"board": {"name": "Roadmap", "description": "Plans"},
+			"columns": [
+				{"id": "c1", "name": "To do", "allowedTransitions": ["c2"]},
+				{"id": "c2", "name": "Done", "isDone": true}
+			],
+			"lanes": [{"id": "l1", "name": "Urgent"}],
+			"customFields": [{"id": "f1", "name": "Priority", "type": "single_select", "options": ["High", "Low"]}],
+			"sprints": [{"id": "s1", "name": "Sprint 1", "startDate": "2026-01-05", "endDate": "2026-01-18", "completedAt": "2026-01-18T17:00:00Z"}],
+			"tasks": [` + tasks + `],
+			"links": [` + links + `]
+		}`
+	}
+
+	tests := []struct {
+		name       string
+		content    string
+		want       []string
+		wantErrors []domain.BoardImportError
+	}{
+		{
+			name: "Success",
+			content: boardExport(`
+				{"id": "t2", "columnId": "c1", "parentId": "t1", "name": "Child"},
+				{"id": "t1", "columnId": "c1", "laneId": "l1", "sprintId": "s1", "name": "Parent",
+					"checklist": [{"text": "Plan", "done": true}], "estimate": 2, "customFields": {"f1": "High"}},
+				{"id": "t3", "columnId": "c2", "name": "Old", "archivedAt": "2026-01-10T09:00:00Z"},
+				{"id": "t4", "columnId": "c2", "name": "Shipped"}
+			`, `{"id": "k1", "sourceId": "t1", "targetId": "t4", "type": "blocks"}`),
+			want: []string{
+				"board Roadmap",
+				"column 1 To do",
+				"column 2 Done done",
+				"lane 1 Urgent",
+				"field Priority single_select [High Low]",
+				"task To do 1 Child parent=Parent",
+				`task To do/Urgent 1 Parent [Plan true] estimate=2 Priority="High" sprint=Sprint 1`,
+				"task Done 2 Old archived",
+				"task Done 1 Shipped",
+				"link Parent blocks Shipped",
+			},
+		},
+		{
+			name:    "Not a board export",
+			content: `{"format": "other", "board": {"name": "Roadmap"}}`,
+			wantErrors: []domain.BoardImportError{
+				{Item: "file", Field: "content", Issues: []string{domain.ErrBoardImportJSONContent}},
+			},
+		},
+		{
+			name:    "Unsupported version",
+			content: `{"format": "goroutine.board", "version": 2, "board": {"name": "Roadmap"}}`,
+			wantErrors: []domain.BoardImportError{
+				{Item: "file", Field: "version", Issues: []string{domain.ErrBoardImportJSONVersion}},
+			},
+		},
+		{
+			name: "Broken references are all reported",
+			content: boardExport(`
+				{"id": "t1", "columnId": "c9", "laneId": "l9", "sprintId": "s9", "parentId": "t9", "name": "Lost"},
+				{"id": "t1", "columnId": "c1", "name": "Twin", "customFields": {"f9": 1}, "archivedAt": "yesterday"}
+			`, `{"id": "k1", "sourceId": "t1", "targetId": "t9", "type": "blocks"}`),
+			wantErrors: []domain.BoardImportError{
+				{Item: "task t1", Field: "id", Issues: []string{domain.ErrBoardImportDuplicateID}},
+				{Item: "task t1", Field: "columnId", Issues: []string{domain.ErrBoardImportReference}},
+				{Item: "task t1", Field: "laneId", Issues: []string{domain.ErrBoardImportReference}},
+				{Item: "task t1", Field: "sprintId", Issues: []string{domain.ErrBoardImportReference}},
+				{Item: "task t1", Field: "parentId", Issues: []string{domain.ErrBoardImportReference}},
+				{Item: "task t1", Field: "customFields.f9", Issues: []string{domain.ErrBoardImportReference}},
+				{Item: "task t1", Field: "archivedAt", Issues: []string{domain.ErrBoardImportTimestamp}},
+				{Item: "link k1", Field: "targetId", Issues: []string{domain.ErrBoardImportReference}},
+			},
+		},
+		{
+			name: "Cycles are reported",
+			content: boardExport(`
+				{"id": "t1", "columnId": "c1", "parentId": "t2", "name": "First"},
+				{"id": "t2", "columnId": "c1", "parentId": "t1", "name": "Second"},
+				{"id": "t3", "columnId": "c1", "name": "Third"}
+			`, `
+				{"id": "k1", "sourceId": "t1", "targetId": "t3", "type": "blocks"},
+				{"id": "k2", "sourceId": "t3", "targetId": "t1", "type": "blocks"},
+				{"id": "k3", "sourceId": "t1", "targetId": "t3", "type": "blocks"},
+				{"id": "k4", "sourceId": "t2", "targetId": "t2", "type": "relates_to"}
+			`),
+			wantErrors: []domain.BoardImportError{
+				{Item: "task t2", Field: "parentId", Issues: []string{domain.ErrBoardImportParentCycle}},
+				{Item: "link k3", Field: "targetId", Issues: []string{domain.ErrBoardImportDuplicateLink}},
+				{Item: "link k4", Field: "targetId", Issues: []string{domain.ErrTaskLinkSelf}},
+				{Item: "link k2", Field: "targetId", Issues: []string{domain.ErrBoardImportLinkCycle}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardImport := testutil.ValidBoardImport(testutil.ValidUserID())
+			boardImport.Source = domain.BoardImportJSON
+			boardImport.Content = []byte(tt.content)
+
+			imported, importErrors := runBoardImport(t, boardImport)
+
+			if diff := cmp.Diff(tt.wantErrors, importErrors); diff != "" {
+				t.Errorf("import errors mismatch (-want +got):\n%s", diff)
+			}
+			if tt.wantErrors != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, describeImportedBoard(t, imported)); diff != "" {
+				t.Errorf("imported board mismatch (-want +got):\n%s", diff)
+			}
+			wantCompletedAt := time.Date(2026, 1, 18, 17, 0, 0, 0, time.UTC)
+			for _, sprint := range imported.Sprints {
+				if !sprint.CompletedAt.Equal(wantCompletedAt) {
+					t.Errorf("got sprint completed at %v, want %v", sprint.CompletedAt, wantCompletedAt)
+				}
+			}
+		})
+	}
+}
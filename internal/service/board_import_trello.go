@@ -0,0 +1,246 @@
+package service
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+
+	"goroutine/internal/domain"
+)
+
+const (
+	trelloLabelsFieldName = "Labels"
+	trelloDueFieldName    = "Due"
+)
+
+// trelloBoard is the part of a Trello board export, as downloaded from the board menu, that is
+// imported.
+type trelloBoard struct {
+	Name       string            `json:"name"`
+	Desc       string            `json:"desc"`
+	Lists      []trelloList      `json:"lists"`
+	Cards      []trelloCard      `json:"cards"`
+	Labels     []trelloLabel     `json:"labels"`
+	Checklists []trelloChecklist `json:"checklists"`
+}
+
+type trelloList struct {
+	ID     string  `json:"id"`
+	Name   string  `json:"name"`
+	Closed bool    `json:"closed"`
+	Pos    float64 `json:"pos"`
+}
+
+type trelloCard struct {
+	ID       string   `json:"id"`
+	IDList   string   `json:"idList"`
+	Name     string   `json:"name"`
+	Desc     string   `json:"desc"`
+	Closed   bool     `json:"closed"`
+	Pos      float64  `json:"pos"`
+	Due      *string  `json:"due"`
+	IDLabels []string `json:"idLabels"`
+}
+
+type trelloLabel struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type trelloChecklist struct {
+	ID         string            `json:"id"`
+	IDCard     string            `json:"idCard"`
+	Pos        float64           `json:"pos"`
+	CheckItems []trelloCheckItem `json:"checkItems"`
+}
+
+type trelloCheckItem struct {
+	Name  string  `json:"name"`
+	State string  `json:"state"`
+	Pos   float64 `json:"pos"`
+}
+
+// convertTrello imports a Trello board. Open lists become columns and their cards tasks, in
+// Trello order. Archived cards become archived tasks; archived lists are left out with their
+// cards. Labels become the options of a multi select "Labels" field and due dates the values of
+// a date "Due" field; either field is only created when the board uses it. The checklists of a
+// card are joined into the checklist of its task.
+func (b *boardImportBuilder) convertTrello(content []byte) {
+	var board trelloBoard
+	err := json.Unmarshal(content, &board)
+	if err != nil || board.Lists == nil {
+		b.report(boardImportFileItem, "content", domain.ErrBoardImportTrelloContent)
+		return
+	}
+
+	b.setBoard(boardImportBoardItem, board.Name, board.Desc)
+
+	lists := slices.DeleteFunc(slices.Clone(board.Lists), func(list trelloList) bool {
+		return list.Closed
+	})
+	slices.SortStableFunc(lists, func(a, b trelloList) int {
+		return cmp.Compare(a.Pos, b.Pos)
+	})
+
+	cards := slices.Clone(board.Cards)
+	slices.SortStableFunc(cards, func(a, b trelloCard) int {
+		return cmp.Compare(a.Pos, b.Pos)
+	})
+	cardsByList := make(map[string][]trelloCard)
+	for _, card := range cards {
+		cardsByList[card.IDList] = append(cardsByList[card.IDList], card)
+	}
+
+	checklists := slices.Clone(board.Checklists)
+	slices.SortStableFunc(checklists, func(a, b trelloChecklist) int {
+		return cmp.Compare(a.Pos, b.Pos)
+	})
+	checklistsByCard := make(map[string][]trelloChecklist)
+	for _, checklist := range checklists {
+		checklistsByCard[checklist.IDCard] = append(checklistsByCard[checklist.IDCard], checklist)
+	}
+
+	fields := newTrelloFields(b, board.Labels)
+
+	for _, list := range lists {
+		item := "list " + list.ID
+		name, nameErr := domain.NewColumnName(list.Name)
+		b.check(item, "name", nameErr)
+		columnID := b.addColumn(item, domain.Column{Name: name})
+
+		for _, card := range cardsByList[list.ID] {
+			b.addTrelloCard(fields, columnID, &card, checklistsByCard[card.ID])
+		}
+	}
+}
+
+func (b *boardImportBuilder) addTrelloCard(fields *trelloFields, columnID domain.ColumnID, card *trelloCard, checklists []trelloChecklist) {
+	item := "card " + card.ID
+	task := domain.Task{ColumnID: columnID}
+
+	var err error
+	task.Name, err = domain.NewTaskName(card.Name)
+	b.check(item, "name", err)
+	task.Description, err = domain.NewTaskDescription(card.Desc)
+	b.check(item, "description", err)
+
+	var items []domain.TaskChecklistItem
+	for _, checklist := range checklists {
+		checkItems := slices.Clone(checklist.CheckItems)
+		slices.SortStableFunc(checkItems, func(a, b trelloCheckItem) int {
+			return cmp.Compare(a.Pos, b.Pos)
+		})
+		for _, checkItem := range checkItems {
+			items = append(items, domain.TaskChecklistItem{Text: checkItem.Name, Done: checkItem.State == "complete"})
+		}
+	}
+	task.Checklist, err = domain.NewTaskChecklist(items)
+	b.check(item, "checklist", err)
+
+	task.CustomFields = fields.values(b, item, card)
+
+	if card.Closed {
+		task.ArchivedAt = b.now
+	}
+
+	b.addTask(task)
+}
+
+// trelloFields maps Trello labels and due dates to custom fields of the board.
+type trelloFields struct {
+	labels       *domain.CustomField
+	labelOptions map[string]string // Option by label id.
+	due          *domain.CustomField
+}
+
+func newTrelloFields(b *boardImportBuilder, labels []trelloLabel) *trelloFields {
+	fields := &trelloFields{labelOptions: make(map[string]string)}
+
+	// Unnamed labels are told apart by color only.
+	var options []string
+	for _, label := range labels {
+		option := strings.TrimSpace(label.Name)
+		if option == "" {
+			option = label.Color
+		}
+		if option == "" {
+			continue
+		}
+		fields.labelOptions[label.ID] = option
+		if !slices.Contains(options, option) {
+			options = append(options, option)
+		}
+	}
+	if len(options) == 0 {
+		return fields
+	}
+
+	fieldOptions, err := domain.NewCustomFieldOptions(options)
+	if !b.check(boardImportBoardItem, "labels", err) {
+		return fields
+	}
+	name, err := domain.NewCustomFieldName(trelloLabelsFieldName)
+	b.check(boardImportBoardItem, "labels", err)
+	field := domain.CustomField{Name: name, Type: domain.CustomFieldMultiSelect, Options: fieldOptions}
+	field.ID = b.addCustomField(field)
+	fields.labels = &field
+
+	return fields
+}
+
+func (f *trelloFields) values(b *boardImportBuilder, item string, card *trelloCard) domain.TaskCustomFields {
+	var values domain.TaskCustomFields
+
+	var options []string
+	for _, labelID := range card.IDLabels {
+		option, ok := f.labelOptions[labelID]
+		if ok && !slices.Contains(options, option) {
+			options = append(options, option)
+		}
+	}
+	if len(options) > 0 && f.labels != nil {
+		value, err := trelloFieldValue(f.labels, options)
+		if b.check(item, "labels", err) {
+			values = values.With(f.labels.ID, value)
+		}
+	}
+
+	if card.Due != nil {
+		due, err := time.Parse(time.RFC3339, *card.Due)
+		if err != nil {
+			b.report(item, "due", domain.ErrCustomFieldDateValue)
+			return values
+		}
+		field := f.dueField(b)
+		value, err := trelloFieldValue(field, due.UTC().Format(time.DateOnly))
+		if b.check(item, "due", err) {
+			values = values.With(field.ID, value)
+		}
+	}
+
+	return values
+}
+
+// trelloFieldValue validates v, encoded as JSON, as a value of field.
+func trelloFieldValue(field *domain.CustomField, v any) (domain.CustomFieldValue, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return domain.CustomFieldValue{}, err
+	}
+	return domain.NewCustomFieldValue(field, raw)
+}
+
+// dueField returns the "Due" field, adding it to the board on first use.
+func (f *trelloFields) dueField(b *boardImportBuilder) *domain.CustomField {
+	if f.due == nil {
+		name, err := domain.NewCustomFieldName(trelloDueFieldName)
+		b.check(boardImportBoardItem, "due", err)
+		field := domain.CustomField{Name: name, Type: domain.CustomFieldDate}
+		field.ID = b.addCustomField(field)
+		f.due = &field
+	}
+	return f.due
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type templateRepository interface {
+	CreateFromBoard(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error)
+	Get(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error)
+	ListAvailable(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error)
+	Delete(ctx context.Context, templateID domain.BoardTemplateID) error
+}
+
+type templateBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type boardTemplate struct {
+	templateRepo templateRepository
+	boardRepo    templateBoardRepository
+}
+
+func NewBoardTemplate(templateRepo templateRepository, boardRepo templateBoardRepository) *boardTemplate {
+	return &boardTemplate{templateRepo: templateRepo, boardRepo: boardRepo}
+}
+
+func (s *boardTemplate) SaveFromBoard(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	name domain.BoardName,
+	description domain.BoardDescription,
+	includeTasks bool,
+) (domain.BoardTemplate, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.BoardTemplate{}, ErrBoardNotFound
+		}
+		return domain.BoardTemplate{}, fmt.Errorf("board template service: save from board: get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.BoardTemplate{}, ErrBoardNotFound
+	}
+
+	template, err := s.templateRepo.CreateFromBoard(ctx, callerID, boardID, name, description, includeTasks)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.BoardTemplate{}, ErrBoardNotFound
+		}
+		return domain.BoardTemplate{}, fmt.Errorf("board template service: save from board: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (s *boardTemplate) ListAvailable(ctx context.Context, callerID domain.UserID) ([]domain.BoardTemplate, error) {
+	templates, err := s.templateRepo.ListAvailable(ctx, callerID)
+	if err != nil {
+		return nil, fmt.Errorf("board template service: list available: %v: %w", err, ErrInternal)
+	}
+
+	return templates, nil
+}
+
+// Delete removes a template owned by the caller. Built-in templates are reported as not found.
+func (s *boardTemplate) Delete(ctx context.Context, callerID domain.UserID, templateID domain.BoardTemplateID) error {
+	template, err := s.templateRepo.Get(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrBoardTemplateNotFound
+		}
+		return fmt.Errorf("board template service: delete: get: %v: %w", err, ErrInternal)
+	}
+	if template.IsBuiltIn() || template.OwnerID != callerID {
+		return ErrBoardTemplateNotFound
+	}
+
+	err = s.templateRepo.Delete(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrBoardTemplateNotFound
+		}
+		return fmt.Errorf("board template service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
@@ -0,0 +1,293 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestBoardTemplate_SaveFromBoard(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidBoardTemplate(validBoard.OwnerID)
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		setupTemplateRepo func(t *testing.T, r *MockBoardTemplateRepository)
+		wantTemplate      domain.BoardTemplate
+		wantErr           error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.CreateFromBoardFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+					if ownerID != validBoard.OwnerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
+					}
+					if boardID != validBoard.ID {
+						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
+					}
+					if name != validTemplate.Name {
+						t.Errorf("got name %v, want %v", name, validTemplate.Name)
+					}
+					if !includeTasks {
+						t.Error("got includeTasks false, want true")
+					}
+					return validTemplate, nil
+				}
+			},
+			wantTemplate: validTemplate,
+		},
+		{
+			name:     "Not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Not found when row missing",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Get internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, errors.New("db exploded")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name:     "Board deleted before snapshot",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.CreateFromBoardFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+					return domain.BoardTemplate{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Create internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.CreateFromBoardFunc = func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+					return domain.BoardTemplate{}, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			templateRepo := NewMockBoardTemplateRepository(t)
+			if tt.setupTemplateRepo != nil {
+				tt.setupTemplateRepo(t, templateRepo)
+			}
+			s := service.NewBoardTemplate(templateRepo, boardRepo)
+
+			got, err := s.SaveFromBoard(context.Background(), tt.callerID, validBoard.ID, validTemplate.Name, validTemplate.Description, true)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantTemplate, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("SaveFromBoard() template mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBoardTemplate_ListAvailable(t *testing.T) {
+	t.Parallel()
+
+	callerID := testutil.ValidUserID()
+	templates := []domain.BoardTemplate{
+		testutil.ValidBoardTemplate(domain.UserID{}),
+		testutil.ValidBoardTemplate(callerID),
+	}
+
+	tests := []struct {
+		name              string
+		setupTemplateRepo func(t *testing.T, r *MockBoardTemplateRepository)
+		wantTemplates     []domain.BoardTemplate
+		wantErr           error
+	}{
+		{
+			name: "Success",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.ListAvailableFunc = func(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+					if ownerID != callerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, callerID)
+					}
+					return templates, nil
+				}
+			},
+			wantTemplates: templates,
+		},
+		{
+			name: "Internal error",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.ListAvailableFunc = func(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+					return nil, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			templateRepo := NewMockBoardTemplateRepository(t)
+			tt.setupTemplateRepo(t, templateRepo)
+			s := service.NewBoardTemplate(templateRepo, nil)
+
+			got, err := s.ListAvailable(context.Background(), callerID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantTemplates, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("ListAvailable() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestBoardTemplate_Delete(t *testing.T) {
+	t.Parallel()
+
+	callerID := testutil.ValidUserID()
+	ownTemplate := testutil.ValidBoardTemplate(callerID)
+	builtInTemplate := testutil.ValidBoardTemplate(domain.UserID{})
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		setupTemplateRepo func(t *testing.T, r *MockBoardTemplateRepository)
+		wantErr           error
+	}{
+		{
+			name:     "Success",
+			callerID: callerID,
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return ownTemplate, nil
+				}
+				r.DeleteFunc = func(ctx context.Context, templateID domain.BoardTemplateID) error {
+					if templateID != ownTemplate.ID {
+						t.Errorf("got templateID %v, want %v", templateID, ownTemplate.ID)
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:     "Not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return ownTemplate, nil
+				}
+			},
+			wantErr: service.ErrBoardTemplateNotFound,
+		},
+		{
+			name:     "Not found when built-in",
+			callerID: callerID,
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return builtInTemplate, nil
+				}
+			},
+			wantErr: service.ErrBoardTemplateNotFound,
+		},
+		{
+			name:     "Not found when row missing",
+			callerID: callerID,
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return domain.BoardTemplate{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardTemplateNotFound,
+		},
+		{
+			name:     "Delete internal error",
+			callerID: callerID,
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return ownTemplate, nil
+				}
+				r.DeleteFunc = func(ctx context.Context, templateID domain.BoardTemplateID) error {
+					return repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			templateRepo := NewMockBoardTemplateRepository(t)
+			tt.setupTemplateRepo(t, templateRepo)
+			s := service.NewBoardTemplate(templateRepo, nil)
+
+			err := s.Delete(context.Background(), tt.callerID, ownTemplate.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
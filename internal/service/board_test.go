@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -69,7 +70,7 @@ func TestBoard_Create(t *testing.T) {
 
 			r := NewMockBoardRepository(t)
 			tt.setupBoardRepo(t, r)
-			s := service.NewBoard(r, nil, nil)
+			s := service.NewBoard(r, nil, nil, nil, nil)
 
 			got, err := s.Create(context.Background(), validBoard.OwnerID, validBoard.Name, validBoard.Description)
 
@@ -85,6 +86,127 @@ func TestBoard_Create(t *testing.T) {
 	}
 }
 
+func TestBoard_CreateFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	ownTemplate := testutil.ValidBoardTemplate(validBoard.OwnerID)
+	builtInTemplate := testutil.ValidBoardTemplate(domain.UserID{})
+	foreignTemplate := testutil.ValidBoardTemplate(domain.NewUserID())
+
+	tests := []struct {
+		name              string
+		setupTemplateRepo func(t *testing.T, r *MockBoardTemplateRepository)
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		wantErr           error
+		wantBoard         domain.Board
+	}{
+		{
+			name: "Success own template",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return ownTemplate, nil
+				}
+			},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.CreateWithColumnsFunc = func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, columns []domain.BoardTemplateColumn) (domain.Board, error) {
+					if ownerID != validBoard.OwnerID {
+						t.Errorf("got ownerID %v, want %v", ownerID, validBoard.OwnerID)
+					}
+					if name != validBoard.Name {
+						t.Errorf("got name %v, want %v", name, validBoard.Name)
+					}
+					if diff := cmp.Diff(ownTemplate.Columns, columns, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("columns mismatch (-want +got):\n%s", diff)
+					}
+					return validBoard, nil
+				}
+			},
+			wantBoard: validBoard,
+		},
+		{
+			name: "Success built-in template",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return builtInTemplate, nil
+				}
+			},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.CreateWithColumnsFunc = func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, columns []domain.BoardTemplateColumn) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantBoard: validBoard,
+		},
+		{
+			name: "Not found when template belongs to another user",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return foreignTemplate, nil
+				}
+			},
+			wantErr: service.ErrBoardTemplateNotFound,
+		},
+		{
+			name: "Not found when template row missing",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return domain.BoardTemplate{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardTemplateNotFound,
+		},
+		{
+			name: "Get template internal error",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return domain.BoardTemplate{}, errors.New("db exploded")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name: "Create internal error",
+			setupTemplateRepo: func(t *testing.T, r *MockBoardTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+					return ownTemplate, nil
+				}
+			},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.CreateWithColumnsFunc = func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, columns []domain.BoardTemplateColumn) (domain.Board, error) {
+					return domain.Board{}, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			if tt.setupBoardRepo != nil {
+				tt.setupBoardRepo(t, boardRepo)
+			}
+			templateRepo := NewMockBoardTemplateRepository(t)
+			tt.setupTemplateRepo(t, templateRepo)
+			s := service.NewBoard(boardRepo, nil, nil, nil, templateRepo)
+
+			got, err := s.CreateFromTemplate(context.Background(), validBoard.OwnerID, validBoard.Name, validBoard.Description, ownTemplate.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantBoard, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("CreateFromTemplate() board mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestBoard_ListByOwnerID(t *testing.T) {
 	t.Parallel()
 
@@ -135,7 +257,7 @@ func TestBoard_ListByOwnerID(t *testing.T) {
 
 			r := NewMockBoardRepository(t)
 			tt.setupBoardRepo(t, r)
-			s := service.NewBoard(r, nil, nil)
+			s := service.NewBoard(r, nil, nil, nil, nil)
 
 			got, err := s.ListByOwnerID(context.Background(), validBoard.OwnerID)
 
@@ -228,7 +350,7 @@ func TestBoard_Get(t *testing.T) {
 
 			r := NewMockBoardRepository(t)
 			tt.setupBoardRepo(t, r)
-			s := service.NewBoard(r, nil, nil)
+			s := service.NewBoard(r, nil, nil, nil, nil)
 
 			got, err := s.Get(context.Background(), tt.callerID, validBoard.ID)
 
@@ -254,20 +376,72 @@ func TestBoard_GetAggregate(t *testing.T) {
 	secondTask := testutil.NewValidTask(t, firstColumn.ID, "Second task", "Second description", 2)
 	doneTask := testutil.ValidTask(secondColumn.ID)
 	otherOwner := domain.NewUserID()
+	firstLane := testutil.ValidLane(validBoard.ID)
+	secondLane := testutil.NewValidLane(t, validBoard.ID, "Support", 2)
+	laneTask := testutil.NewValidTask(t, firstColumn.ID, "Lane task", "Lane description", 1)
+	laneTask.LaneID = secondLane.ID
+	enteredAt := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	columnEnteredAt := map[domain.TaskID]time.Time{firstTask.ID: enteredAt, doneTask.ID: enteredAt.Add(time.Hour)}
 
 	wantAggregate := service.AggregateBoard{
 		Board: validBoard,
 		Columns: []service.AggregateColumn{
-			{Column: firstColumn, Tasks: []domain.Task{firstTask, secondTask}},
+			{Column: firstColumn, Tasks: []domain.Task{firstTask, secondTask, laneTask}},
 			{Column: secondColumn, Tasks: []domain.Task{doneTask}},
 		},
+		Lanes: []service.AggregateLane{
+			{
+				Cells: []service.AggregateCell{
+					{ColumnID: firstColumn.ID, TaskIDs: []domain.TaskID{firstTask.ID, secondTask.ID}},
+					{ColumnID: secondColumn.ID, TaskIDs: []domain.TaskID{doneTask.ID}},
+				},
+			},
+			{
+				Lane: firstLane,
+				Cells: []service.AggregateCell{
+					{ColumnID: firstColumn.ID, TaskIDs: []domain.TaskID{}},
+					{ColumnID: secondColumn.ID, TaskIDs: []domain.TaskID{}},
+				},
+			},
+			{
+				Lane: secondLane,
+				Cells: []service.AggregateCell{
+					{ColumnID: firstColumn.ID, TaskIDs: []domain.TaskID{laneTask.ID}},
+					{ColumnID: secondColumn.ID, TaskIDs: []domain.TaskID{}},
+				},
+			},
+		},
+		ColumnEnteredAt: columnEnteredAt,
+	}
+
+	archivedTask := testutil.NewValidTask(t, secondColumn.ID, "Archived task", "Archived description", 1)
+	archivedTask.ArchivedAt = enteredAt.Add(48 * time.Hour)
+	earlierArchivedTask := testutil.NewValidTask(t, secondColumn.ID, "Earlier archived task", "Earlier archived description", 2)
+	earlierArchivedTask.ArchivedAt = enteredAt.Add(24 * time.Hour)
+	wantArchivedAggregate := service.AggregateBoard{
+		Board: validBoard,
+		Columns: []service.AggregateColumn{
+			{Column: firstColumn, Tasks: []domain.Task{}},
+			{Column: secondColumn, Tasks: []domain.Task{doneTask, earlierArchivedTask, archivedTask}},
+		},
+		Lanes: []service.AggregateLane{
+			{
+				Cells: []service.AggregateCell{
+					{ColumnID: firstColumn.ID, TaskIDs: []domain.TaskID{}},
+					{ColumnID: secondColumn.ID, TaskIDs: []domain.TaskID{doneTask.ID, earlierArchivedTask.ID, archivedTask.ID}},
+				},
+			},
+		},
+		ColumnEnteredAt: map[domain.TaskID]time.Time{},
 	}
 
 	tests := []struct {
 		name            string
 		callerID        domain.UserID
+		includeArchived bool
 		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
 		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
+		setupLaneRepo   func(t *testing.T, r *MockLaneRepository)
 		setupTaskRepo   func(t *testing.T, r *MockTaskRepository)
 		wantErr         error
 		wantAggregate   service.AggregateBoard
@@ -291,16 +465,65 @@ func TestBoard_GetAggregate(t *testing.T) {
 					return []domain.Column{secondColumn, firstColumn}, nil
 				}
 			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					return []domain.Lane{secondLane, firstLane}, nil
+				}
+			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if includeArchived {
+						t.Errorf("got include archived, want active tasks only")
+					}
+					return []domain.Task{laneTask, secondTask, doneTask, firstTask}, nil
+				}
+				r.ListColumnEntriesFunc = func(ctx context.Context, boardID domain.BoardID) (map[domain.TaskID]time.Time, error) {
 					if boardID != validBoard.ID {
 						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
 					}
-					return []domain.Task{secondTask, doneTask, firstTask}, nil
+					return columnEnteredAt, nil
 				}
 			},
 			wantAggregate: wantAggregate,
 		},
+		{
+			name:            "Success places archived tasks after active ones",
+			callerID:        validBoard.OwnerID,
+			includeArchived: true,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return []domain.Column{firstColumn, secondColumn}, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error) {
+					return nil, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
+					if !includeArchived {
+						t.Errorf("got active tasks only, want include archived")
+					}
+					return []domain.Task{archivedTask, doneTask, earlierArchivedTask}, nil
+				}
+				r.ListColumnEntriesFunc = func(ctx context.Context, boardID domain.BoardID) (map[domain.TaskID]time.Time, error) {
+					return map[domain.TaskID]time.Time{}, nil
+				}
+			},
+			wantAggregate: wantArchivedAggregate,
+		},
 		{
 			name:     "Not found when not owner",
 			callerID: otherOwner,
@@ -316,7 +539,7 @@ func TestBoard_GetAggregate(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return nil, nil
 				}
@@ -338,7 +561,7 @@ func TestBoard_GetAggregate(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return nil, nil
 				}
@@ -359,7 +582,33 @@ func TestBoard_GetAggregate(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
+					t.Fatalf("got call, want no call")
+					return nil, nil
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name:     "Internal error from lane repository",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return []domain.Column{firstColumn, secondColumn}, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error) {
+					return nil, repository.ErrInternal
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return nil, nil
 				}
@@ -379,8 +628,41 @@ func TestBoard_GetAggregate(t *testing.T) {
 					return []domain.Column{firstColumn, secondColumn}, nil
 				}
 			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error) {
+					return nil, nil
+				}
+			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
+					return nil, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name:     "Internal error from column entries",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return []domain.Column{firstColumn, secondColumn}, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error) {
+					return nil, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
+					return []domain.Task{firstTask}, nil
+				}
+				r.ListColumnEntriesFunc = func(ctx context.Context, boardID domain.BoardID) (map[domain.TaskID]time.Time, error) {
 					return nil, repository.ErrInternal
 				}
 			},
@@ -394,18 +676,26 @@ func TestBoard_GetAggregate(t *testing.T) {
 
 			boardRepo := NewMockBoardRepository(t)
 			columnRepo := NewMockColumnRepository(t)
+			laneRepo := NewMockLaneRepository(t)
 			taskRepo := NewMockTaskRepository(t)
 			tt.setupBoardRepo(t, boardRepo)
 			tt.setupColumnRepo(t, columnRepo)
+			if tt.setupLaneRepo != nil {
+				tt.setupLaneRepo(t, laneRepo)
+			}
 			tt.setupTaskRepo(t, taskRepo)
 
-			s := service.NewBoard(boardRepo, columnRepo, taskRepo)
-			got, err := s.GetAggregate(context.Background(), tt.callerID, validBoard.ID)
+			s := service.NewBoard(boardRepo, columnRepo, laneRepo, taskRepo, nil)
+			got, err := s.GetAggregate(context.Background(), tt.callerID, validBoard.ID, tt.includeArchived)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
 			}
 			if tt.wantErr == nil {
+				if got.AsOf.IsZero() {
+					t.Errorf("got zero AsOf, want the read time")
+				}
+				got.AsOf = time.Time{}
 				if diff := cmp.Diff(tt.wantAggregate, got, testutil.CmpAllowUnexported()); diff != "" {
 					t.Errorf("GetAggregate() mismatch (-want +got):\n%s", diff)
 				}
@@ -433,6 +723,7 @@ func TestBoard_Update(t *testing.T) {
 		callerID         domain.UserID
 		inputName        *domain.BoardName
 		inputDescription *domain.BoardDescription
+		ifMatch          domain.Version
 		setupBoardRepo   func(t *testing.T, r *MockBoardRepository)
 		wantErr          error
 		wantBoard        domain.Board
@@ -443,7 +734,7 @@ func TestBoard_Update(t *testing.T) {
 			inputName:        &updatedName,
 			inputDescription: &updatedDescription,
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
 					if boardID != validBoard.ID {
 						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
 					}
@@ -471,7 +762,7 @@ func TestBoard_Update(t *testing.T) {
 			callerID:  validBoard.OwnerID,
 			inputName: &updatedNameOnly,
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
 					if name == nil || *name != updatedNameOnlyBoard.Name {
 						t.Errorf("got name %+v, want %+v", name, updatedNameOnlyBoard.Name)
 					}
@@ -492,7 +783,7 @@ func TestBoard_Update(t *testing.T) {
 			callerID:         validBoard.OwnerID,
 			inputDescription: &updatedDescriptionOnly,
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
 					if name != nil {
 						t.Errorf("got name %+v, want nil", name)
 					}
@@ -519,6 +810,52 @@ func TestBoard_Update(t *testing.T) {
 			wantErr:   nil,
 			wantBoard: validBoard,
 		},
+		{
+			name:      "Success with matching version",
+			callerID:  validBoard.OwnerID,
+			inputName: &updatedNameOnly,
+			ifMatch:   validBoard.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
+					if expected != validBoard.Version {
+						t.Errorf("got expected version %v, want %v", expected, validBoard.Version)
+					}
+					return updatedNameOnlyBoard, nil
+				}
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr:   nil,
+			wantBoard: updatedNameOnlyBoard,
+		},
+		{
+			name:      "Version conflict when version is stale",
+			callerID:  validBoard.OwnerID,
+			inputName: &updatedName,
+			ifMatch:   updatedValidBoard.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:      "Version conflict when board changes concurrently",
+			callerID:  validBoard.OwnerID,
+			inputName: &updatedName,
+			ifMatch:   validBoard.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
+					return domain.Board{}, repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
 		{
 			name:     "Not found when wrong owner",
 			callerID: otherOwner,
@@ -547,7 +884,7 @@ func TestBoard_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
 					return domain.Board{}, repository.ErrInternal
 				}
 			},
@@ -561,7 +898,7 @@ func TestBoard_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
 					return domain.Board{}, errors.New("db exploded")
 				}
 			},
@@ -585,9 +922,9 @@ func TestBoard_Update(t *testing.T) {
 
 			r := NewMockBoardRepository(t)
 			tt.setupBoardRepo(t, r)
-			s := service.NewBoard(r, nil, nil)
+			s := service.NewBoard(r, nil, nil, nil, nil)
 
-			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, tt.inputName, tt.inputDescription)
+			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, tt.inputName, tt.inputDescription, tt.ifMatch)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
@@ -606,10 +943,15 @@ func TestBoard_Delete(t *testing.T) {
 
 	validBoard := testutil.ValidBoard()
 	otherOwner := domain.NewUserID()
+	staleVersion, err := domain.NewVersion(validBoard.Version.Int64() + 1)
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
 
 	tests := []struct {
 		name           string
 		callerID       domain.UserID
+		ifMatch        domain.Version
 		setupBoardRepo func(t *testing.T, r *MockBoardRepository)
 		wantErr        error
 	}{
@@ -623,7 +965,7 @@ func TestBoard_Delete(t *testing.T) {
 					}
 					return validBoard, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, expected domain.Version) error {
 					if boardID != validBoard.ID {
 						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
 					}
@@ -632,6 +974,34 @@ func TestBoard_Delete(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name:     "Version conflict when version is stale",
+			callerID: validBoard.OwnerID,
+			ifMatch:  staleVersion,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:     "Version conflict when board changes concurrently",
+			callerID: validBoard.OwnerID,
+			ifMatch:  validBoard.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, expected domain.Version) error {
+					if expected != validBoard.Version {
+						t.Errorf("got expected version %v, want %v", expected, validBoard.Version)
+					}
+					return repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
 		{
 			name:     "Not found when wrong owner",
 			callerID: otherOwner,
@@ -679,7 +1049,7 @@ func TestBoard_Delete(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, expected domain.Version) error {
 					return repository.ErrRowNotFound
 				}
 			},
@@ -692,7 +1062,7 @@ func TestBoard_Delete(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, expected domain.Version) error {
 					return repository.ErrInternal
 				}
 			},
@@ -706,9 +1076,9 @@ func TestBoard_Delete(t *testing.T) {
 
 			r := NewMockBoardRepository(t)
 			tt.setupBoardRepo(t, r)
-			s := service.NewBoard(r, nil, nil)
+			s := service.NewBoard(r, nil, nil, nil, nil)
 
-			err := s.Delete(context.Background(), tt.callerID, validBoard.ID)
+			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, tt.ifMatch)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
@@ -716,3 +1086,113 @@ func TestBoard_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestBoard_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	copiedBoard := validBoard
+	copiedBoard.ID = domain.NewBoardID()
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		setupBoardRepo func(t *testing.T, r *MockBoardRepository)
+		wantBoard      domain.Board
+		wantErr        error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
+					}
+					return copiedBoard, nil
+				}
+			},
+			wantBoard: copiedBoard,
+		},
+		{
+			name:     "Not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Not found when row missing",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Get internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, errors.New("db exploded")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name:     "Duplicate returns not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Duplicate returns internal",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewMockBoardRepository(t)
+			tt.setupBoardRepo(t, r)
+			s := service.NewBoard(r, nil, nil, nil, nil)
+
+			got, err := s.Duplicate(context.Background(), tt.callerID, validBoard.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantBoard, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Duplicate() board mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
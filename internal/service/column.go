@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/repository"
@@ -13,9 +14,10 @@ type columnRepository interface {
 	Create(ctx context.Context, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error)
 	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error)
 	Get(ctx context.Context, columnID domain.ColumnID) (domain.Column, error)
-	Update(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error)
-	Move(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error)
-	Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error
+	Update(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error)
+	Move(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error)
+	Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error
+	Duplicate(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error)
 }
 
 type columnBoardRepository interface {
@@ -72,13 +74,15 @@ func (s *column) ListByBoardID(ctx context.Context, callerID domain.UserID, boar
 	return columns, nil
 }
 
+// Update changes the column. A set ifMatch is the version the caller last saw: if the column
+// has changed since, nothing is written and ErrVersionConflict is returned.
 func (s *column) Update(
 	ctx context.Context,
 	callerID domain.UserID,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
-	name *domain.ColumnName,
-	description *domain.ColumnDescription,
+	patch domain.ColumnPatch,
+	ifMatch domain.Version,
 ) (domain.Column, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
@@ -101,27 +105,43 @@ func (s *column) Update(
 	if column.BoardID != boardID {
 		return domain.Column{}, ErrColumnNotFound
 	}
+	if ifMatch.IsSet() && ifMatch != column.Version {
+		return domain.Column{}, ErrVersionConflict
+	}
 
-	if name == nil && description == nil {
+	if patch.IsEmpty() {
 		return column, nil
 	}
 
-	updated, err := s.columnRepo.Update(ctx, boardID, columnID, name, description)
+	if patch.Transitions != nil && len(patch.Transitions.Targets()) > 0 {
+		err = s.checkTransitionTargets(ctx, boardID, patch.Transitions)
+		if err != nil {
+			return domain.Column{}, fmt.Errorf("column service: update: %w", err)
+		}
+	}
+
+	updated, err := s.columnRepo.Update(ctx, boardID, columnID, patch, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
 			return domain.Column{}, ErrColumnNotFound
 		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return domain.Column{}, ErrVersionConflict
+		}
 		return domain.Column{}, fmt.Errorf("column service: update: %v: %w", err, ErrInternal)
 	}
 
 	return updated, nil
 }
 
+// Delete deletes the column with its tasks unless ifMatch is set and the column has changed
+// since that version.
 func (s *column) Delete(
 	ctx context.Context,
 	callerID domain.UserID,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
+	ifMatch domain.Version,
 ) error {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
@@ -134,56 +154,114 @@ func (s *column) Delete(
 		return ErrColumnNotFound
 	}
 
-	err = s.columnRepo.Delete(ctx, boardID, columnID)
+	err = s.columnRepo.Delete(ctx, boardID, columnID, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
 			return ErrColumnNotFound
 		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return ErrVersionConflict
+		}
 		return fmt.Errorf("column service: delete: %v: %w", err, ErrInternal)
 	}
 
 	return nil
 }
 
+func (s *column) Duplicate(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+) (domain.Column, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Column{}, ErrColumnNotFound
+		}
+		return domain.Column{}, fmt.Errorf("column service: duplicate get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Column{}, ErrColumnNotFound
+	}
+
+	column, err := s.columnRepo.Duplicate(ctx, boardID, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Column{}, ErrColumnNotFound
+		}
+		return domain.Column{}, fmt.Errorf("column service: duplicate: %v: %w", err, ErrInternal)
+	}
+
+	return column, nil
+}
+
+// Move moves the column to targetPosition unless ifMatch is set and the column has changed since
+// that version.
 func (s *column) Move(
 	ctx context.Context,
 	callerID domain.UserID,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
 	targetPosition domain.ColumnPosition,
-) (domain.ColumnPosition, error) {
+	ifMatch domain.Version,
+) (domain.ColumnPlacement, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
-			return domain.ColumnPosition{}, ErrColumnNotFound
+			return domain.ColumnPlacement{}, ErrColumnNotFound
 		}
-		return domain.ColumnPosition{}, fmt.Errorf("column service: move get board: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column service: move get board: %v: %w", err, ErrInternal)
 	}
 	if board.OwnerID != callerID {
-		return domain.ColumnPosition{}, ErrColumnNotFound
+		return domain.ColumnPlacement{}, ErrColumnNotFound
 	}
 
 	column, err := s.columnRepo.Get(ctx, columnID)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
-			return domain.ColumnPosition{}, ErrColumnNotFound
+			return domain.ColumnPlacement{}, ErrColumnNotFound
 		}
-		return domain.ColumnPosition{}, fmt.Errorf("column service: move get column: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column service: move get column: %v: %w", err, ErrInternal)
 	}
 	if column.BoardID != boardID {
-		return domain.ColumnPosition{}, ErrColumnNotFound
+		return domain.ColumnPlacement{}, ErrColumnNotFound
+	}
+	if ifMatch.IsSet() && ifMatch != column.Version {
+		return domain.ColumnPlacement{}, ErrVersionConflict
 	}
 
-	position, err := s.columnRepo.Move(ctx, boardID, columnID, targetPosition)
+	placement, err := s.columnRepo.Move(ctx, boardID, columnID, targetPosition, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
-			return domain.ColumnPosition{}, ErrColumnNotFound
+			return domain.ColumnPlacement{}, ErrColumnNotFound
 		}
 		if errors.Is(err, repository.ErrIndexOutOfBounds) {
-			return domain.ColumnPosition{}, ErrIndexOutOfBounds
+			return domain.ColumnPlacement{}, ErrIndexOutOfBounds
+		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return domain.ColumnPlacement{}, ErrVersionConflict
 		}
-		return domain.ColumnPosition{}, fmt.Errorf("column service: move: %v: %w", err, ErrInternal)
+		return domain.ColumnPlacement{}, fmt.Errorf("column service: move: %v: %w", err, ErrInternal)
 	}
 
-	return position, nil
+	return placement, nil
+}
+
+// checkTransitionTargets verifies that every target of transitions is a column of boardID.
+// A target deleted right after the check is dropped from the transitions along with the column.
+func (s *column) checkTransitionTargets(ctx context.Context, boardID domain.BoardID, transitions *domain.ColumnTransitions) error {
+	columns, err := s.columnRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return fmt.Errorf("list board columns: %v: %w", err, ErrInternal)
+	}
+
+	for _, target := range transitions.Targets() {
+		found := slices.ContainsFunc(columns, func(c domain.Column) bool { return c.ID == target })
+		if !found {
+			return ErrTransitionColumnNotFound
+		}
+	}
+
+	return nil
 }
@@ -246,6 +246,10 @@ func TestColumn_Update(t *testing.T) {
 	updatedColumn := validColumn
 	updatedColumn.Name = updatedName
 	updatedColumn.UpdatedAt = testutil.FixedNow()
+	updatedColumn.Version, err = domain.NewVersion(validColumn.Version.Int64() + 1)
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
 
 	updatedDesc, errDesc := domain.NewColumnDescription("Patched column description")
 	if errDesc != nil {
@@ -255,22 +259,75 @@ func TestColumn_Update(t *testing.T) {
 	updatedColumnDescOnly.Description = updatedDesc
 	updatedColumnDescOnly.UpdatedAt = testutil.FixedNow()
 
+	updatedWIPLimit, errWIP := domain.NewColumnWIPLimit(3)
+	if errWIP != nil {
+		t.Fatalf("NewColumnWIPLimit() error = %v", errWIP)
+	}
+	updatedColumnWIPOnly := validColumn
+	updatedColumnWIPOnly.WIPLimit = updatedWIPLimit
+	updatedColumnWIPOnly.UpdatedAt = testutil.FixedNow()
+
+	patchSLA, errSLA := domain.NewColumnSLA(48)
+	if errSLA != nil {
+		t.Fatalf("NewColumnSLA() error = %v", errSLA)
+	}
+	updatedColumnSLAOnly := validColumn
+	updatedColumnSLAOnly.SLA = patchSLA
+	updatedColumnSLAOnly.UpdatedAt = testutil.FixedNow()
+
+	patchArchiveAfter, errArchive := domain.NewColumnArchiveAfter(14)
+	if errArchive != nil {
+		t.Fatalf("NewColumnArchiveAfter() error = %v", errArchive)
+	}
+	updatedColumnArchiveOnly := validColumn
+	updatedColumnArchiveOnly.ArchiveAfter = patchArchiveAfter
+	updatedColumnArchiveOnly.UpdatedAt = testutil.FixedNow()
+
+	patchIsDone := true
+	updatedColumnDoneOnly := validColumn
+	updatedColumnDoneOnly.IsDone = true
+	updatedColumnDoneOnly.UpdatedAt = testutil.FixedNow()
+
+	patchIsStarted := true
+	updatedColumnStartedOnly := validColumn
+	updatedColumnStartedOnly.IsStarted = true
+	updatedColumnStartedOnly.UpdatedAt = testutil.FixedNow()
+
+	reviewColumn := testutil.ValidColumn(validBoard.ID)
+	reviewColumn.ID = domain.NewColumnID()
+	patchTransitions, errTransitions := domain.NewColumnTransitions([]domain.ColumnID{reviewColumn.ID})
+	if errTransitions != nil {
+		t.Fatalf("NewColumnTransitions() error = %v", errTransitions)
+	}
+	foreignTransitions, errTransitions := domain.NewColumnTransitions([]domain.ColumnID{domain.NewColumnID()})
+	if errTransitions != nil {
+		t.Fatalf("NewColumnTransitions() error = %v", errTransitions)
+	}
+	patchEntryConditions, errConditions := domain.NewColumnEntryConditions([]string{"checklist_done"})
+	if errConditions != nil {
+		t.Fatalf("NewColumnEntryConditions() error = %v", errConditions)
+	}
+	updatedColumnWorkflow := validColumn
+	updatedColumnWorkflow.Transitions = patchTransitions
+	updatedColumnWorkflow.EntryConditions = patchEntryConditions
+	updatedColumnWorkflow.UpdatedAt = testutil.FixedNow()
+
 	tests := []struct {
-		name             string
-		callerID         domain.UserID
-		columnID         domain.ColumnID
-		patchName        *domain.ColumnName
-		patchDescription *domain.ColumnDescription
-		setupBoardRepo   func(t *testing.T, r *MockBoardRepository)
-		setupColumnRepo  func(t *testing.T, r *MockColumnRepository)
-		wantErr          error
-		wantColumn       domain.Column
+		name            string
+		callerID        domain.UserID
+		columnID        domain.ColumnID
+		patch           domain.ColumnPatch
+		ifMatch         domain.Version
+		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
+		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
+		wantErr         error
+		wantColumn      domain.Column
 	}{
 		{
-			name:      "Success",
-			callerID:  validBoard.OwnerID,
-			columnID:  validColumn.ID,
-			patchName: &updatedName,
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Name: &updatedName},
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
@@ -283,18 +340,18 @@ func TestColumn_Update(t *testing.T) {
 					}
 					return validColumn, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 					if boardID != validBoard.ID {
 						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
 					}
 					if columnID != validColumn.ID {
 						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
 					}
-					if name == nil || *name != updatedName {
-						t.Errorf("got name %v, want %v", name, updatedName)
+					if patch.Name == nil || *patch.Name != updatedName {
+						t.Errorf("got name %v, want %v", patch.Name, updatedName)
 					}
-					if description != nil {
-						t.Errorf("got description %+v, want nil", description)
+					if patch.Description != nil {
+						t.Errorf("got description %+v, want nil", patch.Description)
 					}
 					return updatedColumn, nil
 				}
@@ -302,10 +359,10 @@ func TestColumn_Update(t *testing.T) {
 			wantColumn: updatedColumn,
 		},
 		{
-			name:             "Success description only",
-			callerID:         validBoard.OwnerID,
-			columnID:         validColumn.ID,
-			patchDescription: &updatedDesc,
+			name:     "Success description only",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Description: &updatedDesc},
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
@@ -315,18 +372,201 @@ func TestColumn_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return validColumn, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
-					if name != nil {
-						t.Errorf("got name %+v, want nil", name)
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if patch.Name != nil {
+						t.Errorf("got name %+v, want nil", patch.Name)
 					}
-					if description == nil || *description != updatedDesc {
-						t.Errorf("got description %v, want %v", description, updatedDesc)
+					if patch.Description == nil || *patch.Description != updatedDesc {
+						t.Errorf("got description %v, want %v", patch.Description, updatedDesc)
 					}
 					return updatedColumnDescOnly, nil
 				}
 			},
 			wantColumn: updatedColumnDescOnly,
 		},
+		{
+			name:     "Success done flag only",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{IsDone: &patchIsDone},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if patch.Name != nil || patch.Description != nil || patch.WIPLimit != nil {
+						t.Errorf("got name %+v, description %+v and wip limit %+v, want nil", patch.Name, patch.Description, patch.WIPLimit)
+					}
+					if patch.IsDone == nil || !*patch.IsDone {
+						t.Errorf("got done flag %v, want true", patch.IsDone)
+					}
+					return updatedColumnDoneOnly, nil
+				}
+			},
+			wantColumn: updatedColumnDoneOnly,
+		},
+		{
+			name:     "Success started flag only",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{IsStarted: &patchIsStarted},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if patch.IsDone != nil {
+						t.Errorf("got done flag %v, want nil", patch.IsDone)
+					}
+					if patch.IsStarted == nil || !*patch.IsStarted {
+						t.Errorf("got started flag %v, want true", patch.IsStarted)
+					}
+					return updatedColumnStartedOnly, nil
+				}
+			},
+			wantColumn: updatedColumnStartedOnly,
+		},
+		{
+			name:     "Success workflow rules",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Transitions: &patchTransitions, EntryConditions: &patchEntryConditions},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return []domain.Column{validColumn, reviewColumn}, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if patch.Transitions == nil || !patch.Transitions.Allows(reviewColumn.ID) || patch.Transitions.Allows(validColumn.ID) {
+						t.Errorf("got transitions %v, want only %v", patch.Transitions, reviewColumn.ID)
+					}
+					if patch.EntryConditions == nil {
+						t.Errorf("got entry conditions nil, want %v", patchEntryConditions.Strings())
+					}
+					return updatedColumnWorkflow, nil
+				}
+			},
+			wantColumn: updatedColumnWorkflow,
+		},
+		{
+			name:     "Transition target on another board",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Transitions: &foreignTransitions},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error) {
+					return []domain.Column{validColumn, reviewColumn}, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					t.Fatalf("got call, want no call")
+					return domain.Column{}, nil
+				}
+			},
+			wantErr: service.ErrTransitionColumnNotFound,
+		},
+		{
+			name:     "Success SLA only",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{SLA: &patchSLA},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if patch.WIPLimit != nil {
+						t.Errorf("got wip limit %v, want nil", patch.WIPLimit)
+					}
+					if patch.SLA == nil || *patch.SLA != patchSLA {
+						t.Errorf("got sla %v, want %v", patch.SLA, patchSLA)
+					}
+					return updatedColumnSLAOnly, nil
+				}
+			},
+			wantColumn: updatedColumnSLAOnly,
+		},
+		{
+			name:     "Success archive delay only",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{ArchiveAfter: &patchArchiveAfter},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if patch.SLA != nil {
+						t.Errorf("got sla %v, want nil", patch.SLA)
+					}
+					if patch.ArchiveAfter == nil || *patch.ArchiveAfter != patchArchiveAfter {
+						t.Errorf("got archive delay %v, want %v", patch.ArchiveAfter, patchArchiveAfter)
+					}
+					return updatedColumnArchiveOnly, nil
+				}
+			},
+			wantColumn: updatedColumnArchiveOnly,
+		},
+		{
+			name:     "Success WIP limit only",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{WIPLimit: &updatedWIPLimit},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if patch.Name != nil || patch.Description != nil {
+						t.Errorf("got name %+v and description %+v, want nil", patch.Name, patch.Description)
+					}
+					if patch.WIPLimit == nil || *patch.WIPLimit != updatedWIPLimit {
+						t.Errorf("got wip limit %v, want %v", patch.WIPLimit, updatedWIPLimit)
+					}
+					return updatedColumnWIPOnly, nil
+				}
+			},
+			wantColumn: updatedColumnWIPOnly,
+		},
 		{
 			name:     "Success no-op patch",
 			callerID: validBoard.OwnerID,
@@ -340,7 +580,7 @@ func TestColumn_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return validColumn, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
@@ -361,7 +601,7 @@ func TestColumn_Update(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
@@ -382,7 +622,7 @@ func TestColumn_Update(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
@@ -404,7 +644,7 @@ func TestColumn_Update(t *testing.T) {
 					otherBoardColumn.BoardID = domain.NewBoardID()
 					return otherBoardColumn, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
@@ -424,7 +664,7 @@ func TestColumn_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return domain.Column{}, repository.ErrRowNotFound
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
@@ -432,10 +672,35 @@ func TestColumn_Update(t *testing.T) {
 			wantErr: service.ErrColumnNotFound,
 		},
 		{
-			name:      "Update internal error",
-			callerID:  validBoard.OwnerID,
-			columnID:  validColumn.ID,
-			patchName: &updatedName,
+			name:     "Success with matching version",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Name: &updatedName},
+			ifMatch:  validColumn.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					if expected != validColumn.Version {
+						t.Errorf("got expected version %v, want %v", expected, validColumn.Version)
+					}
+					return updatedColumn, nil
+				}
+			},
+			wantColumn: updatedColumn,
+		},
+		{
+			name:     "Version conflict when version is stale",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Name: &updatedName},
+			ifMatch:  updatedColumn.Version,
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
@@ -445,7 +710,45 @@ func TestColumn_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return validColumn, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error) {
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:     "Version conflict when column changes concurrently",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Name: &updatedName},
+			ifMatch:  validColumn.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
+					return domain.Column{}, repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:     "Update internal error",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			patch:    domain.ColumnPatch{Name: &updatedName},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 					return domain.Column{}, errors.New("update failed")
 				}
 			},
@@ -463,7 +766,7 @@ func TestColumn_Update(t *testing.T) {
 			tt.setupColumnRepo(t, columnRepo)
 
 			s := service.NewColumn(columnRepo, boardRepo)
-			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, tt.columnID, tt.patchName, tt.patchDescription)
+			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, tt.columnID, tt.patch, tt.ifMatch)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
@@ -486,15 +789,21 @@ func TestColumn_Move(t *testing.T) {
 	if err != nil {
 		t.Fatalf("NewColumnPosition() error = %v", err)
 	}
+	movedVersion, err := domain.NewVersion(validColumn.Version.Int64() + 1)
+	if err != nil {
+		t.Fatalf("NewVersion() error = %v", err)
+	}
+	movedPlacement := domain.ColumnPlacement{Position: targetPosition, Version: movedVersion}
 
 	tests := []struct {
 		name            string
 		callerID        domain.UserID
 		columnID        domain.ColumnID
+		ifMatch         domain.Version
 		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
 		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
 		wantErr         error
-		wantPosition    domain.ColumnPosition
+		wantPlacement   domain.ColumnPlacement
 	}{
 		{
 			name:     "Success",
@@ -512,7 +821,7 @@ func TestColumn_Move(t *testing.T) {
 					}
 					return validColumn, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, gotTargetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, gotTargetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
 					if boardID != validBoard.ID {
 						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
 					}
@@ -522,10 +831,13 @@ func TestColumn_Move(t *testing.T) {
 					if gotTargetPosition != targetPosition {
 						t.Errorf("got target position %v, want %v", gotTargetPosition, targetPosition)
 					}
-					return targetPosition, nil
+					if expected.IsSet() {
+						t.Errorf("got expected version %v, want none", expected)
+					}
+					return movedPlacement, nil
 				}
 			},
-			wantPosition: targetPosition,
+			wantPlacement: movedPlacement,
 		},
 		{
 			name:     "Board not found",
@@ -541,9 +853,9 @@ func TestColumn_Move(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
 					t.Fatalf("got call, want no call")
-					return domain.ColumnPosition{}, nil
+					return domain.ColumnPlacement{}, nil
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
@@ -562,9 +874,9 @@ func TestColumn_Move(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Column{}, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
 					t.Fatalf("got call, want no call")
-					return domain.ColumnPosition{}, nil
+					return domain.ColumnPlacement{}, nil
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
@@ -584,9 +896,9 @@ func TestColumn_Move(t *testing.T) {
 					otherBoardColumn.BoardID = domain.NewBoardID()
 					return otherBoardColumn, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
 					t.Fatalf("got call, want no call")
-					return domain.ColumnPosition{}, nil
+					return domain.ColumnPlacement{}, nil
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
@@ -604,9 +916,9 @@ func TestColumn_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return domain.Column{}, repository.ErrRowNotFound
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
 					t.Fatalf("got call, want no call")
-					return domain.ColumnPosition{}, nil
+					return domain.ColumnPlacement{}, nil
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
@@ -624,8 +936,8 @@ func TestColumn_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return validColumn, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
-					return domain.ColumnPosition{}, repository.ErrIndexOutOfBounds
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, repository.ErrIndexOutOfBounds
 				}
 			},
 			wantErr: service.ErrIndexOutOfBounds,
@@ -643,12 +955,56 @@ func TestColumn_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return validColumn, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
-					return domain.ColumnPosition{}, repository.ErrRowNotFound
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, repository.ErrRowNotFound
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
 		},
+		{
+			name:     "Version conflict when version is stale",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			ifMatch:  movedVersion,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
+					t.Fatalf("got call, want no call")
+					return domain.ColumnPlacement{}, nil
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:     "Version conflict when column changes concurrently",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			ifMatch:  validColumn.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
+					if expected != validColumn.Version {
+						t.Errorf("got expected version %v, want %v", expected, validColumn.Version)
+					}
+					return domain.ColumnPlacement{}, repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
 		{
 			name:     "Move internal error",
 			callerID: validBoard.OwnerID,
@@ -662,8 +1018,8 @@ func TestColumn_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
 					return validColumn, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error) {
-					return domain.ColumnPosition{}, errors.New("move failed")
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, errors.New("move failed")
 				}
 			},
 			wantErr: service.ErrInternal,
@@ -680,13 +1036,13 @@ func TestColumn_Move(t *testing.T) {
 			tt.setupColumnRepo(t, columnRepo)
 
 			s := service.NewColumn(columnRepo, boardRepo)
-			got, err := s.Move(context.Background(), tt.callerID, validBoard.ID, tt.columnID, targetPosition)
+			got, err := s.Move(context.Background(), tt.callerID, validBoard.ID, tt.columnID, targetPosition, tt.ifMatch)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
 			}
-			if tt.wantErr == nil && got != tt.wantPosition {
-				t.Errorf("Move() position = %v, want %v", got, tt.wantPosition)
+			if tt.wantErr == nil && got != tt.wantPlacement {
+				t.Errorf("Move() placement = %v, want %v", got, tt.wantPlacement)
 			}
 		})
 	}
@@ -702,6 +1058,7 @@ func TestColumn_Delete(t *testing.T) {
 		name            string
 		callerID        domain.UserID
 		columnID        domain.ColumnID
+		ifMatch         domain.Version
 		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
 		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
 		wantErr         error
@@ -719,7 +1076,7 @@ func TestColumn_Delete(t *testing.T) {
 				}
 			},
 			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
 					if boardID != validBoard.ID {
 						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
 					}
@@ -740,7 +1097,7 @@ func TestColumn_Delete(t *testing.T) {
 				}
 			},
 			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
 					t.Fatalf("got call, want no call")
 					return nil
 				}
@@ -757,7 +1114,7 @@ func TestColumn_Delete(t *testing.T) {
 				}
 			},
 			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
 					t.Fatalf("got call, want no call")
 					return nil
 				}
@@ -774,12 +1131,32 @@ func TestColumn_Delete(t *testing.T) {
 				}
 			},
 			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
 					return repository.ErrRowNotFound
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
 		},
+		{
+			name:     "Version conflict when column has changed",
+			callerID: validBoard.OwnerID,
+			columnID: validColumn.ID,
+			ifMatch:  validColumn.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
+					if expected != validColumn.Version {
+						t.Errorf("got expected version %v, want %v", expected, validColumn.Version)
+					}
+					return repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
 		{
 			name:     "Delete internal error",
 			callerID: validBoard.OwnerID,
@@ -790,7 +1167,7 @@ func TestColumn_Delete(t *testing.T) {
 				}
 			},
 			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
 					return errors.New("delete failed")
 				}
 			},
@@ -808,11 +1185,136 @@ func TestColumn_Delete(t *testing.T) {
 			tt.setupColumnRepo(t, columnRepo)
 
 			s := service.NewColumn(columnRepo, boardRepo)
-			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, tt.columnID)
+			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, tt.columnID, tt.ifMatch)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestColumn_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	copiedColumn := validColumn
+	copiedColumn.ID = domain.NewColumnID()
+
+	tests := []struct {
+		name            string
+		callerID        domain.UserID
+		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
+		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
+		wantColumn      domain.Column
+		wantErr         error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if columnID != validColumn.ID {
+						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
+					}
+					return copiedColumn, nil
+				}
+			},
+			wantColumn: copiedColumn,
+		},
+		{
+			name:     "Board not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					t.Fatalf("got call, want no call")
+					return domain.Column{}, nil
+				}
+			},
+			wantErr: service.ErrColumnNotFound,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					t.Fatalf("got call, want no call")
+					return domain.Column{}, nil
+				}
+			},
+			wantErr: service.ErrColumnNotFound,
+		},
+		{
+			name:     "Column not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					return domain.Column{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrColumnNotFound,
+		},
+		{
+			name:     "Duplicate internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+					return domain.Column{}, errors.New("duplicate failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			columnRepo := NewMockColumnRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupColumnRepo(t, columnRepo)
+
+			s := service.NewColumn(columnRepo, boardRepo)
+			got, err := s.Duplicate(context.Background(), tt.callerID, validBoard.ID, validColumn.ID)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
 			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantColumn, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Duplicate() column mismatch (-want +got):\n%s", diff)
+				}
+			}
 		})
 	}
 }
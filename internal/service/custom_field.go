@@ -0,0 +1,246 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type customFieldRepository interface {
+	Create(ctx context.Context, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error)
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error)
+	Get(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error)
+	Update(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error)
+	Delete(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID) error
+}
+
+type customFieldBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+// CustomFieldValuesError is returned when task custom field values or filters don't fit the
+// custom fields of the board. Issues are keyed by the field id.
+type CustomFieldValuesError struct {
+	Issues map[domain.CustomFieldID][]string
+}
+
+func (e *CustomFieldValuesError) Error() string {
+	return fmt.Sprintf("%d custom field values are invalid", len(e.Issues))
+}
+
+func (e *CustomFieldValuesError) Is(target error) bool {
+	return target == ErrCustomFieldValueInvalid
+}
+
+type customField struct {
+	fieldRepo customFieldRepository
+	boardRepo customFieldBoardRepository
+}
+
+func NewCustomField(fieldRepo customFieldRepository, boardRepo customFieldBoardRepository) *customField {
+	return &customField{fieldRepo: fieldRepo, boardRepo: boardRepo}
+}
+
+// Create adds a custom field to the board. The options must fit the type, see domain.CheckCustomFieldOptions.
+func (s *customField) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	name domain.CustomFieldName,
+	fieldType domain.CustomFieldType,
+	options domain.CustomFieldOptions,
+) (domain.CustomField, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.CustomField{}, ErrBoardNotFound
+		}
+		return domain.CustomField{}, fmt.Errorf("custom field service: create get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.CustomField{}, ErrBoardNotFound
+	}
+
+	field, err := s.fieldRepo.Create(ctx, boardID, name, fieldType, options)
+	if err != nil {
+		return domain.CustomField{}, fmt.Errorf("custom field service: create: %v: %w", err, ErrInternal)
+	}
+
+	return field, nil
+}
+
+func (s *customField) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.CustomField, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("custom field service: list by board id get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return nil, ErrBoardNotFound
+	}
+
+	fields, err := s.fieldRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("custom field service: list by board id: %v: %w", err, ErrInternal)
+	}
+
+	return fields, nil
+}
+
+// Update renames the field or replaces the options of a select field. Options are rejected with
+// ErrCustomFieldOptionsInvalid when they don't fit the field type. Task values lose the options
+// that are no longer offered.
+func (s *customField) Update(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	fieldID domain.CustomFieldID,
+	name *domain.CustomFieldName,
+	options *domain.CustomFieldOptions,
+) (domain.CustomField, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.CustomField{}, ErrCustomFieldNotFound
+		}
+		return domain.CustomField{}, fmt.Errorf("custom field service: update get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.CustomField{}, ErrCustomFieldNotFound
+	}
+
+	field, err := s.fieldRepo.Get(ctx, fieldID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.CustomField{}, ErrCustomFieldNotFound
+		}
+		return domain.CustomField{}, fmt.Errorf("custom field service: update get field: %v: %w", err, ErrInternal)
+	}
+	if field.BoardID != boardID {
+		return domain.CustomField{}, ErrCustomFieldNotFound
+	}
+
+	if options != nil {
+		err = domain.CheckCustomFieldOptions(field.Type, *options)
+		if err != nil {
+			return domain.CustomField{}, fmt.Errorf("custom field service: update: %w: %w", err, ErrCustomFieldOptionsInvalid)
+		}
+	}
+
+	if name == nil && options == nil {
+		return field, nil
+	}
+
+	updated, err := s.fieldRepo.Update(ctx, boardID, fieldID, name, options)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.CustomField{}, ErrCustomFieldNotFound
+		}
+		return domain.CustomField{}, fmt.Errorf("custom field service: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+// Delete removes the field together with its values on the tasks of the board.
+func (s *customField) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrCustomFieldNotFound
+		}
+		return fmt.Errorf("custom field service: delete get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return ErrCustomFieldNotFound
+	}
+
+	err = s.fieldRepo.Delete(ctx, boardID, fieldID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrCustomFieldNotFound
+		}
+		return fmt.Errorf("custom field service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// applyCustomFieldChanges sets the changed values on the current values of a task. A null value
+// removes the value of the field. Every issue is collected into a CustomFieldValuesError.
+func applyCustomFieldChanges(
+	fields []domain.CustomField,
+	current domain.TaskCustomFields,
+	changes map[domain.CustomFieldID]json.RawMessage,
+) (domain.TaskCustomFields, error) {
+	issues := make(map[domain.CustomFieldID][]string)
+	for fieldID, raw := range changes {
+		field, ok := findCustomField(fields, fieldID)
+		if !ok {
+			issues[fieldID] = []string{domain.ErrCustomFieldNotFound}
+			continue
+		}
+		if bytes.Equal(bytes.TrimSpace(raw), []byte("null")) {
+			current = current.Without(fieldID)
+			continue
+		}
+		value, err := domain.NewCustomFieldValue(&field, raw)
+		if err != nil {
+			issues[fieldID] = domain.ExtractValidationIssues(err)
+			continue
+		}
+		current = current.With(fieldID, value)
+	}
+
+	if len(issues) > 0 {
+		return domain.TaskCustomFields{}, &CustomFieldValuesError{Issues: issues}
+	}
+
+	return current, nil
+}
+
+// newCustomFieldFilter builds the values a task must hold to match the filters given as text.
+// Every issue is collected into a CustomFieldValuesError.
+func newCustomFieldFilter(fields []domain.CustomField, filters map[domain.CustomFieldID][]string) (domain.TaskCustomFields, error) {
+	var filter domain.TaskCustomFields
+	issues := make(map[domain.CustomFieldID][]string)
+	for fieldID, texts := range filters {
+		field, ok := findCustomField(fields, fieldID)
+		if !ok {
+			issues[fieldID] = []string{domain.ErrCustomFieldNotFound}
+			continue
+		}
+		if len(texts) != 1 {
+			issues[fieldID] = []string{domain.ErrCustomFieldFilterRepeated}
+			continue
+		}
+		value, err := domain.ParseCustomFieldFilterValue(&field, texts[0])
+		if err != nil {
+			issues[fieldID] = domain.ExtractValidationIssues(err)
+			continue
+		}
+		filter = filter.With(fieldID, value)
+	}
+
+	if len(issues) > 0 {
+		return domain.TaskCustomFields{}, &CustomFieldValuesError{Issues: issues}
+	}
+
+	return filter, nil
+}
+
+func findCustomField(fields []domain.CustomField, fieldID domain.CustomFieldID) (domain.CustomField, bool) {
+	for _, field := range fields {
+		if field.ID == fieldID {
+			return field, true
+		}
+	}
+	return domain.CustomField{}, false
+}
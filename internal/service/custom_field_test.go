@@ -0,0 +1,406 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestCustomField_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validField := testutil.ValidCustomField(validBoard.ID)
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		setupBoardRepo func(t *testing.T, r *MockBoardRepository)
+		setupFieldRepo func(t *testing.T, r *MockCustomFieldRepository)
+		wantErr        error
+		wantField      domain.CustomField
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.CreateFunc = func(ctx context.Context, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if fieldType != validField.Type {
+						t.Errorf("got type %v, want %v", fieldType, validField.Type)
+					}
+					if diff := cmp.Diff(validField.Options.Strings(), options.Strings()); diff != "" {
+						t.Errorf("options mismatch (-want +got):\n%s", diff)
+					}
+					return validField, nil
+				}
+			},
+			wantField: validField,
+		},
+		{
+			name:     "Board not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Create internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.CreateFunc = func(ctx context.Context, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error) {
+					return domain.CustomField{}, errors.New("insert failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			fieldRepo := NewMockCustomFieldRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			if tt.setupFieldRepo != nil {
+				tt.setupFieldRepo(t, fieldRepo)
+			}
+
+			s := service.NewCustomField(fieldRepo, boardRepo)
+			got, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validField.Name, validField.Type, validField.Options)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantField, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Create() field mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCustomField_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validField := testutil.ValidCustomField(validBoard.ID)
+	otherBoardField := testutil.ValidCustomField(domain.NewBoardID())
+	textField := validField
+	textField.Type = domain.CustomFieldText
+	textField.Options = domain.CustomFieldOptions{}
+
+	newName, err := domain.NewCustomFieldName("Stage")
+	if err != nil {
+		t.Fatalf("NewCustomFieldName() error = %v", err)
+	}
+	newOptions, err := domain.NewCustomFieldOptions([]string{"production"})
+	if err != nil {
+		t.Fatalf("NewCustomFieldOptions() error = %v", err)
+	}
+	updatedField := validField
+	updatedField.Name = newName
+	updatedField.Options = newOptions
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		inputName      *domain.CustomFieldName
+		inputOptions   *domain.CustomFieldOptions
+		setupBoardRepo func(t *testing.T, r *MockBoardRepository)
+		setupFieldRepo func(t *testing.T, r *MockCustomFieldRepository)
+		wantErr        error
+		wantField      domain.CustomField
+	}{
+		{
+			name:         "Success",
+			callerID:     validBoard.OwnerID,
+			inputName:    &newName,
+			inputOptions: &newOptions,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.GetFunc = func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+					return validField, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+					if fieldID != validField.ID {
+						t.Errorf("got field id %v, want %v", fieldID, validField.ID)
+					}
+					if name == nil || *name != newName {
+						t.Errorf("got name %v, want %v", name, newName)
+					}
+					if options == nil || options.Len() != 1 {
+						t.Errorf("got options %v, want %v", options, newOptions)
+					}
+					return updatedField, nil
+				}
+			},
+			wantField: updatedField,
+		},
+		{
+			name:     "No changes returns the field",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.GetFunc = func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+					return validField, nil
+				}
+			},
+			wantField: validField,
+		},
+		{
+			name:         "Options on a text field",
+			callerID:     validBoard.OwnerID,
+			inputOptions: &newOptions,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.GetFunc = func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+					return textField, nil
+				}
+			},
+			wantErr: service.ErrCustomFieldOptionsInvalid,
+		},
+		{
+			name:         "No options on a select field",
+			callerID:     validBoard.OwnerID,
+			inputOptions: &domain.CustomFieldOptions{},
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.GetFunc = func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+					return validField, nil
+				}
+			},
+			wantErr: service.ErrCustomFieldOptionsInvalid,
+		},
+		{
+			name:      "Field of another board",
+			callerID:  validBoard.OwnerID,
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.GetFunc = func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+					return otherBoardField, nil
+				}
+			},
+			wantErr: service.ErrCustomFieldNotFound,
+		},
+		{
+			name:      "Caller has no access",
+			callerID:  domain.NewUserID(),
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrCustomFieldNotFound,
+		},
+		{
+			name:      "Field not found",
+			callerID:  validBoard.OwnerID,
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.GetFunc = func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+					return domain.CustomField{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrCustomFieldNotFound,
+		},
+		{
+			name:      "Update internal error",
+			callerID:  validBoard.OwnerID,
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.GetFunc = func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+					return validField, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+					return domain.CustomField{}, errors.New("update failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			fieldRepo := NewMockCustomFieldRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			if tt.setupFieldRepo != nil {
+				tt.setupFieldRepo(t, fieldRepo)
+			}
+
+			s := service.NewCustomField(fieldRepo, boardRepo)
+			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, validField.ID, tt.inputName, tt.inputOptions)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantField, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Update() field mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestCustomField_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validField := testutil.ValidCustomField(validBoard.ID)
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		setupBoardRepo func(t *testing.T, r *MockBoardRepository)
+		setupFieldRepo func(t *testing.T, r *MockCustomFieldRepository)
+		wantErr        error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+					if boardID != validBoard.ID || fieldID != validField.ID {
+						t.Errorf("got board id %v and field id %v, want %v and %v", boardID, fieldID, validBoard.ID, validField.ID)
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrCustomFieldNotFound,
+		},
+		{
+			name:     "Field not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+					return repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrCustomFieldNotFound,
+		},
+		{
+			name:     "Delete internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupFieldRepo: func(t *testing.T, r *MockCustomFieldRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+					return errors.New("delete failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			fieldRepo := NewMockCustomFieldRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			if tt.setupFieldRepo != nil {
+				tt.setupFieldRepo(t, fieldRepo)
+			}
+
+			s := service.NewCustomField(fieldRepo, boardRepo)
+			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, validField.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -3,17 +3,50 @@ package service
 import "errors"
 
 var (
-	ErrInternal             = errors.New("internal error happened")
-	ErrBoardNotFound        = errors.New("board not found")
-	ErrColumnNotFound       = errors.New("column not found")
-	ErrTaskNotFound         = errors.New("task not found")
-	ErrIndexOutOfBounds     = errors.New("index out of bounds")
-	ErrUserAlreadyExists    = errors.New("user already exists")
-	ErrInvalidCredentials   = errors.New("invalid email or password")
-	ErrUserNotFound         = errors.New("user not found")
-	ErrInvalidToken         = errors.New("invalid token")
-	ErrTokenExpired         = errors.New("token expired")
-	ErrInvalidSigningMethod = errors.New("invalid signing method")
+	ErrInternal                  = errors.New("internal error happened")
+	ErrBoardNotFound             = errors.New("board not found")
+	ErrColumnNotFound            = errors.New("column not found")
+	ErrLaneNotFound              = errors.New("lane not found")
+	ErrTaskNotFound              = errors.New("task not found")
+	ErrBoardTemplateNotFound     = errors.New("board template not found")
+	ErrBoardImportNotFound       = errors.New("board import not found")
+	ErrTaskTemplateNotFound      = errors.New("task template not found")
+	ErrRecurrenceNotFound        = errors.New("recurrence not found")
+	ErrAutomationNotFound        = errors.New("automation not found")
+	ErrActionColumnNotFound      = errors.New("automation action column not found")
+	ErrTriggerFieldNotFound      = errors.New("automation trigger custom field not found")
+	ErrTriggerFieldType          = errors.New("automation trigger custom field type doesn't fit the trigger")
+	ErrTriggerLabelNotFound      = errors.New("automation trigger label is not an option of the field")
+	ErrActionFieldType           = errors.New("automation action custom field is not a select field")
+	ErrActionLabelNotFound       = errors.New("automation action label is not an option of the field")
+	ErrSprintNotFound            = errors.New("sprint not found")
+	ErrSprintCompleted           = errors.New("sprint is already completed")
+	ErrSprintDatesInvalid        = errors.New("sprint ends before it starts")
+	ErrBurndownRangeInvalid      = errors.New("burndown range ends before it starts")
+	ErrForecastTargetDate        = errors.New("forecast target date is outside the horizon")
+	ErrCustomFieldNotFound       = errors.New("custom field not found")
+	ErrCustomFieldOptionsInvalid = errors.New("custom field options don't fit the field type")
+	ErrCustomFieldValueInvalid   = errors.New("custom field value is invalid")
+	ErrTaskLinkNotFound          = errors.New("task link not found")
+	ErrLinkedTaskNotFound        = errors.New("linked task not found")
+	ErrTaskLinkAlreadyExists     = errors.New("task link already exists")
+	ErrTaskLinkCycle             = errors.New("task link creates a dependency cycle")
+	ErrTaskBlocked               = errors.New("task is blocked by unfinished tasks")
+	ErrTransitionNotAllowed      = errors.New("task transition is not allowed by the board workflow")
+	ErrTransitionColumnNotFound  = errors.New("transition target column not found")
+	ErrParentTaskNotFound        = errors.New("parent task not found")
+	ErrTaskParentCycle           = errors.New("task parent creates a cycle")
+	ErrTaskArchived              = errors.New("task is archived")
+	ErrIndexOutOfBounds          = errors.New("index out of bounds")
+	ErrVersionConflict           = errors.New("version doesn't match the current one")
+	ErrIdempotencyKeyInUse       = errors.New("request with the same idempotency key is in progress")
+	ErrIdempotencyKeyReused      = errors.New("idempotency key was used for a different request")
+	ErrUserAlreadyExists         = errors.New("user already exists")
+	ErrInvalidCredentials        = errors.New("invalid email or password")
+	ErrUserNotFound              = errors.New("user not found")
+	ErrInvalidToken              = errors.New("invalid token")
+	ErrTokenExpired              = errors.New("token expired")
+	ErrInvalidSigningMethod      = errors.New("invalid signing method")
 
 	ErrTelegramLinkTokenNotFound = errors.New("telegram link token not found")
 )
@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+const (
+	// BoardExportFormat names the JSON export so an import can tell it from other documents.
+	BoardExportFormat = "goroutine.board"
+	// BoardExportVersion is bumped whenever the JSON export changes in a way older imports cannot read.
+	BoardExportVersion = 1
+)
+
+type exportRepository interface {
+	Stream(
+		ctx context.Context,
+		boardID domain.BoardID,
+		begin func(domain.BoardSnapshot) error,
+		taskFn func(domain.Task) error,
+		linkFn func(domain.TaskLink) error,
+	) error
+}
+
+// BoardExport holds everything of a board but its tasks and links, which are streamed after it.
+type BoardExport struct {
+	Board        domain.Board
+	Columns      []domain.Column // Ordered by position.
+	Lanes        []domain.Lane   // Ordered by position.
+	CustomFields []domain.CustomField
+	Sprints      []domain.Sprint
+	ExportedAt   time.Time
+}
+
+// BoardExportWriter renders an export. Begin is called once, then Task for every task of the
+// board, archived ones included, ordered by column, lane and position, then Link for every link
+// between two tasks of the board, then End.
+type BoardExportWriter interface {
+	Begin(export *BoardExport) error
+	Task(task *domain.Task) error
+	Link(link *domain.TaskLink) error
+	End() error
+}
+
+type export struct {
+	exportRepo exportRepository
+}
+
+func NewExport(exportRepo exportRepository) *export {
+	return &export{exportRepo: exportRepo}
+}
+
+// Export writes the board to w. Everything is read from one snapshot of the board, and tasks and
+// links are passed on one by one as they are read, so the board is never held in memory as a
+// whole. Errors of w are returned wrapped in ErrInternal like the others; w is never called after
+// one of its methods fails.
+func (s *export) Export(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, w BoardExportWriter) error {
+	begin := func(snapshot domain.BoardSnapshot) error {
+		if snapshot.Board.OwnerID != callerID {
+			return ErrBoardNotFound
+		}
+
+		err := w.Begin(&BoardExport{
+			Board:        snapshot.Board,
+			Columns:      snapshot.Columns,
+			Lanes:        snapshot.Lanes,
+			CustomFields: snapshot.CustomFields,
+			Sprints:      snapshot.Sprints,
+			ExportedAt:   timeNow(),
+		})
+		if err != nil {
+			return fmt.Errorf("begin: %w", err)
+		}
+		return nil
+	}
+	taskFn := func(task domain.Task) error {
+		return w.Task(&task)
+	}
+	linkFn := func(link domain.TaskLink) error {
+		return w.Link(&link)
+	}
+
+	err := s.exportRepo.Stream(ctx, boardID, begin, taskFn, linkFn)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) || errors.Is(err, ErrBoardNotFound) {
+			return ErrBoardNotFound
+		}
+		return fmt.Errorf("export service: stream: %v: %w", err, ErrInternal)
+	}
+
+	err = w.End()
+	if err != nil {
+		return fmt.Errorf("export service: end: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
@@ -0,0 +1,223 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+// recordingExportWriter records the calls of an export in order.
+type recordingExportWriter struct {
+	export  *service.BoardExport
+	calls   []string
+	tasks   []domain.Task
+	links   []domain.TaskLink
+	failOn  string
+	failErr error
+}
+
+func (w *recordingExportWriter) record(call string) error {
+	w.calls = append(w.calls, call)
+	if call == w.failOn {
+		return w.failErr
+	}
+	return nil
+}
+
+func (w *recordingExportWriter) Begin(export *service.BoardExport) error {
+	w.export = export
+	return w.record("begin")
+}
+
+func (w *recordingExportWriter) Task(task *domain.Task) error {
+	w.tasks = append(w.tasks, *task)
+	return w.record("task")
+}
+
+func (w *recordingExportWriter) Link(link *domain.TaskLink) error {
+	w.links = append(w.links, *link)
+	return w.record("link")
+}
+
+func (w *recordingExportWriter) End() error {
+	return w.record("end")
+}
+
+func TestExport_Export(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	ownerID := validBoard.OwnerID
+	todo := testutil.NewValidColumn(t, validBoard.ID, "To do", 1)
+	done := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	lane := testutil.ValidLane(validBoard.ID)
+	field := testutil.ValidCustomField(validBoard.ID)
+	sprint := testutil.ValidSprint(validBoard.ID)
+	first := testutil.NewValidTask(t, todo.ID, "First", "", 1)
+	second := testutil.NewValidTask(t, done.ID, "Second", "", 1)
+	link := domain.TaskLink{ID: domain.NewTaskLinkID(), SourceID: first.ID, TargetID: second.ID, Type: domain.TaskLinkBlocks, CreatedAt: testutil.FixedNow()}
+
+	tests := []struct {
+		name        string
+		callerID    domain.UserID
+		readErr     error
+		tasksErr    error
+		linksErr    error
+		failOn      string
+		wantCalls   []string
+		wantTasks   []domain.Task
+		wantLinks   []domain.TaskLink
+		wantErr     error
+		wantStarted bool
+	}{
+		{
+			name:        "Success",
+			callerID:    ownerID,
+			wantCalls:   []string{"begin", "task", "task", "link", "end"},
+			wantTasks:   []domain.Task{first, second},
+			wantLinks:   []domain.TaskLink{link},
+			wantStarted: true,
+		},
+		{
+			name:     "Not owner",
+			callerID: domain.NewUserID(),
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Board not found",
+			callerID: ownerID,
+			readErr:  repository.ErrRowNotFound,
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Internal error from the board read",
+			callerID: ownerID,
+			readErr:  repository.ErrInternal,
+			wantErr:  service.ErrInternal,
+		},
+		{
+			name:        "Writer error stops the tasks",
+			callerID:    ownerID,
+			failOn:      "task",
+			wantCalls:   []string{"begin", "task"},
+			wantTasks:   []domain.Task{first},
+			wantErr:     service.ErrInternal,
+			wantStarted: true,
+		},
+		{
+			name:        "Writer error on begin",
+			callerID:    ownerID,
+			failOn:      "begin",
+			wantCalls:   []string{"begin"},
+			wantErr:     service.ErrInternal,
+			wantStarted: true,
+		},
+		{
+			name:        "Internal error from tasks",
+			callerID:    ownerID,
+			tasksErr:    repository.ErrInternal,
+			wantCalls:   []string{"begin", "task", "task"},
+			wantTasks:   []domain.Task{first, second},
+			wantErr:     service.ErrInternal,
+			wantStarted: true,
+		},
+		{
+			name:        "Internal error from links",
+			callerID:    ownerID,
+			linksErr:    repository.ErrInternal,
+			wantCalls:   []string{"begin", "task", "task", "link"},
+			wantTasks:   []domain.Task{first, second},
+			wantLinks:   []domain.TaskLink{link},
+			wantErr:     service.ErrInternal,
+			wantStarted: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			exportRepo := NewMockExportRepository(t)
+			exportRepo.StreamFunc = func(
+				ctx context.Context,
+				boardID domain.BoardID,
+				begin func(domain.BoardSnapshot) error,
+				taskFn func(domain.Task) error,
+				linkFn func(domain.TaskLink) error,
+			) error {
+				if boardID != validBoard.ID {
+					t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+				}
+				if tt.readErr != nil {
+					return tt.readErr
+				}
+				err := begin(domain.BoardSnapshot{
+					Board:        validBoard,
+					Columns:      []domain.Column{todo, done},
+					Lanes:        []domain.Lane{lane},
+					CustomFields: []domain.CustomField{field},
+					Sprints:      []domain.Sprint{sprint},
+				})
+				if err != nil {
+					return err
+				}
+				for _, task := range []domain.Task{first, second} {
+					err = taskFn(task)
+					if err != nil {
+						return err
+					}
+				}
+				if tt.tasksErr != nil {
+					return tt.tasksErr
+				}
+				err = linkFn(link)
+				if err != nil {
+					return err
+				}
+				return tt.linksErr
+			}
+
+			w := &recordingExportWriter{failOn: tt.failOn, failErr: errors.New("connection reset")}
+			s := service.NewExport(exportRepo)
+			err := s.Export(context.Background(), tt.callerID, validBoard.ID, w)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.wantCalls, w.calls); diff != "" {
+				t.Errorf("writer calls mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantTasks, w.tasks, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("tasks mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tt.wantLinks, w.links, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("links mismatch (-want +got):\n%s", diff)
+			}
+			if !tt.wantStarted {
+				return
+			}
+
+			if w.export.ExportedAt.IsZero() {
+				t.Error("got zero export time, want now")
+			}
+			wantExport := &service.BoardExport{
+				Board:        validBoard,
+				Columns:      []domain.Column{todo, done},
+				Lanes:        []domain.Lane{lane},
+				CustomFields: []domain.CustomField{field},
+				Sprints:      []domain.Sprint{sprint},
+				ExportedAt:   w.export.ExportedAt,
+			}
+			if diff := cmp.Diff(wantExport, w.export, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("export mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type idempotencyRepository interface {
+	GetResponse(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error)
+	Lock(ctx context.Context, req domain.IdempotentRequest) error
+	Unlock(ctx context.Context, req domain.IdempotentRequest) error
+	SaveResponse(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error
+}
+
+type idempotency struct {
+	repo         idempotencyRepository
+	pollInterval time.Duration
+}
+
+// NewIdempotency checks every pollInterval whether a request with the same key is over.
+func NewIdempotency(repo idempotencyRepository, pollInterval time.Duration) *idempotency {
+	return &idempotency{repo: repo, pollInterval: pollInterval}
+}
+
+// Begin returns the stored response and true when req is a retry of a request that has finished.
+// Otherwise it locks the key for req, which then runs and is passed to Finish. While a request
+// with the same key runs, Begin waits for it until ctx ends and fails with ErrIdempotencyKeyInUse.
+func (s *idempotency) Begin(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+	for {
+		resp, found, err := s.storedResponse(ctx, req)
+		if err != nil || found {
+			return resp, found, err
+		}
+
+		err = s.repo.Lock(ctx, req)
+		if err == nil {
+			// The request holding the lock before may have finished between the two calls.
+			resp, found, err = s.storedResponse(ctx, req)
+			if err == nil && !found {
+				return domain.IdempotentResponse{}, false, nil
+			}
+
+			unlockErr := s.repo.Unlock(ctx, req)
+			if err != nil {
+				return domain.IdempotentResponse{}, false, err
+			}
+			if unlockErr != nil {
+				return domain.IdempotentResponse{}, false, fmt.Errorf("idempotency service: begin: unlock: %v: %w", unlockErr, ErrInternal)
+			}
+			return resp, true, nil
+		}
+		if !errors.Is(err, repository.ErrKeyExists) {
+			return domain.IdempotentResponse{}, false, fmt.Errorf("idempotency service: begin: lock: %v: %w", err, ErrInternal)
+		}
+
+		select {
+		case <-ctx.Done():
+			return domain.IdempotentResponse{}, false, fmt.Errorf("idempotency service: begin: %v: %w", ctx.Err(), ErrIdempotencyKeyInUse)
+		case <-time.After(s.pollInterval):
+		}
+	}
+}
+
+func (s *idempotency) storedResponse(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, bool, error) {
+	resp, err := s.repo.GetResponse(ctx, req)
+	if err != nil {
+		if errors.Is(err, repository.ErrKeyNotFound) {
+			return domain.IdempotentResponse{}, false, nil
+		}
+		return domain.IdempotentResponse{}, false, fmt.Errorf("idempotency service: get response: %v: %w", err, ErrInternal)
+	}
+
+	if resp.Fingerprint != req.Fingerprint {
+		return domain.IdempotentResponse{}, false, ErrIdempotencyKeyReused
+	}
+
+	return resp, true, nil
+}
+
+// Finish stores the response of a request Begin let through, to be replayed to its retries, and
+// releases the key. Server errors and timeouts don't tell whether the request took effect, so
+// they are not stored and a retry runs the request again.
+func (s *idempotency) Finish(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+	if resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusRequestTimeout ||
+		resp.StatusCode == statusClientClosedRequest {
+		err := s.repo.Unlock(ctx, req)
+		if err != nil {
+			return fmt.Errorf("idempotency service: finish: unlock: %v: %w", err, ErrInternal)
+		}
+		return nil
+	}
+
+	resp.Fingerprint = req.Fingerprint
+	err := s.repo.SaveResponse(ctx, req, resp)
+	if err != nil {
+		return fmt.Errorf("idempotency service: finish: save response: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// statusClientClosedRequest is the non-standard status the error responder answers a canceled
+// request with.
+const statusClientClosedRequest = 499
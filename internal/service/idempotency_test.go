@@ -0,0 +1,302 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestIdempotency_Begin(t *testing.T) {
+	t.Parallel()
+
+	validReq := testutil.ValidIdempotentRequest()
+	storedResp := domain.IdempotentResponse{
+		Fingerprint: validReq.Fingerprint,
+		StatusCode:  http.StatusCreated,
+		Header:      map[string]string{"Content-Type": "application/json"},
+		Body:        []byte(`{"id":"task"}`),
+	}
+	notFound := func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+		return domain.IdempotentResponse{}, repository.ErrKeyNotFound
+	}
+
+	tests := []struct {
+		name         string
+		timeout      time.Duration
+		setupRepo    func(r *MockIdempotencyRepository)
+		wantResp     domain.IdempotentResponse
+		wantReplayed bool
+		wantErr      error
+	}{
+		{
+			name: "Success replays finished request",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.GetResponseFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+					if diff := cmp.Diff(validReq, req, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("request mismatch (-want +got):\n%s", diff)
+					}
+					return storedResp, nil
+				}
+			},
+			wantResp:     storedResp,
+			wantReplayed: true,
+		},
+		{
+			name: "Success locks new request",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.GetResponseFunc = notFound
+				r.LockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					if diff := cmp.Diff(validReq, req, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("request mismatch (-want +got):\n%s", diff)
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name: "Success replays request finished while locking",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				calls := 0
+				r.GetResponseFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+					calls++
+					if calls == 1 {
+						return domain.IdempotentResponse{}, repository.ErrKeyNotFound
+					}
+					return storedResp, nil
+				}
+				r.LockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return nil
+				}
+				r.UnlockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return nil
+				}
+			},
+			wantResp:     storedResp,
+			wantReplayed: true,
+		},
+		{
+			name: "Success waits for running request",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				calls := 0
+				r.GetResponseFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+					calls++
+					if calls < 3 {
+						return domain.IdempotentResponse{}, repository.ErrKeyNotFound
+					}
+					return storedResp, nil
+				}
+				r.LockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return repository.ErrKeyExists
+				}
+			},
+			wantResp:     storedResp,
+			wantReplayed: true,
+		},
+		{
+			name:    "Key in use until context ends",
+			timeout: 20 * time.Millisecond,
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.GetResponseFunc = notFound
+				r.LockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return repository.ErrKeyExists
+				}
+			},
+			wantErr: service.ErrIdempotencyKeyInUse,
+		},
+		{
+			name: "Key reused for a different request",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.GetResponseFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+					resp := storedResp
+					resp.Fingerprint = "another"
+					return resp, nil
+				}
+			},
+			wantErr: service.ErrIdempotencyKeyReused,
+		},
+		{
+			name: "Get response error",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.GetResponseFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+					return domain.IdempotentResponse{}, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name: "Lock error",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.GetResponseFunc = notFound
+				r.LockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return errors.New("redis exploded")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name: "Unlock error after request finished while locking",
+			setupRepo: func(r *MockIdempotencyRepository) {
+				calls := 0
+				r.GetResponseFunc = func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+					calls++
+					if calls == 1 {
+						return domain.IdempotentResponse{}, repository.ErrKeyNotFound
+					}
+					return storedResp, nil
+				}
+				r.LockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return nil
+				}
+				r.UnlockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewMockIdempotencyRepository(t)
+			tt.setupRepo(r)
+			s := service.NewIdempotency(r, time.Millisecond)
+
+			timeout := tt.timeout
+			if timeout == 0 {
+				timeout = time.Second
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), timeout)
+			defer cancel()
+
+			gotResp, gotReplayed, err := s.Begin(ctx, validReq)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if gotReplayed != tt.wantReplayed {
+				t.Errorf("got replayed %t, want %t", gotReplayed, tt.wantReplayed)
+			}
+			if diff := cmp.Diff(tt.wantResp, gotResp); diff != "" {
+				t.Errorf("response mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIdempotency_Finish(t *testing.T) {
+	t.Parallel()
+
+	validReq := testutil.ValidIdempotentRequest()
+	createdResp := domain.IdempotentResponse{
+		StatusCode: http.StatusCreated,
+		Header:     map[string]string{"Content-Type": "application/json"},
+		Body:       []byte(`{"id":"task"}`),
+	}
+	wantSaved := createdResp
+	wantSaved.Fingerprint = validReq.Fingerprint
+
+	tests := []struct {
+		name      string
+		resp      domain.IdempotentResponse
+		setupRepo func(r *MockIdempotencyRepository)
+		wantErr   error
+	}{
+		{
+			name: "Success saves response",
+			resp: createdResp,
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.SaveResponseFunc = func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+					if diff := cmp.Diff(validReq, req, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("request mismatch (-want +got):\n%s", diff)
+					}
+					if diff := cmp.Diff(wantSaved, resp); diff != "" {
+						t.Errorf("response mismatch (-want +got):\n%s", diff)
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name: "Success saves client error",
+			resp: domain.IdempotentResponse{StatusCode: http.StatusBadRequest},
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.SaveResponseFunc = func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "Success unlocks after server error",
+			resp: domain.IdempotentResponse{StatusCode: http.StatusInternalServerError},
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.UnlockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "Success unlocks after timeout",
+			resp: domain.IdempotentResponse{StatusCode: http.StatusRequestTimeout},
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.UnlockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "Success unlocks after client closed request",
+			resp: domain.IdempotentResponse{StatusCode: 499},
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.UnlockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return nil
+				}
+			},
+		},
+		{
+			name: "Save error",
+			resp: createdResp,
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.SaveResponseFunc = func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+					return repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name: "Unlock error",
+			resp: domain.IdempotentResponse{StatusCode: http.StatusBadGateway},
+			setupRepo: func(r *MockIdempotencyRepository) {
+				r.UnlockFunc = func(ctx context.Context, req domain.IdempotentRequest) error {
+					return repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := NewMockIdempotencyRepository(t)
+			tt.setupRepo(r)
+			s := service.NewIdempotency(r, time.Millisecond)
+
+			err := s.Finish(context.Background(), validReq, tt.resp)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
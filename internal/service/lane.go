@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type laneRepository interface {
+	Create(ctx context.Context, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error)
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error)
+	Get(ctx context.Context, laneID domain.LaneID) (domain.Lane, error)
+	Update(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error)
+	Move(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, targetPosition domain.LanePosition) (domain.LanePosition, error)
+	Delete(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error
+}
+
+type laneBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type lane struct {
+	laneRepo  laneRepository
+	boardRepo laneBoardRepository
+}
+
+func NewLane(laneRepo laneRepository, boardRepo laneBoardRepository) *lane {
+	return &lane{laneRepo: laneRepo, boardRepo: boardRepo}
+}
+
+func (s *lane) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Lane{}, ErrBoardNotFound
+		}
+		return domain.Lane{}, fmt.Errorf("lane service: create get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Lane{}, ErrBoardNotFound
+	}
+
+	lane, err := s.laneRepo.Create(ctx, boardID, name)
+	if err != nil {
+		return domain.Lane{}, fmt.Errorf("lane service: create: %v: %w", err, ErrInternal)
+	}
+
+	return lane, nil
+}
+
+func (s *lane) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Lane, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("lane service: list by board id get board: %v: %w", err, ErrInternal)
+	}
+
+	if board.OwnerID != callerID {
+		return nil, ErrBoardNotFound
+	}
+
+	lanes, err := s.laneRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("lane service: list by board id: %v: %w", err, ErrInternal)
+	}
+
+	return lanes, nil
+}
+
+func (s *lane) Update(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	laneID domain.LaneID,
+	name *domain.LaneName,
+) (domain.Lane, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Lane{}, ErrLaneNotFound
+		}
+		return domain.Lane{}, fmt.Errorf("lane service: update get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Lane{}, ErrLaneNotFound
+	}
+
+	lane, err := s.laneRepo.Get(ctx, laneID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Lane{}, ErrLaneNotFound
+		}
+		return domain.Lane{}, fmt.Errorf("lane service: update get lane: %v: %w", err, ErrInternal)
+	}
+	if lane.BoardID != boardID {
+		return domain.Lane{}, ErrLaneNotFound
+	}
+
+	if name == nil {
+		return lane, nil
+	}
+
+	updated, err := s.laneRepo.Update(ctx, boardID, laneID, name)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Lane{}, ErrLaneNotFound
+		}
+		return domain.Lane{}, fmt.Errorf("lane service: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+func (s *lane) Delete(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	laneID domain.LaneID,
+) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrLaneNotFound
+		}
+		return fmt.Errorf("lane service: delete get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return ErrLaneNotFound
+	}
+
+	err = s.laneRepo.Delete(ctx, boardID, laneID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrLaneNotFound
+		}
+		return fmt.Errorf("lane service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+func (s *lane) Move(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	laneID domain.LaneID,
+	targetPosition domain.LanePosition,
+) (domain.LanePosition, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.LanePosition{}, ErrLaneNotFound
+		}
+		return domain.LanePosition{}, fmt.Errorf("lane service: move get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.LanePosition{}, ErrLaneNotFound
+	}
+
+	lane, err := s.laneRepo.Get(ctx, laneID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.LanePosition{}, ErrLaneNotFound
+		}
+		return domain.LanePosition{}, fmt.Errorf("lane service: move get lane: %v: %w", err, ErrInternal)
+	}
+	if lane.BoardID != boardID {
+		return domain.LanePosition{}, ErrLaneNotFound
+	}
+
+	position, err := s.laneRepo.Move(ctx, boardID, laneID, targetPosition)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.LanePosition{}, ErrLaneNotFound
+		}
+		if errors.Is(err, repository.ErrIndexOutOfBounds) {
+			return domain.LanePosition{}, ErrIndexOutOfBounds
+		}
+		return domain.LanePosition{}, fmt.Errorf("lane service: move: %v: %w", err, ErrInternal)
+	}
+
+	return position, nil
+}
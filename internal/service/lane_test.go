@@ -0,0 +1,404 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestLane_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		setupBoardRepo func(t *testing.T, r *MockBoardRepository)
+		setupLaneRepo  func(t *testing.T, r *MockLaneRepository)
+		wantErr        error
+		wantLane       domain.Lane
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					if id != validBoard.ID {
+						t.Errorf("got board id %v, want %v", id, validBoard.ID)
+					}
+					return validBoard, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.CreateFunc = func(ctx context.Context, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if name != validLane.Name {
+						t.Errorf("got name %v, want %v", name, validLane.Name)
+					}
+					return validLane, nil
+				}
+			},
+			wantLane: validLane,
+		},
+		{
+			name:     "Board not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Create internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.CreateFunc = func(ctx context.Context, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+					return domain.Lane{}, errors.New("insert failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			laneRepo := NewMockLaneRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			if tt.setupLaneRepo != nil {
+				tt.setupLaneRepo(t, laneRepo)
+			}
+
+			s := service.NewLane(laneRepo, boardRepo)
+			got, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validLane.Name)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantLane, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Create() lane mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLane_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+	otherBoardLane := testutil.ValidLane(domain.NewBoardID())
+	newName, err := domain.NewLaneName("Support")
+	if err != nil {
+		t.Fatalf("NewLaneName() error = %v", err)
+	}
+	renamedLane := validLane
+	renamedLane.Name = newName
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		inputName      *domain.LaneName
+		setupBoardRepo func(t *testing.T, r *MockBoardRepository)
+		setupLaneRepo  func(t *testing.T, r *MockLaneRepository)
+		wantErr        error
+		wantLane       domain.Lane
+	}{
+		{
+			name:      "Success",
+			callerID:  validBoard.OwnerID,
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return validLane, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error) {
+					if laneID != validLane.ID {
+						t.Errorf("got lane id %v, want %v", laneID, validLane.ID)
+					}
+					if name == nil || *name != newName {
+						t.Errorf("got name %v, want %v", name, newName)
+					}
+					return renamedLane, nil
+				}
+			},
+			wantLane: renamedLane,
+		},
+		{
+			name:     "No fields returns current lane",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return validLane, nil
+				}
+			},
+			wantLane: validLane,
+		},
+		{
+			name:      "Caller has no access",
+			callerID:  domain.NewUserID(),
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrLaneNotFound,
+		},
+		{
+			name:      "Lane of another board",
+			callerID:  validBoard.OwnerID,
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return otherBoardLane, nil
+				}
+			},
+			wantErr: service.ErrLaneNotFound,
+		},
+		{
+			name:      "Lane not found",
+			callerID:  validBoard.OwnerID,
+			inputName: &newName,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return domain.Lane{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrLaneNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			laneRepo := NewMockLaneRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			if tt.setupLaneRepo != nil {
+				tt.setupLaneRepo(t, laneRepo)
+			}
+
+			s := service.NewLane(laneRepo, boardRepo)
+			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, validLane.ID, tt.inputName)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantLane, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Update() lane mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestLane_Move(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+	targetPosition, err := domain.NewLanePosition(2)
+	if err != nil {
+		t.Fatalf("NewLanePosition() error = %v", err)
+	}
+
+	tests := []struct {
+		name          string
+		setupLaneRepo func(t *testing.T, r *MockLaneRepository)
+		wantErr       error
+	}{
+		{
+			name: "Success",
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return validLane, nil
+				}
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, gotTargetPosition domain.LanePosition) (domain.LanePosition, error) {
+					if gotTargetPosition != targetPosition {
+						t.Errorf("got target position %v, want %v", gotTargetPosition, targetPosition)
+					}
+					return targetPosition, nil
+				}
+			},
+		},
+		{
+			name: "Index out of bounds",
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return validLane, nil
+				}
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, gotTargetPosition domain.LanePosition) (domain.LanePosition, error) {
+					return domain.LanePosition{}, repository.ErrIndexOutOfBounds
+				}
+			},
+			wantErr: service.ErrIndexOutOfBounds,
+		},
+		{
+			name: "Move internal error",
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return validLane, nil
+				}
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, gotTargetPosition domain.LanePosition) (domain.LanePosition, error) {
+					return domain.LanePosition{}, errors.New("update failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			laneRepo := NewMockLaneRepository(t)
+			tt.setupLaneRepo(t, laneRepo)
+
+			s := service.NewLane(laneRepo, boardRepo)
+			got, err := s.Move(context.Background(), validBoard.OwnerID, validBoard.ID, validLane.ID, targetPosition)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil && got != targetPosition {
+				t.Errorf("Move() position = %v, want %v", got, targetPosition)
+			}
+		})
+	}
+}
+
+func TestLane_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validLane := testutil.ValidLane(validBoard.ID)
+
+	tests := []struct {
+		name          string
+		callerID      domain.UserID
+		setupLaneRepo func(t *testing.T, r *MockLaneRepository)
+		wantErr       error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if laneID != validLane.ID {
+						t.Errorf("got lane id %v, want %v", laneID, validLane.ID)
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			wantErr:  service.ErrLaneNotFound,
+		},
+		{
+			name:     "Lane not found",
+			callerID: validBoard.OwnerID,
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error {
+					return repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrLaneNotFound,
+		},
+		{
+			name:     "Delete internal error",
+			callerID: validBoard.OwnerID,
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error {
+					return errors.New("delete failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			laneRepo := NewMockLaneRepository(t)
+			if tt.setupLaneRepo != nil {
+				tt.setupLaneRepo(t, laneRepo)
+			}
+
+			s := service.NewLane(laneRepo, boardRepo)
+			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, validLane.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
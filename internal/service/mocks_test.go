@@ -2,7 +2,9 @@ package service_test
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
+	"time"
 
 	"goroutine/internal/domain"
 	"goroutine/internal/testutil"
@@ -13,6 +15,7 @@ type MockUserRepository struct {
 
 	CreateFunc             func(ctx context.Context, email domain.Email, hash domain.PasswordHash) error
 	GetByEmailFunc         func(ctx context.Context, email domain.Email) (domain.User, error)
+	GetFunc                func(ctx context.Context, userID domain.UserID) (domain.User, error)
 	UpdateTelegramInfoFunc func(ctx context.Context, userID domain.UserID, chatID domain.TelegramChatID, username domain.TelegramUsername) error
 }
 
@@ -30,6 +33,11 @@ func (m *MockUserRepository) GetByEmail(ctx context.Context, email domain.Email)
 	return m.GetByEmailFunc(ctx, email)
 }
 
+func (m *MockUserRepository) Get(ctx context.Context, userID domain.UserID) (domain.User, error) {
+	testutil.AssertFuncNotNil(m.t, "UserRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, userID)
+}
+
 func (m *MockUserRepository) UpdateTelegramInfo(ctx context.Context, userID domain.UserID, chatID domain.TelegramChatID, username domain.TelegramUsername) error {
 	testutil.AssertFuncNotNil(m.t, "UserRepository.UpdateTelegramInfoFunc", m.UpdateTelegramInfoFunc)
 	return m.UpdateTelegramInfoFunc(ctx, userID, chatID, username)
@@ -56,17 +64,246 @@ func (m *MockTelegramTokenRepository) ConsumeTelegramLinkToken(ctx context.Conte
 	return m.ConsumeTelegramLinkTokenFunc(ctx, token)
 }
 
+type MockIdempotencyRepository struct {
+	t *testing.T
+
+	GetResponseFunc  func(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error)
+	LockFunc         func(ctx context.Context, req domain.IdempotentRequest) error
+	UnlockFunc       func(ctx context.Context, req domain.IdempotentRequest) error
+	SaveResponseFunc func(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error
+}
+
+func NewMockIdempotencyRepository(t *testing.T) *MockIdempotencyRepository {
+	return &MockIdempotencyRepository{t: t}
+}
+
+func (m *MockIdempotencyRepository) GetResponse(ctx context.Context, req domain.IdempotentRequest) (domain.IdempotentResponse, error) {
+	testutil.AssertFuncNotNil(m.t, "IdempotencyRepository.GetResponseFunc", m.GetResponseFunc)
+	return m.GetResponseFunc(ctx, req)
+}
+
+func (m *MockIdempotencyRepository) Lock(ctx context.Context, req domain.IdempotentRequest) error {
+	testutil.AssertFuncNotNil(m.t, "IdempotencyRepository.LockFunc", m.LockFunc)
+	return m.LockFunc(ctx, req)
+}
+
+func (m *MockIdempotencyRepository) Unlock(ctx context.Context, req domain.IdempotentRequest) error {
+	testutil.AssertFuncNotNil(m.t, "IdempotencyRepository.UnlockFunc", m.UnlockFunc)
+	return m.UnlockFunc(ctx, req)
+}
+
+func (m *MockIdempotencyRepository) SaveResponse(ctx context.Context, req domain.IdempotentRequest, resp domain.IdempotentResponse) error {
+	testutil.AssertFuncNotNil(m.t, "IdempotencyRepository.SaveResponseFunc", m.SaveResponseFunc)
+	return m.SaveResponseFunc(ctx, req, resp)
+}
+
+type MockBoardEventRepository struct {
+	t *testing.T
+
+	HeadFunc      func(ctx context.Context) (domain.BoardEventCursor, error)
+	ListAfterFunc func(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor, limit int) ([]domain.BoardEvent, error)
+	ListenFunc    func(ctx context.Context, notify func(domain.BoardID)) error
+}
+
+func NewMockBoardEventRepository(t *testing.T) *MockBoardEventRepository {
+	return &MockBoardEventRepository{t: t}
+}
+
+func (m *MockBoardEventRepository) Head(ctx context.Context) (domain.BoardEventCursor, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardEventRepository.HeadFunc", m.HeadFunc)
+	return m.HeadFunc(ctx)
+}
+
+func (m *MockBoardEventRepository) ListAfter(ctx context.Context, boardID domain.BoardID, after domain.BoardEventCursor, limit int) ([]domain.BoardEvent, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardEventRepository.ListAfterFunc", m.ListAfterFunc)
+	return m.ListAfterFunc(ctx, boardID, after, limit)
+}
+
+func (m *MockBoardEventRepository) Listen(ctx context.Context, notify func(domain.BoardID)) error {
+	testutil.AssertFuncNotNil(m.t, "BoardEventRepository.ListenFunc", m.ListenFunc)
+	return m.ListenFunc(ctx, notify)
+}
+
+type MockSyncRepository struct {
+	t *testing.T
+
+	SnapshotFunc     func(ctx context.Context, ownerID domain.UserID) (domain.SyncChanges, error)
+	ChangesAfterFunc func(ctx context.Context, ownerID domain.UserID, after domain.BoardEventCursor, limit int) (domain.SyncChanges, error)
+}
+
+func NewMockSyncRepository(t *testing.T) *MockSyncRepository {
+	return &MockSyncRepository{t: t}
+}
+
+func (m *MockSyncRepository) Snapshot(ctx context.Context, ownerID domain.UserID) (domain.SyncChanges, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncRepository.SnapshotFunc", m.SnapshotFunc)
+	return m.SnapshotFunc(ctx, ownerID)
+}
+
+func (m *MockSyncRepository) ChangesAfter(ctx context.Context, ownerID domain.UserID, after domain.BoardEventCursor, limit int) (domain.SyncChanges, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncRepository.ChangesAfterFunc", m.ChangesAfterFunc)
+	return m.ChangesAfterFunc(ctx, ownerID, after, limit)
+}
+
+type MockExportRepository struct {
+	t *testing.T
+
+	StreamFunc func(ctx context.Context, boardID domain.BoardID, begin func(domain.BoardSnapshot) error, taskFn func(domain.Task) error, linkFn func(domain.TaskLink) error) error
+}
+
+func NewMockExportRepository(t *testing.T) *MockExportRepository {
+	return &MockExportRepository{t: t}
+}
+
+func (m *MockExportRepository) Stream(ctx context.Context, boardID domain.BoardID, begin func(domain.BoardSnapshot) error, taskFn func(domain.Task) error, linkFn func(domain.TaskLink) error) error {
+	testutil.AssertFuncNotNil(m.t, "ExportRepository.StreamFunc", m.StreamFunc)
+	return m.StreamFunc(ctx, boardID, begin, taskFn, linkFn)
+}
+
+type MockSyncBoardService struct {
+	t *testing.T
+
+	CreateFunc func(ctx context.Context, callerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
+	GetFunc    func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.Board, error)
+	UpdateFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error)
+	DeleteFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error
+}
+
+func NewMockSyncBoardService(t *testing.T) *MockSyncBoardService {
+	return &MockSyncBoardService{t: t}
+}
+
+func (m *MockSyncBoardService) Create(ctx context.Context, callerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncBoardService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, name, description)
+}
+
+func (m *MockSyncBoardService) Get(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.Board, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncBoardService.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, callerID, boardID)
+}
+
+func (m *MockSyncBoardService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncBoardService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, name, description, ifMatch)
+}
+
+func (m *MockSyncBoardService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
+	testutil.AssertFuncNotNil(m.t, "SyncBoardService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, ifMatch)
+}
+
+type MockSyncColumnService struct {
+	t *testing.T
+
+	CreateFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error)
+	UpdateFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error)
+	MoveFunc   func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error)
+	DeleteFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error
+}
+
+func NewMockSyncColumnService(t *testing.T) *MockSyncColumnService {
+	return &MockSyncColumnService{t: t}
+}
+
+func (m *MockSyncColumnService) Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncColumnService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, name, description)
+}
+
+func (m *MockSyncColumnService) Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncColumnService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, columnID, patch, ifMatch)
+}
+
+func (m *MockSyncColumnService) Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncColumnService.MoveFunc", m.MoveFunc)
+	return m.MoveFunc(ctx, callerID, boardID, columnID, targetPosition, ifMatch)
+}
+
+func (m *MockSyncColumnService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error {
+	testutil.AssertFuncNotNil(m.t, "SyncColumnService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, columnID, ifMatch)
+}
+
+type MockSyncTaskService struct {
+	t *testing.T
+
+	CreateFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error)
+	UpdateFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error)
+	MoveFunc   func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error)
+	DeleteFunc func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error
+}
+
+func NewMockSyncTaskService(t *testing.T) *MockSyncTaskService {
+	return &MockSyncTaskService{t: t}
+}
+
+func (m *MockSyncTaskService) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	laneID domain.LaneID,
+	parentID domain.TaskID,
+	name domain.TaskName,
+	description domain.TaskDescription,
+	checklist domain.TaskChecklist,
+	estimate domain.TaskEstimate,
+	customFields map[domain.CustomFieldID]json.RawMessage,
+) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncTaskService.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, callerID, boardID, columnID, laneID, parentID, name, description, checklist, estimate, customFields)
+}
+
+func (m *MockSyncTaskService) Update(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	name *domain.TaskName,
+	description *domain.TaskDescription,
+	checklist *domain.TaskChecklist,
+	estimate *domain.TaskEstimate,
+	customFields map[domain.CustomFieldID]json.RawMessage,
+	ifMatch domain.Version,
+) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncTaskService.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, callerID, boardID, columnID, taskID, name, description, checklist, estimate, customFields, ifMatch)
+}
+
+func (m *MockSyncTaskService) Move(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	targetColumnID domain.ColumnID,
+	targetLaneID *domain.LaneID,
+	targetPosition domain.TaskPosition,
+	ifMatch domain.Version,
+) (domain.TaskPlacement, error) {
+	testutil.AssertFuncNotNil(m.t, "SyncTaskService.MoveFunc", m.MoveFunc)
+	return m.MoveFunc(ctx, callerID, boardID, columnID, taskID, targetColumnID, targetLaneID, targetPosition, ifMatch)
+}
+
+func (m *MockSyncTaskService) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error {
+	testutil.AssertFuncNotNil(m.t, "SyncTaskService.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, callerID, boardID, columnID, taskID, cascade, ifMatch)
+}
+
 type MockTelegramNotifier struct {
 	t *testing.T
 
-	NotifyFunc func(ctx context.Context, chatID domain.TelegramChatID, text string) error
+	NotifyFunc func(ctx context.Context, chatID domain.TelegramChatID, text domain.TelegramMessage) error
 }
 
 func NewMockTelegramNotifier(t *testing.T) *MockTelegramNotifier {
 	return &MockTelegramNotifier{t: t}
 }
 
-func (m *MockTelegramNotifier) Notify(ctx context.Context, chatID domain.TelegramChatID, text string) error {
+func (m *MockTelegramNotifier) Notify(ctx context.Context, chatID domain.TelegramChatID, text domain.TelegramMessage) error {
 	testutil.AssertFuncNotNil(m.t, "TelegramNotifier.NotifyFunc", m.NotifyFunc)
 	return m.NotifyFunc(ctx, chatID, text)
 }
@@ -74,11 +311,13 @@ func (m *MockTelegramNotifier) Notify(ctx context.Context, chatID domain.Telegra
 type MockBoardRepository struct {
 	t *testing.T
 
-	CreateFunc        func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
-	GetFunc           func(ctx context.Context, id domain.BoardID) (domain.Board, error)
-	ListByOwnerIDFunc func(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error)
-	UpdateFunc        func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error)
-	DeleteFunc        func(ctx context.Context, boardID domain.BoardID) error
+	CreateFunc            func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
+	CreateWithColumnsFunc func(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, columns []domain.BoardTemplateColumn) (domain.Board, error)
+	GetFunc               func(ctx context.Context, id domain.BoardID) (domain.Board, error)
+	ListByOwnerIDFunc     func(ctx context.Context, ownerID domain.UserID) ([]domain.Board, error)
+	UpdateFunc            func(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error)
+	DeleteFunc            func(ctx context.Context, boardID domain.BoardID, expected domain.Version) error
+	DuplicateFunc         func(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
 }
 
 func NewMockBoardRepository(t *testing.T) *MockBoardRepository {
@@ -91,9 +330,10 @@ type MockColumnRepository struct {
 	CreateFunc        func(ctx context.Context, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error)
 	ListByBoardIDFunc func(ctx context.Context, boardID domain.BoardID) ([]domain.Column, error)
 	GetFunc           func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error)
-	UpdateFunc        func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, name *domain.ColumnName, description *domain.ColumnDescription) (domain.Column, error)
-	MoveFunc          func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition) (domain.ColumnPosition, error)
-	DeleteFunc        func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error
+	UpdateFunc        func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error)
+	MoveFunc          func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, expected domain.Version) (domain.ColumnPlacement, error)
+	DeleteFunc        func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error
+	DuplicateFunc     func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error)
 }
 
 func NewMockColumnRepository(t *testing.T) *MockColumnRepository {
@@ -105,6 +345,11 @@ func (m *MockBoardRepository) Create(ctx context.Context, ownerID domain.UserID,
 	return m.CreateFunc(ctx, ownerID, name, description)
 }
 
+func (m *MockBoardRepository) CreateWithColumns(ctx context.Context, ownerID domain.UserID, name domain.BoardName, description domain.BoardDescription, columns []domain.BoardTemplateColumn) (domain.Board, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardRepository.CreateWithColumnsFunc", m.CreateWithColumnsFunc)
+	return m.CreateWithColumnsFunc(ctx, ownerID, name, description, columns)
+}
+
 func (m *MockBoardRepository) Get(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 	testutil.AssertFuncNotNil(m.t, "BoardRepository.GetFunc", m.GetFunc)
 	return m.GetFunc(ctx, id)
@@ -115,14 +360,19 @@ func (m *MockBoardRepository) ListByOwnerID(ctx context.Context, ownerID domain.
 	return m.ListByOwnerIDFunc(ctx, ownerID)
 }
 
-func (m *MockBoardRepository) Update(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription) (domain.Board, error) {
+func (m *MockBoardRepository) Update(ctx context.Context, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, expected domain.Version) (domain.Board, error) {
 	testutil.AssertFuncNotNil(m.t, "BoardRepository.UpdateFunc", m.UpdateFunc)
-	return m.UpdateFunc(ctx, boardID, name, description)
+	return m.UpdateFunc(ctx, boardID, name, description, expected)
 }
 
-func (m *MockBoardRepository) Delete(ctx context.Context, boardID domain.BoardID) error {
+func (m *MockBoardRepository) Delete(ctx context.Context, boardID domain.BoardID, expected domain.Version) error {
 	testutil.AssertFuncNotNil(m.t, "BoardRepository.DeleteFunc", m.DeleteFunc)
-	return m.DeleteFunc(ctx, boardID)
+	return m.DeleteFunc(ctx, boardID, expected)
+}
+
+func (m *MockBoardRepository) Duplicate(ctx context.Context, boardID domain.BoardID) (domain.Board, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardRepository.DuplicateFunc", m.DuplicateFunc)
+	return m.DuplicateFunc(ctx, boardID)
 }
 
 func (m *MockColumnRepository) Create(
@@ -145,15 +395,9 @@ func (m *MockColumnRepository) Get(ctx context.Context, columnID domain.ColumnID
 	return m.GetFunc(ctx, columnID)
 }
 
-func (m *MockColumnRepository) Update(
-	ctx context.Context,
-	boardID domain.BoardID,
-	columnID domain.ColumnID,
-	name *domain.ColumnName,
-	description *domain.ColumnDescription,
-) (domain.Column, error) {
+func (m *MockColumnRepository) Update(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, expected domain.Version) (domain.Column, error) {
 	testutil.AssertFuncNotNil(m.t, "ColumnRepository.UpdateFunc", m.UpdateFunc)
-	return m.UpdateFunc(ctx, boardID, columnID, name, description)
+	return m.UpdateFunc(ctx, boardID, columnID, patch, expected)
 }
 
 func (m *MockColumnRepository) Move(
@@ -161,26 +405,85 @@ func (m *MockColumnRepository) Move(
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
 	targetPosition domain.ColumnPosition,
-) (domain.ColumnPosition, error) {
+	expected domain.Version,
+) (domain.ColumnPlacement, error) {
 	testutil.AssertFuncNotNil(m.t, "ColumnRepository.MoveFunc", m.MoveFunc)
-	return m.MoveFunc(ctx, boardID, columnID, targetPosition)
+	return m.MoveFunc(ctx, boardID, columnID, targetPosition, expected)
 }
 
-func (m *MockColumnRepository) Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+func (m *MockColumnRepository) Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, expected domain.Version) error {
 	testutil.AssertFuncNotNil(m.t, "ColumnRepository.DeleteFunc", m.DeleteFunc)
-	return m.DeleteFunc(ctx, boardID, columnID)
+	return m.DeleteFunc(ctx, boardID, columnID, expected)
+}
+
+func (m *MockColumnRepository) Duplicate(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) (domain.Column, error) {
+	testutil.AssertFuncNotNil(m.t, "ColumnRepository.DuplicateFunc", m.DuplicateFunc)
+	return m.DuplicateFunc(ctx, boardID, columnID)
+}
+
+type MockLaneRepository struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error)
+	ListByBoardIDFunc func(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error)
+	GetFunc           func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error)
+	UpdateFunc        func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error)
+	MoveFunc          func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, targetPosition domain.LanePosition) (domain.LanePosition, error)
+	DeleteFunc        func(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error
+}
+
+func NewMockLaneRepository(t *testing.T) *MockLaneRepository {
+	return &MockLaneRepository{t: t}
+}
+
+func (m *MockLaneRepository) Create(ctx context.Context, boardID domain.BoardID, name domain.LaneName) (domain.Lane, error) {
+	testutil.AssertFuncNotNil(m.t, "LaneRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, boardID, name)
+}
+
+func (m *MockLaneRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Lane, error) {
+	testutil.AssertFuncNotNil(m.t, "LaneRepository.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, boardID)
+}
+
+func (m *MockLaneRepository) Get(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+	testutil.AssertFuncNotNil(m.t, "LaneRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, laneID)
+}
+
+func (m *MockLaneRepository) Update(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, name *domain.LaneName) (domain.Lane, error) {
+	testutil.AssertFuncNotNil(m.t, "LaneRepository.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, boardID, laneID, name)
+}
+
+func (m *MockLaneRepository) Move(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID, targetPosition domain.LanePosition) (domain.LanePosition, error) {
+	testutil.AssertFuncNotNil(m.t, "LaneRepository.MoveFunc", m.MoveFunc)
+	return m.MoveFunc(ctx, boardID, laneID, targetPosition)
+}
+
+func (m *MockLaneRepository) Delete(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error {
+	testutil.AssertFuncNotNil(m.t, "LaneRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, boardID, laneID)
 }
 
 type MockTaskRepository struct {
 	t *testing.T
 
-	CreateFunc         func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error)
-	ListByBoardIDFunc  func(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error)
-	ListByColumnIDFunc func(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error)
-	GetFunc            func(ctx context.Context, taskID domain.TaskID) (domain.Task, error)
-	UpdateFunc         func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error)
-	MoveFunc           func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error)
-	DeleteFunc         func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error
+	CreateFunc            func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error)
+	ListByBoardIDFunc     func(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error)
+	ListByColumnIDFunc    func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error)
+	ListChildrenFunc      func(ctx context.Context, parentID domain.TaskID) ([]domain.Task, error)
+	ListColumnEntriesFunc func(ctx context.Context, boardID domain.BoardID) (map[domain.TaskID]time.Time, error)
+	GetFunc               func(ctx context.Context, taskID domain.TaskID) (domain.Task, error)
+	UpdateFunc            func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error)
+	SetParentFunc         func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, parentID domain.TaskID) (domain.Task, error)
+	MoveFunc              func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID domain.LaneID, targetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error)
+	DeleteFunc            func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error
+	DuplicateFunc         func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	ListArchivalsDueFunc  func(ctx context.Context, now time.Time, limit int) ([]domain.TaskArchival, error)
+	ArchiveFunc           func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskIDs []domain.TaskID, now time.Time) (int64, error)
+	RestoreFunc           func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	ApplyBatchFunc        func(ctx context.Context, boardID domain.BoardID, changes []domain.TaskChange) ([]domain.TaskBatchResult, error)
 }
 
 func NewMockTaskRepository(t *testing.T) *MockTaskRepository {
@@ -190,21 +493,41 @@ func NewMockTaskRepository(t *testing.T) *MockTaskRepository {
 func (m *MockTaskRepository) Create(
 	ctx context.Context,
 	columnID domain.ColumnID,
+	laneID domain.LaneID,
+	parentID domain.TaskID,
 	name domain.TaskName,
 	description domain.TaskDescription,
+	checklist domain.TaskChecklist,
+	estimate domain.TaskEstimate,
+	customFields domain.TaskCustomFields,
 ) (domain.Task, error) {
 	testutil.AssertFuncNotNil(m.t, "TaskRepository.CreateFunc", m.CreateFunc)
-	return m.CreateFunc(ctx, columnID, name, description)
+	return m.CreateFunc(ctx, columnID, laneID, parentID, name, description, checklist, estimate, customFields)
 }
 
-func (m *MockTaskRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Task, error) {
+func (m *MockTaskRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID, includeArchived bool) ([]domain.Task, error) {
 	testutil.AssertFuncNotNil(m.t, "TaskRepository.ListByBoardIDFunc", m.ListByBoardIDFunc)
-	return m.ListByBoardIDFunc(ctx, boardID)
+	return m.ListByBoardIDFunc(ctx, boardID, includeArchived)
 }
 
-func (m *MockTaskRepository) ListByColumnID(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+func (m *MockTaskRepository) ListColumnEntries(ctx context.Context, boardID domain.BoardID) (map[domain.TaskID]time.Time, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.ListColumnEntriesFunc", m.ListColumnEntriesFunc)
+	return m.ListColumnEntriesFunc(ctx, boardID)
+}
+
+func (m *MockTaskRepository) ListByColumnID(
+	ctx context.Context,
+	columnID domain.ColumnID,
+	filter domain.TaskCustomFields,
+	includeArchived bool,
+) ([]domain.Task, error) {
 	testutil.AssertFuncNotNil(m.t, "TaskRepository.ListByColumnIDFunc", m.ListByColumnIDFunc)
-	return m.ListByColumnIDFunc(ctx, columnID)
+	return m.ListByColumnIDFunc(ctx, columnID, filter, includeArchived)
+}
+
+func (m *MockTaskRepository) ListChildren(ctx context.Context, parentID domain.TaskID) ([]domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.ListChildrenFunc", m.ListChildrenFunc)
+	return m.ListChildrenFunc(ctx, parentID)
 }
 
 func (m *MockTaskRepository) Get(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
@@ -218,9 +541,24 @@ func (m *MockTaskRepository) Update(
 	taskID domain.TaskID,
 	name *domain.TaskName,
 	description *domain.TaskDescription,
+	checklist *domain.TaskChecklist,
+	estimate *domain.TaskEstimate,
+	customFields *domain.TaskCustomFields,
+	expected domain.Version,
 ) (domain.Task, error) {
 	testutil.AssertFuncNotNil(m.t, "TaskRepository.UpdateFunc", m.UpdateFunc)
-	return m.UpdateFunc(ctx, columnID, taskID, name, description)
+	return m.UpdateFunc(ctx, columnID, taskID, name, description, checklist, estimate, customFields, expected)
+}
+
+func (m *MockTaskRepository) SetParent(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	parentID domain.TaskID,
+) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.SetParentFunc", m.SetParentFunc)
+	return m.SetParentFunc(ctx, boardID, columnID, taskID, parentID)
 }
 
 func (m *MockTaskRepository) Move(
@@ -229,10 +567,12 @@ func (m *MockTaskRepository) Move(
 	currentColumnID domain.ColumnID,
 	taskID domain.TaskID,
 	targetColumnID domain.ColumnID,
+	targetLaneID domain.LaneID,
 	targetPosition domain.TaskPosition,
-) (domain.ColumnID, domain.TaskPosition, error) {
+	expected domain.Version,
+) (domain.TaskPlacement, error) {
 	testutil.AssertFuncNotNil(m.t, "TaskRepository.MoveFunc", m.MoveFunc)
-	return m.MoveFunc(ctx, boardID, currentColumnID, taskID, targetColumnID, targetPosition)
+	return m.MoveFunc(ctx, boardID, currentColumnID, taskID, targetColumnID, targetLaneID, targetPosition, expected)
 }
 
 func (m *MockTaskRepository) Delete(
@@ -240,7 +580,540 @@ func (m *MockTaskRepository) Delete(
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
 	taskID domain.TaskID,
+	cascade bool,
+	expected domain.Version,
 ) error {
 	testutil.AssertFuncNotNil(m.t, "TaskRepository.DeleteFunc", m.DeleteFunc)
-	return m.DeleteFunc(ctx, boardID, columnID, taskID)
+	return m.DeleteFunc(ctx, boardID, columnID, taskID, cascade, expected)
+}
+
+func (m *MockTaskRepository) Duplicate(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.DuplicateFunc", m.DuplicateFunc)
+	return m.DuplicateFunc(ctx, boardID, columnID, taskID)
+}
+
+func (m *MockTaskRepository) ListArchivalsDue(ctx context.Context, now time.Time, limit int) ([]domain.TaskArchival, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.ListArchivalsDueFunc", m.ListArchivalsDueFunc)
+	return m.ListArchivalsDueFunc(ctx, now, limit)
+}
+
+func (m *MockTaskRepository) Archive(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskIDs []domain.TaskID,
+	now time.Time,
+) (int64, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.ArchiveFunc", m.ArchiveFunc)
+	return m.ArchiveFunc(ctx, boardID, columnID, taskIDs, now)
+}
+
+func (m *MockTaskRepository) Restore(
+	ctx context.Context,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.RestoreFunc", m.RestoreFunc)
+	return m.RestoreFunc(ctx, boardID, columnID, taskID)
+}
+
+func (m *MockTaskRepository) ApplyBatch(
+	ctx context.Context,
+	boardID domain.BoardID,
+	changes []domain.TaskChange,
+) ([]domain.TaskBatchResult, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskRepository.ApplyBatchFunc", m.ApplyBatchFunc)
+	return m.ApplyBatchFunc(ctx, boardID, changes)
+}
+
+type MockBoardTemplateRepository struct {
+	t *testing.T
+
+	CreateFromBoardFunc func(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error)
+	GetFunc             func(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error)
+	ListAvailableFunc   func(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error)
+	DeleteFunc          func(ctx context.Context, templateID domain.BoardTemplateID) error
+}
+
+func NewMockBoardTemplateRepository(t *testing.T) *MockBoardTemplateRepository {
+	return &MockBoardTemplateRepository{t: t}
+}
+
+func (m *MockBoardTemplateRepository) CreateFromBoard(ctx context.Context, ownerID domain.UserID, boardID domain.BoardID, name domain.BoardName, description domain.BoardDescription, includeTasks bool) (domain.BoardTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardTemplateRepository.CreateFromBoardFunc", m.CreateFromBoardFunc)
+	return m.CreateFromBoardFunc(ctx, ownerID, boardID, name, description, includeTasks)
+}
+
+func (m *MockBoardTemplateRepository) Get(ctx context.Context, templateID domain.BoardTemplateID) (domain.BoardTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardTemplateRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, templateID)
+}
+
+func (m *MockBoardTemplateRepository) ListAvailable(ctx context.Context, ownerID domain.UserID) ([]domain.BoardTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardTemplateRepository.ListAvailableFunc", m.ListAvailableFunc)
+	return m.ListAvailableFunc(ctx, ownerID)
+}
+
+func (m *MockBoardTemplateRepository) Delete(ctx context.Context, templateID domain.BoardTemplateID) error {
+	testutil.AssertFuncNotNil(m.t, "BoardTemplateRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, templateID)
+}
+
+type MockTaskTemplateRepository struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error)
+	GetFunc           func(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error)
+	ListByBoardIDFunc func(ctx context.Context, boardID domain.BoardID) ([]domain.TaskTemplate, error)
+	UpdateFunc        func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error)
+	DeleteFunc        func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID) error
+}
+
+func NewMockTaskTemplateRepository(t *testing.T) *MockTaskTemplateRepository {
+	return &MockTaskTemplateRepository{t: t}
+}
+
+func (m *MockTaskTemplateRepository) Create(
+	ctx context.Context,
+	boardID domain.BoardID,
+	namePattern domain.TaskNamePattern,
+	descriptionPattern domain.TaskDescriptionPattern,
+	checklist domain.TaskChecklist,
+) (domain.TaskTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTemplateRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, boardID, namePattern, descriptionPattern, checklist)
+}
+
+func (m *MockTaskTemplateRepository) Get(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTemplateRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, templateID)
+}
+
+func (m *MockTaskTemplateRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTemplateRepository.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, boardID)
+}
+
+func (m *MockTaskTemplateRepository) Update(
+	ctx context.Context,
+	boardID domain.BoardID,
+	templateID domain.TaskTemplateID,
+	namePattern *domain.TaskNamePattern,
+	descriptionPattern *domain.TaskDescriptionPattern,
+	checklist *domain.TaskChecklist,
+) (domain.TaskTemplate, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTemplateRepository.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, boardID, templateID, namePattern, descriptionPattern, checklist)
+}
+
+func (m *MockTaskTemplateRepository) Delete(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+	testutil.AssertFuncNotNil(m.t, "TaskTemplateRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, boardID, templateID)
+}
+
+type MockRecurrenceRepository struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, recurrence domain.Recurrence) (domain.Recurrence, error)
+	GetFunc           func(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error)
+	ListByBoardIDFunc func(ctx context.Context, boardID domain.BoardID) ([]domain.Recurrence, error)
+	ListDueFunc       func(ctx context.Context, now time.Time, limit int) ([]domain.Recurrence, error)
+	UpdateFunc        func(ctx context.Context, recurrence domain.Recurrence, reschedule bool) (domain.Recurrence, error)
+	DeleteFunc        func(ctx context.Context, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error
+	AdvanceFunc       func(ctx context.Context, recurrence domain.Recurrence, nextRunAt time.Time, materialize bool) (domain.Task, error)
+}
+
+func NewMockRecurrenceRepository(t *testing.T) *MockRecurrenceRepository {
+	return &MockRecurrenceRepository{t: t}
+}
+
+func (m *MockRecurrenceRepository) Create(ctx context.Context, recurrence domain.Recurrence) (domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "RecurrenceRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, recurrence)
+}
+
+func (m *MockRecurrenceRepository) Get(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "RecurrenceRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, recurrenceID)
+}
+
+func (m *MockRecurrenceRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "RecurrenceRepository.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, boardID)
+}
+
+func (m *MockRecurrenceRepository) ListDue(ctx context.Context, now time.Time, limit int) ([]domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "RecurrenceRepository.ListDueFunc", m.ListDueFunc)
+	return m.ListDueFunc(ctx, now, limit)
+}
+
+func (m *MockRecurrenceRepository) Update(ctx context.Context, recurrence domain.Recurrence, reschedule bool) (domain.Recurrence, error) {
+	testutil.AssertFuncNotNil(m.t, "RecurrenceRepository.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, recurrence, reschedule)
+}
+
+func (m *MockRecurrenceRepository) Delete(ctx context.Context, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error {
+	testutil.AssertFuncNotNil(m.t, "RecurrenceRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, boardID, recurrenceID)
+}
+
+func (m *MockRecurrenceRepository) Advance(
+	ctx context.Context,
+	recurrence domain.Recurrence,
+	nextRunAt time.Time,
+	materialize bool,
+) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "RecurrenceRepository.AdvanceFunc", m.AdvanceFunc)
+	return m.AdvanceFunc(ctx, recurrence, nextRunAt, materialize)
+}
+
+type MockSprintRepository struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error)
+	GetFunc           func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error)
+	ListByBoardIDFunc func(ctx context.Context, boardID domain.BoardID) ([]domain.Sprint, error)
+	UpdateFunc        func(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error)
+	DeleteFunc        func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID) error
+	AddTaskFunc       func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	RemoveTaskFunc    func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	CompleteFunc      func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, toNextSprint bool) (domain.SprintReport, error)
+	GetReportFunc     func(ctx context.Context, sprintID domain.SprintID) (domain.SprintReport, error)
+}
+
+func NewMockSprintRepository(t *testing.T) *MockSprintRepository {
+	return &MockSprintRepository{t: t}
+}
+
+func (m *MockSprintRepository) Create(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, sprint)
+}
+
+func (m *MockSprintRepository) Get(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, sprintID)
+}
+
+func (m *MockSprintRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Sprint, error) {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, boardID)
+}
+
+func (m *MockSprintRepository) Update(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, sprint)
+}
+
+func (m *MockSprintRepository) Delete(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID) error {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, boardID, sprintID)
+}
+
+func (m *MockSprintRepository) AddTask(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.AddTaskFunc", m.AddTaskFunc)
+	return m.AddTaskFunc(ctx, boardID, sprintID, taskID)
+}
+
+func (m *MockSprintRepository) RemoveTask(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.RemoveTaskFunc", m.RemoveTaskFunc)
+	return m.RemoveTaskFunc(ctx, boardID, sprintID, taskID)
+}
+
+func (m *MockSprintRepository) Complete(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, toNextSprint bool) (domain.SprintReport, error) {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.CompleteFunc", m.CompleteFunc)
+	return m.CompleteFunc(ctx, boardID, sprintID, toNextSprint)
+}
+
+func (m *MockSprintRepository) GetReport(ctx context.Context, sprintID domain.SprintID) (domain.SprintReport, error) {
+	testutil.AssertFuncNotNil(m.t, "SprintRepository.GetReportFunc", m.GetReportFunc)
+	return m.GetReportFunc(ctx, sprintID)
+}
+
+type MockTaskBlockerRepository struct {
+	t *testing.T
+
+	ListUnfinishedBlockersFunc func(ctx context.Context, taskID domain.TaskID) ([]domain.TaskID, error)
+}
+
+func NewMockTaskBlockerRepository(t *testing.T) *MockTaskBlockerRepository {
+	return &MockTaskBlockerRepository{t: t}
+}
+
+func (m *MockTaskBlockerRepository) ListUnfinishedBlockers(ctx context.Context, taskID domain.TaskID) ([]domain.TaskID, error) {
+	testutil.AssertFuncNotNil(m.t, "taskBlockerRepository.ListUnfinishedBlockersFunc", m.ListUnfinishedBlockersFunc)
+	return m.ListUnfinishedBlockersFunc(ctx, taskID)
+}
+
+type MockTaskLinkRepository struct {
+	t *testing.T
+
+	CreateFunc func(ctx context.Context, ownerID domain.UserID, link domain.TaskLink) (domain.TaskLink, error)
+	DeleteFunc func(ctx context.Context, taskID domain.TaskID, linkID domain.TaskLinkID) error
+}
+
+func NewMockTaskLinkRepository(t *testing.T) *MockTaskLinkRepository {
+	return &MockTaskLinkRepository{t: t}
+}
+
+func (m *MockTaskLinkRepository) Create(ctx context.Context, ownerID domain.UserID, link domain.TaskLink) (domain.TaskLink, error) {
+	testutil.AssertFuncNotNil(m.t, "taskLinkRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, ownerID, link)
+}
+
+func (m *MockTaskLinkRepository) Delete(ctx context.Context, taskID domain.TaskID, linkID domain.TaskLinkID) error {
+	testutil.AssertFuncNotNil(m.t, "taskLinkRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, taskID, linkID)
+}
+
+type MockBurndownRepository struct {
+	t *testing.T
+
+	SnapshotFunc func(ctx context.Context) (int64, error)
+	ListFunc     func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) ([]domain.BurndownPoint, error)
+}
+
+func NewMockBurndownRepository(t *testing.T) *MockBurndownRepository {
+	return &MockBurndownRepository{t: t}
+}
+
+func (m *MockBurndownRepository) Snapshot(ctx context.Context) (int64, error) {
+	testutil.AssertFuncNotNil(m.t, "BurndownRepository.SnapshotFunc", m.SnapshotFunc)
+	return m.SnapshotFunc(ctx)
+}
+
+func (m *MockBurndownRepository) List(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, from time.Time, to time.Time) ([]domain.BurndownPoint, error) {
+	testutil.AssertFuncNotNil(m.t, "BurndownRepository.ListFunc", m.ListFunc)
+	return m.ListFunc(ctx, boardID, sprintID, from, to)
+}
+
+type MockTaskTransitionRepository struct {
+	t *testing.T
+
+	CumulativeFlowFunc  func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) ([]domain.CumulativeFlowCount, error)
+	CycleTimesFunc      func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error)
+	ThroughputFunc      func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]domain.ThroughputWeek, error)
+	DailyThroughputFunc func(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]int64, error)
+	ListSLABreachesFunc func(ctx context.Context, now time.Time, limit int) ([]domain.SLABreach, error)
+	MarkSLANotifiedFunc func(ctx context.Context, transitionID domain.TaskTransitionID, now time.Time) error
+}
+
+func NewMockTaskTransitionRepository(t *testing.T) *MockTaskTransitionRepository {
+	return &MockTaskTransitionRepository{t: t}
+}
+
+func (m *MockTaskTransitionRepository) CumulativeFlow(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time, interval domain.FlowInterval) ([]domain.CumulativeFlowCount, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTransitionRepository.CumulativeFlowFunc", m.CumulativeFlowFunc)
+	return m.CumulativeFlowFunc(ctx, boardID, from, to, interval)
+}
+
+func (m *MockTaskTransitionRepository) CycleTimes(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) (domain.CycleTimeReport, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTransitionRepository.CycleTimesFunc", m.CycleTimesFunc)
+	return m.CycleTimesFunc(ctx, boardID, from, to)
+}
+
+func (m *MockTaskTransitionRepository) Throughput(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]domain.ThroughputWeek, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTransitionRepository.ThroughputFunc", m.ThroughputFunc)
+	return m.ThroughputFunc(ctx, boardID, from, to)
+}
+
+func (m *MockTaskTransitionRepository) DailyThroughput(ctx context.Context, boardID domain.BoardID, from time.Time, to time.Time) ([]int64, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTransitionRepository.DailyThroughputFunc", m.DailyThroughputFunc)
+	return m.DailyThroughputFunc(ctx, boardID, from, to)
+}
+
+func (m *MockTaskTransitionRepository) ListSLABreaches(ctx context.Context, now time.Time, limit int) ([]domain.SLABreach, error) {
+	testutil.AssertFuncNotNil(m.t, "TaskTransitionRepository.ListSLABreachesFunc", m.ListSLABreachesFunc)
+	return m.ListSLABreachesFunc(ctx, now, limit)
+}
+
+func (m *MockTaskTransitionRepository) MarkSLANotified(ctx context.Context, transitionID domain.TaskTransitionID, now time.Time) error {
+	testutil.AssertFuncNotNil(m.t, "TaskTransitionRepository.MarkSLANotifiedFunc", m.MarkSLANotifiedFunc)
+	return m.MarkSLANotifiedFunc(ctx, transitionID, now)
+}
+
+type MockStatsRepository struct {
+	t *testing.T
+
+	BoardStatsFunc func(ctx context.Context, boardID domain.BoardID, now time.Time) (domain.BoardStats, error)
+	UserStatsFunc  func(ctx context.Context, ownerID domain.UserID, now time.Time) (domain.UserStats, error)
+}
+
+func NewMockStatsRepository(t *testing.T) *MockStatsRepository {
+	return &MockStatsRepository{t: t}
+}
+
+func (m *MockStatsRepository) BoardStats(ctx context.Context, boardID domain.BoardID, now time.Time) (domain.BoardStats, error) {
+	testutil.AssertFuncNotNil(m.t, "StatsRepository.BoardStatsFunc", m.BoardStatsFunc)
+	return m.BoardStatsFunc(ctx, boardID, now)
+}
+
+func (m *MockStatsRepository) UserStats(ctx context.Context, ownerID domain.UserID, now time.Time) (domain.UserStats, error) {
+	testutil.AssertFuncNotNil(m.t, "StatsRepository.UserStatsFunc", m.UserStatsFunc)
+	return m.UserStatsFunc(ctx, ownerID, now)
+}
+
+type MockCustomFieldRepository struct {
+	t *testing.T
+
+	CreateFunc        func(ctx context.Context, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error)
+	ListByBoardIDFunc func(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error)
+	GetFunc           func(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error)
+	UpdateFunc        func(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error)
+	DeleteFunc        func(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID) error
+}
+
+func NewMockCustomFieldRepository(t *testing.T) *MockCustomFieldRepository {
+	return &MockCustomFieldRepository{t: t}
+}
+
+func (m *MockCustomFieldRepository) Create(ctx context.Context, boardID domain.BoardID, name domain.CustomFieldName, fieldType domain.CustomFieldType, options domain.CustomFieldOptions) (domain.CustomField, error) {
+	testutil.AssertFuncNotNil(m.t, "CustomFieldRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, boardID, name, fieldType, options)
+}
+
+func (m *MockCustomFieldRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error) {
+	testutil.AssertFuncNotNil(m.t, "CustomFieldRepository.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, boardID)
+}
+
+func (m *MockCustomFieldRepository) Get(ctx context.Context, fieldID domain.CustomFieldID) (domain.CustomField, error) {
+	testutil.AssertFuncNotNil(m.t, "CustomFieldRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, fieldID)
+}
+
+func (m *MockCustomFieldRepository) Update(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID, name *domain.CustomFieldName, options *domain.CustomFieldOptions) (domain.CustomField, error) {
+	testutil.AssertFuncNotNil(m.t, "CustomFieldRepository.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, boardID, fieldID, name, options)
+}
+
+func (m *MockCustomFieldRepository) Delete(ctx context.Context, boardID domain.BoardID, fieldID domain.CustomFieldID) error {
+	testutil.AssertFuncNotNil(m.t, "CustomFieldRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, boardID, fieldID)
+}
+
+type MockAutomationRepository struct {
+	t *testing.T
+
+	CreateFunc               func(ctx context.Context, automation domain.Automation) (domain.Automation, error)
+	GetFunc                  func(ctx context.Context, automationID domain.AutomationID) (domain.Automation, error)
+	ListByBoardIDFunc        func(ctx context.Context, boardID domain.BoardID) ([]domain.Automation, error)
+	UpdateFunc               func(ctx context.Context, automation domain.Automation) (domain.Automation, error)
+	DeleteFunc               func(ctx context.Context, boardID domain.BoardID, automationID domain.AutomationID) error
+	RecordPassedDueDatesFunc func(ctx context.Context, now time.Time, limit int) error
+	ClaimEventsFunc          func(ctx context.Context, now time.Time, limit int) ([]domain.AutomationEvent, error)
+	ListRecentEventsFunc     func(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationEvent, error)
+	CountRunsFunc            func(ctx context.Context, automationID domain.AutomationID, taskID domain.TaskID, since time.Time) (int, error)
+	RecordRunFunc            func(ctx context.Context, run domain.AutomationRun) error
+	ListRunsFunc             func(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationRun, error)
+	CreateFollowUpTaskFunc   func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, now time.Time) (domain.Task, error)
+}
+
+func NewMockAutomationRepository(t *testing.T) *MockAutomationRepository {
+	return &MockAutomationRepository{t: t}
+}
+
+func (m *MockAutomationRepository) Create(ctx context.Context, automation domain.Automation) (domain.Automation, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, automation)
+}
+
+func (m *MockAutomationRepository) Get(ctx context.Context, automationID domain.AutomationID) (domain.Automation, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, automationID)
+}
+
+func (m *MockAutomationRepository) ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Automation, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.ListByBoardIDFunc", m.ListByBoardIDFunc)
+	return m.ListByBoardIDFunc(ctx, boardID)
+}
+
+func (m *MockAutomationRepository) Update(ctx context.Context, automation domain.Automation) (domain.Automation, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.UpdateFunc", m.UpdateFunc)
+	return m.UpdateFunc(ctx, automation)
+}
+
+func (m *MockAutomationRepository) Delete(ctx context.Context, boardID domain.BoardID, automationID domain.AutomationID) error {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.DeleteFunc", m.DeleteFunc)
+	return m.DeleteFunc(ctx, boardID, automationID)
+}
+
+func (m *MockAutomationRepository) RecordPassedDueDates(ctx context.Context, now time.Time, limit int) error {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.RecordPassedDueDatesFunc", m.RecordPassedDueDatesFunc)
+	return m.RecordPassedDueDatesFunc(ctx, now, limit)
+}
+
+func (m *MockAutomationRepository) ClaimEvents(ctx context.Context, now time.Time, limit int) ([]domain.AutomationEvent, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.ClaimEventsFunc", m.ClaimEventsFunc)
+	return m.ClaimEventsFunc(ctx, now, limit)
+}
+
+func (m *MockAutomationRepository) ListRecentEvents(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationEvent, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.ListRecentEventsFunc", m.ListRecentEventsFunc)
+	return m.ListRecentEventsFunc(ctx, boardID, limit)
+}
+
+func (m *MockAutomationRepository) CountRuns(ctx context.Context, automationID domain.AutomationID, taskID domain.TaskID, since time.Time) (int, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.CountRunsFunc", m.CountRunsFunc)
+	return m.CountRunsFunc(ctx, automationID, taskID, since)
+}
+
+func (m *MockAutomationRepository) RecordRun(ctx context.Context, run domain.AutomationRun) error {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.RecordRunFunc", m.RecordRunFunc)
+	return m.RecordRunFunc(ctx, run)
+}
+
+func (m *MockAutomationRepository) ListRuns(ctx context.Context, boardID domain.BoardID, limit int) ([]domain.AutomationRun, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.ListRunsFunc", m.ListRunsFunc)
+	return m.ListRunsFunc(ctx, boardID, limit)
+}
+
+func (m *MockAutomationRepository) CreateFollowUpTask(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription, now time.Time) (domain.Task, error) {
+	testutil.AssertFuncNotNil(m.t, "AutomationRepository.CreateFollowUpTaskFunc", m.CreateFollowUpTaskFunc)
+	return m.CreateFollowUpTaskFunc(ctx, columnID, name, description, now)
+}
+
+type MockBoardImportRepository struct {
+	t *testing.T
+
+	CreateFunc   func(ctx context.Context, boardImport domain.BoardImport) (domain.BoardImport, error)
+	GetFunc      func(ctx context.Context, importID domain.BoardImportID) (domain.BoardImport, error)
+	ClaimFunc    func(ctx context.Context, now, staleBefore time.Time, limit int) ([]domain.BoardImport, error)
+	CompleteFunc func(ctx context.Context, importID domain.BoardImportID, imported *domain.ImportedBoard, now time.Time) error
+	FailFunc     func(ctx context.Context, importID domain.BoardImportID, importErrors []domain.BoardImportError, now time.Time) error
+}
+
+func NewMockBoardImportRepository(t *testing.T) *MockBoardImportRepository {
+	return &MockBoardImportRepository{t: t}
+}
+
+func (m *MockBoardImportRepository) Create(ctx context.Context, boardImport domain.BoardImport) (domain.BoardImport, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardImportRepository.CreateFunc", m.CreateFunc)
+	return m.CreateFunc(ctx, boardImport)
+}
+
+func (m *MockBoardImportRepository) Get(ctx context.Context, importID domain.BoardImportID) (domain.BoardImport, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardImportRepository.GetFunc", m.GetFunc)
+	return m.GetFunc(ctx, importID)
+}
+
+func (m *MockBoardImportRepository) Claim(ctx context.Context, now, staleBefore time.Time, limit int) ([]domain.BoardImport, error) {
+	testutil.AssertFuncNotNil(m.t, "BoardImportRepository.ClaimFunc", m.ClaimFunc)
+	return m.ClaimFunc(ctx, now, staleBefore, limit)
+}
+
+func (m *MockBoardImportRepository) Complete(ctx context.Context, importID domain.BoardImportID, imported *domain.ImportedBoard, now time.Time) error {
+	testutil.AssertFuncNotNil(m.t, "BoardImportRepository.CompleteFunc", m.CompleteFunc)
+	return m.CompleteFunc(ctx, importID, imported, now)
+}
+
+func (m *MockBoardImportRepository) Fail(ctx context.Context, importID domain.BoardImportID, importErrors []domain.BoardImportError, now time.Time) error {
+	testutil.AssertFuncNotNil(m.t, "BoardImportRepository.FailFunc", m.FailFunc)
+	return m.FailFunc(ctx, importID, importErrors, now)
 }
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+// recurrenceBatchSize bounds how many due recurrences one RunDue call processes.
+const recurrenceBatchSize = 100
+
+type recurrenceRepository interface {
+	Create(ctx context.Context, recurrence domain.Recurrence) (domain.Recurrence, error)
+	Get(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error)
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Recurrence, error)
+	ListDue(ctx context.Context, now time.Time, limit int) ([]domain.Recurrence, error)
+	Update(ctx context.Context, recurrence domain.Recurrence, reschedule bool) (domain.Recurrence, error)
+	Delete(ctx context.Context, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error
+	Advance(ctx context.Context, recurrence domain.Recurrence, nextRunAt time.Time, materialize bool) (domain.Task, error)
+}
+
+type recurrenceBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type recurrenceColumnRepository interface {
+	Get(ctx context.Context, columnID domain.ColumnID) (domain.Column, error)
+}
+
+// RecurrencePatch holds the recurrence fields to update. Nil fields are left unchanged.
+type RecurrencePatch struct {
+	ColumnID    *domain.ColumnID
+	Name        *domain.TaskName
+	Description *domain.TaskDescription
+	Rule        *domain.RecurrenceRule
+	StartsAt    *time.Time
+	CatchUp     *domain.RecurrenceCatchUp
+}
+
+type recurrence struct {
+	recurrenceRepo recurrenceRepository
+	boardRepo      recurrenceBoardRepository
+	columnRepo     recurrenceColumnRepository
+	grace          time.Duration
+}
+
+// NewRecurrence creates the recurrence service. An occurrence processed more than grace
+// after its scheduled time is considered missed and handled by the rule catch-up policy.
+func NewRecurrence(
+	recurrenceRepo recurrenceRepository,
+	boardRepo recurrenceBoardRepository,
+	columnRepo recurrenceColumnRepository,
+	grace time.Duration,
+) *recurrence {
+	return &recurrence{
+		recurrenceRepo: recurrenceRepo,
+		boardRepo:      boardRepo,
+		columnRepo:     columnRepo,
+		grace:          grace,
+	}
+}
+
+func (s *recurrence) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	name domain.TaskName,
+	description domain.TaskDescription,
+	rule domain.RecurrenceRule,
+	startsAt time.Time,
+	catchUp domain.RecurrenceCatchUp,
+) (domain.Recurrence, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Recurrence{}, ErrBoardNotFound
+		}
+		return domain.Recurrence{}, fmt.Errorf("recurrence service: create get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Recurrence{}, ErrBoardNotFound
+	}
+
+	err = s.checkColumn(ctx, boardID, columnID)
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("recurrence service: create: %w", err)
+	}
+
+	recurrence, err := s.recurrenceRepo.Create(ctx, domain.Recurrence{
+		BoardID:     boardID,
+		ColumnID:    columnID,
+		Name:        name,
+		Description: description,
+		Rule:        rule,
+		StartsAt:    startsAt,
+		CatchUp:     catchUp,
+		NextRunAt:   rule.Next(startsAt, timeNow()),
+	})
+	if err != nil {
+		return domain.Recurrence{}, fmt.Errorf("recurrence service: create: %v: %w", err, ErrInternal)
+	}
+
+	return recurrence, nil
+}
+
+func (s *recurrence) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Recurrence, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("recurrence service: list get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return nil, ErrBoardNotFound
+	}
+
+	recurrences, err := s.recurrenceRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("recurrence service: list: %v: %w", err, ErrInternal)
+	}
+
+	return recurrences, nil
+}
+
+// Update applies patch to the recurrence. Changing the rule or the start time reschedules
+// the next run from now, so past occurrences of the new schedule are not caught up.
+func (s *recurrence) Update(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	recurrenceID domain.RecurrenceID,
+	patch RecurrencePatch,
+) (domain.Recurrence, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Recurrence{}, ErrRecurrenceNotFound
+		}
+		return domain.Recurrence{}, fmt.Errorf("recurrence service: update get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Recurrence{}, ErrRecurrenceNotFound
+	}
+
+	recurrence, err := s.recurrenceRepo.Get(ctx, recurrenceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Recurrence{}, ErrRecurrenceNotFound
+		}
+		return domain.Recurrence{}, fmt.Errorf("recurrence service: update get recurrence: %v: %w", err, ErrInternal)
+	}
+	if recurrence.BoardID != boardID {
+		return domain.Recurrence{}, ErrRecurrenceNotFound
+	}
+
+	if patch.ColumnID != nil && *patch.ColumnID != recurrence.ColumnID {
+		err = s.checkColumn(ctx, boardID, *patch.ColumnID)
+		if err != nil {
+			return domain.Recurrence{}, fmt.Errorf("recurrence service: update: %w", err)
+		}
+		recurrence.ColumnID = *patch.ColumnID
+	}
+	if patch.Name != nil {
+		recurrence.Name = *patch.Name
+	}
+	if patch.Description != nil {
+		recurrence.Description = *patch.Description
+	}
+	if patch.CatchUp != nil {
+		recurrence.CatchUp = *patch.CatchUp
+	}
+	reschedule := patch.Rule != nil || patch.StartsAt != nil
+	if patch.Rule != nil {
+		recurrence.Rule = *patch.Rule
+	}
+	if patch.StartsAt != nil {
+		recurrence.StartsAt = *patch.StartsAt
+	}
+	if reschedule {
+		recurrence.NextRunAt = recurrence.Rule.Next(recurrence.StartsAt, timeNow())
+	}
+
+	updated, err := s.recurrenceRepo.Update(ctx, recurrence, reschedule)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Recurrence{}, ErrRecurrenceNotFound
+		}
+		return domain.Recurrence{}, fmt.Errorf("recurrence service: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+func (s *recurrence) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrRecurrenceNotFound
+		}
+		return fmt.Errorf("recurrence service: delete get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return ErrRecurrenceNotFound
+	}
+
+	err = s.recurrenceRepo.Delete(ctx, boardID, recurrenceID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrRecurrenceNotFound
+		}
+		return fmt.Errorf("recurrence service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// RunDue materializes the recurrences that are due now and returns the number of created tasks.
+// It is safe to run concurrently from several replicas: every occurrence is claimed by exactly
+// one of them. A failing recurrence does not stop the others; its error is joined into the result.
+func (s *recurrence) RunDue(ctx context.Context) (int, error) {
+	now := timeNow()
+
+	due, err := s.recurrenceRepo.ListDue(ctx, now, recurrenceBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("recurrence service: run due list: %v: %w", err, ErrInternal)
+	}
+
+	created := 0
+	var errs []error
+	for _, recurrence := range due {
+		materialize, nextRunAt := recurrence.Plan(now, s.grace)
+
+		_, err = s.recurrenceRepo.Advance(ctx, recurrence, nextRunAt, materialize)
+		if err != nil {
+			// Claimed by another replica, updated or deleted meanwhile.
+			if errors.Is(err, repository.ErrRowNotFound) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("recurrence %s: %v", recurrence.ID, err))
+			continue
+		}
+		if materialize {
+			created++
+		}
+	}
+
+	if len(errs) > 0 {
+		return created, fmt.Errorf("recurrence service: run due: %v: %w", errors.Join(errs...), ErrInternal)
+	}
+
+	return created, nil
+}
+
+func (s *recurrence) checkColumn(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID) error {
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrColumnNotFound
+		}
+		return fmt.Errorf("get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return ErrColumnNotFound
+	}
+
+	return nil
+}
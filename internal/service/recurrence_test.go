@@ -0,0 +1,392 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+const recurrenceGrace = 5 * time.Minute
+
+func TestRecurrence_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validRecurrence := testutil.ValidRecurrence(validBoard.ID, validColumn.ID)
+
+	tests := []struct {
+		name                string
+		callerID            domain.UserID
+		setupBoardRepo      func(t *testing.T, r *MockBoardRepository)
+		setupColumnRepo     func(t *testing.T, r *MockColumnRepository)
+		setupRecurrenceRepo func(t *testing.T, r *MockRecurrenceRepository)
+		wantErr             error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {
+				before := time.Now().UTC()
+				r.CreateFunc = func(ctx context.Context, recurrence domain.Recurrence) (domain.Recurrence, error) {
+					if recurrence.BoardID != validBoard.ID || recurrence.ColumnID != validColumn.ID {
+						t.Errorf("got board %v column %v, want %v %v", recurrence.BoardID, recurrence.ColumnID, validBoard.ID, validColumn.ID)
+					}
+					if recurrence.Rule != validRecurrence.Rule {
+						t.Errorf("got rule %v, want %v", recurrence.Rule, validRecurrence.Rule)
+					}
+					if !recurrence.NextRunAt.After(before) {
+						t.Errorf("got next run %v, want after %v", recurrence.NextRunAt, before)
+					}
+					if wd := recurrence.NextRunAt.Weekday(); wd != time.Monday && wd != time.Wednesday {
+						t.Errorf("got next run on %v, want Monday or Wednesday", wd)
+					}
+					return validRecurrence, nil
+				}
+			},
+		},
+		{
+			name:     "Board not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo:     func(t *testing.T, r *MockColumnRepository) {},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {},
+			wantErr:             service.ErrBoardNotFound,
+		},
+		{
+			name:     "Column not found when on another board",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return testutil.ValidColumn(domain.NewBoardID()), nil
+				}
+			},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {},
+			wantErr:             service.ErrColumnNotFound,
+		},
+		{
+			name:     "Create internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {
+				r.CreateFunc = func(ctx context.Context, recurrence domain.Recurrence) (domain.Recurrence, error) {
+					return domain.Recurrence{}, errors.New("insert failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			columnRepo := NewMockColumnRepository(t)
+			recurrenceRepo := NewMockRecurrenceRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupColumnRepo(t, columnRepo)
+			tt.setupRecurrenceRepo(t, recurrenceRepo)
+
+			s := service.NewRecurrence(recurrenceRepo, boardRepo, columnRepo, recurrenceGrace)
+			got, err := s.Create(
+				context.Background(), tt.callerID, validBoard.ID, validColumn.ID,
+				validRecurrence.Name, validRecurrence.Description, validRecurrence.Rule, validRecurrence.StartsAt, validRecurrence.CatchUp,
+			)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(validRecurrence, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Create() recurrence mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestRecurrence_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validRecurrence := testutil.ValidRecurrence(validBoard.ID, validColumn.ID)
+
+	renamed, _ := domain.NewTaskName("Renamed")
+	daily, _ := domain.NewRecurrenceRule("FREQ=DAILY")
+	otherColumnID := domain.NewColumnID()
+
+	tests := []struct {
+		name                string
+		patch               service.RecurrencePatch
+		setupColumnRepo     func(t *testing.T, r *MockColumnRepository)
+		setupRecurrenceRepo func(t *testing.T, r *MockRecurrenceRepository)
+		wantErr             error
+	}{
+		{
+			name:            "Success name only keeps schedule",
+			patch:           service.RecurrencePatch{Name: &renamed},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {
+				r.GetFunc = func(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error) {
+					return validRecurrence, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, recurrence domain.Recurrence, reschedule bool) (domain.Recurrence, error) {
+					if reschedule {
+						t.Errorf("got reschedule, want schedule kept")
+					}
+					if recurrence.Name != renamed {
+						t.Errorf("got name %v, want %v", recurrence.Name, renamed)
+					}
+					if !recurrence.NextRunAt.Equal(validRecurrence.NextRunAt) {
+						t.Errorf("got next run %v, want %v", recurrence.NextRunAt, validRecurrence.NextRunAt)
+					}
+					return recurrence, nil
+				}
+			},
+		},
+		{
+			name:            "Success rule reschedules",
+			patch:           service.RecurrencePatch{Rule: &daily},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {
+				before := time.Now().UTC()
+				r.GetFunc = func(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error) {
+					return validRecurrence, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, recurrence domain.Recurrence, reschedule bool) (domain.Recurrence, error) {
+					if !reschedule {
+						t.Errorf("got schedule kept, want reschedule")
+					}
+					if !recurrence.NextRunAt.After(before) || recurrence.NextRunAt.After(before.AddDate(0, 0, 1)) {
+						t.Errorf("got next run %v, want within a day after %v", recurrence.NextRunAt, before)
+					}
+					return recurrence, nil
+				}
+			},
+		},
+		{
+			name:  "Column not found when on another board",
+			patch: service.RecurrencePatch{ColumnID: &otherColumnID},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return testutil.ValidColumn(domain.NewBoardID()), nil
+				}
+			},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {
+				r.GetFunc = func(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error) {
+					return validRecurrence, nil
+				}
+			},
+			wantErr: service.ErrColumnNotFound,
+		},
+		{
+			name:            "Not found when on another board",
+			patch:           service.RecurrencePatch{Name: &renamed},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {
+				r.GetFunc = func(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error) {
+					return testutil.ValidRecurrence(domain.NewBoardID(), validColumn.ID), nil
+				}
+			},
+			wantErr: service.ErrRecurrenceNotFound,
+		},
+		{
+			name:            "Not found when row missing",
+			patch:           service.RecurrencePatch{Name: &renamed},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {},
+			setupRecurrenceRepo: func(t *testing.T, r *MockRecurrenceRepository) {
+				r.GetFunc = func(ctx context.Context, recurrenceID domain.RecurrenceID) (domain.Recurrence, error) {
+					return domain.Recurrence{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrRecurrenceNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			recurrenceRepo := NewMockRecurrenceRepository(t)
+			tt.setupColumnRepo(t, columnRepo)
+			tt.setupRecurrenceRepo(t, recurrenceRepo)
+
+			s := service.NewRecurrence(recurrenceRepo, boardRepo, columnRepo, recurrenceGrace)
+			_, err := s.Update(context.Background(), validBoard.OwnerID, validBoard.ID, validRecurrence.ID, tt.patch)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecurrence_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+
+	tests := []struct {
+		name      string
+		callerID  domain.UserID
+		deleteErr error
+		wantErr   error
+	}{
+		{name: "Success", callerID: validBoard.OwnerID},
+		{name: "Not found when wrong owner", callerID: domain.NewUserID(), wantErr: service.ErrRecurrenceNotFound},
+		{name: "Not found when row missing", callerID: validBoard.OwnerID, deleteErr: repository.ErrRowNotFound, wantErr: service.ErrRecurrenceNotFound},
+		{name: "Delete internal error", callerID: validBoard.OwnerID, deleteErr: errors.New("delete failed"), wantErr: service.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			recurrenceRepo := NewMockRecurrenceRepository(t)
+			recurrenceRepo.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, recurrenceID domain.RecurrenceID) error {
+				return tt.deleteErr
+			}
+
+			s := service.NewRecurrence(recurrenceRepo, boardRepo, NewMockColumnRepository(t), recurrenceGrace)
+			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, domain.NewRecurrenceID())
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRecurrence_RunDue(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	daily, _ := domain.NewRecurrenceRule("FREQ=DAILY")
+
+	newDue := func(late time.Duration, catchUp domain.RecurrenceCatchUp) domain.Recurrence {
+		recurrence := testutil.ValidRecurrence(validBoard.ID, validColumn.ID)
+		recurrence.Rule = daily
+		recurrence.CatchUp = catchUp
+		recurrence.NextRunAt = time.Now().UTC().Add(-late).Truncate(time.Second)
+		return recurrence
+	}
+	onTime := newDue(time.Minute, domain.RecurrenceCatchUpSkip)
+	missedSkip := newDue(48*time.Hour, domain.RecurrenceCatchUpSkip)
+	missedCreateOnce := newDue(48*time.Hour, domain.RecurrenceCatchUpCreateOnce)
+	claimedElsewhere := newDue(time.Minute, domain.RecurrenceCatchUpSkip)
+
+	tests := []struct {
+		name            string
+		due             []domain.Recurrence
+		advanceErr      map[domain.RecurrenceID]error
+		wantMaterialize map[domain.RecurrenceID]bool
+		wantCreated     int
+		wantErr         error
+	}{
+		{
+			name: "Success",
+			due:  []domain.Recurrence{onTime, missedSkip, missedCreateOnce, claimedElsewhere},
+			advanceErr: map[domain.RecurrenceID]error{
+				claimedElsewhere.ID: repository.ErrRowNotFound,
+			},
+			wantMaterialize: map[domain.RecurrenceID]bool{
+				onTime.ID:           true,
+				missedSkip.ID:       false,
+				missedCreateOnce.ID: true,
+				claimedElsewhere.ID: true,
+			},
+			wantCreated: 2,
+		},
+		{
+			name: "Failure does not stop other recurrences",
+			due:  []domain.Recurrence{missedSkip, onTime},
+			advanceErr: map[domain.RecurrenceID]error{
+				missedSkip.ID: errors.New("update failed"),
+			},
+			wantMaterialize: map[domain.RecurrenceID]bool{
+				onTime.ID:     true,
+				missedSkip.ID: false,
+			},
+			wantCreated: 1,
+			wantErr:     service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			recurrenceRepo := NewMockRecurrenceRepository(t)
+			recurrenceRepo.ListDueFunc = func(ctx context.Context, now time.Time, limit int) ([]domain.Recurrence, error) {
+				return tt.due, nil
+			}
+			recurrenceRepo.AdvanceFunc = func(ctx context.Context, recurrence domain.Recurrence, nextRunAt time.Time, materialize bool) (domain.Task, error) {
+				if materialize != tt.wantMaterialize[recurrence.ID] {
+					t.Errorf("recurrence %v: got materialize %t, want %t", recurrence.ID, materialize, tt.wantMaterialize[recurrence.ID])
+				}
+				if !nextRunAt.After(time.Now()) {
+					t.Errorf("recurrence %v: got next run %v, want in the future", recurrence.ID, nextRunAt)
+				}
+				return domain.Task{}, tt.advanceErr[recurrence.ID]
+			}
+
+			s := service.NewRecurrence(recurrenceRepo, NewMockBoardRepository(t), NewMockColumnRepository(t), recurrenceGrace)
+			created, err := s.RunDue(context.Background())
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if created != tt.wantCreated {
+				t.Errorf("got created %d, want %d", created, tt.wantCreated)
+			}
+		})
+	}
+}
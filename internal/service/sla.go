@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+// slaBatchSize bounds how many breaches one NotifyBreaches call processes.
+const slaBatchSize = 100
+
+type slaTransitionRepository interface {
+	ListSLABreaches(ctx context.Context, now time.Time, limit int) ([]domain.SLABreach, error)
+	MarkSLANotified(ctx context.Context, transitionID domain.TaskTransitionID, now time.Time) error
+}
+
+type slaNotifier interface {
+	Notify(ctx context.Context, chatID domain.TelegramChatID, text domain.TelegramMessage) error
+}
+
+type sla struct {
+	transitionRepo slaTransitionRepository
+	notifier       slaNotifier
+}
+
+func NewSLA(transitionRepo slaTransitionRepository, notifier slaNotifier) *sla {
+	return &sla{transitionRepo: transitionRepo, notifier: notifier}
+}
+
+// NotifyBreaches sends a Telegram message to the board owner for every task that has stayed in
+// its column longer than the column SLA and returns the number of sent messages. Every stay of
+// a task in a column is notified at most once, even when several replicas run concurrently: a
+// breach is claimed before its message is sent, so a failed message is not retried.
+func (s *sla) NotifyBreaches(ctx context.Context) (int, error) {
+	now := timeNow()
+
+	breaches, err := s.transitionRepo.ListSLABreaches(ctx, now, slaBatchSize)
+	if err != nil {
+		return 0, fmt.Errorf("sla service: notify breaches list: %v: %w", err, ErrInternal)
+	}
+
+	notified := 0
+	var errs []error
+	for _, breach := range breaches {
+		err = s.transitionRepo.MarkSLANotified(ctx, breach.TransitionID, now)
+		if err != nil {
+			// Claimed by another replica meanwhile.
+			if errors.Is(err, repository.ErrRowNotFound) {
+				continue
+			}
+			errs = append(errs, fmt.Errorf("transition %s: %v", breach.TransitionID, err))
+			continue
+		}
+
+		message, err := domain.NewTelegramMessage(breach.Message(now))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("transition %s: message: %v", breach.TransitionID, err))
+			continue
+		}
+		err = s.notifier.Notify(ctx, breach.ChatID, message)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("transition %s: notify: %v", breach.TransitionID, err))
+			continue
+		}
+		notified++
+	}
+
+	if len(errs) > 0 {
+		return notified, fmt.Errorf("sla service: notify breaches: %v: %w", errors.Join(errs...), ErrInternal)
+	}
+
+	return notified, nil
+}
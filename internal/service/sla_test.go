@@ -0,0 +1,135 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestSLA_NotifyBreaches(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	sla := testutil.NewValidColumnSLA(t, 24)
+	newBreach := func(name string) domain.SLABreach {
+		task := testutil.NewValidTask(t, validColumn.ID, name, "", 1)
+		return domain.SLABreach{
+			TransitionID: domain.NewTaskTransitionID(),
+			BoardID:      validBoard.ID,
+			BoardName:    validBoard.Name,
+			ColumnID:     validColumn.ID,
+			ColumnName:   validColumn.Name,
+			SLA:          sla,
+			TaskID:       task.ID,
+			TaskName:     task.Name,
+			EnteredAt:    time.Now().UTC().Add(-30 * time.Hour),
+			ChatID:       testutil.ValidTelegramChatID(),
+		}
+	}
+	first := newBreach("First task")
+	second := newBreach("Second task")
+	claimedElsewhere := newBreach("Claimed task")
+
+	tests := []struct {
+		name         string
+		breaches     []domain.SLABreach
+		listErr      error
+		markErr      map[domain.TaskTransitionID]error
+		notifyErr    map[domain.TaskTransitionID]error
+		wantNotified []domain.TaskTransitionID
+		wantCount    int
+		wantErr      error
+	}{
+		{
+			name:     "Success skips breaches claimed elsewhere",
+			breaches: []domain.SLABreach{first, claimedElsewhere, second},
+			markErr: map[domain.TaskTransitionID]error{
+				claimedElsewhere.TransitionID: repository.ErrRowNotFound,
+			},
+			wantNotified: []domain.TaskTransitionID{first.TransitionID, second.TransitionID},
+			wantCount:    2,
+		},
+		{
+			name:     "Failure does not stop other breaches",
+			breaches: []domain.SLABreach{first, second},
+			notifyErr: map[domain.TaskTransitionID]error{
+				first.TransitionID: errors.New("telegram down"),
+			},
+			wantNotified: []domain.TaskTransitionID{first.TransitionID, second.TransitionID},
+			wantCount:    1,
+			wantErr:      service.ErrInternal,
+		},
+		{
+			name:     "Claim failure skips the message",
+			breaches: []domain.SLABreach{first, second},
+			markErr: map[domain.TaskTransitionID]error{
+				first.TransitionID: repository.ErrInternal,
+			},
+			wantNotified: []domain.TaskTransitionID{second.TransitionID},
+			wantCount:    1,
+			wantErr:      service.ErrInternal,
+		},
+		{
+			name:    "Internal error from list",
+			listErr: repository.ErrInternal,
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			transitionRepo := NewMockTaskTransitionRepository(t)
+			transitionRepo.ListSLABreachesFunc = func(ctx context.Context, now time.Time, limit int) ([]domain.SLABreach, error) {
+				if limit <= 0 {
+					t.Errorf("got limit %d, want positive", limit)
+				}
+				return tt.breaches, tt.listErr
+			}
+			transitionRepo.MarkSLANotifiedFunc = func(ctx context.Context, transitionID domain.TaskTransitionID, now time.Time) error {
+				return tt.markErr[transitionID]
+			}
+
+			var notified []domain.TaskTransitionID
+			notifier := NewMockTelegramNotifier(t)
+			notifier.NotifyFunc = func(ctx context.Context, chatID domain.TelegramChatID, text domain.TelegramMessage) error {
+				if chatID != testutil.ValidTelegramChatID() {
+					t.Errorf("got chat id %v, want %v", chatID, testutil.ValidTelegramChatID())
+				}
+				for _, breach := range tt.breaches {
+					if strings.Contains(text.String(), strconv.Quote(breach.TaskName.String())) {
+						notified = append(notified, breach.TransitionID)
+						return tt.notifyErr[breach.TransitionID]
+					}
+				}
+				t.Errorf("got unexpected message %q", text.String())
+				return nil
+			}
+
+			s := service.NewSLA(transitionRepo, notifier)
+			count, err := s.NotifyBreaches(context.Background())
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if count != tt.wantCount {
+				t.Errorf("got notified count %d, want %d", count, tt.wantCount)
+			}
+			if diff := cmp.Diff(tt.wantNotified, notified, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("notified breaches mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
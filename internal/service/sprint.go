@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type sprintRepository interface {
+	Create(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error)
+	Get(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error)
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.Sprint, error)
+	Update(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error)
+	Delete(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID) error
+	AddTask(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	RemoveTask(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error
+	Complete(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, toNextSprint bool) (domain.SprintReport, error)
+	GetReport(ctx context.Context, sprintID domain.SprintID) (domain.SprintReport, error)
+}
+
+type sprintBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+// SprintPatch holds the sprint fields to update. Nil fields are left unchanged.
+type SprintPatch struct {
+	Name      *domain.SprintName
+	Goal      *domain.SprintGoal
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+type sprint struct {
+	sprintRepo sprintRepository
+	boardRepo  sprintBoardRepository
+}
+
+func NewSprint(sprintRepo sprintRepository, boardRepo sprintBoardRepository) *sprint {
+	return &sprint{sprintRepo: sprintRepo, boardRepo: boardRepo}
+}
+
+func (s *sprint) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	name domain.SprintName,
+	goal domain.SprintGoal,
+	startDate time.Time,
+	endDate time.Time,
+) (domain.Sprint, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Sprint{}, ErrBoardNotFound
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint service: create get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Sprint{}, ErrBoardNotFound
+	}
+
+	err = domain.ValidateSprintDates(startDate, endDate)
+	if err != nil {
+		return domain.Sprint{}, ErrSprintDatesInvalid
+	}
+
+	sprint, err := s.sprintRepo.Create(ctx, domain.Sprint{
+		BoardID:   boardID,
+		Name:      name,
+		Goal:      goal,
+		StartDate: startDate,
+		EndDate:   endDate,
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Sprint{}, ErrBoardNotFound
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint service: create: %v: %w", err, ErrInternal)
+	}
+
+	return sprint, nil
+}
+
+func (s *sprint) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.Sprint, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("sprint service: list get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return nil, ErrBoardNotFound
+	}
+
+	sprints, err := s.sprintRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("sprint service: list: %v: %w", err, ErrInternal)
+	}
+
+	return sprints, nil
+}
+
+// Update applies patch to an open sprint. A completed sprint is frozen and cannot be updated.
+func (s *sprint) Update(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+	patch SprintPatch,
+) (domain.Sprint, error) {
+	sprint, err := s.getOwned(ctx, "update", callerID, boardID, sprintID)
+	if err != nil {
+		return domain.Sprint{}, err
+	}
+	if sprint.IsCompleted() {
+		return domain.Sprint{}, ErrSprintCompleted
+	}
+
+	if patch.Name != nil {
+		sprint.Name = *patch.Name
+	}
+	if patch.Goal != nil {
+		sprint.Goal = *patch.Goal
+	}
+	if patch.StartDate != nil {
+		sprint.StartDate = *patch.StartDate
+	}
+	if patch.EndDate != nil {
+		sprint.EndDate = *patch.EndDate
+	}
+	err = domain.ValidateSprintDates(sprint.StartDate, sprint.EndDate)
+	if err != nil {
+		return domain.Sprint{}, ErrSprintDatesInvalid
+	}
+
+	updated, err := s.sprintRepo.Update(ctx, sprint)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Sprint{}, ErrSprintNotFound
+		}
+		if errors.Is(err, repository.ErrCompleted) {
+			return domain.Sprint{}, ErrSprintCompleted
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint service: update: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+// Delete removes the sprint and returns its tasks to the backlog.
+func (s *sprint) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrSprintNotFound
+		}
+		return fmt.Errorf("sprint service: delete get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return ErrSprintNotFound
+	}
+
+	err = s.sprintRepo.Delete(ctx, boardID, sprintID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrSprintNotFound
+		}
+		return fmt.Errorf("sprint service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// AddTask puts a task of the board into an open sprint. A task belongs to at most one
+// sprint, so a task already planned elsewhere is moved.
+func (s *sprint) AddTask(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+	taskID domain.TaskID,
+) error {
+	sprint, err := s.getOwned(ctx, "add task", callerID, boardID, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.IsCompleted() {
+		return ErrSprintCompleted
+	}
+
+	err = s.sprintRepo.AddTask(ctx, boardID, sprintID, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskNotFound
+		}
+		if errors.Is(err, repository.ErrCompleted) {
+			return ErrSprintCompleted
+		}
+		return fmt.Errorf("sprint service: add task: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// RemoveTask returns a task of an open sprint to the backlog.
+func (s *sprint) RemoveTask(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+	taskID domain.TaskID,
+) error {
+	sprint, err := s.getOwned(ctx, "remove task", callerID, boardID, sprintID)
+	if err != nil {
+		return err
+	}
+	if sprint.IsCompleted() {
+		return ErrSprintCompleted
+	}
+
+	err = s.sprintRepo.RemoveTask(ctx, boardID, sprintID, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskNotFound
+		}
+		if errors.Is(err, repository.ErrCompleted) {
+			return ErrSprintCompleted
+		}
+		return fmt.Errorf("sprint service: remove task: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// Complete closes the sprint and returns its report. Unfinished tasks go to the open sprint
+// that starts next, or to the backlog when carryOver asks for it or there is no such sprint.
+func (s *sprint) Complete(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+	carryOver domain.SprintCarryOver,
+) (domain.SprintReport, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.SprintReport{}, ErrSprintNotFound
+		}
+		return domain.SprintReport{}, fmt.Errorf("sprint service: complete get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.SprintReport{}, ErrSprintNotFound
+	}
+
+	report, err := s.sprintRepo.Complete(ctx, boardID, sprintID, carryOver == domain.SprintCarryOverNextSprint)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.SprintReport{}, ErrSprintNotFound
+		}
+		if errors.Is(err, repository.ErrCompleted) {
+			return domain.SprintReport{}, ErrSprintCompleted
+		}
+		return domain.SprintReport{}, fmt.Errorf("sprint service: complete: %v: %w", err, ErrInternal)
+	}
+
+	return report, nil
+}
+
+// Report returns the report recorded when the sprint was completed, or a preview of it
+// for an open sprint.
+func (s *sprint) Report(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, sprintID domain.SprintID) (domain.SprintReport, error) {
+	_, err := s.getOwned(ctx, "report", callerID, boardID, sprintID)
+	if err != nil {
+		return domain.SprintReport{}, err
+	}
+
+	report, err := s.sprintRepo.GetReport(ctx, sprintID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.SprintReport{}, ErrSprintNotFound
+		}
+		return domain.SprintReport{}, fmt.Errorf("sprint service: report: %v: %w", err, ErrInternal)
+	}
+
+	return report, nil
+}
+
+func (s *sprint) getOwned(
+	ctx context.Context,
+	op string,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	sprintID domain.SprintID,
+) (domain.Sprint, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Sprint{}, ErrSprintNotFound
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint service: %s get board: %v: %w", op, err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Sprint{}, ErrSprintNotFound
+	}
+
+	sprint, err := s.sprintRepo.Get(ctx, sprintID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Sprint{}, ErrSprintNotFound
+		}
+		return domain.Sprint{}, fmt.Errorf("sprint service: %s get sprint: %v: %w", op, err, ErrInternal)
+	}
+	if sprint.BoardID != boardID {
+		return domain.Sprint{}, ErrSprintNotFound
+	}
+
+	return sprint, nil
+}
@@ -0,0 +1,528 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestSprint_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+
+	tests := []struct {
+		name            string
+		callerID        domain.UserID
+		endDate         time.Time
+		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
+		setupSprintRepo func(t *testing.T, r *MockSprintRepository)
+		wantErr         error
+		wantSprint      domain.Sprint
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			endDate:  validSprint.EndDate,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.CreateFunc = func(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+					want := domain.Sprint{
+						BoardID:   validBoard.ID,
+						Name:      validSprint.Name,
+						Goal:      validSprint.Goal,
+						StartDate: validSprint.StartDate,
+						EndDate:   validSprint.EndDate,
+					}
+					if diff := cmp.Diff(want, sprint, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("Create() sprint mismatch (-want +got):\n%s", diff)
+					}
+					return validSprint, nil
+				}
+			},
+			wantSprint: validSprint,
+		},
+		{
+			name:     "End before start",
+			callerID: validBoard.OwnerID,
+			endDate:  validSprint.StartDate.AddDate(0, 0, -1),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrSprintDatesInvalid,
+		},
+		{
+			name:     "Board not found",
+			callerID: validBoard.OwnerID,
+			endDate:  validSprint.EndDate,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			endDate:  validSprint.EndDate,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			wantErr: service.ErrBoardNotFound,
+		},
+		{
+			name:     "Create internal error",
+			callerID: validBoard.OwnerID,
+			endDate:  validSprint.EndDate,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.CreateFunc = func(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+					return domain.Sprint{}, errors.New("insert failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			sprintRepo := NewMockSprintRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			if tt.setupSprintRepo != nil {
+				tt.setupSprintRepo(t, sprintRepo)
+			}
+
+			s := service.NewSprint(sprintRepo, boardRepo)
+			got, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validSprint.Name, validSprint.Goal, validSprint.StartDate, tt.endDate)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantSprint, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Create() sprint mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSprint_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	completedSprint := validSprint
+	completedSprint.CompletedAt = testutil.FixedNow()
+	otherBoardSprint := testutil.ValidSprint(domain.NewBoardID())
+	newName, err := domain.NewSprintName("Sprint 15")
+	if err != nil {
+		t.Fatalf("NewSprintName() error = %v", err)
+	}
+	newEndDate := validSprint.EndDate.AddDate(0, 0, 7)
+	earlyEndDate := validSprint.StartDate.AddDate(0, 0, -1)
+	updatedSprint := validSprint
+	updatedSprint.Name = newName
+	updatedSprint.EndDate = newEndDate
+
+	tests := []struct {
+		name            string
+		patch           service.SprintPatch
+		setupSprintRepo func(t *testing.T, r *MockSprintRepository)
+		wantErr         error
+		wantSprint      domain.Sprint
+	}{
+		{
+			name:  "Success",
+			patch: service.SprintPatch{Name: &newName, EndDate: &newEndDate},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+					if diff := cmp.Diff(updatedSprint, sprint, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("Update() sprint mismatch (-want +got):\n%s", diff)
+					}
+					return updatedSprint, nil
+				}
+			},
+			wantSprint: updatedSprint,
+		},
+		{
+			name:  "End before start",
+			patch: service.SprintPatch{EndDate: &earlyEndDate},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+			},
+			wantErr: service.ErrSprintDatesInvalid,
+		},
+		{
+			name:  "Sprint completed",
+			patch: service.SprintPatch{Name: &newName},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return completedSprint, nil
+				}
+			},
+			wantErr: service.ErrSprintCompleted,
+		},
+		{
+			name:  "Sprint completed concurrently",
+			patch: service.SprintPatch{Name: &newName},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, sprint domain.Sprint) (domain.Sprint, error) {
+					return domain.Sprint{}, repository.ErrCompleted
+				}
+			},
+			wantErr: service.ErrSprintCompleted,
+		},
+		{
+			name:  "Sprint of another board",
+			patch: service.SprintPatch{Name: &newName},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return otherBoardSprint, nil
+				}
+			},
+			wantErr: service.ErrSprintNotFound,
+		},
+		{
+			name:  "Sprint not found",
+			patch: service.SprintPatch{Name: &newName},
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return domain.Sprint{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrSprintNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			sprintRepo := NewMockSprintRepository(t)
+			tt.setupSprintRepo(t, sprintRepo)
+
+			s := service.NewSprint(sprintRepo, boardRepo)
+			got, err := s.Update(context.Background(), validBoard.OwnerID, validBoard.ID, validSprint.ID, tt.patch)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantSprint, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Update() sprint mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSprint_AddTask(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	completedSprint := validSprint
+	completedSprint.CompletedAt = testutil.FixedNow()
+	taskID := domain.NewTaskID()
+
+	tests := []struct {
+		name            string
+		callerID        domain.UserID
+		setupSprintRepo func(t *testing.T, r *MockSprintRepository)
+		wantErr         error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+				r.AddTaskFunc = func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, gotTaskID domain.TaskID) error {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if sprintID != validSprint.ID {
+						t.Errorf("got sprint id %v, want %v", sprintID, validSprint.ID)
+					}
+					if gotTaskID != taskID {
+						t.Errorf("got task id %v, want %v", gotTaskID, taskID)
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			wantErr:  service.ErrSprintNotFound,
+		},
+		{
+			name:     "Sprint completed",
+			callerID: validBoard.OwnerID,
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return completedSprint, nil
+				}
+			},
+			wantErr: service.ErrSprintCompleted,
+		},
+		{
+			name:     "Task not found",
+			callerID: validBoard.OwnerID,
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+				r.AddTaskFunc = func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+					return repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrTaskNotFound,
+		},
+		{
+			name:     "Sprint completed concurrently",
+			callerID: validBoard.OwnerID,
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+				r.AddTaskFunc = func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, taskID domain.TaskID) error {
+					return repository.ErrCompleted
+				}
+			},
+			wantErr: service.ErrSprintCompleted,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			sprintRepo := NewMockSprintRepository(t)
+			if tt.setupSprintRepo != nil {
+				tt.setupSprintRepo(t, sprintRepo)
+			}
+
+			s := service.NewSprint(sprintRepo, boardRepo)
+			err := s.AddTask(context.Background(), tt.callerID, validBoard.ID, validSprint.ID, taskID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestSprint_Complete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	report := domain.SprintReport{
+		SprintID:      validSprint.ID,
+		Committed:     3,
+		Completed:     2,
+		CompletedAt:   testutil.FixedNow(),
+		CarriedOverTo: domain.NewSprintID(),
+		CarryOver:     []domain.SprintCarryOverTask{{TaskID: domain.NewTaskID(), Name: testutil.ValidTask(domain.NewColumnID()).Name}},
+	}
+
+	tests := []struct {
+		name             string
+		carryOver        domain.SprintCarryOver
+		completeErr      error
+		wantToNextSprint bool
+		wantErr          error
+		wantReport       domain.SprintReport
+	}{
+		{
+			name:             "Carry over to next sprint",
+			carryOver:        domain.SprintCarryOverNextSprint,
+			wantToNextSprint: true,
+			wantReport:       report,
+		},
+		{
+			name:       "Carry over to backlog",
+			carryOver:  domain.SprintCarryOverBacklog,
+			wantReport: report,
+		},
+		{
+			name:             "Already completed",
+			carryOver:        domain.SprintCarryOverNextSprint,
+			completeErr:      repository.ErrCompleted,
+			wantToNextSprint: true,
+			wantErr:          service.ErrSprintCompleted,
+		},
+		{
+			name:             "Sprint not found",
+			carryOver:        domain.SprintCarryOverNextSprint,
+			completeErr:      repository.ErrRowNotFound,
+			wantToNextSprint: true,
+			wantErr:          service.ErrSprintNotFound,
+		},
+		{
+			name:             "Complete internal error",
+			carryOver:        domain.SprintCarryOverNextSprint,
+			completeErr:      errors.New("tx failed"),
+			wantToNextSprint: true,
+			wantErr:          service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			sprintRepo := NewMockSprintRepository(t)
+			sprintRepo.CompleteFunc = func(ctx context.Context, boardID domain.BoardID, sprintID domain.SprintID, toNextSprint bool) (domain.SprintReport, error) {
+				if sprintID != validSprint.ID {
+					t.Errorf("got sprint id %v, want %v", sprintID, validSprint.ID)
+				}
+				if toNextSprint != tt.wantToNextSprint {
+					t.Errorf("got toNextSprint %v, want %v", toNextSprint, tt.wantToNextSprint)
+				}
+				if tt.completeErr != nil {
+					return domain.SprintReport{}, tt.completeErr
+				}
+				return report, nil
+			}
+
+			s := service.NewSprint(sprintRepo, boardRepo)
+			got, err := s.Complete(context.Background(), validBoard.OwnerID, validBoard.ID, validSprint.ID, tt.carryOver)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantReport, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Complete() report mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestSprint_Report(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validSprint := testutil.ValidSprint(validBoard.ID)
+	otherBoardSprint := testutil.ValidSprint(domain.NewBoardID())
+	preview := domain.SprintReport{SprintID: validSprint.ID, Committed: 2, Completed: 1}
+
+	tests := []struct {
+		name            string
+		setupSprintRepo func(t *testing.T, r *MockSprintRepository)
+		wantErr         error
+		wantReport      domain.SprintReport
+	}{
+		{
+			name: "Success",
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+				r.GetReportFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.SprintReport, error) {
+					if sprintID != validSprint.ID {
+						t.Errorf("got sprint id %v, want %v", sprintID, validSprint.ID)
+					}
+					return preview, nil
+				}
+			},
+			wantReport: preview,
+		},
+		{
+			name: "Sprint of another board",
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return otherBoardSprint, nil
+				}
+			},
+			wantErr: service.ErrSprintNotFound,
+		},
+		{
+			name: "Report internal error",
+			setupSprintRepo: func(t *testing.T, r *MockSprintRepository) {
+				r.GetFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.Sprint, error) {
+					return validSprint, nil
+				}
+				r.GetReportFunc = func(ctx context.Context, sprintID domain.SprintID) (domain.SprintReport, error) {
+					return domain.SprintReport{}, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			sprintRepo := NewMockSprintRepository(t)
+			tt.setupSprintRepo(t, sprintRepo)
+
+			s := service.NewSprint(sprintRepo, boardRepo)
+			got, err := s.Report(context.Background(), validBoard.OwnerID, validBoard.ID, validSprint.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantReport, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Report() report mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
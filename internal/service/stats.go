@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type statsRepository interface {
+	BoardStats(ctx context.Context, boardID domain.BoardID, now time.Time) (domain.BoardStats, error)
+	UserStats(ctx context.Context, ownerID domain.UserID, now time.Time) (domain.UserStats, error)
+}
+
+type statsBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type stats struct {
+	statsRepo statsRepository
+	boardRepo statsBoardRepository
+}
+
+func NewStats(statsRepo statsRepository, boardRepo statsBoardRepository) *stats {
+	return &stats{statsRepo: statsRepo, boardRepo: boardRepo}
+}
+
+func (s *stats) BoardStats(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.BoardStats, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.BoardStats{}, ErrBoardNotFound
+		}
+		return domain.BoardStats{}, fmt.Errorf("stats service: board stats get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.BoardStats{}, ErrBoardNotFound
+	}
+
+	boardStats, err := s.statsRepo.BoardStats(ctx, boardID, timeNow())
+	if err != nil {
+		return domain.BoardStats{}, fmt.Errorf("stats service: board stats: %v: %w", err, ErrInternal)
+	}
+
+	return boardStats, nil
+}
+
+// UserStats summarizes all boards owned by the caller.
+func (s *stats) UserStats(ctx context.Context, callerID domain.UserID) (domain.UserStats, error) {
+	userStats, err := s.statsRepo.UserStats(ctx, callerID, timeNow())
+	if err != nil {
+		return domain.UserStats{}, fmt.Errorf("stats service: user stats: %v: %w", err, ErrInternal)
+	}
+
+	return userStats, nil
+}
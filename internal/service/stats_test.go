@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestStats_BoardStats(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	boardStats := domain.BoardStats{
+		BoardID: validBoard.ID,
+		Columns: []domain.ColumnTaskCount{{ColumnID: validColumn.ID, Name: validColumn.Name, Tasks: 3}},
+		TaskStats: domain.TaskStats{
+			Tasks:     3,
+			OpenTasks: 3,
+			Last7Days: domain.TaskActivity{Created: 3, Moved: 1},
+		},
+	}
+
+	tests := []struct {
+		name           string
+		callerID       domain.UserID
+		boardErr       error
+		setupStatsRepo func(t *testing.T, r *MockStatsRepository)
+		wantErr        error
+		wantStats      domain.BoardStats
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupStatsRepo: func(t *testing.T, r *MockStatsRepository) {
+				r.BoardStatsFunc = func(ctx context.Context, boardID domain.BoardID, now time.Time) (domain.BoardStats, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if now.IsZero() || now.Location() != time.UTC {
+						t.Errorf("got now %v, want the current UTC time", now)
+					}
+					return boardStats, nil
+				}
+			},
+			wantStats: boardStats,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Board not found",
+			callerID: validBoard.OwnerID,
+			boardErr: repository.ErrRowNotFound,
+			wantErr:  service.ErrBoardNotFound,
+		},
+		{
+			name:     "Stats internal error",
+			callerID: validBoard.OwnerID,
+			setupStatsRepo: func(t *testing.T, r *MockStatsRepository) {
+				r.BoardStatsFunc = func(ctx context.Context, boardID domain.BoardID, now time.Time) (domain.BoardStats, error) {
+					return domain.BoardStats{}, errors.New("query failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				if tt.boardErr != nil {
+					return domain.Board{}, tt.boardErr
+				}
+				return validBoard, nil
+			}
+			statsRepo := NewMockStatsRepository(t)
+			if tt.setupStatsRepo != nil {
+				tt.setupStatsRepo(t, statsRepo)
+			}
+
+			s := service.NewStats(statsRepo, boardRepo)
+			got, err := s.BoardStats(context.Background(), tt.callerID, validBoard.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantStats, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("BoardStats() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestStats_UserStats(t *testing.T) {
+	t.Parallel()
+
+	userID := testutil.ValidUserID()
+	userStats := domain.UserStats{
+		UserID:    userID,
+		Boards:    2,
+		TaskStats: domain.TaskStats{Tasks: 5, OpenTasks: 4},
+	}
+
+	tests := []struct {
+		name      string
+		statsErr  error
+		wantErr   error
+		wantStats domain.UserStats
+	}{
+		{name: "Success", wantStats: userStats},
+		{name: "Stats internal error", statsErr: errors.New("query failed"), wantErr: service.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			statsRepo := NewMockStatsRepository(t)
+			statsRepo.UserStatsFunc = func(ctx context.Context, ownerID domain.UserID, now time.Time) (domain.UserStats, error) {
+				if ownerID != userID {
+					t.Errorf("got owner id %v, want %v", ownerID, userID)
+				}
+				if tt.statsErr != nil {
+					return domain.UserStats{}, tt.statsErr
+				}
+				return userStats, nil
+			}
+
+			s := service.NewStats(statsRepo, NewMockBoardRepository(t))
+			got, err := s.UserStats(context.Background(), userID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantStats, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("UserStats() mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,365 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+// syncPageSize bounds the events one pull reads.
+const syncPageSize = 500
+
+type syncRepository interface {
+	Snapshot(ctx context.Context, ownerID domain.UserID) (domain.SyncChanges, error)
+	ChangesAfter(ctx context.Context, ownerID domain.UserID, after domain.BoardEventCursor, limit int) (domain.SyncChanges, error)
+}
+
+type syncColumnRepository interface {
+	Get(ctx context.Context, columnID domain.ColumnID) (domain.Column, error)
+}
+
+type syncTaskRepository interface {
+	Get(ctx context.Context, taskID domain.TaskID) (domain.Task, error)
+}
+
+type syncBoardService interface {
+	Create(ctx context.Context, callerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error)
+	Get(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) (domain.Board, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name *domain.BoardName, description *domain.BoardDescription, ifMatch domain.Version) (domain.Board, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error
+}
+
+type syncColumnService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, patch domain.ColumnPatch, ifMatch domain.Version) (domain.Column, error)
+	Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, ifMatch domain.Version) error
+}
+
+type syncTaskService interface {
+	Create(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage) (domain.Task, error)
+	Update(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version) (domain.Task, error)
+	Move(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version) (domain.TaskPlacement, error)
+	Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, ifMatch domain.Version) error
+}
+
+// deltaSync keeps the replicas of offline-capable clients: it tells them what changed since
+// they last synced and applies the changes they made offline. Changes are written through the
+// board, column and task services, so they are checked like the requests of online clients.
+type deltaSync struct {
+	syncRepo      syncRepository
+	columnRepo    syncColumnRepository
+	taskRepo      syncTaskRepository
+	boardService  syncBoardService
+	columnService syncColumnService
+	taskService   syncTaskService
+}
+
+func NewSync(
+	syncRepo syncRepository,
+	columnRepo syncColumnRepository,
+	taskRepo syncTaskRepository,
+	boardService syncBoardService,
+	columnService syncColumnService,
+	taskService syncTaskService,
+) *deltaSync {
+	return &deltaSync{
+		syncRepo:      syncRepo,
+		columnRepo:    columnRepo,
+		taskRepo:      taskRepo,
+		boardService:  boardService,
+		columnService: columnService,
+		taskService:   taskService,
+	}
+}
+
+// SyncResult is the outcome of an operation of a push. Board, Column or Task, following the
+// entity of the operation, is the entity as the operation left it, or as the server has it on a
+// conflict; nothing is set for deletes. Err is why a rejected, gone or failed operation was not
+// applied, like ErrIndexOutOfBounds or a *TransitionNotAllowedError.
+type SyncResult struct {
+	Ref    string
+	Type   domain.SyncOperationType
+	Status domain.SyncStatus
+	Board  domain.Board
+	Column domain.Column
+	Task   domain.Task
+	Err    error
+}
+
+// Changes returns what changed on the boards of the caller after since, a page at a time, or
+// everything the caller has if since is nil.
+func (s *deltaSync) Changes(ctx context.Context, callerID domain.UserID, since *domain.BoardEventCursor) (domain.SyncChanges, error) {
+	if since == nil {
+		changes, err := s.syncRepo.Snapshot(ctx, callerID)
+		if err != nil {
+			return domain.SyncChanges{}, fmt.Errorf("sync service: snapshot: %v: %w", err, ErrInternal)
+		}
+		return changes, nil
+	}
+
+	changes, err := s.syncRepo.ChangesAfter(ctx, callerID, *since, syncPageSize)
+	if err != nil {
+		return domain.SyncChanges{}, fmt.Errorf("sync service: changes after: %v: %w", err, ErrInternal)
+	}
+
+	return changes, nil
+}
+
+// Push applies the operations in order, each on its own, and returns the outcome of every one.
+// An operation that is not applied doesn't stop the ones after it, except for a failure of the
+// server: the remaining operations are skipped then.
+func (s *deltaSync) Push(ctx context.Context, callerID domain.UserID, operations []domain.SyncOperation) []SyncResult {
+	created := make(map[string]uuid.UUID)
+	results := make([]SyncResult, len(operations))
+	failed := false
+
+	for i := range operations {
+		operation := operations[i]
+		result := &results[i]
+		result.Ref, result.Type = operation.Ref, operation.Type
+
+		if failed || !resolveSyncRefs(&operation, created) {
+			result.Status = domain.SyncSkipped
+			continue
+		}
+
+		err := s.apply(ctx, callerID, &operation, result)
+		switch {
+		case err == nil:
+			result.Status = domain.SyncApplied
+			if operation.Type.IsCreate() && operation.Ref != "" {
+				created[operation.Ref] = createdSyncID(result)
+			}
+		case errors.Is(err, ErrVersionConflict):
+			result.Status = domain.SyncConflict
+			result.Err = err
+			err = s.current(ctx, callerID, &operation, result)
+			if err != nil {
+				result.Status, result.Err = syncFailureStatus(err), err
+			}
+		default:
+			result.Status, result.Err = syncFailureStatus(err), err
+		}
+
+		failed = result.Status == domain.SyncFailed
+	}
+
+	return results
+}
+
+// apply writes the operation and sets the entity it leaves on result.
+func (s *deltaSync) apply(ctx context.Context, callerID domain.UserID, operation *domain.SyncOperation, result *SyncResult) error {
+	var err error
+
+	switch operation.Type {
+	case domain.SyncBoardCreate:
+		result.Board, err = s.boardService.Create(ctx, callerID, *operation.BoardName, valueOrZero(operation.BoardDescription))
+	case domain.SyncBoardUpdate:
+		result.Board, err = s.boardService.Update(
+			ctx, callerID, operation.BoardID, operation.BoardName, operation.BoardDescription, operation.BaseVersion,
+		)
+	case domain.SyncBoardDelete:
+		err = s.boardService.Delete(ctx, callerID, operation.BoardID, operation.BaseVersion)
+	case domain.SyncColumnCreate:
+		result.Column, err = s.columnService.Create(
+			ctx, callerID, operation.BoardID, *operation.ColumnName, valueOrZero(operation.ColumnDescription),
+		)
+	case domain.SyncColumnUpdate, domain.SyncColumnMove, domain.SyncColumnDelete:
+		err = s.applyToColumn(ctx, callerID, operation, result)
+	case domain.SyncTaskCreate:
+		var column domain.Column
+		column, err = s.column(ctx, operation.ColumnID)
+		if err != nil {
+			return err
+		}
+		result.Task, err = s.taskService.Create(
+			ctx, callerID, column.BoardID, column.ID, domain.LaneID{}, domain.TaskID{},
+			*operation.TaskName, valueOrZero(operation.TaskDescription), valueOrZero(operation.Checklist),
+			valueOrZero(operation.Estimate), nil,
+		)
+	case domain.SyncTaskUpdate, domain.SyncTaskMove, domain.SyncTaskDelete:
+		err = s.applyToTask(ctx, callerID, operation, result)
+	default:
+		return fmt.Errorf("unknown sync operation type %q: %w", operation.Type, ErrInternal)
+	}
+
+	return err
+}
+
+// applyToColumn changes a column found where it is now, as the client may not know it moved.
+func (s *deltaSync) applyToColumn(ctx context.Context, callerID domain.UserID, operation *domain.SyncOperation, result *SyncResult) error {
+	column, err := s.column(ctx, operation.ColumnID)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Type {
+	case domain.SyncColumnUpdate:
+		result.Column, err = s.columnService.Update(
+			ctx, callerID, column.BoardID, column.ID,
+			domain.ColumnPatch{Name: operation.ColumnName, Description: operation.ColumnDescription}, operation.BaseVersion,
+		)
+		return err
+	case domain.SyncColumnMove:
+		_, err = s.columnService.Move(ctx, callerID, column.BoardID, column.ID, operation.ColumnPosition, operation.BaseVersion)
+		if err != nil {
+			return err
+		}
+		result.Column, err = s.column(ctx, column.ID)
+		return err
+	default:
+		return s.columnService.Delete(ctx, callerID, column.BoardID, column.ID, operation.BaseVersion)
+	}
+}
+
+// applyToTask changes a task found in the column it is in now, as the client may not know it
+// moved.
+func (s *deltaSync) applyToTask(ctx context.Context, callerID domain.UserID, operation *domain.SyncOperation, result *SyncResult) error {
+	task, column, err := s.task(ctx, operation.TaskID)
+	if err != nil {
+		return err
+	}
+
+	switch operation.Type {
+	case domain.SyncTaskUpdate:
+		result.Task, err = s.taskService.Update(
+			ctx, callerID, column.BoardID, column.ID, task.ID, operation.TaskName, operation.TaskDescription,
+			operation.Checklist, operation.Estimate, nil, operation.BaseVersion,
+		)
+		return err
+	case domain.SyncTaskMove:
+		_, err = s.taskService.Move(
+			ctx, callerID, column.BoardID, column.ID, task.ID, operation.TargetColumnID, nil,
+			operation.TaskPosition, operation.BaseVersion,
+		)
+		if err != nil {
+			return err
+		}
+		result.Task, _, err = s.task(ctx, task.ID)
+		return err
+	default:
+		return s.taskService.Delete(ctx, callerID, column.BoardID, column.ID, task.ID, false, operation.BaseVersion)
+	}
+}
+
+// current sets the entity of the operation on result as the server has it.
+func (s *deltaSync) current(ctx context.Context, callerID domain.UserID, operation *domain.SyncOperation, result *SyncResult) error {
+	var err error
+
+	switch operation.Type.Entity() {
+	case domain.BoardEventBoard:
+		result.Board, err = s.boardService.Get(ctx, callerID, operation.BoardID)
+	case domain.BoardEventColumn:
+		result.Column, err = s.column(ctx, operation.ColumnID)
+	case domain.BoardEventTask:
+		result.Task, _, err = s.task(ctx, operation.TaskID)
+	}
+
+	return err
+}
+
+func (s *deltaSync) column(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Column{}, ErrColumnNotFound
+		}
+		return domain.Column{}, fmt.Errorf("sync service: get column: %v: %w", err, ErrInternal)
+	}
+
+	return column, nil
+}
+
+func (s *deltaSync) task(ctx context.Context, taskID domain.TaskID) (domain.Task, domain.Column, error) {
+	task, err := s.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, domain.Column{}, ErrTaskNotFound
+		}
+		return domain.Task{}, domain.Column{}, fmt.Errorf("sync service: get task: %v: %w", err, ErrInternal)
+	}
+
+	column, err := s.column(ctx, task.ColumnID)
+	if err != nil {
+		if errors.Is(err, ErrColumnNotFound) {
+			return domain.Task{}, domain.Column{}, ErrTaskNotFound
+		}
+		return domain.Task{}, domain.Column{}, err
+	}
+
+	return task, column, nil
+}
+
+// syncFailureStatus tells how an operation that was not applied ended.
+func syncFailureStatus(err error) domain.SyncStatus {
+	switch {
+	case errors.Is(err, ErrInternal):
+		return domain.SyncFailed
+	case errors.Is(err, ErrBoardNotFound), errors.Is(err, ErrColumnNotFound),
+		errors.Is(err, ErrTaskNotFound), errors.Is(err, ErrLaneNotFound):
+		return domain.SyncGone
+	default:
+		return domain.SyncRejected
+	}
+}
+
+// resolveSyncRefs sets the ids of the entities the operation names by the ref of their create.
+// It reports false if one of those creates was not applied.
+func resolveSyncRefs(operation *domain.SyncOperation, created map[string]uuid.UUID) bool {
+	resolved := true
+	resolve := func(ref string, set func(uuid.UUID) error) {
+		if ref == "" {
+			return
+		}
+		id, ok := created[ref]
+		if !ok || set(id) != nil {
+			resolved = false
+		}
+	}
+
+	resolve(operation.BoardRef, func(id uuid.UUID) (err error) {
+		operation.BoardID, err = domain.NewBoardIDFromUUID(id)
+		return err
+	})
+	resolve(operation.ColumnRef, func(id uuid.UUID) (err error) {
+		operation.ColumnID, err = domain.NewColumnIDFromUUID(id)
+		return err
+	})
+	resolve(operation.TaskRef, func(id uuid.UUID) (err error) {
+		operation.TaskID, err = domain.NewTaskIDFromUUID(id)
+		return err
+	})
+	resolve(operation.TargetColumnRef, func(id uuid.UUID) (err error) {
+		operation.TargetColumnID, err = domain.NewColumnIDFromUUID(id)
+		return err
+	})
+
+	return resolved
+}
+
+// createdSyncID is the id of the entity an applied create operation added.
+func createdSyncID(result *SyncResult) uuid.UUID {
+	switch result.Type.Entity() {
+	case domain.BoardEventBoard:
+		return result.Board.ID.UUID()
+	case domain.BoardEventColumn:
+		return result.Column.ID.UUID()
+	default:
+		return result.Task.ID.UUID()
+	}
+}
+
+func valueOrZero[T any](value *T) T {
+	var zero T
+	if value == nil {
+		return zero
+	}
+	return *value
+}
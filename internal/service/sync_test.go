@@ -0,0 +1,300 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestSync_Changes(t *testing.T) {
+	t.Parallel()
+
+	callerID := testutil.ValidUserID()
+	since := domain.NewBoardEventCursor(742, 1093)
+	changes := domain.SyncChanges{Cursor: domain.NewBoardEventCursor(800, 0), Boards: []domain.Board{testutil.ValidBoard()}}
+
+	tests := []struct {
+		name      string
+		since     *domain.BoardEventCursor
+		setupRepo func(t *testing.T, r *MockSyncRepository)
+		want      domain.SyncChanges
+		wantErr   error
+	}{
+		{
+			name: "Success takes a snapshot without a cursor",
+			setupRepo: func(t *testing.T, r *MockSyncRepository) {
+				r.SnapshotFunc = func(ctx context.Context, ownerID domain.UserID) (domain.SyncChanges, error) {
+					if ownerID != callerID {
+						t.Errorf("got owner %v, want %v", ownerID, callerID)
+					}
+					return changes, nil
+				}
+			},
+			want: changes,
+		},
+		{
+			name:  "Success reads changes after the cursor",
+			since: &since,
+			setupRepo: func(t *testing.T, r *MockSyncRepository) {
+				r.ChangesAfterFunc = func(ctx context.Context, ownerID domain.UserID, after domain.BoardEventCursor, limit int) (domain.SyncChanges, error) {
+					if ownerID != callerID || after != since || limit != 500 {
+						t.Errorf("got (%v, %v, %d), want (%v, %v, 500)", ownerID, after, limit, callerID, since)
+					}
+					return changes, nil
+				}
+			},
+			want: changes,
+		},
+		{
+			name: "Snapshot error",
+			setupRepo: func(t *testing.T, r *MockSyncRepository) {
+				r.SnapshotFunc = func(ctx context.Context, ownerID domain.UserID) (domain.SyncChanges, error) {
+					return domain.SyncChanges{}, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+		{
+			name:  "Changes error",
+			since: &since,
+			setupRepo: func(t *testing.T, r *MockSyncRepository) {
+				r.ChangesAfterFunc = func(ctx context.Context, ownerID domain.UserID, after domain.BoardEventCursor, limit int) (domain.SyncChanges, error) {
+					return domain.SyncChanges{}, repository.ErrInternal
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			syncRepo := NewMockSyncRepository(t)
+			tt.setupRepo(t, syncRepo)
+			s := service.NewSync(
+				syncRepo, NewMockColumnRepository(t), NewMockTaskRepository(t),
+				NewMockSyncBoardService(t), NewMockSyncColumnService(t), NewMockSyncTaskService(t),
+			)
+
+			got, err := s.Changes(context.Background(), callerID, tt.since)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("changes mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+type syncMocks struct {
+	columnRepo    *MockColumnRepository
+	taskRepo      *MockTaskRepository
+	boardService  *MockSyncBoardService
+	columnService *MockSyncColumnService
+	taskService   *MockSyncTaskService
+}
+
+func TestSync_Push(t *testing.T) {
+	t.Parallel()
+
+	callerID := testutil.ValidUserID()
+	board := testutil.ValidBoard()
+	column := testutil.ValidColumn(board.ID)
+	task := testutil.ValidTask(column.ID)
+	targetColumn := testutil.ValidColumn(board.ID)
+	baseVersion := task.Version
+	serverTask := task
+	serverTask.Version, _ = domain.NewVersion(3)
+	movedTask := task
+	movedTask.ColumnID = targetColumn.ID
+	name := task.Name
+	position, _ := domain.NewTaskPosition(2)
+
+	getColumn := func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+		switch columnID {
+		case column.ID:
+			return column, nil
+		case targetColumn.ID:
+			return targetColumn, nil
+		}
+		return domain.Column{}, repository.ErrRowNotFound
+	}
+
+	tests := []struct {
+		name        string
+		operations  []domain.SyncOperation
+		setup       func(t *testing.T, m *syncMocks)
+		wantResults []service.SyncResult
+	}{
+		{
+			name: "Success creates refer to earlier creates",
+			operations: []domain.SyncOperation{
+				{Ref: "b", Type: domain.SyncBoardCreate, BoardName: &board.Name},
+				{Ref: "c", Type: domain.SyncColumnCreate, BoardRef: "b", ColumnName: &column.Name},
+				{Type: domain.SyncTaskCreate, ColumnRef: "c", TaskName: &task.Name},
+			},
+			setup: func(t *testing.T, m *syncMocks) {
+				m.boardService.CreateFunc = func(ctx context.Context, gotCallerID domain.UserID, name domain.BoardName, description domain.BoardDescription) (domain.Board, error) {
+					if gotCallerID != callerID {
+						t.Errorf("got caller %v, want %v", gotCallerID, callerID)
+					}
+					return board, nil
+				}
+				m.columnService.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error) {
+					if boardID != board.ID {
+						t.Errorf("got board %v, want the created %v", boardID, board.ID)
+					}
+					return column, nil
+				}
+				m.columnRepo.GetFunc = getColumn
+				m.taskService.CreateFunc = func(
+					ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID,
+					laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription,
+					checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage,
+				) (domain.Task, error) {
+					if boardID != board.ID || columnID != column.ID {
+						t.Errorf("got (%v, %v), want the created (%v, %v)", boardID, columnID, board.ID, column.ID)
+					}
+					return task, nil
+				}
+			},
+			wantResults: []service.SyncResult{
+				{Ref: "b", Type: domain.SyncBoardCreate, Status: domain.SyncApplied, Board: board},
+				{Ref: "c", Type: domain.SyncColumnCreate, Status: domain.SyncApplied, Column: column},
+				{Type: domain.SyncTaskCreate, Status: domain.SyncApplied, Task: task},
+			},
+		},
+		{
+			name: "Operations on a create that was not applied are skipped",
+			operations: []domain.SyncOperation{
+				{Ref: "c", Type: domain.SyncColumnCreate, BoardID: board.ID, ColumnName: &column.Name},
+				{Type: domain.SyncTaskCreate, ColumnRef: "c", TaskName: &task.Name},
+			},
+			setup: func(t *testing.T, m *syncMocks) {
+				m.columnService.CreateFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, name domain.ColumnName, description domain.ColumnDescription) (domain.Column, error) {
+					return domain.Column{}, service.ErrBoardNotFound
+				}
+			},
+			wantResults: []service.SyncResult{
+				{Ref: "c", Type: domain.SyncColumnCreate, Status: domain.SyncGone, Err: service.ErrBoardNotFound},
+				{Type: domain.SyncTaskCreate, Status: domain.SyncSkipped},
+			},
+		},
+		{
+			name:       "Conflict returns the task as the server has it",
+			operations: []domain.SyncOperation{{Type: domain.SyncTaskUpdate, TaskID: task.ID, BaseVersion: baseVersion, TaskName: &name}},
+			setup: func(t *testing.T, m *syncMocks) {
+				m.taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return serverTask, nil
+				}
+				m.columnRepo.GetFunc = getColumn
+				m.taskService.UpdateFunc = func(
+					ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID,
+					name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist,
+					estimate *domain.TaskEstimate, customFields map[domain.CustomFieldID]json.RawMessage, ifMatch domain.Version,
+				) (domain.Task, error) {
+					if ifMatch != baseVersion {
+						t.Errorf("got if match %v, want %v", ifMatch, baseVersion)
+					}
+					return domain.Task{}, service.ErrVersionConflict
+				}
+			},
+			wantResults: []service.SyncResult{
+				{Type: domain.SyncTaskUpdate, Status: domain.SyncConflict, Task: serverTask, Err: service.ErrVersionConflict},
+			},
+		},
+		{
+			name: "Task moved on the server is found in its column",
+			operations: []domain.SyncOperation{
+				{Type: domain.SyncTaskMove, TaskID: task.ID, TargetColumnID: targetColumn.ID, TaskPosition: position},
+			},
+			setup: func(t *testing.T, m *syncMocks) {
+				m.taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return movedTask, nil
+				}
+				m.columnRepo.GetFunc = getColumn
+				m.taskService.MoveFunc = func(
+					ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID,
+					targetColumnID domain.ColumnID, targetLaneID *domain.LaneID, targetPosition domain.TaskPosition, ifMatch domain.Version,
+				) (domain.TaskPlacement, error) {
+					if columnID != targetColumn.ID || targetLaneID != nil || targetPosition != position {
+						t.Errorf("got (%v, %v, %v), want (%v, nil, %v)", columnID, targetLaneID, targetPosition, targetColumn.ID, position)
+					}
+					return domain.TaskPlacement{}, nil
+				}
+			},
+			wantResults: []service.SyncResult{
+				{Type: domain.SyncTaskMove, Status: domain.SyncApplied, Task: movedTask},
+			},
+		},
+		{
+			name: "Deleted entities are gone and broken rules rejected",
+			operations: []domain.SyncOperation{
+				{Type: domain.SyncTaskDelete, TaskID: task.ID},
+				{Type: domain.SyncColumnMove, ColumnID: column.ID},
+			},
+			setup: func(t *testing.T, m *syncMocks) {
+				m.taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, repository.ErrRowNotFound
+				}
+				m.columnRepo.GetFunc = getColumn
+				m.columnService.MoveFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, columnID domain.ColumnID, targetPosition domain.ColumnPosition, ifMatch domain.Version) (domain.ColumnPlacement, error) {
+					return domain.ColumnPlacement{}, service.ErrIndexOutOfBounds
+				}
+			},
+			wantResults: []service.SyncResult{
+				{Type: domain.SyncTaskDelete, Status: domain.SyncGone, Err: service.ErrTaskNotFound},
+				{Type: domain.SyncColumnMove, Status: domain.SyncRejected, Err: service.ErrIndexOutOfBounds},
+			},
+		},
+		{
+			name: "Failure skips the remaining operations",
+			operations: []domain.SyncOperation{
+				{Type: domain.SyncBoardDelete, BoardID: board.ID},
+				{Type: domain.SyncBoardUpdate, BoardID: board.ID, BoardName: &board.Name},
+			},
+			setup: func(t *testing.T, m *syncMocks) {
+				m.boardService.DeleteFunc = func(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, ifMatch domain.Version) error {
+					return service.ErrInternal
+				}
+			},
+			wantResults: []service.SyncResult{
+				{Type: domain.SyncBoardDelete, Status: domain.SyncFailed, Err: service.ErrInternal},
+				{Type: domain.SyncBoardUpdate, Status: domain.SyncSkipped},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			m := &syncMocks{
+				columnRepo:    NewMockColumnRepository(t),
+				taskRepo:      NewMockTaskRepository(t),
+				boardService:  NewMockSyncBoardService(t),
+				columnService: NewMockSyncColumnService(t),
+				taskService:   NewMockSyncTaskService(t),
+			}
+			tt.setup(t, m)
+			s := service.NewSync(NewMockSyncRepository(t), m.columnRepo, m.taskRepo, m.boardService, m.columnService, m.taskService)
+
+			got := s.Push(context.Background(), callerID, tt.operations)
+			if diff := cmp.Diff(tt.wantResults, got, testutil.CmpAllowUnexported(), cmp.Comparer(func(a, b error) bool {
+				return errors.Is(a, b) || errors.Is(b, a)
+			})); diff != "" {
+				t.Errorf("results mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
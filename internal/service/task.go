@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -10,12 +11,17 @@ import (
 )
 
 type taskRepository interface {
-	Create(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error)
-	ListByColumnID(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error)
+	Create(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error)
+	ListByColumnID(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error)
+	ListChildren(ctx context.Context, parentID domain.TaskID) ([]domain.Task, error)
 	Get(ctx context.Context, taskID domain.TaskID) (domain.Task, error)
-	Update(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error)
-	Move(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error)
-	Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error
+	Update(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error)
+	SetParent(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, parentID domain.TaskID) (domain.Task, error)
+	Move(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID domain.LaneID, targetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error)
+	Delete(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error
+	Duplicate(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	Restore(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error)
+	ApplyBatch(ctx context.Context, boardID domain.BoardID, changes []domain.TaskChange) ([]domain.TaskBatchResult, error)
 }
 
 type taskBoardRepository interface {
@@ -26,27 +32,111 @@ type taskColumnRepository interface {
 	Get(ctx context.Context, columnID domain.ColumnID) (domain.Column, error)
 }
 
+type taskLaneRepository interface {
+	Get(ctx context.Context, laneID domain.LaneID) (domain.Lane, error)
+}
+
+type taskTemplateRepository interface {
+	Get(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error)
+}
+
+type taskCustomFieldRepository interface {
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error)
+}
+
+type taskBlockerRepository interface {
+	ListUnfinishedBlockers(ctx context.Context, taskID domain.TaskID) ([]domain.TaskID, error)
+}
+
+// TaskBlockedError is returned when a task can't be moved into a done column
+// because the tasks blocking it are not finished yet.
+type TaskBlockedError struct {
+	BlockerIDs []domain.TaskID
+}
+
+func (e *TaskBlockedError) Error() string {
+	return fmt.Sprintf("task is blocked by %d unfinished tasks", len(e.BlockerIDs))
+}
+
+func (e *TaskBlockedError) Is(target error) bool {
+	return target == ErrTaskBlocked
+}
+
+// TransitionNotAllowedError is returned when the workflow rules of a board don't let a task
+// move into the target column. Allowed is false when the source column doesn't list the
+// target column among its transitions, Restricted is set when the target column doesn't let
+// the role making the move in, and UnmetConditions are the entry conditions of the target
+// column the task doesn't meet.
+type TransitionNotAllowedError struct {
+	Allowed         bool
+	Restricted      bool
+	UnmetConditions []domain.ColumnEntryCondition
+}
+
+func (e *TransitionNotAllowedError) Error() string {
+	if !e.Allowed {
+		return "task transition is not allowed between these columns"
+	}
+	if e.Restricted {
+		return "target column doesn't let this role move tasks into it"
+	}
+	return fmt.Sprintf("task doesn't meet %d entry conditions of the target column", len(e.UnmetConditions))
+}
+
+func (e *TransitionNotAllowedError) Is(target error) bool {
+	return target == ErrTransitionNotAllowed
+}
+
 type task struct {
-	taskRepo   taskRepository
-	boardRepo  taskBoardRepository
-	columnRepo taskColumnRepository
+	taskRepo        taskRepository
+	boardRepo       taskBoardRepository
+	columnRepo      taskColumnRepository
+	laneRepo        taskLaneRepository
+	templateRepo    taskTemplateRepository
+	blockerRepo     taskBlockerRepository
+	customFieldRepo taskCustomFieldRepository
+	enforceBlockers bool
 }
 
-func NewTask(taskRepo taskRepository, boardRepo taskBoardRepository, columnRepo taskColumnRepository) *task {
+// NewTask creates the task service. With enforceBlockers set, a task can't be moved into
+// a done column while any task blocking it is outside of a done column.
+func NewTask(
+	taskRepo taskRepository,
+	boardRepo taskBoardRepository,
+	columnRepo taskColumnRepository,
+	laneRepo taskLaneRepository,
+	templateRepo taskTemplateRepository,
+	blockerRepo taskBlockerRepository,
+	customFieldRepo taskCustomFieldRepository,
+	enforceBlockers bool,
+) *task {
 	return &task{
-		taskRepo:   taskRepo,
-		boardRepo:  boardRepo,
-		columnRepo: columnRepo,
+		taskRepo:        taskRepo,
+		boardRepo:       boardRepo,
+		columnRepo:      columnRepo,
+		laneRepo:        laneRepo,
+		templateRepo:    templateRepo,
+		blockerRepo:     blockerRepo,
+		customFieldRepo: customFieldRepo,
+		enforceBlockers: enforceBlockers,
 	}
 }
 
+// Create appends a task to the column within the lane, or within the default lane when laneID is nil.
+// Non-nil laneID and parentID must belong to the same board. Custom field values are validated
+// against the fields of the board and rejected with a CustomFieldValuesError.
 func (s *task) Create(
 	ctx context.Context,
 	callerID domain.UserID,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
+	laneID domain.LaneID,
+	parentID domain.TaskID,
 	name domain.TaskName,
 	description domain.TaskDescription,
+	checklist domain.TaskChecklist,
+	estimate domain.TaskEstimate,
+	customFields map[domain.CustomFieldID]json.RawMessage,
 ) (domain.Task, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
@@ -70,7 +160,26 @@ func (s *task) Create(
 		return domain.Task{}, ErrColumnNotFound
 	}
 
-	task, err := s.taskRepo.Create(ctx, columnID, name, description)
+	if !laneID.IsNil() {
+		err = s.checkLane(ctx, boardID, laneID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("task service: create: %w", err)
+		}
+	}
+
+	if !parentID.IsNil() {
+		err = s.checkParent(ctx, boardID, parentID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("task service: create: %w", err)
+		}
+	}
+
+	values, err := s.withCustomFieldChanges(ctx, boardID, domain.TaskCustomFields{}, customFields)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task service: create: %w", err)
+	}
+
+	task, err := s.taskRepo.Create(ctx, columnID, laneID, parentID, name, description, checklist, estimate, values)
 	if err != nil {
 		return domain.Task{}, fmt.Errorf("task service: create: %v: %w", err, ErrInternal)
 	}
@@ -78,11 +187,108 @@ func (s *task) Create(
 	return task, nil
 }
 
+// CreateFromTemplate creates a task from a template of the same board. Fields left nil are
+// taken from the template, with its placeholders expanded at the current time. Non-nil
+// laneID and parentID must belong to the same board.
+func (s *task) CreateFromTemplate(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	laneID domain.LaneID,
+	parentID domain.TaskID,
+	templateID domain.TaskTemplateID,
+	name *domain.TaskName,
+	description *domain.TaskDescription,
+	checklist *domain.TaskChecklist,
+	estimate domain.TaskEstimate,
+	customFields map[domain.CustomFieldID]json.RawMessage,
+) (domain.Task, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrColumnNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: create from template get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Task{}, ErrColumnNotFound
+	}
+
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrColumnNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: create from template get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return domain.Task{}, ErrColumnNotFound
+	}
+
+	template, err := s.templateRepo.Get(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskTemplateNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: create from template get template: %v: %w", err, ErrInternal)
+	}
+	if template.BoardID != boardID {
+		return domain.Task{}, ErrTaskTemplateNotFound
+	}
+
+	if !laneID.IsNil() {
+		err = s.checkLane(ctx, boardID, laneID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("task service: create from template: %w", err)
+		}
+	}
+
+	if !parentID.IsNil() {
+		err = s.checkParent(ctx, boardID, parentID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("task service: create from template: %w", err)
+		}
+	}
+
+	values, err := s.withCustomFieldChanges(ctx, boardID, domain.TaskCustomFields{}, customFields)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task service: create from template: %w", err)
+	}
+
+	renderedName, renderedDescription, err := template.Render(timeNow())
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task service: create from template render: %v: %w", err, ErrInternal)
+	}
+	if name == nil {
+		name = &renderedName
+	}
+	if description == nil {
+		description = &renderedDescription
+	}
+	if checklist == nil {
+		checklist = &template.Checklist
+	}
+
+	task, err := s.taskRepo.Create(ctx, columnID, laneID, parentID, *name, *description, *checklist, estimate, values)
+	if err != nil {
+		return domain.Task{}, fmt.Errorf("task service: create from template: %v: %w", err, ErrInternal)
+	}
+
+	return task, nil
+}
+
+// ListByColumnID lists the tasks of the column. With filters, given as text by custom field,
+// only the tasks holding every filtered value are listed; for multi select fields a task must
+// hold the filtered option. Filters are rejected with a CustomFieldValuesError when they don't
+// fit the fields of the board. Archived tasks are listed only with includeArchived.
 func (s *task) ListByColumnID(
 	ctx context.Context,
 	callerID domain.UserID,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
+	filters map[domain.CustomFieldID][]string,
+	includeArchived bool,
 ) ([]domain.Task, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
@@ -106,7 +312,19 @@ func (s *task) ListByColumnID(
 		return nil, ErrColumnNotFound
 	}
 
-	tasks, err := s.taskRepo.ListByColumnID(ctx, columnID)
+	var filter domain.TaskCustomFields
+	if len(filters) > 0 {
+		fields, fieldsErr := s.customFieldRepo.ListByBoardID(ctx, boardID)
+		if fieldsErr != nil {
+			return nil, fmt.Errorf("task service: list custom fields: %v: %w", fieldsErr, ErrInternal)
+		}
+		filter, err = newCustomFieldFilter(fields, filters)
+		if err != nil {
+			return nil, fmt.Errorf("task service: list: %w", err)
+		}
+	}
+
+	tasks, err := s.taskRepo.ListByColumnID(ctx, columnID, filter, includeArchived)
 	if err != nil {
 		return nil, fmt.Errorf("task service: list: %v: %w", err, ErrInternal)
 	}
@@ -114,6 +332,8 @@ func (s *task) ListByColumnID(
 	return tasks, nil
 }
 
+// Update changes the task. A set ifMatch is the version the caller last saw: if the task has
+// changed since, nothing is written and ErrVersionConflict is returned.
 func (s *task) Update(
 	ctx context.Context,
 	callerID domain.UserID,
@@ -122,6 +342,10 @@ func (s *task) Update(
 	taskID domain.TaskID,
 	name *domain.TaskName,
 	description *domain.TaskDescription,
+	checklist *domain.TaskChecklist,
+	estimate *domain.TaskEstimate,
+	customFields map[domain.CustomFieldID]json.RawMessage,
+	ifMatch domain.Version,
 ) (domain.Task, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
@@ -155,28 +379,48 @@ func (s *task) Update(
 	if task.ColumnID != columnID {
 		return domain.Task{}, ErrTaskNotFound
 	}
+	if ifMatch.IsSet() && ifMatch != task.Version {
+		return domain.Task{}, ErrVersionConflict
+	}
 
-	if name == nil && description == nil {
+	if name == nil && description == nil && checklist == nil && estimate == nil && len(customFields) == 0 {
 		return task, nil
 	}
 
-	updated, err := s.taskRepo.Update(ctx, columnID, taskID, name, description)
+	var values *domain.TaskCustomFields
+	if len(customFields) > 0 {
+		changed, changeErr := s.withCustomFieldChanges(ctx, boardID, task.CustomFields, customFields)
+		if changeErr != nil {
+			return domain.Task{}, fmt.Errorf("task service: update: %w", changeErr)
+		}
+		values = &changed
+	}
+
+	updated, err := s.taskRepo.Update(ctx, columnID, taskID, name, description, checklist, estimate, values, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
 			return domain.Task{}, ErrTaskNotFound
 		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return domain.Task{}, ErrVersionConflict
+		}
 		return domain.Task{}, fmt.Errorf("task service: update: %v: %w", err, ErrInternal)
 	}
 
 	return updated, nil
 }
 
+// Delete removes the task unless ifMatch is set and the task has changed since that version.
+// Its children become top-level tasks, or are removed together with all of their descendants
+// when cascade is set.
 func (s *task) Delete(
 	ctx context.Context,
 	callerID domain.UserID,
 	boardID domain.BoardID,
 	columnID domain.ColumnID,
 	taskID domain.TaskID,
+	cascade bool,
+	ifMatch domain.Version,
 ) error {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
@@ -210,18 +454,198 @@ func (s *task) Delete(
 	if task.ColumnID != columnID {
 		return ErrTaskNotFound
 	}
+	if ifMatch.IsSet() && ifMatch != task.Version {
+		return ErrVersionConflict
+	}
 
-	err = s.taskRepo.Delete(ctx, boardID, columnID, taskID)
+	err = s.taskRepo.Delete(ctx, boardID, columnID, taskID, cascade, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
 			return ErrTaskNotFound
 		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return ErrVersionConflict
+		}
 		return fmt.Errorf("task service: delete: %v: %w", err, ErrInternal)
 	}
 
 	return nil
 }
 
+// ListChildren returns the direct children of the task. They may sit in any column of the board.
+func (s *task) ListChildren(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) ([]domain.Task, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("task service: list children get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return nil, ErrTaskNotFound
+	}
+
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("task service: list children get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return nil, ErrTaskNotFound
+	}
+
+	task, err := s.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("task service: list children get task: %v: %w", err, ErrInternal)
+	}
+	if task.ColumnID != columnID {
+		return nil, ErrTaskNotFound
+	}
+
+	children, err := s.taskRepo.ListChildren(ctx, taskID)
+	if err != nil {
+		return nil, fmt.Errorf("task service: list children: %v: %w", err, ErrInternal)
+	}
+
+	return children, nil
+}
+
+// SetParent moves the task under parentID, or to the top level when parentID is nil.
+// The parent must be on the same board and must not be a descendant of the task.
+func (s *task) SetParent(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	parentID domain.TaskID,
+) (domain.Task, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: set parent get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: set parent get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+
+	task, err := s.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: set parent get task: %v: %w", err, ErrInternal)
+	}
+	if task.ColumnID != columnID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+
+	if task.ParentID == parentID {
+		return task, nil
+	}
+
+	if !parentID.IsNil() {
+		err = s.checkParent(ctx, boardID, parentID)
+		if err != nil {
+			return domain.Task{}, fmt.Errorf("task service: set parent: %w", err)
+		}
+	}
+
+	updated, err := s.taskRepo.SetParent(ctx, boardID, columnID, taskID, parentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		if errors.Is(err, repository.ErrCycle) {
+			return domain.Task{}, ErrTaskParentCycle
+		}
+		return domain.Task{}, fmt.Errorf("task service: set parent: %v: %w", err, ErrInternal)
+	}
+
+	return updated, nil
+}
+
+func (s *task) Duplicate(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) (domain.Task, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: duplicate get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: duplicate get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+
+	task, err := s.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: duplicate get task: %v: %w", err, ErrInternal)
+	}
+	if task.ColumnID != columnID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+	if task.IsArchived() {
+		return domain.Task{}, ErrTaskArchived
+	}
+
+	duplicated, err := s.taskRepo.Duplicate(ctx, boardID, columnID, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: duplicate: %v: %w", err, ErrInternal)
+	}
+
+	return duplicated, nil
+}
+
+// Move places the task at targetPosition of the target column. A nil targetLaneID keeps
+// the task in its current lane, a pointer to a nil ID moves it to the default lane. A set
+// ifMatch fails the move with ErrVersionConflict if the task has changed since that version.
 func (s *task) Move(
 	ctx context.Context,
 	callerID domain.UserID,
@@ -229,39 +653,47 @@ func (s *task) Move(
 	columnID domain.ColumnID,
 	taskID domain.TaskID,
 	targetColumnID domain.ColumnID,
+	targetLaneID *domain.LaneID,
 	targetPosition domain.TaskPosition,
-) (domain.ColumnID, domain.TaskPosition, error) {
+	ifMatch domain.Version,
+) (domain.TaskPlacement, error) {
 	board, err := s.boardRepo.Get(ctx, boardID)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrTaskNotFound
+			return domain.TaskPlacement{}, ErrTaskNotFound
 		}
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task service: move get board: %v: %w", err, ErrInternal)
+		return domain.TaskPlacement{}, fmt.Errorf("task service: move get board: %v: %w", err, ErrInternal)
 	}
 	if board.OwnerID != callerID {
-		return domain.ColumnID{}, domain.TaskPosition{}, ErrTaskNotFound
+		return domain.TaskPlacement{}, ErrTaskNotFound
 	}
 
 	column, err := s.columnRepo.Get(ctx, columnID)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrTaskNotFound
+			return domain.TaskPlacement{}, ErrTaskNotFound
 		}
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task service: move get column: %v: %w", err, ErrInternal)
+		return domain.TaskPlacement{}, fmt.Errorf("task service: move get column: %v: %w", err, ErrInternal)
 	}
 	if column.BoardID != boardID {
-		return domain.ColumnID{}, domain.TaskPosition{}, ErrTaskNotFound
+		return domain.TaskPlacement{}, ErrTaskNotFound
 	}
 
 	task, err := s.taskRepo.Get(ctx, taskID)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrTaskNotFound
+			return domain.TaskPlacement{}, ErrTaskNotFound
 		}
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task service: move get task: %v: %w", err, ErrInternal)
+		return domain.TaskPlacement{}, fmt.Errorf("task service: move get task: %v: %w", err, ErrInternal)
 	}
 	if task.ColumnID != columnID {
-		return domain.ColumnID{}, domain.TaskPosition{}, ErrTaskNotFound
+		return domain.TaskPlacement{}, ErrTaskNotFound
+	}
+	if ifMatch.IsSet() && ifMatch != task.Version {
+		return domain.TaskPlacement{}, ErrVersionConflict
+	}
+	if task.IsArchived() {
+		return domain.TaskPlacement{}, ErrTaskArchived
 	}
 
 	if targetColumnID != columnID {
@@ -269,25 +701,187 @@ func (s *task) Move(
 		targetColumn, err = s.columnRepo.Get(ctx, targetColumnID)
 		if err != nil {
 			if errors.Is(err, repository.ErrRowNotFound) {
-				return domain.ColumnID{}, domain.TaskPosition{}, ErrColumnNotFound
+				return domain.TaskPlacement{}, ErrColumnNotFound
 			}
-			return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task service: move get target column: %v: %w", err, ErrInternal)
+			return domain.TaskPlacement{}, fmt.Errorf("task service: move get target column: %v: %w", err, ErrInternal)
 		}
 		if targetColumn.BoardID != boardID {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrColumnNotFound
+			return domain.TaskPlacement{}, ErrColumnNotFound
+		}
+
+		// Only the board owner gets this far, so users always move tasks as the owner.
+		err = checkTransition(&task, &column, &targetColumn, domain.ColumnEntryOwner)
+		if err != nil {
+			return domain.TaskPlacement{}, fmt.Errorf("task service: move: %w", err)
+		}
+
+		// Blockers are checked before the move transaction starts, so a blocker reopened at
+		// the same moment may slip through. Links are advisory enough for that to be fine.
+		if s.enforceBlockers && targetColumn.IsDone {
+			var blockerIDs []domain.TaskID
+			blockerIDs, err = s.blockerRepo.ListUnfinishedBlockers(ctx, taskID)
+			if err != nil {
+				return domain.TaskPlacement{}, fmt.Errorf("task service: move list blockers: %v: %w", err, ErrInternal)
+			}
+			if len(blockerIDs) > 0 {
+				return domain.TaskPlacement{}, &TaskBlockedError{BlockerIDs: blockerIDs}
+			}
+		}
+	}
+
+	laneID := task.LaneID
+	if targetLaneID != nil {
+		laneID = *targetLaneID
+	}
+	if !laneID.IsNil() && laneID != task.LaneID {
+		err = s.checkLane(ctx, boardID, laneID)
+		if err != nil {
+			return domain.TaskPlacement{}, fmt.Errorf("task service: move: %w", err)
 		}
 	}
 
-	newColumnID, newPosition, err := s.taskRepo.Move(ctx, boardID, columnID, taskID, targetColumnID, targetPosition)
+	placement, err := s.taskRepo.Move(ctx, boardID, columnID, taskID, targetColumnID, laneID, targetPosition, ifMatch)
 	if err != nil {
 		if errors.Is(err, repository.ErrRowNotFound) {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrTaskNotFound
+			return domain.TaskPlacement{}, ErrTaskNotFound
 		}
 		if errors.Is(err, repository.ErrIndexOutOfBounds) {
-			return domain.ColumnID{}, domain.TaskPosition{}, ErrIndexOutOfBounds
+			return domain.TaskPlacement{}, ErrIndexOutOfBounds
+		}
+		if errors.Is(err, repository.ErrVersionConflict) {
+			return domain.TaskPlacement{}, ErrVersionConflict
 		}
-		return domain.ColumnID{}, domain.TaskPosition{}, fmt.Errorf("task service: move: %v: %w", err, ErrInternal)
+		return domain.TaskPlacement{}, fmt.Errorf("task service: move: %v: %w", err, ErrInternal)
+	}
+
+	return placement, nil
+}
+
+// Restore brings an archived task back to the end of its cell in the column. Restoring an active
+// task changes nothing.
+func (s *task) Restore(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) (domain.Task, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: restore get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: restore get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+
+	task, err := s.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: restore get task: %v: %w", err, ErrInternal)
+	}
+	if task.ColumnID != columnID {
+		return domain.Task{}, ErrTaskNotFound
+	}
+	if !task.IsArchived() {
+		return task, nil
+	}
+
+	restored, err := s.taskRepo.Restore(ctx, boardID, columnID, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Task{}, ErrTaskNotFound
+		}
+		return domain.Task{}, fmt.Errorf("task service: restore: %v: %w", err, ErrInternal)
+	}
+
+	return restored, nil
+}
+
+// checkTransition verifies that the workflow rules of the board let role move task from column
+// from into column to. Like blockers, the rules are checked before the move transaction starts.
+func checkTransition(task *domain.Task, from, to *domain.Column, role domain.ColumnEntryRole) error {
+	allowed := from.Transitions.Allows(to.ID)
+	restricted := !to.EntryRoles.Allows(role)
+	unmet := to.EntryConditions.Unmet(task)
+	if allowed && !restricted && len(unmet) == 0 {
+		return nil
+	}
+
+	return &TransitionNotAllowedError{Allowed: allowed, Restricted: restricted, UnmetConditions: unmet}
+}
+
+// checkParent verifies that parentID is a task on boardID.
+func (s *task) checkParent(ctx context.Context, boardID domain.BoardID, parentID domain.TaskID) error {
+	parent, err := s.taskRepo.Get(ctx, parentID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrParentTaskNotFound
+		}
+		return fmt.Errorf("get parent task: %v: %w", err, ErrInternal)
+	}
+
+	parentColumn, err := s.columnRepo.Get(ctx, parent.ColumnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrParentTaskNotFound
+		}
+		return fmt.Errorf("get parent column: %v: %w", err, ErrInternal)
+	}
+	if parentColumn.BoardID != boardID {
+		return ErrParentTaskNotFound
+	}
+
+	return nil
+}
+
+// checkLane verifies that laneID is a lane of boardID.
+func (s *task) checkLane(ctx context.Context, boardID domain.BoardID, laneID domain.LaneID) error {
+	lane, err := s.laneRepo.Get(ctx, laneID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrLaneNotFound
+		}
+		return fmt.Errorf("get lane: %v: %w", err, ErrInternal)
+	}
+	if lane.BoardID != boardID {
+		return ErrLaneNotFound
+	}
+
+	return nil
+}
+
+// withCustomFieldChanges applies changes to the current custom field values of a task on the
+// board, see applyCustomFieldChanges. The fields of the board are loaded only for changes.
+func (s *task) withCustomFieldChanges(
+	ctx context.Context,
+	boardID domain.BoardID,
+	current domain.TaskCustomFields,
+	changes map[domain.CustomFieldID]json.RawMessage,
+) (domain.TaskCustomFields, error) {
+	if len(changes) == 0 {
+		return current, nil
+	}
+
+	fields, err := s.customFieldRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return domain.TaskCustomFields{}, fmt.Errorf("list custom fields: %v: %w", err, ErrInternal)
 	}
 
-	return newColumnID, newPosition, nil
+	return applyCustomFieldChanges(fields, current, changes)
 }
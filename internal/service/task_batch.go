@@ -0,0 +1,367 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+// TaskBatchError is returned when an operation of a batch can't be applied, in which case none
+// of the batch is. Index is the position of the operation in the batch and Err is the error
+// the operation would have failed with on its own, like ErrTaskNotFound or a
+// *TransitionNotAllowedError.
+type TaskBatchError struct {
+	Index int
+	Err   error
+}
+
+func (e *TaskBatchError) Error() string {
+	return fmt.Sprintf("operation %d: %v", e.Index, e.Err)
+}
+
+func (e *TaskBatchError) Unwrap() error {
+	return e.Err
+}
+
+// batchTask is a task of a batch as the operations before the current one left it.
+type batchTask struct {
+	task    domain.Task
+	column  domain.Column
+	deleted bool
+}
+
+// Batch applies operations to the tasks of the board in order and all at once: either every
+// operation succeeds or nothing changes. Each operation is checked like its single-task
+// counterpart, against the board as the operations before it leave it, and the batch is
+// written in one transaction. Blockers are the exception: they are read as stored, only
+// blockers the batch itself moves into a done column or deletes count as finished.
+func (s *task) Batch(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	operations []domain.TaskBatchOperation,
+) ([]domain.TaskBatchResult, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("task service: batch get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return nil, ErrBoardNotFound
+	}
+
+	b := &taskBatch{
+		service: s,
+		boardID: boardID,
+		columns: make(map[domain.ColumnID]domain.Column),
+		lanes:   make(map[domain.LaneID]bool),
+		tasks:   make(map[domain.TaskID]*batchTask),
+	}
+
+	changes := make([]domain.TaskChange, len(operations))
+	for i := range operations {
+		changes[i], err = b.change(ctx, &operations[i])
+		if err != nil {
+			if errors.Is(err, ErrInternal) {
+				return nil, fmt.Errorf("task service: batch operation %d: %w", i, err)
+			}
+			return nil, &TaskBatchError{Index: i, Err: err}
+		}
+	}
+
+	results, err := s.taskRepo.ApplyBatch(ctx, boardID, changes)
+	if err != nil {
+		var operationErr *repository.BatchOperationError
+		if errors.As(err, &operationErr) {
+			if errors.Is(operationErr.Err, repository.ErrIndexOutOfBounds) {
+				return nil, &TaskBatchError{Index: operationErr.Index, Err: ErrIndexOutOfBounds}
+			}
+			return nil, &TaskBatchError{Index: operationErr.Index, Err: ErrTaskNotFound}
+		}
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("task service: batch: %v: %w", err, ErrInternal)
+	}
+
+	// A label is written as an update, but is reported the way it was asked for.
+	for i := range results {
+		results[i].Type = operations[i].Type
+	}
+
+	return results, nil
+}
+
+// taskBatch tracks what the operations of a batch checked so far do to the board, loading
+// columns, lanes, tasks and custom fields the first time an operation needs them.
+type taskBatch struct {
+	service *task
+	boardID domain.BoardID
+
+	columns map[domain.ColumnID]domain.Column
+	lanes   map[domain.LaneID]bool
+	tasks   map[domain.TaskID]*batchTask
+
+	fields       []domain.CustomField
+	fieldsLoaded bool
+}
+
+// change checks operation against the state of the batch, applies it to that state and returns
+// the change to write.
+func (b *taskBatch) change(ctx context.Context, operation *domain.TaskBatchOperation) (domain.TaskChange, error) {
+	current, err := b.task(ctx, operation.TaskID)
+	if err != nil {
+		return domain.TaskChange{}, err
+	}
+
+	change := domain.TaskChange{Type: operation.Type, TaskID: operation.TaskID, ColumnID: current.task.ColumnID}
+
+	switch operation.Type {
+	case domain.TaskBatchMove:
+		err = b.move(ctx, current, operation, &change)
+	case domain.TaskBatchUpdate:
+		err = b.update(ctx, current, operation, &change)
+	case domain.TaskBatchSetLabel:
+		err = b.setLabel(ctx, current, operation, &change)
+	case domain.TaskBatchDelete:
+		current.deleted = true
+	default:
+		err = fmt.Errorf("unknown operation type %q: %w", operation.Type, ErrInternal)
+	}
+	if err != nil {
+		return domain.TaskChange{}, err
+	}
+
+	return change, nil
+}
+
+func (b *taskBatch) move(ctx context.Context, current *batchTask, operation *domain.TaskBatchOperation, change *domain.TaskChange) error {
+	if current.task.IsArchived() {
+		return ErrTaskArchived
+	}
+
+	target := current.column
+	if operation.TargetColumnID != current.task.ColumnID {
+		var err error
+		target, err = b.column(ctx, operation.TargetColumnID)
+		if err != nil {
+			return err
+		}
+
+		err = checkTransition(&current.task, &current.column, &target, domain.ColumnEntryOwner)
+		if err != nil {
+			return err
+		}
+
+		if b.service.enforceBlockers && target.IsDone {
+			err = b.checkBlockers(ctx, current.task.ID)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	laneID := current.task.LaneID
+	if operation.TargetLaneID != nil {
+		laneID = *operation.TargetLaneID
+	}
+	if !laneID.IsNil() && laneID != current.task.LaneID {
+		err := b.checkLane(ctx, laneID)
+		if err != nil {
+			return err
+		}
+	}
+
+	change.TargetColumnID = target.ID
+	change.TargetLaneID = laneID
+	change.TargetPosition = operation.TargetPosition
+
+	current.task.ColumnID = target.ID
+	current.task.LaneID = laneID
+	current.column = target
+
+	return nil
+}
+
+func (b *taskBatch) update(ctx context.Context, current *batchTask, operation *domain.TaskBatchOperation, change *domain.TaskChange) error {
+	change.Name = operation.Name
+	change.Description = operation.Description
+	change.Checklist = operation.Checklist
+	change.Estimate = operation.Estimate
+
+	if len(operation.CustomFields) > 0 {
+		err := b.changeCustomFields(ctx, current, operation.CustomFields, change)
+		if err != nil {
+			return err
+		}
+	}
+
+	if operation.Name != nil {
+		current.task.Name = *operation.Name
+	}
+	if operation.Description != nil {
+		current.task.Description = *operation.Description
+	}
+	if operation.Checklist != nil {
+		current.task.Checklist = *operation.Checklist
+	}
+	if operation.Estimate != nil {
+		current.task.Estimate = *operation.Estimate
+	}
+
+	return nil
+}
+
+func (b *taskBatch) setLabel(ctx context.Context, current *batchTask, operation *domain.TaskBatchOperation, change *domain.TaskChange) error {
+	fields, err := b.customFields(ctx)
+	if err != nil {
+		return err
+	}
+
+	field, ok := findCustomField(fields, operation.FieldID)
+	if !ok {
+		return &CustomFieldValuesError{Issues: map[domain.CustomFieldID][]string{operation.FieldID: {domain.ErrCustomFieldNotFound}}}
+	}
+	if !field.Type.IsSelect() {
+		return &CustomFieldValuesError{Issues: map[domain.CustomFieldID][]string{operation.FieldID: {domain.ErrTaskBatchLabelField}}}
+	}
+
+	change.Type = domain.TaskBatchUpdate
+	return b.changeCustomFields(ctx, current, map[domain.CustomFieldID]json.RawMessage{operation.FieldID: operation.Value}, change)
+}
+
+func (b *taskBatch) changeCustomFields(
+	ctx context.Context,
+	current *batchTask,
+	changes map[domain.CustomFieldID]json.RawMessage,
+	change *domain.TaskChange,
+) error {
+	fields, err := b.customFields(ctx)
+	if err != nil {
+		return err
+	}
+
+	values, err := applyCustomFieldChanges(fields, current.task.CustomFields, changes)
+	if err != nil {
+		return err
+	}
+
+	change.CustomFields = &values
+	current.task.CustomFields = values
+
+	return nil
+}
+
+// checkBlockers is the blocker check of a single move, except that blockers the batch has
+// already moved into a done column or deleted are finished.
+func (b *taskBatch) checkBlockers(ctx context.Context, taskID domain.TaskID) error {
+	blockerIDs, err := b.service.blockerRepo.ListUnfinishedBlockers(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("list blockers: %v: %w", err, ErrInternal)
+	}
+
+	unfinished := blockerIDs[:0]
+	for _, blockerID := range blockerIDs {
+		blocker, ok := b.tasks[blockerID]
+		if ok && (blocker.deleted || blocker.column.IsDone) {
+			continue
+		}
+		unfinished = append(unfinished, blockerID)
+	}
+	if len(unfinished) > 0 {
+		return &TaskBlockedError{BlockerIDs: unfinished}
+	}
+
+	return nil
+}
+
+// task returns the task of the board as the batch left it. Deleted tasks and tasks of other
+// boards are not found.
+func (b *taskBatch) task(ctx context.Context, taskID domain.TaskID) (*batchTask, error) {
+	if current, ok := b.tasks[taskID]; ok {
+		if current.deleted {
+			return nil, ErrTaskNotFound
+		}
+		return current, nil
+	}
+
+	stored, err := b.service.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, fmt.Errorf("get task: %v: %w", err, ErrInternal)
+	}
+
+	column, err := b.column(ctx, stored.ColumnID)
+	if err != nil {
+		if errors.Is(err, ErrColumnNotFound) {
+			return nil, ErrTaskNotFound
+		}
+		return nil, err
+	}
+
+	current := &batchTask{task: stored, column: column}
+	b.tasks[taskID] = current
+
+	return current, nil
+}
+
+// column returns a column of the board, ErrColumnNotFound for columns of other boards.
+func (b *taskBatch) column(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+	if column, ok := b.columns[columnID]; ok {
+		return column, nil
+	}
+
+	column, err := b.service.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.Column{}, ErrColumnNotFound
+		}
+		return domain.Column{}, fmt.Errorf("get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != b.boardID {
+		return domain.Column{}, ErrColumnNotFound
+	}
+
+	b.columns[columnID] = column
+
+	return column, nil
+}
+
+func (b *taskBatch) checkLane(ctx context.Context, laneID domain.LaneID) error {
+	if b.lanes[laneID] {
+		return nil
+	}
+
+	err := b.service.checkLane(ctx, b.boardID, laneID)
+	if err != nil {
+		return err
+	}
+
+	b.lanes[laneID] = true
+
+	return nil
+}
+
+func (b *taskBatch) customFields(ctx context.Context) ([]domain.CustomField, error) {
+	if b.fieldsLoaded {
+		return b.fields, nil
+	}
+
+	fields, err := b.service.customFieldRepo.ListByBoardID(ctx, b.boardID)
+	if err != nil {
+		return nil, fmt.Errorf("list custom fields: %v: %w", err, ErrInternal)
+	}
+
+	b.fields = fields
+	b.fieldsLoaded = true
+
+	return fields, nil
+}
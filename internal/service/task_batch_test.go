@@ -0,0 +1,277 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestTask_Batch(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	todoColumn := testutil.ValidColumn(validBoard.ID)
+	doneColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	doneColumn.IsDone = true
+	otherBoardColumn := testutil.ValidColumn(domain.NewBoardID())
+	lane := testutil.ValidLane(validBoard.ID)
+	otherBoardLane := testutil.ValidLane(domain.NewBoardID())
+
+	firstTask := testutil.NewValidTask(t, todoColumn.ID, "First", "", 1)
+	secondTask := testutil.NewValidTask(t, todoColumn.ID, "Second", "", 2)
+	blockerTask := testutil.NewValidTask(t, todoColumn.ID, "Blocker", "", 3)
+	archivedTask := testutil.NewValidTask(t, todoColumn.ID, "Archived", "", 4)
+	archivedTask.ArchivedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	otherBoardTask := testutil.ValidTask(otherBoardColumn.ID)
+
+	envField := testutil.ValidCustomField(validBoard.ID)
+	noteField := testutil.ValidCustomField(validBoard.ID)
+	noteField.Type = domain.CustomFieldText
+	staging, err := domain.NewCustomFieldValue(&envField, json.RawMessage(`"staging"`))
+	if err != nil {
+		t.Fatalf("NewCustomFieldValue() error = %v", err)
+	}
+	stagingFields := domain.TaskCustomFields{}.With(envField.ID, staging)
+
+	renamed, err := domain.NewTaskName("Renamed")
+	if err != nil {
+		t.Fatalf("NewTaskName() error = %v", err)
+	}
+	first := testutil.NewValidTaskPosition(t, 1)
+
+	columns := []domain.Column{todoColumn, doneColumn, otherBoardColumn}
+	tasks := []domain.Task{firstTask, secondTask, blockerTask, archivedTask, otherBoardTask}
+	lanes := []domain.Lane{lane, otherBoardLane}
+
+	tests := []struct {
+		name       string
+		callerID   domain.UserID
+		operations []domain.TaskBatchOperation
+		enforce    bool
+		blockers   []domain.TaskID
+		applyErr   error
+		wantErr    error
+		wantIndex  int // Checked when wantErr comes with a *service.TaskBatchError.
+		wantChange []domain.TaskChange
+		wantTypes  []domain.TaskBatchOperationType
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{
+				{Type: domain.TaskBatchMove, TaskID: firstTask.ID, TargetColumnID: doneColumn.ID, TargetLaneID: &lane.ID, TargetPosition: first},
+				{Type: domain.TaskBatchUpdate, TaskID: firstTask.ID, Name: &renamed},
+				{Type: domain.TaskBatchSetLabel, TaskID: secondTask.ID, FieldID: envField.ID, Value: json.RawMessage(`"staging"`)},
+				{Type: domain.TaskBatchDelete, TaskID: blockerTask.ID},
+			},
+			wantChange: []domain.TaskChange{
+				{Type: domain.TaskBatchMove, TaskID: firstTask.ID, ColumnID: todoColumn.ID, TargetColumnID: doneColumn.ID, TargetLaneID: lane.ID, TargetPosition: first},
+				{Type: domain.TaskBatchUpdate, TaskID: firstTask.ID, ColumnID: doneColumn.ID, Name: &renamed},
+				{Type: domain.TaskBatchUpdate, TaskID: secondTask.ID, ColumnID: todoColumn.ID, CustomFields: &stagingFields},
+				{Type: domain.TaskBatchDelete, TaskID: blockerTask.ID, ColumnID: todoColumn.ID},
+			},
+			wantTypes: []domain.TaskBatchOperationType{domain.TaskBatchMove, domain.TaskBatchUpdate, domain.TaskBatchSetLabel, domain.TaskBatchDelete},
+		},
+		{
+			name:       "Board of another user",
+			callerID:   domain.NewUserID(),
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchDelete, TaskID: firstTask.ID}},
+			wantErr:    service.ErrBoardNotFound,
+		},
+		{
+			name:     "Task of another board",
+			callerID: validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{
+				{Type: domain.TaskBatchDelete, TaskID: firstTask.ID},
+				{Type: domain.TaskBatchDelete, TaskID: otherBoardTask.ID},
+			},
+			wantErr:   service.ErrTaskNotFound,
+			wantIndex: 1,
+		},
+		{
+			name:     "Task deleted earlier in the batch",
+			callerID: validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{
+				{Type: domain.TaskBatchDelete, TaskID: firstTask.ID},
+				{Type: domain.TaskBatchUpdate, TaskID: firstTask.ID, Name: &renamed},
+			},
+			wantErr:   service.ErrTaskNotFound,
+			wantIndex: 1,
+		},
+		{
+			name:       "Moving an archived task",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchMove, TaskID: archivedTask.ID, TargetColumnID: doneColumn.ID, TargetPosition: first}},
+			wantErr:    service.ErrTaskArchived,
+		},
+		{
+			name:       "Target column of another board",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchMove, TaskID: firstTask.ID, TargetColumnID: otherBoardColumn.ID, TargetPosition: first}},
+			wantErr:    service.ErrColumnNotFound,
+		},
+		{
+			name:       "Target lane of another board",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchMove, TaskID: firstTask.ID, TargetColumnID: todoColumn.ID, TargetLaneID: &otherBoardLane.ID, TargetPosition: first}},
+			wantErr:    service.ErrLaneNotFound,
+		},
+		{
+			name:       "Label on a text field",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchSetLabel, TaskID: firstTask.ID, FieldID: noteField.ID, Value: json.RawMessage(`"note"`)}},
+			wantErr:    service.ErrCustomFieldValueInvalid,
+		},
+		{
+			name:       "Label outside of the options",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchSetLabel, TaskID: firstTask.ID, FieldID: envField.ID, Value: json.RawMessage(`"qa"`)}},
+			wantErr:    service.ErrCustomFieldValueInvalid,
+		},
+		{
+			name:       "Blocked move into a done column",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchMove, TaskID: firstTask.ID, TargetColumnID: doneColumn.ID, TargetPosition: first}},
+			enforce:    true,
+			blockers:   []domain.TaskID{blockerTask.ID},
+			wantErr:    service.ErrTaskBlocked,
+		},
+		{
+			name:     "Blocker finished earlier in the batch",
+			callerID: validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{
+				{Type: domain.TaskBatchMove, TaskID: blockerTask.ID, TargetColumnID: doneColumn.ID, TargetPosition: first},
+				{Type: domain.TaskBatchMove, TaskID: firstTask.ID, TargetColumnID: doneColumn.ID, TargetPosition: first},
+			},
+			enforce:  true,
+			blockers: []domain.TaskID{blockerTask.ID},
+			wantChange: []domain.TaskChange{
+				{Type: domain.TaskBatchMove, TaskID: blockerTask.ID, ColumnID: todoColumn.ID, TargetColumnID: doneColumn.ID, TargetPosition: first},
+				{Type: domain.TaskBatchMove, TaskID: firstTask.ID, ColumnID: todoColumn.ID, TargetColumnID: doneColumn.ID, TargetPosition: first},
+			},
+			wantTypes: []domain.TaskBatchOperationType{domain.TaskBatchMove, domain.TaskBatchMove},
+		},
+		{
+			name:     "Position out of bounds",
+			callerID: validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{
+				{Type: domain.TaskBatchDelete, TaskID: secondTask.ID},
+				{Type: domain.TaskBatchMove, TaskID: firstTask.ID, TargetColumnID: doneColumn.ID, TargetPosition: testutil.NewValidTaskPosition(t, 9)},
+			},
+			applyErr:  &repository.BatchOperationError{Index: 1, Err: repository.ErrIndexOutOfBounds},
+			wantErr:   service.ErrIndexOutOfBounds,
+			wantIndex: 1,
+		},
+		{
+			name:       "Task gone before the batch is written",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchDelete, TaskID: firstTask.ID}},
+			applyErr:   &repository.BatchOperationError{Index: 0, Err: repository.ErrRowNotFound},
+			wantErr:    service.ErrTaskNotFound,
+		},
+		{
+			name:       "Repository failure",
+			callerID:   validBoard.OwnerID,
+			operations: []domain.TaskBatchOperation{{Type: domain.TaskBatchDelete, TaskID: firstTask.ID}},
+			applyErr:   errors.New("db down"),
+			wantErr:    service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				i := slices.IndexFunc(columns, func(c domain.Column) bool { return c.ID == columnID })
+				if i < 0 {
+					return domain.Column{}, repository.ErrRowNotFound
+				}
+				return columns[i], nil
+			}
+			laneRepo := NewMockLaneRepository(t)
+			laneRepo.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+				i := slices.IndexFunc(lanes, func(l domain.Lane) bool { return l.ID == laneID })
+				if i < 0 {
+					return domain.Lane{}, repository.ErrRowNotFound
+				}
+				return lanes[i], nil
+			}
+			fieldRepo := NewMockCustomFieldRepository(t)
+			fieldRepo.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error) {
+				return []domain.CustomField{envField, noteField}, nil
+			}
+			blockerRepo := NewMockTaskBlockerRepository(t)
+			blockerRepo.ListUnfinishedBlockersFunc = func(ctx context.Context, taskID domain.TaskID) ([]domain.TaskID, error) {
+				if taskID != firstTask.ID {
+					return nil, nil
+				}
+				return slices.Clone(tt.blockers), nil
+			}
+
+			var gotChanges []domain.TaskChange
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				i := slices.IndexFunc(tasks, func(task domain.Task) bool { return task.ID == taskID })
+				if i < 0 {
+					return domain.Task{}, repository.ErrRowNotFound
+				}
+				return tasks[i], nil
+			}
+			taskRepo.ApplyBatchFunc = func(ctx context.Context, boardID domain.BoardID, changes []domain.TaskChange) ([]domain.TaskBatchResult, error) {
+				if boardID != validBoard.ID {
+					t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+				}
+				gotChanges = changes
+				if tt.applyErr != nil {
+					return nil, tt.applyErr
+				}
+				results := make([]domain.TaskBatchResult, len(changes))
+				for i, change := range changes {
+					results[i] = domain.TaskBatchResult{Type: change.Type, TaskID: change.TaskID}
+				}
+				return results, nil
+			}
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, laneRepo, nil, blockerRepo, fieldRepo, tt.enforce)
+			results, err := s.Batch(context.Background(), tt.callerID, validBoard.ID, tt.operations)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				var batchErr *service.TaskBatchError
+				if errors.As(err, &batchErr) && batchErr.Index != tt.wantIndex {
+					t.Errorf("got operation index %d, want %d", batchErr.Index, tt.wantIndex)
+				}
+				return
+			}
+
+			if diff := cmp.Diff(tt.wantChange, gotChanges, testutil.CmpAllowUnexported()); diff != "" {
+				t.Errorf("changes mismatch (-want +got):\n%s", diff)
+			}
+			types := make([]domain.TaskBatchOperationType, len(results))
+			for i, result := range results {
+				types[i] = result.Type
+			}
+			if !slices.Equal(types, tt.wantTypes) {
+				t.Errorf("got result types %v, want %v", types, tt.wantTypes)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type taskLinkRepository interface {
+	Create(ctx context.Context, ownerID domain.UserID, link domain.TaskLink) (domain.TaskLink, error)
+	Delete(ctx context.Context, taskID domain.TaskID, linkID domain.TaskLinkID) error
+}
+
+type taskLinkBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type taskLinkColumnRepository interface {
+	Get(ctx context.Context, columnID domain.ColumnID) (domain.Column, error)
+}
+
+type taskLinkTaskRepository interface {
+	Get(ctx context.Context, taskID domain.TaskID) (domain.Task, error)
+}
+
+type taskLink struct {
+	linkRepo   taskLinkRepository
+	boardRepo  taskLinkBoardRepository
+	columnRepo taskLinkColumnRepository
+	taskRepo   taskLinkTaskRepository
+}
+
+func NewTaskLink(
+	linkRepo taskLinkRepository,
+	boardRepo taskLinkBoardRepository,
+	columnRepo taskLinkColumnRepository,
+	taskRepo taskLinkTaskRepository,
+) *taskLink {
+	return &taskLink{
+		linkRepo:   linkRepo,
+		boardRepo:  boardRepo,
+		columnRepo: columnRepo,
+		taskRepo:   taskRepo,
+	}
+}
+
+// Create links taskID with another task of the caller. The other task may be on any of
+// the caller's boards. Blocking links that would form a dependency cycle are rejected.
+func (s *taskLink) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	link domain.TaskLink,
+) (domain.TaskLink, error) {
+	if link.SourceID != taskID && link.TargetID != taskID {
+		return domain.TaskLink{}, errors.New("BUG: task link service: create: link does not involve the task")
+	}
+
+	err := s.checkTask(ctx, callerID, boardID, columnID, taskID)
+	if err != nil {
+		return domain.TaskLink{}, fmt.Errorf("task link service: create: %w", err)
+	}
+
+	created, err := s.linkRepo.Create(ctx, callerID, link)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.TaskLink{}, ErrLinkedTaskNotFound
+		}
+		if errors.Is(err, repository.ErrCycle) {
+			return domain.TaskLink{}, ErrTaskLinkCycle
+		}
+		if errors.Is(err, repository.ErrUniqueViolation) {
+			return domain.TaskLink{}, ErrTaskLinkAlreadyExists
+		}
+		return domain.TaskLink{}, fmt.Errorf("task link service: create: %v: %w", err, ErrInternal)
+	}
+
+	return created, nil
+}
+
+func (s *taskLink) Delete(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+	linkID domain.TaskLinkID,
+) error {
+	err := s.checkTask(ctx, callerID, boardID, columnID, taskID)
+	if err != nil {
+		return fmt.Errorf("task link service: delete: %w", err)
+	}
+
+	err = s.linkRepo.Delete(ctx, taskID, linkID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskLinkNotFound
+		}
+		return fmt.Errorf("task link service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
+
+// checkTask verifies that taskID is in columnID of a board owned by callerID.
+func (s *taskLink) checkTask(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	columnID domain.ColumnID,
+	taskID domain.TaskID,
+) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskNotFound
+		}
+		return fmt.Errorf("get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return ErrTaskNotFound
+	}
+
+	column, err := s.columnRepo.Get(ctx, columnID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskNotFound
+		}
+		return fmt.Errorf("get column: %v: %w", err, ErrInternal)
+	}
+	if column.BoardID != boardID {
+		return ErrTaskNotFound
+	}
+
+	task, err := s.taskRepo.Get(ctx, taskID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskNotFound
+		}
+		return fmt.Errorf("get task: %v: %w", err, ErrInternal)
+	}
+	if task.ColumnID != columnID {
+		return ErrTaskNotFound
+	}
+
+	return nil
+}
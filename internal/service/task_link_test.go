@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestTaskLink_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	otherTaskID := domain.NewTaskID()
+	link := domain.TaskLink{SourceID: otherTaskID, TargetID: validTask.ID, Type: domain.TaskLinkBlocks}
+
+	tests := []struct {
+		name          string
+		callerID      domain.UserID
+		taskColumnID  domain.ColumnID
+		createErr     error
+		wantCreateHit bool
+		wantErr       error
+	}{
+		{name: "Success", callerID: validBoard.OwnerID, taskColumnID: validColumn.ID, wantCreateHit: true},
+		{name: "Foreign board", callerID: domain.NewUserID(), taskColumnID: validColumn.ID, wantErr: service.ErrTaskNotFound},
+		{name: "Task in another column", callerID: validBoard.OwnerID, taskColumnID: domain.NewColumnID(), wantErr: service.ErrTaskNotFound},
+		{
+			name:          "Linked task not found",
+			callerID:      validBoard.OwnerID,
+			taskColumnID:  validColumn.ID,
+			createErr:     repository.ErrRowNotFound,
+			wantCreateHit: true,
+			wantErr:       service.ErrLinkedTaskNotFound,
+		},
+		{
+			name:          "Cycle",
+			callerID:      validBoard.OwnerID,
+			taskColumnID:  validColumn.ID,
+			createErr:     repository.ErrCycle,
+			wantCreateHit: true,
+			wantErr:       service.ErrTaskLinkCycle,
+		},
+		{
+			name:          "Already exists",
+			callerID:      validBoard.OwnerID,
+			taskColumnID:  validColumn.ID,
+			createErr:     repository.ErrUniqueViolation,
+			wantCreateHit: true,
+			wantErr:       service.ErrTaskLinkAlreadyExists,
+		},
+		{
+			name:          "Repository failure",
+			callerID:      validBoard.OwnerID,
+			taskColumnID:  validColumn.ID,
+			createErr:     repository.ErrInternal,
+			wantCreateHit: true,
+			wantErr:       service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				return validColumn, nil
+			}
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				task := validTask
+				task.ColumnID = tt.taskColumnID
+				return task, nil
+			}
+			linkRepo := NewMockTaskLinkRepository(t)
+			createHit := false
+			linkRepo.CreateFunc = func(ctx context.Context, ownerID domain.UserID, gotLink domain.TaskLink) (domain.TaskLink, error) {
+				createHit = true
+				if ownerID != validBoard.OwnerID {
+					t.Errorf("got owner id %v, want %v", ownerID, validBoard.OwnerID)
+				}
+				if gotLink != link {
+					t.Errorf("got link %+v, want %+v", gotLink, link)
+				}
+				if tt.createErr != nil {
+					return domain.TaskLink{}, tt.createErr
+				}
+				gotLink.ID = domain.NewTaskLinkID()
+				return gotLink, nil
+			}
+
+			s := service.NewTaskLink(linkRepo, boardRepo, columnRepo, taskRepo)
+			_, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, validTask.ID, link)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if createHit != tt.wantCreateHit {
+				t.Errorf("repository create called = %v, want %v", createHit, tt.wantCreateHit)
+			}
+		})
+	}
+}
+
+func TestTaskLink_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	linkID := domain.NewTaskLinkID()
+
+	tests := []struct {
+		name      string
+		deleteErr error
+		wantErr   error
+	}{
+		{name: "Success"},
+		{name: "Link not found", deleteErr: repository.ErrRowNotFound, wantErr: service.ErrTaskLinkNotFound},
+		{name: "Repository failure", deleteErr: repository.ErrInternal, wantErr: service.ErrInternal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				return validColumn, nil
+			}
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				return validTask, nil
+			}
+			linkRepo := NewMockTaskLinkRepository(t)
+			linkRepo.DeleteFunc = func(ctx context.Context, taskID domain.TaskID, gotLinkID domain.TaskLinkID) error {
+				if taskID != validTask.ID {
+					t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+				}
+				if gotLinkID != linkID {
+					t.Errorf("got link id %v, want %v", gotLinkID, linkID)
+				}
+				return tt.deleteErr
+			}
+
+			s := service.NewTaskLink(linkRepo, boardRepo, columnRepo, taskRepo)
+			err := s.Delete(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID, validTask.ID, linkID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+)
+
+type taskTemplatesRepository interface {
+	Create(ctx context.Context, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error)
+	ListByBoardID(ctx context.Context, boardID domain.BoardID) ([]domain.TaskTemplate, error)
+	Update(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error)
+	Delete(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID) error
+}
+
+type taskTemplatesBoardRepository interface {
+	Get(ctx context.Context, boardID domain.BoardID) (domain.Board, error)
+}
+
+type taskTemplate struct {
+	templateRepo taskTemplatesRepository
+	boardRepo    taskTemplatesBoardRepository
+}
+
+func NewTaskTemplate(templateRepo taskTemplatesRepository, boardRepo taskTemplatesBoardRepository) *taskTemplate {
+	return &taskTemplate{templateRepo: templateRepo, boardRepo: boardRepo}
+}
+
+func (s *taskTemplate) Create(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	namePattern domain.TaskNamePattern,
+	descriptionPattern domain.TaskDescriptionPattern,
+	checklist domain.TaskChecklist,
+) (domain.TaskTemplate, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.TaskTemplate{}, ErrBoardNotFound
+		}
+		return domain.TaskTemplate{}, fmt.Errorf("task template service: create get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.TaskTemplate{}, ErrBoardNotFound
+	}
+
+	template, err := s.templateRepo.Create(ctx, boardID, namePattern, descriptionPattern, checklist)
+	if err != nil {
+		return domain.TaskTemplate{}, fmt.Errorf("task template service: create: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (s *taskTemplate) ListByBoardID(ctx context.Context, callerID domain.UserID, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return nil, ErrBoardNotFound
+		}
+		return nil, fmt.Errorf("task template service: list get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return nil, ErrBoardNotFound
+	}
+
+	templates, err := s.templateRepo.ListByBoardID(ctx, boardID)
+	if err != nil {
+		return nil, fmt.Errorf("task template service: list: %v: %w", err, ErrInternal)
+	}
+
+	return templates, nil
+}
+
+func (s *taskTemplate) Update(
+	ctx context.Context,
+	callerID domain.UserID,
+	boardID domain.BoardID,
+	templateID domain.TaskTemplateID,
+	namePattern *domain.TaskNamePattern,
+	descriptionPattern *domain.TaskDescriptionPattern,
+	checklist *domain.TaskChecklist,
+) (domain.TaskTemplate, error) {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.TaskTemplate{}, ErrTaskTemplateNotFound
+		}
+		return domain.TaskTemplate{}, fmt.Errorf("task template service: update get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return domain.TaskTemplate{}, ErrTaskTemplateNotFound
+	}
+
+	template, err := s.templateRepo.Update(ctx, boardID, templateID, namePattern, descriptionPattern, checklist)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return domain.TaskTemplate{}, ErrTaskTemplateNotFound
+		}
+		return domain.TaskTemplate{}, fmt.Errorf("task template service: update: %v: %w", err, ErrInternal)
+	}
+
+	return template, nil
+}
+
+func (s *taskTemplate) Delete(ctx context.Context, callerID domain.UserID, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+	board, err := s.boardRepo.Get(ctx, boardID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskTemplateNotFound
+		}
+		return fmt.Errorf("task template service: delete get board: %v: %w", err, ErrInternal)
+	}
+	if board.OwnerID != callerID {
+		return ErrTaskTemplateNotFound
+	}
+
+	err = s.templateRepo.Delete(ctx, boardID, templateID)
+	if err != nil {
+		if errors.Is(err, repository.ErrRowNotFound) {
+			return ErrTaskTemplateNotFound
+		}
+		return fmt.Errorf("task template service: delete: %v: %w", err, ErrInternal)
+	}
+
+	return nil
+}
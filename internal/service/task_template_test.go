@@ -0,0 +1,402 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"goroutine/internal/domain"
+	"goroutine/internal/repository"
+	"goroutine/internal/service"
+	"goroutine/internal/testutil"
+)
+
+func TestTaskTemplate_Create(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		setupTemplateRepo func(t *testing.T, r *MockTaskTemplateRepository)
+		wantTemplate      domain.TaskTemplate
+		wantErr           error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.CreateFunc = func(ctx context.Context, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
+					}
+					if namePattern != validTemplate.NamePattern {
+						t.Errorf("got name pattern %v, want %v", namePattern, validTemplate.NamePattern)
+					}
+					if diff := cmp.Diff(validTemplate.Checklist, checklist, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("checklist mismatch (-want +got):\n%s", diff)
+					}
+					return validTemplate, nil
+				}
+			},
+			wantTemplate: validTemplate,
+		},
+		{
+			name:     "Not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {},
+			wantErr:           service.ErrBoardNotFound,
+		},
+		{
+			name:     "Not found when row missing",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return domain.Board{}, repository.ErrRowNotFound
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {},
+			wantErr:           service.ErrBoardNotFound,
+		},
+		{
+			name:     "Create internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.CreateFunc = func(ctx context.Context, boardID domain.BoardID, namePattern domain.TaskNamePattern, descriptionPattern domain.TaskDescriptionPattern, checklist domain.TaskChecklist) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, errors.New("insert failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			templateRepo := NewMockTaskTemplateRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupTemplateRepo(t, templateRepo)
+
+			s := service.NewTaskTemplate(templateRepo, boardRepo)
+			got, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validTemplate.NamePattern, validTemplate.DescriptionPattern, validTemplate.Checklist)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantTemplate, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Create() template mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskTemplate_ListByBoardID(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		setupTemplateRepo func(t *testing.T, r *MockTaskTemplateRepository)
+		wantTemplates     []domain.TaskTemplate
+		wantErr           error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got boardID %v, want %v", boardID, validBoard.ID)
+					}
+					return []domain.TaskTemplate{validTemplate}, nil
+				}
+			},
+			wantTemplates: []domain.TaskTemplate{validTemplate},
+		},
+		{
+			name:     "Not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {},
+			wantErr:           service.ErrBoardNotFound,
+		},
+		{
+			name:     "List internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.TaskTemplate, error) {
+					return nil, errors.New("db down")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			templateRepo := NewMockTaskTemplateRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupTemplateRepo(t, templateRepo)
+
+			s := service.NewTaskTemplate(templateRepo, boardRepo)
+			got, err := s.ListByBoardID(context.Background(), tt.callerID, validBoard.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantTemplates, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("ListByBoardID() templates mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskTemplate_Update(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+	newNamePattern, err := domain.NewTaskNamePattern("Retro {{week}}")
+	if err != nil {
+		t.Fatalf("NewTaskNamePattern: %v", err)
+	}
+	updatedTemplate := validTemplate
+	updatedTemplate.NamePattern = newNamePattern
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		setupTemplateRepo func(t *testing.T, r *MockTaskTemplateRepository)
+		wantTemplate      domain.TaskTemplate
+		wantErr           error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error) {
+					if boardID != validBoard.ID || templateID != validTemplate.ID {
+						t.Errorf("got board %v template %v, want %v %v", boardID, templateID, validBoard.ID, validTemplate.ID)
+					}
+					if namePattern == nil || *namePattern != newNamePattern {
+						t.Errorf("got name pattern %v, want %v", namePattern, newNamePattern)
+					}
+					if descriptionPattern != nil || checklist != nil {
+						t.Errorf("got description pattern %v and checklist %v, want nil", descriptionPattern, checklist)
+					}
+					return updatedTemplate, nil
+				}
+			},
+			wantTemplate: updatedTemplate,
+		},
+		{
+			name:     "Not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {},
+			wantErr:           service.ErrTaskTemplateNotFound,
+		},
+		{
+			name:     "Not found when row missing",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrTaskTemplateNotFound,
+		},
+		{
+			name:     "Update internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.UpdateFunc = func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID, namePattern *domain.TaskNamePattern, descriptionPattern *domain.TaskDescriptionPattern, checklist *domain.TaskChecklist) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, errors.New("update failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			templateRepo := NewMockTaskTemplateRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupTemplateRepo(t, templateRepo)
+
+			s := service.NewTaskTemplate(templateRepo, boardRepo)
+			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, validTemplate.ID, &newNamePattern, nil, nil)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantTemplate, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Update() template mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskTemplate_Delete(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		setupTemplateRepo func(t *testing.T, r *MockTaskTemplateRepository)
+		wantErr           error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+					if boardID != validBoard.ID || templateID != validTemplate.ID {
+						t.Errorf("got board %v template %v, want %v %v", boardID, templateID, validBoard.ID, validTemplate.ID)
+					}
+					return nil
+				}
+			},
+		},
+		{
+			name:     "Not found when wrong owner",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {},
+			wantErr:           service.ErrTaskTemplateNotFound,
+		},
+		{
+			name:     "Not found when row missing",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+					return repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrTaskTemplateNotFound,
+		},
+		{
+			name:     "Delete internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, templateID domain.TaskTemplateID) error {
+					return errors.New("delete failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			templateRepo := NewMockTaskTemplateRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupTemplateRepo(t, templateRepo)
+
+			s := service.NewTaskTemplate(templateRepo, boardRepo)
+			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, validTemplate.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
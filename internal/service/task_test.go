@@ -2,8 +2,12 @@ package service_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -51,7 +55,7 @@ func TestTask_Create(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
 					if columnID != validColumn.ID {
 						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
 					}
@@ -81,7 +85,7 @@ func TestTask_Create(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -103,7 +107,7 @@ func TestTask_Create(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -124,7 +128,7 @@ func TestTask_Create(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -147,7 +151,7 @@ func TestTask_Create(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -168,7 +172,7 @@ func TestTask_Create(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, name domain.TaskName, description domain.TaskDescription) (domain.Task, error) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
 					return domain.Task{}, errors.New("insert failed")
 				}
 			},
@@ -187,8 +191,8 @@ func TestTask_Create(t *testing.T) {
 			tt.setupColumnRepo(t, columnRepo)
 			tt.setupTaskRepo(t, taskRepo)
 
-			s := service.NewTask(taskRepo, boardRepo, columnRepo)
-			got, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, validName, validDescription)
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			got, err := s.Create(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, domain.LaneID{}, domain.TaskID{}, validName, validDescription, validTask.Checklist, validTask.Estimate, nil)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
@@ -202,6 +206,203 @@ func TestTask_Create(t *testing.T) {
 	}
 }
 
+func TestTask_CreateFromTemplate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	validTemplate := testutil.ValidTaskTemplate(validBoard.ID)
+	foreignTemplate := testutil.ValidTaskTemplate(domain.NewBoardID())
+	overrideName := validTask.Name
+	overrideChecklist := testutil.NewValidTaskChecklist(t, domain.TaskChecklistItem{Text: "Only step"})
+
+	tests := []struct {
+		name              string
+		callerID          domain.UserID
+		overrideName      *domain.TaskName
+		overrideChecklist *domain.TaskChecklist
+		setupBoardRepo    func(t *testing.T, r *MockBoardRepository)
+		setupColumnRepo   func(t *testing.T, r *MockColumnRepository)
+		setupTemplateRepo func(t *testing.T, r *MockTaskTemplateRepository)
+		setupTaskRepo     func(t *testing.T, r *MockTaskRepository)
+		wantErr           error
+	}{
+		{
+			name:     "Success fills fields from template",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error) {
+					if templateID != validTemplate.ID {
+						t.Errorf("got template id %v, want %v", templateID, validTemplate.ID)
+					}
+					return validTemplate, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
+					// {{date}} expands to a 10 character date.
+					if !strings.HasPrefix(name.String(), "Standup ") || len(name.String()) != len("Standup 2006-01-02") {
+						t.Errorf("got name %q, want expanded %q", name.String(), validTemplate.NamePattern.String())
+					}
+					if !strings.HasPrefix(description.String(), "Notes for ") || strings.Contains(description.String(), "{{") {
+						t.Errorf("got description %q, want expanded %q", description.String(), validTemplate.DescriptionPattern.String())
+					}
+					if diff := cmp.Diff(validTemplate.Checklist, checklist, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("checklist mismatch (-want +got):\n%s", diff)
+					}
+					return validTask, nil
+				}
+			},
+		},
+		{
+			name:              "Success keeps provided fields",
+			callerID:          validBoard.OwnerID,
+			overrideName:      &overrideName,
+			overrideChecklist: &overrideChecklist,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error) {
+					return validTemplate, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
+					if name != overrideName {
+						t.Errorf("got name %v, want %v", name, overrideName)
+					}
+					if diff := cmp.Diff(overrideChecklist, checklist, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("checklist mismatch (-want +got):\n%s", diff)
+					}
+					return validTask, nil
+				}
+			},
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo:   func(t *testing.T, r *MockColumnRepository) {},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {},
+			setupTaskRepo:     func(t *testing.T, r *MockTaskRepository) {},
+			wantErr:           service.ErrColumnNotFound,
+		},
+		{
+			name:     "Template not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, repository.ErrRowNotFound
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {},
+			wantErr:       service.ErrTaskTemplateNotFound,
+		},
+		{
+			name:     "Template belongs to another board",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error) {
+					return foreignTemplate, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {},
+			wantErr:       service.ErrTaskTemplateNotFound,
+		},
+		{
+			name:     "Get template internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTemplateRepo: func(t *testing.T, r *MockTaskTemplateRepository) {
+				r.GetFunc = func(ctx context.Context, templateID domain.TaskTemplateID) (domain.TaskTemplate, error) {
+					return domain.TaskTemplate{}, errors.New("db down")
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {},
+			wantErr:       service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			columnRepo := NewMockColumnRepository(t)
+			templateRepo := NewMockTaskTemplateRepository(t)
+			taskRepo := NewMockTaskRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupColumnRepo(t, columnRepo)
+			tt.setupTemplateRepo(t, templateRepo)
+			tt.setupTaskRepo(t, taskRepo)
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, templateRepo, nil, nil, false)
+			got, err := s.CreateFromTemplate(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, domain.LaneID{}, domain.TaskID{}, validTemplate.ID, tt.overrideName, nil, tt.overrideChecklist, domain.TaskEstimate{}, nil)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(validTask, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("CreateFromTemplate() task mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
 func TestTask_ListByColumnID(t *testing.T) {
 	t.Parallel()
 
@@ -209,10 +410,13 @@ func TestTask_ListByColumnID(t *testing.T) {
 	validColumn := testutil.ValidColumn(validBoard.ID)
 	first := testutil.ValidTask(validColumn.ID)
 	second := testutil.NewValidTask(t, validColumn.ID, "Second", "second", 2)
+	archived := testutil.NewValidTask(t, validColumn.ID, "Archived", "archived", 1)
+	archived.ArchivedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name            string
 		callerID        domain.UserID
+		includeArchived bool
 		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
 		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
 		setupTaskRepo   func(t *testing.T, r *MockTaskRepository)
@@ -233,15 +437,42 @@ func TestTask_ListByColumnID(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
 					if columnID != validColumn.ID {
 						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
 					}
+					if includeArchived {
+						t.Errorf("got include archived, want active tasks only")
+					}
 					return []domain.Task{first, second}, nil
 				}
 			},
 			wantTasks: []domain.Task{first, second},
 		},
+		{
+			name:            "Success including archived",
+			callerID:        validBoard.OwnerID,
+			includeArchived: true,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
+					if !includeArchived {
+						t.Errorf("got active tasks only, want include archived")
+					}
+					return []domain.Task{first, second, archived}, nil
+				}
+			},
+			wantTasks: []domain.Task{first, second, archived},
+		},
 		{
 			name:     "Board not found",
 			callerID: validBoard.OwnerID,
@@ -257,7 +488,7 @@ func TestTask_ListByColumnID(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return nil, nil
 				}
@@ -279,7 +510,7 @@ func TestTask_ListByColumnID(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return nil, nil
 				}
@@ -300,7 +531,7 @@ func TestTask_ListByColumnID(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return nil, nil
 				}
@@ -323,7 +554,7 @@ func TestTask_ListByColumnID(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return nil, nil
 				}
@@ -344,7 +575,7 @@ func TestTask_ListByColumnID(t *testing.T) {
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
-				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID) ([]domain.Task, error) {
+				r.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
 					return nil, errors.New("db failed")
 				}
 			},
@@ -363,8 +594,8 @@ func TestTask_ListByColumnID(t *testing.T) {
 			tt.setupColumnRepo(t, columnRepo)
 			tt.setupTaskRepo(t, taskRepo)
 
-			s := service.NewTask(taskRepo, boardRepo, columnRepo)
-			got, err := s.ListByColumnID(context.Background(), tt.callerID, validBoard.ID, validColumn.ID)
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			got, err := s.ListByColumnID(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, nil, tt.includeArchived)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
@@ -387,6 +618,11 @@ func TestTask_Update(t *testing.T) {
 	updatedTask := testutil.UpdateValidTask(t, &validTask, "Renamed", "Renamed description", testutil.FixedNow())
 	updatedName := updatedTask.Name
 	updatedDescription := updatedTask.Description
+	updatedChecklist := testutil.NewValidTaskChecklist(t, domain.TaskChecklistItem{Text: "Review", Done: true})
+	checkedTask := validTask
+	checkedTask.Checklist = updatedChecklist
+	clearedEstimate := domain.TaskEstimate{}
+	unestimatedTask := validTask
 
 	tests := []struct {
 		name             string
@@ -394,6 +630,9 @@ func TestTask_Update(t *testing.T) {
 		taskID           domain.TaskID
 		patchName        *domain.TaskName
 		patchDescription *domain.TaskDescription
+		patchChecklist   *domain.TaskChecklist
+		patchEstimate    *domain.TaskEstimate
+		ifMatch          domain.Version
 		setupBoardRepo   func(t *testing.T, r *MockBoardRepository)
 		setupColumnRepo  func(t *testing.T, r *MockColumnRepository)
 		setupTaskRepo    func(t *testing.T, r *MockTaskRepository)
@@ -423,7 +662,7 @@ func TestTask_Update(t *testing.T) {
 					}
 					return validTask, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					if columnID != validColumn.ID {
 						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
 					}
@@ -441,6 +680,70 @@ func TestTask_Update(t *testing.T) {
 			},
 			wantTask: updatedTask,
 		},
+		{
+			name:          "Success clears estimate",
+			callerID:      validBoard.OwnerID,
+			taskID:        validTask.ID,
+			patchEstimate: &clearedEstimate,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					estimated := validTask
+					estimated.Estimate = testutil.NewValidTaskEstimate(t, 8)
+					return estimated, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
+					if name != nil || description != nil || checklist != nil {
+						t.Errorf("got name %v, description %v and checklist %v, want only the estimate", name, description, checklist)
+					}
+					if estimate == nil || estimate.IsSet() {
+						t.Errorf("got estimate %v, want a cleared estimate", estimate)
+					}
+					return unestimatedTask, nil
+				}
+			},
+			wantTask: unestimatedTask,
+		},
+		{
+			name:           "Success checklist only",
+			callerID:       validBoard.OwnerID,
+			taskID:         validTask.ID,
+			patchChecklist: &updatedChecklist,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
+					if name != nil || description != nil {
+						t.Errorf("got name %v and description %v, want nil", name, description)
+					}
+					if diff := cmp.Diff(&updatedChecklist, checklist, testutil.CmpAllowUnexported()); diff != "" {
+						t.Errorf("checklist mismatch (-want +got):\n%s", diff)
+					}
+					return checkedTask, nil
+				}
+			},
+			wantTask: checkedTask,
+		},
 		{
 			name:     "Success no-op patch",
 			callerID: validBoard.OwnerID,
@@ -459,7 +762,7 @@ func TestTask_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -486,7 +789,7 @@ func TestTask_Update(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -513,7 +816,7 @@ func TestTask_Update(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -539,7 +842,7 @@ func TestTask_Update(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -567,7 +870,7 @@ func TestTask_Update(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -592,7 +895,7 @@ func TestTask_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return domain.Task{}, repository.ErrRowNotFound
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
@@ -619,13 +922,65 @@ func TestTask_Update(t *testing.T) {
 					otherColumnTask.ColumnID = domain.NewColumnID()
 					return otherColumnTask, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
 			},
 			wantErr: service.ErrTaskNotFound,
 		},
+		{
+			name:      "Version conflict when version is stale",
+			callerID:  validBoard.OwnerID,
+			taskID:    validTask.ID,
+			patchName: &updatedName,
+			ifMatch:   updatedTask.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:      "Version conflict when task changes concurrently",
+			callerID:  validBoard.OwnerID,
+			taskID:    validTask.ID,
+			patchName: &updatedName,
+			ifMatch:   validTask.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
+					if expected != validTask.Version {
+						t.Errorf("got expected version %v, want %v", expected, validTask.Version)
+					}
+					return domain.Task{}, repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
 		{
 			name:      "Update internal error",
 			callerID:  validBoard.OwnerID,
@@ -645,7 +1000,7 @@ func TestTask_Update(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription) (domain.Task, error) {
+				r.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
 					return domain.Task{}, errors.New("update failed")
 				}
 			},
@@ -664,8 +1019,8 @@ func TestTask_Update(t *testing.T) {
 			tt.setupColumnRepo(t, columnRepo)
 			tt.setupTaskRepo(t, taskRepo)
 
-			s := service.NewTask(taskRepo, boardRepo, columnRepo)
-			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, tt.taskID, tt.patchName, tt.patchDescription)
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			got, err := s.Update(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, tt.taskID, tt.patchName, tt.patchDescription, tt.patchChecklist, tt.patchEstimate, nil, tt.ifMatch)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
@@ -687,17 +1042,26 @@ func TestTask_Move(t *testing.T) {
 	targetColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
 	validTask := testutil.ValidTask(validColumn.ID)
 	targetPosition := testutil.NewValidTaskPosition(t, 2)
+	targetLane := testutil.ValidLane(validBoard.ID)
+	otherBoardLane := testutil.ValidLane(domain.NewBoardID())
+	archivedTask := validTask
+	archivedTask.ArchivedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	staleVersion := testutil.UpdateValidTask(t, &validTask, "Renamed", "Renamed description", testutil.FixedNow()).Version
 
 	tests := []struct {
 		name            string
 		callerID        domain.UserID
 		taskID          domain.TaskID
 		targetColumnID  domain.ColumnID
+		targetLaneID    *domain.LaneID
+		ifMatch         domain.Version
 		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
 		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
+		setupLaneRepo   func(t *testing.T, r *MockLaneRepository)
 		setupTaskRepo   func(t *testing.T, r *MockTaskRepository)
 		wantErr         error
 		wantColumn      domain.ColumnID
+		wantLane        domain.LaneID
 		wantPosition    domain.TaskPosition
 	}{
 		{
@@ -722,7 +1086,7 @@ func TestTask_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
 					if boardID != validBoard.ID {
 						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
 					}
@@ -738,17 +1102,18 @@ func TestTask_Move(t *testing.T) {
 					if gotTargetPosition != targetPosition {
 						t.Errorf("got target position %v, want %v", gotTargetPosition, targetPosition)
 					}
-					return validColumn.ID, targetPosition, nil
+					return domain.TaskPlacement{ColumnID: validColumn.ID, Position: targetPosition}, nil
 				}
 			},
 			wantColumn:   validColumn.ID,
 			wantPosition: targetPosition,
 		},
 		{
-			name:           "Success cross column",
+			name:           "Success into lane",
 			callerID:       validBoard.OwnerID,
 			taskID:         validTask.ID,
-			targetColumnID: targetColumn.ID,
+			targetColumnID: validColumn.ID,
+			targetLaneID:   &targetLane.ID,
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
@@ -756,36 +1121,38 @@ func TestTask_Move(t *testing.T) {
 			},
 			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
-					switch columnID {
-					case validColumn.ID:
-						return validColumn, nil
-					case targetColumn.ID:
-						return targetColumn, nil
-					default:
-						t.Errorf("got column id %v, want %v or %v", columnID, validColumn.ID, targetColumn.ID)
-						return domain.Column{}, nil
+					return validColumn, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					if laneID != targetLane.ID {
+						t.Errorf("got lane id %v, want %v", laneID, targetLane.ID)
 					}
+					return targetLane, nil
 				}
 			},
 			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					if gotTargetColumnID != targetColumn.ID {
-						t.Errorf("got target column id %v, want %v", gotTargetColumnID, targetColumn.ID)
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+					if gotTargetLaneID != targetLane.ID {
+						t.Errorf("got target lane id %v, want %v", gotTargetLaneID, targetLane.ID)
 					}
-					return targetColumn.ID, targetPosition, nil
+					return domain.TaskPlacement{ColumnID: validColumn.ID, LaneID: targetLane.ID, Position: targetPosition}, nil
 				}
 			},
-			wantColumn:   targetColumn.ID,
+			wantColumn:   validColumn.ID,
+			wantLane:     targetLane.ID,
 			wantPosition: targetPosition,
 		},
 		{
-			name:           "Target column not found",
+			name:           "Lane of another board",
 			callerID:       validBoard.OwnerID,
 			taskID:         validTask.ID,
-			targetColumnID: targetColumn.ID,
+			targetColumnID: validColumn.ID,
+			targetLaneID:   &otherBoardLane.ID,
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
@@ -793,19 +1160,111 @@ func TestTask_Move(t *testing.T) {
 			},
 			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
 				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
-					if columnID == validColumn.ID {
-						return validColumn, nil
-					}
-					return domain.Column{}, repository.ErrRowNotFound
+					return validColumn, nil
 				}
 			},
-			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return otherBoardLane, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+			},
+			wantErr: service.ErrLaneNotFound,
+		},
+		{
+			name:           "Lane not found",
+			callerID:       validBoard.OwnerID,
+			taskID:         validTask.ID,
+			targetColumnID: validColumn.ID,
+			targetLaneID:   &targetLane.ID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupLaneRepo: func(t *testing.T, r *MockLaneRepository) {
+				r.GetFunc = func(ctx context.Context, laneID domain.LaneID) (domain.Lane, error) {
+					return domain.Lane{}, repository.ErrRowNotFound
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+			},
+			wantErr: service.ErrLaneNotFound,
+		},
+		{
+			name:           "Success cross column",
+			callerID:       validBoard.OwnerID,
+			taskID:         validTask.ID,
+			targetColumnID: targetColumn.ID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					switch columnID {
+					case validColumn.ID:
+						return validColumn, nil
+					case targetColumn.ID:
+						return targetColumn, nil
+					default:
+						t.Errorf("got column id %v, want %v or %v", columnID, validColumn.ID, targetColumn.ID)
+						return domain.Column{}, nil
+					}
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+					if gotTargetColumnID != targetColumn.ID {
+						t.Errorf("got target column id %v, want %v", gotTargetColumnID, targetColumn.ID)
+					}
+					return domain.TaskPlacement{ColumnID: targetColumn.ID, Position: targetPosition}, nil
+				}
+			},
+			wantColumn:   targetColumn.ID,
+			wantPosition: targetPosition,
+		},
+		{
+			name:           "Target column not found",
+			callerID:       validBoard.OwnerID,
+			taskID:         validTask.ID,
+			targetColumnID: targetColumn.ID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					if columnID == validColumn.ID {
+						return validColumn, nil
+					}
+					return domain.Column{}, repository.ErrRowNotFound
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
 					t.Fatalf("got call, want no call")
-					return domain.ColumnID{}, domain.TaskPosition{}, nil
+					return domain.TaskPlacement{}, nil
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
@@ -834,9 +1293,9 @@ func TestTask_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
 					t.Fatalf("got call, want no call")
-					return domain.ColumnID{}, domain.TaskPosition{}, nil
+					return domain.TaskPlacement{}, nil
 				}
 			},
 			wantErr: service.ErrColumnNotFound,
@@ -860,8 +1319,8 @@ func TestTask_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					return domain.ColumnID{}, domain.TaskPosition{}, repository.ErrIndexOutOfBounds
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, repository.ErrIndexOutOfBounds
 				}
 			},
 			wantErr: service.ErrIndexOutOfBounds,
@@ -885,8 +1344,8 @@ func TestTask_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					return domain.ColumnID{}, domain.TaskPosition{}, repository.ErrRowNotFound
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, repository.ErrRowNotFound
 				}
 			},
 			wantErr: service.ErrTaskNotFound,
@@ -910,13 +1369,87 @@ func TestTask_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return domain.Task{}, repository.ErrRowNotFound
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
 					t.Fatalf("got call, want no call")
-					return domain.ColumnID{}, domain.TaskPosition{}, nil
+					return domain.TaskPlacement{}, nil
 				}
 			},
 			wantErr: service.ErrTaskNotFound,
 		},
+		{
+			name:           "Task archived",
+			callerID:       validBoard.OwnerID,
+			taskID:         validTask.ID,
+			targetColumnID: validColumn.ID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return archivedTask, nil
+				}
+			},
+			wantErr: service.ErrTaskArchived,
+		},
+		{
+			name:           "Version conflict when version is stale",
+			callerID:       validBoard.OwnerID,
+			taskID:         validTask.ID,
+			targetColumnID: validColumn.ID,
+			ifMatch:        staleVersion,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:           "Version conflict when task changes concurrently",
+			callerID:       validBoard.OwnerID,
+			taskID:         validTask.ID,
+			targetColumnID: validColumn.ID,
+			ifMatch:        validTask.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+					if expected != validTask.Version {
+						t.Errorf("got expected version %v, want %v", expected, validTask.Version)
+					}
+					return domain.TaskPlacement{}, repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
 		{
 			name:           "Move internal error",
 			callerID:       validBoard.OwnerID,
@@ -936,8 +1469,8 @@ func TestTask_Move(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetPosition domain.TaskPosition) (domain.ColumnID, domain.TaskPosition, error) {
-					return domain.ColumnID{}, domain.TaskPosition{}, errors.New("move failed")
+				r.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, gotTargetColumnID domain.ColumnID, gotTargetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+					return domain.TaskPlacement{}, errors.New("move failed")
 				}
 			},
 			wantErr: service.ErrInternal,
@@ -950,24 +1483,286 @@ func TestTask_Move(t *testing.T) {
 
 			boardRepo := NewMockBoardRepository(t)
 			columnRepo := NewMockColumnRepository(t)
+			laneRepo := NewMockLaneRepository(t)
 			taskRepo := NewMockTaskRepository(t)
 			tt.setupBoardRepo(t, boardRepo)
 			tt.setupColumnRepo(t, columnRepo)
+			if tt.setupLaneRepo != nil {
+				tt.setupLaneRepo(t, laneRepo)
+			}
 			tt.setupTaskRepo(t, taskRepo)
 
-			s := service.NewTask(taskRepo, boardRepo, columnRepo)
-			gotColumn, gotPosition, err := s.Move(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, tt.taskID, tt.targetColumnID, targetPosition)
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, laneRepo, nil, nil, nil, false)
+			got, err := s.Move(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, tt.taskID, tt.targetColumnID, tt.targetLaneID, targetPosition, tt.ifMatch)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
 			}
 			if tt.wantErr == nil {
-				if gotColumn != tt.wantColumn {
-					t.Errorf("Move() column = %v, want %v", gotColumn, tt.wantColumn)
+				if got.ColumnID != tt.wantColumn {
+					t.Errorf("Move() column = %v, want %v", got.ColumnID, tt.wantColumn)
+				}
+				if got.LaneID != tt.wantLane {
+					t.Errorf("Move() lane = %v, want %v", got.LaneID, tt.wantLane)
+				}
+				if got.Position != tt.wantPosition {
+					t.Errorf("Move() position = %v, want %v", got.Position, tt.wantPosition)
+				}
+			}
+		})
+	}
+}
+
+func TestTask_MoveBlockers(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	doneColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 2)
+	doneColumn.IsDone = true
+	reviewColumn := testutil.NewValidColumn(t, validBoard.ID, "Review", 1)
+	validTask := testutil.ValidTask(validColumn.ID)
+	targetPosition := testutil.NewValidTaskPosition(t, 1)
+	blockerID := domain.NewTaskID()
+
+	tests := []struct {
+		name            string
+		enforce         bool
+		targetColumn    domain.Column
+		blockers        []domain.TaskID
+		blockersErr     error
+		wantListBlocker bool
+		wantErr         error
+		wantBlockers    []domain.TaskID
+	}{
+		{
+			name:            "Blocked",
+			enforce:         true,
+			targetColumn:    doneColumn,
+			blockers:        []domain.TaskID{blockerID},
+			wantListBlocker: true,
+			wantErr:         service.ErrTaskBlocked,
+			wantBlockers:    []domain.TaskID{blockerID},
+		},
+		{
+			name:            "No unfinished blockers",
+			enforce:         true,
+			targetColumn:    doneColumn,
+			wantListBlocker: true,
+		},
+		{
+			name:         "Not a done column",
+			enforce:      true,
+			targetColumn: reviewColumn,
+		},
+		{
+			name:         "Enforcement disabled",
+			targetColumn: doneColumn,
+		},
+		{
+			name:            "Blockers lookup failure",
+			enforce:         true,
+			targetColumn:    doneColumn,
+			blockersErr:     errors.New("db down"),
+			wantListBlocker: true,
+			wantErr:         service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				if columnID == tt.targetColumn.ID {
+					return tt.targetColumn, nil
+				}
+				return validColumn, nil
+			}
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				return validTask, nil
+			}
+			taskRepo.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+				return domain.TaskPlacement{ColumnID: targetColumnID, LaneID: targetLaneID, Position: gotTargetPosition}, nil
+			}
+			blockerRepo := NewMockTaskBlockerRepository(t)
+			listed := false
+			blockerRepo.ListUnfinishedBlockersFunc = func(ctx context.Context, taskID domain.TaskID) ([]domain.TaskID, error) {
+				listed = true
+				if taskID != validTask.ID {
+					t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+				}
+				return tt.blockers, tt.blockersErr
+			}
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, blockerRepo, nil, tt.enforce)
+			_, err := s.Move(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID, validTask.ID, tt.targetColumn.ID, nil, targetPosition, domain.Version{})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if listed != tt.wantListBlocker {
+				t.Errorf("blockers listed = %v, want %v", listed, tt.wantListBlocker)
+			}
+			if tt.wantBlockers != nil {
+				var blockedErr *service.TaskBlockedError
+				if !errors.As(err, &blockedErr) {
+					t.Fatalf("got error %v, want *service.TaskBlockedError", err)
+				}
+				if !slices.Equal(blockedErr.BlockerIDs, tt.wantBlockers) {
+					t.Errorf("got blocker ids %v, want %v", blockedErr.BlockerIDs, tt.wantBlockers)
+				}
+			}
+		})
+	}
+}
+
+func TestTask_MoveWorkflow(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	reviewColumn := testutil.NewValidColumn(t, validBoard.ID, "Review", 2)
+	doneColumn := testutil.NewValidColumn(t, validBoard.ID, "Done", 3)
+	reviewOnly, err := domain.NewColumnTransitions([]domain.ColumnID{reviewColumn.ID})
+	if err != nil {
+		t.Fatalf("NewColumnTransitions() error = %v", err)
+	}
+	sourceColumn := testutil.ValidColumn(validBoard.ID)
+	sourceColumn.Transitions = reviewOnly
+	doneColumn.EntryConditions, err = domain.NewColumnEntryConditions([]string{"checklist_done", "estimate_set"})
+	if err != nil {
+		t.Fatalf("NewColumnEntryConditions() error = %v", err)
+	}
+	reviewColumn.EntryConditions, err = domain.NewColumnEntryConditions([]string{"estimate_set"})
+	if err != nil {
+		t.Fatalf("NewColumnEntryConditions() error = %v", err)
+	}
+	ownerReviewColumn := reviewColumn
+	ownerReviewColumn.EntryRoles, err = domain.NewColumnEntryRoles([]string{"owner"})
+	if err != nil {
+		t.Fatalf("NewColumnEntryRoles() error = %v", err)
+	}
+	automationReviewColumn := reviewColumn
+	automationReviewColumn.EntryRoles, err = domain.NewColumnEntryRoles([]string{"automation"})
+	if err != nil {
+		t.Fatalf("NewColumnEntryRoles() error = %v", err)
+	}
+
+	estimate, err := domain.NewTaskEstimate(2)
+	if err != nil {
+		t.Fatalf("NewTaskEstimate() error = %v", err)
+	}
+	unfinished, err := domain.NewTaskChecklist([]domain.TaskChecklistItem{{Text: "Review"}})
+	if err != nil {
+		t.Fatalf("NewTaskChecklist() error = %v", err)
+	}
+	estimatedTask := testutil.ValidTask(sourceColumn.ID)
+	estimatedTask.Estimate = estimate
+	roughTask := testutil.ValidTask(sourceColumn.ID)
+	roughTask.Checklist = unfinished
+	targetPosition := testutil.NewValidTaskPosition(t, 1)
+
+	tests := []struct {
+		name           string
+		task           domain.Task
+		targetColumn   domain.Column
+		wantMove       bool
+		wantAllowed    bool
+		wantRestricted bool
+		wantUnmet      []domain.ColumnEntryCondition
+	}{
+		{
+			name:         "Allowed transition with conditions met",
+			task:         estimatedTask,
+			targetColumn: reviewColumn,
+			wantMove:     true,
+		},
+		{
+			name:         "Owner moves into an owner only column",
+			task:         estimatedTask,
+			targetColumn: ownerReviewColumn,
+			wantMove:     true,
+		},
+		{
+			name:           "Owner can't move into an automation only column",
+			task:           estimatedTask,
+			targetColumn:   automationReviewColumn,
+			wantAllowed:    true,
+			wantRestricted: true,
+		},
+		{
+			name:         "Allowed transition with unmet condition",
+			task:         roughTask,
+			targetColumn: reviewColumn,
+			wantAllowed:  true,
+			wantUnmet:    []domain.ColumnEntryCondition{domain.ColumnEntryEstimateSet},
+		},
+		{
+			name:         "Skipped column lists every broken rule",
+			task:         roughTask,
+			targetColumn: doneColumn,
+			wantUnmet:    []domain.ColumnEntryCondition{domain.ColumnEntryChecklistDone, domain.ColumnEntryEstimateSet},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				if columnID == tt.targetColumn.ID {
+					return tt.targetColumn, nil
 				}
-				if gotPosition != tt.wantPosition {
-					t.Errorf("Move() position = %v, want %v", gotPosition, tt.wantPosition)
+				return sourceColumn, nil
+			}
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				return tt.task, nil
+			}
+			moved := false
+			taskRepo.MoveFunc = func(ctx context.Context, boardID domain.BoardID, currentColumnID domain.ColumnID, taskID domain.TaskID, targetColumnID domain.ColumnID, targetLaneID domain.LaneID, gotTargetPosition domain.TaskPosition, expected domain.Version) (domain.TaskPlacement, error) {
+				moved = true
+				return domain.TaskPlacement{ColumnID: targetColumnID, LaneID: targetLaneID, Position: gotTargetPosition}, nil
+			}
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			_, err := s.Move(context.Background(), validBoard.OwnerID, validBoard.ID, sourceColumn.ID, tt.task.ID, tt.targetColumn.ID, nil, targetPosition, domain.Version{})
+
+			if moved != tt.wantMove {
+				t.Errorf("moved = %v, want %v", moved, tt.wantMove)
+			}
+			if tt.wantMove {
+				if err != nil {
+					t.Errorf("got error %v, want nil", err)
 				}
+				return
+			}
+			if !errors.Is(err, service.ErrTransitionNotAllowed) {
+				t.Fatalf("got error %v, want %v", err, service.ErrTransitionNotAllowed)
+			}
+			var transitionErr *service.TransitionNotAllowedError
+			if !errors.As(err, &transitionErr) {
+				t.Fatalf("got error %v, want *service.TransitionNotAllowedError", err)
+			}
+			if transitionErr.Allowed != tt.wantAllowed {
+				t.Errorf("got allowed %v, want %v", transitionErr.Allowed, tt.wantAllowed)
+			}
+			if transitionErr.Restricted != tt.wantRestricted {
+				t.Errorf("got restricted %v, want %v", transitionErr.Restricted, tt.wantRestricted)
+			}
+			if !slices.Equal(transitionErr.UnmetConditions, tt.wantUnmet) {
+				t.Errorf("got unmet conditions %v, want %v", transitionErr.UnmetConditions, tt.wantUnmet)
 			}
 		})
 	}
@@ -979,11 +1774,13 @@ func TestTask_Delete(t *testing.T) {
 	validBoard := testutil.ValidBoard()
 	validColumn := testutil.ValidColumn(validBoard.ID)
 	validTask := testutil.ValidTask(validColumn.ID)
+	staleVersion := testutil.UpdateValidTask(t, &validTask, "Renamed", "Renamed description", testutil.FixedNow()).Version
 
 	tests := []struct {
 		name            string
 		callerID        domain.UserID
 		taskID          domain.TaskID
+		ifMatch         domain.Version
 		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
 		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
 		setupTaskRepo   func(t *testing.T, r *MockTaskRepository)
@@ -1007,7 +1804,7 @@ func TestTask_Delete(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error {
 					if boardID != validBoard.ID {
 						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
 					}
@@ -1041,7 +1838,7 @@ func TestTask_Delete(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error {
 					t.Fatalf("got call, want no call")
 					return nil
 				}
@@ -1068,7 +1865,7 @@ func TestTask_Delete(t *testing.T) {
 					t.Fatalf("got call, want no call")
 					return domain.Task{}, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error {
 					t.Fatalf("got call, want no call")
 					return nil
 				}
@@ -1093,16 +1890,17 @@ func TestTask_Delete(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error {
 					return repository.ErrRowNotFound
 				}
 			},
 			wantErr: service.ErrTaskNotFound,
 		},
 		{
-			name:     "Delete internal error",
+			name:     "Version conflict when version is stale",
 			callerID: validBoard.OwnerID,
 			taskID:   validTask.ID,
+			ifMatch:  staleVersion,
 			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
 				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
 					return validBoard, nil
@@ -1117,17 +1915,66 @@ func TestTask_Delete(t *testing.T) {
 				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
 					return validTask, nil
 				}
-				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) error {
-					return errors.New("delete failed")
-				}
 			},
-			wantErr: service.ErrInternal,
+			wantErr: service.ErrVersionConflict,
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
+		{
+			name:     "Version conflict when task changes concurrently",
+			callerID: validBoard.OwnerID,
+			taskID:   validTask.ID,
+			ifMatch:  validTask.Version,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error {
+					if expected != validTask.Version {
+						t.Errorf("got expected version %v, want %v", expected, validTask.Version)
+					}
+					return repository.ErrVersionConflict
+				}
+			},
+			wantErr: service.ErrVersionConflict,
+		},
+		{
+			name:     "Delete internal error",
+			callerID: validBoard.OwnerID,
+			taskID:   validTask.ID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.DeleteFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, cascade bool, expected domain.Version) error {
+					return errors.New("delete failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
 
 			boardRepo := NewMockBoardRepository(t)
 			columnRepo := NewMockColumnRepository(t)
@@ -1136,8 +1983,8 @@ func TestTask_Delete(t *testing.T) {
 			tt.setupColumnRepo(t, columnRepo)
 			tt.setupTaskRepo(t, taskRepo)
 
-			s := service.NewTask(taskRepo, boardRepo, columnRepo)
-			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, tt.taskID)
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			err := s.Delete(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, tt.taskID, false, tt.ifMatch)
 
 			if !errors.Is(err, tt.wantErr) {
 				t.Errorf("got error %v, want %v", err, tt.wantErr)
@@ -1145,3 +1992,707 @@ func TestTask_Delete(t *testing.T) {
 		})
 	}
 }
+
+func TestTask_Duplicate(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	copiedTask := validTask
+	copiedTask.ID = domain.NewTaskID()
+	archivedTask := validTask
+	archivedTask.ArchivedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		callerID        domain.UserID
+		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
+		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
+		setupTaskRepo   func(t *testing.T, r *MockTaskRepository)
+		wantTask        domain.Task
+		wantErr         error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if columnID != validColumn.ID {
+						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
+					}
+					if taskID != validTask.ID {
+						t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+					}
+					return copiedTask, nil
+				}
+			},
+			wantTask: copiedTask,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {},
+			setupTaskRepo:   func(t *testing.T, r *MockTaskRepository) {},
+			wantErr:         service.ErrTaskNotFound,
+		},
+		{
+			name:     "Column from other board",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return testutil.ValidColumn(domain.NewBoardID()), nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {},
+			wantErr:       service.ErrTaskNotFound,
+		},
+		{
+			name:     "Task from other column",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return testutil.ValidTask(domain.NewColumnID()), nil
+				}
+			},
+			wantErr: service.ErrTaskNotFound,
+		},
+		{
+			name:     "Task archived",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return archivedTask, nil
+				}
+			},
+			wantErr: service.ErrTaskArchived,
+		},
+		{
+			name:     "Duplicate returns not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrTaskNotFound,
+		},
+		{
+			name:     "Duplicate internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+				r.DuplicateFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, errors.New("duplicate failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			columnRepo := NewMockColumnRepository(t)
+			taskRepo := NewMockTaskRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupColumnRepo(t, columnRepo)
+			tt.setupTaskRepo(t, taskRepo)
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			got, err := s.Duplicate(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, validTask.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantTask, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Duplicate() task mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTask_Restore(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	archivedTask := validTask
+	archivedTask.ArchivedAt = time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	restoredTask := validTask
+	restoredTask.Position = testutil.NewValidTaskPosition(t, 3)
+
+	tests := []struct {
+		name            string
+		callerID        domain.UserID
+		setupBoardRepo  func(t *testing.T, r *MockBoardRepository)
+		setupColumnRepo func(t *testing.T, r *MockColumnRepository)
+		setupTaskRepo   func(t *testing.T, r *MockTaskRepository)
+		wantTask        domain.Task
+		wantErr         error
+	}{
+		{
+			name:     "Success",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return archivedTask, nil
+				}
+				r.RestoreFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					if boardID != validBoard.ID {
+						t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+					}
+					if columnID != validColumn.ID {
+						t.Errorf("got column id %v, want %v", columnID, validColumn.ID)
+					}
+					if taskID != validTask.ID {
+						t.Errorf("got task id %v, want %v", taskID, validTask.ID)
+					}
+					return restoredTask, nil
+				}
+			},
+			wantTask: restoredTask,
+		},
+		{
+			name:     "Active task is returned as is",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return validTask, nil
+				}
+			},
+			wantTask: validTask,
+		},
+		{
+			name:     "Caller has no access",
+			callerID: domain.NewUserID(),
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {},
+			setupTaskRepo:   func(t *testing.T, r *MockTaskRepository) {},
+			wantErr:         service.ErrTaskNotFound,
+		},
+		{
+			name:     "Task from other column",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					task := testutil.ValidTask(domain.NewColumnID())
+					task.ArchivedAt = archivedTask.ArchivedAt
+					return task, nil
+				}
+			},
+			wantErr: service.ErrTaskNotFound,
+		},
+		{
+			name:     "Restore returns not found",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return archivedTask, nil
+				}
+				r.RestoreFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, repository.ErrRowNotFound
+				}
+			},
+			wantErr: service.ErrTaskNotFound,
+		},
+		{
+			name:     "Restore internal error",
+			callerID: validBoard.OwnerID,
+			setupBoardRepo: func(t *testing.T, r *MockBoardRepository) {
+				r.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+					return validBoard, nil
+				}
+			},
+			setupColumnRepo: func(t *testing.T, r *MockColumnRepository) {
+				r.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+					return validColumn, nil
+				}
+			},
+			setupTaskRepo: func(t *testing.T, r *MockTaskRepository) {
+				r.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+					return archivedTask, nil
+				}
+				r.RestoreFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID) (domain.Task, error) {
+					return domain.Task{}, errors.New("restore failed")
+				}
+			},
+			wantErr: service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			columnRepo := NewMockColumnRepository(t)
+			taskRepo := NewMockTaskRepository(t)
+			tt.setupBoardRepo(t, boardRepo)
+			tt.setupColumnRepo(t, columnRepo)
+			tt.setupTaskRepo(t, taskRepo)
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			got, err := s.Restore(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, validTask.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr == nil {
+				if diff := cmp.Diff(tt.wantTask, got, testutil.CmpAllowUnexported()); diff != "" {
+					t.Errorf("Restore() task mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestTask_SetParent(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	foreignColumn := testutil.ValidColumn(domain.NewBoardID())
+	validTask := testutil.ValidTask(validColumn.ID)
+	parent := testutil.NewValidTask(t, validColumn.ID, "Parent", "", 2)
+	foreignParent := testutil.ValidTask(foreignColumn.ID)
+	currentParentID := domain.NewTaskID()
+
+	tests := []struct {
+		name          string
+		parentID      domain.TaskID
+		currentParent domain.TaskID
+		setParentErr  error
+		wantSetParent bool
+		wantErr       error
+	}{
+		{name: "Success", parentID: parent.ID, wantSetParent: true},
+		{name: "Clear parent", currentParent: currentParentID, wantSetParent: true},
+		{name: "Unchanged parent", parentID: currentParentID, currentParent: currentParentID},
+		{name: "Parent not found", parentID: domain.NewTaskID(), wantErr: service.ErrParentTaskNotFound},
+		{name: "Parent on another board", parentID: foreignParent.ID, wantErr: service.ErrParentTaskNotFound},
+		{
+			name:          "Cycle",
+			parentID:      parent.ID,
+			setParentErr:  repository.ErrCycle,
+			wantSetParent: true,
+			wantErr:       service.ErrTaskParentCycle,
+		},
+		{
+			name:          "Task gone",
+			parentID:      parent.ID,
+			setParentErr:  repository.ErrRowNotFound,
+			wantSetParent: true,
+			wantErr:       service.ErrTaskNotFound,
+		},
+		{
+			name:          "Repository failure",
+			parentID:      parent.ID,
+			setParentErr:  repository.ErrInternal,
+			wantSetParent: true,
+			wantErr:       service.ErrInternal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				if columnID == foreignColumn.ID {
+					return foreignColumn, nil
+				}
+				return validColumn, nil
+			}
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				switch taskID {
+				case validTask.ID:
+					task := validTask
+					task.ParentID = tt.currentParent
+					return task, nil
+				case parent.ID:
+					return parent, nil
+				case foreignParent.ID:
+					return foreignParent, nil
+				}
+				return domain.Task{}, repository.ErrRowNotFound
+			}
+			setParentHit := false
+			taskRepo.SetParentFunc = func(ctx context.Context, boardID domain.BoardID, columnID domain.ColumnID, taskID domain.TaskID, parentID domain.TaskID) (domain.Task, error) {
+				setParentHit = true
+				if boardID != validBoard.ID {
+					t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+				}
+				if parentID != tt.parentID {
+					t.Errorf("got parent id %v, want %v", parentID, tt.parentID)
+				}
+				if tt.setParentErr != nil {
+					return domain.Task{}, tt.setParentErr
+				}
+				task := validTask
+				task.ParentID = parentID
+				return task, nil
+			}
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			got, err := s.SetParent(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID, validTask.ID, tt.parentID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if setParentHit != tt.wantSetParent {
+				t.Errorf("repository set parent called = %v, want %v", setParentHit, tt.wantSetParent)
+			}
+			if tt.wantErr == nil && got.ParentID != tt.parentID {
+				t.Errorf("got parent id %v, want %v", got.ParentID, tt.parentID)
+			}
+		})
+	}
+}
+
+func TestTask_ListChildren(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	child := testutil.ValidTask(domain.NewColumnID())
+	child.ParentID = validTask.ID
+
+	tests := []struct {
+		name        string
+		callerID    domain.UserID
+		listErr     error
+		wantErr     error
+		wantIDs     []domain.TaskID
+		wantListHit bool
+	}{
+		{name: "Success", callerID: validBoard.OwnerID, wantIDs: []domain.TaskID{child.ID}, wantListHit: true},
+		{name: "Foreign board", callerID: domain.NewUserID(), wantErr: service.ErrTaskNotFound},
+		{name: "Repository failure", callerID: validBoard.OwnerID, listErr: errors.New("db down"), wantErr: service.ErrInternal, wantListHit: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			boardRepo := NewMockBoardRepository(t)
+			boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+				return validBoard, nil
+			}
+			columnRepo := NewMockColumnRepository(t)
+			columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+				return validColumn, nil
+			}
+			taskRepo := NewMockTaskRepository(t)
+			taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+				return validTask, nil
+			}
+			listHit := false
+			taskRepo.ListChildrenFunc = func(ctx context.Context, parentID domain.TaskID) ([]domain.Task, error) {
+				listHit = true
+				if parentID != validTask.ID {
+					t.Errorf("got parent id %v, want %v", parentID, validTask.ID)
+				}
+				if tt.listErr != nil {
+					return nil, tt.listErr
+				}
+				return []domain.Task{child}, nil
+			}
+
+			s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, nil, false)
+			got, err := s.ListChildren(context.Background(), tt.callerID, validBoard.ID, validColumn.ID, validTask.ID)
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("got error %v, want %v", err, tt.wantErr)
+			}
+			if listHit != tt.wantListHit {
+				t.Errorf("repository list children called = %v, want %v", listHit, tt.wantListHit)
+			}
+			gotIDs := make([]domain.TaskID, 0, len(got))
+			for _, task := range got {
+				gotIDs = append(gotIDs, task.ID)
+			}
+			if len(tt.wantIDs) > 0 && !slices.Equal(gotIDs, tt.wantIDs) {
+				t.Errorf("got children %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
+
+func TestTask_CustomFields(t *testing.T) {
+	t.Parallel()
+
+	validBoard := testutil.ValidBoard()
+	validColumn := testutil.ValidColumn(validBoard.ID)
+	validTask := testutil.ValidTask(validColumn.ID)
+	envField := testutil.ValidCustomField(validBoard.ID)
+	unknownFieldID := domain.NewCustomFieldID()
+
+	staging, err := domain.NewCustomFieldValue(&envField, json.RawMessage(`"staging"`))
+	if err != nil {
+		t.Fatalf("NewCustomFieldValue() error = %v", err)
+	}
+	taggedTask := validTask
+	taggedTask.CustomFields = domain.TaskCustomFields{}.With(envField.ID, staging)
+
+	// newRepos returns the repositories of a board with the env field, where current is the stored task.
+	newRepos := func(t *testing.T, taskRepo *MockTaskRepository, current domain.Task) (*MockBoardRepository, *MockColumnRepository, *MockCustomFieldRepository) {
+		t.Helper()
+
+		boardRepo := NewMockBoardRepository(t)
+		boardRepo.GetFunc = func(ctx context.Context, id domain.BoardID) (domain.Board, error) {
+			return validBoard, nil
+		}
+		columnRepo := NewMockColumnRepository(t)
+		columnRepo.GetFunc = func(ctx context.Context, columnID domain.ColumnID) (domain.Column, error) {
+			return validColumn, nil
+		}
+		fieldRepo := NewMockCustomFieldRepository(t)
+		fieldRepo.ListByBoardIDFunc = func(ctx context.Context, boardID domain.BoardID) ([]domain.CustomField, error) {
+			if boardID != validBoard.ID {
+				t.Errorf("got board id %v, want %v", boardID, validBoard.ID)
+			}
+			return []domain.CustomField{envField}, nil
+		}
+		taskRepo.GetFunc = func(ctx context.Context, taskID domain.TaskID) (domain.Task, error) {
+			return current, nil
+		}
+
+		return boardRepo, columnRepo, fieldRepo
+	}
+
+	t.Run("Create stores validated values", func(t *testing.T) {
+		t.Parallel()
+
+		taskRepo := NewMockTaskRepository(t)
+		taskRepo.CreateFunc = func(ctx context.Context, columnID domain.ColumnID, laneID domain.LaneID, parentID domain.TaskID, name domain.TaskName, description domain.TaskDescription, checklist domain.TaskChecklist, estimate domain.TaskEstimate, customFields domain.TaskCustomFields) (domain.Task, error) {
+			value, ok := customFields.Get(envField.ID)
+			if !ok || string(value.JSON()) != `"staging"` {
+				t.Errorf("got custom fields %v, want staging", customFields.JSON())
+			}
+			return taggedTask, nil
+		}
+
+		boardRepo, columnRepo, fieldRepo := newRepos(t, taskRepo, validTask)
+
+		s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, fieldRepo, false)
+		_, err := s.Create(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID, domain.LaneID{}, domain.TaskID{}, validTask.Name, validTask.Description, validTask.Checklist, validTask.Estimate,
+			map[domain.CustomFieldID]json.RawMessage{envField.ID: json.RawMessage(`"staging"`)})
+		if err != nil {
+			t.Fatalf("Create() error = %v", err)
+		}
+	})
+
+	t.Run("Create rejects invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		taskRepo := NewMockTaskRepository(t)
+		boardRepo, columnRepo, fieldRepo := newRepos(t, taskRepo, validTask)
+
+		s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, fieldRepo, false)
+		_, err := s.Create(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID, domain.LaneID{}, domain.TaskID{}, validTask.Name, validTask.Description, validTask.Checklist, validTask.Estimate,
+			map[domain.CustomFieldID]json.RawMessage{
+				envField.ID:    json.RawMessage(`"qa"`),
+				unknownFieldID: json.RawMessage(`1`),
+			})
+
+		var valuesErr *service.CustomFieldValuesError
+		if !errors.As(err, &valuesErr) {
+			t.Fatalf("got error %v, want CustomFieldValuesError", err)
+		}
+		if !errors.Is(err, service.ErrCustomFieldValueInvalid) {
+			t.Errorf("got error %v, want %v", err, service.ErrCustomFieldValueInvalid)
+		}
+		want := map[domain.CustomFieldID][]string{
+			envField.ID:    {domain.ErrCustomFieldOptionValue},
+			unknownFieldID: {domain.ErrCustomFieldNotFound},
+		}
+		if diff := cmp.Diff(want, valuesErr.Issues, testutil.CmpAllowUnexported()); diff != "" {
+			t.Errorf("issues mismatch (-want +got):\n%s", diff)
+		}
+	})
+
+	t.Run("Update with null removes the value", func(t *testing.T) {
+		t.Parallel()
+
+		taskRepo := NewMockTaskRepository(t)
+		taskRepo.UpdateFunc = func(ctx context.Context, columnID domain.ColumnID, taskID domain.TaskID, name *domain.TaskName, description *domain.TaskDescription, checklist *domain.TaskChecklist, estimate *domain.TaskEstimate, customFields *domain.TaskCustomFields, expected domain.Version) (domain.Task, error) {
+			if customFields == nil || customFields.Len() != 0 {
+				t.Errorf("got custom fields %v, want empty", customFields)
+			}
+			return validTask, nil
+		}
+
+		boardRepo, columnRepo, fieldRepo := newRepos(t, taskRepo, taggedTask)
+
+		s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, fieldRepo, false)
+		_, err := s.Update(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID, validTask.ID, nil, nil, nil, nil,
+			map[domain.CustomFieldID]json.RawMessage{envField.ID: json.RawMessage(`null`)}, domain.Version{})
+		if err != nil {
+			t.Fatalf("Update() error = %v", err)
+		}
+	})
+
+	t.Run("List filters by value", func(t *testing.T) {
+		t.Parallel()
+
+		taskRepo := NewMockTaskRepository(t)
+		taskRepo.ListByColumnIDFunc = func(ctx context.Context, columnID domain.ColumnID, filter domain.TaskCustomFields, includeArchived bool) ([]domain.Task, error) {
+			value, ok := filter.Get(envField.ID)
+			if !ok || string(value.JSON()) != `"production"` {
+				t.Errorf("got filter %v, want production", filter.JSON())
+			}
+			return nil, nil
+		}
+
+		boardRepo, columnRepo, fieldRepo := newRepos(t, taskRepo, validTask)
+
+		s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, fieldRepo, false)
+		_, err := s.ListByColumnID(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID,
+			map[domain.CustomFieldID][]string{envField.ID: {"production"}}, false)
+		if err != nil {
+			t.Fatalf("ListByColumnID() error = %v", err)
+		}
+	})
+
+	t.Run("List rejects repeated filters", func(t *testing.T) {
+		t.Parallel()
+
+		taskRepo := NewMockTaskRepository(t)
+		boardRepo, columnRepo, fieldRepo := newRepos(t, taskRepo, validTask)
+
+		s := service.NewTask(taskRepo, boardRepo, columnRepo, nil, nil, nil, fieldRepo, false)
+		_, err := s.ListByColumnID(context.Background(), validBoard.OwnerID, validBoard.ID, validColumn.ID,
+			map[domain.CustomFieldID][]string{envField.ID: {"staging", "production"}}, false)
+		if !errors.Is(err, service.ErrCustomFieldValueInvalid) {
+			t.Errorf("got error %v, want %v", err, service.ErrCustomFieldValueInvalid)
+		}
+	})
+}
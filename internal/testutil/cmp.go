@@ -57,15 +57,57 @@ func CmpAllowUnexported() cmp.Option {
 		domain.BoardID{},
 		domain.BoardName{},
 		domain.BoardDescription{},
+		domain.BoardTemplateID{},
 		domain.UserID{},
 		domain.ColumnID{},
 		domain.ColumnName{},
 		domain.ColumnDescription{},
 		domain.ColumnPosition{},
+		domain.ColumnWIPLimit{},
+		domain.ColumnSLA{},
+		domain.ColumnArchiveAfter{},
+		domain.ColumnTransitions{},
+		domain.ColumnEntryConditions{},
+		domain.ColumnEntryRoles{},
+		domain.LaneID{},
+		domain.LaneName{},
+		domain.LanePosition{},
 		domain.TaskID{},
 		domain.TaskName{},
 		domain.TaskDescription{},
 		domain.TaskPosition{},
+		domain.TaskChecklist{},
+		domain.TaskEstimate{},
+		domain.TaskCustomFields{},
+		domain.CustomFieldID{},
+		domain.CustomFieldName{},
+		domain.CustomFieldOptions{},
+		domain.CustomFieldValue{},
+		domain.TaskLinkID{},
+		domain.TaskTransitionID{},
+		domain.TaskTemplateID{},
+		domain.TaskNamePattern{},
+		domain.TaskDescriptionPattern{},
+		domain.RecurrenceID{},
+		domain.RecurrenceRule{},
+		domain.SprintID{},
+		domain.SprintName{},
+		domain.SprintGoal{},
+		domain.ForecastTaskCount{},
+		domain.ForecastSimulations{},
+		domain.ForecastHistoryDays{},
+		domain.ForecastConfidence{},
+		domain.ForecastSeed{},
+		domain.AutomationID{},
+		domain.AutomationName{},
+		domain.AutomationMessage{},
+		domain.AutomationRunID{},
+		domain.AutomationEventID{},
+		domain.AutomationDryRunEvents{},
+		domain.BoardImportID{},
+		domain.Version{},
+		domain.IdempotencyKey{},
+		domain.BoardEventCursor{},
 		domain.UserPassword{},
 		domain.AuthToken{},
 		domain.TelegramLinkToken{},
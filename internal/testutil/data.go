@@ -49,11 +49,31 @@ func NewValidColumnPosition(t *testing.T, n int64) domain.ColumnPosition {
 	return must(domain.NewColumnPosition, n)
 }
 
+func NewValidColumnSLA(t *testing.T, hours int64) domain.ColumnSLA {
+	t.Helper()
+	return must(domain.NewColumnSLA, hours)
+}
+
+func NewValidColumnArchiveAfter(t *testing.T, days int64) domain.ColumnArchiveAfter {
+	t.Helper()
+	return must(domain.NewColumnArchiveAfter, days)
+}
+
 func NewValidTaskPosition(t *testing.T, n int64) domain.TaskPosition {
 	t.Helper()
 	return must(domain.NewTaskPosition, n)
 }
 
+func NewValidTaskEstimate(t *testing.T, points float64) domain.TaskEstimate {
+	t.Helper()
+	return must(domain.NewTaskEstimate, points)
+}
+
+func NewValidTaskChecklist(t *testing.T, items ...domain.TaskChecklistItem) domain.TaskChecklist {
+	t.Helper()
+	return must(domain.NewTaskChecklist, items)
+}
+
 func NewValidColumn(t *testing.T, boardID domain.BoardID, name string, position int64) domain.Column {
 	t.Helper()
 
@@ -68,6 +88,20 @@ func NewValidColumn(t *testing.T, boardID domain.BoardID, name string, position
 	return column
 }
 
+func NewValidLane(t *testing.T, boardID domain.BoardID, name string, position int64) domain.Lane {
+	t.Helper()
+
+	lane := ValidLane(boardID)
+
+	domainName := must(domain.NewLaneName, name)
+	domainPosition := must(domain.NewLanePosition, position)
+
+	lane.Name = domainName
+	lane.Position = domainPosition
+
+	return lane
+}
+
 func NewValidTask(t *testing.T, columnID domain.ColumnID, name, description string, position int64) domain.Task {
 	t.Helper()
 
@@ -166,6 +200,7 @@ func ValidBoard() domain.Board {
 		Description: description,
 		CreatedAt:   pseudoNow,
 		UpdatedAt:   pseudoNow,
+		Version:     must(domain.NewVersion, 1),
 	}
 
 	return validBoard
@@ -185,6 +220,50 @@ func ValidColumn(boardID domain.BoardID) domain.Column {
 		Position:    position,
 		CreatedAt:   pseudoNow,
 		UpdatedAt:   pseudoNow,
+		Version:     must(domain.NewVersion, 1),
+	}
+}
+
+func ValidLane(boardID domain.BoardID) domain.Lane {
+	pseudoNow := FixedNow()
+
+	return domain.Lane{
+		ID:        domain.NewLaneID(),
+		BoardID:   boardID,
+		Name:      must(domain.NewLaneName, "Platform team"),
+		Position:  must(domain.NewLanePosition, 1),
+		CreatedAt: pseudoNow,
+		UpdatedAt: pseudoNow,
+	}
+}
+
+// ValidCustomField returns a single select field with the options "staging" and "production".
+func ValidCustomField(boardID domain.BoardID) domain.CustomField {
+	pseudoNow := FixedNow()
+
+	return domain.CustomField{
+		ID:        domain.NewCustomFieldID(),
+		BoardID:   boardID,
+		Name:      must(domain.NewCustomFieldName, "Environment"),
+		Type:      domain.CustomFieldSingleSelect,
+		Options:   must(domain.NewCustomFieldOptions, []string{"staging", "production"}),
+		CreatedAt: pseudoNow,
+		UpdatedAt: pseudoNow,
+	}
+}
+
+func ValidSprint(boardID domain.BoardID) domain.Sprint {
+	pseudoNow := FixedNow()
+
+	return domain.Sprint{
+		ID:        domain.NewSprintID(),
+		BoardID:   boardID,
+		Name:      must(domain.NewSprintName, "Sprint 14"),
+		Goal:      must(domain.NewSprintGoal, "Ship the billing page"),
+		StartDate: must(domain.ParseSprintDate, "2026-01-05"),
+		EndDate:   must(domain.ParseSprintDate, "2026-01-18"),
+		CreatedAt: pseudoNow,
+		UpdatedAt: pseudoNow,
 	}
 }
 
@@ -202,6 +281,91 @@ func ValidTask(columnID domain.ColumnID) domain.Task {
 		Position:    position,
 		CreatedAt:   pseudoNow,
 		UpdatedAt:   pseudoNow,
+		Version:     must(domain.NewVersion, 1),
+	}
+}
+
+func ValidBoardTemplate(ownerID domain.UserID) domain.BoardTemplate {
+	pseudoNow := FixedNow()
+
+	return domain.BoardTemplate{
+		ID:          domain.NewBoardTemplateID(),
+		OwnerID:     ownerID,
+		Name:        ValidBoardName(),
+		Description: ValidBoardDescription(),
+		Columns: []domain.BoardTemplateColumn{
+			{
+				Name:        validColumnName(),
+				Description: ValidColumnDescription(),
+				WIPLimit:    must(domain.NewColumnWIPLimit, int64(3)),
+				Tasks: []domain.BoardTemplateTask{
+					{Name: validTaskName(), Description: validTaskDescription()},
+				},
+			},
+		},
+		CreatedAt: pseudoNow,
+		UpdatedAt: pseudoNow,
+	}
+}
+
+func ValidTaskTemplate(boardID domain.BoardID) domain.TaskTemplate {
+	pseudoNow := FixedNow()
+
+	return domain.TaskTemplate{
+		ID:                 domain.NewTaskTemplateID(),
+		BoardID:            boardID,
+		NamePattern:        must(domain.NewTaskNamePattern, "Standup {{date}}"),
+		DescriptionPattern: must(domain.NewTaskDescriptionPattern, "Notes for {{weekday}}"),
+		Checklist:          must(domain.NewTaskChecklist, []domain.TaskChecklistItem{{Text: "Yesterday"}, {Text: "Today"}}),
+		CreatedAt:          pseudoNow,
+		UpdatedAt:          pseudoNow,
+	}
+}
+
+func ValidRecurrence(boardID domain.BoardID, columnID domain.ColumnID) domain.Recurrence {
+	pseudoNow := FixedNow()
+	rule := must(domain.NewRecurrenceRule, "FREQ=WEEKLY;BYDAY=MO,WE")
+	startsAt := must(domain.NewRecurrenceStartsAt, pseudoNow.Add(-24*time.Hour))
+
+	return domain.Recurrence{
+		ID:          domain.NewRecurrenceID(),
+		BoardID:     boardID,
+		ColumnID:    columnID,
+		Name:        validTaskName(),
+		Description: validTaskDescription(),
+		Rule:        rule,
+		StartsAt:    startsAt,
+		CatchUp:     domain.RecurrenceCatchUpSkip,
+		NextRunAt:   rule.Next(startsAt, pseudoNow),
+		CreatedAt:   pseudoNow,
+		UpdatedAt:   pseudoNow,
+	}
+}
+
+func ValidAutomation(boardID domain.BoardID, columnID domain.ColumnID) domain.Automation {
+	pseudoNow := FixedNow()
+
+	return domain.Automation{
+		ID:        domain.NewAutomationID(),
+		BoardID:   boardID,
+		Name:      must(domain.NewAutomationName, "Move new tasks"),
+		Trigger:   domain.AutomationTrigger{Type: domain.AutomationTaskCreated},
+		Action:    domain.NewMoveToColumnAction(columnID),
+		Enabled:   true,
+		CreatedAt: pseudoNow,
+		UpdatedAt: pseudoNow,
+	}
+}
+
+func ValidBoardImport(ownerID domain.UserID) domain.BoardImport {
+	return domain.BoardImport{
+		ID:        domain.NewBoardImportID(),
+		OwnerID:   ownerID,
+		Source:    domain.BoardImportTrello,
+		Content:   []byte(`{"name":"Roadmap","lists":[{"id":"l1","name":"To do","pos":1}],"cards":[{"id":"c1","idList":"l1","name":"Write docs","pos":1}]}`),
+		Status:    domain.BoardImportPending,
+		Errors:    []domain.BoardImportError{},
+		CreatedAt: FixedNow(),
 	}
 }
 
@@ -229,6 +393,21 @@ func ValidTelegramMessage() domain.TelegramMessage {
 	return must(domain.NewTelegramMessage, "Hello, world!")
 }
 
+func ValidIdempotencyKey() domain.IdempotencyKey {
+	return must(domain.NewIdempotencyKey, validUUIDv7)
+}
+
+// ValidIdempotentRequest is a task creation retried with ValidIdempotencyKey.
+func ValidIdempotentRequest() domain.IdempotentRequest {
+	return domain.IdempotentRequest{
+		UserID:      ValidUserID(),
+		Route:       "POST /v1/boards/" + validUUIDv7 + "/columns/" + validUUIDv7 + "/tasks",
+		Key:         ValidIdempotencyKey(),
+		Fingerprint: "8a5edab282632443219e051e4ade2d1d5bbc671c781051bf1437897cbdfea0f1",
+		Attempt:     "first-attempt",
+	}
+}
+
 func UpdateValidColumn(t *testing.T, base *domain.Column, name, description string, updatedAt time.Time) domain.Column {
 	t.Helper()
 
@@ -243,6 +422,7 @@ func UpdateValidColumn(t *testing.T, base *domain.Column, name, description stri
 		Position:    base.Position,
 		CreatedAt:   base.CreatedAt,
 		UpdatedAt:   updatedAt,
+		Version:     must(domain.NewVersion, base.Version.Int64()+1),
 	}
 }
 
@@ -258,6 +438,7 @@ func UpdateValidBoard(t *testing.T, base *domain.Board, name, description string
 		Description: domainDescription,
 		CreatedAt:   base.CreatedAt,
 		UpdatedAt:   updatedAt,
+		Version:     must(domain.NewVersion, base.Version.Int64()+1),
 	}
 }
 
@@ -275,5 +456,6 @@ func UpdateValidTask(t *testing.T, base *domain.Task, name, description string,
 		Position:    base.Position,
 		CreatedAt:   base.CreatedAt,
 		UpdatedAt:   updatedAt,
+		Version:     must(domain.NewVersion, base.Version.Int64()+1),
 	}
 }
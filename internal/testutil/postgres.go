@@ -33,7 +33,7 @@ func TruncateAllTables(t *testing.T, pool *pgxpool.Pool) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	names := []string{"tasks", "columns", "boards", "users"}
+	names := []string{"board_events", "board_imports", "automation_runs", "automations", "task_links", "recurrences", "sprint_carry_overs", "burndown_snapshots", "task_transitions", "tasks", "sprints", "task_templates", "lanes", "columns", "boards", "board_templates", "users"}
 	parts := make([]string, len(names))
 	for i, name := range names {
 		parts[i] = pgx.Identifier{name}.Sanitize()
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE columns
+    ADD COLUMN wip_limit INT NOT NULL DEFAULT 0 CHECK (wip_limit >= 0);
+
+-- +goose Down
+ALTER TABLE columns
+    DROP COLUMN wip_limit;
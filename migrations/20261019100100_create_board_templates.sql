@@ -0,0 +1,45 @@
+-- +goose Up
+CREATE TABLE board_templates (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    -- NULL owner marks a built-in template visible to everyone
+    owner_id UUID REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    columns JSONB NOT NULL CHECK (jsonb_typeof(columns) = 'array'),
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX board_templates_owner_id_idx ON board_templates (owner_id);
+
+INSERT INTO board_templates (id, owner_id, name, description, columns) VALUES
+(
+    '019a0000-0000-7000-8000-000000000001',
+    NULL,
+    'Kanban',
+    'Classic flow from backlog to done',
+    '[
+        {"name": "Backlog", "description": "Ideas and requests nobody committed to yet", "wip_limit": 0, "tasks": []},
+        {"name": "To Do", "description": "Committed and ready to start", "wip_limit": 0, "tasks": []},
+        {"name": "In Progress", "description": "", "wip_limit": 3, "tasks": []},
+        {"name": "Review", "description": "", "wip_limit": 2, "tasks": []},
+        {"name": "Done", "description": "", "wip_limit": 0, "tasks": []}
+    ]'
+),
+(
+    '019a0000-0000-7000-8000-000000000002',
+    NULL,
+    'Simple',
+    'Three columns for personal todo lists',
+    '[
+        {"name": "To Do", "description": "", "wip_limit": 0, "tasks": [
+            {"name": "Drag me to In Progress", "description": "Tasks move between columns by changing their position"},
+            {"name": "Rename this board", "description": ""}
+        ]},
+        {"name": "In Progress", "description": "", "wip_limit": 0, "tasks": []},
+        {"name": "Done", "description": "", "wip_limit": 0, "tasks": []}
+    ]'
+);
+
+-- +goose Down
+DROP TABLE board_templates;
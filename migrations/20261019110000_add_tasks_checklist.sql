@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE tasks
+    ADD COLUMN checklist JSONB NOT NULL DEFAULT '[]' CHECK (jsonb_typeof(checklist) = 'array');
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP COLUMN checklist;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE task_templates (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name_pattern TEXT NOT NULL,
+    description_pattern TEXT NOT NULL DEFAULT '',
+    checklist JSONB NOT NULL DEFAULT '[]' CHECK (jsonb_typeof(checklist) = 'array'),
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX task_templates_board_id_idx ON task_templates (board_id);
+
+-- +goose Down
+DROP TABLE task_templates;
@@ -0,0 +1,20 @@
+-- +goose Up
+CREATE TABLE recurrences (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    column_id UUID NOT NULL REFERENCES columns(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    rrule TEXT NOT NULL CHECK (rrule <> ''),
+    starts_at TIMESTAMP NOT NULL,
+    catch_up TEXT NOT NULL CHECK (catch_up IN ('skip', 'create_once')),
+    next_run_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX recurrences_board_id_idx ON recurrences (board_id);
+CREATE INDEX recurrences_next_run_at_idx ON recurrences (next_run_at);
+
+-- +goose Down
+DROP TABLE recurrences;
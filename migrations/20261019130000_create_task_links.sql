@@ -0,0 +1,32 @@
+-- +goose Up
+ALTER TABLE columns
+    ADD COLUMN is_done BOOLEAN NOT NULL DEFAULT false;
+
+-- Built-in templates end with a Done column.
+UPDATE board_templates
+SET columns = (
+    SELECT jsonb_agg(
+        CASE WHEN c ->> 'name' = 'Done' THEN c || '{"is_done": true}'::jsonb ELSE c END
+        ORDER BY ord
+    )
+    FROM jsonb_array_elements(columns) WITH ORDINALITY AS e(c, ord)
+)
+WHERE owner_id IS NULL;
+
+CREATE TABLE task_links (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    source_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    target_task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('blocks', 'relates_to', 'duplicates')),
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    CHECK (source_task_id <> target_task_id),
+    UNIQUE (source_task_id, target_task_id, type)
+);
+
+CREATE INDEX task_links_target_task_id_idx ON task_links (target_task_id);
+
+-- +goose Down
+DROP TABLE task_links;
+
+ALTER TABLE columns
+    DROP COLUMN is_done;
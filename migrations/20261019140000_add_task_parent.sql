@@ -0,0 +1,11 @@
+-- +goose Up
+-- Deleting a parent orphans its children unless the caller asks to cascade.
+ALTER TABLE tasks
+    ADD COLUMN parent_id UUID REFERENCES tasks(id) ON DELETE SET NULL,
+    ADD CHECK (parent_id <> id);
+
+CREATE INDEX tasks_parent_id_idx ON tasks (parent_id);
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP COLUMN parent_id;
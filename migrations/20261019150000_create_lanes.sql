@@ -0,0 +1,38 @@
+-- +goose Up
+CREATE TABLE lanes (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    position INTEGER NOT NULL CHECK (position > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    CONSTRAINT lanes_board_id_position_key UNIQUE (board_id, position) DEFERRABLE INITIALLY IMMEDIATE
+);
+
+-- Tasks without a lane stay in the board's default lane. Positions are scoped per (column, lane) cell,
+-- and NULLS NOT DISTINCT keeps the default lane a cell of its own.
+ALTER TABLE tasks
+    ADD COLUMN lane_id UUID REFERENCES lanes(id),
+    DROP CONSTRAINT tasks_column_id_position_key,
+    ADD CONSTRAINT tasks_cell_position_key
+        UNIQUE NULLS NOT DISTINCT (column_id, lane_id, position) DEFERRABLE INITIALLY IMMEDIATE;
+
+CREATE INDEX tasks_lane_id_idx ON tasks (lane_id);
+
+-- +goose Down
+-- Positions restart per column, ordered by lane, so the per-column constraint holds again.
+UPDATE tasks t
+SET position = ordered.position
+FROM (
+    SELECT t.id, ROW_NUMBER() OVER (PARTITION BY t.column_id ORDER BY l.position NULLS FIRST, t.position) AS position
+    FROM tasks t
+    LEFT JOIN lanes l ON l.id = t.lane_id
+) ordered
+WHERE t.id = ordered.id;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT tasks_cell_position_key,
+    DROP COLUMN lane_id,
+    ADD CONSTRAINT tasks_column_id_position_key UNIQUE (column_id, position) DEFERRABLE INITIALLY IMMEDIATE;
+
+DROP TABLE lanes;
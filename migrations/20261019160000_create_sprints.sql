@@ -0,0 +1,43 @@
+-- +goose Up
+CREATE TABLE sprints (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    goal TEXT NOT NULL DEFAULT '',
+    start_date DATE NOT NULL,
+    end_date DATE NOT NULL,
+    completed_at TIMESTAMP,
+    -- The report snapshot, written once when the sprint is completed.
+    committed_count INTEGER,
+    completed_count INTEGER,
+    carried_over_to UUID REFERENCES sprints(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    CHECK (start_date <= end_date),
+    CHECK ((completed_at IS NULL) = (committed_count IS NULL AND completed_count IS NULL))
+);
+
+CREATE INDEX sprints_board_id_start_date_idx ON sprints (board_id, start_date);
+CREATE INDEX sprints_carried_over_to_idx ON sprints (carried_over_to);
+
+-- Carry-over tasks are copied by value so the report survives later task deletes.
+CREATE TABLE sprint_carry_overs (
+    sprint_id UUID NOT NULL REFERENCES sprints(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL,
+    task_name TEXT NOT NULL,
+    ordinal INTEGER NOT NULL,
+    PRIMARY KEY (sprint_id, task_id)
+);
+
+ALTER TABLE tasks
+    ADD COLUMN sprint_id UUID REFERENCES sprints(id) ON DELETE SET NULL;
+
+CREATE INDEX tasks_sprint_id_idx ON tasks (sprint_id);
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP COLUMN sprint_id;
+
+DROP TABLE sprint_carry_overs;
+
+DROP TABLE sprints;
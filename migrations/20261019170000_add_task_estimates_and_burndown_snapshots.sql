@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE tasks
+    ADD COLUMN estimate NUMERIC(6, 2) CHECK (estimate BETWEEN 0 AND 1000);
+
+-- One row per scope and UTC day. The scope is the whole board (sprint_id IS NULL) for boards
+-- without sprints and a single sprint otherwise.
+CREATE TABLE burndown_snapshots (
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    sprint_id UUID REFERENCES sprints(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    remaining_points NUMERIC(12, 2) NOT NULL,
+    completed_points NUMERIC(12, 2) NOT NULL,
+    captured_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    UNIQUE NULLS NOT DISTINCT (board_id, sprint_id, day)
+);
+
+CREATE INDEX burndown_snapshots_sprint_id_idx ON burndown_snapshots (sprint_id);
+
+-- +goose Down
+DROP TABLE burndown_snapshots;
+
+ALTER TABLE tasks
+    DROP COLUMN estimate;
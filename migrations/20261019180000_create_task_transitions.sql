@@ -0,0 +1,24 @@
+-- +goose Up
+-- One row per column change of a task; the first row of a task has no from_column_id and
+-- records its creation. Column ids are kept without foreign keys so the history stays
+-- keyed by column identity through renames, reorders and deletes of other columns.
+CREATE TABLE task_transitions (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    from_column_id UUID,
+    to_column_id UUID NOT NULL,
+    transitioned_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX task_transitions_board_id_transitioned_at_idx ON task_transitions (board_id, transitioned_at);
+CREATE INDEX task_transitions_task_id_idx ON task_transitions (task_id);
+
+-- Existing tasks have no history, so they are assumed to be in their current column since creation.
+INSERT INTO task_transitions (task_id, board_id, to_column_id, transitioned_at)
+SELECT t.id, c.board_id, t.column_id, t.created_at
+FROM tasks t
+JOIN columns c ON c.id = t.column_id;
+
+-- +goose Down
+DROP TABLE task_transitions;
@@ -0,0 +1,18 @@
+-- +goose Up
+ALTER TABLE columns
+    ADD COLUMN is_started BOOLEAN NOT NULL DEFAULT false;
+
+-- Work on tasks of built-in templates starts in their In Progress column.
+UPDATE board_templates
+SET columns = (
+    SELECT jsonb_agg(
+        CASE WHEN c ->> 'name' = 'In Progress' THEN c || '{"is_started": true}'::jsonb ELSE c END
+        ORDER BY ord
+    )
+    FROM jsonb_array_elements(columns) WITH ORDINALITY AS e(c, ord)
+)
+WHERE owner_id IS NULL;
+
+-- +goose Down
+ALTER TABLE columns
+    DROP COLUMN is_started;
@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE columns
+    ADD COLUMN sla_hours INTEGER NOT NULL DEFAULT 0;
+
+-- A breach is tied to the transition that put the task into its column, so leaving and
+-- re-entering the column starts with a fresh, unnotified transition.
+ALTER TABLE task_transitions
+    ADD COLUMN sla_notified_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE task_transitions
+    DROP COLUMN sla_notified_at;
+
+ALTER TABLE columns
+    DROP COLUMN sla_hours;
@@ -0,0 +1,23 @@
+-- +goose Up
+-- Daily counters of task changes per board. They are kept next to the history because deleting
+-- a task also deletes its transitions, so deletions could not be counted from them.
+CREATE TABLE board_task_activity (
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    created BIGINT NOT NULL DEFAULT 0,
+    moved BIGINT NOT NULL DEFAULT 0,
+    deleted BIGINT NOT NULL DEFAULT 0,
+    PRIMARY KEY (board_id, day)
+);
+
+-- Deletions before this migration are unknown; creations and moves come from the history.
+INSERT INTO board_task_activity (board_id, day, created, moved)
+SELECT board_id,
+       transitioned_at::date,
+       COUNT(*) FILTER (WHERE from_column_id IS NULL),
+       COUNT(*) FILTER (WHERE from_column_id IS NOT NULL)
+FROM task_transitions
+GROUP BY board_id, transitioned_at::date;
+
+-- +goose Down
+DROP TABLE board_task_activity;
@@ -0,0 +1,26 @@
+-- +goose Up
+CREATE TABLE custom_fields (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    type TEXT NOT NULL CHECK (type IN ('text', 'number', 'date', 'single_select', 'multi_select', 'checkbox', 'user')),
+    options JSONB NOT NULL DEFAULT '[]' CHECK (jsonb_typeof(options) = 'array'),
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX custom_fields_board_id_idx ON custom_fields (board_id);
+
+-- Values are keyed by custom field id. The GIN index serves containment filters on the values.
+ALTER TABLE tasks
+    ADD COLUMN custom_fields JSONB NOT NULL DEFAULT '{}' CHECK (jsonb_typeof(custom_fields) = 'object');
+
+CREATE INDEX tasks_custom_fields_idx ON tasks USING GIN (custom_fields jsonb_path_ops);
+
+-- +goose Down
+DROP INDEX tasks_custom_fields_idx;
+
+ALTER TABLE tasks
+    DROP COLUMN custom_fields;
+
+DROP TABLE custom_fields;
@@ -0,0 +1,10 @@
+-- +goose Up
+-- An empty allowed_transitions list lets tasks move from the column into any column of the board.
+ALTER TABLE columns
+    ADD COLUMN allowed_transitions UUID[] NOT NULL DEFAULT '{}',
+    ADD COLUMN entry_conditions TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE columns
+    DROP COLUMN entry_conditions,
+    DROP COLUMN allowed_transitions;
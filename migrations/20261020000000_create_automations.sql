@@ -0,0 +1,50 @@
+-- +goose Up
+CREATE TABLE automations (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    trigger_type TEXT NOT NULL CHECK (trigger_type IN ('task_created', 'task_moved')),
+    trigger_column_id UUID REFERENCES columns(id) ON DELETE CASCADE,
+    action_type TEXT NOT NULL CHECK (action_type IN ('move_to_column', 'set_custom_field', 'notify_telegram', 'create_task')),
+    action JSONB NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    updated_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX automations_board_id_idx ON automations (board_id);
+
+-- Task and event ids are kept without foreign keys so the log outlives deleted tasks.
+CREATE TABLE automation_runs (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    automation_id UUID NOT NULL REFERENCES automations(id) ON DELETE CASCADE,
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    event_id UUID NOT NULL,
+    task_id UUID NOT NULL,
+    status TEXT NOT NULL CHECK (status IN ('succeeded', 'failed', 'skipped')),
+    message TEXT NOT NULL DEFAULT '',
+    ran_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX automation_runs_board_id_ran_at_idx ON automation_runs (board_id, ran_at);
+CREATE INDEX automation_runs_automation_id_task_id_ran_at_idx ON automation_runs (automation_id, task_id, ran_at);
+
+-- Transitions are the events automations react to. An event is claimed by setting
+-- automations_run_at; the history before this migration is not replayed.
+ALTER TABLE task_transitions
+    ADD COLUMN automations_run_at TIMESTAMP;
+
+UPDATE task_transitions SET automations_run_at = transitioned_at;
+
+CREATE INDEX task_transitions_automations_pending_idx ON task_transitions (transitioned_at, id)
+    WHERE automations_run_at IS NULL;
+
+-- +goose Down
+DROP INDEX task_transitions_automations_pending_idx;
+
+ALTER TABLE task_transitions
+    DROP COLUMN automations_run_at;
+
+DROP TABLE automation_runs;
+
+DROP TABLE automations;
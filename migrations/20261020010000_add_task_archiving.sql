@@ -0,0 +1,40 @@
+-- +goose Up
+-- Tasks are archived archive_after_days days after they enter the column; zero never archives.
+ALTER TABLE columns
+    ADD COLUMN archive_after_days INTEGER NOT NULL DEFAULT 0;
+
+-- Archived tasks leave the dense position sequence of their cell. archived_key is null for active
+-- tasks, so they share one sequence per cell, and unique per archived task, so the archived ones
+-- keep the position they had without colliding with the active ones or each other.
+-- restored_at restarts the archive delay of a restored task, which would otherwise be archived
+-- again right away.
+ALTER TABLE tasks
+    ADD COLUMN archived_at TIMESTAMP,
+    ADD COLUMN restored_at TIMESTAMP,
+    ADD COLUMN archived_key UUID GENERATED ALWAYS AS (CASE WHEN archived_at IS NULL THEN NULL ELSE id END) STORED,
+    DROP CONSTRAINT tasks_cell_position_key,
+    ADD CONSTRAINT tasks_cell_position_key
+        UNIQUE NULLS NOT DISTINCT (column_id, lane_id, archived_key, position) DEFERRABLE INITIALLY IMMEDIATE;
+
+-- +goose Down
+-- Archived tasks are restored after the active tasks of their cell.
+UPDATE tasks t
+SET position = ordered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (
+        PARTITION BY column_id, lane_id ORDER BY archived_at NULLS FIRST, position
+    ) AS position
+    FROM tasks
+) ordered
+WHERE t.id = ordered.id;
+
+ALTER TABLE tasks
+    DROP CONSTRAINT tasks_cell_position_key,
+    DROP COLUMN archived_key,
+    DROP COLUMN restored_at,
+    DROP COLUMN archived_at,
+    ADD CONSTRAINT tasks_cell_position_key
+        UNIQUE NULLS NOT DISTINCT (column_id, lane_id, position) DEFERRABLE INITIALLY IMMEDIATE;
+
+ALTER TABLE columns
+    DROP COLUMN archive_after_days;
@@ -0,0 +1,24 @@
+-- +goose Up
+-- The uploaded file is kept in content until the import finishes. Running imports whose replica
+-- died are picked up again after a while, which is safe as the board is written in one
+-- transaction together with the succeeded status.
+CREATE TABLE board_imports (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    source TEXT NOT NULL CHECK (source IN ('trello', 'csv', 'json')),
+    name TEXT NOT NULL DEFAULT '',
+    mapping JSONB NOT NULL DEFAULT '{}' CHECK (jsonb_typeof(mapping) = 'object'),
+    content BYTEA NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending' CHECK (status IN ('pending', 'running', 'succeeded', 'failed')),
+    board_id UUID REFERENCES boards(id) ON DELETE SET NULL,
+    errors JSONB NOT NULL DEFAULT '[]' CHECK (jsonb_typeof(errors) = 'array'),
+    created_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    started_at TIMESTAMP,
+    finished_at TIMESTAMP
+);
+
+CREATE INDEX board_imports_unfinished_idx ON board_imports (created_at, id)
+    WHERE status IN ('pending', 'running');
+
+-- +goose Down
+DROP TABLE board_imports;
@@ -0,0 +1,22 @@
+-- +goose Up
+-- version counts the changes of a row for optimistic concurrency. It grows by one whenever the row
+-- itself is edited, moved or archived, and also whenever its position shifts because a neighbour
+-- is moved, inserted, duplicated, archived or deleted.
+ALTER TABLE boards
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE columns
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE tasks
+    ADD COLUMN version BIGINT NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE tasks
+    DROP COLUMN version;
+
+ALTER TABLE columns
+    DROP COLUMN version;
+
+ALTER TABLE boards
+    DROP COLUMN version;
@@ -0,0 +1,106 @@
+-- +goose Up
+-- Changes of boards, columns and tasks, written by triggers so every code path is covered. A row
+-- changes when its version does. Ids are kept without foreign keys so deletions stay in the log.
+-- Ids are handed out before commit, so a later id may become visible first. Readers order by the
+-- writing transaction and only read transactions older than every running one, which makes
+-- (tx_id, id) a cursor no later commit can fall behind.
+CREATE TABLE board_events (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    tx_id BIGINT NOT NULL DEFAULT (pg_current_xact_id()::text::bigint),
+    board_id UUID NOT NULL,
+    entity TEXT NOT NULL CHECK (entity IN ('board', 'column', 'task')),
+    entity_id UUID NOT NULL,
+    column_id UUID,
+    action TEXT NOT NULL CHECK (action IN ('created', 'updated', 'moved', 'deleted')),
+    version BIGINT NOT NULL,
+    occurred_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC')
+);
+
+CREATE INDEX board_events_board_id_tx_id_id_idx ON board_events (board_id, tx_id, id);
+
+-- Children removed along with their board or column are not recorded: the parent's deletion says it.
+-- +goose StatementBegin
+CREATE FUNCTION record_board_event() RETURNS trigger AS $$
+DECLARE
+    event_board_id UUID;
+    event_column_id UUID;
+    event_action TEXT;
+    event_row RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        event_row := OLD;
+        event_action := 'deleted';
+    ELSE
+        event_row := NEW;
+        event_action := CASE WHEN TG_OP = 'INSERT' THEN 'created' ELSE 'updated' END;
+    END IF;
+
+    IF TG_TABLE_NAME = 'boards' THEN
+        event_board_id := event_row.id;
+    ELSIF TG_TABLE_NAME = 'columns' THEN
+        SELECT id INTO event_board_id FROM boards WHERE id = event_row.board_id;
+        IF TG_OP = 'UPDATE' THEN
+            IF OLD.position <> NEW.position THEN
+                event_action := 'moved';
+            END IF;
+        END IF;
+    ELSE
+        event_column_id := event_row.column_id;
+        SELECT board_id INTO event_board_id FROM columns WHERE id = event_row.column_id;
+        -- Archiving takes a task out of its cell, which is an update rather than a move.
+        IF TG_OP = 'UPDATE' THEN
+            IF OLD.archived_at IS NOT DISTINCT FROM NEW.archived_at
+                AND (OLD.column_id, OLD.lane_id, OLD.position) IS DISTINCT FROM (NEW.column_id, NEW.lane_id, NEW.position) THEN
+                event_action := 'moved';
+            END IF;
+        END IF;
+    END IF;
+
+    IF event_board_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO board_events (board_id, entity, entity_id, column_id, action, version)
+    VALUES (event_board_id, rtrim(TG_TABLE_NAME, 's'), event_row.id, event_column_id, event_action, event_row.version);
+    PERFORM pg_notify('board_events', event_board_id::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER boards_record_board_event
+    AFTER INSERT OR DELETE ON boards
+    FOR EACH ROW EXECUTE FUNCTION record_board_event();
+
+CREATE TRIGGER boards_record_board_event_on_update
+    AFTER UPDATE ON boards
+    FOR EACH ROW WHEN (OLD.version IS DISTINCT FROM NEW.version) EXECUTE FUNCTION record_board_event();
+
+CREATE TRIGGER columns_record_board_event
+    AFTER INSERT OR DELETE ON columns
+    FOR EACH ROW EXECUTE FUNCTION record_board_event();
+
+CREATE TRIGGER columns_record_board_event_on_update
+    AFTER UPDATE ON columns
+    FOR EACH ROW WHEN (OLD.version IS DISTINCT FROM NEW.version) EXECUTE FUNCTION record_board_event();
+
+CREATE TRIGGER tasks_record_board_event
+    AFTER INSERT OR DELETE ON tasks
+    FOR EACH ROW EXECUTE FUNCTION record_board_event();
+
+CREATE TRIGGER tasks_record_board_event_on_update
+    AFTER UPDATE ON tasks
+    FOR EACH ROW WHEN (OLD.version IS DISTINCT FROM NEW.version) EXECUTE FUNCTION record_board_event();
+
+-- +goose Down
+DROP TRIGGER tasks_record_board_event_on_update ON tasks;
+DROP TRIGGER tasks_record_board_event ON tasks;
+DROP TRIGGER columns_record_board_event_on_update ON columns;
+DROP TRIGGER columns_record_board_event ON columns;
+DROP TRIGGER boards_record_board_event_on_update ON boards;
+DROP TRIGGER boards_record_board_event ON boards;
+
+DROP FUNCTION record_board_event();
+
+DROP TABLE board_events;
@@ -0,0 +1,117 @@
+-- +goose Up
+-- The owner of the board is kept with each event, so the changes of every board of a user can be
+-- read at once, deleted boards included.
+ALTER TABLE board_events ADD COLUMN owner_id UUID;
+
+UPDATE board_events e SET owner_id = b.owner_id FROM boards b WHERE b.id = e.board_id;
+
+CREATE INDEX board_events_owner_id_tx_id_id_idx ON board_events (owner_id, tx_id, id);
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_board_event() RETURNS trigger AS $$
+DECLARE
+    event_board_id UUID;
+    event_owner_id UUID;
+    event_column_id UUID;
+    event_action TEXT;
+    event_row RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        event_row := OLD;
+        event_action := 'deleted';
+    ELSE
+        event_row := NEW;
+        event_action := CASE WHEN TG_OP = 'INSERT' THEN 'created' ELSE 'updated' END;
+    END IF;
+
+    IF TG_TABLE_NAME = 'boards' THEN
+        event_board_id := event_row.id;
+        event_owner_id := event_row.owner_id;
+    ELSIF TG_TABLE_NAME = 'columns' THEN
+        SELECT id, owner_id INTO event_board_id, event_owner_id FROM boards WHERE id = event_row.board_id;
+        IF TG_OP = 'UPDATE' THEN
+            IF OLD.position <> NEW.position THEN
+                event_action := 'moved';
+            END IF;
+        END IF;
+    ELSE
+        event_column_id := event_row.column_id;
+        SELECT b.id, b.owner_id INTO event_board_id, event_owner_id
+        FROM columns c JOIN boards b ON b.id = c.board_id
+        WHERE c.id = event_row.column_id;
+        -- Archiving takes a task out of its cell, which is an update rather than a move.
+        IF TG_OP = 'UPDATE' THEN
+            IF OLD.archived_at IS NOT DISTINCT FROM NEW.archived_at
+                AND (OLD.column_id, OLD.lane_id, OLD.position) IS DISTINCT FROM (NEW.column_id, NEW.lane_id, NEW.position) THEN
+                event_action := 'moved';
+            END IF;
+        END IF;
+    END IF;
+
+    IF event_board_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO board_events (board_id, owner_id, entity, entity_id, column_id, action, version)
+    VALUES (event_board_id, event_owner_id, rtrim(TG_TABLE_NAME, 's'), event_row.id, event_column_id, event_action, event_row.version);
+    PERFORM pg_notify('board_events', event_board_id::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION record_board_event() RETURNS trigger AS $$
+DECLARE
+    event_board_id UUID;
+    event_column_id UUID;
+    event_action TEXT;
+    event_row RECORD;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        event_row := OLD;
+        event_action := 'deleted';
+    ELSE
+        event_row := NEW;
+        event_action := CASE WHEN TG_OP = 'INSERT' THEN 'created' ELSE 'updated' END;
+    END IF;
+
+    IF TG_TABLE_NAME = 'boards' THEN
+        event_board_id := event_row.id;
+    ELSIF TG_TABLE_NAME = 'columns' THEN
+        SELECT id INTO event_board_id FROM boards WHERE id = event_row.board_id;
+        IF TG_OP = 'UPDATE' THEN
+            IF OLD.position <> NEW.position THEN
+                event_action := 'moved';
+            END IF;
+        END IF;
+    ELSE
+        event_column_id := event_row.column_id;
+        SELECT board_id INTO event_board_id FROM columns WHERE id = event_row.column_id;
+        -- Archiving takes a task out of its cell, which is an update rather than a move.
+        IF TG_OP = 'UPDATE' THEN
+            IF OLD.archived_at IS NOT DISTINCT FROM NEW.archived_at
+                AND (OLD.column_id, OLD.lane_id, OLD.position) IS DISTINCT FROM (NEW.column_id, NEW.lane_id, NEW.position) THEN
+                event_action := 'moved';
+            END IF;
+        END IF;
+    END IF;
+
+    IF event_board_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    INSERT INTO board_events (board_id, entity, entity_id, column_id, action, version)
+    VALUES (event_board_id, rtrim(TG_TABLE_NAME, 's'), event_row.id, event_column_id, event_action, event_row.version);
+    PERFORM pg_notify('board_events', event_board_id::text);
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+DROP INDEX board_events_owner_id_tx_id_id_idx;
+
+ALTER TABLE board_events DROP COLUMN owner_id;
@@ -0,0 +1,97 @@
+-- +goose Up
+ALTER TABLE automations
+    DROP CONSTRAINT automations_trigger_type_check,
+    ADD CONSTRAINT automations_trigger_type_check
+        CHECK (trigger_type IN ('task_created', 'task_moved', 'due_date_passed', 'label_added')),
+    DROP CONSTRAINT automations_action_type_check,
+    ADD CONSTRAINT automations_action_type_check
+        CHECK (action_type IN ('move_to_column', 'set_custom_field', 'add_label', 'notify_telegram', 'create_task')),
+    ADD COLUMN trigger_field_id UUID REFERENCES custom_fields(id) ON DELETE CASCADE,
+    ADD COLUMN trigger_label TEXT;
+
+-- Field events are the custom field changes automations react to, next to task transitions.
+-- label_added is written by trigger so every code path is covered. due_date_passed is written by
+-- the automation runner once the date is over, at most once per task, field and date.
+CREATE TABLE task_field_events (
+    id UUID PRIMARY KEY DEFAULT uuidv7(),
+    board_id UUID NOT NULL REFERENCES boards(id) ON DELETE CASCADE,
+    task_id UUID NOT NULL REFERENCES tasks(id) ON DELETE CASCADE,
+    column_id UUID NOT NULL,
+    field_id UUID NOT NULL REFERENCES custom_fields(id) ON DELETE CASCADE,
+    type TEXT NOT NULL CHECK (type IN ('due_date_passed', 'label_added')),
+    value TEXT NOT NULL,
+    occurred_at TIMESTAMP NOT NULL DEFAULT (CURRENT_TIMESTAMP AT TIME ZONE 'UTC'),
+    automations_run_at TIMESTAMP
+);
+
+CREATE INDEX task_field_events_board_id_occurred_at_idx ON task_field_events (board_id, occurred_at);
+
+CREATE UNIQUE INDEX task_field_events_due_date_idx ON task_field_events (task_id, field_id, value)
+    WHERE type = 'due_date_passed';
+
+CREATE INDEX task_field_events_automations_pending_idx ON task_field_events (occurred_at, id)
+    WHERE automations_run_at IS NULL;
+
+-- +goose StatementBegin
+CREATE FUNCTION custom_field_options(value JSONB) RETURNS SETOF TEXT AS $$
+    SELECT jsonb_array_elements_text(CASE jsonb_typeof(value)
+        WHEN 'array' THEN value
+        WHEN 'string' THEN jsonb_build_array(value)
+        ELSE '[]'::jsonb
+    END);
+$$ LANGUAGE sql IMMUTABLE;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION record_task_field_events() RETURNS trigger AS $$
+DECLARE
+    old_fields JSONB := '{}';
+BEGIN
+    IF TG_OP = 'UPDATE' THEN
+        old_fields := OLD.custom_fields;
+    END IF;
+
+    INSERT INTO task_field_events (board_id, task_id, column_id, field_id, type, value)
+    SELECT f.board_id, NEW.id, NEW.column_id, f.id, 'label_added', label
+    FROM columns c
+    JOIN custom_fields f ON f.board_id = c.board_id AND f.type IN ('single_select', 'multi_select')
+    CROSS JOIN LATERAL custom_field_options(NEW.custom_fields -> f.id::text) AS label
+    WHERE c.id = NEW.column_id
+      AND label NOT IN (SELECT custom_field_options(old_fields -> f.id::text));
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER tasks_record_task_field_events
+    AFTER INSERT ON tasks
+    FOR EACH ROW WHEN (NEW.custom_fields <> '{}') EXECUTE FUNCTION record_task_field_events();
+
+CREATE TRIGGER tasks_record_task_field_events_on_update
+    AFTER UPDATE OF custom_fields ON tasks
+    FOR EACH ROW WHEN (OLD.custom_fields IS DISTINCT FROM NEW.custom_fields) EXECUTE FUNCTION record_task_field_events();
+
+-- +goose Down
+DROP TRIGGER tasks_record_task_field_events_on_update ON tasks;
+
+DROP TRIGGER tasks_record_task_field_events ON tasks;
+
+DROP FUNCTION record_task_field_events();
+
+DROP FUNCTION custom_field_options(JSONB);
+
+DROP TABLE task_field_events;
+
+DELETE FROM automations
+WHERE trigger_type IN ('due_date_passed', 'label_added') OR action_type = 'add_label';
+
+ALTER TABLE automations
+    DROP COLUMN trigger_label,
+    DROP COLUMN trigger_field_id,
+    DROP CONSTRAINT automations_action_type_check,
+    ADD CONSTRAINT automations_action_type_check
+        CHECK (action_type IN ('move_to_column', 'set_custom_field', 'notify_telegram', 'create_task')),
+    DROP CONSTRAINT automations_trigger_type_check,
+    ADD CONSTRAINT automations_trigger_type_check
+        CHECK (trigger_type IN ('task_created', 'task_moved'));
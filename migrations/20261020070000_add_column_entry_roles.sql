@@ -0,0 +1,8 @@
+-- +goose Up
+-- An empty entry_roles list lets every role move tasks into the column.
+ALTER TABLE columns
+    ADD COLUMN entry_roles TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE columns
+    DROP COLUMN entry_roles;